@@ -2,9 +2,7 @@ package asset
 
 import (
 	"encoding/base64"
-	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"path"
 	"path/filepath"
 	"strings"
@@ -28,6 +26,9 @@ type ThemeConfig struct {
 		DisableMinify []string `json:"disable_minify"`
 		DisableInline []string `json:"disable_inline"`
 	} `json:"asset"`
+	Templates struct {
+		Page []*ThemeTemplate `json:"page"`
+	} `json:"templates"`
 }
 
 var themeAssetsBundled = false
@@ -35,6 +36,11 @@ var bundledAssets = []*StaticAsset{}
 var assets []*StaticAsset
 
 func Load(themeDir string, force bool) {
+	// the bundled assets don't need the theme directory, the templates are kept when theme.json can't be read
+	if themeConfig, err := readThemeConfig(themeDir); err == nil {
+		theme = themeConfig
+	}
+
 	loadThemeAssets(themeDir, force)
 
 	for _, bundledAsset := range bundledAssets {
@@ -90,18 +96,12 @@ func loadThemeAssets(themeDir string, force bool) {
 		},
 	}
 
-	themeConfigFile := path.Join(themeDir, "theme.json")
-	themeConfig := &ThemeConfig{}
-	file, err := ioutil.ReadFile(themeConfigFile)
+	themeConfig, err := readThemeConfig(themeDir)
 
 	if err != nil {
 		panic(err)
 	}
 
-	if err := json.Unmarshal([]byte(file), themeConfig); err != nil {
-		panic(err)
-	}
-
 	assetDir := path.Join(themeDir, themeConfig.Asset.Dir)
 	themeAssets, err := filepath.Glob(assetDir + "/*/**")
 
@@ -0,0 +1,51 @@
+package asset
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path"
+)
+
+// ThemeTemplate is a template that the theme declares in theme.json, e.g. a landing page
+type ThemeTemplate struct {
+	Name  string `json:"name"`
+	Label string `json:"label"`
+}
+
+var theme = &ThemeConfig{}
+
+func readThemeConfig(themeDir string) (*ThemeConfig, error) {
+	themeConfig := &ThemeConfig{}
+	file, err := ioutil.ReadFile(path.Join(themeDir, "theme.json"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(file, themeConfig); err != nil {
+		return nil, err
+	}
+
+	return themeConfig, nil
+}
+
+// PageTemplates returns the page templates declared by the current theme
+func PageTemplates() []*ThemeTemplate {
+	return theme.Templates.Page
+}
+
+// HasPageTemplate reports whether the current theme declares the page template,
+// the empty name is the default template
+func HasPageTemplate(name string) bool {
+	if name == "" {
+		return true
+	}
+
+	for _, template := range theme.Templates.Page {
+		if template.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,22 @@
+package asset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThemeTemplates(t *testing.T) {
+	themeConfig, err := readThemeConfig("../themes/default")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "assets", themeConfig.Asset.Dir)
+
+	theme = themeConfig
+	assert.Equal(t, []string{"landing", "legal"}, []string{PageTemplates()[0].Name, PageTemplates()[1].Name})
+	assert.True(t, HasPageTemplate(""))
+	assert.True(t, HasPageTemplate("landing"))
+	assert.False(t, HasPageTemplate("missing"))
+
+	_, err = readThemeConfig("../themes/missing")
+	assert.NotEqual(t, nil, err)
+}
@@ -95,7 +95,7 @@ func Routes(s server.Server) {
 		return provider.Login(c)
 	})
 
-	authRoute.Get("/callback", func(c server.Context) error {
+	callback := func(c server.Context) error {
 		provider := GetProvider(c.Param("provider"))
 		userData, err := provider.Callback(c)
 
@@ -104,6 +104,16 @@ func Routes(s server.Server) {
 			return c.Status(http.StatusBadGateway).SendString("Something went wrong")
 		}
 
+		// Only existing users can login with a provider when the registration is not open
+		if userData.ID == 0 && config.Setting("registration_mode", config.REGISTRATION_OPEN) != config.REGISTRATION_OPEN {
+			if _, err := repositories.User.ByProvider(c.Context(), userData.Provider, userData.ProviderID); err != nil {
+				if !entities.IsNotFound(err) {
+					c.Logger().Error(err)
+				}
+				return c.Status(http.StatusForbidden).SendString("Registration is closed")
+			}
+		}
+
 		user, err := repositories.User.CreateIfNotExistsByProvider(c.Context(), userData)
 
 		if err != nil {
@@ -117,5 +127,8 @@ func Routes(s server.Server) {
 		}
 
 		return c.Redirect("/")
-	})
+	}
+
+	authRoute.Get("/callback", callback)
+	authRoute.Post("/callback", callback)
 }
@@ -3,8 +3,10 @@ package auth_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -28,7 +30,7 @@ import (
 func init() {
 	mock.CreateRepositories()
 	repositories.User.Create(context.Background(), mock.RootUser)
-	cache.Roles = []*entities.Role{auth.ROLE_ADMIN, auth.ROLE_USER, auth.ROLE_GUEST}
+	cache.SetPermissions([]*entities.Role{auth.ROLE_ADMIN, auth.ROLE_USER, auth.ROLE_GUEST}, cache.RolesPermissions())
 }
 
 func TestProvider(t *testing.T) {
@@ -88,16 +90,16 @@ func TestHelpers(t *testing.T) {
 		Action: "test",
 		Value:  entities.PERM_ALL,
 	}}
-	cache.RolesPermissions = []*entities.RolePermissions{{
+	cache.SetPermissions(cache.Roles(), []*entities.RolePermissions{{
 		RoleID:      1,
 		Permissions: role1Permissions,
-	}}
+	}})
 
 	assert.Equal(t, role1Permissions, auth.GetRolePermissions(1).Permissions)
 	assert.Equal(t, []*entities.PermissionValue{}, auth.GetRolePermissions(2).Permissions)
 	assert.Equal(t, role1Permissions[0], auth.GetRolePermission(1, "test"))
 	assert.Equal(t, &entities.PermissionValue{}, auth.GetRolePermission(1, "test2"))
-	assert.Equal(t, []*entities.Role{cache.Roles[0]}, auth.GetRolesFromIDs([]int{1}))
+	assert.Equal(t, []*entities.Role{cache.Roles()[0]}, auth.GetRolesFromIDs([]int{1}))
 	assert.Equal(t, []*entities.Role{}, auth.GetRolesFromIDs([]int{4}))
 	assert.Equal(t, true, auth.AllowAll(ctx))
 	assert.Equal(t, false, auth.AllowNone(ctx))
@@ -158,6 +160,35 @@ func TestHelpers(t *testing.T) {
 	mock.GetRequest(s, "/files/1")
 	mock.GetRequest(s, "/files/2")
 
+	repositories.Invite.Create(context.Background(), &entities.Invite{
+		ID:     1,
+		Code:   "invite1",
+		UserID: 1,
+	})
+
+	s.Get("/invites/:id", func(c server.Context) error {
+		err := auth.GetInvite(c)
+
+		if c.ParamInt("id") > 1 {
+			assert.Equal(t, true, entities.IsNotFound(err))
+			assert.Equal(t, nil, c.Locals("invite"))
+			assert.Equal(t, false, auth.InviteOwnerCheck(c))
+		} else {
+			assert.Equal(t, nil, err)
+
+			c.Locals("user", &entities.User{ID: 2})
+			assert.Equal(t, false, auth.InviteOwnerCheck(c))
+
+			c.Locals("user", &entities.User{ID: 1})
+			assert.Equal(t, true, auth.InviteOwnerCheck(c))
+		}
+
+		return nil
+	})
+
+	mock.GetRequest(s, "/invites/1")
+	mock.GetRequest(s, "/invites/2")
+
 	repositories.Post.Create(context.Background(), &entities.Post{
 		ID:     1,
 		Name:   "post 1",
@@ -369,6 +400,26 @@ func TestAssignUserInfo(t *testing.T) {
 		"cookie": config.APP_TOKEN_KEY + "=" + jwtToken,
 	})
 	assert.Equal(t, jwt.NewValidationError("token contains an invalid number of segments", 0x1), logger.Messages[0].Params[0])
+
+	s.Get("/bearertoken", func(c server.Context) error {
+		assert.Equal(t, mock.NormalUser2.ID, c.User().ID)
+		assert.Equal(t, true, auth.TokenAuthenticated(c))
+		return nil
+	})
+
+	mock.GetRequest(s, "/bearertoken", map[string]string{
+		"Authorization": "Bearer " + jwtToken,
+		"cookie":        config.APP_TOKEN_KEY + "=aaaa",
+	})
+
+	s.Get("/notoken", func(c server.Context) error {
+		assert.Equal(t, false, auth.TokenAuthenticated(c))
+		return nil
+	})
+
+	mock.GetRequest(s, "/notoken", map[string]string{
+		"cookie": config.APP_TOKEN_KEY + "=" + jwtToken,
+	})
 }
 
 func TestAuthCheck(t *testing.T) {
@@ -432,19 +483,97 @@ func TestGuestViewPostAllActionConfigs(t *testing.T) {
 	assert.Equal(t, http.StatusFound, resp.StatusCode)
 	assert.Equal(t, "/login?back=%2Fposts%2F1", resp.Header["Location"][0])
 
-	cache.RolesPermissions = []*entities.RolePermissions{{
+	cache.SetPermissions(cache.Roles(), []*entities.RolePermissions{{
 		RoleID: 3, // Guest
 		Permissions: []*entities.PermissionValue{{
 			Action: "guest.post.view.perm_all",
 			Value:  entities.PERM_ALL,
 		}},
-	}}
+	}})
 	s = createServerWithAuthConfig("guest.post.view.perm_all")
 	body, resp = mock.GetRequest(s, "/posts/1")
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 	assert.Equal(t, "View post: 1", body)
 }
 
+func TestTopicScopedPermission(t *testing.T) {
+	exp := time.Now().Add(time.Hour * 100 * 365 * 24)
+	jwtToken, _ := mock.NormalUser2.JwtClaim(exp)
+	authHeader := map[string]string{"cookie": config.APP_TOKEN_KEY + "=" + jwtToken}
+	post1, _ := repositories.Post.Create(context.Background(), &entities.Post{UserID: 1, Topics: []*entities.Topic{{ID: 1}}})
+	post2, _ := repositories.Post.Create(context.Background(), &entities.Post{UserID: 1, Topics: []*entities.Topic{{ID: 1}, {ID: 2}}})
+	post3, _ := repositories.Post.Create(context.Background(), &entities.Post{UserID: 1})
+	post1Url := fmt.Sprintf("/posts/%d", post1.ID)
+	post2Url := fmt.Sprintf("/posts/%d", post2.ID)
+
+	s := mock.CreateServer()
+	s.Use(auth.Check)
+	s.Get("/posts/:id", func(c server.Context) error {
+		return c.SendString("Compose post: " + c.Param("id"))
+	}, auth.Config(&server.AuthConfig{
+		Action:       "topicscoped.post.compose",
+		DefaultValue: entities.PERM_NONE,
+		Prepare:      auth.GetPost,
+		OwnCheckFN:   auth.PostOwnerCheck,
+		TopicCheckFN: auth.PostTopicCheck,
+	}))
+	s.Post("/posts/:id", func(c server.Context) error {
+		return c.SendString("Save post: " + c.Param("id"))
+	}, auth.Config(&server.AuthConfig{
+		Action:       "topicscoped.post.save",
+		DefaultValue: entities.PERM_NONE,
+		Prepare:      auth.GetPost,
+		OwnCheckFN:   auth.PostOwnerCheck,
+		TopicCheckFN: auth.PostSaveTopicCheck,
+	}))
+
+	cache.SetPermissions(cache.Roles(), []*entities.RolePermissions{{
+		RoleID: 2, // User
+		Permissions: []*entities.PermissionValue{{
+			Action:   "topicscoped.post.compose",
+			Value:    entities.PERM_ALL,
+			TopicIDs: []int{1},
+		}, {
+			Action:   "topicscoped.post.save",
+			Value:    entities.PERM_ALL,
+			TopicIDs: []int{1},
+		}},
+	}})
+
+	body, resp := mock.GetRequest(s, post1Url, authHeader)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, fmt.Sprintf("Compose post: %d", post1.ID), body)
+
+	_, resp = mock.GetRequest(s, "/posts/new", authHeader)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_, resp = mock.GetRequest(s, post2Url, authHeader)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	_, resp = mock.GetRequest(s, fmt.Sprintf("/posts/%d", post3.ID), authHeader)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	savePost := func(uri, form string) *http.Response {
+		req := httptest.NewRequest("POST", uri, strings.NewReader(form))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("cookie", authHeader["cookie"])
+		_, resp := mock.SendRequest(s, req)
+		return resp
+	}
+
+	assert.Equal(t, http.StatusOK, savePost(post1Url, "topic_ids=1").StatusCode)
+	assert.Equal(t, http.StatusOK, savePost("/posts/new", "topic_ids=1").StatusCode)
+	assert.Equal(t, http.StatusForbidden, savePost(post1Url, "topic_ids=1&topic_ids=2").StatusCode)
+	assert.Equal(t, http.StatusForbidden, savePost("/posts/new", "").StatusCode)
+	assert.Equal(t, http.StatusForbidden, savePost(post2Url, "topic_ids=1").StatusCode)
+
+	// Permissions without topics apply to all posts
+	cache.RolesPermissions()[0].Permissions[0].TopicIDs = nil
+	_, resp = mock.GetRequest(s, post2Url, authHeader)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	cache.SetPermissions(cache.Roles(), []*entities.RolePermissions{})
+}
+
 func TestInactiveUser(t *testing.T) {
 	var s server.Server
 	var resp *http.Response
@@ -455,13 +584,13 @@ func TestInactiveUser(t *testing.T) {
 	authHeaderNormalUser2 := map[string]string{"cookie": config.APP_TOKEN_KEY + "=" + jwtTokenNormalUser2}
 
 	s = createServerWithAuthConfig("inactiveuser.post.view.perm_none")
-	cache.RolesPermissions = []*entities.RolePermissions{{
+	cache.SetPermissions(cache.Roles(), []*entities.RolePermissions{{
 		RoleID: 2, // User
 		Permissions: []*entities.PermissionValue{{
 			Action: "inactiveuser.post.view.perm_none",
 			Value:  entities.PERM_NONE,
 		}},
-	}}
+	}})
 
 	_, resp = mock.GetRequest(s, "/posts/2", authHeaderNormalUser2)
 	assert.Equal(t, http.StatusFound, resp.StatusCode)
@@ -483,13 +612,13 @@ func TestUserViewPostAllActionConfigs(t *testing.T) {
 
 	// Action that allow no one to access
 	s = createServerWithAuthConfig("user.post.view.perm_none")
-	cache.RolesPermissions = []*entities.RolePermissions{{
+	cache.SetPermissions(cache.Roles(), []*entities.RolePermissions{{
 		RoleID: 2, // User
 		Permissions: []*entities.PermissionValue{{
 			Action: "user.post.view.perm_none",
 			Value:  entities.PERM_NONE,
 		}},
-	}}
+	}})
 
 	// Access from user who is the post owner
 	body, resp = mock.GetRequest(s, "/posts/2", authHeaderNormalUser2)
@@ -503,13 +632,13 @@ func TestUserViewPostAllActionConfigs(t *testing.T) {
 
 	// Action that only allow the owner to access
 	s = createServerWithAuthConfig("user.post.view.perm_own")
-	cache.RolesPermissions = []*entities.RolePermissions{{
+	cache.SetPermissions(cache.Roles(), []*entities.RolePermissions{{
 		RoleID: 2, // User
 		Permissions: []*entities.PermissionValue{{
 			Action: "user.post.view.perm_own",
 			Value:  entities.PERM_OWN,
 		}},
-	}}
+	}})
 
 	// Access from user who is not the post owner
 	body, resp = mock.GetRequest(s, "/posts/2", authHeaderNormalUser3)
@@ -524,13 +653,13 @@ func TestUserViewPostAllActionConfigs(t *testing.T) {
 
 	// Action that allow all users to access
 	s = createServerWithAuthConfig("user.post.view.perm_all")
-	cache.RolesPermissions = []*entities.RolePermissions{{
+	cache.SetPermissions(cache.Roles(), []*entities.RolePermissions{{
 		RoleID: 2, // User
 		Permissions: []*entities.PermissionValue{{
 			Action: "user.post.view.perm_all",
 			Value:  entities.PERM_ALL,
 		}},
-	}}
+	}})
 
 	// Access from user who is not the post owner
 	body, resp = mock.GetRequest(s, "/posts/2", authHeaderNormalUser3)
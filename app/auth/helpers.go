@@ -5,10 +5,11 @@ import (
 	"github.com/ngocphuongnb/tetua/app/entities"
 	"github.com/ngocphuongnb/tetua/app/repositories"
 	"github.com/ngocphuongnb/tetua/app/server"
+	"github.com/ngocphuongnb/tetua/app/utils"
 )
 
 func GetRolePermissions(roleID int) *entities.RolePermissions {
-	for _, rolePermission := range cache.RolesPermissions {
+	for _, rolePermission := range cache.RolesPermissions() {
 		if rolePermission.RoleID == roleID {
 			return rolePermission
 		}
@@ -35,7 +36,7 @@ func GetRolePermission(roleID int, action string) *entities.PermissionValue {
 func GetRolesFromIDs(IDs []int) []*entities.Role {
 	result := []*entities.Role{}
 
-	for _, role := range cache.Roles {
+	for _, role := range cache.Roles() {
 		for _, id := range IDs {
 			if role.ID == id {
 				result = append(result, role)
@@ -95,6 +96,29 @@ func GetComment(c server.Context) error {
 	return nil
 }
 
+func GetInvite(c server.Context) error {
+	invite, err := repositories.Invite.ByID(c.Context(), c.ParamInt("id"))
+
+	if err != nil {
+		return err
+	}
+
+	c.Locals("invite", invite)
+
+	return nil
+}
+
+func InviteOwnerCheck(c server.Context) bool {
+	user := c.User()
+	invite, ok := c.Locals("invite").(*entities.Invite)
+
+	if !ok || user == nil || invite == nil {
+		return false
+	}
+
+	return invite.UserID == user.ID
+}
+
 func FileOwnerCheck(c server.Context) bool {
 	if c.Param("id") == "new" {
 		return true
@@ -138,6 +162,31 @@ func PostOwnerCheck(c server.Context) bool {
 	return true
 }
 
+// PostTopicCheck checks the topic scope of a permission against the processing post topics
+func PostTopicCheck(c server.Context, permission *entities.PermissionValue) bool {
+	post := c.Post()
+
+	if post == nil {
+		return c.Param("id") == "new"
+	}
+
+	return permission.InTopics(utils.SliceMap(post.Topics, func(topic *entities.Topic) int {
+		return topic.ID
+	}))
+}
+
+// PostSaveTopicCheck also requires the submitted topics to be in the permission topics
+// so that a post can't be moved out of the topics the user is allowed to manage
+func PostSaveTopicCheck(c server.Context, permission *entities.PermissionValue) bool {
+	data := &entities.PostMutation{}
+
+	if err := c.BodyParser(data); err != nil || !permission.InTopics(data.TopicIDs) {
+		return false
+	}
+
+	return PostTopicCheck(c, permission)
+}
+
 func CommentOwnerCheck(c server.Context) bool {
 	if c.Param("id") == "new" {
 		return true
@@ -3,6 +3,7 @@ package auth
 import (
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
@@ -54,6 +55,11 @@ func Check(c server.Context) error {
 	for _, role := range userRoles {
 		permission := GetRolePermission(role.ID, routeName)
 
+		// Topic scoped permissions only apply to the resources in their topics
+		if len(permission.TopicIDs) > 0 && authConfig.TopicCheckFN != nil && !authConfig.TopicCheckFN(c, permission) {
+			continue
+		}
+
 		if permission.Value == entities.PERM_ALL {
 			return c.Next()
 		}
@@ -70,10 +76,21 @@ func Check(c server.Context) error {
 	return c.Status(http.StatusForbidden).SendString("Insufficient permission")
 }
 
+// TokenAuthenticated reports whether the request carries its credentials in the Authorization header
+func TokenAuthenticated(c server.Context) bool {
+	tokenAuth, ok := c.Locals("token_auth").(bool)
+	return ok && tokenAuth
+}
+
 func AssignUserInfo(c server.Context) error {
 	c.Locals("user", GUEST_USER)
 	tokenString := c.Cookies(config.APP_TOKEN_KEY)
 
+	if authorization := c.Header("Authorization"); strings.HasPrefix(authorization, "Bearer ") {
+		tokenString = strings.TrimPrefix(authorization, "Bearer ")
+		c.Locals("token_auth", true)
+	}
+
 	if tokenString == "" {
 		return c.Next()
 	}
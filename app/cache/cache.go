@@ -9,16 +9,60 @@ import (
 	"github.com/ngocphuongnb/tetua/app/utils"
 )
 
-var RolesPermissions = []*entities.RolePermissions{}
-var Topics = []*entities.Topic{}
-var Roles []*entities.Role
+var rolesPermissions = []*entities.RolePermissions{}
+var topics = []*entities.Topic{}
+var roles = []*entities.Role{}
+
+// valuesMu guards the cached values: the rebuilds swap in new slices under the write lock
+// and the readers get the current slices under the read lock, a swapped in slice isn't modified.
+// The rebuilds of each cache are serialized by their own mutex while they query the repositories.
+var valuesMu sync.RWMutex
+var topicsMu sync.Mutex
+var permissionsMu sync.Mutex
+
+// Topics returns the cached topics
+func Topics() []*entities.Topic {
+	valuesMu.RLock()
+	defer valuesMu.RUnlock()
+	return topics
+}
+
+// Roles returns the cached roles
+func Roles() []*entities.Role {
+	valuesMu.RLock()
+	defer valuesMu.RUnlock()
+	return roles
+}
+
+// RolesPermissions returns the cached permissions of the roles
+func RolesPermissions() []*entities.RolePermissions {
+	valuesMu.RLock()
+	defer valuesMu.RUnlock()
+	return rolesPermissions
+}
+
+// SetTopics replaces the cached topics
+func SetTopics(cachedTopics []*entities.Topic) {
+	valuesMu.Lock()
+	defer valuesMu.Unlock()
+	topics = cachedTopics
+}
+
+// SetPermissions replaces the cached roles and their permissions together
+func SetPermissions(cachedRoles []*entities.Role, cachedRolesPermissions []*entities.RolePermissions) {
+	valuesMu.Lock()
+	defer valuesMu.Unlock()
+	roles, rolesPermissions = cachedRoles, cachedRolesPermissions
+}
 
 func All() error {
 	var err1 error
 	var err2 error
+	var err3 error
+	var err4 error
 	var wg sync.WaitGroup
 
-	wg.Add(2)
+	wg.Add(4)
 	go func(wg *sync.WaitGroup) {
 		defer wg.Done()
 		err1 = CacheTopics()
@@ -27,40 +71,69 @@ func All() error {
 		defer wg.Done()
 		err2 = CachePermissions()
 	}(&wg)
+	go func(wg *sync.WaitGroup) {
+		defer wg.Done()
+		err3 = CacheMenus()
+	}(&wg)
+	go func(wg *sync.WaitGroup) {
+		defer wg.Done()
+		err4 = CacheCustomFields()
+	}(&wg)
 	wg.Wait()
 
-	return utils.FirstError(err1, err2)
+	return utils.FirstError(err1, err2, err3, err4)
 }
 
-func CacheTopics(ctxs ...context.Context) (err error) {
+// CacheTopics reloads the topics, the cache is only replaced when the query succeeded
+func CacheTopics(ctxs ...context.Context) error {
 	ctxs = append(ctxs, context.Background())
-	Topics, err = repositories.Topic.All(ctxs[0])
-	return
+	topicsMu.Lock()
+	defer topicsMu.Unlock()
+
+	allTopics, err := repositories.Topic.All(ctxs[0])
+
+	if err != nil {
+		return err
+	}
+
+	SetTopics(allTopics)
+
+	return nil
 }
 
-func CachePermissions(ctxs ...context.Context) (err error) {
+// CachePermissions rebuilds the roles and their permissions into new slices
+// and swaps them in together once they are complete
+func CachePermissions(ctxs ...context.Context) error {
 	ctxs = append(ctxs, context.Background())
-	Roles, err = repositories.Role.All(ctxs[0])
+	permissionsMu.Lock()
+	defer permissionsMu.Unlock()
+
+	allRoles, err := repositories.Role.All(ctxs[0])
 
 	if err != nil {
 		return err
 	}
 
-	for _, role := range Roles {
+	allRolesPermissions := make([]*entities.RolePermissions, 0, len(allRoles))
+
+	for _, role := range allRoles {
 		permissions := []*entities.PermissionValue{}
 
 		for _, permission := range role.Permissions {
 			permissions = append(permissions, &entities.PermissionValue{
-				Action: permission.Action,
-				Value:  entities.GetPermTypeValue(permission.Value),
+				Action:   permission.Action,
+				Value:    entities.GetPermTypeValue(permission.Value),
+				TopicIDs: permission.TopicIDs,
 			})
 		}
 
-		RolesPermissions = append(RolesPermissions, &entities.RolePermissions{
+		allRolesPermissions = append(allRolesPermissions, &entities.RolePermissions{
 			RoleID:      role.ID,
 			Permissions: permissions,
 		})
 	}
 
+	SetPermissions(allRoles, allRolesPermissions)
+
 	return nil
 }
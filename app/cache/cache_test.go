@@ -3,6 +3,8 @@ package cache_test
 import (
 	"context"
 	"errors"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/ngocphuongnb/tetua/app/auth"
@@ -33,13 +35,13 @@ func TestCache(t *testing.T) {
 
 	assert.Equal(t, nil, err)
 	assert.Equal(t, nil, cache.All())
-	assert.Equal(t, 1, len(cache.Topics))
-	assert.Equal(t, 3, len(cache.Roles))
-	assert.Equal(t, 2, cache.RolesPermissions[1].RoleID)
+	assert.Equal(t, 1, len(cache.Topics()))
+	assert.Equal(t, 3, len(cache.Roles()))
+	assert.Equal(t, 2, cache.RolesPermissions()[1].RoleID)
 	assert.Equal(t, []*entities.PermissionValue{{
 		Action: "post.view",
 		Value:  entities.PERM_ALL,
-	}}, cache.RolesPermissions[1].Permissions)
+	}}, cache.RolesPermissions()[1].Permissions)
 }
 
 func TestCacheError(t *testing.T) {
@@ -48,3 +50,146 @@ func TestCacheError(t *testing.T) {
 	assert.Equal(t, errors.New("Get all topics error"), cache.CacheTopics(ctx))
 	assert.Equal(t, errors.New("Get all roles error"), cache.CachePermissions(ctx))
 }
+
+func TestCachePermissionsRebuild(t *testing.T) {
+	assert.Equal(t, nil, cache.CachePermissions())
+	assert.Equal(t, nil, cache.CachePermissions())
+	assert.Equal(t, len(cache.Roles()), len(cache.RolesPermissions()))
+
+	roles := cache.Roles()
+	rolesPermissions := cache.RolesPermissions()
+	ctx := context.WithValue(context.Background(), "query_error", true)
+
+	assert.Equal(t, errors.New("Get all roles error"), cache.Invalidate(ctx, cache.EVENT_PERMISSIONS))
+	assert.Equal(t, roles, cache.Roles())
+	assert.Equal(t, rolesPermissions, cache.RolesPermissions())
+}
+
+func TestCacheConcurrentReads(t *testing.T) {
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-done:
+					return
+				default:
+					_ = len(cache.Topics()) + len(cache.Roles()) + len(cache.RolesPermissions())
+					_ = cache.Menu("header")
+					_ = cache.CustomFields("post")
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, nil, cache.All())
+	}
+
+	close(done)
+	wg.Wait()
+}
+
+func TestCacheInvalidation(t *testing.T) {
+	transport := cache.NewLocalTransport()
+	messages := []string{}
+	transport.Subscribe(func(message string) {
+		messages = append(messages, message)
+	})
+
+	assert.Equal(t, nil, cache.Listen(transport))
+	defer cache.Close()
+
+	topic, _ := repositories.Topic.Create(context.Background(), &entities.Topic{Name: "Topic 2"})
+	defer repositories.Topic.DeleteByID(context.Background(), topic.ID)
+	repositories.Changed(context.Background(), "topic")
+	assert.Equal(t, 2, len(cache.Topics()))
+
+	repositories.Changed(context.Background(), "post")
+	assert.Equal(t, 1, len(messages))
+	assert.True(t, strings.HasSuffix(messages[0], ":"+cache.EVENT_TOPICS))
+
+	role, _ := repositories.Role.Create(context.Background(), &entities.Role{Name: "Editor"})
+	defer repositories.Role.DeleteByID(context.Background(), role.ID)
+	repositories.Changed(context.Background(), "permission")
+	assert.Equal(t, 4, len(cache.Roles()))
+	assert.Equal(t, 4, len(cache.RolesPermissions()))
+	assert.Equal(t, 2, len(messages))
+	assert.True(t, strings.HasSuffix(messages[1], ":"+cache.EVENT_PERMISSIONS))
+
+	// events published by this instance are ignored, events from the other instances rebuild the cache
+	repositories.Topic.DeleteByID(context.Background(), topic.ID)
+	transport.Publish(context.Background(), messages[0])
+	assert.Equal(t, 2, len(cache.Topics()))
+
+	transport.Publish(context.Background(), "other-instance:"+cache.EVENT_TOPICS)
+	assert.Equal(t, 1, len(cache.Topics()))
+}
+
+func TestCacheMenus(t *testing.T) {
+	ctx := context.Background()
+	topic, _ := repositories.Topic.Create(ctx, &entities.Topic{Name: "Docs", Slug: "docs"})
+	defer repositories.Topic.DeleteByID(ctx, topic.ID)
+	post, _ := repositories.Post.Create(ctx, &entities.Post{Name: "Hello", Slug: "hello", Approved: true})
+	draftPost, _ := repositories.Post.Create(ctx, &entities.Post{Name: "Draft", Slug: "draft", Approved: true, Draft: true})
+	menu, _ := repositories.Menu.Create(ctx, &entities.Menu{Name: "Main", Location: "header"})
+	defer repositories.Menu.DeleteByID(ctx, menu.ID)
+
+	assert.Equal(t, nil, repositories.Menu.SetItems(ctx, menu.ID, entities.MenuItemsFromRows([]*entities.MenuItemMutation{
+		{Label: "Docs", Type: entities.MENU_ITEM_TOPIC, TopicID: topic.ID},
+		{Label: "Hello", Type: entities.MENU_ITEM_POST, PostID: post.ID, Depth: 1},
+		{Label: "Draft", Type: entities.MENU_ITEM_POST, PostID: draftPost.ID},
+		{Label: "Under draft", Type: entities.MENU_ITEM_CUSTOM, Url: "/under-draft", Depth: 1},
+		{Label: "Github", Type: entities.MENU_ITEM_CUSTOM, Url: "https://github.com"},
+	})))
+	assert.Equal(t, nil, cache.CacheMenus())
+
+	header := cache.Menu("header")
+	assert.Equal(t, "Main", header.Name)
+	assert.Equal(t, 2, len(header.Items))
+	assert.Equal(t, topic.Url(), header.Items[0].Link)
+	assert.Equal(t, post.Url(), header.Items[0].Children[0].Link)
+	assert.Equal(t, "https://github.com", header.Items[1].Link)
+
+	// the cached menus are copies, the stored items are kept
+	storedMenu, _ := repositories.Menu.ByID(ctx, menu.ID)
+	assert.Equal(t, 3, len(storedMenu.Items))
+
+	footer := cache.Menu("footer")
+	assert.Equal(t, "footer", footer.Location)
+	assert.Equal(t, 0, len(footer.Items))
+
+	// publishing the draft post rebuilds the menus even though its item was left out
+	assert.Equal(t, nil, cache.Listen(cache.NewLocalTransport()))
+	defer cache.Close()
+	draftPost.Draft = false
+	repositories.Changed(ctx, "post")
+	assert.Equal(t, 3, len(cache.Menu("header").Items))
+	assert.Equal(t, "/under-draft", cache.Menu("header").Items[1].Children[0].Link)
+
+	ctx = context.WithValue(ctx, "query_error", true)
+	assert.Equal(t, errors.New("Get all menus error"), cache.CacheMenus(ctx))
+}
+
+func TestCacheCustomFields(t *testing.T) {
+	ctx := context.Background()
+	subtitle, _ := repositories.CustomField.Create(ctx, &entities.CustomField{Name: "subtitle", Target: "post", Order: 2})
+	defer repositories.CustomField.DeleteByID(ctx, subtitle.ID)
+	source, _ := repositories.CustomField.Create(ctx, &entities.CustomField{Name: "source", Target: "post", Order: 1})
+	defer repositories.CustomField.DeleteByID(ctx, source.ID)
+	website, _ := repositories.CustomField.Create(ctx, &entities.CustomField{Name: "website", Target: "user"})
+	defer repositories.CustomField.DeleteByID(ctx, website.ID)
+
+	assert.Equal(t, nil, cache.CacheCustomFields())
+	assert.Equal(t, []*entities.CustomField{source, subtitle}, cache.CustomFields("post"))
+	assert.Equal(t, []*entities.CustomField{website}, cache.CustomFields("user"))
+	assert.Equal(t, 0, len(cache.CustomFields("page")))
+
+	ctx = context.WithValue(ctx, "query_error", true)
+	assert.Equal(t, errors.New("Get all custom fields error"), cache.CacheCustomFields(ctx))
+}
@@ -0,0 +1,57 @@
+package cache
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+)
+
+var customFields = []*entities.CustomField{}
+var customFieldsMu sync.Mutex
+
+// CustomFields returns the custom fields of a target type, e.g. post, page or user
+func CustomFields(target string) []*entities.CustomField {
+	valuesMu.RLock()
+	cachedFields := customFields
+	valuesMu.RUnlock()
+	fields := []*entities.CustomField{}
+
+	for _, field := range cachedFields {
+		if field.Target == target {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+// CacheCustomFields reloads the custom fields sorted by their order
+func CacheCustomFields(ctxs ...context.Context) error {
+	ctxs = append(ctxs, context.Background())
+	customFieldsMu.Lock()
+	defer customFieldsMu.Unlock()
+
+	fields, err := repositories.CustomField.All(ctxs[0])
+
+	if err != nil {
+		return err
+	}
+
+	sorted := append([]*entities.CustomField{}, fields...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Order == sorted[j].Order {
+			return sorted[i].ID < sorted[j].ID
+		}
+
+		return sorted[i].Order < sorted[j].Order
+	})
+
+	valuesMu.Lock()
+	customFields = sorted
+	valuesMu.Unlock()
+
+	return nil
+}
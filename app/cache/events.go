@@ -0,0 +1,131 @@
+package cache
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/ngocphuongnb/tetua/app/logger"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+)
+
+const (
+	EVENT_TOPICS        = "topics"
+	EVENT_PERMISSIONS   = "permissions"
+	EVENT_MENUS         = "menus"
+	EVENT_CUSTOM_FIELDS = "custom_fields"
+)
+
+// Transport broadcasts the invalidation events to the other instances of the app
+type Transport interface {
+	Publish(ctx context.Context, message string) error
+	Subscribe(handler func(message string)) error
+	Close() error
+}
+
+// entityEvents maps the repository names to the caches they invalidate
+var entityEvents = map[string][]string{
+	"topic":        {EVENT_TOPICS, EVENT_MENUS},
+	"role":         {EVENT_PERMISSIONS},
+	"permission":   {EVENT_PERMISSIONS},
+	"menu":         {EVENT_MENUS},
+	"page":         {EVENT_MENUS},
+	"post":         {EVENT_MENUS},
+	"custom_field": {EVENT_CUSTOM_FIELDS},
+}
+
+var instanceID = uuid.NewString()
+var transportMu sync.RWMutex
+var transport Transport
+
+func init() {
+	repositories.OnChange(func(ctx context.Context, entity string) {
+		for _, event := range entityEvents[entity] {
+			// the menus only need to be rebuilt when they link to the changed type
+			if event == EVENT_MENUS && entity != "menu" && !menuReferences(entity) {
+				continue
+			}
+
+			if err := Invalidate(ctx, event); err != nil {
+				logger.Error("Error invalidating cache", event, err)
+			}
+		}
+	})
+}
+
+// Listen sets the transport used to broadcast the invalidation events and
+// rebuilds the cache when the other instances publish an event
+func Listen(t Transport) error {
+	transportMu.Lock()
+	defer transportMu.Unlock()
+
+	if transport != nil {
+		if err := transport.Close(); err != nil {
+			return err
+		}
+	}
+
+	transport = t
+
+	return t.Subscribe(handleMessage)
+}
+
+// Close stops broadcasting, the cache is only rebuilt on local changes afterward
+func Close() error {
+	transportMu.Lock()
+	defer transportMu.Unlock()
+
+	if transport == nil {
+		return nil
+	}
+
+	err := transport.Close()
+	transport = nil
+
+	return err
+}
+
+// Invalidate rebuilds the cache of the event locally then notifies the other instances
+func Invalidate(ctx context.Context, event string) error {
+	if err := rebuild(ctx, event); err != nil {
+		return err
+	}
+
+	transportMu.RLock()
+	t := transport
+	transportMu.RUnlock()
+
+	if t == nil {
+		return nil
+	}
+
+	return t.Publish(ctx, instanceID+":"+event)
+}
+
+func handleMessage(message string) {
+	sender, event, found := strings.Cut(message, ":")
+
+	if !found || sender == instanceID {
+		return
+	}
+
+	if err := rebuild(context.Background(), event); err != nil {
+		logger.Error("Error rebuilding cache", event, err)
+	}
+}
+
+func rebuild(ctx context.Context, event string) error {
+	switch event {
+	case EVENT_TOPICS:
+		return CacheTopics(ctx)
+	case EVENT_PERMISSIONS:
+		return CachePermissions(ctx)
+	case EVENT_MENUS:
+		return CacheMenus(ctx)
+	case EVENT_CUSTOM_FIELDS:
+		return CacheCustomFields(ctx)
+	}
+
+	return nil
+}
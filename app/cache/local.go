@@ -0,0 +1,45 @@
+package cache
+
+import (
+	"context"
+	"sync"
+)
+
+// LocalTransport delivers the events to the subscribers of the same process,
+// it's the default transport for a single instance deployment
+type LocalTransport struct {
+	mu       sync.RWMutex
+	handlers []func(message string)
+}
+
+func NewLocalTransport() *LocalTransport {
+	return &LocalTransport{}
+}
+
+func (t *LocalTransport) Publish(ctx context.Context, message string) error {
+	t.mu.RLock()
+	handlers := t.handlers
+	t.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(message)
+	}
+
+	return nil
+}
+
+func (t *LocalTransport) Subscribe(handler func(message string)) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.handlers = append(t.handlers, handler)
+
+	return nil
+}
+
+func (t *LocalTransport) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.handlers = nil
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package cache
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+)
+
+var menus = []*entities.Menu{}
+var menusMu sync.Mutex
+
+// menuItemTypes are the item types of the menus before resolving,
+// unresolved items still need a rebuild when their target is changed
+var menuItemTypes = map[string]bool{}
+
+// Menus returns the cached menus
+func Menus() []*entities.Menu {
+	valuesMu.RLock()
+	defer valuesMu.RUnlock()
+	return menus
+}
+
+// Menu returns the cached menu of a location, an empty menu is returned
+// when there is no menu at the location so themes can always range over the items
+func Menu(location string) *entities.Menu {
+	for _, menu := range Menus() {
+		if menu.Location == location {
+			return menu
+		}
+	}
+
+	return &entities.Menu{Location: location}
+}
+
+// CacheMenus reloads the menus and resolves the links of their items,
+// items whose target is missing or not published are left out with their children
+func CacheMenus(ctxs ...context.Context) error {
+	ctxs = append(ctxs, context.Background())
+	menusMu.Lock()
+	defer menusMu.Unlock()
+
+	allMenus, err := repositories.Menu.All(ctxs[0])
+
+	if err != nil {
+		return err
+	}
+
+	cachedMenus := []*entities.Menu{}
+	itemTypes := map[string]bool{}
+
+	for _, menu := range allMenus {
+		for _, item := range entities.FlattenMenuItems(menu.Items, 0) {
+			itemTypes[item.Type] = true
+		}
+
+		cachedMenu := *menu
+		cachedMenu.Items = resolveMenuItems(ctxs[0], menu.Items)
+		cachedMenus = append(cachedMenus, &cachedMenu)
+	}
+
+	valuesMu.Lock()
+	menus, menuItemTypes = cachedMenus, itemTypes
+	valuesMu.Unlock()
+
+	return nil
+}
+
+// menuReferences reports whether the cached menus have an item of the given type
+func menuReferences(itemType string) bool {
+	valuesMu.RLock()
+	defer valuesMu.RUnlock()
+	return menuItemTypes[itemType]
+}
+
+func resolveMenuItems(ctx context.Context, items []*entities.MenuItem) []*entities.MenuItem {
+	result := []*entities.MenuItem{}
+
+	for _, item := range items {
+		resolved := *item
+
+		if resolved.Link = menuItemLink(ctx, item); resolved.Link == "" {
+			continue
+		}
+
+		resolved.Children = resolveMenuItems(ctx, item.Children)
+		result = append(result, &resolved)
+	}
+
+	return result
+}
+
+func menuItemLink(ctx context.Context, item *entities.MenuItem) string {
+	switch item.Type {
+	case entities.MENU_ITEM_PAGE:
+		if page, err := repositories.Page.ByID(ctx, item.TargetID); err == nil && !page.Draft {
+			return page.Url()
+		}
+	case entities.MENU_ITEM_TOPIC:
+		if topic, err := repositories.Topic.ByID(ctx, item.TargetID); err == nil {
+			return topic.Url()
+		}
+	case entities.MENU_ITEM_POST:
+		if post, err := repositories.Post.PublishedPostByID(ctx, item.TargetID); err == nil {
+			return post.Url()
+		}
+	case entities.MENU_ITEM_CUSTOM:
+		return item.Url
+	}
+
+	return ""
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/ngocphuongnb/tetua/app/config"
+	"github.com/ngocphuongnb/tetua/app/logger"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+)
+
+// PruneAuditLogs deletes the audit logs that are older than the audit_log_retention_days setting,
+// an empty or zero value keeps the logs forever
+func PruneAuditLogs(ctxs ...context.Context) (int, error) {
+	ctxs = append(ctxs, context.Background())
+	days, err := strconv.Atoi(config.Setting("audit_log_retention_days", "0"))
+
+	if err != nil || days <= 0 {
+		return 0, nil
+	}
+
+	return repositories.AuditLog.DeleteBefore(ctxs[0], time.Now().AddDate(0, 0, -days))
+}
+
+// ScheduleAuditLogPrune prunes the audit logs now and then at every interval
+func ScheduleAuditLogPrune(interval time.Duration) {
+	prune := func() {
+		if count, err := PruneAuditLogs(); err != nil {
+			logger.Error("Error pruning audit logs", err)
+		} else if count > 0 {
+			logger.Info("Pruned audit logs", logger.Context{"count": count})
+		}
+	}
+
+	prune()
+	go func() {
+		for range time.Tick(interval) {
+			prune()
+		}
+	}()
+}
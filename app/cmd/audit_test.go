@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ngocphuongnb/tetua/app/config"
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/mock"
+	mockrepository "github.com/ngocphuongnb/tetua/app/mock/repository"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPruneAuditLogs(t *testing.T) {
+	mock.CreateRepositories()
+	defer config.Settings([]*config.SettingItem{{Name: "audit_log_retention_days", Value: "90", Type: "input"}})
+
+	ctx := context.Background()
+	old := time.Now().AddDate(0, 0, -31)
+
+	for _, action := range []string{"manage.topic.save", "manage.role.save", "manage.user.delete"} {
+		repositories.AuditLog.Create(ctx, &entities.AuditLog{Action: action})
+	}
+
+	logs, _ := repositories.AuditLog.All(ctx)
+	logs[0].CreatedAt = &old
+
+	config.Settings([]*config.SettingItem{{Name: "audit_log_retention_days", Value: "0", Type: "input"}})
+	count, err := PruneAuditLogs(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+
+	config.Settings([]*config.SettingItem{{Name: "audit_log_retention_days", Value: "30", Type: "input"}})
+	count, err = PruneAuditLogs(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+
+	logs, _ = repositories.AuditLog.All(ctx)
+	assert.Equal(t, 2, len(logs))
+	assert.Equal(t, "manage.role.save", logs[0].Action)
+
+	mockrepository.FakeRepoErrors["audit_log_deleteBefore"] = errors.New("Delete error")
+	defer delete(mockrepository.FakeRepoErrors, "audit_log_deleteBefore")
+	_, err = PruneAuditLogs(ctx)
+	assert.Equal(t, errors.New("Delete error"), err)
+}
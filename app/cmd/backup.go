@@ -0,0 +1,521 @@
+package cmd
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/fs"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+)
+
+// BackupFormat is the version of the backup archive format
+const BackupFormat = 1
+
+const backupDatabaseEntry = "database.ndjson"
+const backupManifestEntry = "manifest.json"
+const backupDisksDir = "disks"
+
+// BackupObject is an entry of a backup archive, the objects of the disks are stored at disks/{disk}/{path}
+type BackupObject struct {
+	Disk   string `json:"disk,omitempty"`
+	Path   string `json:"path"`
+	Type   string `json:"type,omitempty"`
+	Size   int    `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// BackupManifest is the last entry of a backup archive, it's used to verify the archive before it's restored
+type BackupManifest struct {
+	Format        int                              `json:"format"`
+	CreatedAt     time.Time                        `json:"created_at"`
+	Driver        string                           `json:"driver"`
+	SchemaVersion int64                            `json:"schema_version"`
+	Database      *BackupObject                    `json:"database"`
+	Tables        map[string]*entities.BackupTable `json:"tables"`
+	Objects       []*BackupObject                  `json:"objects"`
+}
+
+type BackupResult struct {
+	Manifest *BackupManifest
+	// Failed are the objects that couldn't be read, keyed by {disk}/{path}
+	Failed map[string]error
+	Size   int
+}
+
+type RestoreResult struct {
+	Manifest *BackupManifest
+	Tables   map[string]*entities.BackupTable
+	Restored []*BackupObject
+	Failed   map[string]error
+	Size     int
+}
+
+// CreateBackup writes a tar.gz archive with the database dump of repositories.Backup and the objects of the
+// files and their variants on all the disks. The objects are staged in a temporary file so the archive
+// entries have their real sizes, the objects that can't be read are reported and left out of the archive.
+func CreateBackup(archivePath string, ctxs ...context.Context) (result *BackupResult, err error) {
+	ctxs = append(ctxs, context.Background())
+	ctx := ctxs[0]
+	archive, err := os.Create(archivePath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		archive.Close()
+
+		if err != nil {
+			os.Remove(archivePath)
+		}
+	}()
+
+	stage, err := os.CreateTemp("", "tetua-backup-*")
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		stage.Close()
+		os.Remove(stage.Name())
+	}()
+
+	schemaVersion, err := repositories.Backup.SchemaVersion(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result = &BackupResult{
+		Manifest: &BackupManifest{
+			Format:        BackupFormat,
+			CreatedAt:     time.Now().UTC(),
+			Driver:        repositories.Backup.Driver(),
+			SchemaVersion: schemaVersion,
+			Objects:       []*BackupObject{},
+		},
+		Failed: map[string]error{},
+	}
+	gzipWriter := gzip.NewWriter(archive)
+	tarWriter := tar.NewWriter(gzipWriter)
+	result.Manifest.Database = &BackupObject{Path: backupDatabaseEntry}
+
+	if err := stageBackupObject(stage, result.Manifest.Database, func(w io.Writer) (err error) {
+		result.Manifest.Tables, err = repositories.Backup.Dump(ctx, w)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := writeBackupEntry(tarWriter, backupDatabaseEntry, stage, result.Manifest.Database.Size); err != nil {
+		return nil, err
+	}
+
+	files, err := findAllFiles(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// deduplicated files share their objects, which are stored once
+	stored := map[string]bool{}
+
+	for _, object := range fileObjects(files) {
+		key := path.Join(object.Disk, object.Path)
+
+		if stored[key] {
+			continue
+		}
+
+		stored[key] = true
+		disk := fs.Disk(object.Disk)
+
+		if disk == nil {
+			result.Failed[key] = errors.New("disk not found")
+			continue
+		}
+
+		if err := stageBackupObject(stage, object, func(w io.Writer) error {
+			reader, err := disk.Open(ctx, object.Path)
+
+			if err != nil {
+				return err
+			}
+
+			defer reader.Close()
+			_, err = io.Copy(w, reader)
+			return err
+		}); err != nil {
+			result.Failed[key] = err
+			continue
+		}
+
+		if err := writeBackupEntry(tarWriter, path.Join(backupDisksDir, key), stage, object.Size); err != nil {
+			return nil, err
+		}
+
+		result.Manifest.Objects = append(result.Manifest.Objects, object)
+		result.Size += object.Size
+	}
+
+	manifest, err := json.MarshalIndent(result.Manifest, "", "  ")
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tarWriter.WriteHeader(backupEntryHeader(backupManifestEntry, len(manifest))); err != nil {
+		return nil, err
+	}
+
+	if _, err := tarWriter.Write(manifest); err != nil {
+		return nil, err
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return nil, err
+	}
+
+	return result, gzipWriter.Close()
+}
+
+// RestoreBackup verifies the checksums of the archive entries and the rows of the dump against the manifest,
+// then restores the database with repositories.Backup and puts the objects to their disks.
+// The database must be empty and its schema must be at least at the schema version of the backup,
+// the disks of the objects must be configured. The objects that can't be put are reported.
+func RestoreBackup(archivePath string, ctxs ...context.Context) (*RestoreResult, error) {
+	ctxs = append(ctxs, context.Background())
+	ctx := ctxs[0]
+	manifest, err := VerifyBackup(archivePath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	objects := map[string]*BackupObject{}
+
+	for _, object := range manifest.Objects {
+		if fs.Disk(object.Disk) == nil {
+			return nil, fmt.Errorf("the disk %s of the backup isn't configured", object.Disk)
+		}
+
+		objects[path.Join(backupDisksDir, object.Disk, object.Path)] = object
+	}
+
+	schemaVersion, err := repositories.Backup.SchemaVersion(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if schemaVersion < manifest.SchemaVersion {
+		return nil, fmt.Errorf(
+			"the backup needs the database schema version %d, the database is at version %d, apply the migrations first",
+			manifest.SchemaVersion,
+			schemaVersion,
+		)
+	}
+
+	result := &RestoreResult{Manifest: manifest, Failed: map[string]error{}}
+	err = readBackupEntries(archivePath, func(header *tar.Header, reader io.Reader) error {
+		if header.Name == backupDatabaseEntry {
+			tables, err := repositories.Backup.Restore(ctx, reader)
+			result.Tables = tables
+			return err
+		}
+
+		object, ok := objects[header.Name]
+
+		if !ok {
+			return nil
+		}
+
+		if _, err := fs.Disk(object.Disk).Put(ctx, reader, int64(object.Size), object.Type, object.Path); err != nil {
+			result.Failed[path.Join(object.Disk, object.Path)] = err
+			return nil
+		}
+
+		result.Restored = append(result.Restored, object)
+		result.Size += object.Size
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// VerifyBackup reads the whole archive and checks its entries against the manifest, the manifest is returned
+func VerifyBackup(archivePath string) (*BackupManifest, error) {
+	var manifest *BackupManifest
+	entries := map[string]*BackupObject{}
+	tables := map[string]int{}
+
+	err := readBackupEntries(archivePath, func(header *tar.Header, reader io.Reader) error {
+		// the database is restored first so the objects aren't put when the dump can't be restored
+		if len(entries) == 0 && header.Name != backupDatabaseEntry {
+			return fmt.Errorf("the first backup entry must be %s", backupDatabaseEntry)
+		}
+
+		if _, ok := entries[header.Name]; ok {
+			return fmt.Errorf("the backup entry %s is duplicated", header.Name)
+		}
+
+		hasher := sha256.New()
+		counter := &countingReader{Reader: io.TeeReader(reader, hasher)}
+
+		switch {
+		case header.Name == backupManifestEntry:
+			manifest = &BackupManifest{}
+
+			if err := json.NewDecoder(counter).Decode(manifest); err != nil {
+				return fmt.Errorf("invalid backup manifest: %w", err)
+			}
+		case header.Name == backupDatabaseEntry:
+			if err := countBackupRows(counter, tables); err != nil {
+				return fmt.Errorf("invalid database dump: %w", err)
+			}
+		case !strings.HasPrefix(header.Name, backupDisksDir+"/"):
+			return fmt.Errorf("unexpected backup entry %s", header.Name)
+		}
+
+		if _, err := io.Copy(io.Discard, counter); err != nil {
+			return err
+		}
+
+		entries[header.Name] = &BackupObject{Size: counter.count, SHA256: hex.EncodeToString(hasher.Sum(nil))}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if manifest == nil {
+		return nil, errors.New("the backup has no manifest")
+	}
+
+	if manifest.Format != BackupFormat {
+		return nil, fmt.Errorf("unsupported backup format %d", manifest.Format)
+	}
+
+	if manifest.Database == nil {
+		return nil, errors.New("the backup has no database dump")
+	}
+
+	if err := verifyBackupEntry(entries, backupDatabaseEntry, manifest.Database); err != nil {
+		return nil, err
+	}
+
+	for name, table := range manifest.Tables {
+		if tables[name] != table.Rows {
+			return nil, fmt.Errorf("the dump has %d rows of the %s table, %d are expected", tables[name], name, table.Rows)
+		}
+
+		delete(tables, name)
+	}
+
+	for name, rows := range tables {
+		return nil, fmt.Errorf("the dump has %d rows of the %s table, 0 are expected", rows, name)
+	}
+
+	for _, object := range manifest.Objects {
+		if object.Disk == "" || strings.Contains(object.Disk, "/") || !validBackupPath(object.Path) {
+			return nil, fmt.Errorf("invalid backup object path %s/%s", object.Disk, object.Path)
+		}
+
+		if err := verifyBackupEntry(entries, path.Join(backupDisksDir, object.Disk, object.Path), object); err != nil {
+			return nil, err
+		}
+	}
+
+	for name := range entries {
+		if name != backupManifestEntry {
+			return nil, fmt.Errorf("the backup entry %s isn't in the manifest", name)
+		}
+	}
+
+	return manifest, nil
+}
+
+func verifyBackupEntry(entries map[string]*BackupObject, name string, expected *BackupObject) error {
+	entry, ok := entries[name]
+
+	if !ok {
+		return fmt.Errorf("the backup entry %s is missing", name)
+	}
+
+	if entry.Size != expected.Size || entry.SHA256 != expected.SHA256 {
+		return fmt.Errorf("the backup entry %s is corrupted, its size or checksum doesn't match the manifest", name)
+	}
+
+	delete(entries, name)
+	return nil
+}
+
+func validBackupPath(objectPath string) bool {
+	return objectPath != "" &&
+		path.Clean(objectPath) == objectPath &&
+		!path.IsAbs(objectPath) &&
+		objectPath != ".." &&
+		!strings.HasPrefix(objectPath, "../")
+}
+
+// countBackupRows counts the rows of each table of a database dump
+func countBackupRows(reader io.Reader, tables map[string]int) error {
+	decoder := json.NewDecoder(reader)
+
+	for {
+		row := struct {
+			Table string `json:"table"`
+		}{}
+
+		if err := decoder.Decode(&row); errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		tables[row.Table]++
+	}
+}
+
+func readBackupEntries(archivePath string, fn func(header *tar.Header, reader io.Reader) error) error {
+	archive, err := os.Open(archivePath)
+
+	if err != nil {
+		return err
+	}
+
+	defer archive.Close()
+	gzipReader, err := gzip.NewReader(archive)
+
+	if err != nil {
+		return fmt.Errorf("invalid backup archive: %w", err)
+	}
+
+	tarReader := tar.NewReader(gzipReader)
+
+	for {
+		header, err := tarReader.Next()
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("invalid backup archive: %w", err)
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			return fmt.Errorf("unexpected backup entry %s", header.Name)
+		}
+
+		if err := fn(header, tarReader); err != nil {
+			return err
+		}
+	}
+}
+
+// stageBackupObject writes the content to the truncated stage file and sets the size and the checksum of the object
+func stageBackupObject(stage *os.File, object *BackupObject, write func(w io.Writer) error) error {
+	if err := stage.Truncate(0); err != nil {
+		return err
+	}
+
+	if _, err := stage.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	hasher := sha256.New()
+	counter := &countingWriter{Writer: io.MultiWriter(stage, hasher)}
+
+	if err := write(counter); err != nil {
+		return err
+	}
+
+	object.Size = counter.count
+	object.SHA256 = hex.EncodeToString(hasher.Sum(nil))
+	_, err := stage.Seek(0, io.SeekStart)
+	return err
+}
+
+func writeBackupEntry(tarWriter *tar.Writer, name string, reader io.Reader, size int) error {
+	if err := tarWriter.WriteHeader(backupEntryHeader(name, size)); err != nil {
+		return err
+	}
+
+	_, err := io.CopyN(tarWriter, reader, int64(size))
+	return err
+}
+
+func backupEntryHeader(name string, size int) *tar.Header {
+	return &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     int64(size),
+		Mode:     0644,
+		ModTime:  time.Now(),
+	}
+}
+
+// fileObjects returns the objects of the files and their variants
+func fileObjects(files []*entities.File) []*BackupObject {
+	objects := []*BackupObject{}
+
+	for _, file := range files {
+		objects = append(objects, &BackupObject{Disk: file.Disk, Path: file.Path, Type: file.Type})
+
+		for _, variant := range file.Variants {
+			objects = append(objects, &BackupObject{Disk: file.Disk, Path: variant.Path, Type: variant.Type})
+		}
+	}
+
+	return objects
+}
+
+func findAllFiles(ctx context.Context) ([]*entities.File, error) {
+	files := []*entities.File{}
+
+	for page := 1; ; page++ {
+		pageFiles, err := repositories.File.Find(ctx, &entities.FileFilter{
+			Filter: &entities.Filter{Page: page, Limit: filesBatchSize},
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, pageFiles...)
+
+		if len(pageFiles) < filesBatchSize {
+			return files, nil
+		}
+	}
+}
+
+type countingWriter struct {
+	io.Writer
+	count int
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.Writer.Write(p)
+	w.count += n
+	return n, err
+}
@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/fs"
+	"github.com/ngocphuongnb/tetua/app/mock"
+	mockrepository "github.com/ngocphuongnb/tetua/app/mock/repository"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+type backupDisk struct {
+	*mock.Disk
+}
+
+func (d *backupDisk) Name() string {
+	return "backup_mock"
+}
+
+func backupTestObject(disk, objectPath, content string) *BackupObject {
+	hash := sha256.Sum256([]byte(content))
+	return &BackupObject{Disk: disk, Path: objectPath, Size: len(content), SHA256: hex.EncodeToString(hash[:])}
+}
+
+// writeBackupArchive writes the entries in order, the manifest is added when it's not nil
+func writeBackupArchive(t *testing.T, manifest *BackupManifest, entries ...[2]string) string {
+	archivePath := path.Join(t.TempDir(), "backup.tar.gz")
+	archive, err := os.Create(archivePath)
+	assert.NoError(t, err)
+	defer archive.Close()
+	gzipWriter := gzip.NewWriter(archive)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	if manifest != nil {
+		data, _ := json.Marshal(manifest)
+		entries = append(entries, [2]string{backupManifestEntry, string(data)})
+	}
+
+	for _, entry := range entries {
+		assert.NoError(t, tarWriter.WriteHeader(backupEntryHeader(entry[0], len(entry[1]))))
+		_, err := tarWriter.Write([]byte(entry[1]))
+		assert.NoError(t, err)
+	}
+
+	assert.NoError(t, tarWriter.Close())
+	assert.NoError(t, gzipWriter.Close())
+	return archivePath
+}
+
+func TestBackupRestore(t *testing.T) {
+	ctx := context.Background()
+	mock.CreateRepositories()
+	fs.New("backup_mock", []fs.FSDisk{&backupDisk{&mock.Disk{}}})
+	disk := fs.Disk("backup_mock").(*backupDisk)
+	disk.Files = map[string][]byte{"a.jpg": []byte("image a"), "a-thumb.jpg": []byte("thumb"), "doc.pdf": []byte("%PDF")}
+	repositories.File.Create(ctx, &entities.File{Disk: "backup_mock", Path: "a.jpg", Type: "image/jpeg", Variants: []*fs.ImageVariant{
+		{Name: "thumb", Path: "a-thumb.jpg", Type: "image/jpeg"},
+	}})
+	repositories.File.Create(ctx, &entities.File{Disk: "backup_mock", Path: "a.jpg", Type: "image/jpeg"})
+	repositories.File.Create(ctx, &entities.File{Disk: "backup_mock", Path: "missing.png", Type: "image/png"})
+	repositories.File.Create(ctx, &entities.File{Disk: "disk_removed", Path: "b.png", Type: "image/png"})
+	repositories.Backup = &mockrepository.BackupRepository{Version: 2, Rows: []string{
+		`{"table":"users","id":1}`,
+		`{"table":"posts","id":1}`,
+		`{"table":"posts","id":3}`,
+	}}
+	archivePath := path.Join(t.TempDir(), "backup.tar.gz")
+
+	result, err := CreateBackup(archivePath)
+	assert.NoError(t, err)
+	assert.Equal(t, BackupFormat, result.Manifest.Format)
+	assert.Equal(t, "mock", result.Manifest.Driver)
+	assert.Equal(t, int64(2), result.Manifest.SchemaVersion)
+	assert.Equal(t, &entities.BackupTable{Rows: 2}, result.Manifest.Tables["posts"])
+	assert.Equal(t, []*BackupObject{
+		{Disk: "backup_mock", Path: "a.jpg", Type: "image/jpeg", Size: 7, SHA256: backupTestObject("", "", "image a").SHA256},
+		{Disk: "backup_mock", Path: "a-thumb.jpg", Type: "image/jpeg", Size: 5, SHA256: backupTestObject("", "", "thumb").SHA256},
+	}, result.Manifest.Objects)
+	assert.Equal(t, map[string]error{
+		"backup_mock/missing.png": errors.New("File not found"),
+		"disk_removed/b.png":      errors.New("disk not found"),
+	}, result.Failed)
+	assert.Equal(t, 12, result.Size)
+
+	manifest, err := VerifyBackup(archivePath)
+	assert.NoError(t, err)
+	assert.Equal(t, result.Manifest.Objects, manifest.Objects)
+	assert.Equal(t, result.Manifest.Database, manifest.Database)
+
+	disk.Files = map[string][]byte{}
+	repositories.Backup = &mockrepository.BackupRepository{Version: 1}
+	_, err = RestoreBackup(archivePath)
+	assert.EqualError(t, err, "the backup needs the database schema version 2, the database is at version 1, apply the migrations first")
+
+	target := &mockrepository.BackupRepository{Version: 3}
+	repositories.Backup = target
+	restored, err := RestoreBackup(archivePath)
+	assert.NoError(t, err)
+	assert.Equal(t, &entities.BackupTable{Rows: 2}, restored.Tables["posts"])
+	assert.Equal(t, manifest.Objects, restored.Restored)
+	assert.Equal(t, 12, restored.Size)
+	assert.Equal(t, 3, len(target.Restored))
+	assert.Equal(t, map[string][]byte{"a.jpg": []byte("image a"), "a-thumb.jpg": []byte("thumb")}, disk.Files)
+
+	_, err = RestoreBackup(archivePath)
+	assert.EqualError(t, err, "the database isn't empty")
+}
+
+func TestVerifyBackup(t *testing.T) {
+	fs.New("backup_mock", []fs.FSDisk{&backupDisk{&mock.Disk{}}})
+	repositories.Backup = &mockrepository.BackupRepository{}
+	dump := `{"table":"posts","id":1}` + "\n"
+	object := backupTestObject("backup_mock", "a.jpg", "image a")
+	newManifest := func(objects ...*BackupObject) *BackupManifest {
+		return &BackupManifest{
+			Format:   BackupFormat,
+			Database: backupTestObject("", backupDatabaseEntry, dump),
+			Tables:   map[string]*entities.BackupTable{"posts": {Rows: 1}},
+			Objects:  objects,
+		}
+	}
+	database := [2]string{backupDatabaseEntry, dump}
+
+	_, err := VerifyBackup(path.Join(t.TempDir(), "not-found.tar.gz"))
+	assert.True(t, errors.Is(err, os.ErrNotExist))
+
+	invalidPath := path.Join(t.TempDir(), "invalid.tar.gz")
+	assert.NoError(t, os.WriteFile(invalidPath, []byte("invalid"), 0644))
+	_, err = VerifyBackup(invalidPath)
+	assert.EqualError(t, err, "invalid backup archive: unexpected EOF")
+
+	_, err = VerifyBackup(writeBackupArchive(t, nil, database))
+	assert.EqualError(t, err, "the backup has no manifest")
+
+	_, err = VerifyBackup(writeBackupArchive(t, newManifest(object), [2]string{"disks/backup_mock/a.jpg", "image a"}, database))
+	assert.EqualError(t, err, "the first backup entry must be database.ndjson")
+
+	_, err = VerifyBackup(writeBackupArchive(t, newManifest(), database, [2]string{"notes.txt", "notes"}))
+	assert.EqualError(t, err, "unexpected backup entry notes.txt")
+
+	unsupported := newManifest()
+	unsupported.Format = 2
+	_, err = VerifyBackup(writeBackupArchive(t, unsupported, database))
+	assert.EqualError(t, err, "unsupported backup format 2")
+
+	_, err = VerifyBackup(writeBackupArchive(t, newManifest(), [2]string{backupDatabaseEntry, `{"table":"posts","id":2}` + "\n"}))
+	assert.EqualError(t, err, "the backup entry database.ndjson is corrupted, its size or checksum doesn't match the manifest")
+
+	truncated := newManifest()
+	truncated.Tables["posts"].Rows = 2
+	_, err = VerifyBackup(writeBackupArchive(t, truncated, database))
+	assert.EqualError(t, err, "the dump has 1 rows of the posts table, 2 are expected")
+
+	_, err = VerifyBackup(writeBackupArchive(t, newManifest(object), database))
+	assert.EqualError(t, err, "the backup entry disks/backup_mock/a.jpg is missing")
+
+	_, err = VerifyBackup(writeBackupArchive(t, newManifest(object), database, [2]string{"disks/backup_mock/a.jpg", "image b"}))
+	assert.EqualError(t, err, "the backup entry disks/backup_mock/a.jpg is corrupted, its size or checksum doesn't match the manifest")
+
+	_, err = VerifyBackup(writeBackupArchive(t, newManifest(), database, [2]string{"disks/backup_mock/a.jpg", "image a"}))
+	assert.EqualError(t, err, "the backup entry disks/backup_mock/a.jpg isn't in the manifest")
+
+	escaped := backupTestObject("backup_mock", "../a.jpg", "image a")
+	_, err = VerifyBackup(writeBackupArchive(t, newManifest(escaped), database, [2]string{"disks/a.jpg", "image a"}))
+	assert.EqualError(t, err, "invalid backup object path backup_mock/../a.jpg")
+
+	removed := backupTestObject("disk_removed", "a.jpg", "image a")
+	archivePath := writeBackupArchive(t, newManifest(removed), database, [2]string{"disks/disk_removed/a.jpg", "image a"})
+	_, err = VerifyBackup(archivePath)
+	assert.NoError(t, err)
+	_, err = RestoreBackup(archivePath)
+	assert.EqualError(t, err, "the disk disk_removed of the backup isn't configured")
+}
@@ -0,0 +1,729 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ngocphuongnb/tetua/app/config"
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/fs"
+	"github.com/ngocphuongnb/tetua/app/imaging"
+	"github.com/ngocphuongnb/tetua/app/logger"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+	"github.com/ngocphuongnb/tetua/app/services"
+)
+
+const filesBatchSize = 100
+
+// defaultFilesGCGraceDays is used when the file_gc_grace_days setting is empty or invalid
+const defaultFilesGCGraceDays = 7
+
+// defaultTusUploadTTLHours is used when the tus_upload_ttl_hours setting is empty or invalid
+const defaultTusUploadTTLHours = 24
+
+type FilesGCResult struct {
+	Orphaned []*entities.File
+	Deleted  []*entities.File
+	Failed   map[int]error
+	Size     int
+}
+
+type FilesMigrateResult struct {
+	Migrated []*entities.File
+	Failed   map[int]error
+	Size     int
+	Posts    int
+	Pages    int
+	Users    int
+	Menus    int
+	Settings int
+}
+
+type StripMetadataResult struct {
+	Stripped []*entities.File
+	Failed   map[int]error
+	Removed  int
+}
+
+// StripFilesMetadata re-processes the stored JPEG and PNG files of a disk with imaging.StripMetadata,
+// the files that had metadata are overwritten in place and their size is updated.
+// The variants are generated without metadata so only the originals are processed.
+func StripFilesMetadata(diskName string, dryRun bool, ctxs ...context.Context) (*StripMetadataResult, error) {
+	ctxs = append(ctxs, context.Background())
+	result := &StripMetadataResult{Failed: map[int]error{}}
+	disk := fs.Disk(diskName)
+
+	if disk == nil {
+		return nil, errors.New("disk not found")
+	}
+
+	// the files that share an object are processed once, see updateSharedFiles
+	processed := map[string]bool{}
+
+	for page := 1; ; page++ {
+		files, err := repositories.File.Find(ctxs[0], &entities.FileFilter{
+			Filter: &entities.Filter{Page: page, Limit: filesBatchSize},
+		})
+
+		if err != nil {
+			return result, err
+		}
+
+		for _, file := range files {
+			if file.Disk != diskName || !imaging.CanStripMetadata(file.Type) || processed[file.Path] {
+				continue
+			}
+
+			processed[file.Path] = true
+
+			stripped, removed, err := stripFileMetadata(ctxs[0], disk, file, dryRun)
+
+			if err != nil {
+				result.Failed[file.ID] = err
+				continue
+			}
+
+			if stripped {
+				result.Stripped = append(result.Stripped, file)
+				result.Removed += removed
+			}
+		}
+
+		if len(files) < filesBatchSize {
+			return result, nil
+		}
+	}
+}
+
+// MigrateFiles moves the files of a disk to another disk. The objects and their variants are
+// streamed to the same paths on the target disk and their sizes are verified before the file is
+// updated to the target disk, the objects on the source disk are kept.
+// The urls of the migrated files are then replaced in the content of the posts and pages, in the
+// custom field values, the custom menu items and the setting values. The migration is resumable:
+// files that are already on the target disk are skipped and the urls of all the files on the target
+// disk are rewritten on every run.
+// Size is the total size of the migrated files.
+func MigrateFiles(from, to string, dryRun bool, ctxs ...context.Context) (*FilesMigrateResult, error) {
+	ctxs = append(ctxs, context.Background())
+	result := &FilesMigrateResult{Failed: map[int]error{}}
+	fromDisk := fs.Disk(from)
+	toDisk := fs.Disk(to)
+
+	if fromDisk == nil || toDisk == nil {
+		return nil, errors.New("disk not found")
+	}
+
+	if from == to {
+		return nil, errors.New("the source and target disks are the same")
+	}
+
+	// the files are collected first since the migrated files leave the filter and shift the pages
+	files, err := findDiskFiles(ctxs[0], from)
+
+	if err != nil {
+		return result, err
+	}
+
+	// deduplicated files share their objects, which are copied once
+	copied := map[string]error{}
+
+	for _, file := range files {
+		if dryRun {
+			result.Migrated = append(result.Migrated, file)
+			result.Size += fileTotalSize(file)
+			continue
+		}
+
+		err, ok := copied[file.Path]
+
+		if !ok {
+			err = copyFileObjects(ctxs[0], fromDisk, toDisk, file)
+			copied[file.Path] = err
+		}
+
+		if err != nil {
+			result.Failed[file.ID] = err
+			continue
+		}
+
+		file.Disk = to
+
+		if _, err := repositories.File.Update(ctxs[0], file); err != nil {
+			file.Disk = from
+			result.Failed[file.ID] = err
+			continue
+		}
+
+		result.Migrated = append(result.Migrated, file)
+		result.Size += fileTotalSize(file)
+	}
+
+	if dryRun {
+		return result, nil
+	}
+
+	return result, rewriteFileUrls(ctxs[0], fromDisk, toDisk, result)
+}
+
+// FilesGCGracePeriod returns the file_gc_grace_days setting as a duration
+func FilesGCGracePeriod() time.Duration {
+	days, err := strconv.Atoi(config.Setting("file_gc_grace_days", strconv.Itoa(defaultFilesGCGraceDays)))
+
+	if err != nil || days < 0 {
+		days = defaultFilesGCGraceDays
+	}
+
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// TusUploadTTL returns the tus_upload_ttl_hours setting as a duration
+func TusUploadTTL() time.Duration {
+	hours, err := strconv.Atoi(config.Setting("tus_upload_ttl_hours", strconv.Itoa(defaultTusUploadTTLHours)))
+
+	if err != nil || hours <= 0 {
+		hours = defaultTusUploadTTLHours
+	}
+
+	return time.Duration(hours) * time.Hour
+}
+
+// GCFiles finds the files that aren't used as a featured image of a post or a page, an avatar,
+// a setting value, a url custom field value, a menu item url or in the content of a post or a page,
+// see repositories.FileRepository.Usages.
+// Files that were uploaded within the grace period are kept since they can belong to a post that
+// is still being composed. Unless it's a dry run, the orphaned files are deleted with services.DeleteFile.
+// Size is the total size of the orphaned files.
+func GCFiles(gracePeriod time.Duration, dryRun bool, ctxs ...context.Context) (*FilesGCResult, error) {
+	ctxs = append(ctxs, context.Background())
+	result := &FilesGCResult{Failed: map[int]error{}}
+	before := time.Now().Add(-gracePeriod)
+
+	// the files are deleted after the scan so the pages aren't shifted
+	for page := 1; ; page++ {
+		files, err := repositories.File.Find(ctxs[0], &entities.FileFilter{
+			Filter: &entities.Filter{Page: page, Limit: filesBatchSize},
+		})
+
+		if err != nil {
+			return result, err
+		}
+
+		for _, file := range files {
+			if file.CreatedAt == nil || file.CreatedAt.After(before) || fileUsedInSettings(file) {
+				continue
+			}
+
+			usages, err := repositories.File.Usages(ctxs[0], file)
+
+			if err != nil {
+				result.Failed[file.ID] = err
+				continue
+			}
+
+			if len(usages) == 0 {
+				result.Orphaned = append(result.Orphaned, file)
+				result.Size += fileTotalSize(file)
+			}
+		}
+
+		if len(files) < filesBatchSize {
+			break
+		}
+	}
+
+	if dryRun {
+		return result, nil
+	}
+
+	for _, file := range result.Orphaned {
+		if err := services.DeleteFile(ctxs[0], file); err != nil {
+			result.Failed[file.ID] = err
+			continue
+		}
+
+		result.Deleted = append(result.Deleted, file)
+	}
+
+	return result, nil
+}
+
+// ScheduleFilesGC collects the orphaned files now and then at every interval
+// when the file_gc_enabled setting is on, the expired resumable uploads are always removed
+func ScheduleFilesGC(interval time.Duration) {
+	gc := func() {
+		if expired, err := services.ExpireTusUploads(context.Background(), TusUploadTTL()); err != nil {
+			logger.Error("Error removing expired uploads", err)
+		} else if expired > 0 {
+			logger.Info("Removed expired uploads", logger.Context{"count": expired})
+		}
+
+		if config.Setting("file_gc_enabled") != "yes" {
+			return
+		}
+
+		result, err := GCFiles(FilesGCGracePeriod(), false)
+
+		if err != nil {
+			logger.Error("Error collecting orphaned files", err)
+			return
+		}
+
+		for id, err := range result.Failed {
+			logger.Error("Error deleting orphaned file", logger.Context{"id": id, "error": err.Error()})
+		}
+
+		if len(result.Deleted) > 0 {
+			logger.Info("Deleted orphaned files", logger.Context{"count": len(result.Deleted), "size": result.Size})
+		}
+	}
+
+	gc()
+	go func() {
+		for range time.Tick(interval) {
+			gc()
+		}
+	}()
+}
+
+// fileUsedInSettings reports whether a setting value, e.g. the app logo url, contains the file or one of its variants
+func fileUsedInSettings(file *entities.File) bool {
+	paths := []string{file.Path}
+
+	for _, variant := range file.Variants {
+		paths = append(paths, variant.Path)
+	}
+
+	for _, setting := range config.AllSettings() {
+		for _, filePath := range paths {
+			if filePath != "" && strings.Contains(setting.Value, filePath) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func fileTotalSize(file *entities.File) int {
+	size := file.Size
+
+	for _, variant := range file.Variants {
+		size += variant.Size
+	}
+
+	return size
+}
+
+// stripFileMetadata reports whether the file had metadata and the number of removed bytes,
+// the number can be negative when an image is re-encoded to apply its orientation
+func stripFileMetadata(ctx context.Context, disk fs.FSDisk, file *entities.File, dryRun bool) (bool, int, error) {
+	reader, err := disk.Open(ctx, file.Path)
+
+	if err != nil {
+		return false, 0, err
+	}
+
+	data, err := io.ReadAll(reader)
+	reader.Close()
+
+	if err != nil {
+		return false, 0, err
+	}
+
+	stripped, err := imaging.StripMetadata(data, file.Type)
+
+	if err != nil {
+		return false, 0, err
+	}
+
+	if bytes.Equal(data, stripped) {
+		return false, 0, nil
+	}
+
+	if !dryRun {
+		info, err := disk.Put(ctx, bytes.NewReader(stripped), int64(len(stripped)), file.Type, file.Path)
+
+		if err != nil {
+			return false, 0, err
+		}
+
+		file.Size = info.Size
+		file.Hash = info.Hash
+
+		if _, err := repositories.File.Update(ctx, file); err != nil {
+			return false, 0, err
+		}
+
+		if err := updateSharedFiles(ctx, file); err != nil {
+			return false, 0, err
+		}
+
+		if err := services.PurgeImageTransforms(ctx, file.Disk, file.Path); err != nil {
+			return false, 0, err
+		}
+	}
+
+	return true, len(data) - len(stripped), nil
+}
+
+// updateSharedFiles copies the size and hash of a rewritten object to the other files
+// that point to the same object, deduplicated uploads share it
+func updateSharedFiles(ctx context.Context, file *entities.File) error {
+	for page := 1; ; page++ {
+		files, err := repositories.File.Find(ctx, &entities.FileFilter{
+			Filter: &entities.Filter{Page: page, Limit: filesBatchSize},
+			Disks:  []string{file.Disk},
+			Paths:  []string{file.Path},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, shared := range files {
+			if shared.ID == file.ID || (shared.Size == file.Size && shared.Hash == file.Hash) {
+				continue
+			}
+
+			shared.Size = file.Size
+			shared.Hash = file.Hash
+
+			if _, err := repositories.File.Update(ctx, shared); err != nil {
+				return err
+			}
+		}
+
+		if len(files) < filesBatchSize {
+			return nil
+		}
+	}
+}
+
+type countingReader struct {
+	io.Reader
+	count int
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.count += n
+	return n, err
+}
+
+func findDiskFiles(ctx context.Context, diskName string) ([]*entities.File, error) {
+	files := []*entities.File{}
+
+	for page := 1; ; page++ {
+		pageFiles, err := repositories.File.Find(ctx, &entities.FileFilter{
+			Filter: &entities.Filter{Page: page, Limit: filesBatchSize},
+			Disks:  []string{diskName},
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, pageFiles...)
+
+		if len(pageFiles) < filesBatchSize {
+			return files, nil
+		}
+	}
+}
+
+// copyFileObjects copies the object of a file and its variants to the same paths on the target disk
+func copyFileObjects(ctx context.Context, from, to fs.FSDisk, file *entities.File) error {
+	if err := copyObject(ctx, from, to, file.Path, file.Type, file.Size); err != nil {
+		return err
+	}
+
+	for _, variant := range file.Variants {
+		if err := copyObject(ctx, from, to, variant.Path, variant.Type, variant.Size); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copyObject streams an object between the disks and verifies that the source, the target
+// and the recorded sizes match
+func copyObject(ctx context.Context, from, to fs.FSDisk, filePath, mime string, size int) error {
+	reader, err := from.Open(ctx, filePath)
+
+	if err != nil {
+		return err
+	}
+
+	defer reader.Close()
+	counter := &countingReader{Reader: reader}
+	info, err := to.Put(ctx, counter, int64(size), mime, filePath)
+
+	if err != nil {
+		return err
+	}
+
+	if counter.count != size || info.Size != size {
+		return fmt.Errorf("size mismatch of %s: %d bytes recorded, %d read, %d stored", filePath, size, counter.count, info.Size)
+	}
+
+	return nil
+}
+
+// rewriteFileUrls replaces the source disk urls of the files on the target disk in the
+// content of the posts and pages and in the setting values
+func rewriteFileUrls(ctx context.Context, from, to fs.FSDisk, result *FilesMigrateResult) error {
+	files, err := findDiskFiles(ctx, to.Name())
+
+	if err != nil {
+		return err
+	}
+
+	urls := map[string]string{}
+
+	for _, file := range files {
+		urls[from.Url(file.Path)] = to.Url(file.Path)
+
+		for _, variant := range file.Variants {
+			urls[from.Url(variant.Path)] = to.Url(variant.Path)
+		}
+	}
+
+	if len(urls) == 0 {
+		return nil
+	}
+
+	// longer urls are replaced first so a url isn't replaced inside a longer one
+	oldUrls := make([]string, 0, len(urls))
+	for oldUrl := range urls {
+		oldUrls = append(oldUrls, oldUrl)
+	}
+
+	sort.Slice(oldUrls, func(i, j int) bool {
+		return len(oldUrls[i]) > len(oldUrls[j])
+	})
+
+	pairs := []string{}
+	for _, oldUrl := range oldUrls {
+		pairs = append(pairs, oldUrl, urls[oldUrl])
+	}
+
+	replacer := strings.NewReplacer(pairs...)
+
+	if result.Posts, err = rewritePostUrls(ctx, replacer); err != nil {
+		return err
+	}
+
+	if result.Pages, err = rewritePageUrls(ctx, replacer); err != nil {
+		return err
+	}
+
+	if result.Users, err = rewriteUserUrls(ctx, replacer); err != nil {
+		return err
+	}
+
+	if result.Menus, err = rewriteMenuUrls(ctx, replacer); err != nil {
+		return err
+	}
+
+	result.Settings, err = rewriteSettingUrls(ctx, replacer)
+
+	return err
+}
+
+func rewritePostUrls(ctx context.Context, replacer *strings.Replacer) (int, error) {
+	count := 0
+
+	for page := 1; ; page++ {
+		posts, err := repositories.Post.Find(ctx, &entities.PostFilter{
+			Filter:  &entities.Filter{Page: page, Limit: filesBatchSize},
+			Publish: "all",
+			Approve: "all",
+		})
+
+		if err != nil {
+			return count, err
+		}
+
+		for _, post := range posts {
+			content, contentHtml := replacer.Replace(post.Content), replacer.Replace(post.ContentHTML)
+			customFields, customFieldsChanged := rewriteCustomFieldUrls(replacer, post.CustomFields)
+
+			if content == post.Content && contentHtml == post.ContentHTML && !customFieldsChanged {
+				continue
+			}
+
+			post.Content, post.ContentHTML, post.CustomFields = content, contentHtml, customFields
+
+			if _, err := repositories.Post.Update(ctx, post); err != nil {
+				return count, err
+			}
+
+			count++
+		}
+
+		if len(posts) < filesBatchSize {
+			return count, nil
+		}
+	}
+}
+
+func rewritePageUrls(ctx context.Context, replacer *strings.Replacer) (int, error) {
+	count := 0
+
+	for p := 1; ; p++ {
+		pages, err := repositories.Page.Find(ctx, &entities.PageFilter{
+			Filter:  &entities.Filter{Page: p, Limit: filesBatchSize},
+			Publish: "all",
+		})
+
+		if err != nil {
+			return count, err
+		}
+
+		for _, page := range pages {
+			content, contentHtml := replacer.Replace(page.Content), replacer.Replace(page.ContentHTML)
+			customFields, customFieldsChanged := rewriteCustomFieldUrls(replacer, page.CustomFields)
+
+			if content == page.Content && contentHtml == page.ContentHTML && !customFieldsChanged {
+				continue
+			}
+
+			page.Content, page.ContentHTML, page.CustomFields = content, contentHtml, customFields
+
+			if _, err := repositories.Page.Update(ctx, page); err != nil {
+				return count, err
+			}
+
+			count++
+		}
+
+		if len(pages) < filesBatchSize {
+			return count, nil
+		}
+	}
+}
+
+func rewriteUserUrls(ctx context.Context, replacer *strings.Replacer) (int, error) {
+	count := 0
+
+	for page := 1; ; page++ {
+		users, err := repositories.User.Find(ctx, &entities.UserFilter{
+			Filter: &entities.Filter{Page: page, Limit: filesBatchSize},
+		})
+
+		if err != nil {
+			return count, err
+		}
+
+		for _, user := range users {
+			customFields, changed := rewriteCustomFieldUrls(replacer, user.CustomFields)
+
+			if !changed {
+				continue
+			}
+
+			user.CustomFields = customFields
+
+			if _, err := repositories.User.Update(ctx, user); err != nil {
+				return count, err
+			}
+
+			count++
+		}
+
+		if len(users) < filesBatchSize {
+			return count, nil
+		}
+	}
+}
+
+// rewriteCustomFieldUrls returns a copy of the custom field values with the urls replaced
+// and whether a value was changed
+func rewriteCustomFieldUrls(replacer *strings.Replacer, values map[string]string) (map[string]string, bool) {
+	if values == nil {
+		return nil, false
+	}
+
+	changed := false
+	rewritten := map[string]string{}
+
+	for name, value := range values {
+		rewritten[name] = replacer.Replace(value)
+		changed = changed || rewritten[name] != value
+	}
+
+	return rewritten, changed
+}
+
+// rewriteMenuUrls replaces the urls of the custom menu items, the items of a changed menu are saved again
+func rewriteMenuUrls(ctx context.Context, replacer *strings.Replacer) (int, error) {
+	menus, err := repositories.Menu.All(ctx)
+
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+
+	for _, menu := range menus {
+		if !rewriteMenuItemUrls(replacer, menu.Items) {
+			continue
+		}
+
+		if err := repositories.Menu.SetItems(ctx, menu.ID, menu.Items); err != nil {
+			return count, err
+		}
+
+		count++
+	}
+
+	return count, nil
+}
+
+func rewriteMenuItemUrls(replacer *strings.Replacer, items []*entities.MenuItem) bool {
+	changed := false
+
+	for _, item := range items {
+		if item.Type == entities.MENU_ITEM_CUSTOM {
+			link := replacer.Replace(item.Url)
+			changed = changed || link != item.Url
+			item.Url = link
+		}
+
+		changed = rewriteMenuItemUrls(replacer, item.Children) || changed
+	}
+
+	return changed
+}
+
+func rewriteSettingUrls(ctx context.Context, replacer *strings.Replacer) (int, error) {
+	settings := []*entities.Setting{}
+	items := []*config.SettingItem{}
+
+	for _, setting := range config.AllSettings() {
+		if value := replacer.Replace(setting.Value); value != setting.Value {
+			settings = append(settings, &entities.Setting{Name: setting.Name, Value: value, Type: setting.Type})
+			items = append(items, &config.SettingItem{Name: setting.Name, Value: value, Type: setting.Type})
+		}
+	}
+
+	if len(settings) == 0 {
+		return 0, nil
+	}
+
+	if err := repositories.Setting.Save(ctx, settings); err != nil {
+		return 0, err
+	}
+
+	config.Settings(items)
+
+	return len(settings), nil
+}
@@ -0,0 +1,255 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/jpeg"
+	"testing"
+	"time"
+
+	"github.com/ngocphuongnb/tetua/app/config"
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/fs"
+	"github.com/ngocphuongnb/tetua/app/mock"
+	mockrepository "github.com/ngocphuongnb/tetua/app/mock/repository"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStripFilesMetadata(t *testing.T) {
+	mock.CreateRepositories()
+	ctx := context.Background()
+	buf := &bytes.Buffer{}
+	assert.NoError(t, jpeg.Encode(buf, image.NewRGBA(image.Rect(0, 0, 10, 10)), nil))
+	clean := buf.Bytes()
+	withComment := append([]byte{0xFF, 0xD8, 0xFF, 0xFE, 0, 8, 'G', 'P', 'S', ' ', '4', '2'}, clean[2:]...)
+	disk := &mock.Disk{Files: map[string][]byte{
+		"photo.jpg": withComment,
+		"clean.jpg": clean,
+		"doc.pdf":   []byte("%PDF-1.4"),
+	}}
+	fs.New("disk_mock", []fs.FSDisk{disk})
+
+	photo, _ := repositories.File.Create(ctx, &entities.File{Disk: "disk_mock", Path: "photo.jpg", Type: "image/jpeg", Size: len(withComment), UserID: 1})
+	shared, _ := repositories.File.Create(ctx, &entities.File{Disk: "disk_mock", Path: "photo.jpg", Type: "image/jpeg", Size: len(withComment), UserID: 2})
+	repositories.File.Create(ctx, &entities.File{Disk: "disk_mock", Path: "clean.jpg", Type: "image/jpeg", Size: len(clean), UserID: 1})
+	repositories.File.Create(ctx, &entities.File{Disk: "disk_mock", Path: "doc.pdf", Type: "application/pdf", UserID: 1})
+	missing, _ := repositories.File.Create(ctx, &entities.File{Disk: "disk_mock", Path: "missing.png", Type: "image/png", UserID: 1})
+	repositories.File.Create(ctx, &entities.File{Disk: "other_disk", Path: "photo.jpg", Type: "image/jpeg", UserID: 1})
+
+	_, err := StripFilesMetadata("invalid_disk", false)
+	assert.Equal(t, errors.New("disk not found"), err)
+
+	result, err := StripFilesMetadata("disk_mock", true)
+	assert.NoError(t, err)
+	assert.Equal(t, []*entities.File{photo}, result.Stripped)
+	assert.Equal(t, 10, result.Removed)
+	assert.Equal(t, map[int]error{missing.ID: errors.New("File not found")}, result.Failed)
+	assert.Equal(t, withComment, disk.Files["photo.jpg"])
+
+	result, err = StripFilesMetadata("disk_mock", false)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(result.Stripped))
+	assert.Equal(t, clean, disk.Files["photo.jpg"])
+	assert.Equal(t, len(clean), photo.Size)
+	shared, _ = repositories.File.ByID(ctx, shared.ID)
+	assert.Equal(t, len(clean), shared.Size)
+	assert.Equal(t, photo.Hash, shared.Hash)
+
+	result, err = StripFilesMetadata("disk_mock", false)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(result.Stripped))
+}
+
+func TestGCFiles(t *testing.T) {
+	mock.CreateRepositories()
+	ctx := context.Background()
+	// the first registered disk_mock is used when another test has registered one
+	fs.New("disk_mock", []fs.FSDisk{&mock.Disk{}})
+	disk := fs.Disk("disk_mock").(*mock.Disk)
+	disk.Files = map[string][]byte{
+		"used.jpg":          []byte("used"),
+		"orphan.jpg":        []byte("orphan"),
+		"orphan-medium.jpg": []byte("medium"),
+		"logo.png":          []byte("logo"),
+		"recent.jpg":        []byte("recent"),
+	}
+	config.Settings([]*config.SettingItem{{Name: "app_logo", Value: "/disk_mock/logo.png", Type: "image"}})
+	defer config.Settings([]*config.SettingItem{{Name: "app_logo", Value: "", Type: "image"}})
+
+	old := time.Now().AddDate(0, 0, -10)
+	used, _ := repositories.File.Create(ctx, &entities.File{Disk: "disk_mock", Path: "used.jpg", Size: 4, UserID: 1})
+	orphan, _ := repositories.File.Create(ctx, &entities.File{Disk: "disk_mock", Path: "orphan.jpg", Size: 6, UserID: 1, Variants: []*fs.ImageVariant{
+		{Name: "medium", Path: "orphan-medium.jpg", Size: 6},
+	}})
+	logo, _ := repositories.File.Create(ctx, &entities.File{Disk: "disk_mock", Path: "logo.png", Size: 4, UserID: 1})
+	repositories.File.Create(ctx, &entities.File{Disk: "disk_mock", Path: "recent.jpg", Size: 6, UserID: 1})
+	used.CreatedAt = &old
+	orphan.CreatedAt = &old
+	logo.CreatedAt = &old
+	mockrepository.FakeFileUsages[used.ID] = []*entities.FileUsage{{Type: "post", ID: 1, Name: "Post"}}
+	defer delete(mockrepository.FakeFileUsages, used.ID)
+
+	result, err := GCFiles(7*24*time.Hour, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []*entities.File{orphan}, result.Orphaned)
+	assert.Equal(t, 12, result.Size)
+	assert.Equal(t, 0, len(result.Deleted))
+	assert.Equal(t, 5, len(disk.Files))
+
+	mockrepository.FakeRepoErrors["file_usages"] = errors.New("usages error")
+	result, err = GCFiles(7*24*time.Hour, true)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(result.Orphaned))
+	assert.Equal(t, errors.New("usages error"), result.Failed[used.ID])
+	mockrepository.FakeRepoErrors["file_usages"] = nil
+
+	result, err = GCFiles(7*24*time.Hour, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []*entities.File{orphan}, result.Deleted)
+	assert.Equal(t, 0, len(result.Failed))
+	assert.NotContains(t, disk.Files, "orphan.jpg")
+	assert.NotContains(t, disk.Files, "orphan-medium.jpg")
+	_, err = repositories.File.ByID(ctx, orphan.ID)
+	assert.Error(t, err)
+
+	result, err = GCFiles(0, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(result.Deleted))
+	assert.Equal(t, "recent.jpg", result.Deleted[0].Path)
+}
+
+func TestFilesGCGracePeriod(t *testing.T) {
+	assert.Equal(t, 7*24*time.Hour, FilesGCGracePeriod())
+	config.Settings([]*config.SettingItem{{Name: "file_gc_grace_days", Value: "2", Type: "input"}})
+	assert.Equal(t, 2*24*time.Hour, FilesGCGracePeriod())
+	config.Settings([]*config.SettingItem{{Name: "file_gc_grace_days", Value: "invalid", Type: "input"}})
+	assert.Equal(t, 7*24*time.Hour, FilesGCGracePeriod())
+	config.Settings([]*config.SettingItem{{Name: "file_gc_grace_days", Value: "7", Type: "input"}})
+}
+
+func TestTusUploadTTL(t *testing.T) {
+	assert.Equal(t, 24*time.Hour, TusUploadTTL())
+	config.Settings([]*config.SettingItem{{Name: "tus_upload_ttl_hours", Value: "6", Type: "input"}})
+	assert.Equal(t, 6*time.Hour, TusUploadTTL())
+	config.Settings([]*config.SettingItem{{Name: "tus_upload_ttl_hours", Value: "0", Type: "input"}})
+	assert.Equal(t, 24*time.Hour, TusUploadTTL())
+	config.Settings([]*config.SettingItem{{Name: "tus_upload_ttl_hours", Value: "24", Type: "input"}})
+}
+
+type targetDisk struct {
+	*mock.Disk
+}
+
+func (d *targetDisk) Name() string {
+	return "disk_target"
+}
+
+func (d *targetDisk) Url(path string) string {
+	return "/disk_target/" + path
+}
+
+func TestMigrateFiles(t *testing.T) {
+	mock.CreateRepositories()
+	ctx := context.Background()
+	fs.New("disk_mock", []fs.FSDisk{&mock.Disk{}, &targetDisk{&mock.Disk{}}})
+	source := fs.Disk("disk_mock").(*mock.Disk)
+	target := fs.Disk("disk_target").(*targetDisk)
+	source.Files = map[string][]byte{
+		"photo.jpg":       []byte("photo"),
+		"photo-small.jpg": []byte("small"),
+		"broken.jpg":      []byte("broken"),
+	}
+	target.Files = map[string][]byte{}
+	defer func() { source.Files, target.Files = nil, nil }()
+	config.Settings([]*config.SettingItem{{Name: "app_logo", Value: "/disk_mock/photo.jpg", Type: "image"}})
+	defer config.Settings([]*config.SettingItem{{Name: "app_logo", Value: "", Type: "image"}})
+
+	photo, _ := repositories.File.Create(ctx, &entities.File{Disk: "disk_mock", Path: "photo.jpg", Type: "image/jpeg", Size: 5, UserID: 1, Variants: []*fs.ImageVariant{
+		{Name: "small", Path: "photo-small.jpg", Type: "image/jpeg", Size: 5},
+	}})
+	duplicate, _ := repositories.File.Create(ctx, &entities.File{Disk: "disk_mock", Path: "photo.jpg", Type: "image/jpeg", Size: 5, UserID: 2})
+	broken, _ := repositories.File.Create(ctx, &entities.File{Disk: "disk_mock", Path: "broken.jpg", Type: "image/jpeg", Size: 10, UserID: 1})
+	missing, _ := repositories.File.Create(ctx, &entities.File{Disk: "disk_mock", Path: "missing.jpg", Type: "image/jpeg", Size: 1, UserID: 1})
+	repositories.File.Create(ctx, &entities.File{Disk: "other_disk", Path: "other.jpg", Size: 1, UserID: 1})
+	post, _ := repositories.Post.Create(ctx, &entities.Post{
+		Name:         "Post",
+		UserID:       1,
+		Content:      "![photo](/disk_mock/photo.jpg)",
+		ContentHTML:  `<img src="/disk_mock/photo.jpg" srcset="/disk_mock/photo-small.jpg 100w">`,
+		CustomFields: map[string]string{"cover": "/disk_mock/photo-small.jpg"},
+	})
+	page, _ := repositories.Page.Create(ctx, &entities.Page{
+		Name:        "Page",
+		Draft:       true,
+		ContentHTML: `<a href="/disk_mock/broken.jpg">broken</a>`,
+	})
+	user, _ := repositories.User.Create(ctx, &entities.User{Username: "author", CustomFields: map[string]string{"banner": "/disk_mock/photo.jpg", "city": "Hanoi"}})
+	footer, _ := repositories.Menu.Create(ctx, &entities.Menu{Name: "Footer", Location: "footer"})
+	repositories.Menu.SetItems(ctx, footer.ID, []*entities.MenuItem{
+		{Label: "Home", Type: entities.MENU_ITEM_CUSTOM, Url: "/"},
+		{Label: "Media", Type: entities.MENU_ITEM_CUSTOM, Url: "/", Children: []*entities.MenuItem{
+			{Label: "Photo", Type: entities.MENU_ITEM_CUSTOM, Url: "/disk_mock/photo.jpg"},
+		}},
+	})
+
+	_, err := MigrateFiles("disk_mock", "invalid_disk", false)
+	assert.Equal(t, errors.New("disk not found"), err)
+	_, err = MigrateFiles("disk_mock", "disk_mock", false)
+	assert.Equal(t, errors.New("the source and target disks are the same"), err)
+
+	result, err := MigrateFiles("disk_mock", "disk_target", true)
+	assert.NoError(t, err)
+	assert.Equal(t, []*entities.File{photo, duplicate, broken, missing}, result.Migrated)
+	assert.Equal(t, 26, result.Size)
+	assert.Equal(t, 0, len(target.Files))
+
+	result, err = MigrateFiles("disk_mock", "disk_target", false)
+	assert.NoError(t, err)
+	assert.Equal(t, []*entities.File{photo, duplicate}, result.Migrated)
+	assert.Equal(t, errors.New("size mismatch of broken.jpg: 10 bytes recorded, 6 read, 6 stored"), result.Failed[broken.ID])
+	assert.Equal(t, errors.New("File not found"), result.Failed[missing.ID])
+	assert.Equal(t, "disk_target", photo.Disk)
+	assert.Equal(t, "disk_target", duplicate.Disk)
+	assert.Equal(t, "disk_mock", broken.Disk)
+	assert.Equal(t, []byte("photo"), target.Files["photo.jpg"])
+	assert.Equal(t, []byte("small"), target.Files["photo-small.jpg"])
+	assert.Contains(t, source.Files, "photo.jpg")
+	assert.Equal(t, 1, result.Posts)
+	assert.Equal(t, 0, result.Pages)
+	assert.Equal(t, 1, result.Users)
+	assert.Equal(t, 1, result.Menus)
+	assert.Equal(t, 1, result.Settings)
+	assert.Equal(t, "![photo](/disk_target/photo.jpg)", post.Content)
+	assert.Equal(t, `<img src="/disk_target/photo.jpg" srcset="/disk_target/photo-small.jpg 100w">`, post.ContentHTML)
+	assert.Equal(t, map[string]string{"cover": "/disk_target/photo-small.jpg"}, post.CustomFields)
+	assert.Equal(t, map[string]string{"banner": "/disk_target/photo.jpg", "city": "Hanoi"}, user.CustomFields)
+	assert.Equal(t, "/", footer.Items[0].Url)
+	assert.Equal(t, "/disk_target/photo.jpg", footer.Items[1].Children[0].Url)
+	assert.Equal(t, "/disk_target/photo.jpg", config.Setting("app_logo"))
+
+	// the migration resumes with the files that are left on the source disk
+	broken.Size = 6
+	mockrepository.FakeRepoErrors["file_update"] = errors.New("update error")
+	result, err = MigrateFiles("disk_mock", "disk_target", false)
+	assert.NoError(t, err)
+	assert.Equal(t, errors.New("update error"), result.Failed[broken.ID])
+	assert.Equal(t, "disk_mock", broken.Disk)
+	mockrepository.FakeRepoErrors["file_update"] = nil
+
+	result, err = MigrateFiles("disk_mock", "disk_target", false)
+	assert.NoError(t, err)
+	assert.Equal(t, []*entities.File{broken}, result.Migrated)
+	assert.Equal(t, 0, result.Posts)
+	assert.Equal(t, 1, result.Pages)
+	assert.Equal(t, 0, result.Users)
+	assert.Equal(t, 0, result.Menus)
+	assert.Equal(t, `<a href="/disk_target/broken.jpg">broken</a>`, page.ContentHTML)
+
+	mockrepository.FakeRepoErrors["file_find"] = errors.New("find error")
+	_, err = MigrateFiles("disk_mock", "disk_target", false)
+	assert.Equal(t, errors.New("find error"), err)
+	mockrepository.FakeRepoErrors["file_find"] = nil
+}
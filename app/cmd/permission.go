@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/ngocphuongnb/tetua/app/auth"
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/logger"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+)
+
+type PermissionSyncResult struct {
+	Created  []*entities.Permission
+	Orphaned []*entities.Permission
+}
+
+// SyncPermissions creates the missing permissions of the non root roles for the actions
+// that were added after the setup, using the action default value.
+// Existing permissions are never updated so the values an admin customized are kept,
+// permissions of actions that no longer exist are only reported.
+func SyncPermissions(dryRun bool, ctxs ...context.Context) (*PermissionSyncResult, error) {
+	ctxs = append(ctxs, context.Background())
+	result := &PermissionSyncResult{}
+	roles, err := repositories.Role.All(ctxs[0])
+
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := repositories.Permission.All(ctxs[0])
+
+	if err != nil {
+		return nil, err
+	}
+
+	roleActions := map[int]map[string]bool{}
+
+	for _, permission := range permissions {
+		if roleActions[permission.RoleID] == nil {
+			roleActions[permission.RoleID] = map[string]bool{}
+		}
+
+		roleActions[permission.RoleID][permission.Action] = true
+
+		if auth.GetAuthConfig(permission.Action) == nil {
+			result.Orphaned = append(result.Orphaned, permission)
+		}
+	}
+
+	for _, role := range roles {
+		if role.Root {
+			continue
+		}
+
+		for _, authConfig := range auth.ActionConfigs {
+			if roleActions[role.ID][authConfig.Action] {
+				continue
+			}
+
+			permission := &entities.Permission{
+				RoleID: role.ID,
+				Action: authConfig.Action,
+				Value:  string(authConfig.DefaultValue),
+			}
+
+			if !dryRun {
+				if permission, err = repositories.Permission.Create(ctxs[0], permission); err != nil {
+					return result, err
+				}
+			}
+
+			result.Created = append(result.Created, permission)
+		}
+	}
+
+	return result, nil
+}
+
+// CheckPermissions syncs the permissions on startup and logs the changes
+func CheckPermissions() error {
+	result, err := SyncPermissions(false)
+
+	if err != nil {
+		return err
+	}
+
+	for _, permission := range result.Created {
+		logger.Info("Created missing permission", logger.Context{
+			"role_id": permission.RoleID,
+			"action":  permission.Action,
+			"value":   permission.Value,
+		})
+	}
+
+	for _, permission := range result.Orphaned {
+		logger.Warn("Orphaned permission, the action no longer exists", logger.Context{
+			"role_id": permission.RoleID,
+			"action":  permission.Action,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ngocphuongnb/tetua/app/auth"
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/mock"
+	mockrepository "github.com/ngocphuongnb/tetua/app/mock/repository"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+	"github.com/ngocphuongnb/tetua/app/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncPermissions(t *testing.T) {
+	actionConfigs := auth.ActionConfigs
+	mock.CreateRepositories()
+	defer func() {
+		auth.ActionConfigs = actionConfigs
+		mock.CreateRepositories()
+	}()
+
+	auth.Config(&server.AuthConfig{
+		Action:       "test.sync.existed",
+		DefaultValue: entities.PERM_NONE,
+	})
+	auth.Config(&server.AuthConfig{
+		Action:       "test.sync.new",
+		DefaultValue: entities.PERM_OWN,
+	})
+
+	ctx := context.Background()
+	adminRole := createRole(auth.ROLE_ADMIN)
+	userRole := createRole(auth.ROLE_USER)
+	createPermission(userRole, "test.sync.existed", entities.PERM_ALL)
+	createPermission(userRole, "test.sync.removed", entities.PERM_ALL)
+
+	result, err := SyncPermissions(true, ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, len(auth.ActionConfigs)-1, len(result.Created))
+	assert.Equal(t, 1, len(result.Orphaned))
+	assert.Equal(t, "test.sync.removed", result.Orphaned[0].Action)
+	permissions, _ := repositories.Permission.All(ctx)
+	assert.Equal(t, 2, len(permissions))
+
+	result, err = SyncPermissions(false, ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, len(auth.ActionConfigs)-1, len(result.Created))
+
+	permissions, _ = repositories.Permission.All(ctx)
+	assert.Equal(t, len(auth.ActionConfigs)+1, len(permissions))
+
+	for _, permission := range permissions {
+		assert.Equal(t, userRole.ID, permission.RoleID)
+		assert.NotEqual(t, adminRole.ID, permission.RoleID)
+
+		switch permission.Action {
+		case "test.sync.existed":
+			assert.Equal(t, string(entities.PERM_ALL), permission.Value)
+		case "test.sync.new":
+			assert.Equal(t, string(entities.PERM_OWN), permission.Value)
+		}
+	}
+
+	// Nothing to create on the next run
+	result, err = SyncPermissions(false, ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(result.Created))
+	assert.Equal(t, 1, len(result.Orphaned))
+	assert.Nil(t, CheckPermissions())
+
+	mockrepository.FakeRepoErrors["permission_create"] = errors.New("Error create permission")
+	defer delete(mockrepository.FakeRepoErrors, "permission_create")
+	createRole(auth.ROLE_GUEST)
+	_, err = SyncPermissions(false, ctx)
+	assert.Equal(t, errors.New("Error create permission"), err)
+}
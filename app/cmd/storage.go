@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ngocphuongnb/tetua/app/fs"
+)
+
+type StorageCheckResult struct {
+	Disk    string
+	Path    string
+	Url     string
+	Size    int
+	Elapsed time.Duration
+}
+
+// CheckStorage puts a small object to a disk, reads it back and deletes it,
+// an error is returned when any step fails or the content read back is different
+func CheckStorage(diskName string, ctxs ...context.Context) (*StorageCheckResult, error) {
+	ctxs = append(ctxs, context.Background())
+	ctx := ctxs[0]
+	disk := fs.Disk(diskName)
+
+	if disk == nil {
+		return nil, errors.New("disk not found")
+	}
+
+	start := time.Now()
+	content := []byte(fmt.Sprintf("tetua storage check %d", start.UnixNano()))
+	result := &StorageCheckResult{
+		Disk: disk.Name(),
+		Path: fmt.Sprintf("tetua-storage-check/%d.txt", start.UnixNano()),
+		Size: len(content),
+	}
+	result.Url = disk.Url(result.Path)
+
+	if _, err := disk.Put(ctx, bytes.NewReader(content), int64(len(content)), "text/plain", result.Path); err != nil {
+		return nil, fmt.Errorf("put %s: %w", result.Path, err)
+	}
+
+	reader, err := disk.Open(ctx, result.Path)
+
+	if err != nil {
+		return nil, fmt.Errorf("get %s: %w", result.Path, err)
+	}
+
+	data, err := io.ReadAll(reader)
+	reader.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("get %s: %w", result.Path, err)
+	}
+
+	if !bytes.Equal(data, content) {
+		return nil, fmt.Errorf("get %s: %d bytes stored, %d bytes read back with a different content", result.Path, len(content), len(data))
+	}
+
+	if err := disk.Delete(ctx, result.Path); err != nil {
+		return nil, fmt.Errorf("delete %s: %w", result.Path, err)
+	}
+
+	result.Elapsed = time.Since(start)
+
+	return result, nil
+}
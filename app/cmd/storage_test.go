@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/ngocphuongnb/tetua/app/fs"
+	"github.com/ngocphuongnb/tetua/app/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type brokenDisk struct {
+	*mock.Disk
+}
+
+func (d *brokenDisk) Name() string {
+	return "disk_broken"
+}
+
+func TestCheckStorage(t *testing.T) {
+	fs.New("disk_mock", []fs.FSDisk{&mock.Disk{}, &brokenDisk{&mock.Disk{}}})
+	disk := fs.Disk("disk_mock").(*mock.Disk)
+	disk.Files = map[string][]byte{}
+
+	_, err := CheckStorage("disk_not_found")
+	assert.Equal(t, "disk not found", err.Error())
+
+	result, err := CheckStorage("disk_mock")
+	assert.Nil(t, err)
+	assert.Equal(t, "disk_mock", result.Disk)
+	assert.Equal(t, "/disk_mock/"+result.Path, result.Url)
+	assert.Greater(t, result.Size, 0)
+	assert.Equal(t, 0, len(disk.Files))
+
+	// the mock disk doesn't keep the content when its files map is nil
+	_, err = CheckStorage("disk_broken")
+	assert.Contains(t, err.Error(), "get tetua-storage-check/")
+}
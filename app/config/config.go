@@ -33,6 +33,35 @@ type AuthConfig struct {
 	Providers        map[string]map[string]string `json:"providers"`
 }
 
+// CacheConfig selects how the cache invalidation events are broadcast to the other instances,
+// Transport is "local" (default) for a single instance or "redis"
+type CacheConfig struct {
+	Transport     string `json:"transport"`
+	RedisAddr     string `json:"redis_addr,omitempty"`
+	RedisPassword string `json:"redis_password,omitempty"`
+	RedisChannel  string `json:"redis_channel,omitempty"`
+}
+
+// ImageVariantConfig is a resized copy generated for the uploaded images,
+// a zero width or height is not limited and Crop cuts the image to the exact size
+type ImageVariantConfig struct {
+	Name   string `json:"name"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Crop   bool   `json:"crop,omitempty"`
+}
+
+// ImageConfig controls the variants generated for JPEG, PNG and GIF uploads,
+// WebP copies are only generated when a WebP encoder is registered in the imaging package.
+// The images transformed by the /img endpoint are cached on CacheDisk.
+type ImageConfig struct {
+	Variants  []*ImageVariantConfig `json:"variants"`
+	WebP      bool                  `json:"webp,omitempty"`
+	Quality   int                   `json:"quality,omitempty"`
+	MaxPixels int                   `json:"max_pixels,omitempty"`
+	CacheDisk string                `json:"cache_disk,omitempty"`
+}
+
 type ConfigFile struct {
 	APP_ENV          string            `json:"app_env"`
 	APP_KEY          string            `json:"app_key"`
@@ -40,12 +69,16 @@ type ConfigFile struct {
 	APP_PORT         string            `json:"app_port"`
 	APP_THEME        string            `json:"app_theme,omitempty"`
 	DB_DSN           string            `json:"db_dsn"`
+	DB_DRIVER        string            `json:"db_driver,omitempty"`
 	COOKIE_UUID      string            `json:"cookie_uuid,omitempty"`
 	SHOW_TETUA_BLOCK bool              `json:"show_tetua_block,omitempty"`
 	DB_QUERY_LOGGING bool              `json:"db_query_logging"`
+	DB_AUTO_MIGRATE  *bool             `json:"db_auto_migrate,omitempty"`
 	STORAGES         *fs.StorageConfig `json:"storage,omitempty"`
 	Mail             *MailConfig       `json:"mail,omitempty"`
 	Auth             *AuthConfig       `json:"auth,omitempty"`
+	Cache            *CacheConfig      `json:"cache,omitempty"`
+	Image            *ImageConfig      `json:"image,omitempty"`
 }
 
 var (
@@ -59,7 +92,9 @@ var (
 	APP_PORT         = "3000"
 	APP_THEME        = "default"
 	DB_DSN           = ""
+	DB_DRIVER        = ""
 	DB_QUERY_LOGGING = false
+	DB_AUTO_MIGRATE  = true
 	ROOT_DIR         = ""
 	PUBLIC_DIR       = "public"
 	PRIVATE_DIR      = "private"
@@ -69,6 +104,17 @@ var (
 
 var Mail *MailConfig
 var Auth *AuthConfig
+var Cache *CacheConfig
+var Image = &ImageConfig{
+	Variants: []*ImageVariantConfig{
+		{Name: "thumbnail", Width: 150, Height: 150, Crop: true},
+		{Name: "medium", Width: 300, Height: 300},
+		{Name: "large", Width: 1024, Height: 1024},
+	},
+	Quality:   85,
+	MaxPixels: 50_000_000,
+	CacheDisk: "local_private",
+}
 
 func ConfigError(name string) {
 	panic(fmt.Sprintf(
@@ -98,6 +144,30 @@ func Init(workingDir string) {
 	if DB_DSN == "" {
 		ConfigError("DB_DSN")
 	}
+
+	initPrivateDisks()
+}
+
+// initPrivateDisks sets the key that signs the urls of the private disks, the private local disks
+// without a base url are served by the app at /files/private/{disk}
+func initPrivateDisks() {
+	for _, disk := range STORAGES.DiskConfigs {
+		if !disk.Private {
+			continue
+		}
+
+		disk.SignKey = APP_KEY
+
+		if disk.Driver == "local" && disk.BaseUrl == "" && disk.BaseUrlFn == nil {
+			disk.BaseUrlFn = privateFilesBaseUrlFn(disk.Name)
+		}
+	}
+}
+
+func privateFilesBaseUrlFn(diskName string) func() string {
+	return func() string {
+		return strings.TrimRight(Setting("app_base_url"), "/") + "/files/private/" + diskName
+	}
 }
 
 func CreateConfigFile(workingDir string) (err error) {
@@ -149,6 +219,27 @@ func parseConfigFile() {
 
 		Mail = cfg.Mail
 		Auth = cfg.Auth
+		Cache = cfg.Cache
+
+		if cfg.Image != nil {
+			if cfg.Image.Variants != nil {
+				Image.Variants = cfg.Image.Variants
+			}
+
+			if cfg.Image.Quality > 0 {
+				Image.Quality = cfg.Image.Quality
+			}
+
+			if cfg.Image.MaxPixels > 0 {
+				Image.MaxPixels = cfg.Image.MaxPixels
+			}
+
+			if cfg.Image.CacheDisk != "" {
+				Image.CacheDisk = cfg.Image.CacheDisk
+			}
+
+			Image.WebP = cfg.Image.WebP
+		}
 
 		if cfg.APP_ENV != "" {
 			APP_ENV = cfg.APP_ENV
@@ -162,6 +253,10 @@ func parseConfigFile() {
 			DB_DSN = cfg.DB_DSN
 		}
 
+		if cfg.DB_DRIVER != "" {
+			DB_DRIVER = cfg.DB_DRIVER
+		}
+
 		if cfg.APP_KEY != "" {
 			APP_KEY = cfg.APP_KEY
 		}
@@ -180,6 +275,10 @@ func parseConfigFile() {
 
 		SHOW_TETUA_BLOCK = cfg.SHOW_TETUA_BLOCK
 		DB_QUERY_LOGGING = cfg.DB_QUERY_LOGGING
+
+		if cfg.DB_AUTO_MIGRATE != nil {
+			DB_AUTO_MIGRATE = *cfg.DB_AUTO_MIGRATE
+		}
 		STORAGES = &fs.StorageConfig{
 			DefaultDisk: "local_public",
 			DiskConfigs: []*fs.DiskConfig{{
@@ -190,9 +289,11 @@ func parseConfigFile() {
 					return Setting("file_base_url") + "/files"
 				},
 			}, {
-				Name:   "local_private",
-				Driver: "local",
-				Root:   path.Join(PRIVATE_DIR, "storage"),
+				Name:      "local_private",
+				Driver:    "local",
+				Root:      path.Join(PRIVATE_DIR, "storage"),
+				Private:   true,
+				BaseUrlFn: privateFilesBaseUrlFn("local_private"),
 			}},
 		}
 
@@ -233,6 +334,10 @@ func parseENV() {
 		DB_DSN = os.Getenv("DB_DSN")
 	}
 
+	if os.Getenv("DB_DRIVER") != "" {
+		DB_DRIVER = os.Getenv("DB_DRIVER")
+	}
+
 	if os.Getenv("APP_KEY") != "" {
 		APP_KEY = os.Getenv("APP_KEY")
 	}
@@ -256,4 +361,8 @@ func parseENV() {
 	if os.Getenv("SHOW_TETUA_BLOCK") != "" {
 		SHOW_TETUA_BLOCK = strings.ToLower(os.Getenv("SHOW_TETUA_BLOCK")) == "true"
 	}
+
+	if os.Getenv("DB_AUTO_MIGRATE") != "" {
+		DB_AUTO_MIGRATE = strings.ToLower(os.Getenv("DB_AUTO_MIGRATE")) == "true"
+	}
 }
@@ -20,6 +20,8 @@ const sampleConfigContent = `{
   "app_base_url": "http://localhost:3001",
   "cookie_uuid": "test_uuid",
   "db_dsn": "root:123@tcp(127.0.0.1:3306)/tetua",
+  "db_driver": "mysql",
+  "db_auto_migrate": false,
   "github_client_id": "github_client_id_test",
   "github_client_secret": "github_client_secret_test",
   "show_tetua_block": true,
@@ -42,7 +44,8 @@ const sampleConfigContent = `{
       {
         "name": "local_private_test",
         "driver": "local",
-        "root": "./private/storage"
+        "root": "./private/storage",
+        "private": true
       },
       {
         "name": "s3_public_test",
@@ -117,6 +120,8 @@ func TestParseConfigFile(t *testing.T) {
 	assert.Equal(t, "testing", APP_ENV)
 	assert.Equal(t, "3001", APP_PORT)
 	assert.Equal(t, "root:123@tcp(127.0.0.1:3306)/tetua", DB_DSN)
+	assert.Equal(t, "mysql", DB_DRIVER)
+	assert.Equal(t, false, DB_AUTO_MIGRATE)
 	assert.Equal(t, "app_key_test", APP_KEY)
 	assert.Equal(t, "app_token_key_test", APP_TOKEN_KEY)
 	assert.Equal(t, "test_theme", APP_THEME)
@@ -128,24 +133,34 @@ func TestParseConfigFile(t *testing.T) {
 	assert.Equal(t, "local_private_test", STORAGES.DiskConfigs[3].Name)
 	assert.Equal(t, "s3_public_test", STORAGES.DiskConfigs[4].Name)
 	assert.Equal(t, "/files", STORAGES.DiskConfigs[0].BaseUrlFn())
+	assert.Equal(t, "/files/private/local_private", STORAGES.DiskConfigs[1].BaseUrlFn())
+	assert.Equal(t, true, STORAGES.DiskConfigs[3].Private)
+
+	initPrivateDisks()
+	assert.Equal(t, "app_key_test", STORAGES.DiskConfigs[1].SignKey)
+	assert.Equal(t, "/files/private/local_private_test", STORAGES.DiskConfigs[3].BaseUrlFn())
+	assert.Equal(t, "", STORAGES.DiskConfigs[4].SignKey)
 }
 
 func TestParseEnv(t *testing.T) {
 	os.Setenv("APP_ENV", "env_development")
 	os.Setenv("APP_PORT", "3002")
 	os.Setenv("DB_DSN", "env_root:123@tcp")
+	os.Setenv("DB_DRIVER", "postgres")
 	os.Setenv("APP_KEY", "env_app_key_test")
 	os.Setenv("APP_TOKEN_KEY", "env_app_token_key_test")
 	os.Setenv("APP_THEME", "env_test_theme")
 	os.Setenv("COOKIE_UUID", "env_test_uuid")
 	os.Setenv("SHOW_TETUA_BLOCK", "true")
 	os.Setenv("DB_QUERY_LOGGING", "true")
+	os.Setenv("DB_AUTO_MIGRATE", "true")
 
 	parseENV()
 
 	assert.Equal(t, "env_development", APP_ENV)
 	assert.Equal(t, "3002", APP_PORT)
 	assert.Equal(t, "env_root:123@tcp", DB_DSN)
+	assert.Equal(t, "postgres", DB_DRIVER)
 	assert.Equal(t, "env_app_key_test", APP_KEY)
 	assert.Equal(t, "env_app_token_key_test", APP_TOKEN_KEY)
 	assert.Equal(t, "env_app_token_key_test", APP_TOKEN_KEY)
@@ -153,14 +168,17 @@ func TestParseEnv(t *testing.T) {
 	assert.Equal(t, "env_test_uuid", COOKIE_UUID)
 	assert.Equal(t, true, SHOW_TETUA_BLOCK)
 	assert.Equal(t, true, DB_QUERY_LOGGING)
+	assert.Equal(t, true, DB_AUTO_MIGRATE)
 
 	os.Setenv("SHOW_TETUA_BLOCK", "false")
 	os.Setenv("DB_QUERY_LOGGING", "false")
+	os.Setenv("DB_AUTO_MIGRATE", "false")
 
 	parseENV()
 
 	assert.Equal(t, false, SHOW_TETUA_BLOCK)
 	assert.Equal(t, false, DB_QUERY_LOGGING)
+	assert.Equal(t, false, DB_AUTO_MIGRATE)
 }
 
 func TestUrl(t *testing.T) {
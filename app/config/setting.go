@@ -25,9 +25,26 @@ var defaultSettings = []*SettingItem{
 	{"auto_approve_user", "", "switch"},
 	{"auto_approve_post", "", "switch"},
 	{"auto_approve_comment", "", "switch"},
+	{"registration_mode", REGISTRATION_OPEN, "select"},
+	{"audit_log_retention_days", "90", "input"},
+	{"strip_image_metadata", "yes", "switch"},
+	{"file_gc_enabled", "", "switch"},
+	{"file_gc_grace_days", "7", "input"},
+	{"tus_upload_ttl_hours", "24", "input"},
 }
 var settings = defaultSettings
 
+const (
+	REGISTRATION_OPEN   = "open"
+	REGISTRATION_INVITE = "invite"
+	REGISTRATION_CLOSED = "closed"
+)
+
+// settingOptions holds the available values of select settings
+var settingOptions = map[string][]string{
+	"registration_mode": {REGISTRATION_OPEN, REGISTRATION_INVITE, REGISTRATION_CLOSED},
+}
+
 func Settings(values []*SettingItem, overrideValues ...[]*SettingItem) {
 	for _, s := range values {
 		updateOrCreateSetting(s.Name, s.Value, s.Type)
@@ -72,6 +89,10 @@ func Setting(key string, defaultValues ...string) string {
 	return ""
 }
 
+func SettingOptions(key string) []string {
+	return settingOptions[key]
+}
+
 func AllSettings() []*SettingItem {
 	return settings
 }
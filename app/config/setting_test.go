@@ -31,4 +31,6 @@ func TestSettings(t *testing.T) {
 	assert.Equal(t, "", Setting("unknown"))
 	assert.Equal(t, "default", Setting("unknown", "default"))
 	assert.Equal(t, settings, AllSettings())
+	assert.Equal(t, []string{REGISTRATION_OPEN, REGISTRATION_INVITE, REGISTRATION_CLOSED}, SettingOptions("registration_mode"))
+	assert.Equal(t, []string(nil), SettingOptions("app_name"))
 }
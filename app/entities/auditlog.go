@@ -0,0 +1,59 @@
+package entities
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ngocphuongnb/tetua/app/utils"
+)
+
+// AuditLog records an admin action, Before and After hold the JSON of the target before and after the change
+type AuditLog struct {
+	ID         int        `json:"id,omitempty"`
+	UserID     int        `json:"user_id,omitempty"`
+	User       *User      `json:"user,omitempty"`
+	Action     string     `json:"action,omitempty"`
+	TargetType string     `json:"target_type,omitempty"`
+	TargetID   int        `json:"target_id,omitempty"`
+	Before     string     `json:"before,omitempty"`
+	After      string     `json:"after,omitempty"`
+	IP         string     `json:"ip,omitempty"`
+	RequestID  string     `json:"request_id,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+	DeletedAt  *time.Time `json:"deleted_at,omitempty"`
+}
+
+type AuditLogFilter struct {
+	*Filter
+	UserIDs     []int    `form:"user_ids" json:"user_ids"`
+	Actions     []string `form:"actions" json:"actions"`
+	TargetTypes []string `form:"target_types" json:"target_types"`
+	TargetIDs   []int    `form:"target_ids" json:"target_ids"`
+}
+
+func (p *AuditLogFilter) Base() string {
+	q := url.Values{}
+	if !utils.SliceContains(p.IgnoreUrlParams, "search") && p.Search != "" {
+		q.Add("q", p.Search)
+	}
+	if !utils.SliceContains(p.IgnoreUrlParams, "user") && len(p.UserIDs) > 0 {
+		q.Add("user", strconv.Itoa(p.UserIDs[0]))
+	}
+	if !utils.SliceContains(p.IgnoreUrlParams, "action") && len(p.Actions) > 0 {
+		q.Add("action", p.Actions[0])
+	}
+	if !utils.SliceContains(p.IgnoreUrlParams, "target_type") && len(p.TargetTypes) > 0 {
+		q.Add("target_type", p.TargetTypes[0])
+	}
+	if !utils.SliceContains(p.IgnoreUrlParams, "target_id") && len(p.TargetIDs) > 0 {
+		q.Add("target_id", strconv.Itoa(p.TargetIDs[0]))
+	}
+
+	if queryString := q.Encode(); queryString != "" {
+		return p.FilterBaseUrl() + "?" + q.Encode()
+	}
+
+	return p.FilterBaseUrl()
+}
@@ -0,0 +1,10 @@
+package entities
+
+// BackupTable is the number of the rows of a table in a database dump
+type BackupTable struct {
+	Rows int `json:"rows"`
+	// Skipped rows are not restored since the row they belong to is not in the dump
+	Skipped int `json:"skipped,omitempty"`
+	// Remapped rows are restored with a different id
+	Remapped int `json:"remapped,omitempty"`
+}
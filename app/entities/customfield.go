@@ -0,0 +1,164 @@
+package entities
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ngocphuongnb/tetua/app/utils"
+)
+
+const (
+	CUSTOM_FIELD_TEXT     = "text"
+	CUSTOM_FIELD_TEXTAREA = "textarea"
+	CUSTOM_FIELD_NUMBER   = "number"
+	CUSTOM_FIELD_URL      = "url"
+	CUSTOM_FIELD_BOOLEAN  = "boolean"
+	CUSTOM_FIELD_SELECT   = "select"
+
+	CUSTOM_FIELD_TARGET_POST = "post"
+	CUSTOM_FIELD_TARGET_PAGE = "page"
+	CUSTOM_FIELD_TARGET_USER = "user"
+)
+
+var CustomFieldTypes = []string{
+	CUSTOM_FIELD_TEXT,
+	CUSTOM_FIELD_TEXTAREA,
+	CUSTOM_FIELD_NUMBER,
+	CUSTOM_FIELD_URL,
+	CUSTOM_FIELD_BOOLEAN,
+	CUSTOM_FIELD_SELECT,
+}
+
+var CustomFieldTargets = []string{
+	CUSTOM_FIELD_TARGET_POST,
+	CUSTOM_FIELD_TARGET_PAGE,
+	CUSTOM_FIELD_TARGET_USER,
+}
+
+var customFieldNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// CustomField is an admin defined field of the posts, pages or users,
+// the values are available in the CustomFields of the target entities
+type CustomField struct {
+	ID          int        `json:"id,omitempty"`
+	Name        string     `json:"name,omitempty" validate:"max=64"`
+	Label       string     `json:"label,omitempty" validate:"max=255"`
+	Description string     `json:"description,omitempty" validate:"max=255"`
+	Type        string     `json:"type,omitempty"`
+	Target      string     `json:"target,omitempty"`
+	Required    bool       `json:"required,omitempty"`
+	MaxLength   int        `json:"max_length,omitempty"`
+	Pattern     string     `json:"pattern,omitempty"`
+	Options     []string   `json:"options,omitempty"`
+	Order       int        `json:"order"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+}
+
+type CustomFieldFilter struct {
+	*Filter
+	Targets []string `form:"targets" json:"targets"`
+}
+
+// CustomFieldMutation is the custom field form, Options has one option per line
+type CustomFieldMutation struct {
+	Name        string `form:"name" json:"name"`
+	Label       string `form:"label" json:"label"`
+	Description string `form:"description" json:"description"`
+	Type        string `form:"type" json:"type"`
+	Target      string `form:"target" json:"target"`
+	Required    bool   `form:"required" json:"required"`
+	MaxLength   int    `form:"max_length" json:"max_length"`
+	Pattern     string `form:"pattern" json:"pattern"`
+	Options     string `form:"options" json:"options"`
+	Order       int    `form:"order" json:"order"`
+}
+
+// ValidCustomFieldName reports whether the name can be used as the key of a custom field value
+func ValidCustomFieldName(name string) bool {
+	return customFieldNameRegex.MatchString(name) && len(name) <= 64
+}
+
+// InputName is the name of the field input in the compose forms
+func (f *CustomField) InputName() string {
+	return "custom_fields." + f.Name
+}
+
+// OptionsText returns the options with one option per line for the custom field form
+func (f *CustomField) OptionsText() string {
+	return strings.Join(f.Options, "\n")
+}
+
+// Clean normalizes a submitted value and validates it against the field rules,
+// boolean values are stored as "true" or as an empty value
+func (f *CustomField) Clean(value string) (string, error) {
+	value = strings.TrimSpace(value)
+
+	switch f.Type {
+	case CUSTOM_FIELD_BOOLEAN:
+		if utils.SliceContains([]string{"on", "true", "1", "yes"}, strings.ToLower(value)) {
+			value = "true"
+		} else {
+			value = ""
+		}
+	case CUSTOM_FIELD_TEXT, CUSTOM_FIELD_TEXTAREA:
+		value = utils.SanitizePlainText(value)
+	}
+
+	if value == "" {
+		if f.Required {
+			return "", fmt.Errorf("%s is required", f.Label)
+		}
+
+		return "", nil
+	}
+
+	if f.MaxLength > 0 && utf8.RuneCountInString(value) > f.MaxLength {
+		return value, fmt.Errorf("%s can't be more than %d characters", f.Label, f.MaxLength)
+	}
+
+	switch f.Type {
+	case CUSTOM_FIELD_NUMBER:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return value, fmt.Errorf("%s must be a number", f.Label)
+		}
+	case CUSTOM_FIELD_URL:
+		if u, err := url.ParseRequestURI(value); err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+			return value, fmt.Errorf("%s must be a http or https url", f.Label)
+		}
+	case CUSTOM_FIELD_SELECT:
+		if !utils.SliceContains(f.Options, value) {
+			return value, fmt.Errorf("%s must be one of the options", f.Label)
+		}
+	}
+
+	if f.Pattern != "" {
+		if matched, err := regexp.MatchString(f.Pattern, value); err != nil || !matched {
+			return value, fmt.Errorf("%s has an invalid format", f.Label)
+		}
+	}
+
+	return value, nil
+}
+
+func (p *CustomFieldFilter) Base() string {
+	q := url.Values{}
+	if !utils.SliceContains(p.IgnoreUrlParams, "search") && p.Search != "" {
+		q.Add("q", p.Search)
+	}
+	if !utils.SliceContains(p.IgnoreUrlParams, "target") && len(p.Targets) > 0 {
+		q.Add("target", p.Targets[0])
+	}
+
+	if queryString := q.Encode(); queryString != "" {
+		return p.FilterBaseUrl() + "?" + q.Encode()
+	}
+
+	return p.FilterBaseUrl()
+}
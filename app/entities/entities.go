@@ -16,11 +16,11 @@ import (
 // Entities are used in all other parts. This will store properties of business objects and associated methods. Example: Article, User
 
 type Entity interface {
-	Comment | File | Permission | Post | Page | Role | Setting | Topic | User
+	AuditLog | Comment | CustomField | File | Invite | Menu | Passkey | Permission | Post | Page | Role | Setting | Topic | User
 }
 
 type EntityFilter interface {
-	PostFilter | PageFilter | FileFilter | CommentFilter | UserFilter | PermissionFilter | RoleFilter | TopicFilter
+	PostFilter | PageFilter | FileFilter | CommentFilter | CustomFieldFilter | AuditLogFilter | InviteFilter | MenuFilter | PasskeyFilter | UserFilter | PermissionFilter | RoleFilter | TopicFilter
 }
 
 type NotFoundError struct {
@@ -66,6 +66,7 @@ type Meta struct {
 	Canonical   string
 	User        *User
 	Messages    *Messages
+	CsrfToken   string
 }
 
 type Map map[string]interface{}
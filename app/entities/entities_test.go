@@ -149,6 +149,71 @@ func TestComment(t *testing.T) {
 	assert.Equal(t, "/comment", commentFilterEmpty.Base())
 }
 
+func TestCustomField(t *testing.T) {
+	assert.True(t, entities.ValidCustomFieldName("subtitle"))
+	assert.True(t, entities.ValidCustomFieldName("cover_2"))
+	assert.False(t, entities.ValidCustomFieldName("2cover"))
+	assert.False(t, entities.ValidCustomFieldName("Sub-title"))
+	assert.False(t, entities.ValidCustomFieldName(""))
+
+	text := &entities.CustomField{Name: "subtitle", Label: "Subtitle", Type: entities.CUSTOM_FIELD_TEXT, Required: true, MaxLength: 5}
+	assert.Equal(t, "custom_fields.subtitle", text.InputName())
+	value, err := text.Clean("  Hi  ")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "Hi", value)
+	_, err = text.Clean(" ")
+	assert.Equal(t, "Subtitle is required", err.Error())
+	_, err = text.Clean("Too long")
+	assert.Equal(t, "Subtitle can't be more than 5 characters", err.Error())
+
+	number := &entities.CustomField{Label: "Price", Type: entities.CUSTOM_FIELD_NUMBER}
+	value, err = number.Clean("")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "", value)
+	_, err = number.Clean("1.5")
+	assert.Equal(t, nil, err)
+	_, err = number.Clean("cheap")
+	assert.Equal(t, "Price must be a number", err.Error())
+
+	link := &entities.CustomField{Label: "Website", Type: entities.CUSTOM_FIELD_URL}
+	_, err = link.Clean("https://tetua.net")
+	assert.Equal(t, nil, err)
+	_, err = link.Clean("javascript:alert(1)")
+	assert.Equal(t, "Website must be a http or https url", err.Error())
+
+	boolean := &entities.CustomField{Label: "Featured", Type: entities.CUSTOM_FIELD_BOOLEAN}
+	value, _ = boolean.Clean("on")
+	assert.Equal(t, "true", value)
+	value, _ = boolean.Clean("off")
+	assert.Equal(t, "", value)
+
+	selectField := &entities.CustomField{Label: "Size", Type: entities.CUSTOM_FIELD_SELECT, Options: []string{"S", "M"}}
+	assert.Equal(t, "S\nM", selectField.OptionsText())
+	_, err = selectField.Clean("M")
+	assert.Equal(t, nil, err)
+	_, err = selectField.Clean("XL")
+	assert.Equal(t, "Size must be one of the options", err.Error())
+
+	pattern := &entities.CustomField{Label: "Code", Type: entities.CUSTOM_FIELD_TEXT, Pattern: `^[A-Z]{3}$`}
+	_, err = pattern.Clean("ABC")
+	assert.Equal(t, nil, err)
+	_, err = pattern.Clean("abc")
+	assert.Equal(t, "Code has an invalid format", err.Error())
+
+	fields := map[string]string{"subtitle": "Hello"}
+	assert.Equal(t, "Hello", (&entities.Post{CustomFields: fields}).Field("subtitle"))
+	assert.Equal(t, "Hello", (&entities.Page{CustomFields: fields}).Field("subtitle"))
+	assert.Equal(t, "", (&entities.User{}).Field("subtitle"))
+
+	filter := &entities.CustomFieldFilter{
+		Filter:  &entities.Filter{BaseUrl: "/manage/custom-fields", Search: "sub"},
+		Targets: []string{entities.CUSTOM_FIELD_TARGET_POST},
+	}
+	assert.Equal(t, "/manage/custom-fields?q=sub&target=post", filter.Base())
+	filter.IgnoreUrlParams = []string{"search", "target"}
+	assert.Equal(t, "/manage/custom-fields", filter.Base())
+}
+
 func TestFile(t *testing.T) {
 	fileFilter := &entities.FileFilter{
 		Filter: &entities.Filter{
@@ -178,6 +243,14 @@ func TestFile(t *testing.T) {
 		Path: "test/file.jpg",
 	}
 	assert.Equal(t, "/disk_mock/test/file.jpg", file.Url())
+	assert.Equal(t, "/disk_mock/test/file.jpg", file.PreviewUrl())
+	assert.Equal(t, "", (*entities.File)(nil).PreviewUrl())
+	// only images are transformed
+	assert.Equal(t, "/disk_mock/test/file.jpg", file.TransformUrl("w_100"))
+	file.Type = "image/jpeg"
+	assert.Regexp(t, `/img/w_100,c_fill,d_disk_mock,s_[0-9a-f]{32}/test/file.jpg$`, file.TransformUrl("c_fill,w_100"))
+	assert.Equal(t, "/disk_mock/test/file.jpg", file.TransformUrl("w_invalid"))
+	assert.Equal(t, "", (*entities.File)(nil).TransformUrl("w_100"))
 	assert.Equal(t, nil, file.Delete(context.Background()))
 	file.Path = "/delete/error"
 	assert.Equal(t, errors.New("Delete file error"), file.Delete(context.Background()))
@@ -193,7 +266,123 @@ func TestFile(t *testing.T) {
 	file.Disk = ""
 	assert.Equal(t, "", file.Url())
 	assert.Equal(t, errors.New("disk or path is empty"), file.Delete(context.Background()))
+	assert.Equal(t, "", (*entities.File)(nil).Url("medium"))
+
+	image := &entities.File{
+		Disk:  "disk_mock",
+		Path:  "photo.jpg",
+		Type:  "image/jpeg",
+		Width: 2000,
+		Variants: []*fs.ImageVariant{
+			{Name: "medium", Path: "photo-medium.jpg", Type: "image/jpeg", Width: 300},
+			{Name: "medium", Path: "photo-medium.webp", Type: "image/webp", Width: 300},
+			{Name: "large", Path: "photo-large.jpg", Type: "image/jpeg", Width: 1024},
+		},
+	}
+	assert.Equal(t, "/disk_mock/photo-medium.jpg", image.Url("medium"))
+	assert.Equal(t, "/disk_mock/photo-large.jpg", image.Url("thumbnail", "large"))
+	assert.Equal(t, "/disk_mock/photo.jpg", image.Url("thumbnail"))
+	assert.Equal(t, "photo-medium.webp", image.Variant("medium", "image/webp").Path)
+	assert.Nil(t, image.Variant("large", "image/webp"))
+	assert.Equal(t, "/disk_mock/photo-medium.jpg 300w, /disk_mock/photo-large.jpg 1024w, /disk_mock/photo.jpg 2000w", image.Srcset("image/jpeg"))
+	assert.Equal(t, "/disk_mock/photo-medium.webp 300w", image.Srcset("image/webp"))
+	assert.Equal(t, "", image.Srcset("image/png"))
+	assert.Equal(t, nil, image.Delete(context.Background()))
+
+	image.Variants[0].Path = "/delete/error"
+	assert.Equal(t, errors.New("Delete file error"), image.Delete(context.Background()))
+}
+
+func TestFileLibrary(t *testing.T) {
+	from := time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2022, 5, 31, 0, 0, 0, 0, time.UTC)
+	fileFilter := &entities.FileFilter{
+		Filter:  &entities.Filter{BaseUrl: "/files", IgnoreUrlParams: []string{"user"}},
+		UserIDs: []int{1},
+		Types:   []string{"image"},
+		Tags:    []string{"logo"},
+		From:    &from,
+		To:      &to,
+	}
+	assert.Equal(t, "/files?from=2022-05-01&tag=logo&to=2022-05-31&type=image", fileFilter.Base())
+	assert.Equal(t, "image", fileFilter.Type())
+	assert.Equal(t, "logo", fileFilter.Tag())
+
+	emptyFilter := &entities.FileFilter{Filter: &entities.Filter{}}
+	assert.Equal(t, "", emptyFilter.Type())
+	assert.Equal(t, "", emptyFilter.Tag())
+	assert.Equal(t, "", emptyFilter.FromDate())
+	assert.Equal(t, "", emptyFilter.ToDate())
+
+	file := &entities.File{Path: "2022/05/abc.png", Type: "image/png"}
+	assert.Equal(t, "abc.png", file.Name())
+	assert.True(t, file.IsImage())
+	file.OriginalName = "logo.png"
+	assert.Equal(t, "logo.png", file.Name())
+	file.Title = "Logo"
+	assert.Equal(t, "Logo", file.Name())
+	assert.False(t, (&entities.File{Type: "application/pdf"}).IsImage())
+
+	assert.Equal(t, []string{"logo", "brand"}, entities.ParseFileTags(" logo, brand,,logo "))
+	assert.Equal(t, []string{}, entities.ParseFileTags(""))
+
+	assert.Equal(t, "/posts/1", (&entities.FileUsage{Type: "post", ID: 1}).EditUrl())
+	assert.Equal(t, "/manage/pages/2", (&entities.FileUsage{Type: "page", ID: 2}).EditUrl())
+	assert.Equal(t, "/manage/users/3", (&entities.FileUsage{Type: "avatar", ID: 3}).EditUrl())
+	assert.Equal(t, "/posts/4", (&entities.FileUsage{Type: "custom_field", Target: "post", ID: 4}).EditUrl())
+	assert.Equal(t, "/manage/users/5", (&entities.FileUsage{Type: "custom_field", Target: "user", ID: 5}).EditUrl())
+	assert.Equal(t, "/manage/menus/6", (&entities.FileUsage{Type: "menu", ID: 6}).EditUrl())
+	assert.Equal(t, "", (&entities.FileUsage{Type: "setting"}).EditUrl())
+}
+
+func TestMenu(t *testing.T) {
+	assert.True(t, entities.ValidMenuUrl("/about"))
+	assert.True(t, entities.ValidMenuUrl("#top"))
+	assert.True(t, entities.ValidMenuUrl("https://tetua.net"))
+	assert.True(t, entities.ValidMenuUrl("mailto:admin@tetua.net"))
+	assert.False(t, entities.ValidMenuUrl("//evil.com"))
+	assert.False(t, entities.ValidMenuUrl("javascript:alert(1)"))
+	assert.False(t, entities.ValidMenuUrl("about"))
+
+	items := entities.MenuItemsFromRows([]*entities.MenuItemMutation{
+		{Label: "Docs", Type: entities.MENU_ITEM_PAGE, PageID: 1, TopicID: 2, Url: "/ignored"},
+		{Label: "Topic", Type: entities.MENU_ITEM_TOPIC, TopicID: 2, Depth: 3},
+		{Label: "", Type: entities.MENU_ITEM_CUSTOM, Url: "/skipped"},
+		{Label: "Post", Type: entities.MENU_ITEM_POST, PostID: 3, Depth: 2},
+		{Label: "Deep", Type: entities.MENU_ITEM_CUSTOM, Url: "/deep", Depth: 5},
+		nil,
+		{Label: "Home", Type: entities.MENU_ITEM_CUSTOM, Url: "/"},
+	})
 
+	assert.Equal(t, 2, len(items))
+	assert.Equal(t, 1, items[0].TargetID)
+	assert.Equal(t, "", items[0].Url)
+	assert.Equal(t, 2, items[0].Children[0].TargetID)
+	assert.Equal(t, 2, items[0].Children[0].TargetOf(entities.MENU_ITEM_TOPIC))
+	assert.Equal(t, 0, items[0].Children[0].TargetOf(entities.MENU_ITEM_PAGE))
+	assert.Equal(t, 0, len(items[0].Children[0].Children[0].Children))
+	assert.Equal(t, "/deep", items[0].Children[0].Children[1].Url)
+	assert.Equal(t, 1, items[0].Children[0].Children[1].Order)
+	assert.Equal(t, 1, items[1].Order)
+
+	flattened := entities.FlattenMenuItems(items, 0)
+	assert.Equal(t, []string{"Docs", "Topic", "Post", "Deep", "Home"}, utils.SliceMap(flattened, func(item *entities.MenuItem) string {
+		return item.Label
+	}))
+	assert.Equal(t, []int{0, 1, 2, 2, 0}, utils.SliceMap(flattened, func(item *entities.MenuItem) int {
+		return item.Depth
+	}))
+
+	tree := entities.GetMenuItemsTree([]*entities.MenuItem{
+		{ID: 1, Label: "B", Order: 1},
+		{ID: 2, Label: "A", Order: 0},
+		{ID: 3, Label: "C", ParentID: 1},
+	}, 0)
+	assert.Equal(t, "A", tree[0].Label)
+	assert.Equal(t, "C", tree[1].Children[0].Label)
+
+	filter := &entities.MenuFilter{Filter: &entities.Filter{BaseUrl: "/manage/menus", Search: "main"}}
+	assert.Equal(t, "/manage/menus?q=main", filter.Base())
 }
 
 func TestPermission(t *testing.T) {
@@ -270,6 +459,24 @@ func TestPage(t *testing.T) {
 		},
 	}
 	assert.Equal(t, "/page", pageFilterEmpty.Base())
+
+	pageFilterParent := &entities.PageFilter{
+		Filter:    &entities.Filter{BaseUrl: "/page"},
+		ParentIDs: []int{3},
+	}
+	assert.Equal(t, "/page?parent=3", pageFilterParent.Base())
+
+	docs := &entities.Page{ID: 2, Slug: "docs"}
+	install := &entities.Page{ID: 3, Slug: "install", ParentID: 2, Parent: docs}
+	linux := &entities.Page{ID: 4, Slug: "linux", ParentID: 3, Parent: install}
+	assert.Equal(t, []*entities.Page{docs, install}, linux.Ancestors())
+	assert.Equal(t, "docs/install/linux", linux.Path())
+	assert.Equal(t, utils.Url("/docs/install/linux"), linux.Url())
+	assert.Equal(t, utils.Url("/docs.html"), docs.Url())
+	assert.True(t, linux.Published())
+
+	docs.Draft = true
+	assert.False(t, linux.Published())
 }
 
 func TestRole(t *testing.T) {
@@ -285,6 +492,15 @@ func TestRole(t *testing.T) {
 	assert.Equal(t, entities.PERM_NONE, entities.GetPermTypeValue("none"))
 	assert.Equal(t, entities.PERM_NONE, entities.GetPermTypeValue("test"))
 
+	permission := &entities.PermissionValue{Action: "post.save", Value: entities.PERM_ALL}
+	assert.Equal(t, true, permission.InTopics(nil))
+	assert.Equal(t, true, permission.InTopics([]int{1, 2}))
+	permission.TopicIDs = []int{1, 3}
+	assert.Equal(t, false, permission.InTopics(nil))
+	assert.Equal(t, true, permission.InTopics([]int{1}))
+	assert.Equal(t, true, permission.InTopics([]int{3, 1}))
+	assert.Equal(t, false, permission.InTopics([]int{1, 2}))
+
 	roleFilter := &entities.RoleFilter{
 		Filter: &entities.Filter{
 			BaseUrl:         "/role",
@@ -432,3 +648,31 @@ func TestUser(t *testing.T) {
 	}
 	assert.Equal(t, "/user", userFilterEmpty.Base())
 }
+
+func TestUserUploadLimits(t *testing.T) {
+	var nilUser *entities.User
+	assert.Equal(t, &entities.UploadLimits{}, nilUser.UploadLimits())
+
+	user := &entities.User{ID: 1, Roles: []*entities.Role{{
+		ID:               2,
+		MaxUploadSize:    100,
+		UploadMimeGroups: []string{"image"},
+		StorageQuota:     1000,
+	}, {
+		ID:               3,
+		MaxUploadSize:    200,
+		UploadMimeGroups: []string{"document", "image"},
+		StorageQuota:     500,
+	}}}
+	assert.Equal(t, &entities.UploadLimits{
+		MaxFileSize:  200,
+		MimeGroups:   []string{"image", "document"},
+		StorageQuota: 1000,
+	}, user.UploadLimits())
+
+	user.Roles = append(user.Roles, &entities.Role{ID: 4})
+	assert.Equal(t, &entities.UploadLimits{}, user.UploadLimits())
+
+	user.Roles = []*entities.Role{{ID: 1, Root: true, MaxUploadSize: 100}}
+	assert.Equal(t, &entities.UploadLimits{}, user.UploadLimits())
+}
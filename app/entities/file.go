@@ -3,30 +3,124 @@ package entities
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/url"
+	"path"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/ngocphuongnb/tetua/app/config"
 	"github.com/ngocphuongnb/tetua/app/fs"
+	"github.com/ngocphuongnb/tetua/app/imaging"
 	"github.com/ngocphuongnb/tetua/app/utils"
 )
 
 type File struct {
-	ID        int        `json:"id,omitempty"`
-	Disk      string     `json:"disk,omitempty"`
-	Path      string     `json:"path,omitempty"`
-	Type      string     `json:"type,omitempty"`
-	Size      int        `json:"size,omitempty"`
-	UserID    int        `json:"user_id,omitempty"`
-	User      *User      `json:"user,omitempty"`
-	Posts     []*Post    `json:"post,omitempty"`
-	CreatedAt *time.Time `json:"created_at,omitempty"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
-	DeletedAt *time.Time `json:"deleted_at,omitempty"`
-}
-
-func (f *File) Url() string {
-	if f.Disk == "" || f.Path == "" {
+	ID           int                `json:"id,omitempty"`
+	Disk         string             `json:"disk,omitempty"`
+	Path         string             `json:"path,omitempty"`
+	Type         string             `json:"type,omitempty"`
+	Size         int                `json:"size,omitempty"`
+	Hash         string             `json:"hash,omitempty"`
+	Width        int                `json:"width,omitempty"`
+	Height       int                `json:"height,omitempty"`
+	Variants     []*fs.ImageVariant `json:"variants,omitempty"`
+	OriginalName string             `json:"original_name,omitempty"`
+	Title        string             `json:"title,omitempty"`
+	Alt          string             `json:"alt,omitempty"`
+	Caption      string             `json:"caption,omitempty"`
+	Tags         []string           `json:"tags,omitempty"`
+	UserID       int                `json:"user_id,omitempty"`
+	User         *User              `json:"user,omitempty"`
+	Posts        []*Post            `json:"post,omitempty"`
+	Usages       []*FileUsage       `json:"usages,omitempty"`
+	CreatedAt    *time.Time         `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time         `json:"updated_at,omitempty"`
+	DeletedAt    *time.Time         `json:"deleted_at,omitempty"`
+}
+
+// FileUsage is a place where a file is used: the featured image or the content
+// of a post or a page, the avatar of a user, a url custom field value or a menu item.
+// Target is the type of the entity that owns a custom field value, ID is then its id
+type FileUsage struct {
+	Type   string `json:"type,omitempty"`
+	Target string `json:"target,omitempty"`
+	ID     int    `json:"id,omitempty"`
+	Name   string `json:"name,omitempty"`
+}
+
+type FileMutation struct {
+	Title   string `json:"title,omitempty" form:"title"`
+	Alt     string `json:"alt,omitempty" form:"alt"`
+	Caption string `json:"caption,omitempty" form:"caption"`
+	Tags    string `json:"tags,omitempty" form:"tags"`
+}
+
+// FileTypeGroups maps the file type filter values to the mime type prefixes they match
+var FileTypeGroups = map[string][]string{
+	"image":    {"image/"},
+	"video":    {"video/"},
+	"audio":    {"audio/"},
+	"document": {"application/", "text/"},
+}
+
+// EditUrl returns the url of the page where the usage can be edited
+func (u *FileUsage) EditUrl() string {
+	usageType := u.Type
+	if usageType == "custom_field" {
+		usageType = u.Target
+	}
+
+	switch usageType {
+	case "post":
+		return utils.Url(fmt.Sprintf("/posts/%d", u.ID))
+	case "page":
+		return utils.Url(fmt.Sprintf("/manage/pages/%d", u.ID))
+	case "avatar", CUSTOM_FIELD_TARGET_USER:
+		return utils.Url(fmt.Sprintf("/manage/users/%d", u.ID))
+	case "menu":
+		return utils.Url(fmt.Sprintf("/manage/menus/%d", u.ID))
+	}
+
+	return ""
+}
+
+// ParseFileTags splits a comma separated list of tags, empty and duplicated tags are removed
+func ParseFileTags(value string) []string {
+	tags := []string{}
+
+	for _, tag := range strings.Split(value, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" && !utils.SliceContains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
+// Name returns the title of the file, or its original name or path when the title is empty
+func (f *File) Name() string {
+	if f.Title != "" {
+		return f.Title
+	}
+
+	if f.OriginalName != "" {
+		return f.OriginalName
+	}
+
+	return path.Base(f.Path)
+}
+
+// IsImage reports whether the file is an image
+func (f *File) IsImage() bool {
+	return strings.HasPrefix(f.Type, "image/")
+}
+
+// Url returns the url of the first found variant in the variants list,
+// or the url of the original file when none of them exists, e.g. Url("medium", "large")
+func (f *File) Url(variants ...string) string {
+	if f == nil || f.Disk == "" || f.Path == "" {
 		return ""
 	}
 
@@ -35,9 +129,106 @@ func (f *File) Url() string {
 		return ""
 	}
 
+	for _, name := range variants {
+		if variant := f.Variant(name, f.Type); variant != nil {
+			return fileDisk.Url(variant.Path)
+		}
+	}
+
 	return fileDisk.Url(f.Path)
 }
 
+// SignedUrl returns a url of the file or its first existing variant that expires after the duration
+// when the file is on a private disk, the url of public files is returned as is
+func (f *File) SignedUrl(ctx context.Context, expiry time.Duration, variants ...string) string {
+	if f == nil {
+		return ""
+	}
+
+	fileDisk := fs.Disk(f.Disk)
+	if f.Path == "" || fileDisk == nil || !fs.IsPrivate(fileDisk) {
+		return f.Url(variants...)
+	}
+
+	filePath := f.Path
+	for _, name := range variants {
+		if variant := f.Variant(name, f.Type); variant != nil {
+			filePath = variant.Path
+			break
+		}
+	}
+
+	signedUrl, err := fs.SignedUrl(ctx, fileDisk, filePath, expiry)
+	if err != nil {
+		return f.Url(variants...)
+	}
+
+	return signedUrl
+}
+
+// PreviewUrl returns a url that opens the file or its first existing variant,
+// the urls of the files on private disks are signed for an hour
+func (f *File) PreviewUrl(variants ...string) string {
+	return f.SignedUrl(context.Background(), time.Hour, variants...)
+}
+
+// TransformUrl returns the signed url of a transformed copy of the image, e.g. TransformUrl("w_600,h_315,c_fill"),
+// see imaging.Transform for the params. The url of the file is returned when it can't be transformed.
+func (f *File) TransformUrl(params string) string {
+	if f == nil || !f.IsImage() || f.Path == "" {
+		return f.Url()
+	}
+
+	fileDisk := fs.Disk(f.Disk)
+	transform, _, err := imaging.ParseTransform(params)
+
+	if fileDisk == nil || fs.IsPrivate(fileDisk) || err != nil {
+		return f.Url()
+	}
+
+	transform.Disk = f.Disk
+
+	return utils.Url("/img/" + transform.Params(config.APP_KEY, f.Path) + "/" + f.Path)
+}
+
+// Variant returns the variant with the name and mime type or nil when it doesn't exist
+func (f *File) Variant(name, mime string) *fs.ImageVariant {
+	if f == nil {
+		return nil
+	}
+
+	for _, variant := range f.Variants {
+		if variant.Name == name && variant.Type == mime {
+			return variant
+		}
+	}
+
+	return nil
+}
+
+// Srcset returns the srcset attribute value of the variants in the mime type,
+// the original file is included when it has the same type and a known width
+func (f *File) Srcset(mime string) string {
+	fileDisk := fs.Disk(f.Disk)
+	if fileDisk == nil {
+		return ""
+	}
+
+	sources := []string{}
+	for _, variant := range f.Variants {
+		if variant.Type == mime && variant.Width > 0 {
+			sources = append(sources, fmt.Sprintf("%s %dw", fileDisk.Url(variant.Path), variant.Width))
+		}
+	}
+
+	if f.Type == mime && f.Width > 0 && len(sources) > 0 {
+		sources = append(sources, fmt.Sprintf("%s %dw", fileDisk.Url(f.Path), f.Width))
+	}
+
+	return strings.Join(sources, ", ")
+}
+
+// Delete removes the file and its variants from the disk
 func (f *File) Delete(ctx context.Context) error {
 	if f.Disk == "" || f.Path == "" {
 		return errors.New("disk or path is empty")
@@ -48,12 +239,61 @@ func (f *File) Delete(ctx context.Context) error {
 		return errors.New("disk not found")
 	}
 
+	for _, variant := range f.Variants {
+		if err := fileDisk.Delete(ctx, variant.Path); err != nil {
+			return err
+		}
+	}
+
 	return fileDisk.Delete(ctx, f.Path)
 }
 
 type FileFilter struct {
 	*Filter
-	UserIDs []int `form:"user_ids" json:"user_ids"`
+	UserIDs []int      `form:"user_ids" json:"user_ids"`
+	Disks   []string   `form:"disks" json:"disks"`
+	Paths   []string   `form:"paths" json:"paths"`
+	Hashes  []string   `form:"hashes" json:"hashes"`
+	Types   []string   `form:"types" json:"types"`
+	Tags    []string   `form:"tags" json:"tags"`
+	From    *time.Time `form:"from" json:"from"`
+	To      *time.Time `form:"to" json:"to"`
+}
+
+// Type returns the first type group of the filter
+func (p *FileFilter) Type() string {
+	if len(p.Types) > 0 {
+		return p.Types[0]
+	}
+
+	return ""
+}
+
+// Tag returns the first tag of the filter
+func (p *FileFilter) Tag() string {
+	if len(p.Tags) > 0 {
+		return p.Tags[0]
+	}
+
+	return ""
+}
+
+// FromDate returns the start date of the filter in the yyyy-mm-dd format
+func (p *FileFilter) FromDate() string {
+	if p.From == nil {
+		return ""
+	}
+
+	return p.From.Format("2006-01-02")
+}
+
+// ToDate returns the end date of the filter in the yyyy-mm-dd format
+func (p *FileFilter) ToDate() string {
+	if p.To == nil {
+		return ""
+	}
+
+	return p.To.Format("2006-01-02")
 }
 
 func (p *FileFilter) Base() string {
@@ -64,6 +304,18 @@ func (p *FileFilter) Base() string {
 	if !utils.SliceContains(p.IgnoreUrlParams, "user") && len(p.UserIDs) > 0 {
 		q.Add("user", strconv.Itoa(p.UserIDs[0]))
 	}
+	if !utils.SliceContains(p.IgnoreUrlParams, "type") && len(p.Types) > 0 {
+		q.Add("type", p.Types[0])
+	}
+	if !utils.SliceContains(p.IgnoreUrlParams, "tag") && len(p.Tags) > 0 {
+		q.Add("tag", p.Tags[0])
+	}
+	if !utils.SliceContains(p.IgnoreUrlParams, "from") && p.From != nil {
+		q.Add("from", p.FromDate())
+	}
+	if !utils.SliceContains(p.IgnoreUrlParams, "to") && p.To != nil {
+		q.Add("to", p.ToDate())
+	}
 
 	if queryString := q.Encode(); queryString != "" {
 		return p.FilterBaseUrl() + "?" + q.Encode()
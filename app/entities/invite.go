@@ -0,0 +1,68 @@
+package entities
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ngocphuongnb/tetua/app/utils"
+)
+
+// Invite is a registration code that can be used in invite registration mode
+type Invite struct {
+	ID           int        `json:"id,omitempty"`
+	Code         string     `json:"code,omitempty" validate:"max=64"`
+	UserID       int        `json:"user_id,omitempty"`
+	User         *User      `json:"user,omitempty"`
+	RoleID       int        `json:"role_id,omitempty"`
+	Role         *Role      `json:"role,omitempty"`
+	MaxUses      int        `json:"max_uses"`
+	Used         int        `json:"used"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	InvitedUsers []*User    `json:"invited_users,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+	DeletedAt    *time.Time `json:"deleted_at,omitempty"`
+}
+
+type InviteMutation struct {
+	MaxUses   int    `form:"max_uses" json:"max_uses"`
+	ExpiresIn int    `form:"expires_in" json:"expires_in"`
+	RoleID    int    `form:"role_id" json:"role_id"`
+	Code      string `form:"code" json:"code"`
+}
+
+type InviteFilter struct {
+	*Filter
+	UserIDs []int `form:"user_ids" json:"user_ids"`
+}
+
+// Expired reports whether the invite expiry time has passed
+func (i *Invite) Expired() bool {
+	return i.ExpiresAt != nil && i.ExpiresAt.Before(time.Now())
+}
+
+// Usable reports whether the invite can still be used to register, MaxUses = 0 means unlimited uses
+func (i *Invite) Usable() bool {
+	return !i.Expired() && (i.MaxUses == 0 || i.Used < i.MaxUses)
+}
+
+func (i *Invite) Url() string {
+	return utils.Url("/register?code=" + url.QueryEscape(i.Code))
+}
+
+func (p *InviteFilter) Base() string {
+	q := url.Values{}
+	if !utils.SliceContains(p.IgnoreUrlParams, "search") && p.Search != "" {
+		q.Add("q", p.Search)
+	}
+	if !utils.SliceContains(p.IgnoreUrlParams, "user") && len(p.UserIDs) > 0 {
+		q.Add("user", strconv.Itoa(p.UserIDs[0]))
+	}
+
+	if queryString := q.Encode(); queryString != "" {
+		return p.FilterBaseUrl() + "?" + q.Encode()
+	}
+
+	return p.FilterBaseUrl()
+}
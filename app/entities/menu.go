@@ -0,0 +1,209 @@
+package entities
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ngocphuongnb/tetua/app/utils"
+)
+
+const (
+	MENU_ITEM_PAGE   = "page"
+	MENU_ITEM_TOPIC  = "topic"
+	MENU_ITEM_POST   = "post"
+	MENU_ITEM_CUSTOM = "custom"
+	// MENU_MAX_DEPTH is the number of nested levels a menu can have
+	MENU_MAX_DEPTH = 3
+)
+
+var MenuItemTypes = []string{MENU_ITEM_PAGE, MENU_ITEM_TOPIC, MENU_ITEM_POST, MENU_ITEM_CUSTOM}
+
+// Menu is a navigation menu that themes render at a location, e.g. header or footer
+type Menu struct {
+	ID        int         `json:"id,omitempty"`
+	CreatedAt *time.Time  `json:"created_at,omitempty"`
+	UpdatedAt *time.Time  `json:"updated_at,omitempty"`
+	DeletedAt *time.Time  `json:"deleted_at,omitempty"`
+	Name      string      `json:"name,omitempty" validate:"max=255"`
+	Location  string      `json:"location,omitempty" validate:"max=64"`
+	Items     []*MenuItem `json:"items,omitempty"`
+}
+
+// MenuItem points to a page, topic, post or a custom url.
+// Link is the resolved url of the target, Depth is the item level when the tree is flattened.
+type MenuItem struct {
+	ID        int         `json:"id,omitempty"`
+	MenuID    int         `json:"menu_id,omitempty"`
+	ParentID  int         `json:"parent_id,omitempty"`
+	Label     string      `json:"label,omitempty" validate:"max=255"`
+	Type      string      `json:"type,omitempty"`
+	TargetID  int         `json:"target_id,omitempty"`
+	Url       string      `json:"url,omitempty" validate:"max=1024"`
+	Order     int         `json:"order"`
+	Link      string      `json:"link,omitempty"`
+	Depth     int         `json:"-"`
+	Children  []*MenuItem `json:"children,omitempty"`
+	CreatedAt *time.Time  `json:"created_at,omitempty"`
+	UpdatedAt *time.Time  `json:"updated_at,omitempty"`
+	DeletedAt *time.Time  `json:"deleted_at,omitempty"`
+}
+
+type MenuFilter struct {
+	*Filter
+}
+
+type MenuMutation struct {
+	Name     string              `form:"name" json:"name"`
+	Location string              `form:"location" json:"location"`
+	Items    []*MenuItemMutation `form:"items" json:"items"`
+}
+
+// MenuItemMutation is a row of the menu form, the rows are ordered and Depth nests a row
+// under the closest previous row with a lower depth
+type MenuItemMutation struct {
+	Label   string `form:"label" json:"label"`
+	Type    string `form:"type" json:"type"`
+	PageID  int    `form:"page_id" json:"page_id"`
+	TopicID int    `form:"topic_id" json:"topic_id"`
+	PostID  int    `form:"post_id" json:"post_id"`
+	Url     string `form:"url" json:"url"`
+	Depth   int    `form:"depth" json:"depth"`
+}
+
+// TargetID returns the id of the selected target for the row type
+func (m *MenuItemMutation) TargetID() int {
+	switch m.Type {
+	case MENU_ITEM_PAGE:
+		return m.PageID
+	case MENU_ITEM_TOPIC:
+		return m.TopicID
+	case MENU_ITEM_POST:
+		return m.PostID
+	}
+
+	return 0
+}
+
+// TargetOf returns the target id when the item has the given type, the compose form uses it
+// to select the target in the select of each type
+func (i *MenuItem) TargetOf(itemType string) int {
+	if i.Type == itemType {
+		return i.TargetID
+	}
+
+	return 0
+}
+
+// ValidMenuUrl reports whether a custom url is a relative path, an anchor or uses a safe scheme
+func ValidMenuUrl(u string) bool {
+	if strings.HasPrefix(u, "/") || strings.HasPrefix(u, "#") {
+		return !strings.HasPrefix(u, "//")
+	}
+
+	parsed, err := url.Parse(u)
+
+	if err != nil {
+		return false
+	}
+
+	return utils.SliceContains([]string{"http", "https", "mailto"}, strings.ToLower(parsed.Scheme))
+}
+
+// GetMenuItemsTree nests the items by their ParentID and sorts each level by Order
+func GetMenuItemsTree(items []*MenuItem, parentID int) []*MenuItem {
+	result := []*MenuItem{}
+
+	for _, item := range items {
+		if item.ParentID == parentID {
+			item.Children = GetMenuItemsTree(items, item.ID)
+			result = append(result, item)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Order < result[j].Order
+	})
+
+	return result
+}
+
+// FlattenMenuItems lists the items of a tree in display order with their Depth
+func FlattenMenuItems(items []*MenuItem, depth int) []*MenuItem {
+	result := []*MenuItem{}
+
+	for _, item := range items {
+		item.Depth = depth
+		result = append(result, item)
+		result = append(result, FlattenMenuItems(item.Children, depth+1)...)
+	}
+
+	return result
+}
+
+// MenuItemsFromRows builds the items tree from the ordered form rows,
+// a row can only be one level deeper than the previous row and not deeper than MENU_MAX_DEPTH
+func MenuItemsFromRows(rows []*MenuItemMutation) []*MenuItem {
+	root := []*MenuItem{}
+	parents := []*MenuItem{}
+
+	for _, row := range rows {
+		if row == nil || row.Label == "" {
+			continue
+		}
+
+		item := &MenuItem{
+			Label:    row.Label,
+			Type:     row.Type,
+			TargetID: row.TargetID(),
+		}
+
+		if row.Type == MENU_ITEM_CUSTOM {
+			item.Url = row.Url
+		}
+
+		depth := row.Depth
+
+		if depth > len(parents) {
+			depth = len(parents)
+		}
+
+		if depth > MENU_MAX_DEPTH-1 {
+			depth = MENU_MAX_DEPTH - 1
+		}
+
+		if depth < 0 {
+			depth = 0
+		}
+
+		parents = parents[:depth]
+
+		if depth == 0 {
+			item.Order = len(root)
+			root = append(root, item)
+		} else {
+			parent := parents[depth-1]
+			item.Order = len(parent.Children)
+			parent.Children = append(parent.Children, item)
+		}
+
+		item.Depth = depth
+		parents = append(parents, item)
+	}
+
+	return root
+}
+
+func (p *MenuFilter) Base() string {
+	q := url.Values{}
+	if !utils.SliceContains(p.IgnoreUrlParams, "search") && p.Search != "" {
+		q.Add("q", p.Search)
+	}
+
+	if queryString := q.Encode(); queryString != "" {
+		return p.FilterBaseUrl() + "?" + q.Encode()
+	}
+
+	return p.FilterBaseUrl()
+}
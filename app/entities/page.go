@@ -3,33 +3,87 @@ package entities
 import (
 	"fmt"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ngocphuongnb/tetua/app/utils"
 )
 
+// PAGE_MAX_DEPTH is the number of levels a page hierarchy can have
+const PAGE_MAX_DEPTH = 5
+
 // Page is the model entity for the Page schema.
 type Page struct {
-	ID              int        `json:"id,omitempty"`
-	Name            string     `json:"name,omitempty" validate:"max=255"`
-	Slug            string     `json:"slug,omitempty" validate:"max=255"`
-	Content         string     `json:"content,omitempty" validate:"required"`
-	ContentHTML     string     `json:"content_html,omitempty"`
-	Draft           bool       `json:"draft,omitempty"`
-	FeaturedImageID int        `json:"featured_image_id,omitempty"`
-	FeaturedImage   *File      `json:"featured_image,omitempty"`
-	CreatedAt       *time.Time `json:"created_at,omitempty"`
-	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
-	DeletedAt       *time.Time `json:"deleted_at,omitempty"`
+	ID              int               `json:"id,omitempty"`
+	Name            string            `json:"name,omitempty" validate:"max=255"`
+	Slug            string            `json:"slug,omitempty" validate:"max=255"`
+	Content         string            `json:"content,omitempty" validate:"required"`
+	ContentHTML     string            `json:"content_html,omitempty"`
+	Draft           bool              `json:"draft,omitempty"`
+	FeaturedImageID int               `json:"featured_image_id,omitempty"`
+	FeaturedImage   *File             `json:"featured_image,omitempty"`
+	ParentID        int               `form:"parent_id" json:"parent_id,omitempty"`
+	Parent          *Page             `json:"parent,omitempty"`
+	Children        []*Page           `json:"children,omitempty"`
+	Order           int               `form:"order" json:"order"`
+	Template        string            `form:"template" json:"template,omitempty" validate:"max=64"`
+	CustomFields    map[string]string `form:"-" json:"custom_fields,omitempty"`
+	CreatedAt       *time.Time        `json:"created_at,omitempty"`
+	UpdatedAt       *time.Time        `json:"updated_at,omitempty"`
+	DeletedAt       *time.Time        `json:"deleted_at,omitempty"`
 }
 
 type PageFilter struct {
 	*Filter
-	Publish string `form:"publish_type" json:"publish_type"` // publish_type = all, published, draft
+	Publish   string `form:"publish_type" json:"publish_type"` // publish_type = all, published, draft
+	ParentIDs []int  `form:"parent_ids" json:"parent_ids"`
 }
 
+// Url returns /slug.html for the root pages and the path through the hierarchy
+// for the nested pages, e.g. /docs/install
 func (p *Page) Url() string {
-	return utils.Url(fmt.Sprintf("/%s.html", p.Slug))
+	if p.ParentID == 0 {
+		return utils.Url(fmt.Sprintf("/%s.html", p.Slug))
+	}
+
+	return utils.Url("/" + p.Path())
+}
+
+// Field returns the value of a custom field
+func (p *Page) Field(name string) string {
+	return p.CustomFields[name]
+}
+
+// Ancestors returns the loaded parents of the page, starting from the root page
+func (p *Page) Ancestors() []*Page {
+	ancestors := []*Page{}
+
+	for parent := p.Parent; parent != nil && parent.ID > 0; parent = parent.Parent {
+		ancestors = append([]*Page{parent}, ancestors...)
+	}
+
+	return ancestors
+}
+
+// Path joins the slugs of the ancestors and the page
+func (p *Page) Path() string {
+	slugs := utils.SliceMap(p.Ancestors(), func(ancestor *Page) string {
+		return ancestor.Slug
+	})
+
+	return strings.Join(append(slugs, p.Slug), "/")
+}
+
+// Published reports whether the page and all of its ancestors are not drafts
+func (p *Page) Published() bool {
+	for _, page := range append(p.Ancestors(), p) {
+		if page.Draft {
+			return false
+		}
+	}
+
+	return true
 }
 
 func (p *PageFilter) Base() string {
@@ -40,6 +94,9 @@ func (p *PageFilter) Base() string {
 	if !utils.SliceContains(p.IgnoreUrlParams, "publish") && p.Publish != "" {
 		q.Add("publish", p.Publish)
 	}
+	if !utils.SliceContains(p.IgnoreUrlParams, "parent") && len(p.ParentIDs) > 0 {
+		q.Add("parent", strconv.Itoa(p.ParentIDs[0]))
+	}
 
 	if queryString := q.Encode(); queryString != "" {
 		return p.FilterBaseUrl() + "?" + q.Encode()
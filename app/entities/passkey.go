@@ -0,0 +1,48 @@
+package entities
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ngocphuongnb/tetua/app/utils"
+)
+
+// Passkey is a WebAuthn credential registered by a user
+type Passkey struct {
+	ID              int        `json:"id,omitempty"`
+	UserID          int        `json:"user_id,omitempty"`
+	User            *User      `json:"user,omitempty"`
+	Name            string     `json:"name,omitempty" validate:"max=255"`
+	CredentialID    []byte     `json:"credential_id,omitempty"`
+	PublicKey       []byte     `json:"-"`
+	AttestationType string     `json:"attestation_type,omitempty"`
+	AAGUID          []byte     `json:"aaguid,omitempty"`
+	SignCount       uint32     `json:"sign_count,omitempty"`
+	Transports      []string   `json:"transports,omitempty"`
+	LastUsedAt      *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+	DeletedAt       *time.Time `json:"deleted_at,omitempty"`
+}
+
+type PasskeyFilter struct {
+	*Filter
+	UserIDs []int `form:"user_ids" json:"user_ids"`
+}
+
+func (p *PasskeyFilter) Base() string {
+	q := url.Values{}
+	if !utils.SliceContains(p.IgnoreUrlParams, "search") && p.Search != "" {
+		q.Add("q", p.Search)
+	}
+	if !utils.SliceContains(p.IgnoreUrlParams, "user") && len(p.UserIDs) > 0 {
+		q.Add("user", strconv.Itoa(p.UserIDs[0]))
+	}
+
+	if queryString := q.Encode(); queryString != "" {
+		return p.FilterBaseUrl() + "?" + q.Encode()
+	}
+
+	return p.FilterBaseUrl()
+}
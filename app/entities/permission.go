@@ -17,6 +17,7 @@ type Permission struct {
 	RoleID    int        `json:"role_id,omitempty"`
 	Action    string     `json:"action,omitempty" validate:"max=255"`
 	Value     string     `json:"value,omitempty"`
+	TopicIDs  []int      `json:"topic_ids,omitempty"`
 	Role      *Role      `json:"role,omitempty"`
 }
 
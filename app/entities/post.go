@@ -11,38 +11,40 @@ import (
 
 // Post is the model entity for the Post schema.
 type Post struct {
-	ID              int        `json:"id,omitempty"`
-	CreatedAt       *time.Time `json:"created_at,omitempty"`
-	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
-	DeletedAt       *time.Time `json:"deleted_at,omitempty"`
-	Name            string     `json:"name,omitempty" validate:"max=255"`
-	Slug            string     `json:"slug,omitempty" validate:"max=255"`
-	Description     string     `json:"description,omitempty" validate:"max=255"`
-	Content         string     `json:"content,omitempty" validate:"required"`
-	ContentHTML     string     `json:"content_html,omitempty"`
-	ViewCount       int64      `json:"view_count,omitempty"`
-	CommentCount    int64      `json:"comment_count,omitempty"`
-	RatingCount     int64      `json:"rating_count,omitempty"`
-	RatingTotal     int64      `json:"rating_total,omitempty"`
-	Draft           bool       `json:"draft,omitempty"`
-	Approved        bool       `json:"approved,omitempty"`
-	FeaturedImageID int        `json:"featured_image_id,omitempty"`
-	UserID          int        `json:"user_id,omitempty"`
-	User            *User      `json:"user,omitempty"`
-	FeaturedImage   *File      `json:"featured_image,omitempty"`
-	Topics          []*Topic   `json:"topics,omitempty"`
-	TopicIDs        []int      `json:"topic_ids,omitempty"`
+	ID              int               `json:"id,omitempty"`
+	CreatedAt       *time.Time        `json:"created_at,omitempty"`
+	UpdatedAt       *time.Time        `json:"updated_at,omitempty"`
+	DeletedAt       *time.Time        `json:"deleted_at,omitempty"`
+	Name            string            `json:"name,omitempty" validate:"max=255"`
+	Slug            string            `json:"slug,omitempty" validate:"max=255"`
+	Description     string            `json:"description,omitempty" validate:"max=255"`
+	Content         string            `json:"content,omitempty" validate:"required"`
+	ContentHTML     string            `json:"content_html,omitempty"`
+	ViewCount       int64             `json:"view_count,omitempty"`
+	CommentCount    int64             `json:"comment_count,omitempty"`
+	RatingCount     int64             `json:"rating_count,omitempty"`
+	RatingTotal     int64             `json:"rating_total,omitempty"`
+	Draft           bool              `json:"draft,omitempty"`
+	Approved        bool              `json:"approved,omitempty"`
+	FeaturedImageID int               `json:"featured_image_id,omitempty"`
+	UserID          int               `json:"user_id,omitempty"`
+	User            *User             `json:"user,omitempty"`
+	FeaturedImage   *File             `json:"featured_image,omitempty"`
+	Topics          []*Topic          `json:"topics,omitempty"`
+	TopicIDs        []int             `json:"topic_ids,omitempty"`
+	CustomFields    map[string]string `form:"-" json:"custom_fields,omitempty"`
 }
 
 type PostMutation struct {
-	Name            string `form:"name" json:"name"`
-	Slug            string `form:"name" json:"slug"`
-	Description     string `form:"description" json:"description"`
-	Content         string `form:"content" json:"content"`
-	ContentHTML     string `form:"content_html" json:"content_html"`
-	TopicIDs        []int  `form:"topic_ids" json:"topic_ids"`
-	Draft           bool   `form:"draft" json:"draft"`
-	FeaturedImageID int    `form:"featured_image_id" json:"featured_image_id"`
+	Name            string            `form:"name" json:"name"`
+	Slug            string            `form:"name" json:"slug"`
+	Description     string            `form:"description" json:"description"`
+	Content         string            `form:"content" json:"content"`
+	ContentHTML     string            `form:"content_html" json:"content_html"`
+	TopicIDs        []int             `form:"topic_ids" json:"topic_ids"`
+	Draft           bool              `form:"draft" json:"draft"`
+	FeaturedImageID int               `form:"featured_image_id" json:"featured_image_id"`
+	CustomFields    map[string]string `form:"-" json:"custom_fields"`
 }
 
 type PostFilter struct {
@@ -53,6 +55,11 @@ type PostFilter struct {
 	TopicIDs []int  `form:"topic_ids" json:"topic_ids"`
 }
 
+// Field returns the value of a custom field
+func (p *Post) Field(name string) string {
+	return p.CustomFields[name]
+}
+
 func (p *Post) Url() string {
 	return utils.Url(fmt.Sprintf("%s-%d.html", p.Slug, p.ID))
 }
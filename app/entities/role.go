@@ -9,15 +9,21 @@ import (
 
 // Role is the model entity for the Role schema.
 type Role struct {
-	ID          int           `json:"id,omitempty"`
-	CreatedAt   *time.Time    `json:"created_at,omitempty"`
-	UpdatedAt   *time.Time    `json:"updated_at,omitempty"`
-	DeletedAt   *time.Time    `json:"deleted_at,omitempty"`
-	Name        string        `json:"name,omitempty" validate:"max=255"`
-	Description string        `json:"description,omitempty" validate:"max=255"`
-	Root        bool          `json:"root,omitempty"`
-	Users       []*User       `json:"users,omitempty"`
-	Permissions []*Permission `json:"permissions,omitempty"`
+	ID          int        `json:"id,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+	Name        string     `json:"name,omitempty" validate:"max=255"`
+	Description string     `json:"description,omitempty" validate:"max=255"`
+	Root        bool       `json:"root,omitempty"`
+	// MaxUploadSize is the max size of a single upload in bytes, 0 for unlimited
+	MaxUploadSize int `json:"max_upload_size,omitempty"`
+	// UploadMimeGroups limits the uploads to these mime groups, empty for all groups
+	UploadMimeGroups []string `json:"upload_mime_groups,omitempty"`
+	// StorageQuota is the total size of the uploads in bytes, 0 for unlimited
+	StorageQuota int           `json:"storage_quota,omitempty"`
+	Users        []*User       `json:"users,omitempty"`
+	Permissions  []*Permission `json:"permissions,omitempty"`
 }
 
 type PermType string
@@ -45,11 +51,37 @@ type RoleMutation struct {
 	Description string             `form:"description" json:"description"`
 	Root        bool               `form:"root" json:"root"`
 	Permissions []*PermissionValue `form:"permissions" json:"permissions"`
+	// MaxUploadSize and StorageQuota are entered in megabytes
+	MaxUploadSize    int      `form:"max_upload_size" json:"max_upload_size"`
+	UploadMimeGroups []string `form:"upload_mime_groups" json:"upload_mime_groups"`
+	StorageQuota     int      `form:"storage_quota" json:"storage_quota"`
 }
 
 type PermissionValue struct {
-	Action string   `json:"action,omitempty" validate:"max=255"`
-	Value  PermType `json:"value,omitempty"`
+	Action   string   `json:"action,omitempty" validate:"max=255"`
+	Value    PermType `json:"value,omitempty"`
+	TopicIDs []int    `json:"topic_ids,omitempty"`
+}
+
+// InTopics reports whether the permission applies to a resource in the given topics.
+// A permission without topics applies to everything, otherwise the resource must have
+// at least one topic and all of its topics must be in the permission topics.
+func (p *PermissionValue) InTopics(topicIDs []int) bool {
+	if len(p.TopicIDs) == 0 {
+		return true
+	}
+
+	if len(topicIDs) == 0 {
+		return false
+	}
+
+	for _, topicID := range topicIDs {
+		if !utils.SliceContains(p.TopicIDs, topicID) {
+			return false
+		}
+	}
+
+	return true
 }
 
 type RolePermissions struct {
@@ -12,27 +12,30 @@ import (
 
 // User is the model entity for the User schema.
 type User struct {
-	ID               int        `json:"id,omitempty" form:"id"`
-	CreatedAt        *time.Time `json:"created_at,omitempty" form:"created_at"`
-	UpdatedAt        *time.Time `json:"updated_at,omitempty" form:"updated_at"`
-	DeletedAt        *time.Time `json:"deleted_at,omitempty" form:"deleted_at"`
-	Username         string     `json:"username,omitempty" form:"username"`
-	DisplayName      string     `json:"display_name,omitempty" form:"display_name"`
-	URL              string     `json:"url,omitempty" form:"url"`
-	Provider         string     `json:"provider,omitempty" form:"provider"`
-	ProviderID       string     `json:"provider_id,omitempty" form:"provider_id"`
-	ProviderUsername string     `json:"provider_username,omitempty" form:"provider_username"`
-	ProviderAvatar   string     `json:"provider_avatar,omitempty" form:"provider_avatar"`
-	Email            string     `json:"email,omitempty" form:"email"`
-	Password         string     `json:"password,omitempty" form:"password"`
-	Bio              string     `json:"bio,omitempty" form:"bio"`
-	BioHTML          string     `json:"bio_html,omitempty" form:"bio_html"`
-	RoleIDs          []int      `json:"role_ids,omitempty" form:"role_ids"`
-	Roles            []*Role    `json:"roles,omitempty" form:"roles"`
-	Active           bool       `json:"active,omitempty" form:"active"`
-	AvatarImage      *File      `json:"avatar_image,omitempty" form:"avatar_image"`
-	AvatarImageID    int        `json:"avatar_image_id,omitempty" form:"avatar_image_id"`
-	AvatarImageUrl   string     `json:"avatar_image_url,omitempty" form:"avatar_image_url"`
+	ID               int               `json:"id,omitempty" form:"id"`
+	CreatedAt        *time.Time        `json:"created_at,omitempty" form:"created_at"`
+	UpdatedAt        *time.Time        `json:"updated_at,omitempty" form:"updated_at"`
+	DeletedAt        *time.Time        `json:"deleted_at,omitempty" form:"deleted_at"`
+	Username         string            `json:"username,omitempty" form:"username"`
+	DisplayName      string            `json:"display_name,omitempty" form:"display_name"`
+	URL              string            `json:"url,omitempty" form:"url"`
+	Provider         string            `json:"provider,omitempty" form:"provider"`
+	ProviderID       string            `json:"provider_id,omitempty" form:"provider_id"`
+	ProviderUsername string            `json:"provider_username,omitempty" form:"provider_username"`
+	ProviderAvatar   string            `json:"provider_avatar,omitempty" form:"provider_avatar"`
+	Email            string            `json:"email,omitempty" form:"email"`
+	Password         string            `json:"password,omitempty" form:"password"`
+	Bio              string            `json:"bio,omitempty" form:"bio"`
+	BioHTML          string            `json:"bio_html,omitempty" form:"bio_html"`
+	RoleIDs          []int             `json:"role_ids,omitempty" form:"role_ids"`
+	Roles            []*Role           `json:"roles,omitempty" form:"roles"`
+	Active           bool              `json:"active,omitempty" form:"active"`
+	AvatarImage      *File             `json:"avatar_image,omitempty" form:"avatar_image"`
+	AvatarImageID    int               `json:"avatar_image_id,omitempty" form:"avatar_image_id"`
+	AvatarImageUrl   string            `json:"avatar_image_url,omitempty" form:"avatar_image_url"`
+	InviteID         int               `json:"invite_id,omitempty" form:"invite_id"`
+	Invite           *Invite           `json:"invite,omitempty" form:"invite"`
+	CustomFields     map[string]string `json:"custom_fields,omitempty" form:"-"`
 }
 
 type UserMutation struct {
@@ -109,6 +112,11 @@ func (u *User) JwtClaim(exp time.Time, jwtHeaders ...map[string]interface{}) (st
 	return token.SignedString([]byte(config.APP_KEY))
 }
 
+// Field returns the value of a custom field
+func (u *User) Field(name string) string {
+	return u.CustomFields[name]
+}
+
 func (u *User) IsRoot() bool {
 	if u == nil {
 		return false
@@ -125,6 +133,63 @@ func (u *User) IsRoot() bool {
 	return false
 }
 
+// UploadLimits are the upload limits of a user, zero and empty values are unlimited
+type UploadLimits struct {
+	MaxFileSize  int
+	MimeGroups   []string
+	StorageQuota int
+}
+
+// UploadLimits combines the upload limits of the user roles, the most permissive value of
+// each limit wins and root users have no limits
+func (u *User) UploadLimits() *UploadLimits {
+	limits := &UploadLimits{}
+
+	if u == nil || len(u.Roles) == 0 || u.IsRoot() {
+		return limits
+	}
+
+	unlimitedSize, unlimitedMimes, unlimitedQuota := false, false, false
+
+	for _, role := range u.Roles {
+		if role.MaxUploadSize == 0 {
+			unlimitedSize = true
+		} else if role.MaxUploadSize > limits.MaxFileSize {
+			limits.MaxFileSize = role.MaxUploadSize
+		}
+
+		if len(role.UploadMimeGroups) == 0 {
+			unlimitedMimes = true
+		} else {
+			for _, group := range role.UploadMimeGroups {
+				if !utils.SliceContains(limits.MimeGroups, group) {
+					limits.MimeGroups = append(limits.MimeGroups, group)
+				}
+			}
+		}
+
+		if role.StorageQuota == 0 {
+			unlimitedQuota = true
+		} else if role.StorageQuota > limits.StorageQuota {
+			limits.StorageQuota = role.StorageQuota
+		}
+	}
+
+	if unlimitedSize {
+		limits.MaxFileSize = 0
+	}
+
+	if unlimitedMimes {
+		limits.MimeGroups = nil
+	}
+
+	if unlimitedQuota {
+		limits.StorageQuota = 0
+	}
+
+	return limits
+}
+
 func (u *User) Name() string {
 	if u == nil {
 		return ""
@@ -147,7 +212,7 @@ func (u *User) Avatar() string {
 		return ""
 	}
 	if u.AvatarImage != nil && u.AvatarImage.ID > 0 {
-		return u.AvatarImage.Url()
+		return u.AvatarImage.Url("thumbnail")
 	}
 
 	if u.ProviderAvatar != "" {
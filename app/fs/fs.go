@@ -12,6 +12,7 @@ type FSDisk interface {
 	Name() string
 	Url(filepath string) string
 	Delete(ctx context.Context, filepath string) error
+	Open(ctx context.Context, filepath string) (io.ReadCloser, error)
 	Put(ctx context.Context, in io.Reader, size int64, mime, dst string) (*FileInfo, error)
 	PutMultipart(ctx context.Context, m *multipart.FileHeader, dsts ...string) (*FileInfo, error)
 }
@@ -21,6 +22,17 @@ type FileInfo struct {
 	Path string `json:"path,omitempty"`
 	Type string `json:"type,omitempty"`
 	Size int    `json:"size,omitempty"`
+	Hash string `json:"hash,omitempty"`
+}
+
+// ImageVariant is a resized copy of an image, stored on the disk of the original
+type ImageVariant struct {
+	Name   string `json:"name"`
+	Path   string `json:"path"`
+	Type   string `json:"type"`
+	Size   int    `json:"size"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
 }
 
 type DiskConfig struct {
@@ -36,6 +48,20 @@ type DiskConfig struct {
 	AccessKeyID     string        `json:"access_key_id"`
 	SecretAccessKey string        `json:"secret_access_key"`
 	ACL             string        `json:"acl"`
+	// Connection settings of the sftp and webdav drivers
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	User     string `json:"user"`
+	Password string `json:"password"`
+	KeyFile  string `json:"key_file"`
+	Url      string `json:"url"`
+	Vendor   string `json:"vendor"`
+	// Options are passed as is to the rclone backend of the driver,
+	// any rclone backend type can be used as the driver of a disk
+	Options map[string]string `json:"options"`
+	// Private disks can only be accessed with signed urls, they are signed with SignKey
+	Private bool   `json:"private"`
+	SignKey string `json:"-"`
 }
 
 type StorageConfig struct {
@@ -43,7 +69,13 @@ type StorageConfig struct {
 	DiskConfigs []*DiskConfig `json:"disks"`
 }
 
+// UploadFilter can replace the content of an uploaded file before it's stored,
+// e.g. to strip the image metadata. A filter returns the input unchanged
+// when it doesn't handle the mime type.
+type UploadFilter func(mime string, in io.Reader, size int64) (io.Reader, int64, error)
+
 var fsDisks []FSDisk
+var uploadFilters []UploadFilter
 
 func New(defaultDisk string, disks []FSDisk) {
 	fsDisks = append(fsDisks, disks...)
@@ -77,3 +109,26 @@ func Disk(names ...string) FSDisk {
 
 	return nil
 }
+
+// AddUploadFilter registers a filter that is applied to the files uploaded with PutMultipart
+func AddUploadFilter(filter UploadFilter) {
+	uploadFilters = append(uploadFilters, filter)
+}
+
+// FilterUpload applies the upload filters in the order they were added
+func FilterUpload(mime string, in io.Reader, size int64) (io.Reader, int64, error) {
+	var err error
+
+	for _, filter := range uploadFilters {
+		if in, size, err = filter(mime, in, size); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return in, size, nil
+}
+
+// Disks returns all the registered disks
+func Disks() []FSDisk {
+	return fsDisks
+}
@@ -1,7 +1,10 @@
 package fs_test
 
 import (
+	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/ngocphuongnb/tetua/app/fs"
 	"github.com/ngocphuongnb/tetua/app/mock"
@@ -38,3 +41,85 @@ func TestNew(t *testing.T) {
 	assert.Equal(t, nil, fs.Disk("disk_mock_test"))
 
 }
+
+func TestAllowedMimes(t *testing.T) {
+	ctx := context.Background()
+	all := fs.GroupMimes(fs.MimeGroupNames...)
+	assert.Contains(t, all, "image/png")
+	assert.Contains(t, all, "application/pdf")
+	assert.Equal(t, all, fs.AllowedMimes(ctx))
+	assert.Equal(t, fs.MimeGroups["image"], fs.GroupMimes("image", "unknown"))
+
+	limited := fs.WithAllowedMimes(ctx, fs.GroupMimes("audio"))
+	assert.Contains(t, fs.AllowedMimes(limited), "audio/mpeg")
+	assert.NotContains(t, fs.AllowedMimes(limited), "image/png")
+}
+
+func TestDetectContentType(t *testing.T) {
+	svg := []byte(`<svg xmlns="http://www.w3.org/2000/svg"><rect width="1" height="1"/></svg>`)
+	assert.Equal(t, "image/svg+xml", fs.DetectContentType(svg, "icon.svg"))
+	assert.Equal(t, "image/svg+xml", fs.DetectContentType(svg, "icon.txt"))
+	assert.Equal(t, "image/svg+xml", fs.DetectContentType(append([]byte(`<?xml version="1.0"?>`), svg...), "icon"))
+	assert.Equal(t, "image/svg+xml", fs.DetectContentType([]byte("<!-- icon -->"), "icon.SVG"))
+	assert.Equal(t, "image/svg+xml", fs.DetectContentType([]byte("plain text"), "icon.svg"))
+	assert.Equal(t, "text/xml; charset=utf-8", fs.DetectContentType([]byte(`<?xml version="1.0"?><feed></feed>`), "feed.xml"))
+	assert.Equal(t, "image/png", fs.DetectContentType([]byte("\x89PNG\r\n\x1a\n"), "image.svg"))
+}
+
+func TestDetectXMLContentType(t *testing.T) {
+	padding := "<!-- " + strings.Repeat("padding ", 100) + "-->"
+	feed := `<?xml version="1.0"?>` + padding + `<feed><title>a</title></feed>`
+	svg := `<?xml version="1.0"?>` + padding + `<svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script></svg>`
+	assert.Equal(t, "text/xml; charset=utf-8", fs.DetectContentType([]byte(svg)[:512], "icon.xml"))
+
+	mime, err := fs.DetectXMLContentType([]byte(feed), "text/xml; charset=utf-8")
+	assert.NoError(t, err)
+	assert.Equal(t, "text/xml; charset=utf-8", mime)
+
+	mime, err = fs.DetectXMLContentType([]byte(svg), "text/xml; charset=utf-8")
+	assert.NoError(t, err)
+	assert.Equal(t, "image/svg+xml", mime)
+
+	refused := []string{
+		`<feed><script xmlns="http://www.w3.org/1999/xhtml">alert(1)</script></feed>`,
+		`<feed xmlns:s="http://www.w3.org/2000/svg"><s:svg><s:script>alert(1)</s:script></s:svg></feed>`,
+		`<html xmlns="http://www.w3.org/1999/xhtml"><body></body></html>`,
+		`<?xml-stylesheet type="text/xsl" href="evil.xsl"?><feed></feed>`,
+		`<!DOCTYPE feed [<!ENTITY x "<script/>">]><feed>&x;</feed>`,
+		`<feed><title>unclosed</feed>`,
+	}
+
+	for _, data := range refused {
+		_, err = fs.DetectXMLContentType([]byte(data), "text/xml; charset=utf-8")
+		assert.ErrorIs(t, err, fs.ErrFileTypeNotAllowed, data)
+	}
+}
+
+func TestSignedUrl(t *testing.T) {
+	ctx := context.Background()
+	expires := time.Now().Add(time.Hour).Unix()
+	signature := fs.Sign("key", "disk", "a/b.pdf", expires)
+
+	assert.Equal(t, true, fs.VerifySignature("key", "disk", "a/b.pdf", expires, signature))
+	assert.Equal(t, false, fs.VerifySignature("key", "disk", "a/c.pdf", expires, signature))
+	assert.Equal(t, false, fs.VerifySignature("key", "other", "a/b.pdf", expires, signature))
+	assert.Equal(t, false, fs.VerifySignature("key", "disk", "a/b.pdf", expires+1, signature))
+	assert.Equal(t, false, fs.VerifySignature("other", "disk", "a/b.pdf", expires, signature))
+	assert.Equal(t, false, fs.VerifySignature("", "disk", "a/b.pdf", expires, fs.Sign("", "disk", "a/b.pdf", expires)))
+	expired := time.Now().Add(-time.Minute).Unix()
+	assert.Equal(t, false, fs.VerifySignature("key", "disk", "a/b.pdf", expired, fs.Sign("key", "disk", "a/b.pdf", expired)))
+
+	_, err := fs.SignedUrl(ctx, &mock.Disk{}, "a/b.pdf", time.Hour)
+	assert.Equal(t, fs.ErrSignedUrlNotSupported, err)
+	assert.Equal(t, false, fs.IsPrivate(&mock.Disk{}))
+
+	privateDisk := &mock.PrivateDisk{Disk: &mock.Disk{}, SignKey: "key"}
+	assert.Equal(t, true, fs.IsPrivate(privateDisk))
+	signedUrl, err := fs.SignedUrl(ctx, privateDisk, "a/b.pdf", time.Hour)
+	assert.NoError(t, err)
+	assert.Contains(t, signedUrl, "/files/private/disk_private/a/b.pdf?expires=")
+
+	assert.Equal(t, nil, fs.PrivateDisk())
+	fs.New("disk_mock", []fs.FSDisk{privateDisk})
+	assert.Equal(t, privateDisk, fs.PrivateDisk())
+}
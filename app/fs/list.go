@@ -0,0 +1,25 @@
+package fs
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrListNotSupported is returned by List when the disk can't list its objects
+var ErrListNotSupported = errors.New("the disk doesn't support listing")
+
+// ListDisk is implemented by the disks that can list their objects
+type ListDisk interface {
+	// List returns the paths of the objects under the directory and its subdirectories,
+	// a directory that doesn't exist has no objects
+	List(ctx context.Context, dir string) ([]string, error)
+}
+
+// List returns the paths of the objects under a directory of the disk
+func List(ctx context.Context, disk FSDisk, dir string) ([]string, error) {
+	if listDisk, ok := disk.(ListDisk); ok {
+		return listDisk.List(ctx, dir)
+	}
+
+	return nil, ErrListNotSupported
+}
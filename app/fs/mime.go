@@ -0,0 +1,167 @@
+package fs
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// ErrFileTypeNotAllowed is returned by PutMultipart when the mime type of the upload isn't allowed
+var ErrFileTypeNotAllowed = errors.New("file type is not allowed")
+
+// MimeGroupNames are the names of the mime groups in display order
+var MimeGroupNames = []string{"image", "video", "audio", "document"}
+
+// MimeGroups are the mime types that can be uploaded, grouped by kind
+var MimeGroups = map[string][]string{
+	"image": {
+		"image/svg+xml",
+		"image/jpeg",
+		"image/pjpeg",
+		"image/png",
+		"image/gif",
+		"image/x-icon",
+	},
+	"video": {
+		"video/x-mpeg",
+		"video/mp4",
+		"video/x-m4v",
+		"video/quicktime",
+		"video/x-ms-asf",
+		"video/x-ms-wmv",
+		"application/x-troff-msvideo",
+		"video/avi",
+		"video/msvideo",
+		"video/x-msvideo",
+		"video/mpeg",
+		"video/ogg",
+		"video/3gpp",
+		"video/3gpp2",
+	},
+	"audio": {
+		"audio/mpeg3",
+		"audio/x-mpeg-3",
+		"audio/m4a",
+		"audio/ogg",
+		"audio/wav",
+		"audio/x-wav",
+		"audio/mpeg",
+		"audio/3gpp",
+		"audio/3gpp2",
+	},
+	"document": {
+		"text/xml",
+		"text/xml; charset=utf-8",
+		"application/pdf",
+		"application/msword",
+		"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+		"application/powerpoint",
+		"application/x-mspowerpoint",
+		"application/vnd.openxmlformats-officedocument.presentationml.presentation",
+		"application/mspowerpoint",
+		"application/vnd.ms-powerpoint",
+		"application/vnd.openxmlformats-officedocument.presentationml.slideshow",
+		"application/vnd.oasis.opendocument.text",
+		"application/excel",
+		"application/vnd.ms-excel",
+		"application/x-excel",
+		"application/x-msexcel",
+		"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	},
+}
+
+const (
+	svgNamespace   = "http://www.w3.org/2000/svg"
+	xhtmlNamespace = "http://www.w3.org/1999/xhtml"
+)
+
+type allowedMimesKey struct{}
+
+// DetectContentType returns the mime type of an upload from the first bytes of its content, see http.DetectContentType.
+// The content sniffing detects SVG images as xml, html or plain text, so the textual uploads that have an svg element
+// or the .svg extension are detected as SVG images and can be sanitized.
+func DetectContentType(data []byte, filename string) string {
+	mime := http.DetectContentType(data)
+
+	switch strings.Split(mime, ";")[0] {
+	case "text/xml", "text/html", "text/plain":
+		if strings.EqualFold(path.Ext(filename), ".svg") || bytes.Contains(bytes.ToLower(data), []byte("<svg")) {
+			return "image/svg+xml"
+		}
+	}
+
+	return mime
+}
+
+// DetectXMLContentType checks the whole content of an upload that is detected as xml, the svg element can be anywhere
+// after a long prolog so DetectContentType can't see it. The documents with an svg root element are SVG images
+// and are sanitized like them. The documents that a browser could run scripts of, with svg or xhtml elements,
+// stylesheets or entity declarations, and the documents that can't be parsed are refused with ErrFileTypeNotAllowed.
+func DetectXMLContentType(data []byte, mime string) (string, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	root := true
+
+	for {
+		token, err := decoder.Token()
+
+		if err == io.EOF {
+			return mime, nil
+		}
+
+		if err != nil {
+			return "", ErrFileTypeNotAllowed
+		}
+
+		switch token := token.(type) {
+		case xml.ProcInst:
+			if token.Target == "xml-stylesheet" {
+				return "", ErrFileTypeNotAllowed
+			}
+		case xml.Directive:
+			if bytes.Contains(bytes.ToUpper(token), []byte("ENTITY")) {
+				return "", ErrFileTypeNotAllowed
+			}
+		case xml.StartElement:
+			if root && (token.Name.Space == svgNamespace || strings.EqualFold(token.Name.Local, "svg")) {
+				return "image/svg+xml", nil
+			}
+
+			if token.Name.Space == svgNamespace || token.Name.Space == xhtmlNamespace {
+				return "", ErrFileTypeNotAllowed
+			}
+
+			root = false
+		}
+	}
+}
+
+// GroupMimes returns the mime types of the groups, unknown groups are ignored
+func GroupMimes(groups ...string) []string {
+	mimes := make([]string, 0)
+
+	for _, group := range groups {
+		mimes = append(mimes, MimeGroups[group]...)
+	}
+
+	return mimes
+}
+
+// WithAllowedMimes returns a context that limits the mime types accepted by PutMultipart
+func WithAllowedMimes(ctx context.Context, mimes []string) context.Context {
+	return context.WithValue(ctx, allowedMimesKey{}, mimes)
+}
+
+// AllowedMimes returns the mime types set with WithAllowedMimes,
+// or all the mime types of the groups when the context has none
+func AllowedMimes(ctx context.Context) []string {
+	if mimes, ok := ctx.Value(allowedMimesKey{}).([]string); ok {
+		return mimes
+	}
+
+	return GroupMimes(MimeGroupNames...)
+}
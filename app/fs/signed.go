@@ -0,0 +1,63 @@
+package fs
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrSignedUrlNotSupported is returned by SignedUrl when the disk can't create signed urls
+var ErrSignedUrlNotSupported = errors.New("the disk doesn't support signed urls")
+
+// SignedUrlDisk is implemented by the disks that can create signed, expiring urls
+type SignedUrlDisk interface {
+	// Private reports whether the files of the disk can only be accessed with signed urls
+	Private() bool
+	SignedUrl(ctx context.Context, filepath string, expiry time.Duration) (string, error)
+}
+
+// SignedUrl returns a url of the file that expires after the duration
+func SignedUrl(ctx context.Context, disk FSDisk, filepath string, expiry time.Duration) (string, error) {
+	if signedUrlDisk, ok := disk.(SignedUrlDisk); ok {
+		return signedUrlDisk.SignedUrl(ctx, filepath, expiry)
+	}
+
+	return "", ErrSignedUrlNotSupported
+}
+
+// IsPrivate reports whether the files of the disk can only be accessed with signed urls
+func IsPrivate(disk FSDisk) bool {
+	signedUrlDisk, ok := disk.(SignedUrlDisk)
+	return ok && signedUrlDisk.Private()
+}
+
+// Sign returns the HMAC-SHA256 signature of a file of a disk and the unix time the signature expires at
+func Sign(key, disk, filepath string, expires int64) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(fmt.Sprintf("%s\n%s\n%d", disk, filepath, expires)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports whether the signature of a file is valid and hasn't expired
+func VerifySignature(key, disk, filepath string, expires int64, signature string) bool {
+	if key == "" || expires < time.Now().Unix() {
+		return false
+	}
+
+	return hmac.Equal([]byte(Sign(key, disk, filepath, expires)), []byte(signature))
+}
+
+// PrivateDisk returns the first registered private disk or nil
+func PrivateDisk() FSDisk {
+	for _, disk := range fsDisks {
+		if IsPrivate(disk) {
+			return disk
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package imaging
+
+import (
+	"errors"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"sync"
+
+	"golang.org/x/image/draw"
+)
+
+// EncodeFn writes the image to w in the format of the encoder,
+// quality is in the range 1-100 and can be ignored by lossless formats
+type EncodeFn func(w io.Writer, img image.Image, quality int) error
+
+var ErrUnsupportedFormat = errors.New("unsupported image format")
+
+var encoders = map[string]EncodeFn{
+	"image/jpeg": func(w io.Writer, img image.Image, quality int) error {
+		return jpeg.Encode(w, img, &jpeg.Options{Quality: quality})
+	},
+	"image/png": func(w io.Writer, img image.Image, quality int) error {
+		return png.Encode(w, img)
+	},
+	"image/gif": func(w io.Writer, img image.Image, quality int) error {
+		return gif.Encode(w, img, nil)
+	},
+}
+var encodersMu sync.RWMutex
+
+// Extensions maps the supported mime types to their file extensions
+var Extensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// RegisterEncoder adds or replaces the encoder of a mime type,
+// e.g. a WebP encoder since the standard library can only decode it
+func RegisterEncoder(mime string, fn EncodeFn) {
+	encodersMu.Lock()
+	defer encodersMu.Unlock()
+	encoders[mime] = fn
+}
+
+// CanEncode reports whether an encoder is registered for the mime type
+func CanEncode(mime string) bool {
+	encodersMu.RLock()
+	defer encodersMu.RUnlock()
+	_, ok := encoders[mime]
+	return ok
+}
+
+// Encode writes the image in the format of the mime type
+func Encode(w io.Writer, img image.Image, mime string, quality int) error {
+	encodersMu.RLock()
+	fn, ok := encoders[mime]
+	encodersMu.RUnlock()
+
+	if !ok {
+		return ErrUnsupportedFormat
+	}
+
+	if quality < 1 || quality > 100 {
+		quality = jpeg.DefaultQuality
+	}
+
+	return fn(w, img, quality)
+}
+
+// Fit returns the size of a width x height image scaled to fit into maxWidth x maxHeight,
+// a zero max dimension is not limited and images are never upscaled
+func Fit(width, height, maxWidth, maxHeight int) (int, int) {
+	if width <= 0 || height <= 0 {
+		return 0, 0
+	}
+
+	scale := 1.0
+
+	if maxWidth > 0 && width > maxWidth {
+		scale = float64(maxWidth) / float64(width)
+	}
+
+	if maxHeight > 0 && float64(height)*scale > float64(maxHeight) {
+		scale = float64(maxHeight) / float64(height)
+	}
+
+	return max(1, int(float64(width)*scale+0.5)), max(1, int(float64(height)*scale+0.5))
+}
+
+// Resize scales the image to fit into width x height, when crop is set the image
+// is scaled to cover the whole box and the overflow is cut from the center
+func Resize(img image.Image, width, height int, crop bool) image.Image {
+	bounds := img.Bounds()
+
+	if !crop || width <= 0 || height <= 0 {
+		w, h := Fit(bounds.Dx(), bounds.Dy(), width, height)
+		return scale(img, bounds, w, h)
+	}
+
+	width = min(width, bounds.Dx())
+	height = min(height, bounds.Dy())
+	src := bounds
+
+	// cut the source to the aspect ratio of the box before scaling it down
+	if bounds.Dx()*height > bounds.Dy()*width {
+		w := bounds.Dy() * width / height
+		src.Min.X += (bounds.Dx() - w) / 2
+		src.Max.X = src.Min.X + w
+	} else {
+		h := bounds.Dx() * height / width
+		src.Min.Y += (bounds.Dy() - h) / 2
+		src.Max.Y = src.Min.Y + h
+	}
+
+	return scale(img, src, width, height)
+}
+
+func scale(img image.Image, src image.Rectangle, width, height int) image.Image {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, src, draw.Src, nil)
+	return dst
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
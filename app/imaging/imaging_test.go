@@ -0,0 +1,81 @@
+package imaging_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"testing"
+
+	"github.com/ngocphuongnb/tetua/app/imaging"
+	"github.com/stretchr/testify/assert"
+)
+
+func createImage(width, height int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, color.RGBA{uint8(x), uint8(y), 0, 255})
+		}
+	}
+
+	return img
+}
+
+func TestFit(t *testing.T) {
+	w, h := imaging.Fit(2000, 1000, 300, 300)
+	assert.Equal(t, []int{300, 150}, []int{w, h})
+
+	w, h = imaging.Fit(1000, 2000, 1024, 1024)
+	assert.Equal(t, []int{512, 1024}, []int{w, h})
+
+	w, h = imaging.Fit(2000, 1000, 500, 0)
+	assert.Equal(t, []int{500, 250}, []int{w, h})
+
+	w, h = imaging.Fit(200, 100, 300, 300)
+	assert.Equal(t, []int{200, 100}, []int{w, h})
+
+	w, h = imaging.Fit(0, 100, 300, 300)
+	assert.Equal(t, []int{0, 0}, []int{w, h})
+}
+
+func TestResize(t *testing.T) {
+	img := createImage(400, 200)
+
+	resized := imaging.Resize(img, 100, 100, false)
+	assert.Equal(t, image.Rect(0, 0, 100, 50), resized.Bounds())
+
+	cropped := imaging.Resize(img, 100, 100, true)
+	assert.Equal(t, image.Rect(0, 0, 100, 100), cropped.Bounds())
+
+	// the crop box is never larger than the image
+	cropped = imaging.Resize(img, 300, 300, true)
+	assert.Equal(t, image.Rect(0, 0, 300, 200), cropped.Bounds())
+
+	cropped = imaging.Resize(createImage(100, 400), 50, 50, true)
+	assert.Equal(t, image.Rect(0, 0, 50, 50), cropped.Bounds())
+}
+
+func TestEncode(t *testing.T) {
+	img := createImage(20, 10)
+
+	for _, mime := range []string{"image/jpeg", "image/png", "image/gif"} {
+		buf := &bytes.Buffer{}
+		assert.Equal(t, nil, imaging.Encode(buf, img, mime, 0))
+		cfg, _, err := image.DecodeConfig(buf)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, 20, cfg.Width)
+	}
+
+	assert.False(t, imaging.CanEncode("image/bmp"))
+	assert.Equal(t, imaging.ErrUnsupportedFormat, imaging.Encode(&bytes.Buffer{}, img, "image/bmp", 80))
+
+	imaging.RegisterEncoder("image/bmp", func(w io.Writer, img image.Image, quality int) error {
+		assert.Equal(t, 80, quality)
+		return png.Encode(w, img)
+	})
+	assert.True(t, imaging.CanEncode("image/bmp"))
+	assert.Equal(t, nil, imaging.Encode(&bytes.Buffer{}, img, "image/bmp", 80))
+}
@@ -0,0 +1,344 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+)
+
+// reencodeQuality is the JPEG quality of the images that are re-encoded to apply their orientation
+const reencodeQuality = 95
+
+var ErrInvalidImage = errors.New("invalid image data")
+
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+// pngKeepChunks are the PNG chunks needed to render the image, the others like
+// eXIf, tEXt, zTXt, iTXt and tIME are removed
+var pngKeepChunks = map[string]bool{
+	"IHDR": true, "PLTE": true, "IDAT": true, "IEND": true,
+	"tRNS": true, "cHRM": true, "gAMA": true, "iCCP": true, "sBIT": true, "sRGB": true,
+	"bKGD": true, "pHYs": true, "acTL": true, "fcTL": true, "fdAT": true,
+}
+
+// StripMetadata removes the metadata of a JPEG or PNG image, e.g. EXIF with the GPS location
+// and camera serials, XMP, IPTC and comments. Only the data needed to render the image is kept:
+// the JFIF and Adobe segments and the ICC color profile. The EXIF orientation is applied to the
+// pixels, so images that aren't upright are re-encoded. Other mime types are returned unchanged.
+func StripMetadata(data []byte, mime string) ([]byte, error) {
+	switch mime {
+	case "image/jpeg", "image/pjpeg":
+		return stripJpeg(data)
+	case "image/png":
+		return stripPng(data)
+	}
+
+	return data, nil
+}
+
+// CanStripMetadata reports whether StripMetadata handles the mime type
+func CanStripMetadata(mime string) bool {
+	return mime == "image/jpeg" || mime == "image/pjpeg" || mime == "image/png"
+}
+
+// Orientation returns the EXIF orientation of a JPEG or PNG image, 1 when it's upright or unknown
+func Orientation(data []byte, mime string) int {
+	switch mime {
+	case "image/jpeg", "image/pjpeg":
+		segments, _, err := jpegSegments(data)
+
+		if err != nil {
+			return 1
+		}
+
+		for _, segment := range segments {
+			if len(segment) > 10 && segment[1] == 0xE1 && bytes.HasPrefix(segment[4:], []byte("Exif\x00\x00")) {
+				return exifOrientation(segment[10:])
+			}
+		}
+	case "image/png":
+		chunks, err := pngChunks(data)
+
+		if err != nil {
+			return 1
+		}
+
+		for _, chunk := range chunks {
+			if string(chunk[4:8]) == "eXIf" {
+				return exifOrientation(chunk[8 : len(chunk)-4])
+			}
+		}
+	}
+
+	return 1
+}
+
+// Orient transforms the image so it's upright according to its EXIF orientation
+func Orient(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	dw, dh := w, h
+
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			sx, sy := x, y
+
+			switch orientation {
+			case 2:
+				sx = w - 1 - x
+			case 3:
+				sx, sy = w-1-x, h-1-y
+			case 4:
+				sy = h - 1 - y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, h-1-x
+			case 7:
+				sx, sy = w-1-y, h-1-x
+			case 8:
+				sx, sy = w-1-y, x
+			}
+
+			dst.Set(x, y, img.At(bounds.Min.X+sx, bounds.Min.Y+sy))
+		}
+	}
+
+	return dst
+}
+
+func stripJpeg(data []byte) ([]byte, error) {
+	segments, scan, err := jpegSegments(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	kept := [][]byte{}
+
+	for _, segment := range segments {
+		if keepJpegSegment(segment) {
+			kept = append(kept, segment)
+		}
+	}
+
+	if orientation := Orientation(data, "image/jpeg"); orientation > 1 {
+		img, err := jpeg.Decode(bytes.NewReader(data))
+
+		if err != nil {
+			return nil, err
+		}
+
+		buf := &bytes.Buffer{}
+
+		if err := jpeg.Encode(buf, Orient(img, orientation), &jpeg.Options{Quality: reencodeQuality}); err != nil {
+			return nil, err
+		}
+
+		// the encoder writes its own tables, only the color profile is copied to the new image
+		out := []byte{0xFF, 0xD8}
+		for _, segment := range kept {
+			if segment[1] == 0xE2 {
+				out = append(out, segment...)
+			}
+		}
+
+		return append(out, buf.Bytes()[2:]...), nil
+	}
+
+	out := []byte{0xFF, 0xD8}
+	for _, segment := range kept {
+		out = append(out, segment...)
+	}
+
+	return append(out, scan...), nil
+}
+
+// jpegSegments splits a JPEG image into the marker segments before the image data and the
+// image data itself, starting with the start of scan segment
+func jpegSegments(data []byte) ([][]byte, []byte, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, nil, ErrInvalidImage
+	}
+
+	segments := [][]byte{}
+	i := 2
+
+	for i+4 <= len(data) {
+		if data[i] != 0xFF {
+			return nil, nil, ErrInvalidImage
+		}
+
+		marker := data[i+1]
+
+		if marker == 0xFF {
+			i++
+			continue
+		}
+
+		if marker == 0xDA || marker == 0xD9 {
+			return segments, data[i:], nil
+		}
+
+		if marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7) {
+			segments = append(segments, data[i:i+2])
+			i += 2
+			continue
+		}
+
+		end := i + 2 + int(binary.BigEndian.Uint16(data[i+2:i+4]))
+
+		if end > len(data) {
+			return nil, nil, ErrInvalidImage
+		}
+
+		segments = append(segments, data[i:end])
+		i = end
+	}
+
+	return nil, nil, ErrInvalidImage
+}
+
+func keepJpegSegment(segment []byte) bool {
+	if len(segment) < 4 {
+		return true
+	}
+
+	payload := segment[4:]
+
+	switch {
+	case segment[1] == 0xE0:
+		return bytes.HasPrefix(payload, []byte("JFIF\x00")) || bytes.HasPrefix(payload, []byte("JFXX\x00"))
+	case segment[1] == 0xE2:
+		return bytes.HasPrefix(payload, []byte("ICC_PROFILE\x00"))
+	case segment[1] == 0xEE:
+		return bytes.HasPrefix(payload, []byte("Adobe"))
+	case segment[1] > 0xE0 && segment[1] <= 0xEF, segment[1] == 0xFE:
+		return false
+	}
+
+	return true
+}
+
+func stripPng(data []byte) ([]byte, error) {
+	chunks, err := pngChunks(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if orientation := Orientation(data, "image/png"); orientation > 1 {
+		img, err := png.Decode(bytes.NewReader(data))
+
+		if err != nil {
+			return nil, err
+		}
+
+		buf := &bytes.Buffer{}
+
+		if err := png.Encode(buf, Orient(img, orientation)); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	}
+
+	out := append([]byte{}, pngSignature...)
+
+	for _, chunk := range chunks {
+		if pngKeepChunks[string(chunk[4:8])] {
+			out = append(out, chunk...)
+		}
+	}
+
+	return out, nil
+}
+
+// pngChunks splits a PNG image into its chunks, each chunk includes its length, type and crc
+func pngChunks(data []byte) ([][]byte, error) {
+	if !bytes.HasPrefix(data, pngSignature) {
+		return nil, ErrInvalidImage
+	}
+
+	chunks := [][]byte{}
+	i := len(pngSignature)
+
+	for i+12 <= len(data) {
+		end := i + 12 + int(binary.BigEndian.Uint32(data[i:i+4]))
+
+		if end > len(data) || end < i {
+			return nil, ErrInvalidImage
+		}
+
+		chunks = append(chunks, data[i:end])
+
+		if string(data[i+4:i+8]) == "IEND" {
+			return chunks, nil
+		}
+
+		i = end
+	}
+
+	return nil, ErrInvalidImage
+}
+
+// exifOrientation reads the orientation tag of the first IFD of an EXIF TIFF structure
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	if order.Uint16(tiff[2:4]) != 42 {
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:8]))
+
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+
+	count := int(order.Uint16(tiff[ifd : ifd+2]))
+
+	for n := 0; n < count; n++ {
+		entry := ifd + 2 + n*12
+
+		if entry+12 > len(tiff) {
+			break
+		}
+
+		if order.Uint16(tiff[entry:entry+2]) == 0x0112 {
+			if orientation := int(order.Uint16(tiff[entry+8 : entry+10])); orientation >= 1 && orientation <= 8 {
+				return orientation
+			}
+
+			break
+		}
+	}
+
+	return 1
+}
@@ -0,0 +1,184 @@
+package imaging_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/ngocphuongnb/tetua/app/imaging"
+	"github.com/stretchr/testify/assert"
+)
+
+func jpegSegment(marker byte, payload []byte) []byte {
+	segment := []byte{0xFF, marker, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+func pngChunk(chunkType string, data []byte) []byte {
+	chunk := make([]byte, 4)
+	binary.BigEndian.PutUint32(chunk, uint32(len(data)))
+	chunk = append(chunk, chunkType...)
+	chunk = append(chunk, data...)
+	crc := make([]byte, 4)
+	binary.BigEndian.PutUint32(crc, crc32.ChecksumIEEE(chunk[4:]))
+	return append(chunk, crc...)
+}
+
+// exifData returns a little endian TIFF structure with the orientation and a GPS IFD pointer tag
+func exifData(orientation uint16) []byte {
+	tiff := []byte("II\x2a\x00\x08\x00\x00\x00\x02\x00")
+	entry := make([]byte, 12)
+	binary.LittleEndian.PutUint16(entry[0:], 0x0112)
+	binary.LittleEndian.PutUint16(entry[2:], 3)
+	binary.LittleEndian.PutUint32(entry[4:], 1)
+	binary.LittleEndian.PutUint16(entry[8:], orientation)
+	tiff = append(tiff, entry...)
+	gps := make([]byte, 12)
+	binary.LittleEndian.PutUint16(gps[0:], 0x8825)
+	binary.LittleEndian.PutUint16(gps[2:], 4)
+	binary.LittleEndian.PutUint32(gps[4:], 1)
+	tiff = append(tiff, gps...)
+	return append(tiff, 0, 0, 0, 0)
+}
+
+// withJpegSegments inserts the segments after the start of image marker
+func withJpegSegments(data []byte, segments ...[]byte) []byte {
+	out := []byte{0xFF, 0xD8}
+	for _, segment := range segments {
+		out = append(out, segment...)
+	}
+	return append(out, data[2:]...)
+}
+
+// withPngChunks inserts the chunks after the IHDR chunk
+func withPngChunks(data []byte, chunks ...[]byte) []byte {
+	out := append([]byte{}, data[:33]...)
+	for _, chunk := range chunks {
+		out = append(out, chunk...)
+	}
+	return append(out, data[33:]...)
+}
+
+func createEncodedImage(t *testing.T, mime string, width, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	img.Set(0, 0, color.RGBA{255, 0, 0, 255})
+	buf := &bytes.Buffer{}
+
+	if mime == "image/png" {
+		assert.NoError(t, png.Encode(buf, img))
+	} else {
+		assert.NoError(t, jpeg.Encode(buf, img, nil))
+	}
+
+	return buf.Bytes()
+}
+
+func TestStripJpegMetadata(t *testing.T) {
+	original := createEncodedImage(t, "image/jpeg", 40, 20)
+	icc := jpegSegment(0xE2, []byte("ICC_PROFILE\x00\x01\x01profile"))
+	withMetadata := withJpegSegments(
+		original,
+		jpegSegment(0xE1, append([]byte("Exif\x00\x00"), exifData(1)...)),
+		jpegSegment(0xE1, []byte("http://ns.adobe.com/xap/1.0/\x00<x:xmpmeta/>")),
+		jpegSegment(0xED, []byte("Photoshop 3.0\x00IPTC")),
+		jpegSegment(0xFE, []byte("camera serial 123")),
+		icc,
+	)
+
+	stripped, err := imaging.StripMetadata(withMetadata, "image/jpeg")
+	assert.NoError(t, err)
+	assert.Equal(t, withJpegSegments(original, icc), stripped)
+	assert.False(t, bytes.Contains(stripped, []byte("Exif")))
+	assert.False(t, bytes.Contains(stripped, []byte("serial")))
+
+	// images without metadata are unchanged
+	stripped, err = imaging.StripMetadata(original, "image/jpeg")
+	assert.NoError(t, err)
+	assert.Equal(t, original, stripped)
+
+	rotated := withJpegSegments(original, jpegSegment(0xE1, append([]byte("Exif\x00\x00"), exifData(6)...)), icc)
+	assert.Equal(t, 6, imaging.Orientation(rotated, "image/jpeg"))
+	stripped, err = imaging.StripMetadata(rotated, "image/jpeg")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, imaging.Orientation(stripped, "image/jpeg"))
+	assert.True(t, bytes.HasPrefix(stripped, append([]byte{0xFF, 0xD8}, icc...)))
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(stripped))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{20, 40}, []int{cfg.Width, cfg.Height})
+
+	_, err = imaging.StripMetadata([]byte("not a jpeg"), "image/jpeg")
+	assert.Equal(t, imaging.ErrInvalidImage, err)
+	_, err = imaging.StripMetadata(original[:30], "image/jpeg")
+	assert.Equal(t, imaging.ErrInvalidImage, err)
+}
+
+func TestStripPngMetadata(t *testing.T) {
+	original := createEncodedImage(t, "image/png", 40, 20)
+	withMetadata := withPngChunks(
+		original,
+		pngChunk("tEXt", []byte("Author\x00John")),
+		pngChunk("eXIf", exifData(1)),
+		pngChunk("gAMA", []byte{0, 0, 0xB1, 0x8F}),
+	)
+
+	stripped, err := imaging.StripMetadata(withMetadata, "image/png")
+	assert.NoError(t, err)
+	assert.Equal(t, withPngChunks(original, pngChunk("gAMA", []byte{0, 0, 0xB1, 0x8F})), stripped)
+
+	rotated := withPngChunks(original, pngChunk("eXIf", exifData(8)))
+	assert.Equal(t, 8, imaging.Orientation(rotated, "image/png"))
+	stripped, err = imaging.StripMetadata(rotated, "image/png")
+	assert.NoError(t, err)
+	img, err := png.Decode(bytes.NewReader(stripped))
+	assert.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 20, 40), img.Bounds())
+	// the red top left pixel is moved to the bottom left by the counterclockwise rotation
+	r, _, _, _ := img.At(0, 39).RGBA()
+	assert.Equal(t, uint32(0xFFFF), r)
+
+	_, err = imaging.StripMetadata([]byte("not a png"), "image/png")
+	assert.Equal(t, imaging.ErrInvalidImage, err)
+
+	gif := []byte("GIF89a")
+	stripped, err = imaging.StripMetadata(gif, "image/gif")
+	assert.NoError(t, err)
+	assert.Equal(t, gif, stripped)
+	assert.False(t, imaging.CanStripMetadata("image/gif"))
+	assert.Equal(t, 1, imaging.Orientation(gif, "image/gif"))
+}
+
+func TestOrient(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	img.Set(0, 0, color.RGBA{255, 0, 0, 255})
+
+	assert.Equal(t, img, imaging.Orient(img, 1))
+	assert.Equal(t, img, imaging.Orient(img, 9))
+
+	cases := map[int]image.Point{
+		2: {2, 0},
+		3: {2, 1},
+		4: {0, 1},
+		5: {0, 0},
+		6: {1, 0},
+		7: {1, 2},
+		8: {0, 2},
+	}
+
+	for orientation, point := range cases {
+		oriented := imaging.Orient(img, orientation)
+		r, _, _, _ := oriented.At(point.X, point.Y).RGBA()
+		assert.Equal(t, uint32(0xFFFF), r, "orientation %d", orientation)
+
+		if orientation >= 5 {
+			assert.Equal(t, image.Rect(0, 0, 2, 3), oriented.Bounds())
+		} else {
+			assert.Equal(t, image.Rect(0, 0, 3, 2), oriented.Bounds())
+		}
+	}
+}
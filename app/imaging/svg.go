@@ -0,0 +1,315 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrUnsafeSVG is returned by SanitizeSVG when the image has content that can't be removed safely
+var ErrUnsafeSVG = errors.New("unsafe svg")
+
+// svgElements are the elements that are kept, other elements are removed with their content
+var svgElements = map[string]bool{
+	"svg": true, "g": true, "defs": true, "symbol": true, "use": true, "switch": true,
+	"title": true, "desc": true, "style": true, "image": true,
+	"path": true, "rect": true, "circle": true, "ellipse": true, "line": true, "polyline": true, "polygon": true,
+	"text": true, "tspan": true, "textPath": true,
+	"linearGradient": true, "radialGradient": true, "stop": true, "pattern": true,
+	"clipPath": true, "mask": true, "marker": true,
+	"filter": true, "feBlend": true, "feColorMatrix": true, "feComponentTransfer": true, "feComposite": true,
+	"feConvolveMatrix": true, "feDiffuseLighting": true, "feDisplacementMap": true, "feDistantLight": true,
+	"feDropShadow": true, "feFlood": true, "feFuncA": true, "feFuncB": true, "feFuncG": true, "feFuncR": true,
+	"feGaussianBlur": true, "feImage": true, "feMerge": true, "feMergeNode": true, "feMorphology": true,
+	"feOffset": true, "fePointLight": true, "feSpecularLighting": true, "feSpotLight": true, "feTile": true,
+	"feTurbulence": true,
+}
+
+// svgAttributes are the attributes without a namespace prefix that are kept, other attributes are removed
+var svgAttributes = map[string]bool{
+	"id": true, "class": true, "style": true, "transform": true, "viewBox": true, "preserveAspectRatio": true,
+	"version": true, "width": true, "height": true, "x": true, "y": true, "x1": true, "y1": true, "x2": true, "y2": true,
+	"cx": true, "cy": true, "r": true, "rx": true, "ry": true, "fx": true, "fy": true, "fr": true,
+	"d": true, "points": true, "pathLength": true, "href": true,
+	"fill": true, "fill-opacity": true, "fill-rule": true, "stroke": true, "stroke-width": true, "stroke-opacity": true,
+	"stroke-linecap": true, "stroke-linejoin": true, "stroke-miterlimit": true, "stroke-dasharray": true,
+	"stroke-dashoffset": true, "opacity": true, "color": true, "display": true, "visibility": true, "overflow": true,
+	"clip-path": true, "clip-rule": true, "mask": true, "filter": true, "paint-order": true, "vector-effect": true,
+	"shape-rendering": true, "text-rendering": true, "image-rendering": true, "mix-blend-mode": true, "isolation": true,
+	"color-interpolation": true, "color-interpolation-filters": true, "enable-background": true,
+	"font-family": true, "font-size": true, "font-weight": true, "font-style": true, "font-variant": true,
+	"font-stretch": true, "text-anchor": true, "dominant-baseline": true, "alignment-baseline": true,
+	"baseline-shift": true, "letter-spacing": true, "word-spacing": true, "text-decoration": true,
+	"writing-mode": true, "dx": true, "dy": true, "rotate": true, "textLength": true, "lengthAdjust": true,
+	"startOffset": true, "method": true, "spacing": true, "side": true,
+	"offset": true, "stop-color": true, "stop-opacity": true, "gradientUnits": true, "gradientTransform": true,
+	"spreadMethod": true, "patternUnits": true, "patternContentUnits": true, "patternTransform": true,
+	"clipPathUnits": true, "maskUnits": true, "maskContentUnits": true,
+	"markerWidth": true, "markerHeight": true, "markerUnits": true, "refX": true, "refY": true, "orient": true,
+	"marker-start": true, "marker-mid": true, "marker-end": true,
+	"filterUnits": true, "primitiveUnits": true, "in": true, "in2": true, "result": true, "stdDeviation": true,
+	"mode": true, "operator": true, "k1": true, "k2": true, "k3": true, "k4": true, "values": true, "type": true,
+	"flood-color": true, "flood-opacity": true, "lighting-color": true, "baseFrequency": true, "numOctaves": true,
+	"seed": true, "stitchTiles": true, "scale": true, "xChannelSelector": true, "yChannelSelector": true,
+	"radius": true, "tableValues": true, "slope": true, "intercept": true, "amplitude": true, "exponent": true,
+	"kernelMatrix": true, "order": true, "divisor": true, "bias": true, "targetX": true, "targetY": true,
+	"edgeMode": true, "preserveAlpha": true, "surfaceScale": true, "diffuseConstant": true,
+	"specularConstant": true, "specularExponent": true, "azimuth": true, "elevation": true, "z": true,
+	"pointsAtX": true, "pointsAtY": true, "pointsAtZ": true, "limitingConeAngle": true,
+}
+
+// svgNamespaces are the namespaces that can be declared, the declarations of other namespaces are removed
+var svgNamespaces = map[string]string{
+	"xmlns":       "http://www.w3.org/2000/svg",
+	"xmlns:xlink": "http://www.w3.org/1999/xlink",
+}
+
+// svgDataImageRegexp matches the embedded raster images that image and feImage elements can reference
+var svgDataImageRegexp = regexp.MustCompile(`^data:image/(png|jpeg|gif|webp);base64,[A-Za-z0-9+/=\s]*$`)
+
+// svgUrlRegexp matches the url() references of css values and presentation attributes
+var svgUrlRegexp = regexp.MustCompile(`(?i)url\(\s*['"]?\s*([^'")\s]*)`)
+
+var svgTextEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+var svgAttrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+
+// SanitizeSVG parses the SVG image and writes it again with the allowed elements and attributes only,
+// comments, processing instructions and the elements and attributes of editor namespaces are removed.
+// The image is refused with ErrUnsafeSVG when it has scripts, event handlers, foreignObject elements,
+// references to external resources or entity declarations.
+func SanitizeSVG(data []byte) ([]byte, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	out := &bytes.Buffer{}
+	stack := []string{}
+	skipDepth := 0
+	hasRoot := false
+
+	for {
+		token, err := decoder.RawToken()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: the file can't be parsed: %v", ErrUnsafeSVG, err)
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			name := svgName(token.Name)
+
+			if err := checkSVGElement(token.Name, token.Attr); err != nil {
+				return nil, err
+			}
+
+			if len(stack) == 0 {
+				if hasRoot || name != "svg" {
+					return nil, fmt.Errorf("%w: the root element must be svg", ErrUnsafeSVG)
+				}
+
+				hasRoot = true
+			}
+
+			stack = append(stack, name)
+
+			if skipDepth > 0 || !svgElements[name] {
+				skipDepth++
+				continue
+			}
+
+			out.WriteString("<" + name)
+
+			for _, attr := range token.Attr {
+				attrName := svgName(attr.Name)
+
+				if !keepSVGAttribute(attrName, attr.Value) {
+					continue
+				}
+
+				out.WriteString(" " + attrName + `="` + svgAttrEscaper.Replace(attr.Value) + `"`)
+			}
+
+			out.WriteString(">")
+		case xml.EndElement:
+			name := svgName(token.Name)
+
+			if len(stack) == 0 || stack[len(stack)-1] != name {
+				return nil, fmt.Errorf("%w: the file can't be parsed: unexpected end element %s", ErrUnsafeSVG, name)
+			}
+
+			stack = stack[:len(stack)-1]
+
+			if skipDepth > 0 {
+				skipDepth--
+				continue
+			}
+
+			out.WriteString("</" + name + ">")
+		case xml.CharData:
+			if len(stack) > 0 && stack[len(stack)-1] == "style" {
+				if err := checkSVGStyle(string(token)); err != nil {
+					return nil, err
+				}
+			}
+
+			if skipDepth == 0 && len(stack) > 0 {
+				out.WriteString(svgTextEscaper.Replace(string(token)))
+			}
+		case xml.Directive:
+			if strings.Contains(string(token), "ENTITY") {
+				return nil, fmt.Errorf("%w: entity declarations are not allowed", ErrUnsafeSVG)
+			}
+		}
+	}
+
+	if !hasRoot || len(stack) > 0 {
+		return nil, fmt.Errorf("%w: the file isn't a complete svg image", ErrUnsafeSVG)
+	}
+
+	return out.Bytes(), nil
+}
+
+// checkSVGElement refuses the elements and attributes that can run scripts or load external resources,
+// they are refused even when the element would be removed
+func checkSVGElement(name xml.Name, attrs []xml.Attr) error {
+	switch strings.ToLower(name.Local) {
+	case "script":
+		return fmt.Errorf("%w: scripts are not allowed", ErrUnsafeSVG)
+	case "foreignobject":
+		return fmt.Errorf("%w: foreignObject elements are not allowed", ErrUnsafeSVG)
+	}
+
+	for _, attr := range attrs {
+		attrName := svgName(attr.Name)
+		value := strings.TrimSpace(attr.Value)
+
+		if strings.HasPrefix(strings.ToLower(attr.Name.Local), "on") {
+			return fmt.Errorf("%w: event handler attributes are not allowed: %s", ErrUnsafeSVG, attrName)
+		}
+
+		if attr.Name.Local == "href" && !svgInternalReference(name.Local, value) {
+			return fmt.Errorf("%w: external references are not allowed: %s", ErrUnsafeSVG, svgShorten(value))
+		}
+
+		if err := checkSVGStyle(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkSVGStyle refuses the css that references external resources,
+// the css escapes are decoded first so they can't hide the functions and the at-rules
+func checkSVGStyle(value string) error {
+	unescaped := unescapeCSS(value)
+	lower := strings.ToLower(unescaped)
+
+	for _, keyword := range []string{"@import", "javascript:", "expression(", "image-set(", "image("} {
+		if strings.Contains(lower, keyword) {
+			return fmt.Errorf("%w: external references are not allowed: %s", ErrUnsafeSVG, svgShorten(value))
+		}
+	}
+
+	for _, match := range svgUrlRegexp.FindAllStringSubmatch(unescaped, -1) {
+		if !strings.HasPrefix(match[1], "#") {
+			return fmt.Errorf("%w: external references are not allowed: %s", ErrUnsafeSVG, svgShorten(match[0]))
+		}
+	}
+
+	return nil
+}
+
+// unescapeCSS decodes the css escapes: a backslash followed by up to 6 hex digits and an optional whitespace,
+// or by any other character that stands for itself. An escaped newline is removed.
+func unescapeCSS(value string) string {
+	if !strings.Contains(value, "\\") {
+		return value
+	}
+
+	var result strings.Builder
+	runes := []rune(value)
+
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '\\' || i == len(runes)-1 {
+			result.WriteRune(runes[i])
+			continue
+		}
+
+		i++
+		hex := 0
+
+		for hex < 6 && i+hex < len(runes) && isHexRune(runes[i+hex]) {
+			hex++
+		}
+
+		switch {
+		case hex > 0:
+			code, _ := strconv.ParseUint(string(runes[i:i+hex]), 16, 32)
+			if code == 0 || code > unicode.MaxRune || (code >= 0xD800 && code <= 0xDFFF) {
+				code = unicode.ReplacementChar
+			}
+			result.WriteRune(rune(code))
+			i += hex
+
+			if i < len(runes) && unicode.IsSpace(runes[i]) {
+				if runes[i] == '\r' && i+1 < len(runes) && runes[i+1] == '\n' {
+					i++
+				}
+			} else {
+				i--
+			}
+		case runes[i] == '\n' || runes[i] == '\r' || runes[i] == '\f':
+		default:
+			result.WriteRune(runes[i])
+		}
+	}
+
+	return result.String()
+}
+
+func isHexRune(r rune) bool {
+	return (r >= '0' && r <= '9') || (r >= 'a' && r <= 'f') || (r >= 'A' && r <= 'F')
+}
+
+// svgInternalReference reports whether the href points to an element of the image,
+// or to an embedded raster image in the case of the image elements
+func svgInternalReference(element, href string) bool {
+	if href == "" || strings.HasPrefix(href, "#") {
+		return true
+	}
+
+	return (element == "image" || element == "feImage") && svgDataImageRegexp.MatchString(href)
+}
+
+func keepSVGAttribute(name, value string) bool {
+	if namespace, ok := svgNamespaces[name]; ok {
+		return value == namespace
+	}
+
+	return svgAttributes[name] || name == "xlink:href" || name == "xml:space"
+}
+
+// svgName returns the name with its namespace prefix as it's written in the file
+func svgName(name xml.Name) string {
+	if name.Space != "" {
+		return name.Space + ":" + name.Local
+	}
+
+	return name.Local
+}
+
+func svgShorten(value string) string {
+	if len(value) > 50 {
+		return value[:50] + "..."
+	}
+
+	return value
+}
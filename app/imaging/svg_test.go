@@ -0,0 +1,71 @@
+package imaging_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ngocphuongnb/tetua/app/imaging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeSVG(t *testing.T) {
+	svg := `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE svg PUBLIC "-//W3C//DTD SVG 1.1//EN" "http://www.w3.org/Graphics/SVG/1.1/DTD/svg11.dtd">
+<!-- Generator: editor -->
+<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" xmlns:inkscape="http://www.inkscape.org/namespaces/inkscape" viewBox="0 0 10 10" inkscape:version="1.0" data-name="icon">
+<style><![CDATA[.a > path { fill: url(#grad); }]]></style>
+<defs><linearGradient id="grad"><stop offset="0" stop-color="#fff"/></linearGradient></defs>
+<inkscape:namedview><inkscape:grid/></inkscape:namedview>
+<metadata><rdf>tracking</rdf></metadata>
+<g class="a" fill="url(#grad)"><path d="M0 0h10v10z"/><use xlink:href="#grad"/></g>
+<image href="data:image/png;base64,iVBORw0KGgo=" width="1" height="1"/>
+<text x="1" y="2">A &amp; B &lt; C</text>
+</svg>`
+
+	sanitized, err := imaging.SanitizeSVG([]byte(svg))
+	assert.NoError(t, err)
+	assert.Equal(t, `<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" viewBox="0 0 10 10">
+<style>.a &gt; path { fill: url(#grad); }</style>
+<defs><linearGradient id="grad"><stop offset="0" stop-color="#fff"></stop></linearGradient></defs>
+
+
+<g class="a" fill="url(#grad)"><path d="M0 0h10v10z"></path><use xlink:href="#grad"></use></g>
+<image href="data:image/png;base64,iVBORw0KGgo=" width="1" height="1"></image>
+<text x="1" y="2">A &amp; B &lt; C</text>
+</svg>`, string(sanitized))
+
+	// the sanitized image is sanitized again without changes
+	again, err := imaging.SanitizeSVG(sanitized)
+	assert.NoError(t, err)
+	assert.Equal(t, string(sanitized), string(again))
+}
+
+func TestSanitizeSVGRefused(t *testing.T) {
+	for svg, message := range map[string]string{
+		`<svg><script>alert(1)</script></svg>`:                                       "scripts are not allowed",
+		`<svg><svg:script>alert(1)</svg:script></svg>`:                               "scripts are not allowed",
+		`<svg><metadata><script>alert(1)</script></metadata></svg>`:                  "scripts are not allowed",
+		`<svg onload="alert(1)"></svg>`:                                              "event handler attributes are not allowed: onload",
+		`<svg><rect ONCLICK="alert(1)"/></svg>`:                                      "event handler attributes are not allowed: ONCLICK",
+		`<svg><foreignObject><div></div></foreignObject></svg>`:                      "foreignObject elements are not allowed",
+		`<svg><use href="https://site.local/icons.svg#a"/></svg>`:                    "external references are not allowed: https://site.local/icons.svg#a",
+		`<svg><a xlink:href="javascript:alert(1)"><rect/></a></svg>`:                 "external references are not allowed: javascript:alert(1)",
+		`<svg><image href="data:image/svg+xml;base64,PHN2Zz4="/></svg>`:              "external references are not allowed: data:image/svg+xml;base64,PHN2Zz4=",
+		`<svg><rect style="fill: url('https://site.local/track')"/></svg>`:           "external references are not allowed: url('https://site.local/track",
+		`<svg><style>@import url(https://site.local/a.css);</style></svg>`:           "external references are not allowed: @import url(https://site.local/a.css);",
+		`<svg><style>rect { fill: u\72l(https://site.local/track) }</style></svg>`:   "external references are not allowed: url(https://site.local/track",
+		`<svg><rect style="fill: u\000072 l('https://site.local/track')"/></svg>`:    "external references are not allowed: url('https://site.local/track",
+		`<svg><style>@im\port "https://site.local/a.css";</style></svg>`:             `external references are not allowed: @im\port "https://site.local/a.css";`,
+		`<svg><rect style="fill: image-set('https://site.local/a.png' 1x)"/></svg>`:  "external references are not allowed: fill: image-set('https://site.local/a.png' 1x)",
+		`<svg><style>a { fill: -webkit-image-set("//a.local/b.png") }</style></svg>`: `external references are not allowed: a { fill: -webkit-image-set("//a.local/b.png") }`,
+		`<!DOCTYPE svg [<!ENTITY x "y">]><svg>&x;</svg>`:                             "entity declarations are not allowed",
+		`<html><body></body></html>`:                                                 "the root element must be svg",
+		`<svg></svg><svg></svg>`:                                                     "the root element must be svg",
+		`<svg><rect></svg>`:                                                          "the file can't be parsed: unexpected end element svg",
+		`not an image`:                                                               "the file isn't a complete svg image",
+	} {
+		_, err := imaging.SanitizeSVG([]byte(svg))
+		assert.True(t, errors.Is(err, imaging.ErrUnsafeSVG), svg)
+		assert.Equal(t, "unsafe svg: "+message, err.Error(), svg)
+	}
+}
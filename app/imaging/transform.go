@@ -0,0 +1,170 @@
+package imaging
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"strconv"
+	"strings"
+)
+
+// MaxTransformSize is the largest width or height of a transformed image
+const MaxTransformSize = 4096
+
+// signatureLength is the number of hex characters of the transform signatures that are kept in the urls
+const signatureLength = 32
+
+var ErrInvalidTransform = errors.New("invalid image transform")
+
+// Formats maps the format names of the transform params to their mime types
+var Formats = map[string]string{
+	"jpeg": "image/jpeg",
+	"jpg":  "image/jpeg",
+	"png":  "image/png",
+	"gif":  "image/gif",
+	"webp": "image/webp",
+}
+
+// Transform describes how an image is resized, cropped and encoded.
+// The params are a comma separated list of key_value pairs:
+//
+//	w_300     the max width
+//	h_200     the max height
+//	c_fill    crop the image to cover the whole box, c_fit (default) keeps the whole image
+//	f_webp    the output format: jpeg, png, gif or webp
+//	q_80      the quality of the lossy formats
+//	d_disk    the disk of the image, the default disk when it's empty
+type Transform struct {
+	Width   int
+	Height  int
+	Crop    bool
+	Format  string
+	Quality int
+	Disk    string
+}
+
+// ParseTransform parses the params of a transform and returns the signature that is sent with them
+func ParseTransform(params string) (*Transform, string, error) {
+	t := &Transform{}
+	signature := ""
+
+	for _, param := range strings.Split(params, ",") {
+		key, value, ok := strings.Cut(param, "_")
+
+		if !ok || value == "" {
+			return nil, "", fmt.Errorf("%w: %s", ErrInvalidTransform, param)
+		}
+
+		var err error
+
+		switch key {
+		case "w":
+			t.Width, err = transformSize(value)
+		case "h":
+			t.Height, err = transformSize(value)
+		case "c":
+			if value != "fill" && value != "fit" {
+				err = ErrInvalidTransform
+			}
+			t.Crop = value == "fill"
+		case "f":
+			if t.Format = Formats[value]; t.Format == "" {
+				err = ErrInvalidTransform
+			}
+		case "q":
+			if t.Quality, err = strconv.Atoi(value); err == nil && (t.Quality < 1 || t.Quality > 100) {
+				err = ErrInvalidTransform
+			}
+		case "d":
+			t.Disk = value
+		case "s":
+			signature = value
+		default:
+			err = ErrInvalidTransform
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: %s", ErrInvalidTransform, param)
+		}
+	}
+
+	return t, signature, nil
+}
+
+// String returns the params of the transform in the canonical order without the signature
+func (t *Transform) String() string {
+	params := []string{}
+
+	if t.Width > 0 {
+		params = append(params, "w_"+strconv.Itoa(t.Width))
+	}
+
+	if t.Height > 0 {
+		params = append(params, "h_"+strconv.Itoa(t.Height))
+	}
+
+	if t.Crop {
+		params = append(params, "c_fill")
+	}
+
+	if t.Format != "" {
+		params = append(params, "f_"+strings.TrimPrefix(t.Format, "image/"))
+	}
+
+	if t.Quality > 0 {
+		params = append(params, "q_"+strconv.Itoa(t.Quality))
+	}
+
+	if t.Disk != "" {
+		params = append(params, "d_"+t.Disk)
+	}
+
+	return strings.Join(params, ",")
+}
+
+// Sign returns the signature of the transform of an image path
+func (t *Transform) Sign(key, filepath string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(t.String() + "\n" + filepath))
+	return hex.EncodeToString(mac.Sum(nil))[:signatureLength]
+}
+
+// Verify reports whether the signature of the transform of an image path is valid
+func (t *Transform) Verify(key, filepath, signature string) bool {
+	if key == "" {
+		return false
+	}
+
+	return hmac.Equal([]byte(t.Sign(key, filepath)), []byte(signature))
+}
+
+// Params returns the canonical params of the transform with the signature of the image path
+func (t *Transform) Params(key, filepath string) string {
+	if params := t.String(); params != "" {
+		return params + ",s_" + t.Sign(key, filepath)
+	}
+
+	return "s_" + t.Sign(key, filepath)
+}
+
+// Apply resizes the image, images are never upscaled
+func (t *Transform) Apply(img image.Image) image.Image {
+	if t.Width <= 0 && t.Height <= 0 {
+		return img
+	}
+
+	return Resize(img, t.Width, t.Height, t.Crop)
+}
+
+func transformSize(value string) (int, error) {
+	size, err := strconv.Atoi(value)
+
+	if err != nil || size < 1 || size > MaxTransformSize {
+		return 0, ErrInvalidTransform
+	}
+
+	return size, nil
+}
@@ -0,0 +1,61 @@
+package imaging_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ngocphuongnb/tetua/app/imaging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTransform(t *testing.T) {
+	transform, signature, err := imaging.ParseTransform("h_200,w_300,c_fill,f_jpg,q_80,d_local_public,s_abc")
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", signature)
+	assert.Equal(t, &imaging.Transform{
+		Width:   300,
+		Height:  200,
+		Crop:    true,
+		Format:  "image/jpeg",
+		Quality: 80,
+		Disk:    "local_public",
+	}, transform)
+	assert.Equal(t, "w_300,h_200,c_fill,f_jpeg,q_80,d_local_public", transform.String())
+
+	transform, _, err = imaging.ParseTransform("w_300,c_fit")
+	assert.NoError(t, err)
+	assert.Equal(t, "w_300", transform.String())
+
+	for _, params := range []string{"", "w", "w_", "w_0", "w_5000", "h_abc", "c_scale", "f_bmp", "q_0", "q_101", "x_1"} {
+		_, _, err := imaging.ParseTransform(params)
+		assert.True(t, errors.Is(err, imaging.ErrInvalidTransform), params)
+	}
+}
+
+func TestTransformSignature(t *testing.T) {
+	transform := &imaging.Transform{Width: 300, Crop: true}
+	signature := transform.Sign("key", "2022/photo.jpg")
+	assert.Len(t, signature, 32)
+	assert.True(t, transform.Verify("key", "2022/photo.jpg", signature))
+	assert.False(t, transform.Verify("key", "2022/other.jpg", signature))
+	assert.False(t, transform.Verify("other_key", "2022/photo.jpg", signature))
+	assert.False(t, transform.Verify("", "2022/photo.jpg", transform.Sign("", "2022/photo.jpg")))
+	assert.False(t, (&imaging.Transform{Width: 301, Crop: true}).Verify("key", "2022/photo.jpg", signature))
+	assert.Equal(t, "w_300,c_fill,s_"+signature, transform.Params("key", "2022/photo.jpg"))
+	assert.Equal(t, "s_"+(&imaging.Transform{}).Sign("key", "a.jpg"), (&imaging.Transform{}).Params("key", "a.jpg"))
+}
+
+func TestTransformApply(t *testing.T) {
+	img := createImage(400, 200)
+	assert.Equal(t, img, (&imaging.Transform{Format: "image/png"}).Apply(img))
+
+	resized := (&imaging.Transform{Width: 100}).Apply(img)
+	assert.Equal(t, []int{100, 50}, []int{resized.Bounds().Dx(), resized.Bounds().Dy()})
+
+	cropped := (&imaging.Transform{Width: 100, Height: 100, Crop: true}).Apply(img)
+	assert.Equal(t, []int{100, 100}, []int{cropped.Bounds().Dx(), cropped.Bounds().Dy()})
+
+	// images are never upscaled
+	resized = (&imaging.Transform{Width: 800, Height: 800}).Apply(img)
+	assert.Equal(t, []int{400, 200}, []int{resized.Bounds().Dx(), resized.Bounds().Dy()})
+}
@@ -0,0 +1,83 @@
+package middlewares
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+
+	"github.com/ngocphuongnb/tetua/app/auth"
+	"github.com/ngocphuongnb/tetua/app/config"
+	"github.com/ngocphuongnb/tetua/app/server"
+)
+
+const CSRF_COOKIE = "csrf"
+const CSRF_FIELD = "_csrf"
+const HEADER_CSRF_TOKEN = "X-Csrf-Token"
+
+var csrfSafeMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodTrace:   true,
+}
+
+// Csrf issues a per-session secret stored in a http only cookie and exposes
+// the token derived from it to the views through meta.CsrfToken.
+// Unsafe requests must send the token back in the _csrf form field or the X-Csrf-Token header,
+// requests that are authenticated with a bearer token don't use the cookies and are exempt.
+func Csrf(c server.Context) error {
+	if auth.TokenAuthenticated(c) {
+		return c.Next()
+	}
+
+	secret := c.Cookies(CSRF_COOKIE)
+
+	if secret == "" {
+		secret = csrfSecret()
+		c.Cookie(&server.Cookie{
+			Name:     CSRF_COOKIE,
+			Value:    secret,
+			Path:     "/",
+			HTTPOnly: true,
+			SameSite: "lax",
+			Secure:   true,
+		})
+	}
+
+	token := CsrfToken(secret)
+	c.Locals("csrf_token", token)
+
+	if csrfSafeMethods[c.Method()] {
+		return c.Next()
+	}
+
+	requestToken := c.Header(HEADER_CSRF_TOKEN)
+
+	if requestToken == "" {
+		requestToken = c.FormValue(CSRF_FIELD)
+	}
+
+	if !hmac.Equal([]byte(requestToken), []byte(token)) {
+		return c.Status(http.StatusForbidden).SendString("Invalid CSRF token")
+	}
+
+	return c.Next()
+}
+
+// CsrfToken returns the token for a session secret, signing the secret with the app key
+// prevents an attacker that can plant a cookie from computing a matching token
+func CsrfToken(secret string) string {
+	mac := hmac.New(sha256.New, []byte(config.APP_KEY))
+	mac.Write([]byte(secret))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func csrfSecret() string {
+	b := make([]byte, 32)
+	rand.Read(b)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -12,6 +12,7 @@ func All() []server.Handler {
 		Recover,
 		RequestLog,
 		Cookie,
+		Csrf,
 		auth.Check,
 	}
 }
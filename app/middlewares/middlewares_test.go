@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 
@@ -103,5 +105,75 @@ func TestRequestLogMiddlewares(t *testing.T) {
 }
 
 func TestGetAllMiddlewares(t *testing.T) {
-	assert.Equal(t, 6, len(middlewares.All()))
+	assert.Equal(t, 7, len(middlewares.All()))
+}
+
+func TestCsrfMiddleware(t *testing.T) {
+	config.APP_KEY = "sesj5JYrRxrB2yUWkBFM7KKWCY2ykxBw"
+	mockServer := mock.CreateServer()
+	mockServer.Use(middlewares.Csrf)
+	mockServer.Get("/test", func(c server.Context) error {
+		return c.SendString(c.Meta().CsrfToken)
+	})
+	mockServer.Post("/test", func(c server.Context) error {
+		return c.SendString("ok")
+	})
+	mockServer.Delete("/test", func(c server.Context) error {
+		return c.SendString("ok")
+	})
+
+	// Safe requests issue the session cookie and expose the token to the views
+	body, resp := mock.GetRequest(mockServer, "/test")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var secret string
+	for _, cookie := range resp.Cookies() {
+		if cookie.Name == middlewares.CSRF_COOKIE {
+			secret = cookie.Value
+			assert.Equal(t, true, cookie.HttpOnly)
+		}
+	}
+	assert.NotEqual(t, "", secret)
+	assert.Equal(t, middlewares.CsrfToken(secret), body)
+	sessionCookie := middlewares.CSRF_COOKIE + "=" + secret
+
+	body, _ = mock.GetRequest(mockServer, "/test", map[string]string{"Cookie": sessionCookie})
+	assert.Equal(t, middlewares.CsrfToken(secret), body)
+
+	// Unsafe requests without a valid token are rejected
+	body, resp = mock.PostRequest(mockServer, "/test", map[string]string{"Cookie": sessionCookie})
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Equal(t, "Invalid CSRF token", body)
+
+	_, resp = mock.Request(mockServer, "DELETE", "/test", map[string]string{
+		"Cookie":                      sessionCookie,
+		middlewares.HEADER_CSRF_TOKEN: middlewares.CsrfToken("another-secret"),
+	})
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	_, resp = mock.PostRequest(mockServer, "/test", map[string]string{
+		middlewares.HEADER_CSRF_TOKEN: middlewares.CsrfToken(secret),
+	})
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	// Unsafe requests with a valid token in the header or the form are accepted
+	body, resp = mock.Request(mockServer, "DELETE", "/test", map[string]string{
+		"Cookie":                      sessionCookie,
+		middlewares.HEADER_CSRF_TOKEN: middlewares.CsrfToken(secret),
+	})
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "ok", body)
+
+	form := url.Values{}
+	form.Set(middlewares.CSRF_FIELD, middlewares.CsrfToken(secret))
+	req := httptest.NewRequest("POST", "/test", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Cookie", sessionCookie)
+	body, resp = mock.SendRequest(mockServer, req)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "ok", body)
+
+	// Bearer token authenticated requests are exempt
+	body, resp = mock.PostRequest(mockServer, "/test", map[string]string{"Authorization": "Bearer token"})
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "ok", body)
 }
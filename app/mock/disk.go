@@ -1,15 +1,24 @@
 package mock
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"io"
 	"mime/multipart"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/ngocphuongnb/tetua/app/fs"
+	"github.com/ngocphuongnb/tetua/app/utils"
 )
 
 type Disk struct {
+	Files map[string][]byte
 }
 
 func (d *Disk) Name() string {
@@ -24,11 +33,56 @@ func (d *Disk) Delete(ctx context.Context, path string) error {
 	if path == "/delete/error" {
 		return errors.New("Delete file error")
 	}
+	if d.Files != nil {
+		delete(d.Files, path)
+	}
 	return nil
 }
 
+func (d *Disk) Open(ctx context.Context, path string) (io.ReadCloser, error) {
+	data, ok := d.Files[path]
+
+	if !ok {
+		return nil, errors.New("File not found")
+	}
+
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (d *Disk) List(ctx context.Context, dir string) ([]string, error) {
+	paths := []string{}
+
+	for filePath := range d.Files {
+		if strings.HasPrefix(filePath, dir+"/") {
+			paths = append(paths, filePath)
+		}
+	}
+
+	sort.Strings(paths)
+
+	return paths, nil
+}
+
 func (d *Disk) Put(ctx context.Context, in io.Reader, size int64, mime, dst string) (*fs.FileInfo, error) {
-	return nil, nil
+	data, err := io.ReadAll(in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if d.Files != nil {
+		d.Files[dst] = data
+	}
+
+	hash := sha256.Sum256(data)
+
+	return &fs.FileInfo{
+		Disk: d.Name(),
+		Path: dst,
+		Type: mime,
+		Size: len(data),
+		Hash: hex.EncodeToString(hash[:]),
+	}, nil
 }
 
 func (d *Disk) PutMultipart(ctx context.Context, m *multipart.FileHeader, dsts ...string) (*fs.FileInfo, error) {
@@ -42,10 +96,67 @@ func (d *Disk) PutMultipart(ctx context.Context, m *multipart.FileHeader, dsts .
 		mime = mimes[0]
 	}
 
+	// Unknown mime types are accepted so the tests can upload any sample file
+	knownMimes := fs.GroupMimes(fs.MimeGroupNames...)
+	if utils.SliceContains(knownMimes, mime) && !utils.SliceContains(fs.AllowedMimes(ctx), mime) {
+		return nil, fs.ErrFileTypeNotAllowed
+	}
+
+	dst := m.Filename
+
+	if len(dsts) > 0 {
+		dst = dsts[0]
+	}
+
+	hash := sha256.New()
+
+	if f, err := m.Open(); err == nil {
+		io.Copy(hash, f)
+		f.Close()
+	}
+
 	return &fs.FileInfo{
 		Disk: d.Name(),
-		Path: m.Filename,
+		Path: dst,
 		Type: mime,
 		Size: 100,
+		Hash: hex.EncodeToString(hash.Sum(nil)),
 	}, nil
 }
+
+// PrivateDisk is a private disk that signs its urls like the private local disks
+type PrivateDisk struct {
+	*Disk
+	SignKey string
+}
+
+func (d *PrivateDisk) Name() string {
+	return "disk_private"
+}
+
+func (d *PrivateDisk) Url(path string) string {
+	return "/files/private/disk_private/" + path
+}
+
+func (d *PrivateDisk) Private() bool {
+	return true
+}
+
+func (d *PrivateDisk) SignedUrl(ctx context.Context, path string, expiry time.Duration) (string, error) {
+	if path == "sign/error" {
+		return "", errors.New("SignedUrl error")
+	}
+
+	expires := time.Now().Add(expiry).Unix()
+	return fmt.Sprintf("%s?expires=%d&signature=%s", d.Url(path), expires, fs.Sign(d.SignKey, d.Name(), path, expires)), nil
+}
+
+func (d *PrivateDisk) PutMultipart(ctx context.Context, m *multipart.FileHeader, dsts ...string) (*fs.FileInfo, error) {
+	info, err := d.Disk.PutMultipart(ctx, m, dsts...)
+
+	if info != nil {
+		info.Disk = d.Name()
+	}
+
+	return info, err
+}
@@ -8,13 +8,19 @@ import (
 
 func Repositories() repositories.Repositories {
 	return repositories.Repositories{
-		File:       &repo.FileRepository{Repository: &repo.Repository[entities.File]{Name: "file"}},
-		Post:       &repo.PostRepository{Repository: &repo.Repository[entities.Post]{Name: "post"}},
-		Comment:    &repo.CommentRepository{Repository: &repo.Repository[entities.Comment]{Name: "comment"}},
-		Role:       &repo.RoleRepository{Repository: &repo.Repository[entities.Role]{Name: "role"}},
-		Topic:      &repo.TopicRepository{Repository: &repo.Repository[entities.Topic]{Name: "topic"}},
-		User:       &repo.UserRepository{Repository: &repo.Repository[entities.User]{Name: "user"}},
-		Permission: &repo.PermissionRepository{Repository: &repo.Repository[entities.Permission]{Name: "permission"}},
+		File:        &repo.FileRepository{Repository: &repo.Repository[entities.File]{Name: "file"}},
+		Post:        &repo.PostRepository{Repository: &repo.Repository[entities.Post]{Name: "post"}},
+		Comment:     &repo.CommentRepository{Repository: &repo.Repository[entities.Comment]{Name: "comment"}},
+		Role:        &repo.RoleRepository{Repository: &repo.Repository[entities.Role]{Name: "role"}},
+		Topic:       &repo.TopicRepository{Repository: &repo.Repository[entities.Topic]{Name: "topic"}},
+		User:        &repo.UserRepository{Repository: &repo.Repository[entities.User]{Name: "user"}},
+		Permission:  &repo.PermissionRepository{Repository: &repo.Repository[entities.Permission]{Name: "permission"}},
+		Passkey:     &repo.PasskeyRepository{Repository: &repo.Repository[entities.Passkey]{Name: "passkey"}},
+		Invite:      &repo.InviteRepository{Repository: &repo.Repository[entities.Invite]{Name: "invite"}},
+		AuditLog:    &repo.AuditLogRepository{Repository: &repo.Repository[entities.AuditLog]{Name: "audit_log"}},
+		Menu:        &repo.MenuRepository{Repository: &repo.Repository[entities.Menu]{Name: "menu"}},
+		CustomField: &repo.CustomFieldRepository{Repository: &repo.Repository[entities.CustomField]{Name: "custom_field"}},
+		Backup:      &repo.BackupRepository{},
 	}
 }
 func CreateRepositories() {
@@ -25,4 +31,12 @@ func CreateRepositories() {
 	repositories.Topic = &repo.TopicRepository{Repository: &repo.Repository[entities.Topic]{Name: "topic"}}
 	repositories.User = &repo.UserRepository{Repository: &repo.Repository[entities.User]{Name: "user"}}
 	repositories.Permission = &repo.PermissionRepository{Repository: &repo.Repository[entities.Permission]{Name: "permission"}}
+	repositories.Passkey = &repo.PasskeyRepository{Repository: &repo.Repository[entities.Passkey]{Name: "passkey"}}
+	repositories.Invite = &repo.InviteRepository{Repository: &repo.Repository[entities.Invite]{Name: "invite"}}
+	repositories.AuditLog = &repo.AuditLogRepository{Repository: &repo.Repository[entities.AuditLog]{Name: "audit_log"}}
+	repositories.Menu = &repo.MenuRepository{Repository: &repo.Repository[entities.Menu]{Name: "menu"}}
+	repositories.CustomField = &repo.CustomFieldRepository{Repository: &repo.Repository[entities.CustomField]{Name: "custom_field"}}
+	repositories.Page = &repo.PageRepository{Repository: &repo.Repository[entities.Page]{Name: "page"}}
+	repositories.Setting = &repo.SettingRepository{}
+	repositories.Backup = &repo.BackupRepository{}
 }
@@ -0,0 +1,125 @@
+package mockrepository
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/utils"
+)
+
+type AuditLogRepository struct {
+	*Repository[entities.AuditLog]
+}
+
+func (m *AuditLogRepository) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	if err, ok := FakeRepoErrors["audit_log_deleteBefore"]; ok && err != nil {
+		return 0, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	count := len(m.entities)
+	m.entities = utils.SliceFilter(m.entities, func(log *entities.AuditLog) bool {
+		return !log.CreatedAt.Before(before)
+	})
+
+	return count - len(m.entities), nil
+}
+
+func (m *AuditLogRepository) match(filter entities.AuditLogFilter, log *entities.AuditLog) bool {
+	if filter.Search != "" && log.RequestID != filter.Search && log.IP != filter.Search && !strings.Contains(log.Action, filter.Search) {
+		return false
+	}
+
+	if len(filter.ExcludeIDs) > 0 && utils.SliceContains(filter.ExcludeIDs, log.ID) {
+		return false
+	}
+
+	if len(filter.UserIDs) > 0 && !utils.SliceContains(filter.UserIDs, log.UserID) {
+		return false
+	}
+
+	if len(filter.Actions) > 0 && !utils.SliceContains(filter.Actions, log.Action) {
+		return false
+	}
+
+	if len(filter.TargetTypes) > 0 && !utils.SliceContains(filter.TargetTypes, log.TargetType) {
+		return false
+	}
+
+	if len(filter.TargetIDs) > 0 && !utils.SliceContains(filter.TargetIDs, log.TargetID) {
+		return false
+	}
+
+	return true
+}
+
+func (m *AuditLogRepository) filter(filter entities.AuditLogFilter) []*entities.AuditLog {
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.Limit < 1 {
+		filter.Limit = 10
+	}
+	offset := (filter.Page - 1) * filter.Limit
+	matched := utils.SliceFilter(m.entities, func(log *entities.AuditLog) bool {
+		return m.match(filter, log)
+	})
+
+	if offset >= len(matched) {
+		return []*entities.AuditLog{}
+	}
+
+	return matched[offset:int(math.Min(float64(len(matched)), float64(offset+filter.Limit)))]
+}
+
+func (m *AuditLogRepository) Find(ctx context.Context, filters ...*entities.AuditLogFilter) ([]*entities.AuditLog, error) {
+	if err, ok := FakeRepoErrors["audit_log_find"]; ok && err != nil {
+		return nil, err
+	}
+
+	if len(filters) == 0 {
+		return m.entities, nil
+	}
+
+	return m.filter(*filters[0]), nil
+}
+
+func (m *AuditLogRepository) Count(ctx context.Context, filters ...*entities.AuditLogFilter) (int, error) {
+	if len(filters) == 0 {
+		return len(m.entities), nil
+	}
+
+	return len(utils.SliceFilter(m.entities, func(log *entities.AuditLog) bool {
+		return m.match(*filters[0], log)
+	})), nil
+}
+
+func (m *AuditLogRepository) Paginate(ctx context.Context, filters ...*entities.AuditLogFilter) (*entities.Paginate[entities.AuditLog], error) {
+	logs, err := m.Find(ctx, filters...)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := m.Count(ctx, filters...)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := filters[0]
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.Limit < 1 {
+		filter.Limit = 10
+	}
+	return &entities.Paginate[entities.AuditLog]{
+		Data:        logs,
+		PageSize:    filter.Limit,
+		PageCurrent: filter.Page,
+		Total:       count,
+	}, nil
+}
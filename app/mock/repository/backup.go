@@ -0,0 +1,94 @@
+package mockrepository
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+)
+
+// BackupRepository dumps the Rows lines and restores a dump to the Restored lines
+type BackupRepository struct {
+	Version  int64
+	Rows     []string
+	Restored []string
+}
+
+func (m *BackupRepository) Driver() string {
+	return "mock"
+}
+
+func (m *BackupRepository) SchemaVersion(ctx context.Context) (int64, error) {
+	return m.Version, nil
+}
+
+func (m *BackupRepository) Dump(ctx context.Context, w io.Writer) (map[string]*entities.BackupTable, error) {
+	if err, ok := FakeRepoErrors["backup_dump"]; ok && err != nil {
+		return nil, err
+	}
+
+	data := []byte{}
+
+	for _, row := range m.Rows {
+		data = append(data, row+"\n"...)
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+
+	return backupTables(data)
+}
+
+func (m *BackupRepository) Restore(ctx context.Context, r io.Reader) (map[string]*entities.BackupTable, error) {
+	if len(m.Restored) > 0 {
+		return nil, errors.New("the database isn't empty")
+	}
+
+	data, err := io.ReadAll(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := backupTables(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for scanner.Scan() {
+		m.Restored = append(m.Restored, scanner.Text())
+	}
+
+	return tables, nil
+}
+
+func backupTables(data []byte) (map[string]*entities.BackupTable, error) {
+	tables := map[string]*entities.BackupTable{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+
+	for {
+		row := struct {
+			Table string `json:"table"`
+		}{}
+
+		if err := decoder.Decode(&row); err == io.EOF {
+			return tables, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		if tables[row.Table] == nil {
+			tables[row.Table] = &entities.BackupTable{}
+		}
+
+		tables[row.Table].Rows++
+	}
+}
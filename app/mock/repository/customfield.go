@@ -0,0 +1,69 @@
+package mockrepository
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/utils"
+)
+
+type CustomFieldRepository struct {
+	*Repository[entities.CustomField]
+}
+
+func (m *CustomFieldRepository) All(ctx context.Context) ([]*entities.CustomField, error) {
+	if ctx.Value("query_error") != nil {
+		return nil, errors.New("Get all custom fields error")
+	}
+	return m.entities, nil
+}
+
+func (m *CustomFieldRepository) filter(filter entities.CustomFieldFilter) []*entities.CustomField {
+	return utils.SliceFilter(m.entities, func(field *entities.CustomField) bool {
+		if filter.Search != "" && !strings.Contains(field.Name, filter.Search) && !strings.Contains(field.Label, filter.Search) {
+			return false
+		}
+
+		if len(filter.Targets) > 0 && !utils.SliceContains(filter.Targets, field.Target) {
+			return false
+		}
+
+		return len(filter.ExcludeIDs) == 0 || !utils.SliceContains(filter.ExcludeIDs, field.ID)
+	})
+}
+
+func (m *CustomFieldRepository) Find(ctx context.Context, filters ...*entities.CustomFieldFilter) ([]*entities.CustomField, error) {
+	if err, ok := FakeRepoErrors["custom_field_find"]; ok && err != nil {
+		return nil, err
+	}
+
+	if len(filters) == 0 {
+		return m.entities, nil
+	}
+
+	return m.filter(*filters[0]), nil
+}
+
+func (m *CustomFieldRepository) Count(ctx context.Context, filters ...*entities.CustomFieldFilter) (int, error) {
+	if len(filters) == 0 {
+		return len(m.entities), nil
+	}
+
+	return len(m.filter(*filters[0])), nil
+}
+
+func (m *CustomFieldRepository) Paginate(ctx context.Context, filters ...*entities.CustomFieldFilter) (*entities.Paginate[entities.CustomField], error) {
+	fields, err := m.Find(ctx, filters...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.Paginate[entities.CustomField]{
+		Data:        fields,
+		PageSize:    10,
+		PageCurrent: 1,
+		Total:       len(fields),
+	}, nil
+}
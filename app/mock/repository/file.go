@@ -9,61 +9,85 @@ import (
 	"github.com/ngocphuongnb/tetua/app/utils"
 )
 
+// FakeFileUsages are the usages returned by FileRepository.Usages for the file IDs
+var FakeFileUsages = map[int][]*entities.FileUsage{}
+
 type FileRepository struct {
 	*Repository[entities.File]
 }
 
-func (m *FileRepository) Find(ctx context.Context, filters ...*entities.FileFilter) ([]*entities.File, error) {
-	if err, ok := FakeRepoErrors["file_find"]; ok && err != nil {
+func (m *FileRepository) Usages(ctx context.Context, file *entities.File) ([]*entities.FileUsage, error) {
+	if err, ok := FakeRepoErrors["file_usages"]; ok && err != nil {
 		return nil, err
 	}
-	if len(filters) == 0 {
-		return m.entities, nil
+
+	if usages, ok := FakeFileUsages[file.ID]; ok {
+		return usages, nil
 	}
-	filter := *filters[0]
-	result := make([]*entities.File, 0)
-	if filter.Page < 1 {
-		filter.Page = 1
+
+	return []*entities.FileUsage{}, nil
+}
+
+func (m *FileRepository) match(filter entities.FileFilter, file *entities.File) bool {
+	if filter.Search != "" &&
+		!strings.Contains(file.Path, filter.Search) &&
+		!strings.Contains(file.OriginalName, filter.Search) &&
+		!strings.Contains(file.Title, filter.Search) &&
+		!strings.Contains(file.Alt, filter.Search) {
+		return false
 	}
-	if filter.Limit < 1 {
-		filter.Limit = 10
+
+	if len(filter.ExcludeIDs) > 0 && utils.SliceContains(filter.ExcludeIDs, file.ID) {
+		return false
 	}
-	offset := (filter.Page - 1) * filter.Limit
 
-	for index, file := range m.entities {
-		if index < offset {
-			continue
-		}
-		if index >= offset+filter.Limit {
-			break
-		}
+	if len(filter.UserIDs) > 0 && !utils.SliceContains(filter.UserIDs, file.UserID) {
+		return false
+	}
+
+	if len(filter.Disks) > 0 && !utils.SliceContains(filter.Disks, file.Disk) {
+		return false
+	}
+
+	if len(filter.Paths) > 0 && !utils.SliceContains(filter.Paths, file.Path) {
+		return false
+	}
 
-		if filter.Search != "" {
-			if !strings.Contains(file.Path, filter.Search) {
-				continue
+	if len(filter.Hashes) > 0 && !utils.SliceContains(filter.Hashes, file.Hash) {
+		return false
+	}
+
+	if len(filter.Types) > 0 {
+		matched := false
+		for _, group := range filter.Types {
+			for _, prefix := range entities.FileTypeGroups[group] {
+				matched = matched || strings.HasPrefix(file.Type, prefix)
 			}
 		}
 
-		if len(filter.ExcludeIDs) > 0 && utils.SliceContains(filter.ExcludeIDs, file.ID) {
-			continue
+		if !matched {
+			return false
 		}
+	}
 
-		if len(filter.UserIDs) > 0 && !utils.SliceContains(filter.UserIDs, file.UserID) {
-			continue
+	for _, tag := range filter.Tags {
+		if !utils.SliceContains(file.Tags, tag) {
+			return false
 		}
+	}
 
-		result = append(result, file)
+	if filter.From != nil && (file.CreatedAt == nil || file.CreatedAt.Before(*filter.From)) {
+		return false
 	}
 
-	return result, nil
+	if filter.To != nil && (file.CreatedAt == nil || !file.CreatedAt.Before(filter.To.AddDate(0, 0, 1))) {
+		return false
+	}
+
+	return true
 }
 
-func (m *FileRepository) Count(ctx context.Context, filters ...*entities.FileFilter) (int, error) {
-	if len(filters) == 0 {
-		return len(m.entities), nil
-	}
-	filter := filters[0]
-	count := 0
+func (m *FileRepository) filter(filter entities.FileFilter) []*entities.File {
 	if filter.Page < 1 {
 		filter.Page = 1
 	}
@@ -71,33 +95,51 @@ func (m *FileRepository) Count(ctx context.Context, filters ...*entities.FileFil
 		filter.Limit = 10
 	}
 	offset := (filter.Page - 1) * filter.Limit
+	matched := utils.SliceFilter(m.entities, func(file *entities.File) bool {
+		return m.match(filter, file)
+	})
 
-	for index, file := range m.entities {
-		if index < offset {
-			continue
-		}
-		if index >= offset+filter.Limit {
-			break
-		}
+	if offset >= len(matched) {
+		return []*entities.File{}
+	}
 
-		if filter.Search != "" {
-			if !strings.Contains(file.Path, filter.Search) {
-				continue
-			}
-		}
+	return matched[offset:int(math.Min(float64(len(matched)), float64(offset+filter.Limit)))]
+}
 
-		if len(filter.ExcludeIDs) > 0 && utils.SliceContains(filter.ExcludeIDs, file.ID) {
-			continue
-		}
+func (m *FileRepository) Find(ctx context.Context, filters ...*entities.FileFilter) ([]*entities.File, error) {
+	if err, ok := FakeRepoErrors["file_find"]; ok && err != nil {
+		return nil, err
+	}
+	if len(filters) == 0 {
+		return m.entities, nil
+	}
 
-		if len(filter.UserIDs) > 0 && !utils.SliceContains(filter.UserIDs, file.UserID) {
-			continue
-		}
+	return m.filter(*filters[0]), nil
+}
+
+func (m *FileRepository) Count(ctx context.Context, filters ...*entities.FileFilter) (int, error) {
+	if len(filters) == 0 {
+		return len(m.entities), nil
+	}
+
+	return len(utils.SliceFilter(m.entities, func(file *entities.File) bool {
+		return m.match(*filters[0], file)
+	})), nil
+}
 
-		count++
+func (m *FileRepository) TotalSize(ctx context.Context, filters ...*entities.FileFilter) (int, error) {
+	if err, ok := FakeRepoErrors["file_total_size"]; ok && err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, file := range m.entities {
+		if len(filters) == 0 || m.match(*filters[0], file) {
+			total += file.Size
+		}
 	}
 
-	return count, nil
+	return total, nil
 }
 
 func (m *FileRepository) Paginate(ctx context.Context, filters ...*entities.FileFilter) (*entities.Paginate[entities.File], error) {
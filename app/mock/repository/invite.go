@@ -0,0 +1,138 @@
+package mockrepository
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/utils"
+)
+
+type InviteRepository struct {
+	*Repository[entities.Invite]
+}
+
+func (m *InviteRepository) ByCode(ctx context.Context, code string) (*entities.Invite, error) {
+	if ctx.Value("query_error") != nil {
+		return nil, errors.New("ByCode error")
+	}
+
+	return getEntityByField(m.Name, m.entities, "Code", code)
+}
+
+func (m *InviteRepository) Use(ctx context.Context, id int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, invite := range m.entities {
+		if invite.ID != id {
+			continue
+		}
+
+		if !invite.Usable() {
+			return errors.New("invite is expired or has been used up")
+		}
+
+		invite.Used++
+		return nil
+	}
+
+	return &entities.NotFoundError{Message: "invite not found"}
+}
+
+func (m *InviteRepository) Release(ctx context.Context, id int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, invite := range m.entities {
+		if invite.ID == id && invite.Used > 0 {
+			invite.Used--
+		}
+	}
+
+	return nil
+}
+
+func (m *InviteRepository) filter(filter entities.InviteFilter) []*entities.Invite {
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.Limit < 1 {
+		filter.Limit = 10
+	}
+	offset := (filter.Page - 1) * filter.Limit
+	result := make([]*entities.Invite, 0)
+
+	for index, invite := range m.entities {
+		if index < offset {
+			continue
+		}
+		if index >= offset+filter.Limit {
+			break
+		}
+
+		if filter.Search != "" && !strings.Contains(invite.Code, filter.Search) {
+			continue
+		}
+
+		if len(filter.ExcludeIDs) > 0 && utils.SliceContains(filter.ExcludeIDs, invite.ID) {
+			continue
+		}
+
+		if len(filter.UserIDs) > 0 && !utils.SliceContains(filter.UserIDs, invite.UserID) {
+			continue
+		}
+
+		result = append(result, invite)
+	}
+
+	return result
+}
+
+func (m *InviteRepository) Find(ctx context.Context, filters ...*entities.InviteFilter) ([]*entities.Invite, error) {
+	if err, ok := FakeRepoErrors["invite_find"]; ok && err != nil {
+		return nil, err
+	}
+
+	if len(filters) == 0 {
+		return m.entities, nil
+	}
+
+	return m.filter(*filters[0]), nil
+}
+
+func (m *InviteRepository) Count(ctx context.Context, filters ...*entities.InviteFilter) (int, error) {
+	if len(filters) == 0 {
+		return len(m.entities), nil
+	}
+
+	return len(m.filter(*filters[0])), nil
+}
+
+func (m *InviteRepository) Paginate(ctx context.Context, filters ...*entities.InviteFilter) (*entities.Paginate[entities.Invite], error) {
+	invites, err := m.Find(ctx, filters...)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := m.Count(ctx, filters...)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := filters[0]
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.Limit < 1 {
+		filter.Limit = 10
+	}
+	return &entities.Paginate[entities.Invite]{
+		Data:        invites,
+		PageSize:    filter.Limit,
+		PageCurrent: filter.Page,
+		Total:       int(math.Ceil(float64(count) / float64(filter.Limit))),
+	}, nil
+}
@@ -0,0 +1,96 @@
+package mockrepository
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/utils"
+)
+
+type MenuRepository struct {
+	*Repository[entities.Menu]
+	itemID int
+}
+
+func (m *MenuRepository) All(ctx context.Context) ([]*entities.Menu, error) {
+	if ctx.Value("query_error") != nil {
+		return nil, errors.New("Get all menus error")
+	}
+	return m.entities, nil
+}
+
+func (m *MenuRepository) SetItems(ctx context.Context, id int, items []*entities.MenuItem) error {
+	if err, ok := FakeRepoErrors["menu_setItems"]; ok && err != nil {
+		return err
+	}
+
+	menu, err := m.ByID(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.setItemIDs(id, 0, items)
+	menu.Items = items
+
+	return nil
+}
+
+func (m *MenuRepository) setItemIDs(menuID, parentID int, items []*entities.MenuItem) {
+	for order, item := range items {
+		m.itemID++
+		item.ID = m.itemID
+		item.MenuID = menuID
+		item.ParentID = parentID
+		item.Order = order
+		m.setItemIDs(menuID, item.ID, item.Children)
+	}
+}
+
+func (m *MenuRepository) filter(filter entities.MenuFilter) []*entities.Menu {
+	return utils.SliceFilter(m.entities, func(menu *entities.Menu) bool {
+		if filter.Search != "" && !strings.Contains(menu.Name, filter.Search) && !strings.Contains(menu.Location, filter.Search) {
+			return false
+		}
+
+		return len(filter.ExcludeIDs) == 0 || !utils.SliceContains(filter.ExcludeIDs, menu.ID)
+	})
+}
+
+func (m *MenuRepository) Find(ctx context.Context, filters ...*entities.MenuFilter) ([]*entities.Menu, error) {
+	if err, ok := FakeRepoErrors["menu_find"]; ok && err != nil {
+		return nil, err
+	}
+
+	if len(filters) == 0 {
+		return m.entities, nil
+	}
+
+	return m.filter(*filters[0]), nil
+}
+
+func (m *MenuRepository) Count(ctx context.Context, filters ...*entities.MenuFilter) (int, error) {
+	if len(filters) == 0 {
+		return len(m.entities), nil
+	}
+
+	return len(m.filter(*filters[0])), nil
+}
+
+func (m *MenuRepository) Paginate(ctx context.Context, filters ...*entities.MenuFilter) (*entities.Paginate[entities.Menu], error) {
+	menus, err := m.Find(ctx, filters...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.Paginate[entities.Menu]{
+		Data:        menus,
+		PageSize:    10,
+		PageCurrent: 1,
+		Total:       len(menus),
+	}, nil
+}
@@ -0,0 +1,98 @@
+package mockrepository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/utils"
+)
+
+type PageRepository struct {
+	*Repository[entities.Page]
+}
+
+func (m *PageRepository) PublishedPageBySlug(ctx context.Context, slug string) (*entities.Page, error) {
+	for _, page := range m.entities {
+		if page.Slug == slug && !page.Draft {
+			return page, nil
+		}
+	}
+
+	return nil, &entities.NotFoundError{Message: "page not found"}
+}
+
+func (m *PageRepository) filter(filter entities.PageFilter) []*entities.Page {
+	return utils.SliceFilter(m.entities, func(page *entities.Page) bool {
+		if filter.Search != "" && !strings.Contains(page.Name, filter.Search) && !strings.Contains(page.Content, filter.Search) {
+			return false
+		}
+
+		if filter.Publish == "published" && page.Draft || filter.Publish == "draft" && !page.Draft {
+			return false
+		}
+
+		if len(filter.ParentIDs) > 0 && !utils.SliceContains(filter.ParentIDs, page.ParentID) {
+			return false
+		}
+
+		return len(filter.ExcludeIDs) == 0 || !utils.SliceContains(filter.ExcludeIDs, page.ID)
+	})
+}
+
+func (m *PageRepository) Find(ctx context.Context, filters ...*entities.PageFilter) ([]*entities.Page, error) {
+	if err, ok := FakeRepoErrors["page_find"]; ok && err != nil {
+		return nil, err
+	}
+
+	if len(filters) == 0 {
+		return m.entities, nil
+	}
+
+	pages := m.filter(*filters[0])
+	page, limit := filters[0].Page, filters[0].Limit
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10
+	}
+
+	offset := (page - 1) * limit
+	if offset >= len(pages) {
+		return []*entities.Page{}, nil
+	}
+
+	if offset+limit < len(pages) {
+		return pages[offset : offset+limit], nil
+	}
+
+	return pages[offset:], nil
+}
+
+func (m *PageRepository) Count(ctx context.Context, filters ...*entities.PageFilter) (int, error) {
+	if len(filters) == 0 {
+		return len(m.entities), nil
+	}
+
+	return len(m.filter(*filters[0])), nil
+}
+
+func (m *PageRepository) Paginate(ctx context.Context, filters ...*entities.PageFilter) (*entities.Paginate[entities.Page], error) {
+	pages, err := m.Find(ctx, filters...)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := m.Count(ctx, filters...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.Paginate[entities.Page]{
+		Data:        pages,
+		PageSize:    10,
+		PageCurrent: 1,
+		Total:       count,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package mockrepository
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/utils"
+)
+
+type PasskeyRepository struct {
+	*Repository[entities.Passkey]
+}
+
+func (m *PasskeyRepository) ByCredentialID(ctx context.Context, credentialID []byte) (*entities.Passkey, error) {
+	if ctx.Value("query_error") != nil {
+		return nil, errors.New("ByCredentialID error")
+	}
+
+	for _, passkey := range m.entities {
+		if bytes.Equal(passkey.CredentialID, credentialID) {
+			return passkey, nil
+		}
+	}
+
+	return nil, &entities.NotFoundError{Message: "passkey not found"}
+}
+
+func (m *PasskeyRepository) ByUserID(ctx context.Context, userID int) ([]*entities.Passkey, error) {
+	if ctx.Value("query_error") != nil {
+		return nil, errors.New("ByUserID error")
+	}
+
+	return utils.SliceFilter(m.entities, func(passkey *entities.Passkey) bool {
+		return passkey.UserID == userID
+	}), nil
+}
+
+func (m *PasskeyRepository) filter(filter entities.PasskeyFilter) []*entities.Passkey {
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.Limit < 1 {
+		filter.Limit = 10
+	}
+	offset := (filter.Page - 1) * filter.Limit
+	result := make([]*entities.Passkey, 0)
+
+	for index, passkey := range m.entities {
+		if index < offset {
+			continue
+		}
+		if index >= offset+filter.Limit {
+			break
+		}
+
+		if filter.Search != "" && !strings.Contains(passkey.Name, filter.Search) {
+			continue
+		}
+
+		if len(filter.ExcludeIDs) > 0 && utils.SliceContains(filter.ExcludeIDs, passkey.ID) {
+			continue
+		}
+
+		if len(filter.UserIDs) > 0 && !utils.SliceContains(filter.UserIDs, passkey.UserID) {
+			continue
+		}
+
+		result = append(result, passkey)
+	}
+
+	return result
+}
+
+func (m *PasskeyRepository) Find(ctx context.Context, filters ...*entities.PasskeyFilter) ([]*entities.Passkey, error) {
+	if len(filters) == 0 {
+		return m.entities, nil
+	}
+
+	return m.filter(*filters[0]), nil
+}
+
+func (m *PasskeyRepository) Count(ctx context.Context, filters ...*entities.PasskeyFilter) (int, error) {
+	if len(filters) == 0 {
+		return len(m.entities), nil
+	}
+
+	return len(m.filter(*filters[0])), nil
+}
+
+func (m *PasskeyRepository) Paginate(ctx context.Context, filters ...*entities.PasskeyFilter) (*entities.Paginate[entities.Passkey], error) {
+	passkeys, err := m.Find(ctx, filters...)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := m.Count(ctx, filters...)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := filters[0]
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.Limit < 1 {
+		filter.Limit = 10
+	}
+	return &entities.Paginate[entities.Passkey]{
+		Data:        passkeys,
+		PageSize:    filter.Limit,
+		PageCurrent: filter.Page,
+		Total:       int(math.Ceil(float64(count) / float64(filter.Limit))),
+	}, nil
+}
@@ -141,9 +141,10 @@ func (m *RoleRepository) SetPermissions(ctx context.Context, id int, permissions
 			m.entities[index].Permissions = utils.SliceMap(permissions, func(item *entities.PermissionValue) *entities.Permission {
 				permissionID++
 				return &entities.Permission{
-					ID:     permissionID,
-					Action: item.Action,
-					Value:  item.Value.String(),
+					ID:       permissionID,
+					Action:   item.Action,
+					Value:    item.Value.String(),
+					TopicIDs: item.TopicIDs,
 				}
 			})
 		}
@@ -0,0 +1,46 @@
+package mockrepository
+
+import (
+	"context"
+
+	"github.com/ngocphuongnb/tetua/app/config"
+	"github.com/ngocphuongnb/tetua/app/entities"
+)
+
+type SettingRepository struct {
+	Settings []*entities.Setting
+}
+
+func (m *SettingRepository) All(ctx context.Context) []*config.SettingItem {
+	items := []*config.SettingItem{}
+
+	for _, setting := range m.Settings {
+		items = append(items, &config.SettingItem{Name: setting.Name, Value: setting.Value, Type: setting.Type})
+	}
+
+	return items
+}
+
+func (m *SettingRepository) Save(ctx context.Context, settings []*entities.Setting) error {
+	if err, ok := FakeRepoErrors["setting_save"]; ok && err != nil {
+		return err
+	}
+
+	for _, setting := range settings {
+		found := false
+
+		for _, s := range m.Settings {
+			if s.Name == setting.Name {
+				s.Value = setting.Value
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			m.Settings = append(m.Settings, setting)
+		}
+	}
+
+	return nil
+}
@@ -77,10 +77,6 @@ func (m *UserRepository) ByUsernameOrEmail(ctx context.Context, username, email
 		}
 	}
 
-	if len(result) == 0 {
-		return nil, &entities.NotFoundError{Message: "User not found with username " + username + " or email " + email}
-	}
-
 	return result, nil
 }
 
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+)
+
+type AuditLogRepository interface {
+	Repository[entities.AuditLog, entities.AuditLogFilter]
+	DeleteBefore(ctx context.Context, before time.Time) (int, error)
+}
@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+	"io"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+)
+
+// BackupRepository dumps the rows of all the tables to a portable NDJSON stream and restores them,
+// the dump can be restored to a database of another driver
+type BackupRepository interface {
+	Driver() string
+	// SchemaVersion is the latest applied migration version
+	SchemaVersion(ctx context.Context) (int64, error)
+	Dump(ctx context.Context, w io.Writer) (map[string]*entities.BackupTable, error)
+	// Restore loads a dump into an empty database, the rows get new ids and their references are remapped
+	Restore(ctx context.Context, r io.Reader) (map[string]*entities.BackupTable, error)
+}
@@ -0,0 +1,9 @@
+package repositories
+
+import (
+	"github.com/ngocphuongnb/tetua/app/entities"
+)
+
+type CustomFieldRepository interface {
+	Repository[entities.CustomField, entities.CustomFieldFilter]
+}
@@ -0,0 +1,31 @@
+package repositories
+
+import (
+	"context"
+	"sync"
+)
+
+// ChangeHandler is called after an entity was created, updated or deleted,
+// entity is the repository name, e.g. "role", "topic" or "permission"
+type ChangeHandler func(ctx context.Context, entity string)
+
+var changeHandlersMu sync.RWMutex
+var changeHandlers []ChangeHandler
+
+// OnChange registers a handler that is notified when the repositories change the data
+func OnChange(handler ChangeHandler) {
+	changeHandlersMu.Lock()
+	defer changeHandlersMu.Unlock()
+	changeHandlers = append(changeHandlers, handler)
+}
+
+// Changed notifies the registered handlers, repository implementations call it after a successful write
+func Changed(ctx context.Context, entity string) {
+	changeHandlersMu.RLock()
+	handlers := changeHandlers
+	changeHandlersMu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(ctx, entity)
+	}
+}
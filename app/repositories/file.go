@@ -1,9 +1,13 @@
 package repositories
 
 import (
+	"context"
+
 	"github.com/ngocphuongnb/tetua/app/entities"
 )
 
 type FileRepository interface {
 	Repository[entities.File, entities.FileFilter]
+	Usages(ctx context.Context, file *entities.File) ([]*entities.FileUsage, error)
+	TotalSize(ctx context.Context, filters ...*entities.FileFilter) (int, error)
 }
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+)
+
+type InviteRepository interface {
+	Repository[entities.Invite, entities.InviteFilter]
+	ByCode(ctx context.Context, code string) (*entities.Invite, error)
+	Use(ctx context.Context, id int) error
+	Release(ctx context.Context, id int) error
+}
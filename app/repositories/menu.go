@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+)
+
+type MenuRepository interface {
+	Repository[entities.Menu, entities.MenuFilter]
+	// SetItems replaces the items of a menu with the given items tree
+	SetItems(ctx context.Context, id int, items []*entities.MenuItem) error
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+)
+
+type PasskeyRepository interface {
+	Repository[entities.Passkey, entities.PasskeyFilter]
+	ByCredentialID(ctx context.Context, credentialID []byte) (*entities.Passkey, error)
+	ByUserID(ctx context.Context, userID int) ([]*entities.Passkey, error)
+}
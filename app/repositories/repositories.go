@@ -9,15 +9,21 @@ import (
 // Repository will manipulate CRUD into the database only, not related to business rules. In microservices architecture, repositories can connect to microservices to get data.
 
 var (
-	File       FileRepository
-	Role       RoleRepository
-	Post       PostRepository
-	Page       PageRepository
-	Topic      TopicRepository
-	User       UserRepository
-	Permission PermissionRepository
-	Comment    CommentRepository
-	Setting    SettingRepository
+	File        FileRepository
+	Role        RoleRepository
+	Post        PostRepository
+	Page        PageRepository
+	Topic       TopicRepository
+	User        UserRepository
+	Permission  PermissionRepository
+	Comment     CommentRepository
+	Setting     SettingRepository
+	Passkey     PasskeyRepository
+	Invite      InviteRepository
+	AuditLog    AuditLogRepository
+	Menu        MenuRepository
+	CustomField CustomFieldRepository
+	Backup      BackupRepository
 )
 
 type Repository[E entities.Entity, F entities.EntityFilter] interface {
@@ -32,15 +38,21 @@ type Repository[E entities.Entity, F entities.EntityFilter] interface {
 }
 
 type Repositories struct {
-	File       FileRepository
-	User       UserRepository
-	Post       PostRepository
-	Page       PageRepository
-	Role       RoleRepository
-	Topic      TopicRepository
-	Comment    CommentRepository
-	Setting    SettingRepository
-	Permission PermissionRepository
+	File        FileRepository
+	User        UserRepository
+	Post        PostRepository
+	Page        PageRepository
+	Role        RoleRepository
+	Topic       TopicRepository
+	Comment     CommentRepository
+	Setting     SettingRepository
+	Permission  PermissionRepository
+	Passkey     PasskeyRepository
+	Invite      InviteRepository
+	AuditLog    AuditLogRepository
+	Menu        MenuRepository
+	CustomField CustomFieldRepository
+	Backup      BackupRepository
 }
 
 func New(config Repositories) {
@@ -53,4 +65,10 @@ func New(config Repositories) {
 	Comment = config.Comment
 	Setting = config.Setting
 	Permission = config.Permission
+	Passkey = config.Passkey
+	Invite = config.Invite
+	AuditLog = config.AuditLog
+	Menu = config.Menu
+	CustomField = config.CustomField
+	Backup = config.Backup
 }
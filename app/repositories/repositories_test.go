@@ -19,4 +19,9 @@ func TestComment(t *testing.T) {
 	assert.Equal(t, repos.Topic, repositories.Topic)
 	assert.Equal(t, repos.User, repositories.User)
 	assert.Equal(t, repos.Permission, repositories.Permission)
+	assert.Equal(t, repos.Passkey, repositories.Passkey)
+	assert.Equal(t, repos.Invite, repositories.Invite)
+	assert.Equal(t, repos.AuditLog, repositories.AuditLog)
+	assert.Equal(t, repos.Menu, repositories.Menu)
+	assert.Equal(t, repos.CustomField, repositories.CustomField)
 }
@@ -3,6 +3,7 @@ package server
 import (
 	"bufio"
 	"context"
+	"io"
 	"mime/multipart"
 	"net/http"
 	"time"
@@ -17,12 +18,20 @@ type AuthProvider interface {
 	Callback(Context) (*entities.User, error)
 }
 
+// PasskeyAuthProvider is an auth provider that also lets logged in users register passkeys
+type PasskeyAuthProvider interface {
+	AuthProvider
+	BeginRegistration(Context) error
+	FinishRegistration(Context) (*entities.Passkey, error)
+}
+
 type AuthConfig struct {
 	Action       string
 	Value        entities.PermType
 	DefaultValue entities.PermType
 	Prepare      func(c Context) error
 	OwnCheckFN   func(c Context) bool
+	TopicCheckFN func(c Context, permission *entities.PermissionValue) bool
 }
 
 type Cookie struct {
@@ -76,9 +85,12 @@ type Context interface {
 	Query(string, ...string) string
 	SendString(string) error
 	Send([]byte) error
+	SendStream(stream io.Reader, size ...int) error
 	Redirect(string) error
 	RedirectToRoute(name string, params ...map[string]interface{}) error
 	BodyParser(interface{}) error
+	Body() []byte
+	FormValue(string) string
 	Render(func(meta *entities.Meta, wr *bufio.Writer)) error
 	Context() context.Context
 	File(name string) (*multipart.FileHeader, error)
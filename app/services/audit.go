@@ -0,0 +1,91 @@
+package services
+
+import (
+	"encoding/json"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+	"github.com/ngocphuongnb/tetua/app/server"
+)
+
+// auditRedactedFields are removed from the before and after JSON of the audit logs
+var auditRedactedFields = []string{"password"}
+
+// Audit records the current action, the route name, on a target.
+// Before and after are the target states, nil when the target is created or deleted.
+// Errors are only logged so a failing audit log never breaks the admin action.
+func Audit(c server.Context, targetType string, targetID int, before, after interface{}) {
+	log := &entities.AuditLog{
+		Action:     c.RouteName(),
+		TargetType: targetType,
+		TargetID:   targetID,
+		Before:     AuditJSON(before),
+		After:      AuditJSON(after),
+		IP:         c.IP(),
+		RequestID:  c.RequestID(),
+	}
+
+	if user := c.User(); user != nil {
+		log.UserID = user.ID
+	}
+
+	if _, err := repositories.AuditLog.Create(c.Context(), log); err != nil {
+		c.Logger().Error("Error writing audit log", err)
+	}
+}
+
+// AuditState copies an entity before it's modified,
+// a new entity has no previous state so nil is returned
+func AuditState[E any](entity *E, exists bool) *E {
+	if entity == nil || !exists {
+		return nil
+	}
+
+	state := *entity
+
+	return &state
+}
+
+// AuditJSON encodes a target state with the sensitive fields removed
+func AuditJSON(data interface{}) string {
+	if data == nil {
+		return ""
+	}
+
+	encoded, err := json.Marshal(data)
+
+	if err != nil || string(encoded) == "null" {
+		return ""
+	}
+
+	var decoded interface{}
+
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return string(encoded)
+	}
+
+	redact(decoded)
+
+	if encoded, err = json.Marshal(decoded); err != nil {
+		return ""
+	}
+
+	return string(encoded)
+}
+
+func redact(data interface{}) {
+	switch value := data.(type) {
+	case map[string]interface{}:
+		for _, field := range auditRedactedFields {
+			delete(value, field)
+		}
+
+		for _, item := range value {
+			redact(item)
+		}
+	case []interface{}:
+		for _, item := range value {
+			redact(item)
+		}
+	}
+}
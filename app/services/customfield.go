@@ -0,0 +1,24 @@
+package services
+
+import (
+	"github.com/ngocphuongnb/tetua/app/cache"
+	"github.com/ngocphuongnb/tetua/app/server"
+)
+
+// CustomFieldValues reads the submitted values of the custom fields of a target type,
+// the invalid values are added to the error messages of the request
+func CustomFieldValues(c server.Context, target string) map[string]string {
+	values := map[string]string{}
+
+	for _, field := range cache.CustomFields(target) {
+		value, err := field.Clean(c.FormValue(field.InputName()))
+
+		if err != nil {
+			c.Messages().AppendError(err.Error())
+		}
+
+		values[field.Name] = value
+	}
+
+	return values
+}
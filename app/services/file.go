@@ -1,10 +1,21 @@
 package services
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
 	"github.com/ngocphuongnb/tetua/app/entities"
 	"github.com/ngocphuongnb/tetua/app/fs"
+	"github.com/ngocphuongnb/tetua/app/logger"
 	"github.com/ngocphuongnb/tetua/app/repositories"
 	"github.com/ngocphuongnb/tetua/app/server"
+	"github.com/ngocphuongnb/tetua/app/utils"
 	"github.com/valyala/fasthttp"
 )
 
@@ -18,16 +29,264 @@ func SaveFile(c server.Context, inputFileName string) (*entities.File, error) {
 		return nil, nil
 	}
 
-	featuredImage, err := fs.Disk().PutMultipart(c.Context(), featuredImageHeader)
+	file, err := StoreUploadedFile(c, featuredImageHeader)
+	var uploadErr *UploadError
+	if errors.As(err, &uploadErr) {
+		c.Messages().AppendError(uploadErr.Message)
+	}
+
+	return file, err
+}
+
+// UploadError is an upload that is rejected by the upload limits of the user roles,
+// the message can be shown to the user
+type UploadError struct {
+	Message string
+}
+
+func (e *UploadError) Error() string {
+	return e.Message
+}
+
+// UsedStorage returns the total size of the files uploaded by the user
+func UsedStorage(ctx context.Context, userID int) (int, error) {
+	return repositories.File.TotalSize(ctx, &entities.FileFilter{
+		Filter:  &entities.Filter{},
+		UserIDs: []int{userID},
+	})
+}
+
+// CheckUploadLimits returns an UploadError when an upload of the size exceeds
+// the max file size or the storage quota of the user roles,
+// the length of the resumable uploads in progress is counted as used storage
+func CheckUploadLimits(ctx context.Context, user *entities.User, size int) error {
+	limits := user.UploadLimits()
+
+	if limits.MaxFileSize > 0 && size > limits.MaxFileSize {
+		return &UploadError{fmt.Sprintf(
+			"The file is too large, the maximum upload size is %s",
+			utils.FormatSize(limits.MaxFileSize),
+		)}
+	}
+
+	if limits.StorageQuota > 0 {
+		used, err := UsedStorage(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+
+		staged, err := StagedTusSize(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+
+		used += staged
+
+		if used+size > limits.StorageQuota {
+			return &UploadError{fmt.Sprintf(
+				"The storage quota of %s is exceeded, %s is used",
+				utils.FormatSize(limits.StorageQuota),
+				utils.FormatSize(used),
+			)}
+		}
+	}
+
+	return nil
+}
+
+// StoreUploadedFile stores an uploaded file on the default disk and creates its record.
+// When a file with the same content is already stored on the disk, the new object is
+// removed and the record points to the stored object and its variants instead.
+//
+// The upload is checked against the limits of the user roles and an UploadError is returned
+// when it's rejected.
+//
+// The file is stored on the disk when it's given.
+func StoreUploadedFile(c server.Context, header *multipart.FileHeader, disks ...fs.FSDisk) (*entities.File, error) {
+	if err := CheckUploadLimits(c.Context(), c.User(), int(header.Size)); err != nil {
+		return nil, err
+	}
+
+	ctx := c.Context()
+	mimeGroups := c.User().UploadLimits().MimeGroups
+	if len(mimeGroups) > 0 {
+		ctx = fs.WithAllowedMimes(ctx, fs.GroupMimes(mimeGroups...))
+	}
+
+	disk := fs.Disk()
+	if len(disks) > 0 && disks[0] != nil {
+		disk = disks[0]
+	}
+
+	uploadedFile, err := disk.PutMultipart(ctx, header)
+	if errors.Is(err, fs.ErrFileTypeNotAllowed) {
+		message := "The file type is not allowed"
+		if len(mimeGroups) > 0 {
+			message += ", allowed types: " + strings.Join(mimeGroups, ", ")
+		}
+		return nil, &UploadError{message}
+	}
+
 	if err != nil {
 		return nil, err
 	}
 
-	return repositories.File.Create(c.Context(), &entities.File{
-		Disk:   featuredImage.Disk,
-		Path:   featuredImage.Path,
-		Type:   featuredImage.Type,
-		Size:   featuredImage.Size,
-		UserID: c.User().ID,
+	file := &entities.File{
+		Disk:         uploadedFile.Disk,
+		Path:         uploadedFile.Path,
+		Type:         uploadedFile.Type,
+		Size:         uploadedFile.Size,
+		Hash:         uploadedFile.Hash,
+		UserID:       c.User().ID,
+		OriginalName: OriginalFileName(header.Filename),
+	}
+
+	if !reuseStoredFile(c.Context(), file) {
+		ImageVariants(c, file, header)
+	}
+
+	return repositories.File.Create(c.Context(), file)
+}
+
+// DeleteFile removes the file record, the stored object and its variants are only removed
+// when no other record shares them, their cached transforms are then purged
+func DeleteFile(ctx context.Context, file *entities.File) error {
+	if err := repositories.File.DeleteByID(ctx, file.ID); err != nil {
+		return err
+	}
+
+	count, err := repositories.File.Count(ctx, &entities.FileFilter{
+		Filter: &entities.Filter{},
+		Disks:  []string{file.Disk},
+		Paths:  []string{file.Path},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return nil
+	}
+
+	if err := file.Delete(ctx); err != nil {
+		return err
+	}
+
+	paths := []string{file.Path}
+
+	for _, variant := range file.Variants {
+		paths = append(paths, variant.Path)
+	}
+
+	if err := PurgeImageTransforms(ctx, file.Disk, paths...); err != nil {
+		logger.Error("Error purging the cached image transforms", err)
+	}
+
+	return nil
+}
+
+// reuseStoredFile points the file to the object of a stored file with the same hash on the same disk
+// and removes the object that was just stored, it reports whether a stored object is reused
+func reuseStoredFile(ctx context.Context, file *entities.File) bool {
+	if file.Hash == "" {
+		return false
+	}
+
+	files, err := repositories.File.Find(ctx, &entities.FileFilter{
+		Filter: &entities.Filter{Limit: 1},
+		Disks:  []string{file.Disk},
+		Hashes: []string{file.Hash},
 	})
+
+	if err != nil || len(files) == 0 || files[0].Path == file.Path {
+		return false
+	}
+
+	disk := fs.Disk(file.Disk)
+
+	if disk == nil || disk.Delete(ctx, file.Path) != nil {
+		return false
+	}
+
+	file.Path = files[0].Path
+	file.Size = files[0].Size
+	file.Width = files[0].Width
+	file.Height = files[0].Height
+	file.Variants = files[0].Variants
+
+	return true
+}
+
+// PrivateFileUrlExpiry is how long the signed urls of the files on private disks are valid
+const PrivateFileUrlExpiry = time.Hour
+
+// SignPrivateFileUrls replaces the urls of the files on private disks in the content with signed urls
+// when the viewer is logged in, the links of the guests are kept and the files can't be opened
+func SignPrivateFileUrls(c server.Context, html string) string {
+	if c.User() == nil || c.User().ID == 0 {
+		return html
+	}
+
+	for _, disk := range fs.Disks() {
+		baseUrl := disk.Url("")
+
+		if !fs.IsPrivate(disk) || baseUrl == "" || !strings.Contains(html, baseUrl) {
+			continue
+		}
+
+		urlRegexp := regexp.MustCompile(regexp.QuoteMeta(baseUrl) + `[^"'\s<>?#)]+`)
+		html = urlRegexp.ReplaceAllStringFunc(html, func(fileUrl string) string {
+			signedUrl, err := fs.SignedUrl(c.Context(), disk, strings.TrimPrefix(fileUrl, baseUrl), PrivateFileUrlExpiry)
+
+			if err != nil {
+				c.Logger().Error("Error signing private file url", err)
+				return fileUrl
+			}
+
+			return strings.ReplaceAll(signedUrl, "&", "&amp;")
+		})
+	}
+
+	return html
+}
+
+// OriginalFileName returns the base name of an uploaded file, browsers may send
+// the full client path and the name is limited to 255 characters
+func OriginalFileName(name string) string {
+	name = strings.TrimSpace(path.Base(strings.ReplaceAll(name, "\\", "/")))
+
+	if name == "." || name == "/" {
+		return ""
+	}
+
+	if runes := []rune(name); len(runes) > 255 {
+		return string(runes[:255])
+	}
+
+	return name
+}
+
+// FileFilterFromQuery sets the search, type, tag and date range filters of the media library from the query string,
+// the dates are in the yyyy-mm-dd format and invalid values are ignored
+func FileFilterFromQuery(c server.Context, filter *entities.FileFilter) *entities.FileFilter {
+	filter.Search = strings.TrimSpace(c.Query("q"))
+
+	if fileType := c.Query("type"); entities.FileTypeGroups[fileType] != nil {
+		filter.Types = []string{fileType}
+	}
+
+	if tag := strings.TrimSpace(c.Query("tag")); tag != "" {
+		filter.Tags = []string{tag}
+	}
+
+	if from, err := time.Parse("2006-01-02", c.Query("from")); err == nil {
+		filter.From = &from
+	}
+
+	if to, err := time.Parse("2006-01-02", c.Query("to")); err == nil {
+		filter.To = &to
+	}
+
+	return filter
 }
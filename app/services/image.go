@@ -0,0 +1,295 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"mime/multipart"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/ngocphuongnb/tetua/app/config"
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/fs"
+	"github.com/ngocphuongnb/tetua/app/imaging"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+	"github.com/ngocphuongnb/tetua/app/server"
+)
+
+// variantSourceTypes are the image types that variants are generated for
+var variantSourceTypes = map[string]string{
+	"image/jpeg":  "image/jpeg",
+	"image/pjpeg": "image/jpeg",
+	"image/png":   "image/png",
+	"image/gif":   "image/gif",
+}
+
+var imgTagRegex = regexp.MustCompile(`<img\s[^>]*>`)
+var imgSrcRegex = regexp.MustCompile(`\ssrc="([^"]+)"`)
+
+// ImageVariants generates the variants of an uploaded image, see GenerateImageVariants.
+// The upload is already stored so errors are only logged.
+func ImageVariants(c server.Context, file *entities.File, m *multipart.FileHeader) {
+	if _, ok := variantSourceTypes[file.Type]; !ok {
+		return
+	}
+
+	f, err := m.Open()
+
+	if err != nil {
+		c.Logger().Error("Error opening uploaded image", err)
+		return
+	}
+
+	defer f.Close()
+
+	if err := GenerateImageVariants(c.Context(), file, f); err != nil {
+		c.Logger().Error("Error generating image variants", err)
+	}
+}
+
+// GenerateImageVariants reads the original image of the file, stores the configured variants
+// next to it on the same disk and records them on the file together with the original size.
+// Images aren't upscaled, a variant is skipped when the original already fits into it.
+// Only the first frame of animated GIFs is used.
+func GenerateImageVariants(ctx context.Context, file *entities.File, r io.Reader) error {
+	mime, ok := variantSourceTypes[file.Type]
+
+	if !ok {
+		return nil
+	}
+
+	disk := fs.Disk(file.Disk)
+
+	if disk == nil {
+		return errors.New("disk not found")
+	}
+
+	data, err := io.ReadAll(r)
+
+	if err != nil {
+		return err
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+
+	if err != nil {
+		return err
+	}
+
+	file.Width = cfg.Width
+	file.Height = cfg.Height
+
+	if config.Image.MaxPixels > 0 && cfg.Width*cfg.Height > config.Image.MaxPixels {
+		return fmt.Errorf("image is too large to generate variants: %dx%d", cfg.Width, cfg.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+
+	if err != nil {
+		return err
+	}
+
+	// the variants have no EXIF so the orientation of the original is applied to their pixels
+	img = imaging.Orient(img, imaging.Orientation(data, file.Type))
+	file.Width = img.Bounds().Dx()
+	file.Height = img.Bounds().Dy()
+
+	mimes := []string{mime}
+
+	if config.Image.WebP && imaging.CanEncode("image/webp") {
+		mimes = append(mimes, "image/webp")
+	}
+
+	variants := []*fs.ImageVariant{}
+
+	for _, variantConfig := range config.Image.Variants {
+		if fitsImageVariant(file.Width, file.Height, variantConfig) {
+			continue
+		}
+
+		resized := imaging.Resize(img, variantConfig.Width, variantConfig.Height, variantConfig.Crop)
+
+		for _, variantMime := range mimes {
+			buf := &bytes.Buffer{}
+
+			if err := imaging.Encode(buf, resized, variantMime, config.Image.Quality); err != nil {
+				return err
+			}
+
+			size := buf.Len()
+			dst := ImageVariantPath(file.Path, variantConfig.Name, variantMime)
+			info, err := disk.Put(ctx, buf, int64(size), variantMime, dst)
+
+			if err != nil {
+				return err
+			}
+
+			variants = append(variants, &fs.ImageVariant{
+				Name:   variantConfig.Name,
+				Path:   info.Path,
+				Type:   variantMime,
+				Size:   info.Size,
+				Width:  resized.Bounds().Dx(),
+				Height: resized.Bounds().Dy(),
+			})
+			file.Variants = variants
+		}
+	}
+
+	return nil
+}
+
+// ImageVariantPath returns the path of a variant next to the original file,
+// e.g. 2022/05/photo.png becomes 2022/05/photo-medium.png
+func ImageVariantPath(filePath, name, mime string) string {
+	ext := imaging.Extensions[mime]
+
+	if ext == "" {
+		ext = path.Ext(filePath)
+	}
+
+	return strings.TrimSuffix(filePath, path.Ext(filePath)) + "-" + name + ext
+}
+
+// StripImageMetadata is the upload filter that removes the metadata of the uploaded JPEG
+// and PNG images when the strip_image_metadata setting is enabled, see imaging.StripMetadata
+func StripImageMetadata(mime string, in io.Reader, size int64) (io.Reader, int64, error) {
+	if config.Setting("strip_image_metadata") != "yes" || !imaging.CanStripMetadata(mime) {
+		return in, size, nil
+	}
+
+	data, err := io.ReadAll(in)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if data, err = imaging.StripMetadata(data, mime); err != nil {
+		return nil, 0, err
+	}
+
+	return bytes.NewReader(data), int64(len(data)), nil
+}
+
+// SanitizeSVG is the upload filter that sanitizes the uploaded SVG images, see imaging.SanitizeSVG,
+// the images that can't be sanitized are refused with an UploadError that explains why
+func SanitizeSVG(mime string, in io.Reader, size int64) (io.Reader, int64, error) {
+	if mime != "image/svg+xml" {
+		return in, size, nil
+	}
+
+	data, err := io.ReadAll(in)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if data, err = imaging.SanitizeSVG(data); err != nil {
+		return nil, 0, &UploadError{"The SVG image can't be sanitized, " + strings.TrimPrefix(err.Error(), imaging.ErrUnsafeSVG.Error()+": ")}
+	}
+
+	return bytes.NewReader(data), int64(len(data)), nil
+}
+
+// ImageSrcset adds the srcset and sizes attributes to the img tags of a rendered content
+// that point to the stored files, WebP variants are added as the source of a picture element
+func ImageSrcset(ctx context.Context, html string) string {
+	tags := imgTagRegex.FindAllString(html, -1)
+	paths := []string{}
+
+	for _, tag := range tags {
+		if _, filePath := imageTagFile(tag); filePath != "" {
+			paths = append(paths, filePath)
+		}
+	}
+
+	if len(paths) == 0 {
+		return html
+	}
+
+	// Deduplicated uploads share the object, several rows can have the same path,
+	// the rows are read page by page so that the duplicates don't hide the other paths
+	files := map[string]*entities.File{}
+
+	for page := 1; ; page++ {
+		pageFiles, err := repositories.File.Find(ctx, &entities.FileFilter{
+			Filter: &entities.Filter{Page: page, Limit: len(paths)},
+			Paths:  paths,
+		})
+
+		if err != nil {
+			return html
+		}
+
+		for _, file := range pageFiles {
+			if _, ok := files[file.Disk+"/"+file.Path]; !ok {
+				files[file.Disk+"/"+file.Path] = file
+			}
+		}
+
+		if len(pageFiles) < len(paths) {
+			break
+		}
+	}
+
+	return imgTagRegex.ReplaceAllStringFunc(html, func(tag string) string {
+		diskName, filePath := imageTagFile(tag)
+
+		if filePath == "" || strings.Contains(tag, " srcset=") {
+			return tag
+		}
+
+		if file, ok := files[diskName+"/"+filePath]; ok {
+			return imageTagWithSrcset(tag, file)
+		}
+
+		return tag
+	})
+}
+
+// imageTagFile returns the disk and the path of the file that an img tag points to
+func imageTagFile(tag string) (string, string) {
+	matches := imgSrcRegex.FindStringSubmatch(tag)
+
+	if len(matches) < 2 {
+		return "", ""
+	}
+
+	for _, disk := range fs.Disks() {
+		if prefix := disk.Url(""); prefix != "" && strings.HasPrefix(matches[1], prefix) {
+			return disk.Name(), strings.TrimPrefix(matches[1], prefix)
+		}
+	}
+
+	return "", ""
+}
+
+func imageTagWithSrcset(tag string, file *entities.File) string {
+	srcset := file.Srcset(file.Type)
+
+	if srcset == "" {
+		return tag
+	}
+
+	sizes := fmt.Sprintf("(max-width: %dpx) 100vw, %dpx", file.Width, file.Width)
+	attrs := fmt.Sprintf(` srcset="%s" sizes="%s"`, srcset, sizes)
+	tag = strings.Replace(tag, "<img", "<img"+attrs, 1)
+
+	if webpSrcset := file.Srcset("image/webp"); webpSrcset != "" {
+		return fmt.Sprintf(`<picture><source type="image/webp" srcset="%s" sizes="%s">%s</picture>`, webpSrcset, sizes, tag)
+	}
+
+	return tag
+}
+
+func fitsImageVariant(width, height int, variant *config.ImageVariantConfig) bool {
+	return (variant.Width <= 0 || width <= variant.Width) && (variant.Height <= 0 || height <= variant.Height)
+}
@@ -1,12 +1,29 @@
 package services_test
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/ngocphuongnb/tetua/app/config"
 	"github.com/ngocphuongnb/tetua/app/entities"
 	"github.com/ngocphuongnb/tetua/app/fs"
+	"github.com/ngocphuongnb/tetua/app/imaging"
 	"github.com/ngocphuongnb/tetua/app/mock"
+	mockrepository "github.com/ngocphuongnb/tetua/app/mock/repository"
+	"github.com/ngocphuongnb/tetua/app/repositories"
 	"github.com/ngocphuongnb/tetua/app/server"
 	"github.com/ngocphuongnb/tetua/app/services"
 	"github.com/stretchr/testify/assert"
@@ -60,9 +77,610 @@ func TestFile(t *testing.T) {
 		assert.Equal(t, "image/jpeg", f.Type)
 		assert.Equal(t, 100, f.Size)
 		assert.Equal(t, 2, f.UserID)
+		assert.Equal(t, "image.jpg", f.OriginalName)
 		return c.SendString("ok")
 	})
 
 	req = mock.CreateUploadRequest("POST", "/test-upload-success", "featured_image", "image.jpg")
 	mockServer.Test(req)
+
+	assert.Equal(t, "photo.jpg", services.OriginalFileName(`C:\Users\me\photo.jpg`))
+	assert.Equal(t, "photo.jpg", services.OriginalFileName(" ../photo.jpg "))
+	assert.Equal(t, "", services.OriginalFileName(""))
+	assert.Equal(t, 255, len(services.OriginalFileName(strings.Repeat("a", 300))))
+
+	mockServer.Get("/test-file-filter", func(c server.Context) error {
+		filter := services.FileFilterFromQuery(c, &entities.FileFilter{Filter: &entities.Filter{}})
+		assert.Equal(t, "logo", filter.Search)
+		assert.Equal(t, []string{"image"}, filter.Types)
+		assert.Equal(t, []string{"brand"}, filter.Tags)
+		assert.Equal(t, "2022-05-01", filter.FromDate())
+		assert.Nil(t, filter.To)
+		return c.SendString("ok")
+	})
+
+	mock.GetRequest(mockServer, "/test-file-filter?q=logo&type=image&tag=brand&from=2022-05-01&to=invalid")
+}
+
+func TestAudit(t *testing.T) {
+	mock.CreateRepositories()
+	mockServer := mock.CreateServer()
+	mockServer.Post("/test-audit", func(c server.Context) error {
+		c.Locals("user", &entities.User{ID: 2})
+		topic := &entities.Topic{ID: 1, Name: "Topic"}
+		before := services.AuditState(topic, true)
+		topic.Name = "Topic updated"
+		services.Audit(c, "topic", topic.ID, before, topic)
+		services.Audit(c, "user", 3, nil, &entities.User{ID: 3, Username: "user3", Password: "hash"})
+		return c.SendString("ok")
+	}, &server.AuthConfig{Action: "manage.test.save"})
+
+	mock.PostRequest(mockServer, "/test-audit")
+	logs, err := repositories.AuditLog.All(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(logs))
+	assert.Equal(t, 2, logs[0].UserID)
+	assert.Equal(t, "manage.test.save", logs[0].Action)
+	assert.Equal(t, "topic", logs[0].TargetType)
+	assert.Equal(t, 1, logs[0].TargetID)
+	assert.Equal(t, `{"id":1,"name":"Topic"}`, logs[0].Before)
+	assert.Equal(t, `{"id":1,"name":"Topic updated"}`, logs[0].After)
+	assert.Equal(t, "0.0.0.0", logs[0].IP)
+	assert.Equal(t, "", logs[1].Before)
+	assert.Equal(t, `{"id":3,"username":"user3"}`, logs[1].After)
+
+	assert.Equal(t, (*entities.Topic)(nil), services.AuditState(&entities.Topic{}, false))
+	assert.Equal(t, "", services.AuditJSON(nil))
+	assert.Equal(t, "", services.AuditJSON((*entities.Topic)(nil)))
+	assert.Equal(t, `[{"id":1,"roles":[{"id":2}]}]`, services.AuditJSON([]*entities.User{{
+		ID:       1,
+		Password: "hash",
+		Roles:    []*entities.Role{{ID: 2}},
+	}}))
+}
+
+func createTestImage(t *testing.T, width, height int) []byte {
+	buf := &bytes.Buffer{}
+	assert.NoError(t, png.Encode(buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+	return buf.Bytes()
+}
+
+func TestGenerateImageVariants(t *testing.T) {
+	ctx := context.Background()
+	fs.New("disk_mock", []fs.FSDisk{&mock.Disk{}})
+
+	document := &entities.File{Disk: "disk_mock", Path: "2022/05/doc.pdf", Type: "application/pdf"}
+	assert.NoError(t, services.GenerateImageVariants(ctx, document, bytes.NewReader(nil)))
+	assert.Equal(t, 0, len(document.Variants))
+
+	invalidDisk := &entities.File{Disk: "invalid_disk", Path: "photo.png", Type: "image/png"}
+	assert.Equal(t, errors.New("disk not found"), services.GenerateImageVariants(ctx, invalidDisk, bytes.NewReader(nil)))
+
+	invalidImage := &entities.File{Disk: "disk_mock", Path: "photo.png", Type: "image/png"}
+	assert.Equal(t, image.ErrFormat, services.GenerateImageVariants(ctx, invalidImage, bytes.NewReader([]byte("not an image"))))
+
+	photo := &entities.File{Disk: "disk_mock", Path: "2022/05/photo.png", Type: "image/png"}
+	assert.NoError(t, services.GenerateImageVariants(ctx, photo, bytes.NewReader(createTestImage(t, 600, 400))))
+	assert.Equal(t, 600, photo.Width)
+	assert.Equal(t, 400, photo.Height)
+	// the large variant is skipped since the original fits into it
+	assert.Equal(t, 2, len(photo.Variants))
+	assert.Equal(t, fs.ImageVariant{Name: "thumbnail", Path: "2022/05/photo-thumbnail.png", Type: "image/png", Size: photo.Variants[0].Size, Width: 150, Height: 150}, *photo.Variants[0])
+	assert.Equal(t, "2022/05/photo-medium.png", photo.Variants[1].Path)
+	assert.Equal(t, []int{300, 200}, []int{photo.Variants[1].Width, photo.Variants[1].Height})
+
+	config.Image.WebP = true
+	imaging.RegisterEncoder("image/webp", func(w io.Writer, img image.Image, quality int) error {
+		return png.Encode(w, img)
+	})
+	defer func() { config.Image.WebP = false }()
+
+	photo = &entities.File{Disk: "disk_mock", Path: "photo.jpg", Type: "image/pjpeg"}
+	assert.NoError(t, services.GenerateImageVariants(ctx, photo, bytes.NewReader(createTestImage(t, 200, 200))))
+	assert.Equal(t, 2, len(photo.Variants))
+	assert.Equal(t, "photo-thumbnail.jpg", photo.Variants[0].Path)
+	assert.Equal(t, "image/jpeg", photo.Variants[0].Type)
+	assert.Equal(t, "photo-thumbnail.webp", photo.Variants[1].Path)
+	assert.Equal(t, "image/webp", photo.Variants[1].Type)
+
+	maxPixels := config.Image.MaxPixels
+	config.Image.MaxPixels = 100
+	defer func() { config.Image.MaxPixels = maxPixels }()
+	huge := &entities.File{Disk: "disk_mock", Path: "huge.png", Type: "image/png"}
+	assert.Equal(t, "image is too large to generate variants: 20x20", services.GenerateImageVariants(ctx, huge, bytes.NewReader(createTestImage(t, 20, 20))).Error())
+	assert.Equal(t, 20, huge.Width)
+}
+
+func TestImageSrcset(t *testing.T) {
+	ctx := context.Background()
+	mock.CreateRepositories()
+	fs.New("disk_mock", []fs.FSDisk{&mock.Disk{}})
+
+	photo, _ := repositories.File.Create(ctx, &entities.File{
+		Disk:  "disk_mock",
+		Path:  "photo.jpg",
+		Type:  "image/jpeg",
+		Width: 2000,
+		Variants: []*fs.ImageVariant{
+			{Name: "medium", Path: "photo-medium.jpg", Type: "image/jpeg", Width: 300},
+			{Name: "medium", Path: "photo-medium.webp", Type: "image/webp", Width: 300},
+		},
+	})
+	repositories.File.Create(ctx, &entities.File{Disk: "disk_mock", Path: "small.png", Type: "image/png", Width: 100})
+
+	assert.Equal(t, "<p>No images</p>", services.ImageSrcset(ctx, "<p>No images</p>"))
+	assert.Equal(t, `<img src="https://example.com/photo.jpg">`, services.ImageSrcset(ctx, `<img src="https://example.com/photo.jpg">`))
+	assert.Equal(
+		t,
+		`<p><picture><source type="image/webp" srcset="/disk_mock/photo-medium.webp 300w" sizes="(max-width: 2000px) 100vw, 2000px">`+
+			`<img srcset="/disk_mock/photo-medium.jpg 300w, /disk_mock/photo.jpg 2000w" sizes="(max-width: 2000px) 100vw, 2000px" src="/disk_mock/photo.jpg" alt="Photo"></picture>`+
+			`<img src="/disk_mock/small.png" alt=""></p>`,
+		services.ImageSrcset(ctx, `<p><img src="/disk_mock/photo.jpg" alt="Photo"><img src="/disk_mock/small.png" alt=""></p>`),
+	)
+
+	photo.Variants = photo.Variants[:1]
+	assert.Equal(
+		t,
+		`<img srcset="/disk_mock/photo-medium.jpg 300w, /disk_mock/photo.jpg 2000w" sizes="(max-width: 2000px) 100vw, 2000px" src="/disk_mock/photo.jpg">`,
+		services.ImageSrcset(ctx, `<img src="/disk_mock/photo.jpg">`),
+	)
+	assert.Equal(t, `<img src="/disk_mock/photo.jpg" srcset="x.jpg">`, services.ImageSrcset(ctx, `<img src="/disk_mock/photo.jpg" srcset="x.jpg">`))
+
+	repositories.File.Create(ctx, &entities.File{Disk: "disk_mock", Path: "photo.jpg", Type: "image/jpeg", Width: 2000})
+	repositories.File.Create(ctx, &entities.File{Disk: "disk_mock", Path: "banner.jpg", Type: "image/jpeg", Width: 800, Variants: []*fs.ImageVariant{
+		{Name: "medium", Path: "banner-medium.jpg", Type: "image/jpeg", Width: 300},
+	}})
+	assert.Equal(
+		t,
+		`<img srcset="/disk_mock/photo-medium.jpg 300w, /disk_mock/photo.jpg 2000w" sizes="(max-width: 2000px) 100vw, 2000px" src="/disk_mock/photo.jpg">`+
+			`<img srcset="/disk_mock/banner-medium.jpg 300w, /disk_mock/banner.jpg 800w" sizes="(max-width: 800px) 100vw, 800px" src="/disk_mock/banner.jpg">`,
+		services.ImageSrcset(ctx, `<img src="/disk_mock/photo.jpg"><img src="/disk_mock/banner.jpg">`),
+	)
+
+	mockrepository.FakeRepoErrors["file_find"] = errors.New("Find files error")
+	defer delete(mockrepository.FakeRepoErrors, "file_find")
+	assert.Equal(t, `<img src="/disk_mock/photo.jpg">`, services.ImageSrcset(ctx, `<img src="/disk_mock/photo.jpg">`))
+}
+
+// createTestJpeg returns a JPEG image with an EXIF orientation and a comment segment
+func createTestJpeg(t *testing.T, width, height int, orientation byte) []byte {
+	buf := &bytes.Buffer{}
+	assert.NoError(t, jpeg.Encode(buf, image.NewRGBA(image.Rect(0, 0, width, height)), nil))
+	tiff := []byte("II\x2a\x00\x08\x00\x00\x00\x01\x00\x12\x01\x03\x00\x01\x00\x00\x00")
+	tiff = append(tiff, orientation, 0, 0, 0, 0, 0, 0, 0)
+	exif := append([]byte("Exif\x00\x00"), tiff...)
+	segments := []byte{0xFF, 0xD8, 0xFF, 0xE1, 0, byte(len(exif) + 2)}
+	segments = append(segments, exif...)
+	segments = append(segments, 0xFF, 0xFE, 0, 8, 'G', 'P', 'S', ' ', '4', '2')
+	return append(segments, buf.Bytes()[2:]...)
+}
+
+func TestStripImageMetadata(t *testing.T) {
+	data := createTestJpeg(t, 30, 20, 1)
+	defer config.Settings([]*config.SettingItem{{Name: "strip_image_metadata", Value: "yes", Type: "switch"}})
+
+	config.Settings([]*config.SettingItem{{Name: "strip_image_metadata", Value: "", Type: "switch"}})
+	in, size, err := services.StripImageMetadata("image/jpeg", bytes.NewReader(data), int64(len(data)))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(data)), size)
+	unchanged, _ := io.ReadAll(in)
+	assert.Equal(t, data, unchanged)
+
+	config.Settings([]*config.SettingItem{{Name: "strip_image_metadata", Value: "yes", Type: "switch"}})
+	in, size, err = services.StripImageMetadata("image/jpeg", bytes.NewReader(data), int64(len(data)))
+	assert.NoError(t, err)
+	stripped, _ := io.ReadAll(in)
+	assert.Equal(t, int64(len(stripped)), size)
+	assert.Less(t, len(stripped), len(data))
+	assert.False(t, bytes.Contains(stripped, []byte("GPS 42")))
+
+	pdf := []byte("%PDF-1.4")
+	in, _, err = services.StripImageMetadata("application/pdf", bytes.NewReader(pdf), int64(len(pdf)))
+	assert.NoError(t, err)
+	unchanged, _ = io.ReadAll(in)
+	assert.Equal(t, pdf, unchanged)
+
+	_, _, err = services.StripImageMetadata("image/jpeg", bytes.NewReader(pdf), int64(len(pdf)))
+	assert.Equal(t, imaging.ErrInvalidImage, err)
+
+	// the orientation of the original is applied to the variants
+	photo := &entities.File{Disk: "disk_mock", Path: "rotated.jpg", Type: "image/jpeg"}
+	fs.New("disk_mock", []fs.FSDisk{&mock.Disk{}})
+	assert.NoError(t, services.GenerateImageVariants(context.Background(), photo, bytes.NewReader(createTestJpeg(t, 600, 400, 6))))
+	assert.Equal(t, []int{400, 600}, []int{photo.Width, photo.Height})
+	assert.Equal(t, []int{200, 300}, []int{photo.Variants[1].Width, photo.Variants[1].Height})
+}
+
+func TestSanitizeSVGUpload(t *testing.T) {
+	pdf := []byte("%PDF-1.4")
+	in, _, err := services.SanitizeSVG("application/pdf", bytes.NewReader(pdf), int64(len(pdf)))
+	assert.NoError(t, err)
+	unchanged, _ := io.ReadAll(in)
+	assert.Equal(t, pdf, unchanged)
+
+	svg := []byte(`<svg xmlns="http://www.w3.org/2000/svg"><!-- editor --><rect width="10" height="10"/></svg>`)
+	in, size, err := services.SanitizeSVG("image/svg+xml", bytes.NewReader(svg), int64(len(svg)))
+	assert.NoError(t, err)
+	sanitized, _ := io.ReadAll(in)
+	assert.Equal(t, int64(len(sanitized)), size)
+	assert.Equal(t, `<svg xmlns="http://www.w3.org/2000/svg"><rect width="10" height="10"></rect></svg>`, string(sanitized))
+
+	unsafe := []byte(`<svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script></svg>`)
+	_, _, err = services.SanitizeSVG("image/svg+xml", bytes.NewReader(unsafe), int64(len(unsafe)))
+	assert.Equal(t, &services.UploadError{Message: "The SVG image can't be sanitized, scripts are not allowed"}, err)
+}
+
+func TestFileDeduplication(t *testing.T) {
+	mock.CreateRepositories()
+	ctx := context.Background()
+	fs.New("disk_mock", []fs.FSDisk{&mock.Disk{}})
+	disk := fs.Disk("disk_mock").(*mock.Disk)
+	disk.Files = map[string][]byte{"a.jpg": {}, "b.jpg": {}, "c.jpg": {}}
+	defer func() { disk.Files = nil }()
+	uploaded := []*entities.File{}
+
+	mockServer := mock.CreateServer()
+	mockServer.Post("/upload", func(c server.Context) error {
+		c.Locals("user", &entities.User{ID: 1})
+		f, err := services.SaveFile(c, "file")
+		assert.NoError(t, err)
+		uploaded = append(uploaded, f)
+		return c.SendString("ok")
+	})
+
+	mockServer.Test(mock.CreateUploadRequest("POST", "/upload", "file", "a.jpg"))
+	mockServer.Test(mock.CreateUploadRequest("POST", "/upload", "file", "b.jpg"))
+	assert.Equal(t, 2, len(uploaded))
+	assert.NotEqual(t, "", uploaded[0].Hash)
+	assert.Equal(t, uploaded[0].Hash, uploaded[1].Hash)
+	assert.Equal(t, "a.jpg", uploaded[1].Path)
+	assert.Equal(t, "b.jpg", uploaded[1].OriginalName)
+	assert.NotContains(t, disk.Files, "b.jpg")
+
+	// files without a hash aren't deduplicated
+	legacy, _ := repositories.File.Create(ctx, &entities.File{Disk: "disk_mock", Path: "c.jpg", UserID: 1})
+
+	assert.NoError(t, services.DeleteFile(ctx, uploaded[0]))
+	assert.Contains(t, disk.Files, "a.jpg")
+	assert.NoError(t, services.DeleteFile(ctx, uploaded[1]))
+	assert.NotContains(t, disk.Files, "a.jpg")
+	assert.NoError(t, services.DeleteFile(ctx, legacy))
+	assert.NotContains(t, disk.Files, "c.jpg")
+
+	mockrepository.FakeRepoErrors["file_deleteByID"] = errors.New("delete error")
+	assert.Equal(t, errors.New("delete error"), services.DeleteFile(ctx, legacy))
+	mockrepository.FakeRepoErrors["file_deleteByID"] = nil
+}
+
+func TestUploadLimits(t *testing.T) {
+	mock.CreateRepositories()
+	ctx := context.Background()
+	fs.New("disk_mock", []fs.FSDisk{&mock.Disk{}})
+	role := &entities.Role{ID: 2, Name: "User"}
+	user := &entities.User{ID: 1, Roles: []*entities.Role{role}}
+	var lastErr error
+	var lastFile *entities.File
+	var lastMessages []string
+
+	mockServer := mock.CreateServer()
+	mockServer.Post("/upload", func(c server.Context) error {
+		c.Locals("user", user)
+		lastFile, lastErr = services.SaveFile(c, "file")
+		lastMessages = []string{}
+		for _, message := range *c.Messages() {
+			lastMessages = append(lastMessages, message.Message)
+		}
+		return c.SendString("ok")
+	})
+
+	// the test file itself is used as an upload with a known size
+	mockServer.Test(mock.CreateUploadRequest("POST", "/upload", "file", "services_test.go"))
+	assert.NoError(t, lastErr)
+	assert.NotNil(t, lastFile)
+
+	role.MaxUploadSize = 10
+	mockServer.Test(mock.CreateUploadRequest("POST", "/upload", "file", "services_test.go"))
+	assert.Equal(t, &services.UploadError{Message: "The file is too large, the maximum upload size is 10 B"}, lastErr)
+	assert.Equal(t, []string{"The file is too large, the maximum upload size is 10 B"}, lastMessages)
+
+	// the most permissive role wins
+	user.Roles = append(user.Roles, &entities.Role{ID: 3, Name: "Editor"})
+	mockServer.Test(mock.CreateUploadRequest("POST", "/upload", "file", "services_test.go"))
+	assert.NoError(t, lastErr)
+	user.Roles = user.Roles[:1]
+	role.MaxUploadSize = 0
+
+	role.UploadMimeGroups = []string{"document", "audio"}
+	mockServer.Test(mock.CreateUploadRequest("POST", "/upload", "file", "image.jpg"))
+	assert.Equal(t, &services.UploadError{Message: "The file type is not allowed, allowed types: document, audio"}, lastErr)
+	role.UploadMimeGroups = []string{"image"}
+	mockServer.Test(mock.CreateUploadRequest("POST", "/upload", "file", "image.jpg"))
+	assert.NoError(t, lastErr)
+	role.UploadMimeGroups = nil
+
+	used, err := services.UsedStorage(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 300, used)
+
+	role.StorageQuota = 350
+	mockServer.Test(mock.CreateUploadRequest("POST", "/upload", "file", "services_test.go"))
+	assert.Equal(t, &services.UploadError{Message: "The storage quota of 350 B is exceeded, 300 B is used"}, lastErr)
+	mockServer.Test(mock.CreateUploadRequest("POST", "/upload", "file", "empty.jpg"))
+	assert.NoError(t, lastErr)
+
+	// root users have no limits
+	user.Roles = append(user.Roles, &entities.Role{ID: 1, Name: "Admin", Root: true})
+	mockServer.Test(mock.CreateUploadRequest("POST", "/upload", "file", "services_test.go"))
+	assert.NoError(t, lastErr)
+	user.Roles = user.Roles[:1]
+
+	mockrepository.FakeRepoErrors["file_total_size"] = errors.New("total size error")
+	assert.Equal(t, errors.New("total size error"), services.CheckUploadLimits(ctx, user, 10))
+	mockrepository.FakeRepoErrors["file_total_size"] = nil
+}
+
+func TestPrivateFiles(t *testing.T) {
+	mock.CreateRepositories()
+	privateDisk := &mock.PrivateDisk{Disk: &mock.Disk{}, SignKey: "key"}
+	fs.New("disk_mock", []fs.FSDisk{&mock.Disk{}, privateDisk})
+	content := `<a href="/files/private/disk_private/2022/doc.pdf">doc</a><img src="/disk_mock/a.jpg"><a href="/files/private/disk_private/sign/error">error</a>`
+	var guestContent, memberContent string
+	var uploaded *entities.File
+
+	mockServer := mock.CreateServer()
+	mockServer.Get("/guest", func(c server.Context) error {
+		guestContent = services.SignPrivateFileUrls(c, content)
+		return c.SendString("ok")
+	})
+	mockServer.Get("/member", func(c server.Context) error {
+		c.Locals("user", &entities.User{ID: 1})
+		memberContent = services.SignPrivateFileUrls(c, content)
+		return c.SendString("ok")
+	})
+	mockServer.Post("/upload", func(c server.Context) error {
+		c.Locals("user", &entities.User{ID: 1})
+		header, _ := c.File("file")
+		uploaded, _ = services.StoreUploadedFile(c, header, privateDisk)
+		return c.SendString("ok")
+	})
+
+	mock.GetRequest(mockServer, "/guest")
+	assert.Equal(t, content, guestContent)
+
+	mock.GetRequest(mockServer, "/member")
+	assert.Regexp(t, `<a href="/files/private/disk_private/2022/doc.pdf\?expires=\d+&amp;signature=[0-9a-f]{64}">doc</a>`, memberContent)
+	assert.Contains(t, memberContent, `<img src="/disk_mock/a.jpg">`)
+	assert.Contains(t, memberContent, `<a href="/files/private/disk_private/sign/error">error</a>`)
+
+	mockServer.Test(mock.CreateUploadRequest("POST", "/upload", "file", "doc.pdf"))
+	assert.Equal(t, "disk_private", uploaded.Disk)
+	assert.Equal(t, "/files/private/disk_private/doc.pdf", uploaded.Url())
+	assert.Contains(t, uploaded.PreviewUrl(), "/files/private/disk_private/doc.pdf?expires=")
+}
+
+func TestParseTusMetadata(t *testing.T) {
+	metadata, err := services.ParseTusMetadata("filename dmlkZW8ubXA0, is_confidential,filetype dmlkZW8vbXA0")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"filename": "video.mp4", "is_confidential": "", "filetype": "video/mp4"}, metadata)
+
+	metadata, err = services.ParseTusMetadata("")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{}, metadata)
+
+	_, err = services.ParseTusMetadata("filename a b")
+	assert.Error(t, err)
+	_, err = services.ParseTusMetadata("filename !!!")
+	assert.Error(t, err)
+}
+
+func TestTusUpload(t *testing.T) {
+	mock.CreateRepositories()
+	fs.New("disk_mock", []fs.FSDisk{&mock.Disk{}, &mock.PrivateDisk{Disk: &mock.Disk{}}})
+	privateDisk := fs.PrivateDisk().(*mock.PrivateDisk)
+	privateDisk.Files = map[string][]byte{}
+	user := &entities.User{ID: 1, Roles: []*entities.Role{{ID: 2}}}
+	var upload *services.TusUpload
+	var file *entities.File
+	var err error
+
+	mockServer := mock.CreateServer()
+	mockServer.Post("/create", func(c server.Context) error {
+		c.Locals("user", user)
+		metadata, _ := services.ParseTusMetadata(c.Header("Upload-Metadata"))
+		upload, err = services.CreateTusUpload(c, c.QueryInt("length"), metadata)
+		return c.SendString("ok")
+	})
+	mockServer.Post("/patch/:id", func(c server.Context) error {
+		c.Locals("user", user)
+		if upload, err = services.GetTusUpload(c.Context(), c.Param("id"), user.ID); err == nil {
+			file, err = services.WriteTusChunk(c, upload, c.QueryInt("offset"), c.Body())
+		}
+		return c.SendString("ok")
+	})
+	patch := func(offset int, chunk string) {
+		mockServer.Test(httptest.NewRequest("POST", fmt.Sprintf("/patch/%s?offset=%d", upload.ID, offset), strings.NewReader(chunk)))
+	}
+
+	mock.PostRequest(mockServer, "/create?length=0")
+	assert.Equal(t, &services.UploadError{Message: "The file is empty"}, err)
+
+	user.Roles[0].StorageQuota = 5
+	mock.PostRequest(mockServer, "/create?length=6")
+	assert.Equal(t, &services.UploadError{Message: "The storage quota of 5 B is exceeded, 0 B is used"}, err)
+	user.Roles[0].StorageQuota = 0
+
+	// private uploads are stored on the private disk
+	mock.PostRequest(mockServer, "/create?length=8", map[string]string{"Upload-Metadata": "filename ZG9jLnBkZg==,private dHJ1ZQ=="})
+	assert.NoError(t, err)
+	assert.Equal(t, "doc.pdf", upload.Filename())
+	assert.True(t, upload.Private())
+	patch(0, "%PDF")
+	assert.Nil(t, file)
+	assert.Equal(t, 4, upload.Offset)
+	assert.Equal(t, []byte("%PDF"), privateDisk.Files["tus/"+upload.ID+"/00000000000000000000"])
+
+	// the length of the uploads in progress is counted toward the quota, but not twice when they're completed
+	privateUpload := upload
+	user.Roles[0].StorageQuota = 10
+	mock.PostRequest(mockServer, "/create?length=4")
+	assert.Equal(t, &services.UploadError{Message: "The storage quota of 10 B is exceeded, 8 B is used"}, err)
+	upload = privateUpload
+	patch(4, "-1.4")
+	assert.NoError(t, err)
+	user.Roles[0].StorageQuota = 0
+	assert.Equal(t, "disk_private", file.Disk)
+	assert.Equal(t, "doc.pdf", file.OriginalName)
+	assert.True(t, upload.Completed())
+	assert.Equal(t, file.ID, upload.FileID)
+	patch(8, "")
+	assert.Equal(t, services.ErrTusOffsetMismatch, err)
+
+	_, err = services.GetTusUpload(context.Background(), upload.ID, 2)
+	assert.Equal(t, services.ErrTusUploadNotFound, err)
+	_, err = services.GetTusUpload(context.Background(), "../"+upload.ID, 1)
+	assert.Equal(t, services.ErrTusUploadNotFound, err)
+
+	// the completion is retried with an empty chunk when storing the file fails
+	mock.PostRequest(mockServer, "/create?length=4", map[string]string{"Upload-Metadata": "filename aW1hZ2UuanBn,filetype aW1hZ2UvanBlZw=="})
+	mockrepository.FakeRepoErrors["file_create"] = errors.New("Error creating file")
+	patch(0, "jpeg")
+	assert.Equal(t, errors.New("Error creating file"), err)
+	assert.False(t, upload.Completed())
+	mockrepository.FakeRepoErrors["file_create"] = nil
+	patch(4, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "disk_mock", file.Disk)
+	assert.Equal(t, "image/jpeg", file.Type)
+
+	// rejected files are removed with their staged chunks
+	user.Roles[0].UploadMimeGroups = []string{"video"}
+	mock.PostRequest(mockServer, "/create?length=4", map[string]string{"Upload-Metadata": "filename aW1hZ2UuanBn,filetype aW1hZ2UvanBlZw=="})
+	id := upload.ID
+	patch(0, "jpeg")
+	assert.Equal(t, &services.UploadError{Message: "The file type is not allowed, allowed types: video"}, err)
+	_, err = services.GetTusUpload(context.Background(), id, 1)
+	assert.Equal(t, services.ErrTusUploadNotFound, err)
+
+	for path := range privateDisk.Files {
+		assert.NotContains(t, path, id)
+	}
+}
+
+func TestExpireTusUploads(t *testing.T) {
+	ctx := context.Background()
+	fs.New("disk_mock", []fs.FSDisk{&mock.Disk{}})
+	expired, err := services.ExpireTusUploads(ctx, time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, expired)
+
+	fs.New("disk_mock", []fs.FSDisk{&mock.Disk{}, &mock.PrivateDisk{Disk: &mock.Disk{}}})
+	privateDisk := fs.PrivateDisk().(*mock.PrivateDisk)
+	info := func(upload *services.TusUpload) []byte {
+		data, _ := json.Marshal(upload)
+		return data
+	}
+	privateDisk.Files = map[string][]byte{
+		"tus/a/info.json":            info(&services.TusUpload{ID: "a", UserID: 1, Length: 8, Offset: 4, Chunks: []int{0}, CreatedAt: time.Now().Add(-2 * time.Hour)}),
+		"tus/a/00000000000000000000": []byte("%PDF"),
+		"tus/b/info.json":            info(&services.TusUpload{ID: "b", UserID: 1, Length: 4, Offset: 4, FileID: 1, CreatedAt: time.Now().Add(-2 * time.Hour)}),
+		"tus/c/info.json":            info(&services.TusUpload{ID: "c", UserID: 1, Length: 6, CreatedAt: time.Now()}),
+		"tus/d/info.json":            info(&services.TusUpload{ID: "d", UserID: 2, Length: 5, CreatedAt: time.Now()}),
+		"tus/e/00000000000000000000": []byte("orphaned"),
+		"doc.pdf":                    []byte("%PDF"),
+	}
+
+	staged, err := services.StagedTusSize(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 14, staged)
+
+	expired, err = services.ExpireTusUploads(ctx, time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, expired)
+	paths, _ := fs.List(ctx, privateDisk, "tus")
+	assert.Equal(t, []string{"tus/c/info.json", "tus/d/info.json"}, paths)
+	assert.Equal(t, []byte("%PDF"), privateDisk.Files["doc.pdf"])
+
+	staged, err = services.StagedTusSize(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, staged)
+}
+
+type cacheDisk struct {
+	*mock.Disk
+}
+
+func (d *cacheDisk) Name() string {
+	return "disk_cache"
+}
+
+func TestTransformImage(t *testing.T) {
+	ctx := context.Background()
+	fs.New("disk_mock", []fs.FSDisk{&mock.Disk{}, &cacheDisk{&mock.Disk{}}, &mock.PrivateDisk{Disk: &mock.Disk{}}})
+	source := fs.Disk("disk_mock").(*mock.Disk)
+	cache := fs.Disk("disk_cache").(*cacheDisk)
+	source.Files = map[string][]byte{"2022/photo.png": createTestImage(t, 600, 400), "broken.png": []byte("not an image")}
+	cache.Files = map[string][]byte{}
+	cacheDiskName := config.Image.CacheDisk
+	config.Image.CacheDisk = "disk_cache"
+	defer func() { config.Image.CacheDisk = cacheDiskName }()
+
+	transform := &imaging.Transform{Width: 300, Height: 300, Crop: true, Format: "image/jpeg", Disk: "disk_mock"}
+	result, err := services.TransformImage(ctx, transform, "2022/photo.png")
+	assert.NoError(t, err)
+	assert.Equal(t, "image/jpeg", result.Type)
+	assert.False(t, result.Cache)
+	data, _ := io.ReadAll(result)
+	assert.Equal(t, result.Size, len(data))
+	cfg, format, _ := image.DecodeConfig(bytes.NewReader(data))
+	assert.Equal(t, "jpeg", format)
+	assert.Equal(t, []int{300, 300}, []int{cfg.Width, cfg.Height})
+	sourceHash := sha256.Sum256([]byte("disk_mock\n2022/photo.png"))
+	sourceKey := hex.EncodeToString(sourceHash[:])
+	assert.Equal(t, data, cache.Files["cache/img/"+sourceKey[:2]+"/"+sourceKey+"/"+result.ETag+".jpg"])
+
+	cached, err := services.TransformImage(ctx, transform, "2022/photo.png")
+	assert.NoError(t, err)
+	assert.True(t, cached.Cache)
+	assert.Equal(t, result.ETag, cached.ETag)
+	cachedData, _ := io.ReadAll(cached)
+	assert.Equal(t, data, cachedData)
+
+	// the format of the original is kept and the default disk is used when they're not set
+	result, err = services.TransformImage(ctx, &imaging.Transform{Width: 100}, "2022/photo.png")
+	assert.NoError(t, err)
+	assert.Equal(t, "image/png", result.Type)
+	assert.Equal(t, 2, len(cache.Files))
+
+	_, err = services.TransformImage(ctx, &imaging.Transform{Format: "image/tiff"}, "2022/photo.png")
+	assert.Equal(t, imaging.ErrUnsupportedFormat, err)
+
+	for _, notFound := range []struct{ disk, path string }{
+		{"disk_mock", "2022/missing.png"},
+		{"disk_mock", "doc.pdf"},
+		{"disk_unknown", "2022/photo.png"},
+		{"disk_private", "2022/photo.png"},
+	} {
+		_, err = services.TransformImage(ctx, &imaging.Transform{Width: 100, Disk: notFound.disk}, notFound.path)
+		assert.Equal(t, services.ErrImageNotFound, err)
+	}
+
+	_, err = services.TransformImage(ctx, &imaging.Transform{Width: 100}, "broken.png")
+	assert.Equal(t, image.ErrFormat, err)
+
+	// the cached transforms of a removed image aren't served and they're purged with the image
+	source.Files["2022/other.png"] = createTestImage(t, 200, 100)
+	_, err = services.TransformImage(ctx, &imaging.Transform{Width: 100}, "2022/other.png")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(cache.Files))
+	photo := source.Files["2022/photo.png"]
+	delete(source.Files, "2022/photo.png")
+	_, err = services.TransformImage(ctx, transform, "2022/photo.png")
+	assert.Equal(t, services.ErrImageNotFound, err)
+	assert.NoError(t, services.PurgeImageTransforms(ctx, "disk_mock", "2022/photo.png"))
+	assert.Equal(t, 1, len(cache.Files))
+	source.Files["2022/photo.png"] = photo
+	result, err = services.TransformImage(ctx, transform, "2022/photo.png")
+	assert.NoError(t, err)
+	assert.False(t, result.Cache)
 }
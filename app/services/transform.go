@@ -0,0 +1,173 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"mime"
+	"path"
+
+	"github.com/ngocphuongnb/tetua/app/config"
+	"github.com/ngocphuongnb/tetua/app/fs"
+	"github.com/ngocphuongnb/tetua/app/imaging"
+	"github.com/ngocphuongnb/tetua/app/logger"
+)
+
+// imageTransformCacheDir is the directory of the transformed images on the cache disk
+const imageTransformCacheDir = "cache/img"
+
+var ErrImageNotFound = errors.New("image not found")
+
+// TransformedImage is the result of an image transform, ETag identifies the source image and the transform
+type TransformedImage struct {
+	io.ReadCloser
+	Type  string
+	Size  int
+	ETag  string
+	Cache bool
+}
+
+// TransformImage returns the transformed copy of an image from the cache disk,
+// the image is transformed and cached when it's not cached yet.
+// Images of the private disks aren't transformed.
+func TransformImage(ctx context.Context, t *imaging.Transform, filepath string) (*TransformedImage, error) {
+	disk := fs.Disk()
+
+	if t.Disk != "" {
+		disk = fs.Disk(t.Disk)
+	}
+
+	if disk == nil || fs.IsPrivate(disk) {
+		return nil, ErrImageNotFound
+	}
+
+	sourceMime, ok := variantSourceTypes[mime.TypeByExtension(path.Ext(filepath))]
+
+	if !ok {
+		return nil, ErrImageNotFound
+	}
+
+	result := &TransformedImage{Type: t.Format}
+
+	if result.Type == "" {
+		result.Type = sourceMime
+	}
+
+	if !imaging.CanEncode(result.Type) {
+		return nil, imaging.ErrUnsupportedFormat
+	}
+
+	hash := sha256.Sum256([]byte(disk.Name() + "\n" + t.String() + "\n" + filepath))
+	result.ETag = hex.EncodeToString(hash[:])
+	cachePath := path.Join(imageTransformCacheSourceDir(disk.Name(), filepath), result.ETag+imaging.Extensions[result.Type])
+	cacheDisk := fs.Disk(config.Image.CacheDisk)
+
+	// the source is opened before the cache so that the transforms of a removed image aren't served
+	reader, err := disk.Open(ctx, filepath)
+
+	if err != nil {
+		return nil, ErrImageNotFound
+	}
+
+	if cacheDisk != nil {
+		if cached, err := cacheDisk.Open(ctx, cachePath); err == nil {
+			reader.Close()
+			result.ReadCloser = cached
+			result.Cache = true
+			return result, nil
+		}
+	}
+
+	data, err := io.ReadAll(reader)
+	reader.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Image.MaxPixels > 0 && cfg.Width*cfg.Height > config.Image.MaxPixels {
+		return nil, fmt.Errorf("image is too large to transform: %dx%d", cfg.Width, cfg.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+
+	if err != nil {
+		return nil, err
+	}
+
+	quality := t.Quality
+
+	if quality == 0 {
+		quality = config.Image.Quality
+	}
+
+	buf := &bytes.Buffer{}
+	img = t.Apply(imaging.Orient(img, imaging.Orientation(data, sourceMime)))
+
+	if err := imaging.Encode(buf, img, result.Type, quality); err != nil {
+		return nil, err
+	}
+
+	result.Size = buf.Len()
+
+	if cacheDisk != nil {
+		if _, err := cacheDisk.Put(ctx, bytes.NewReader(buf.Bytes()), int64(buf.Len()), result.Type, cachePath); err != nil {
+			logger.Error("Error caching transformed image", err)
+		}
+	}
+
+	result.ReadCloser = io.NopCloser(buf)
+
+	return result, nil
+}
+
+// PurgeImageTransforms deletes the cached transforms of the images of a disk,
+// it's called when the images are deleted or their content is replaced
+func PurgeImageTransforms(ctx context.Context, diskName string, filepaths ...string) error {
+	cacheDisk := fs.Disk(config.Image.CacheDisk)
+
+	if cacheDisk == nil {
+		return nil
+	}
+
+	for _, filepath := range filepaths {
+		cachePaths, err := fs.List(ctx, cacheDisk, imageTransformCacheSourceDir(diskName, filepath))
+
+		// the transforms stay on a cache disk that can't be listed, they aren't served once the source is removed
+		if errors.Is(err, fs.ErrListNotSupported) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, cachePath := range cachePaths {
+			if err := cacheDisk.Delete(ctx, cachePath); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// imageTransformCacheSourceDir returns the cache directory of the transforms of an image,
+// the transforms of an image are grouped so that they can be purged together
+func imageTransformCacheSourceDir(diskName, filepath string) string {
+	hash := sha256.Sum256([]byte(diskName + "\n" + filepath))
+	key := hex.EncodeToString(hash[:])
+
+	return path.Join(imageTransformCacheDir, key[:2], key)
+}
@@ -0,0 +1,517 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/fs"
+	"github.com/ngocphuongnb/tetua/app/server"
+)
+
+// TusVersion is the supported version of the tus resumable upload protocol
+const TusVersion = "1.0.0"
+
+// tusDir is the directory of the staged uploads on the private disk
+const tusDir = "tus"
+
+// tusMemoryLimit is the size of a completed upload that is assembled in memory,
+// larger uploads are assembled in a temporary file
+const tusMemoryLimit = 32 << 20
+
+var (
+	ErrTusUploadNotFound = errors.New("upload not found")
+	ErrTusOffsetMismatch = errors.New("the upload offset doesn't match the stored size")
+	ErrTusNoStagingDisk  = errors.New("no private disk is configured to stage the uploads")
+)
+
+// TusUpload is a resumable upload, its chunks are staged on the private disk
+// until the upload is complete and the file is stored on the target disk
+type TusUpload struct {
+	ID        string            `json:"id"`
+	UserID    int               `json:"user_id"`
+	Length    int               `json:"length"`
+	Offset    int               `json:"offset"`
+	Metadata  map[string]string `json:"metadata"`
+	Chunks    []int             `json:"chunks"`
+	FileID    int               `json:"file_id"`
+	FileUrl   string            `json:"file_url"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// Filename returns the file name sent in the upload metadata
+func (u *TusUpload) Filename() string {
+	if name := OriginalFileName(u.Metadata["filename"]); name != "" {
+		return name
+	}
+
+	return u.ID
+}
+
+// Private reports whether the file is stored on the private disk when the upload is complete
+func (u *TusUpload) Private() bool {
+	return u.Metadata["private"] == "true"
+}
+
+func (u *TusUpload) Completed() bool {
+	return u.FileID > 0
+}
+
+func (u *TusUpload) infoPath() string {
+	return path.Join(tusDir, u.ID, "info.json")
+}
+
+func (u *TusUpload) chunkPath(offset int) string {
+	return path.Join(tusDir, u.ID, fmt.Sprintf("%020d", offset))
+}
+
+// ParseTusMetadata parses the Upload-Metadata header, it's a comma separated list of
+// keys and base64 encoded values, the value can be omitted
+func ParseTusMetadata(header string) (map[string]string, error) {
+	metadata := map[string]string{}
+
+	for _, pair := range strings.Split(header, ",") {
+		parts := strings.Fields(pair)
+
+		if len(parts) == 0 {
+			continue
+		}
+
+		if len(parts) > 2 {
+			return nil, fmt.Errorf("invalid upload metadata: %s", pair)
+		}
+
+		value := ""
+
+		if len(parts) == 2 {
+			decoded, err := base64.StdEncoding.DecodeString(parts[1])
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid upload metadata value of %s: %w", parts[0], err)
+			}
+
+			value = string(decoded)
+		}
+
+		metadata[parts[0]] = value
+	}
+
+	return metadata, nil
+}
+
+// CreateTusUpload stages a new upload of the length, the length is checked against
+// the upload limits of the user before any chunk is sent
+func CreateTusUpload(c server.Context, length int, metadata map[string]string) (*TusUpload, error) {
+	disk := fs.PrivateDisk()
+
+	if disk == nil {
+		return nil, ErrTusNoStagingDisk
+	}
+
+	if length <= 0 {
+		return nil, &UploadError{"The file is empty"}
+	}
+
+	if err := CheckUploadLimits(c.Context(), c.User(), length); err != nil {
+		return nil, err
+	}
+
+	id := make([]byte, 16)
+
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+
+	upload := &TusUpload{
+		ID:        hex.EncodeToString(id),
+		UserID:    c.User().ID,
+		Length:    length,
+		Metadata:  metadata,
+		CreatedAt: time.Now(),
+	}
+
+	if err := saveTusUpload(c.Context(), disk, upload); err != nil {
+		return nil, err
+	}
+
+	return upload, nil
+}
+
+// GetTusUpload returns a staged upload of the user
+func GetTusUpload(ctx context.Context, id string, userID int) (*TusUpload, error) {
+	disk := fs.PrivateDisk()
+
+	if disk == nil {
+		return nil, ErrTusNoStagingDisk
+	}
+
+	for _, r := range id {
+		if !strings.ContainsRune("0123456789abcdef", r) {
+			return nil, ErrTusUploadNotFound
+		}
+	}
+
+	upload, err := readTusUpload(ctx, disk, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if upload.ID != id || upload.UserID != userID {
+		return nil, ErrTusUploadNotFound
+	}
+
+	return upload, nil
+}
+
+// WriteTusChunk stages a chunk of the upload at the offset, the offset must be the size that is already stored.
+// When the last chunk is written the file is stored and created like the files uploaded with StoreUploadedFile,
+// an empty chunk at the end of the upload retries the completion.
+func WriteTusChunk(c server.Context, upload *TusUpload, offset int, chunk []byte) (*entities.File, error) {
+	disk := fs.PrivateDisk()
+
+	if disk == nil {
+		return nil, ErrTusNoStagingDisk
+	}
+
+	if upload.Completed() || offset != upload.Offset {
+		return nil, ErrTusOffsetMismatch
+	}
+
+	if offset+len(chunk) > upload.Length {
+		return nil, &UploadError{"The chunk exceeds the upload length"}
+	}
+
+	if len(chunk) > 0 {
+		if _, err := disk.Put(c.Context(), bytes.NewReader(chunk), int64(len(chunk)), "application/octet-stream", upload.chunkPath(offset)); err != nil {
+			return nil, err
+		}
+
+		upload.Chunks = append(upload.Chunks, offset)
+		upload.Offset += len(chunk)
+
+		if err := saveTusUpload(c.Context(), disk, upload); err != nil {
+			return nil, err
+		}
+	}
+
+	if upload.Offset < upload.Length {
+		return nil, nil
+	}
+
+	return completeTusUpload(c, disk, upload)
+}
+
+// DeleteTusUpload removes the staged chunks and the state of the upload
+func DeleteTusUpload(ctx context.Context, upload *TusUpload) error {
+	disk := fs.PrivateDisk()
+
+	if disk == nil {
+		return ErrTusNoStagingDisk
+	}
+
+	if err := deleteTusChunks(ctx, disk, upload); err != nil {
+		return err
+	}
+
+	return disk.Delete(ctx, upload.infoPath())
+}
+
+// completeTusUpload assembles the staged chunks into an uploaded file and stores it with StoreUploadedFile,
+// the upload is removed when the file is rejected so it can't be completed again
+func completeTusUpload(c server.Context, stagingDisk fs.FSDisk, upload *TusUpload) (*entities.File, error) {
+	reader := &tusChunksReader{ctx: c.Context(), disk: stagingDisk}
+
+	for _, offset := range upload.Chunks {
+		reader.paths = append(reader.paths, upload.chunkPath(offset))
+	}
+
+	defer reader.Close()
+	form, header, err := multipartFileHeader(upload.Filename(), upload.Metadata["filetype"], reader)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer form.RemoveAll()
+
+	if int(header.Size) != upload.Length {
+		return nil, fmt.Errorf("the staged chunks of upload %s have %d bytes, %d bytes expected", upload.ID, header.Size, upload.Length)
+	}
+
+	var disk fs.FSDisk
+
+	if upload.Private() {
+		disk = stagingDisk
+	}
+
+	file, err := StoreUploadedFile(c, header, disk)
+	var uploadErr *UploadError
+
+	if errors.As(err, &uploadErr) {
+		if err := DeleteTusUpload(c.Context(), upload); err != nil {
+			c.Logger().Error("Error deleting rejected upload", err)
+		}
+
+		return nil, err
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := deleteTusChunks(c.Context(), stagingDisk, upload); err != nil {
+		c.Logger().Error("Error deleting staged upload chunks", err)
+	}
+
+	upload.Chunks = nil
+	upload.FileID = file.ID
+	upload.FileUrl = file.Url()
+
+	if err := saveTusUpload(c.Context(), stagingDisk, upload); err != nil {
+		c.Logger().Error("Error saving completed upload", err)
+	}
+
+	return file, nil
+}
+
+// StagedTusSize returns the total length of the uploads of the user that are still in progress,
+// it's reserved from the storage quota until the uploads are completed or expired.
+// Nothing is reserved when the private disk can't list the staged uploads.
+func StagedTusSize(ctx context.Context, userID int) (int, error) {
+	disk := fs.PrivateDisk()
+
+	if disk == nil {
+		return 0, nil
+	}
+
+	uploads, err := listTusUploads(ctx, disk)
+
+	if errors.Is(err, fs.ErrListNotSupported) {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	size := 0
+
+	for _, staged := range uploads {
+		if staged.upload != nil && staged.upload.UserID == userID && !staged.upload.Completed() && staged.upload.Offset < staged.upload.Length {
+			size += staged.upload.Length
+		}
+	}
+
+	return size, nil
+}
+
+// ExpireTusUploads removes the staged uploads that were created before the ttl, either abandoned or completed,
+// and the staged objects whose upload state is missing. It returns the number of removed uploads.
+func ExpireTusUploads(ctx context.Context, ttl time.Duration) (int, error) {
+	disk := fs.PrivateDisk()
+
+	if disk == nil {
+		return 0, nil
+	}
+
+	uploads, err := listTusUploads(ctx, disk)
+
+	if err != nil {
+		return 0, err
+	}
+
+	before := time.Now().Add(-ttl)
+	expired := 0
+
+	for _, staged := range uploads {
+		if staged.upload != nil && staged.upload.CreatedAt.After(before) {
+			continue
+		}
+
+		for _, objectPath := range staged.paths {
+			if err := disk.Delete(ctx, objectPath); err != nil {
+				return expired, err
+			}
+		}
+
+		expired++
+	}
+
+	return expired, nil
+}
+
+// tusStagedUpload is an upload listed on the private disk with its staged objects,
+// the upload is nil when its state can't be read
+type tusStagedUpload struct {
+	upload *TusUpload
+	paths  []string
+}
+
+// listTusUploads groups the objects of the staging directory by their upload id
+func listTusUploads(ctx context.Context, disk fs.FSDisk) (map[string]*tusStagedUpload, error) {
+	paths, err := fs.List(ctx, disk, tusDir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	uploads := map[string]*tusStagedUpload{}
+
+	for _, objectPath := range paths {
+		id := strings.SplitN(strings.TrimPrefix(objectPath, tusDir+"/"), "/", 2)[0]
+
+		if uploads[id] == nil {
+			uploads[id] = &tusStagedUpload{}
+		}
+
+		uploads[id].paths = append(uploads[id].paths, objectPath)
+	}
+
+	for id, staged := range uploads {
+		staged.upload, _ = readTusUpload(ctx, disk, id)
+	}
+
+	return uploads, nil
+}
+
+func readTusUpload(ctx context.Context, disk fs.FSDisk, id string) (*TusUpload, error) {
+	reader, err := disk.Open(ctx, (&TusUpload{ID: id}).infoPath())
+
+	if err != nil {
+		return nil, ErrTusUploadNotFound
+	}
+
+	defer reader.Close()
+	upload := &TusUpload{}
+
+	if err := json.NewDecoder(reader).Decode(upload); err != nil {
+		return nil, err
+	}
+
+	return upload, nil
+}
+
+func saveTusUpload(ctx context.Context, disk fs.FSDisk, upload *TusUpload) error {
+	data, err := json.Marshal(upload)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = disk.Put(ctx, bytes.NewReader(data), int64(len(data)), "application/json", upload.infoPath())
+	return err
+}
+
+func deleteTusChunks(ctx context.Context, disk fs.FSDisk, upload *TusUpload) error {
+	for _, offset := range upload.Chunks {
+		if err := disk.Delete(ctx, upload.chunkPath(offset)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// multipartFileHeader writes the content to a multipart form so it can be stored like an uploaded file
+func multipartFileHeader(filename, mime string, content io.Reader) (*multipart.Form, *multipart.FileHeader, error) {
+	if mime == "" {
+		mime = "application/octet-stream"
+	}
+
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+
+	go func() {
+		partHeader := textproto.MIMEHeader{}
+		partHeader.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, strings.ReplaceAll(filename, `"`, "")))
+		partHeader.Set("Content-Type", mime)
+		part, err := writer.CreatePart(partHeader)
+
+		if err == nil {
+			_, err = io.Copy(part, content)
+		}
+
+		if err == nil {
+			err = writer.Close()
+		}
+
+		pw.CloseWithError(err)
+	}()
+
+	form, err := multipart.NewReader(pr, writer.Boundary()).ReadForm(tusMemoryLimit)
+	pr.Close()
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(form.File["file"]) == 0 {
+		form.RemoveAll()
+		return nil, nil, errors.New("the upload has no content")
+	}
+
+	return form, form.File["file"][0], nil
+}
+
+// tusChunksReader reads the staged chunks one after another
+type tusChunksReader struct {
+	ctx     context.Context
+	disk    fs.FSDisk
+	paths   []string
+	current io.ReadCloser
+}
+
+func (r *tusChunksReader) Read(p []byte) (int, error) {
+	for {
+		if r.current == nil {
+			if len(r.paths) == 0 {
+				return 0, io.EOF
+			}
+
+			current, err := r.disk.Open(r.ctx, r.paths[0])
+
+			if err != nil {
+				return 0, err
+			}
+
+			r.current = current
+			r.paths = r.paths[1:]
+		}
+
+		n, err := r.current.Read(p)
+
+		if err == io.EOF {
+			r.current.Close()
+			r.current = nil
+
+			if n == 0 {
+				continue
+			}
+
+			err = nil
+		}
+
+		return n, err
+	}
+}
+
+func (r *tusChunksReader) Close() error {
+	if r.current != nil {
+		return r.current.Close()
+	}
+
+	return nil
+}
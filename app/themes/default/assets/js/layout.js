@@ -1,3 +1,10 @@
+function csrfHeaders(headers) {
+  var tokenElm = document.querySelector('meta[name="csrf-token"]');
+  headers = headers || {};
+  headers['X-Csrf-Token'] = tokenElm ? tokenElm.getAttribute('content') : '';
+  return headers;
+}
+
 window.addEventListener('load', function () {
   var menuTriggerElms = Array.from(document.querySelectorAll('.menu-trigger'));
   for (var menuTriggerElm of menuTriggerElms) {
@@ -17,7 +17,7 @@ function fileInputPreviewer(element) {
 }
 
 function deleteNode(nodeType, url, nodeID, callback, e) {
-  fetch(url + `/${nodeID}`, { method: "DELETE" })
+  fetch(url + `/${nodeID}`, { method: "DELETE", headers: csrfHeaders() })
     .then(function (response) {
       if (response.status !== 200) {
         alert(`Error deleting ${nodeType}`);
@@ -61,7 +61,7 @@ function listenDeleteNodeEvents(nodeType, url, callback) {
 function uploadHandler(file, callback) {
   const formData = new FormData();
   formData.append("file", file);
-  fetch("/files/upload", { method: "POST", body: formData })
+  fetch("/files/upload", { method: "POST", headers: csrfHeaders(), body: formData })
     .then((res) => {
       if (!res.ok) {
         throw new Error("File upload failed");
@@ -73,7 +73,123 @@ function uploadHandler(file, callback) {
     .catch((e) => callback(null, e));
 }
 
+function mediaPicker(callback, type) {
+  var page = 1;
+  var search = "";
+  var modal = document.createElement("div");
+  modal.className = "media-picker";
+  modal.innerHTML = `<div class="media-picker-dialog">
+    <div class="media-picker-header">
+      <input type="text" placeholder="Search files..." />
+      <button type="button" class="media-picker-close">Close</button>
+    </div>
+    <div class="media-picker-files files-list"></div>
+    <button type="button" class="media-picker-more">Load more</button>
+  </div>`;
+
+  var filesElm = modal.querySelector(".media-picker-files");
+  var searchElm = modal.querySelector("input");
+  var moreElm = modal.querySelector(".media-picker-more");
+  var close = function () {
+    modal.remove();
+  };
+
+  var load = function () {
+    var query = new URLSearchParams({ format: "json", type: type || "", q: search, page: page });
+    fetch(`/files?${query.toString()}`)
+      .then(function (res) {
+        if (!res.ok) {
+          throw new Error("Error loading files");
+        }
+
+        return res.json();
+      })
+      .then(function (res) {
+        for (var file of res.data) {
+          var fileElm = document.createElement("a");
+          fileElm.href = "#";
+          fileElm.title = file.name;
+
+          if (file.type.indexOf("image/") === 0) {
+            var imgElm = document.createElement("img");
+            imgElm.src = file.thumbnail;
+            imgElm.alt = file.alt;
+            fileElm.append(imgElm);
+          } else {
+            fileElm.innerText = file.name;
+          }
+
+          fileElm.addEventListener("click", function (file, e) {
+            e.preventDefault();
+            close();
+            callback(file);
+          }.bind(null, file));
+
+          filesElm.append(fileElm);
+        }
+
+        moreElm.style.display = res.page_current < res.total ? "" : "none";
+      })
+      .catch(function (err) {
+        console.error(err);
+        alert("Error loading files");
+      });
+  };
+
+  searchElm.addEventListener("keydown", function (e) {
+    if (e.key !== "Enter") {
+      return;
+    }
+
+    e.preventDefault();
+    search = searchElm.value;
+    page = 1;
+    filesElm.innerHTML = "";
+    load();
+  });
+  moreElm.addEventListener("click", function () {
+    page++;
+    load();
+  });
+  modal.querySelector(".media-picker-close").addEventListener("click", close);
+  modal.addEventListener("click", function (e) {
+    if (e.target === modal) {
+      close();
+    }
+  });
+
+  document.body.append(modal);
+  searchElm.focus();
+  load();
+}
+
+function imagePickHandler(callback) {
+  mediaPicker(function (file) {
+    callback({ url: file.url, alt: file.alt, title: file.title });
+  }, "image");
+}
+
+function featuredImagePicker(element) {
+  element.addEventListener("click", function (e) {
+    e.preventDefault();
+    var container = element.parentNode;
+    mediaPicker(function (file) {
+      container.querySelector("input[type=hidden]").value = file.id;
+      container.querySelector("input[type=file]").value = "";
+      container.querySelector(".image-upload-previewer img").src = file.url;
+    }, "image");
+  });
+}
+
 window.addEventListener('load', function () {
+  var featuredImagePickers = Array.from(
+    document.querySelectorAll(".pick-featured-image")
+  );
+
+  for (var pickerElm of featuredImagePickers) {
+    featuredImagePicker(pickerElm);
+  }
+
   var imagePreviewers = Array.from(
     document.querySelectorAll(".image-upload-previewer")
   );
@@ -1,5 +1,5 @@
 function approvePost(postID, e) {
-  fetch(`/manage/posts/${postID}/approve`, { method: "POST" })
+  fetch(`/manage/posts/${postID}/approve`, { method: "POST", headers: csrfHeaders() })
     .then(function (response) {
       if (response.status !== 200) {
         alert(`Error approve post: ${postID}`);
@@ -32,3 +32,71 @@ window.addEventListener("load", function () {
     });
   }
 });
+
+function initMenuEditor(maxDepth) {
+  var form = document.querySelector(".menu-form");
+  var list = form.querySelector(".menu-items");
+  var template = document.getElementById("menu-item-template");
+
+  function setDepth(row, depth) {
+    depth = Math.max(0, Math.min(depth, maxDepth - 1));
+    row.setAttribute("data-depth", depth);
+    row.style.marginLeft = `${depth * 2}em`;
+    row.querySelector(".menu-item-depth").value = depth;
+  }
+
+  function toggleTargets(row) {
+    var type = row.querySelector(".menu-item-type").value;
+    for (var target of row.querySelectorAll(".menu-item-target")) {
+      target.style.display = target.getAttribute("data-type") === type ? "" : "none";
+    }
+  }
+
+  function bindRow(row) {
+    toggleTargets(row);
+    row.querySelector(".menu-item-type").addEventListener("change", function () {
+      toggleTargets(row);
+    });
+    row.querySelector(".menu-item-actions").addEventListener("click", function (e) {
+      var action = e.target.getAttribute("data-action");
+      var depth = parseInt(row.getAttribute("data-depth"), 10) || 0;
+      var prev = row.previousElementSibling;
+
+      switch (action) {
+        case "up":
+          prev && list.insertBefore(row, prev);
+          break;
+        case "down":
+          row.nextElementSibling && list.insertBefore(row.nextElementSibling, row);
+          break;
+        case "indent":
+          prev && setDepth(row, Math.min(depth + 1, parseInt(prev.getAttribute("data-depth"), 10) + 1));
+          break;
+        case "outdent":
+          setDepth(row, depth - 1);
+          break;
+        case "remove":
+          row.remove();
+          break;
+      }
+    });
+  }
+
+  for (var row of list.querySelectorAll(".menu-item")) {
+    bindRow(row);
+  }
+
+  form.querySelector(".add-menu-item").addEventListener("click", function () {
+    var row = template.content.firstElementChild.cloneNode(true);
+    list.appendChild(row);
+    bindRow(row);
+  });
+
+  form.addEventListener("submit", function () {
+    Array.from(list.querySelectorAll(".menu-item")).forEach(function (row, index) {
+      for (var input of row.querySelectorAll("[name^='items.']")) {
+        input.name = input.name.replace(/^items\.\d+\./, `items.${index}.`);
+      }
+    });
+  });
+}
@@ -0,0 +1,130 @@
+function base64urlToBuffer(value) {
+  var base64 = value.replace(/-/g, "+").replace(/_/g, "/");
+  var padded = base64 + "===".slice((base64.length + 3) % 4);
+  return Uint8Array.from(atob(padded), function (c) {
+    return c.charCodeAt(0);
+  }).buffer;
+}
+
+function bufferToBase64url(buffer) {
+  var binary = String.fromCharCode.apply(null, new Uint8Array(buffer));
+  return btoa(binary).replace(/\+/g, "-").replace(/\//g, "_").replace(/=+$/, "");
+}
+
+function passkeyJson(res) {
+  if (!res.ok) {
+    throw new Error("Passkey request failed");
+  }
+
+  return res.json();
+}
+
+function passkeyLogin() {
+  fetch("/auth/passkey")
+    .then(passkeyJson)
+    .then(function (options) {
+      options.publicKey.challenge = base64urlToBuffer(options.publicKey.challenge);
+      (options.publicKey.allowCredentials || []).forEach(function (credential) {
+        credential.id = base64urlToBuffer(credential.id);
+      });
+
+      return navigator.credentials.get(options);
+    })
+    .then(function (credential) {
+      return fetch("/auth/passkey/callback", {
+        method: "POST",
+        headers: csrfHeaders({ "Content-Type": "application/json" }),
+        body: JSON.stringify({
+          id: credential.id,
+          rawId: bufferToBase64url(credential.rawId),
+          type: credential.type,
+          response: {
+            authenticatorData: bufferToBase64url(credential.response.authenticatorData),
+            clientDataJSON: bufferToBase64url(credential.response.clientDataJSON),
+            signature: bufferToBase64url(credential.response.signature),
+            userHandle: credential.response.userHandle
+              ? bufferToBase64url(credential.response.userHandle)
+              : "",
+          },
+        }),
+      });
+    })
+    .then(function (res) {
+      if (!res.ok) {
+        throw new Error("Passkey login failed");
+      }
+
+      window.location.href = res.url || "/";
+    })
+    .catch(function (err) {
+      console.error(err);
+      alert("Passkey login failed");
+    });
+}
+
+function passkeyRegister(name) {
+  fetch("/settings/passkeys/options", { method: "POST", headers: csrfHeaders() })
+    .then(passkeyJson)
+    .then(function (options) {
+      options.publicKey.challenge = base64urlToBuffer(options.publicKey.challenge);
+      options.publicKey.user.id = base64urlToBuffer(options.publicKey.user.id);
+      (options.publicKey.excludeCredentials || []).forEach(function (credential) {
+        credential.id = base64urlToBuffer(credential.id);
+      });
+
+      return navigator.credentials.create(options);
+    })
+    .then(function (credential) {
+      return fetch("/settings/passkeys?name=" + encodeURIComponent(name), {
+        method: "POST",
+        headers: csrfHeaders({ "Content-Type": "application/json" }),
+        body: JSON.stringify({
+          id: credential.id,
+          rawId: bufferToBase64url(credential.rawId),
+          type: credential.type,
+          transports: credential.response.getTransports
+            ? credential.response.getTransports()
+            : [],
+          response: {
+            attestationObject: bufferToBase64url(credential.response.attestationObject),
+            clientDataJSON: bufferToBase64url(credential.response.clientDataJSON),
+          },
+        }),
+      });
+    })
+    .then(passkeyJson)
+    .then(function () {
+      window.location.reload();
+    })
+    .catch(function (err) {
+      console.error(err);
+      alert("Error registering passkey");
+    });
+}
+
+window.addEventListener("load", function () {
+  var loginBtn = document.getElementById("passkey-login");
+  var registerBtn = document.getElementById("passkey-register");
+
+  if (!window.PublicKeyCredential) {
+    Array.from(document.querySelectorAll(".passkey")).forEach(function (elm) {
+      elm.style.display = "none";
+    });
+    return;
+  }
+
+  if (loginBtn) {
+    loginBtn.addEventListener("click", function (e) {
+      e.preventDefault();
+      passkeyLogin();
+    });
+  }
+
+  if (registerBtn) {
+    registerBtn.addEventListener("click", function (e) {
+      e.preventDefault();
+      var nameInput = document.getElementById("passkey-name");
+      passkeyRegister(nameInput ? nameInput.value : "");
+    });
+  }
+});
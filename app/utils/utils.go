@@ -329,3 +329,21 @@ func FirstError(errors ...error) error {
 
 	return nil
 }
+
+// FormatSize returns a human readable size, e.g. 1.5 MB
+func FormatSize(size int) string {
+	units := []string{"B", "KB", "MB", "GB", "TB"}
+	value := float64(size)
+	unit := 0
+
+	for value >= 1024 && unit < len(units)-1 {
+		value /= 1024
+		unit++
+	}
+
+	if unit == 0 {
+		return fmt.Sprintf("%d %s", size, units[0])
+	}
+
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.1f", value), "0"), ".") + " " + units[unit]
+}
@@ -130,3 +130,11 @@ func TestFirstError(t *testing.T) {
 	assert.Equal(t, err1, utils.FirstError(nil, err1))
 	assert.Equal(t, err2, utils.FirstError(nil, err2, err1))
 }
+
+func TestFormatSize(t *testing.T) {
+	assert.Equal(t, "0 B", utils.FormatSize(0))
+	assert.Equal(t, "512 B", utils.FormatSize(512))
+	assert.Equal(t, "1 KB", utils.FormatSize(1024))
+	assert.Equal(t, "1.5 MB", utils.FormatSize(1536*1024))
+	assert.Equal(t, "2 GB", utils.FormatSize(2*1024*1024*1024))
+}
@@ -1,19 +1,26 @@
 package web
 
 import (
+	"errors"
+	"fmt"
+	"mime"
 	"net/http"
-	"sync"
+	"path"
+	"strings"
+	"time"
 
+	"github.com/ngocphuongnb/tetua/app/config"
 	"github.com/ngocphuongnb/tetua/app/entities"
 	"github.com/ngocphuongnb/tetua/app/fs"
 	"github.com/ngocphuongnb/tetua/app/repositories"
 	"github.com/ngocphuongnb/tetua/app/server"
+	"github.com/ngocphuongnb/tetua/app/services"
 	"github.com/ngocphuongnb/tetua/app/utils"
 	"github.com/ngocphuongnb/tetua/views"
 )
 
 func FileList(c server.Context) error {
-	paginate, err := repositories.File.Paginate(c.Context(), &entities.FileFilter{
+	filter := services.FileFilterFromQuery(c, &entities.FileFilter{
 		UserIDs: []int{c.User().ID},
 		Filter: &entities.Filter{
 			BaseUrl:         utils.Url("/files"),
@@ -22,35 +29,87 @@ func FileList(c server.Context) error {
 			IgnoreUrlParams: []string{"user"},
 		},
 	})
+	paginate, err := repositories.File.Paginate(c.Context(), filter)
+
+	if c.Query("format") == "json" {
+		if err != nil {
+			c.Logger().Error(err)
+			return c.Status(http.StatusInternalServerError).Json(entities.Map{
+				"error": "Error loading files",
+			})
+		}
+
+		files := []entities.Map{}
+		for _, file := range paginate.Data {
+			files = append(files, fileJson(file))
+		}
+
+		return c.Json(entities.Map{
+			"data":         files,
+			"page_current": paginate.PageCurrent,
+			"total":        paginate.Total,
+		})
+	}
 
 	if err != nil {
 		c.Logger().Error(err)
 		return c.Status(http.StatusInternalServerError).Render(views.Error("Something went wrong"))
 	}
 
-	return c.Render(views.FileList(paginate))
+	used, err := services.UsedStorage(c.Context(), c.User().ID)
+	if err != nil {
+		c.Logger().Error(err)
+	}
+
+	return c.Render(views.FileList(paginate, filter, used, c.User().UploadLimits()))
+}
+
+func FileEdit(c server.Context) error {
+	file, ok := c.Locals("file").(*entities.File)
+
+	if !ok || file == nil {
+		return c.Status(http.StatusNotFound).Render(views.Error("File not found"))
+	}
+
+	return fileEditView(c, file, false)
+}
+
+func FileSave(c server.Context) (err error) {
+	file, ok := c.Locals("file").(*entities.File)
+
+	if !ok || file == nil {
+		return c.Status(http.StatusNotFound).Render(views.Error("File not found"))
+	}
+
+	data := getFileSaveData(c)
+	file.Title = data.Title
+	file.Alt = data.Alt
+	file.Caption = data.Caption
+	file.Tags = entities.ParseFileTags(data.Tags)
+
+	if c.Messages().HasError() {
+		return fileEditView(c, file, true)
+	}
+
+	if _, err = repositories.File.Update(c.Context(), file); err != nil {
+		c.WithError("Error saving file", err)
+		return fileEditView(c, file, true)
+	}
+
+	return c.Redirect(fmt.Sprintf("/files/%d", file.ID))
 }
 
 func FileDelete(c server.Context) (err error) {
-	var wg sync.WaitGroup
-	var err1, err2 error
-	wg.Add(2)
-
-	go func(wg *sync.WaitGroup) {
-		defer wg.Done()
-		err1 = repositories.File.DeleteByID(c.Context(), c.ParamInt("id"))
-	}(&wg)
-
-	go func(wg *sync.WaitGroup) {
-		defer wg.Done()
-		if file, ok := c.Locals("file").(*entities.File); ok && file != nil {
-			err2 = file.Delete(c.Context())
-		}
-	}(&wg)
+	file, ok := c.Locals("file").(*entities.File)
 
-	wg.Wait()
+	if !ok || file == nil {
+		return c.Status(http.StatusNotFound).Json(&entities.Message{
+			Type:    "error",
+			Message: "File not found",
+		})
+	}
 
-	if err := utils.FirstError(err1, err2); err != nil {
+	if err := services.DeleteFile(c.Context(), file); err != nil {
 		c.Logger().Error(err)
 		return c.Status(http.StatusBadRequest).Json(&entities.Message{
 			Type:    "error",
@@ -66,24 +125,23 @@ func FileDelete(c server.Context) (err error) {
 
 func Upload(c server.Context) error {
 	if uploadFile, err := c.File("file"); err == nil {
-		if uploadedFile, err := fs.Disk().PutMultipart(c.Context(), uploadFile); err != nil {
-			c.Logger().Error(err)
-		} else {
-			f, err := repositories.File.Create(c.Context(), &entities.File{
-				Disk:   uploadedFile.Disk,
-				Path:   uploadedFile.Path,
-				Type:   uploadedFile.Type,
-				Size:   uploadedFile.Size,
-				UserID: c.User().ID,
-			})
-
-			if err != nil {
-				c.Logger().Error(err)
-				return c.Status(http.StatusInternalServerError).Json(entities.Map{
-					"error": "Error saving file",
+		var disk fs.FSDisk
+		if c.FormValue("private") == "true" {
+			if disk = fs.PrivateDisk(); disk == nil {
+				return c.Status(http.StatusBadRequest).Json(entities.Map{
+					"error": "No private disk is configured",
 				})
 			}
+		}
 
+		var uploadErr *services.UploadError
+		if f, err := services.StoreUploadedFile(c, uploadFile, disk); errors.As(err, &uploadErr) {
+			return c.Status(http.StatusBadRequest).Json(entities.Map{
+				"error": uploadErr.Message,
+			})
+		} else if err != nil {
+			c.Logger().Error(err)
+		} else {
 			return c.Json(entities.Map{
 				"size": f.Size,
 				"type": f.Type,
@@ -98,3 +156,94 @@ func Upload(c server.Context) error {
 		"error": "Error saving file",
 	})
 }
+
+// PrivateFile serves a file of a private disk after its signature and expiry time are verified
+func PrivateFile(c server.Context) error {
+	diskName := c.Param("disk")
+	filePath := c.Param("*")
+	disk := fs.Disk(diskName)
+	expires := int64(c.QueryInt("expires"))
+
+	if disk == nil || !fs.IsPrivate(disk) {
+		return c.Status(http.StatusNotFound).SendString("File not found")
+	}
+
+	if !fs.VerifySignature(config.APP_KEY, diskName, filePath, expires, c.Query("signature")) {
+		return c.Status(http.StatusForbidden).SendString("The link is invalid or has expired")
+	}
+
+	reader, err := disk.Open(c.Context(), filePath)
+
+	if err != nil {
+		c.Logger().Error("Error opening private file", err)
+		return c.Status(http.StatusNotFound).SendString("File not found")
+	}
+
+	contentType := mime.TypeByExtension(path.Ext(filePath))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	c.Header("Content-Type", contentType)
+	c.Header("Cache-Control", fmt.Sprintf("private, max-age=%d", expires-time.Now().Unix()))
+
+	return c.SendStream(reader)
+}
+
+func fileEditView(c server.Context, file *entities.File, isSave bool) error {
+	status := http.StatusOK
+	usages, err := repositories.File.Usages(c.Context(), file)
+	c.Meta().Title = "Edit file: " + file.Name()
+
+	if err != nil {
+		c.WithError("Error loading file usages", err)
+	}
+
+	if isSave && c.Messages().HasError() {
+		status = http.StatusBadRequest
+	}
+
+	file.Usages = usages
+
+	return c.Status(status).Render(views.FileEdit(file))
+}
+
+func getFileSaveData(c server.Context) *entities.FileMutation {
+	data := &entities.FileMutation{}
+
+	if err := c.BodyParser(data); err != nil {
+		c.WithError("Error parsing body", err)
+		return data
+	}
+
+	data.Title = strings.TrimSpace(data.Title)
+	data.Alt = strings.TrimSpace(data.Alt)
+	data.Caption = strings.TrimSpace(data.Caption)
+
+	if len(data.Title) > 255 {
+		c.Messages().AppendError("Title can't be more than 255 characters")
+	}
+
+	if len(data.Alt) > 255 {
+		c.Messages().AppendError("Alt text can't be more than 255 characters")
+	}
+
+	return data
+}
+
+// fileJson returns the file data that the editor and the media picker use
+func fileJson(file *entities.File) entities.Map {
+	return entities.Map{
+		"id":        file.ID,
+		"name":      file.Name(),
+		"size":      file.Size,
+		"type":      file.Type,
+		"url":       file.Url(),
+		"thumbnail": file.Url("thumbnail"),
+		"width":     file.Width,
+		"height":    file.Height,
+		"title":     file.Title,
+		"alt":       file.Alt,
+		"caption":   file.Caption,
+	}
+}
@@ -0,0 +1,59 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/ngocphuongnb/tetua/app/config"
+	"github.com/ngocphuongnb/tetua/app/imaging"
+	"github.com/ngocphuongnb/tetua/app/server"
+	"github.com/ngocphuongnb/tetua/app/services"
+)
+
+// ImageTransform serves a resized, cropped or converted copy of a stored image,
+// the params must be signed with the app key, see entities.File.TransformUrl
+func ImageTransform(c server.Context) error {
+	filePath := c.Param("*")
+	transform, signature, err := imaging.ParseTransform(c.Param("params"))
+
+	if err != nil {
+		return c.Status(http.StatusBadRequest).SendString("Invalid image params")
+	}
+
+	if !transform.Verify(config.APP_KEY, filePath, signature) {
+		return c.Status(http.StatusForbidden).SendString("Invalid image signature")
+	}
+
+	result, err := services.TransformImage(c.Context(), transform, filePath)
+
+	if err != nil {
+		if errors.Is(err, services.ErrImageNotFound) {
+			return c.Status(http.StatusNotFound).SendString("Image not found")
+		}
+
+		if errors.Is(err, imaging.ErrUnsupportedFormat) {
+			return c.Status(http.StatusBadRequest).SendString("Unsupported image format")
+		}
+
+		c.Logger().Error("Error transforming image", err)
+		return c.Status(http.StatusInternalServerError).SendString("Error transforming image")
+	}
+
+	defer result.Close()
+	etag := `"` + result.ETag + `"`
+	// the url is signed and the transform of an image never changes
+	c.Header("Cache-Control", "public, max-age=31536000, immutable")
+	c.Header("ETag", etag)
+
+	if c.Header("If-None-Match") == etag {
+		return c.Status(http.StatusNotModified).SendString("")
+	}
+
+	c.Header("Content-Type", result.Type)
+
+	if result.Size > 0 {
+		return c.SendStream(result, result.Size)
+	}
+
+	return c.SendStream(result)
+}
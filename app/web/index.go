@@ -50,7 +50,7 @@ func Index(c server.Context) (err error) {
 		return c.Status(http.StatusBadGateway).Render(views.Error("Something went wrong"))
 	}
 
-	return c.Render(views.Index(cache.Topics, paginate, topPosts))
+	return c.Render(views.Index(cache.Topics(), paginate, topPosts))
 }
 
 func Search(c server.Context) (err error) {
@@ -75,5 +75,5 @@ func Search(c server.Context) (err error) {
 		return c.Status(http.StatusBadGateway).Render(views.Error("Something went wrong"))
 	}
 
-	return c.Render(views.Search(cache.Topics, paginate))
+	return c.Render(views.Search(cache.Topics(), paginate))
 }
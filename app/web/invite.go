@@ -0,0 +1,115 @@
+package web
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ngocphuongnb/tetua/app/auth"
+	"github.com/ngocphuongnb/tetua/app/cache"
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+	"github.com/ngocphuongnb/tetua/app/server"
+	"github.com/ngocphuongnb/tetua/app/utils"
+	"github.com/ngocphuongnb/tetua/views"
+)
+
+func InviteList(c server.Context) error {
+	c.Meta().Title = "Invites"
+	filter := &entities.InviteFilter{
+		Filter: &entities.Filter{
+			BaseUrl:         utils.Url("/invites"),
+			Page:            c.QueryInt("page"),
+			Limit:           20,
+			IgnoreUrlParams: []string{"user"},
+		},
+	}
+
+	if !c.User().IsRoot() {
+		filter.UserIDs = []int{c.User().ID}
+	}
+
+	paginate, err := repositories.Invite.Paginate(c.Context(), filter)
+
+	if err != nil {
+		c.Logger().Error(err)
+		return c.Status(http.StatusInternalServerError).Render(views.Error("Something went wrong"))
+	}
+
+	return c.Render(views.InviteList(paginate, inviteRoles(c)))
+}
+
+func InviteSave(c server.Context) error {
+	data := &entities.InviteMutation{}
+
+	if err := c.BodyParser(data); err != nil {
+		c.Logger().Error(err)
+		return c.Status(http.StatusBadRequest).Render(views.Error("Invalid invite data"))
+	}
+
+	invite := &entities.Invite{
+		Code:    strings.TrimSpace(data.Code),
+		UserID:  c.User().ID,
+		RoleID:  auth.ROLE_USER.ID,
+		MaxUses: data.MaxUses,
+	}
+
+	if invite.Code == "" {
+		invite.Code = generateInviteCode()
+	}
+
+	if invite.MaxUses < 0 {
+		invite.MaxUses = 0
+	}
+
+	if data.ExpiresIn > 0 {
+		expiresAt := time.Now().Add(time.Hour * 24 * time.Duration(data.ExpiresIn))
+		invite.ExpiresAt = &expiresAt
+	}
+
+	// Only root users can invite people into a role other than the default user role
+	if c.User().IsRoot() && data.RoleID > 0 {
+		invite.RoleID = data.RoleID
+	}
+
+	if _, err := repositories.Invite.Create(c.Context(), invite); err != nil {
+		c.WithError("Error saving invite", err)
+		return InviteList(c)
+	}
+
+	return c.Redirect(utils.Url("/invites"))
+}
+
+func InviteDelete(c server.Context) error {
+	if err := repositories.Invite.DeleteByID(c.Context(), c.ParamInt("id")); err != nil {
+		c.Logger().Error(err)
+		return c.Status(http.StatusBadRequest).Json(&entities.Message{
+			Type:    "error",
+			Message: "Error deleting invite",
+		})
+	}
+
+	return c.Status(http.StatusOK).Json(&entities.Message{
+		Type:    "success",
+		Message: "Invite deleted",
+	})
+}
+
+func inviteRoles(c server.Context) []*entities.Role {
+	if !c.User().IsRoot() {
+		return nil
+	}
+
+	return utils.SliceFilter(cache.Roles(), func(role *entities.Role) bool {
+		return role.ID != auth.ROLE_GUEST.ID
+	})
+}
+
+func generateInviteCode() string {
+	b := make([]byte, 10)
+	rand.Read(b)
+
+	return strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b))
+}
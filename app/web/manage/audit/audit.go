@@ -0,0 +1,78 @@
+package manageaudit
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/ngocphuongnb/tetua/app/auth"
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+	"github.com/ngocphuongnb/tetua/app/server"
+	"github.com/ngocphuongnb/tetua/app/utils"
+	"github.com/ngocphuongnb/tetua/views"
+)
+
+// TargetTypes are the types of the audited targets
+var TargetTypes = []string{"custom_field", "menu", "page", "post", "role", "setting", "topic", "user"}
+
+func Index(c server.Context) (err error) {
+	status := http.StatusOK
+	search := c.Query("q")
+	action := c.Query("action")
+	targetType := c.Query("target_type")
+	userID := c.QueryInt("user")
+	targetID := c.QueryInt("target_id")
+	filter := &entities.AuditLogFilter{
+		Filter: &entities.Filter{
+			BaseUrl: utils.Url("/manage/audit"),
+			Page:    c.QueryInt("page", 1),
+			Limit:   30,
+			Search:  search,
+		},
+	}
+
+	if userID > 0 {
+		filter.UserIDs = []int{userID}
+	}
+
+	if action != "" {
+		filter.Actions = []string{action}
+	}
+
+	if targetType != "" {
+		filter.TargetTypes = []string{targetType}
+	}
+
+	if targetID > 0 {
+		filter.TargetIDs = []int{targetID}
+	}
+
+	paginate, err := repositories.AuditLog.Paginate(c.Context(), filter)
+	c.Meta().Title = "Audit log"
+
+	if err != nil {
+		status = http.StatusBadRequest
+		c.WithError("Load audit logs error", err)
+		paginate = &entities.Paginate[entities.AuditLog]{}
+	}
+
+	return c.Status(status).Render(views.ManageAuditIndex(
+		paginate,
+		search,
+		action,
+		targetType,
+		userID,
+		targetID,
+		actions(),
+		TargetTypes,
+	))
+}
+
+func actions() []string {
+	actions := utils.SliceMap(auth.ActionConfigs, func(config *server.AuthConfig) string {
+		return config.Action
+	})
+	sort.Strings(actions)
+
+	return actions
+}
@@ -0,0 +1,197 @@
+package managecustomfield
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+	"github.com/ngocphuongnb/tetua/app/server"
+	"github.com/ngocphuongnb/tetua/app/services"
+	"github.com/ngocphuongnb/tetua/app/utils"
+	"github.com/ngocphuongnb/tetua/views"
+)
+
+func Index(c server.Context) (err error) {
+	status := http.StatusOK
+	fields, err := repositories.CustomField.All(c.Context())
+	c.Meta().Title = "Manage custom fields"
+
+	if err != nil {
+		status = http.StatusBadRequest
+		c.WithError("Load all custom fields error", err)
+	}
+
+	return c.Status(status).Render(views.ManageCustomFieldIndex(fields))
+}
+
+func Compose(c server.Context) (err error) {
+	field, err := getProcessingCustomField(c)
+
+	if err != nil {
+		c.WithError("Query editting custom field error", err)
+		field = &entities.CustomField{}
+	}
+
+	return composeView(c, field, false)
+}
+
+func Save(c server.Context) (err error) {
+	var field *entities.CustomField
+	data := getCustomFieldSaveData(c)
+
+	if field, err = getProcessingCustomField(c); err != nil {
+		return err
+	}
+
+	if c.Messages().HasError() {
+		return composeView(c, mutationToCustomField(c.ParamInt("id"), data), true)
+	}
+
+	before := services.AuditState(field, field.ID > 0)
+	field.Name = data.Name
+	field.Label = data.Label
+	field.Description = data.Description
+	field.Type = data.Type
+	field.Target = data.Target
+	field.Required = data.Required
+	field.MaxLength = data.MaxLength
+	field.Pattern = data.Pattern
+	field.Options = customFieldOptions(data.Options)
+	field.Order = data.Order
+
+	if field.ID > 0 {
+		field, err = repositories.CustomField.Update(c.Context(), field)
+	} else {
+		field, err = repositories.CustomField.Create(c.Context(), field)
+	}
+
+	if err != nil {
+		c.WithError("Error saving custom field", err)
+		return composeView(c, mutationToCustomField(c.ParamInt("id"), data), true)
+	}
+
+	services.Audit(c, "custom_field", field.ID, before, field)
+
+	return c.RedirectToRoute("manage.custom_field.compose", entities.Map{"id": field.ID})
+}
+
+func Delete(c server.Context) error {
+	field, err := getProcessingCustomField(c)
+
+	if err != nil {
+		c.Logger().Error("Error deleting custom field", err)
+		return c.Status(http.StatusBadRequest).SendString("Error deleting custom field")
+	}
+
+	if err := repositories.CustomField.DeleteByID(c.Context(), field.ID); err != nil {
+		c.Logger().Error("Error deleting custom field", err)
+		return c.Status(http.StatusBadRequest).SendString("Error deleting custom field")
+	}
+
+	services.Audit(c, "custom_field", field.ID, field, nil)
+
+	return c.Status(http.StatusOK).SendString("Custom field deleted")
+}
+
+func getProcessingCustomField(c server.Context) (*entities.CustomField, error) {
+	if c.Param("id") == "new" {
+		return &entities.CustomField{Type: entities.CUSTOM_FIELD_TEXT}, nil
+	}
+
+	return repositories.CustomField.ByID(c.Context(), c.ParamInt("id"))
+}
+
+func composeView(c server.Context, field *entities.CustomField, isSave bool) error {
+	status := http.StatusOK
+	c.Meta().Title = "Create Custom Field"
+
+	if field.ID > 0 {
+		c.Meta().Title = "Edit Custom Field: " + field.Label
+	}
+
+	if isSave && c.Messages().HasError() {
+		status = http.StatusBadRequest
+	}
+
+	return c.Status(status).Render(views.ManageCustomFieldCompose(field))
+}
+
+func mutationToCustomField(id int, data *entities.CustomFieldMutation) *entities.CustomField {
+	return &entities.CustomField{
+		ID:          id,
+		Name:        data.Name,
+		Label:       data.Label,
+		Description: data.Description,
+		Type:        data.Type,
+		Target:      data.Target,
+		Required:    data.Required,
+		MaxLength:   data.MaxLength,
+		Pattern:     data.Pattern,
+		Options:     customFieldOptions(data.Options),
+		Order:       data.Order,
+	}
+}
+
+func customFieldOptions(text string) []string {
+	options := []string{}
+
+	for _, option := range strings.Split(text, "\n") {
+		if option = utils.SanitizePlainText(strings.TrimSpace(option)); option != "" && !utils.SliceContains(options, option) {
+			options = append(options, option)
+		}
+	}
+
+	return options
+}
+
+func getCustomFieldSaveData(c server.Context) *entities.CustomFieldMutation {
+	data := &entities.CustomFieldMutation{}
+
+	if err := c.BodyParser(data); err != nil {
+		c.WithError("Error parsing body", err)
+		return data
+	}
+
+	data.Name = strings.TrimSpace(data.Name)
+	data.Label = utils.SanitizePlainText(strings.TrimSpace(data.Label))
+	data.Description = utils.SanitizePlainText(strings.TrimSpace(data.Description))
+	data.Pattern = strings.TrimSpace(data.Pattern)
+
+	if !entities.ValidCustomFieldName(data.Name) {
+		c.Messages().AppendError("Name is required, must start with a lowercase letter and can only contain lowercase letters, numbers and _")
+	}
+
+	if data.Label == "" || len(data.Label) > 255 {
+		c.Messages().AppendError("Label is required and can't be more than 255 characters")
+	}
+
+	if len(data.Description) > 255 {
+		c.Messages().AppendError("Description can't be more than 255 characters")
+	}
+
+	if !utils.SliceContains(entities.CustomFieldTypes, data.Type) {
+		c.Messages().AppendError("Invalid field type")
+	}
+
+	if !utils.SliceContains(entities.CustomFieldTargets, data.Target) {
+		c.Messages().AppendError("Invalid field target")
+	}
+
+	if data.MaxLength < 0 {
+		c.Messages().AppendError("Max length can't be negative")
+	}
+
+	if data.Pattern != "" {
+		if _, err := regexp.Compile(data.Pattern); err != nil || len(data.Pattern) > 255 {
+			c.Messages().AppendError("Pattern must be a valid regular expression")
+		}
+	}
+
+	if data.Type == entities.CUSTOM_FIELD_SELECT && len(customFieldOptions(data.Options)) == 0 {
+		c.Messages().AppendError("Select fields need at least one option")
+	}
+
+	return data
+}
@@ -4,22 +4,24 @@ import (
 	"github.com/ngocphuongnb/tetua/app/entities"
 	"github.com/ngocphuongnb/tetua/app/repositories"
 	"github.com/ngocphuongnb/tetua/app/server"
+	"github.com/ngocphuongnb/tetua/app/services"
 	"github.com/ngocphuongnb/tetua/app/utils"
 	"github.com/ngocphuongnb/tetua/views"
 )
 
 func Index(c server.Context) error {
-	paginate, err := repositories.File.Paginate(c.Context(), &entities.FileFilter{
+	filter := services.FileFilterFromQuery(c, &entities.FileFilter{
 		Filter: &entities.Filter{
 			BaseUrl: utils.Url("/manage/files"),
 			Page:    c.QueryInt("page"),
 			Limit:   24,
 		},
 	})
+	paginate, err := repositories.File.Paginate(c.Context(), filter)
 
 	if err != nil {
 		c.WithError("Something went wrong", err)
 	}
 
-	return c.Render(views.ManageFileIndex(paginate))
+	return c.Render(views.ManageFileIndex(paginate, filter))
 }
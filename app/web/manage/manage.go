@@ -4,8 +4,11 @@ import (
 	"github.com/ngocphuongnb/tetua/app/auth"
 	"github.com/ngocphuongnb/tetua/app/entities"
 	"github.com/ngocphuongnb/tetua/app/server"
+	manageaudit "github.com/ngocphuongnb/tetua/app/web/manage/audit"
 	managecomment "github.com/ngocphuongnb/tetua/app/web/manage/comment"
+	managecustomfield "github.com/ngocphuongnb/tetua/app/web/manage/customfield"
 	managefile "github.com/ngocphuongnb/tetua/app/web/manage/file"
+	managemenu "github.com/ngocphuongnb/tetua/app/web/manage/menu"
 	managepage "github.com/ngocphuongnb/tetua/app/web/manage/page"
 	managepost "github.com/ngocphuongnb/tetua/app/web/manage/post"
 	managerole "github.com/ngocphuongnb/tetua/app/web/manage/role"
@@ -22,30 +25,51 @@ func manageAuthConfig(action string) *server.AuthConfig {
 	})
 }
 
+// managePostAuthConfig creates the config for the actions on a single post,
+// these actions can be limited to the posts in some topics
+func managePostAuthConfig(action string) *server.AuthConfig {
+	return auth.Config(&server.AuthConfig{
+		Action:       action,
+		DefaultValue: entities.PERM_NONE,
+		Prepare:      auth.GetPost,
+		OwnCheckFN:   auth.AllowNone,
+		TopicCheckFN: auth.PostTopicCheck,
+	})
+}
+
 var (
-	authManage               = manageAuthConfig("manage")
-	authManageTopicList      = manageAuthConfig("manage.topic.list")
-	authManageTopicCompose   = manageAuthConfig("manage.topic.compose")
-	authManageTopicSave      = manageAuthConfig("manage.topic.save")
-	authManageTopicDelete    = manageAuthConfig("manage.topic.delete")
-	authManagePostList       = manageAuthConfig("manage.post.list")
-	authManagePostApprove    = manageAuthConfig("manage.post.approve")
-	authManagePageList       = manageAuthConfig("manage.page.list")
-	authManagePageCompose    = manageAuthConfig("manage.page.compose")
-	authManagePageSave       = manageAuthConfig("manage.page.save")
-	authManagePageDelete     = manageAuthConfig("manage.page.delete")
-	authManageRoleList       = manageAuthConfig("manage.role.list")
-	authManageRoleCompose    = manageAuthConfig("manage.role.compose")
-	authManageRoleSave       = manageAuthConfig("manage.role.save")
-	authManageRoleDelete     = manageAuthConfig("manage.role.delete")
-	authManageUserList       = manageAuthConfig("manage.user.list")
-	authManageUserCompose    = manageAuthConfig("manage.user.compose")
-	authManageUserSave       = manageAuthConfig("manage.user.save")
-	authManageuserdelete     = manageAuthConfig("manage.user.delete")
-	authManageSettingCompose = manageAuthConfig("manage.setting.compose")
-	authManageSettingSave    = manageAuthConfig("manage.setting.save")
-	authManageCommentList    = manageAuthConfig("manage.comment.list")
-	authManageFileList       = manageAuthConfig("manage.file.list")
+	authManage                   = manageAuthConfig("manage")
+	authManageTopicList          = manageAuthConfig("manage.topic.list")
+	authManageTopicCompose       = manageAuthConfig("manage.topic.compose")
+	authManageTopicSave          = manageAuthConfig("manage.topic.save")
+	authManageTopicDelete        = manageAuthConfig("manage.topic.delete")
+	authManagePostList           = manageAuthConfig("manage.post.list")
+	authManagePostApprove        = managePostAuthConfig("manage.post.approve")
+	authManagePageList           = manageAuthConfig("manage.page.list")
+	authManagePageCompose        = manageAuthConfig("manage.page.compose")
+	authManagePageSave           = manageAuthConfig("manage.page.save")
+	authManagePageDelete         = manageAuthConfig("manage.page.delete")
+	authManageRoleList           = manageAuthConfig("manage.role.list")
+	authManageRoleCompose        = manageAuthConfig("manage.role.compose")
+	authManageRoleSave           = manageAuthConfig("manage.role.save")
+	authManageRoleDelete         = manageAuthConfig("manage.role.delete")
+	authManageUserList           = manageAuthConfig("manage.user.list")
+	authManageUserCompose        = manageAuthConfig("manage.user.compose")
+	authManageUserSave           = manageAuthConfig("manage.user.save")
+	authManageuserdelete         = manageAuthConfig("manage.user.delete")
+	authManageSettingCompose     = manageAuthConfig("manage.setting.compose")
+	authManageSettingSave        = manageAuthConfig("manage.setting.save")
+	authManageCommentList        = manageAuthConfig("manage.comment.list")
+	authManageFileList           = manageAuthConfig("manage.file.list")
+	authManageAuditList          = manageAuthConfig("manage.audit.list")
+	authManageMenuList           = manageAuthConfig("manage.menu.list")
+	authManageMenuCompose        = manageAuthConfig("manage.menu.compose")
+	authManageMenuSave           = manageAuthConfig("manage.menu.save")
+	authManageMenuDelete         = manageAuthConfig("manage.menu.delete")
+	authManageCustomFieldList    = manageAuthConfig("manage.custom_field.list")
+	authManageCustomFieldCompose = manageAuthConfig("manage.custom_field.compose")
+	authManageCustomFieldSave    = manageAuthConfig("manage.custom_field.save")
+	authManageCustomFieldDelete  = manageAuthConfig("manage.custom_field.delete")
 )
 
 func RegisterRoutes(s server.Server) {
@@ -89,4 +113,18 @@ func RegisterRoutes(s server.Server) {
 
 	file := manage.Group("/files")
 	file.Get("", managefile.Index, authManageFileList)
+
+	menu := manage.Group("/menus")
+	menu.Get("", managemenu.Index, authManageMenuList)
+	menu.Get("/:id", managemenu.Compose, authManageMenuCompose)
+	menu.Post("/:id", managemenu.Save, authManageMenuSave)
+	menu.Delete("/:id", managemenu.Delete, authManageMenuDelete)
+
+	customField := manage.Group("/custom-fields")
+	customField.Get("", managecustomfield.Index, authManageCustomFieldList)
+	customField.Get("/:id", managecustomfield.Compose, authManageCustomFieldCompose)
+	customField.Post("/:id", managecustomfield.Save, authManageCustomFieldSave)
+	customField.Delete("/:id", managecustomfield.Delete, authManageCustomFieldDelete)
+
+	manage.Get("/audit", manageaudit.Index, authManageAuditList)
 }
@@ -0,0 +1,168 @@
+package managemenu
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/ngocphuongnb/tetua/app/cache"
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+	"github.com/ngocphuongnb/tetua/app/server"
+	"github.com/ngocphuongnb/tetua/app/services"
+	"github.com/ngocphuongnb/tetua/app/utils"
+	"github.com/ngocphuongnb/tetua/views"
+)
+
+var locationRegex = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+func Index(c server.Context) (err error) {
+	status := http.StatusOK
+	menus, err := repositories.Menu.All(c.Context())
+	c.Meta().Title = "Manage menus"
+
+	if err != nil {
+		status = http.StatusBadRequest
+		c.WithError("Load all menus error", err)
+	}
+
+	return c.Status(status).Render(views.ManageMenuIndex(menus))
+}
+
+func Compose(c server.Context) (err error) {
+	menu, err := getProcessingMenu(c)
+
+	if err != nil {
+		c.WithError("Query editting menu error", err)
+		menu = &entities.Menu{}
+	}
+
+	return composeView(c, menu, false)
+}
+
+func Save(c server.Context) (err error) {
+	var menu *entities.Menu
+	data := getMenuSaveData(c)
+
+	if menu, err = getProcessingMenu(c); err != nil {
+		return err
+	}
+
+	items := entities.MenuItemsFromRows(data.Items)
+
+	if c.Messages().HasError() {
+		return composeView(c, &entities.Menu{ID: c.ParamInt("id"), Name: data.Name, Location: data.Location, Items: items}, true)
+	}
+
+	before := services.AuditState(menu, menu.ID > 0)
+	menu.Name = data.Name
+	menu.Location = data.Location
+
+	if menu.ID > 0 {
+		menu, err = repositories.Menu.Update(c.Context(), menu)
+	} else {
+		menu, err = repositories.Menu.Create(c.Context(), menu)
+	}
+
+	if err != nil {
+		c.WithError("Error saving menu", err)
+		return composeView(c, &entities.Menu{ID: c.ParamInt("id"), Name: data.Name, Location: data.Location, Items: items}, true)
+	}
+
+	if err := repositories.Menu.SetItems(c.Context(), menu.ID, items); err != nil {
+		c.WithError("Error saving menu items", err)
+		return composeView(c, &entities.Menu{ID: menu.ID, Name: data.Name, Location: data.Location, Items: items}, true)
+	}
+
+	menu.Items = items
+	services.Audit(c, "menu", menu.ID, before, menu)
+
+	return c.RedirectToRoute("manage.menu.compose", entities.Map{"id": menu.ID})
+}
+
+func Delete(c server.Context) error {
+	menu, err := getProcessingMenu(c)
+
+	if err != nil {
+		c.Logger().Error("Error deleting menu", err)
+		return c.Status(http.StatusBadRequest).SendString("Error deleting menu")
+	}
+
+	if err := repositories.Menu.DeleteByID(c.Context(), menu.ID); err != nil {
+		c.Logger().Error("Error deleting menu", err)
+		return c.Status(http.StatusBadRequest).SendString("Error deleting menu")
+	}
+
+	services.Audit(c, "menu", menu.ID, menu, nil)
+
+	return c.Status(http.StatusOK).SendString("Menu deleted")
+}
+
+func getProcessingMenu(c server.Context) (*entities.Menu, error) {
+	if c.Param("id") == "new" {
+		return &entities.Menu{}, nil
+	}
+
+	return repositories.Menu.ByID(c.Context(), c.ParamInt("id"))
+}
+
+func composeView(c server.Context, menu *entities.Menu, isSave bool) error {
+	status := http.StatusOK
+	pages, err := repositories.Page.All(c.Context())
+	c.Meta().Title = "Create Menu"
+
+	if err != nil {
+		c.WithError("Load pages error", err)
+	}
+
+	if menu.ID > 0 {
+		c.Meta().Title = "Edit Menu: " + menu.Name
+	}
+
+	if isSave && c.Messages().HasError() {
+		status = http.StatusBadRequest
+	}
+
+	return c.Status(status).Render(views.ManageMenuCompose(
+		menu,
+		entities.FlattenMenuItems(menu.Items, 0),
+		pages,
+		cache.Topics(),
+	))
+}
+
+func getMenuSaveData(c server.Context) *entities.MenuMutation {
+	data := &entities.MenuMutation{}
+
+	if err := c.BodyParser(data); err != nil {
+		c.WithError("Error parsing body", err)
+		return data
+	}
+
+	data.Name = utils.SanitizePlainText(data.Name)
+
+	if data.Name == "" || len(data.Name) > 255 {
+		c.Messages().AppendError("Name is required and can't be more than 255 characters")
+	}
+
+	if !locationRegex.MatchString(data.Location) || len(data.Location) > 64 {
+		c.Messages().AppendError("Location is required and can only contain lowercase letters, numbers, - and _")
+	}
+
+	for _, row := range data.Items {
+		if row == nil || row.Label == "" {
+			continue
+		}
+
+		row.Label = utils.SanitizePlainText(row.Label)
+
+		if !utils.SliceContains(entities.MenuItemTypes, row.Type) {
+			c.Messages().AppendError("Invalid type of menu item: " + row.Label)
+		} else if row.Type == entities.MENU_ITEM_CUSTOM && !entities.ValidMenuUrl(row.Url) {
+			c.Messages().AppendError("Invalid url of menu item: " + row.Label)
+		} else if row.Type != entities.MENU_ITEM_CUSTOM && row.TargetID() < 1 {
+			c.Messages().AppendError("Please select the target of menu item: " + row.Label)
+		}
+	}
+
+	return data
+}
@@ -1,10 +1,13 @@
 package managepage
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gosimple/slug"
+	"github.com/ngocphuongnb/tetua/app/asset"
 	"github.com/ngocphuongnb/tetua/app/entities"
 	"github.com/ngocphuongnb/tetua/app/repositories"
 	"github.com/ngocphuongnb/tetua/app/server"
@@ -52,6 +55,8 @@ func Delete(c server.Context) error {
 		return c.Status(http.StatusBadRequest).SendString("Error deleting page")
 	}
 
+	services.Audit(c, "page", page.ID, page, nil)
+
 	return c.Status(http.StatusOK).SendString("Page deleted")
 }
 
@@ -86,6 +91,8 @@ func Save(c server.Context) (err error) {
 		return err
 	}
 
+	validateParent(c, page.ID, pageData.ParentID)
+
 	if pageData.FeaturedImageID > 0 {
 		if featuredImage, err = repositories.File.ByID(c.Context(), pageData.FeaturedImageID); err != nil {
 			c.WithError("Error getting featured image", err)
@@ -94,7 +101,7 @@ func Save(c server.Context) (err error) {
 
 	if !c.Messages().HasError() {
 		var savedPage *entities.Page
-		pageData.ContentHTML = contentHtml
+		pageData.ContentHTML = services.ImageSrcset(c.Context(), contentHtml)
 
 		if page.ID > 0 {
 			now := time.Now()
@@ -110,6 +117,8 @@ func Save(c server.Context) (err error) {
 			return getComposeView(c, pageData, featuredImage)
 		}
 
+		services.Audit(c, "page", savedPage.ID, services.AuditState(page, page.ID > 0), savedPage)
+
 		return c.RedirectToRoute("manage.page.compose", entities.Map{"id": savedPage.ID})
 	}
 
@@ -118,6 +127,13 @@ func Save(c server.Context) (err error) {
 
 func getComposeView(c server.Context, data *entities.Page, featuredImage *entities.File) (err error) {
 	status := http.StatusOK
+	filter := &entities.PageFilter{Publish: "all", Filter: &entities.Filter{Limit: 1000}}
+
+	if data.ID > 0 {
+		filter.ExcludeIDs = []int{data.ID}
+	}
+
+	pages, err := repositories.Page.Find(c.Context(), filter)
 
 	if err != nil {
 		c.Logger().Error("Error getting pages", err)
@@ -128,7 +144,7 @@ func getComposeView(c server.Context, data *entities.Page, featuredImage *entiti
 		status = http.StatusBadRequest
 	}
 
-	return c.Status(status).Render(views.ManagePageCompose(data, featuredImage))
+	return c.Status(status).Render(views.ManagePageCompose(data, featuredImage, pages))
 }
 
 func getPageSaveData(c server.Context) *entities.Page {
@@ -162,9 +178,88 @@ func getPageSaveData(c server.Context) *entities.Page {
 		c.Messages().AppendError("Content is required")
 	}
 
+	pageData.CustomFields = services.CustomFieldValues(c, entities.CUSTOM_FIELD_TARGET_PAGE)
+
+	if strings.Contains(pageData.Slug, "/") {
+		c.Messages().AppendError("Slug can't contain /")
+	}
+
+	if !asset.HasPageTemplate(pageData.Template) {
+		c.Messages().AppendError("Template is not declared by the theme: " + pageData.Template)
+	}
+
 	return pageData
 }
 
+// validateParent checks that moving the page under the parent doesn't create a loop
+// and doesn't make the hierarchy deeper than PAGE_MAX_DEPTH
+func validateParent(c server.Context, pageID, parentID int) {
+	if parentID == 0 {
+		return
+	}
+
+	parent, err := repositories.Page.ByID(c.Context(), parentID)
+
+	if err != nil {
+		c.WithError("Error getting parent page", err)
+		return
+	}
+
+	ancestors := append(parent.Ancestors(), parent)
+
+	for _, ancestor := range ancestors {
+		if pageID > 0 && ancestor.ID == pageID {
+			c.Messages().AppendError("A page can't be moved under itself or its children")
+			return
+		}
+	}
+
+	height, err := subtreeHeight(c, pageID)
+
+	if err != nil {
+		c.WithError("Error getting child pages", err)
+		return
+	}
+
+	if len(ancestors)+height > entities.PAGE_MAX_DEPTH {
+		c.Messages().AppendError(fmt.Sprintf("Pages can't be nested more than %d levels", entities.PAGE_MAX_DEPTH))
+	}
+}
+
+// subtreeHeight returns the number of levels of the page and its descendants
+func subtreeHeight(c server.Context, pageID int) (int, error) {
+	height := 1
+
+	if pageID == 0 {
+		return height, nil
+	}
+
+	parentIDs := []int{pageID}
+
+	for height <= entities.PAGE_MAX_DEPTH {
+		children, err := repositories.Page.Find(c.Context(), &entities.PageFilter{
+			Publish:   "all",
+			ParentIDs: parentIDs,
+			Filter:    &entities.Filter{Limit: 1000},
+		})
+
+		if err != nil {
+			return 0, err
+		}
+
+		if len(children) == 0 {
+			break
+		}
+
+		height++
+		parentIDs = utils.SliceMap(children, func(child *entities.Page) int {
+			return child.ID
+		})
+	}
+
+	return height, nil
+}
+
 func getProcessingPage(c server.Context) (page *entities.Page, err error) {
 	if c.Param("id") == "new" {
 		return &entities.Page{}, nil
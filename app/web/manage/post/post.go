@@ -7,6 +7,7 @@ import (
 	e "github.com/ngocphuongnb/tetua/app/entities"
 	"github.com/ngocphuongnb/tetua/app/repositories"
 	"github.com/ngocphuongnb/tetua/app/server"
+	"github.com/ngocphuongnb/tetua/app/services"
 	"github.com/ngocphuongnb/tetua/app/utils"
 	"github.com/ngocphuongnb/tetua/views"
 )
@@ -70,6 +71,12 @@ func Approve(c server.Context) error {
 		})
 	}
 
+	if post := c.Post(); post != nil {
+		approved := services.AuditState(post, true)
+		approved.Approved = true
+		services.Audit(c, "post", post.ID, post, approved)
+	}
+
 	return c.Status(http.StatusOK).Json(&entities.Message{
 		Type:    "success",
 		Message: "Post aprroved",
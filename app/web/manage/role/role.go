@@ -8,12 +8,17 @@ import (
 	"github.com/ngocphuongnb/tetua/app/auth"
 	"github.com/ngocphuongnb/tetua/app/cache"
 	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/fs"
 	"github.com/ngocphuongnb/tetua/app/repositories"
 	"github.com/ngocphuongnb/tetua/app/server"
+	"github.com/ngocphuongnb/tetua/app/services"
 	"github.com/ngocphuongnb/tetua/app/utils"
 	"github.com/ngocphuongnb/tetua/views"
 )
 
+// the upload limits are entered in megabytes and stored in bytes
+const megabyte = 1024 * 1024
+
 func Index(c server.Context) (err error) {
 	status := http.StatusOK
 	roles, err := repositories.Role.All(c.Context())
@@ -43,9 +48,13 @@ func Save(c server.Context) (err error) {
 		return composeView(c, data, true)
 	}
 
+	before := services.AuditState(role, role.ID > 0)
 	role.Root = data.Root
 	role.Name = data.Name
 	role.Description = data.Description
+	role.MaxUploadSize = data.MaxUploadSize * megabyte
+	role.UploadMimeGroups = data.UploadMimeGroups
+	role.StorageQuota = data.StorageQuota * megabyte
 
 	if role.ID > 0 {
 		role, err = repositories.Role.Update(c.Context(), role)
@@ -65,10 +74,15 @@ func Save(c server.Context) (err error) {
 		}
 	}
 
-	if err := cache.CachePermissions(c.Context()); err != nil {
-		c.WithError("Error caching permissions", err)
-		return composeView(c, data, true)
+	// reload the role so the audit log has the saved permissions
+	after, err := repositories.Role.ByID(c.Context(), role.ID)
+
+	if err != nil {
+		after = role
 	}
+
+	services.Audit(c, "role", role.ID, before, after)
+
 	return c.Redirect("/manage/roles/" + strconv.Itoa(role.ID))
 }
 
@@ -89,6 +103,8 @@ func Delete(c server.Context) error {
 		return c.Status(http.StatusBadRequest).SendString("Error deleting role")
 	}
 
+	services.Audit(c, "role", role.ID, role, nil)
+
 	return c.Status(http.StatusOK).SendString("Success")
 }
 
@@ -110,6 +126,9 @@ func composeView(c server.Context, data *entities.RoleMutation, isSave bool) (er
 		data.Root = role.Root
 		data.Name = role.Name
 		data.Description = role.Description
+		data.MaxUploadSize = role.MaxUploadSize / megabyte
+		data.UploadMimeGroups = role.UploadMimeGroups
+		data.StorageQuota = role.StorageQuota / megabyte
 	}
 
 	if role.ID > 0 {
@@ -117,17 +136,23 @@ func composeView(c server.Context, data *entities.RoleMutation, isSave bool) (er
 	}
 
 	var rolePermissions []*entities.PermissionValue
-	var rolePermissionsByActions = map[string]string{}
+	var topicActions []string
+	var rolePermissionsByActions = map[string]*entities.Permission{}
 
 	for _, permission := range role.Permissions {
-		rolePermissionsByActions[permission.Action] = permission.Value
+		rolePermissionsByActions[permission.Action] = permission
 	}
 
 	for _, permissionValue := range auth.ActionConfigs {
-		if value, ok := rolePermissionsByActions[permissionValue.Action]; ok {
+		if permissionValue.TopicCheckFN != nil {
+			topicActions = append(topicActions, permissionValue.Action)
+		}
+
+		if permission, ok := rolePermissionsByActions[permissionValue.Action]; ok {
 			rolePermissions = append(rolePermissions, &entities.PermissionValue{
-				Action: permissionValue.Action,
-				Value:  entities.GetPermTypeValue(value),
+				Action:   permissionValue.Action,
+				Value:    entities.GetPermTypeValue(permission.Value),
+				TopicIDs: permission.TopicIDs,
 			})
 		} else {
 			rolePermissions = append(rolePermissions, &entities.PermissionValue{
@@ -137,7 +162,7 @@ func composeView(c server.Context, data *entities.RoleMutation, isSave bool) (er
 		}
 	}
 
-	return c.Render(views.ManageRoleCompose(role.ID, data, rolePermissions))
+	return c.Render(views.ManageRoleCompose(role.ID, data, rolePermissions, cache.Topics(), topicActions, fs.MimeGroupNames))
 }
 
 func getRoleSaveData(c server.Context) *entities.RoleMutation {
@@ -150,9 +175,23 @@ func getRoleSaveData(c server.Context) *entities.RoleMutation {
 	data.Name = utils.SanitizePlainText(strings.TrimSpace(data.Name))
 	data.Description = utils.SanitizePlainText(strings.TrimSpace(data.Description))
 
+	for _, permission := range data.Permissions {
+		if authConfig := auth.GetAuthConfig(permission.Action); authConfig == nil || authConfig.TopicCheckFN == nil {
+			permission.TopicIDs = nil
+		}
+	}
+
 	if data.Name == "" || len(data.Name) > 250 {
 		c.Messages().AppendError("Name is required and can't be more than 250 characters")
 	}
 
+	if data.MaxUploadSize < 0 || data.StorageQuota < 0 {
+		c.Messages().AppendError("Max file size and storage quota can't be negative")
+	}
+
+	data.UploadMimeGroups = utils.SliceFilter(data.UploadMimeGroups, func(group string) bool {
+		return fs.MimeGroups[group] != nil
+	})
+
 	return data
 }
@@ -85,9 +85,28 @@ func Save(c server.Context) (err error) {
 
 	if err = repositories.Setting.Save(c.Context(), settings); err != nil {
 		c.WithError("Error saving settings", err)
+	} else {
+		auditSettings(c, settingItems)
 	}
 
 	config.Settings(settingItems)
 
 	return c.Redirect("/manage/settings")
 }
+
+// auditSettings records the settings whose value has changed
+func auditSettings(c server.Context, settingItems []*config.SettingItem) {
+	before := map[string]string{}
+	after := map[string]string{}
+
+	for _, s := range settingItems {
+		if value := config.Setting(s.Name); value != s.Value {
+			before[s.Name] = value
+			after[s.Name] = s.Value
+		}
+	}
+
+	if len(after) > 0 {
+		services.Audit(c, "setting", 0, before, after)
+	}
+}
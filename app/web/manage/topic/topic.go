@@ -5,10 +5,10 @@ import (
 	"strconv"
 
 	"github.com/gosimple/slug"
-	"github.com/ngocphuongnb/tetua/app/cache"
 	"github.com/ngocphuongnb/tetua/app/entities"
 	"github.com/ngocphuongnb/tetua/app/repositories"
 	"github.com/ngocphuongnb/tetua/app/server"
+	"github.com/ngocphuongnb/tetua/app/services"
 	"github.com/ngocphuongnb/tetua/app/utils"
 	"github.com/ngocphuongnb/tetua/views"
 )
@@ -42,6 +42,7 @@ func Save(c server.Context) (err error) {
 		return getTopicComposeView(c, composeData, true)
 	}
 
+	before := services.AuditState(topic, topic.ID > 0)
 	topic.Name = composeData.Name
 	topic.Content = composeData.Content
 	topic.ParentID = composeData.ParentID
@@ -59,10 +60,7 @@ func Save(c server.Context) (err error) {
 		return getTopicComposeView(c, composeData, true)
 	}
 
-	if err := cache.CacheTopics(c.Context()); err != nil {
-		c.WithError("Error caching topics", err)
-		return getTopicComposeView(c, composeData, true)
-	}
+	services.Audit(c, "topic", topic.ID, before, topic)
 
 	return c.Redirect("/manage/topics/" + strconv.Itoa(topic.ID))
 }
@@ -80,6 +78,8 @@ func Delete(c server.Context) error {
 		return c.Status(http.StatusBadRequest).SendString("Error deleting topic")
 	}
 
+	services.Audit(c, "topic", topic.ID, topic, nil)
+
 	return c.Status(http.StatusOK).SendString("Topic deleted")
 }
 
@@ -43,7 +43,14 @@ func Save(c server.Context) (err error) {
 		return composeView(c, data, true)
 	}
 
+	var before *entities.User
+
 	if userID > 0 {
+		if before, err = repositories.User.ByID(c.Context(), userID); err != nil {
+			c.WithError("Query editting user error", err)
+			return composeView(c, data, true)
+		}
+
 		data.ID = userID
 		user, err = repositories.User.Update(c.Context(), data)
 	} else {
@@ -55,6 +62,8 @@ func Save(c server.Context) (err error) {
 		return composeView(c, data, true)
 	}
 
+	services.Audit(c, "user", user.ID, before, user)
+
 	return c.Redirect("/manage/users/" + strconv.Itoa(user.ID))
 }
 
@@ -75,6 +84,8 @@ func Delete(c server.Context) error {
 		return c.Status(http.StatusBadRequest).SendString("Error deleting user")
 	}
 
+	services.Audit(c, "user", user.ID, user, nil)
+
 	return c.Status(http.StatusOK).SendString("Success")
 }
 
@@ -135,6 +146,7 @@ func getUserSaveData(c server.Context) *entities.User {
 	user.Password = utils.SanitizePlainText(strings.TrimSpace(data.Password))
 	user.RoleIDs = data.RoleIDs
 	user.Active = data.Active
+	user.CustomFields = services.CustomFieldValues(c, entities.CUSTOM_FIELD_TARGET_USER)
 
 	if avatarImage, err := services.SaveFile(c, "avatar_image"); err != nil {
 		c.WithError("Error saving avatar image", err)
@@ -21,6 +21,7 @@ func Compose(c server.Context) (err error) {
 		postData.Draft = post.Draft
 		postData.Content = post.Content
 		postData.FeaturedImageID = post.FeaturedImageID
+		postData.CustomFields = post.CustomFields
 		postTopics := post.Topics
 
 		for _, topic := range postTopics {
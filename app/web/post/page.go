@@ -1,22 +1,89 @@
 package webpost
 
 import (
+	"bufio"
 	"net/http"
+	"strings"
 
+	"github.com/ngocphuongnb/tetua/app/entities"
 	"github.com/ngocphuongnb/tetua/app/repositories"
 	"github.com/ngocphuongnb/tetua/app/server"
+	"github.com/ngocphuongnb/tetua/app/services"
 	"github.com/ngocphuongnb/tetua/views"
 )
 
+// PageTemplates are the views of the page templates that the theme declares in theme.json,
+// pages with an unknown template are rendered by the default page view
+var PageTemplates = map[string]func(page *entities.Page, children []*entities.Page) func(meta *entities.Meta, wr *bufio.Writer){
+	"landing": views.PageLanding,
+	"legal":   views.PageLegal,
+}
+
 func ViewPage(c server.Context) error {
 	page, err := repositories.Page.PublishedPageBySlug(c.Context(), c.Param("slug"))
 
-	if err != nil {
+	if err != nil || !page.Published() {
+		return c.Status(http.StatusNotFound).Render(views.Error("Page not found"))
+	}
+
+	if page.ParentID > 0 {
+		return c.Redirect(page.Url())
+	}
+
+	return renderPage(c, page)
+}
+
+// ViewPagePath resolves a nested page by its path through the hierarchy, e.g. /docs/install,
+// the path must match all the parents of the page
+func ViewPagePath(c server.Context) error {
+	path := strings.Trim(c.Param("*"), "/")
+	slugs := strings.Split(path, "/")
+	page, err := repositories.Page.PublishedPageBySlug(c.Context(), slugs[len(slugs)-1])
+
+	if path == "" || err != nil || !page.Published() {
+		return c.Status(http.StatusNotFound).Render(views.Error("Page not found"))
+	}
+
+	if page.ParentID == 0 && path == page.Slug {
+		return c.Redirect(page.Url())
+	}
+
+	// the slug is unique, a path through other parents doesn't lead to the page
+	if page.Path() != path {
 		return c.Status(http.StatusNotFound).Render(views.Error("Page not found"))
 	}
 
+	return renderPage(c, page)
+}
+
+func renderPage(c server.Context, page *entities.Page) error {
+	children, err := repositories.Page.Find(c.Context(), &entities.PageFilter{
+		ParentIDs: []int{page.ID},
+		Filter: &entities.Filter{
+			Limit: 100,
+			Sorts: []*entities.Sort{{Field: "sort_order", Order: "ASC"}},
+		},
+	})
+
+	if err != nil {
+		c.Logger().Error("Error getting child pages", err)
+	}
+
+	// a copy is rendered so the signed urls don't leak into a shared page
+	signedPage := *page
+	signedPage.ContentHTML = services.SignPrivateFileUrls(c, page.ContentHTML)
+	page = &signedPage
 	c.Meta().Title = page.Name
 	c.Meta().Description = page.Name
+	c.Meta().Canonical = page.Url()
+
+	if page.FeaturedImage != nil && page.FeaturedImage.ID > 0 {
+		c.Meta().Image = page.FeaturedImage.TransformUrl(ogImageTransform)
+	}
+
+	if view, ok := PageTemplates[page.Template]; ok {
+		return c.Render(view(page, children))
+	}
 
-	return c.Render(views.PageView(page))
+	return c.Render(views.PageView(page, children))
 }
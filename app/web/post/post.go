@@ -10,10 +10,14 @@ import (
 	"github.com/ngocphuongnb/tetua/app/entities"
 	"github.com/ngocphuongnb/tetua/app/repositories"
 	"github.com/ngocphuongnb/tetua/app/server"
+	"github.com/ngocphuongnb/tetua/app/services"
 	"github.com/ngocphuongnb/tetua/app/utils"
 	"github.com/ngocphuongnb/tetua/views"
 )
 
+// ogImageTransform crops the featured images to the recommended size of the social sharing images
+const ogImageTransform = "w_1200,h_630,c_fill"
+
 func List(c server.Context) error {
 	paginate, err := repositories.Post.Paginate(c.Context(), &entities.PostFilter{
 		Filter: &entities.Filter{
@@ -100,11 +104,15 @@ func View(c server.Context) error {
 	}(&wg)
 
 	wg.Wait()
+	// a copy is rendered so the signed urls don't leak into a shared post
+	signedPost := *post
+	signedPost.ContentHTML = services.SignPrivateFileUrls(c, post.ContentHTML)
+	post = &signedPost
 	c.Meta().Title = post.Name
 	c.Meta().Description = post.Description
 
 	if post.FeaturedImage != nil {
-		c.Meta().Image = post.FeaturedImage.Url()
+		c.Meta().Image = post.FeaturedImage.TransformUrl(ogImageTransform)
 	}
 
 	return c.Render(views.PostView(post, relatedPosts, comments))
@@ -23,6 +23,7 @@ func postMutationToPost(postData *entities.PostMutation) *entities.Post {
 		FeaturedImageID: postData.FeaturedImageID,
 		Draft:           postData.Draft,
 		TopicIDs:        postData.TopicIDs,
+		CustomFields:    postData.CustomFields,
 	}
 }
 
@@ -50,7 +51,7 @@ func Save(c server.Context) (err error) {
 	if !c.Messages().HasError() {
 		var savedPost *entities.Post
 		postData.Slug = slug.Make(postData.Name)
-		postData.ContentHTML = contentHtml
+		postData.ContentHTML = services.ImageSrcset(c.Context(), contentHtml)
 		savePostData := postMutationToPost(postData)
 		user := c.User()
 
@@ -120,5 +121,7 @@ func getPostSaveData(c server.Context) *entities.PostMutation {
 		c.Messages().AppendError("Topic is required")
 	}
 
+	postData.CustomFields = services.CustomFieldValues(c, entities.CUSTOM_FIELD_TARGET_POST)
+
 	return postData
 }
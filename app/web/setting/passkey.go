@@ -0,0 +1,93 @@
+package websetting
+
+import (
+	"net/http"
+
+	"github.com/ngocphuongnb/tetua/app/auth"
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+	"github.com/ngocphuongnb/tetua/app/server"
+)
+
+func getPasskeyProvider(c server.Context) server.PasskeyAuthProvider {
+	provider, ok := auth.GetProvider("passkey").(server.PasskeyAuthProvider)
+
+	if !ok {
+		return nil
+	}
+
+	return provider
+}
+
+func PasskeyOptions(c server.Context) error {
+	provider := getPasskeyProvider(c)
+
+	if provider == nil {
+		return c.Status(http.StatusNotFound).Json(&entities.Message{
+			Type:    "error",
+			Message: "Passkey login is not enabled",
+		})
+	}
+
+	if err := provider.BeginRegistration(c); err != nil {
+		c.Logger().Error("Error creating passkey options", err)
+		return c.Status(http.StatusBadRequest).Json(&entities.Message{
+			Type:    "error",
+			Message: "Error creating passkey options",
+		})
+	}
+
+	return nil
+}
+
+func PasskeyRegister(c server.Context) error {
+	provider := getPasskeyProvider(c)
+
+	if provider == nil {
+		return c.Status(http.StatusNotFound).Json(&entities.Message{
+			Type:    "error",
+			Message: "Passkey login is not enabled",
+		})
+	}
+
+	passkey, err := provider.FinishRegistration(c)
+
+	if err != nil {
+		c.Logger().Error("Error registering passkey", err)
+		return c.Status(http.StatusBadRequest).Json(&entities.Message{
+			Type:    "error",
+			Message: "Error registering passkey",
+		})
+	}
+
+	return c.Json(passkey)
+}
+
+func PasskeyDelete(c server.Context) error {
+	passkey, err := repositories.Passkey.ByID(c.Context(), c.ParamInt("id"))
+
+	if err != nil || passkey.UserID != c.User().ID {
+		return c.Status(http.StatusNotFound).SendString("Passkey not found")
+	}
+
+	if err := repositories.Passkey.DeleteByID(c.Context(), passkey.ID); err != nil {
+		c.Logger().Error("Error deleting passkey", err)
+		return c.Status(http.StatusBadRequest).SendString("Error deleting passkey")
+	}
+
+	return c.Status(http.StatusOK).SendString("Success")
+}
+
+func getUserPasskeys(c server.Context, user *entities.User) []*entities.Passkey {
+	if getPasskeyProvider(c) == nil || user == nil {
+		return nil
+	}
+
+	passkeys, err := repositories.Passkey.ByUserID(c.Context(), user.ID)
+
+	if err != nil {
+		c.Logger().Error("Error getting passkeys", err)
+	}
+
+	return passkeys
+}
@@ -23,7 +23,7 @@ func Index(c server.Context) (err error) {
 		c.WithError("Error while getting user", err)
 	}
 
-	return c.Render(views.UserSetting(user))
+	return c.Render(views.UserSetting(user, getUserPasskeys(c, user)))
 }
 
 func Save(c server.Context) (err error) {
@@ -31,12 +31,12 @@ func Save(c server.Context) (err error) {
 	data := getSettingSaveData(c)
 
 	if c.Messages().HasError() {
-		return c.Render(views.UserSetting(user))
+		return c.Render(views.UserSetting(user, getUserPasskeys(c, user)))
 	}
 
 	if data.Username == "" || data.Email == "" {
 		c.Messages().AppendError("Username and email are required")
-		return c.Render(views.UserSetting(user))
+		return c.Render(views.UserSetting(user, getUserPasskeys(c, user)))
 	}
 
 	existedUsers, err := repositories.User.ByUsernameOrEmail(c.Context(), data.Username, data.Email)
@@ -48,7 +48,7 @@ func Save(c server.Context) (err error) {
 
 		if len(existedUsers) > 0 {
 			c.Messages().AppendError("Username or email is already taken")
-			return c.Render(views.UserSetting(user))
+			return c.Render(views.UserSetting(user, getUserPasskeys(c, user)))
 		}
 	}
 
@@ -57,7 +57,7 @@ func Save(c server.Context) (err error) {
 	if err != nil {
 		user = c.User()
 		c.WithError("Error saving user", err)
-		return c.Render(views.UserSetting(user))
+		return c.Render(views.UserSetting(user, getUserPasskeys(c, user)))
 	}
 
 	user, err = repositories.User.ByID(c.Context(), user.ID)
@@ -65,7 +65,7 @@ func Save(c server.Context) (err error) {
 	if err != nil {
 		user = c.User()
 		c.WithError("Error saving user", err)
-		return c.Render(views.UserSetting(user))
+		return c.Render(views.UserSetting(user, getUserPasskeys(c, user)))
 	}
 
 	exp := time.Now().Add(time.Hour * 100 * 365 * 24)
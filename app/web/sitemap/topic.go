@@ -17,7 +17,7 @@ func Topic(c server.Context) error {
 		XmlnsSchemaLocation: "http://www.sitemaps.org/schemas/sitemap/0.9 http://www.sitemaps.org/schemas/sitemap/0.9/sitemap.xsd http://www.google.com/schemas/sitemap-image/1.1 http://www.google.com/schemas/sitemap-image/1.1/sitemap-image.xsd",
 	}
 
-	for _, topic := range cache.Topics {
+	for _, topic := range cache.Topics() {
 		sitemapTopic.Urls = append(sitemapTopic.Urls, &SitemapUrl{
 			Loc: &SitemapLoc{
 				Value: topic.Url(),
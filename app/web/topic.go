@@ -17,7 +17,7 @@ import (
 
 func TopicView(c server.Context) (err error) {
 	topicSlug := c.Param("slug")
-	topics := utils.SliceFilter(cache.Topics, func(t *entities.Topic) bool {
+	topics := utils.SliceFilter(cache.Topics(), func(t *entities.Topic) bool {
 		return t.Slug == topicSlug
 	})
 
@@ -69,11 +69,11 @@ func TopicView(c server.Context) (err error) {
 		return c.Status(http.StatusBadGateway).Render(views.Error("Something went wrong"))
 	}
 
-	return c.Render(views.TopicView(cache.Topics, topic, paginate, topPosts))
+	return c.Render(views.TopicView(cache.Topics(), topic, paginate, topPosts))
 }
 
 func TopicFeed(c server.Context) error {
-	topics := utils.SliceFilter(cache.Topics, func(t *entities.Topic) bool {
+	topics := utils.SliceFilter(cache.Topics(), func(t *entities.Topic) bool {
 		return t.Slug == c.Param("slug")
 	})
 
@@ -0,0 +1,193 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/server"
+	"github.com/ngocphuongnb/tetua/app/services"
+	"github.com/ngocphuongnb/tetua/app/utils"
+)
+
+// TusOptions describes the supported tus protocol version and extensions
+func TusOptions(c server.Context) error {
+	c.Header("Tus-Resumable", services.TusVersion)
+	c.Header("Tus-Version", services.TusVersion)
+	c.Header("Tus-Extension", "creation,termination")
+
+	if user := c.User(); user != nil {
+		if maxSize := user.UploadLimits().MaxFileSize; maxSize > 0 {
+			c.Header("Tus-Max-Size", strconv.Itoa(maxSize))
+		}
+	}
+
+	return c.Status(http.StatusNoContent).SendString("")
+}
+
+// TusCreate creates a resumable upload with the length and the metadata of the file,
+// the filename, filetype and private metadata are used when the upload is complete
+func TusCreate(c server.Context) error {
+	if !tusCheckVersion(c) {
+		return nil
+	}
+
+	if c.Header("Upload-Defer-Length") != "" {
+		return tusError(c, http.StatusBadRequest, "Deferred upload length is not supported")
+	}
+
+	length, err := strconv.Atoi(c.Header("Upload-Length"))
+
+	if err != nil || length < 0 {
+		return tusError(c, http.StatusBadRequest, "Invalid upload length")
+	}
+
+	metadata, err := services.ParseTusMetadata(c.Header("Upload-Metadata"))
+
+	if err != nil {
+		return tusError(c, http.StatusBadRequest, "Invalid upload metadata")
+	}
+
+	upload, err := services.CreateTusUpload(c, length, metadata)
+
+	if err != nil {
+		status := http.StatusBadRequest
+
+		if maxSize := c.User().UploadLimits().MaxFileSize; maxSize > 0 && length > maxSize {
+			status = http.StatusRequestEntityTooLarge
+		}
+
+		return tusServiceError(c, status, err)
+	}
+
+	c.Header("Location", utils.Url("/files/tus/"+upload.ID))
+	c.Header("Upload-Offset", "0")
+
+	return c.Status(http.StatusCreated).SendString("")
+}
+
+// TusHead returns the stored size of an upload so the client can resume it
+func TusHead(c server.Context) error {
+	if !tusCheckVersion(c) {
+		return nil
+	}
+
+	upload, err := services.GetTusUpload(c.Context(), c.Param("id"), c.User().ID)
+
+	if err != nil {
+		return tusServiceError(c, http.StatusNotFound, err)
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.Header("Upload-Offset", strconv.Itoa(upload.Offset))
+	c.Header("Upload-Length", strconv.Itoa(upload.Length))
+
+	if upload.Completed() {
+		tusFileHeaders(c, upload)
+	}
+
+	return c.Status(http.StatusOK).SendString("")
+}
+
+// TusPatch stages a chunk of an upload, the file is created when the last chunk is received
+// and its id and url are returned in the X-File-Id and X-File-Url headers
+func TusPatch(c server.Context) error {
+	if !tusCheckVersion(c) {
+		return nil
+	}
+
+	if c.Header("Content-Type") != "application/offset+octet-stream" {
+		return tusError(c, http.StatusUnsupportedMediaType, "Invalid content type")
+	}
+
+	offset, err := strconv.Atoi(c.Header("Upload-Offset"))
+
+	if err != nil || offset < 0 {
+		return tusError(c, http.StatusBadRequest, "Invalid upload offset")
+	}
+
+	upload, err := services.GetTusUpload(c.Context(), c.Param("id"), c.User().ID)
+
+	if err != nil {
+		return tusServiceError(c, http.StatusNotFound, err)
+	}
+
+	if _, err := services.WriteTusChunk(c, upload, offset, c.Body()); err != nil {
+		if errors.Is(err, services.ErrTusOffsetMismatch) {
+			c.Header("Upload-Offset", strconv.Itoa(upload.Offset))
+			return tusError(c, http.StatusConflict, "The upload offset doesn't match")
+		}
+
+		return tusServiceError(c, http.StatusBadRequest, err)
+	}
+
+	c.Header("Upload-Offset", strconv.Itoa(upload.Offset))
+
+	if upload.Completed() {
+		tusFileHeaders(c, upload)
+	}
+
+	return c.Status(http.StatusNoContent).SendString("")
+}
+
+// TusDelete removes the staged chunks of an upload
+func TusDelete(c server.Context) error {
+	if !tusCheckVersion(c) {
+		return nil
+	}
+
+	upload, err := services.GetTusUpload(c.Context(), c.Param("id"), c.User().ID)
+
+	if err != nil {
+		return tusServiceError(c, http.StatusNotFound, err)
+	}
+
+	if err := services.DeleteTusUpload(c.Context(), upload); err != nil {
+		return tusServiceError(c, http.StatusInternalServerError, err)
+	}
+
+	return c.Status(http.StatusNoContent).SendString("")
+}
+
+// tusCheckVersion responds with 412 when the client doesn't use the supported protocol version
+func tusCheckVersion(c server.Context) bool {
+	c.Header("Tus-Resumable", services.TusVersion)
+
+	if c.Header("Tus-Resumable") != services.TusVersion {
+		c.Header("Tus-Version", services.TusVersion)
+		tusError(c, http.StatusPreconditionFailed, "Unsupported tus version")
+		return false
+	}
+
+	return true
+}
+
+func tusFileHeaders(c server.Context, upload *services.TusUpload) {
+	c.Header("X-File-Id", strconv.Itoa(upload.FileID))
+	c.Header("X-File-Url", upload.FileUrl)
+}
+
+// tusServiceError responds with the status and the message of an upload error,
+// unexpected errors are logged and responded with 500
+func tusServiceError(c server.Context, status int, err error) error {
+	var uploadErr *services.UploadError
+
+	switch {
+	case errors.As(err, &uploadErr):
+		return tusError(c, status, uploadErr.Message)
+	case errors.Is(err, services.ErrTusUploadNotFound):
+		return tusError(c, http.StatusNotFound, "Upload not found")
+	case errors.Is(err, services.ErrTusNoStagingDisk):
+		return tusError(c, http.StatusBadRequest, "No private disk is configured")
+	}
+
+	c.Logger().Error(err)
+	return tusError(c, http.StatusInternalServerError, "Error saving file")
+}
+
+func tusError(c server.Context, status int, message string) error {
+	return c.Status(status).Json(entities.Map{
+		"error": message,
+	})
+}
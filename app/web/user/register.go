@@ -3,6 +3,7 @@ package webuser
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -24,6 +25,7 @@ type RegisterData struct {
 	Email                string `json:"email"`
 	Password             string `json:"password"`
 	PasswordConfirmation string `json:"passwordconfirmation"`
+	InviteCode           string `json:"invite_code" form:"invite_code"`
 }
 
 func (r *RegisterData) Parse(c server.Context) error {
@@ -43,6 +45,11 @@ func (r *RegisterData) Parse(c server.Context) error {
 		return errors.New("Password and Password confirmation doesn't match")
 	}
 
+	r.InviteCode = strings.TrimSpace(r.InviteCode)
+	if registrationMode() == config.REGISTRATION_INVITE && r.InviteCode == "" {
+		return errors.New("Invite code is required")
+	}
+
 	foundUsers, err := repositories.User.ByUsernameOrEmail(c.Context(), r.Username, r.Email)
 
 	if err != nil {
@@ -62,35 +69,103 @@ func (r *RegisterData) Parse(c server.Context) error {
 	return nil
 }
 
+func registrationMode() string {
+	return config.Setting("registration_mode", config.REGISTRATION_OPEN)
+}
+
+func registrationClosed(c server.Context) error {
+	return c.Status(http.StatusForbidden).Render(views.Message(
+		"Registration is closed",
+		"We're not accepting new registrations at the moment.",
+		"",
+		0,
+	))
+}
+
+// useInvite validates the invite code and claims one use of it
+func useInvite(c server.Context, code string) (*entities.Invite, error) {
+	invite, err := repositories.Invite.ByCode(c.Context(), code)
+
+	if err != nil {
+		if !entities.IsNotFound(err) {
+			c.Logger().Error(err)
+		}
+		return nil, errors.New("Invalid invite code")
+	}
+
+	if !invite.Usable() {
+		return nil, errors.New("The invite code is expired or has been used up")
+	}
+
+	if err := repositories.Invite.Use(c.Context(), invite.ID); err != nil {
+		c.Logger().Error(err)
+		return nil, errors.New("The invite code is expired or has been used up")
+	}
+
+	return invite, nil
+}
+
 func Register(c server.Context) (err error) {
 	if c.User() != nil && c.User().ID > 0 {
 		return c.Redirect(utils.Url(""))
 	}
+
+	if registrationMode() == config.REGISTRATION_CLOSED {
+		return registrationClosed(c)
+	}
+
 	c.Meta().Title = "Register"
-	return c.Render(views.Register("", ""))
+	return c.Render(views.Register("", "", c.Query("code")))
 }
 
 func PostRegister(c server.Context) (err error) {
 	register := &RegisterData{}
 	autoApproveUser := config.Setting("auto_approve_user") == "yes"
+	mode := registrationMode()
+
+	if mode == config.REGISTRATION_CLOSED {
+		return registrationClosed(c)
+	}
 
 	if err = register.Parse(c); err != nil {
 		c.Messages().AppendError(err.Error())
-		return c.Render(views.Register(register.Username, register.Email))
+		return c.Render(views.Register(register.Username, register.Email, register.InviteCode))
 	}
 
-	user, err := repositories.User.Create(c.Context(), &entities.User{
+	userData := &entities.User{
 		Username: register.Username,
 		Email:    register.Email,
 		Password: register.Password,
 		RoleIDs:  []int{auth.ROLE_USER.ID},
 		Provider: "local",
 		Active:   autoApproveUser,
-	})
+	}
+
+	if mode == config.REGISTRATION_INVITE {
+		invite, err := useInvite(c, register.InviteCode)
+
+		if err != nil {
+			c.Messages().AppendError(err.Error())
+			return c.Render(views.Register(register.Username, register.Email, register.InviteCode))
+		}
+
+		userData.InviteID = invite.ID
+		if invite.RoleID > 0 {
+			userData.RoleIDs = []int{invite.RoleID}
+		}
+	}
+
+	user, err := repositories.User.Create(c.Context(), userData)
 
 	if err != nil {
+		if userData.InviteID > 0 {
+			if err := repositories.Invite.Release(c.Context(), userData.InviteID); err != nil {
+				c.Logger().Error(err)
+			}
+		}
+
 		c.WithError("Something went wrong", err)
-		return c.Render(views.Register(register.Username, register.Email))
+		return c.Render(views.Register(register.Username, register.Email, register.InviteCode))
 	}
 
 	mailBody := []string{fmt.Sprintf("Welcome <b>%s</b>, We're happy to have you with us.", user.Username)}
@@ -1,11 +1,21 @@
 package webuser_test
 
 import (
+	"context"
+	"errors"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 
+	"github.com/ngocphuongnb/tetua/app/auth"
+	"github.com/ngocphuongnb/tetua/app/cache"
+	"github.com/ngocphuongnb/tetua/app/config"
+	"github.com/ngocphuongnb/tetua/app/entities"
 	"github.com/ngocphuongnb/tetua/app/mock"
+	mockrepository "github.com/ngocphuongnb/tetua/app/mock/repository"
+	"github.com/ngocphuongnb/tetua/app/repositories"
 	webuser "github.com/ngocphuongnb/tetua/app/web/user"
 	"github.com/stretchr/testify/assert"
 )
@@ -17,3 +27,68 @@ func TestUserLogin(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 	assert.Equal(t, true, strings.Contains(body, `<h1 class="text-center">Login</h1>`))
 }
+
+func TestRegisterInvite(t *testing.T) {
+	mock.CreateLogger(true)
+	mock.CreateRepositories()
+	cache.SetPermissions([]*entities.Role{auth.ROLE_ADMIN, auth.ROLE_USER, auth.ROLE_GUEST}, cache.RolesPermissions())
+	repositories.Invite.Create(context.Background(), &entities.Invite{
+		Code:    "welcome",
+		UserID:  1,
+		RoleID:  auth.ROLE_ADMIN.ID,
+		MaxUses: 1,
+	})
+
+	mockServer := mock.CreateServer()
+	mockServer.Get("/register", webuser.Register)
+	mockServer.Post("/register", webuser.PostRegister)
+	register := func(username, code string) (string, *http.Response) {
+		form := url.Values{}
+		form.Set("username", username)
+		form.Set("email", username+"@local.localhost")
+		form.Set("password", "123456")
+		form.Set("passwordconfirmation", "123456")
+		form.Set("invite_code", code)
+		req := httptest.NewRequest("POST", "/register", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return mock.SendRequest(mockServer, req)
+	}
+
+	config.Settings([]*config.SettingItem{{Name: "registration_mode", Value: config.REGISTRATION_CLOSED}})
+	_, resp := mock.GetRequest(mockServer, "/register")
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	_, resp = register("user1", "")
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	config.Settings([]*config.SettingItem{{Name: "registration_mode", Value: config.REGISTRATION_INVITE}})
+	body, resp := mock.GetRequest(mockServer, "/register?code=welcome")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, true, strings.Contains(body, `value="welcome"`))
+
+	body, _ = register("user1", "")
+	assert.Equal(t, true, strings.Contains(body, "Invite code is required"))
+
+	body, _ = register("user1", "invalid")
+	assert.Equal(t, true, strings.Contains(body, "Invalid invite code"))
+
+	mockrepository.FakeRepoErrors["user_create"] = errors.New("Error creating user")
+	body, _ = register("user1", "welcome")
+	mockrepository.FakeRepoErrors["user_create"] = nil
+	assert.Equal(t, true, strings.Contains(body, "Something went wrong"))
+	invite, _ := repositories.Invite.ByCode(context.Background(), "welcome")
+	assert.Equal(t, 0, invite.Used)
+
+	body, _ = register("user1", "welcome")
+	assert.Equal(t, true, strings.Contains(body, "Your account has been created"))
+
+	users, _ := repositories.User.ByUsernameOrEmail(context.Background(), "user1", "")
+	assert.Equal(t, 1, len(users))
+	assert.Equal(t, []int{auth.ROLE_ADMIN.ID}, users[0].RoleIDs)
+	assert.Equal(t, 1, users[0].InviteID)
+	repositories.User.DeleteByID(context.Background(), users[0].ID)
+
+	body, _ = register("user2", "welcome")
+	assert.Equal(t, true, strings.Contains(body, "The invite code is expired or has been used up"))
+
+	config.Settings([]*config.SettingItem{{Name: "registration_mode", Value: config.REGISTRATION_OPEN}})
+}
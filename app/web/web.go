@@ -29,6 +29,7 @@ var (
 		DefaultValue: entities.PERM_OWN,
 		Prepare:      auth.GetPost,
 		OwnCheckFN:   auth.PostOwnerCheck,
+		TopicCheckFN: auth.PostTopicCheck,
 	})
 
 	authPostSave = auth.Config(&server.AuthConfig{
@@ -36,6 +37,7 @@ var (
 		DefaultValue: entities.PERM_OWN,
 		Prepare:      auth.GetPost,
 		OwnCheckFN:   auth.PostOwnerCheck,
+		TopicCheckFN: auth.PostSaveTopicCheck,
 	})
 
 	authPostDelete = auth.Config(&server.AuthConfig{
@@ -86,6 +88,13 @@ var (
 		OwnCheckFN:   auth.AllowLoggedInUser,
 	})
 
+	authFileSave = auth.Config(&server.AuthConfig{
+		Action:       "file.save",
+		DefaultValue: entities.PERM_OWN,
+		Prepare:      auth.GetFile,
+		OwnCheckFN:   auth.FileOwnerCheck,
+	})
+
 	authFileDelete = auth.Config(&server.AuthConfig{
 		Action:       "file.delete",
 		DefaultValue: entities.PERM_OWN,
@@ -93,6 +102,25 @@ var (
 		OwnCheckFN:   auth.FileOwnerCheck,
 	})
 
+	authInviteList = auth.Config(&server.AuthConfig{
+		Action:       "invite.list",
+		DefaultValue: entities.PERM_NONE,
+		OwnCheckFN:   auth.AllowLoggedInUser,
+	})
+
+	authInviteSave = auth.Config(&server.AuthConfig{
+		Action:       "invite.save",
+		DefaultValue: entities.PERM_NONE,
+		OwnCheckFN:   auth.AllowLoggedInUser,
+	})
+
+	authInviteDelete = auth.Config(&server.AuthConfig{
+		Action:       "invite.delete",
+		DefaultValue: entities.PERM_NONE,
+		Prepare:      auth.GetInvite,
+		OwnCheckFN:   auth.InviteOwnerCheck,
+	})
+
 	authUserProfile = auth.Config(&server.AuthConfig{
 		Action:       "user.profile",
 		DefaultValue: entities.PERM_ALL,
@@ -110,6 +138,11 @@ var (
 		OwnCheckFN:   auth.AllowLoggedInUser,
 	})
 
+	authPageView = auth.Config(&server.AuthConfig{
+		Action:       "page.view",
+		DefaultValue: entities.PERM_ALL,
+	})
+
 	authTopicView = auth.Config(&server.AuthConfig{
 		Action:       "topic.view",
 		DefaultValue: entities.PERM_ALL,
@@ -172,8 +205,23 @@ func NewServer(cfg Config) server.Server {
 	file := s.Group("/files")
 	file.Post("/upload", Upload, authFileUpload)
 	file.Get("", FileList, authFileList)
+	file.Get("/private/:disk/*", PrivateFile)
+	file.Options("/tus", TusOptions)
+	file.Post("/tus", TusCreate, authFileUpload)
+	file.Head("/tus/:id", TusHead, authFileUpload)
+	file.Patch("/tus/:id", TusPatch, authFileUpload)
+	file.Delete("/tus/:id", TusDelete, authFileUpload)
+	file.Get("/:id", FileEdit, authFileSave)
+	file.Post("/:id", FileSave, authFileSave)
 	file.Delete("/:id", FileDelete, authFileDelete)
 
+	s.Get("/img/:params/*", ImageTransform)
+
+	invite := s.Group("/invites")
+	invite.Get("", InviteList, authInviteList)
+	invite.Post("", InviteSave, authInviteSave)
+	invite.Delete("/:id", InviteDelete, authInviteDelete)
+
 	profile := s.Group("/u")
 	profile.Get("/:username", webuser.Profile, authUserProfile)
 
@@ -193,11 +241,15 @@ func NewServer(cfg Config) server.Server {
 	s.Get("/sitemap/posts-:page.xml", websitemap.Post)
 	s.Get("/settings", websetting.Index, authUserSettingCompose)
 	s.Post("/settings", websetting.Save, authUserSettingSave)
+	s.Post("/settings/passkeys/options", websetting.PasskeyOptions, authUserSettingSave)
+	s.Post("/settings/passkeys", websetting.PasskeyRegister, authUserSettingSave)
+	s.Delete("/settings/passkeys/:id", websetting.PasskeyDelete, authUserSettingSave)
 
 	s.Get("/posts", webpost.List, authPostList)
 	s.Get("/:slug.html", webpost.View, authPostView)
 	s.Get("/:slug", TopicView, authTopicView)
 	s.Get("/:slug/feed", TopicFeed, authTopicFeed)
+	s.Get("/*", webpost.ViewPagePath, authPageView)
 
 	return s
 }
@@ -1,10 +1,14 @@
 package web_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"image"
+	"image/png"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -20,6 +24,7 @@ import (
 	"github.com/ngocphuongnb/tetua/app/repositories"
 	"github.com/ngocphuongnb/tetua/app/server"
 	"github.com/ngocphuongnb/tetua/app/web"
+	webpost "github.com/ngocphuongnb/tetua/app/web/post"
 	"github.com/stretchr/testify/assert"
 	"github.com/tdewolff/minify/v2"
 	"github.com/tdewolff/minify/v2/xml"
@@ -34,7 +39,7 @@ var topic1 *entities.Topic
 func init() {
 	m.AddFunc("xml", xml.Minify)
 	fs.New("disk_mock", []fs.FSDisk{&mock.Disk{}})
-	cache.Roles = []*entities.Role{auth.ROLE_ADMIN, auth.ROLE_USER, auth.ROLE_GUEST}
+	cache.SetPermissions([]*entities.Role{auth.ROLE_ADMIN, auth.ROLE_USER, auth.ROLE_GUEST}, cache.RolesPermissions())
 	mockLogger = mock.CreateLogger(true)
 	mock.CreateRepositories()
 	config.Settings([]*config.SettingItem{{
@@ -186,6 +191,83 @@ func TestFileList(t *testing.T) {
 
 	assert.Equal(t, fileLinks, foundLinks)
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, doc.Find(".storage-usage").Text(), "Storage: ")
+	assert.NotContains(t, doc.Find(".storage-usage").Text(), "Max file size")
+
+	mockServer.Get("/files-limited", func(c server.Context) error {
+		c.Locals("user", &entities.User{ID: 1, Roles: []*entities.Role{{
+			ID:               2,
+			MaxUploadSize:    2 * 1024 * 1024,
+			UploadMimeGroups: []string{"image"},
+			StorageQuota:     1024 * 1024 * 1024,
+		}}})
+		return web.FileList(c)
+	})
+
+	body, _ = mock.GetRequest(mockServer, "/files-limited")
+	doc, err = goquery.NewDocumentFromReader(strings.NewReader(body))
+	assert.Nil(t, err)
+	assert.Contains(t, doc.Find(".storage-usage").Text(), "of 1 GB used")
+	assert.Contains(t, doc.Find(".storage-usage").Text(), "Max file size: 2 MB")
+	assert.Contains(t, doc.Find(".storage-usage").Text(), "Allowed types: image")
+}
+
+func TestFileLibrary(t *testing.T) {
+	mockServer := mock.CreateServer()
+	withFile := func(handler server.Handler) server.Handler {
+		return func(c server.Context) error {
+			c.Locals("user", &entities.User{ID: 1})
+			file, _ := repositories.File.ByID(context.Background(), c.ParamInt("id"))
+			c.Locals("file", file)
+			return handler(c)
+		}
+	}
+	mockServer.Get("/files", withFile(web.FileList))
+	mockServer.Get("/files/:id", withFile(web.FileEdit))
+	mockServer.Post("/files/:id", withFile(web.FileSave))
+
+	body, resp := mock.GetRequest(mockServer, "/files?format=json&type=image")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, body, `"url":"/disk_mock//test/file1.jpg"`)
+	assert.Contains(t, body, `"url":"/disk_mock//test/file2.jpg"`)
+	assert.NotContains(t, body, "file3.jpg")
+
+	body, _ = mock.GetRequest(mockServer, "/files?format=json&type=document")
+	assert.Equal(t, `{"data":[],"page_current":1,"total":0}`, body)
+
+	mockrepository.FakeFileUsages[1] = []*entities.FileUsage{{Type: "post", ID: 1, Name: "test post 1"}}
+	body, resp = mock.GetRequest(mockServer, "/files/1")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, body, `/posts/1">test post 1</a>`)
+	delete(mockrepository.FakeFileUsages, 1)
+
+	req := httptest.NewRequest("POST", "/files/1", strings.NewReader("title=Logo&alt=Site+logo&caption=Our+logo&tags=brand,+logo,brand"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	_, resp = mock.SendRequest(mockServer, req)
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, "/files/1", resp.Header.Get("Location"))
+
+	file, _ := repositories.File.ByID(context.Background(), 1)
+	assert.Equal(t, "Logo", file.Title)
+	assert.Equal(t, "Site logo", file.Alt)
+	assert.Equal(t, "Our logo", file.Caption)
+	assert.Equal(t, []string{"brand", "logo"}, file.Tags)
+
+	body, _ = mock.GetRequest(mockServer, "/files?format=json&q=Logo")
+	assert.Contains(t, body, `"title":"Logo"`)
+	assert.NotContains(t, body, "file2.jpg")
+
+	req = httptest.NewRequest("POST", "/files/1", strings.NewReader("alt="+strings.Repeat("a", 256)))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	body, resp = mock.SendRequest(mockServer, req)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Contains(t, body, "Alt text can&#39;t be more than 255 characters")
+
+	file.Title = ""
+	file.Alt = ""
+	file.Caption = ""
+	file.Tags = nil
+	repositories.File.Update(context.Background(), file)
 }
 
 func TestFileDeleteError1(t *testing.T) {
@@ -262,6 +344,251 @@ func TestFileUpload(t *testing.T) {
 	req = mock.CreateUploadRequest("POST", "/files", "file", "image.jpg")
 	body, _ = mock.SendRequest(mockServer, req)
 	assert.Equal(t, `{"size":100,"type":"image/jpeg","url":"/disk_mock/image.jpg"}`, body)
+
+	mockServer.Post("/files-limited", func(c server.Context) error {
+		c.Locals("user", &entities.User{ID: 1, Roles: []*entities.Role{{ID: 2, UploadMimeGroups: []string{"video"}}}})
+		return web.Upload(c)
+	})
+	req = mock.CreateUploadRequest("POST", "/files-limited", "file", "image.jpg")
+	body, resp := mock.SendRequest(mockServer, req)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, `{"error":"The file type is not allowed, allowed types: video"}`, body)
+}
+
+func TestPrivateFile(t *testing.T) {
+	appKey := config.APP_KEY
+	config.APP_KEY = "private_file_key"
+	defer func() { config.APP_KEY = appKey }()
+	privateDisk := &mock.PrivateDisk{
+		Disk:    &mock.Disk{Files: map[string][]byte{"2022/doc.pdf": []byte("%PDF-1.4")}},
+		SignKey: config.APP_KEY,
+	}
+	fs.New("disk_mock", []fs.FSDisk{privateDisk})
+	mockServer := mock.CreateServer()
+	mockServer.Get("/files/private/:disk/*", web.PrivateFile)
+
+	signedUrl, _ := privateDisk.SignedUrl(context.Background(), "2022/doc.pdf", time.Hour)
+	body, resp := mock.GetRequest(mockServer, signedUrl)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "%PDF-1.4", body)
+	assert.Equal(t, "application/pdf", resp.Header.Get("Content-Type"))
+	assert.Contains(t, resp.Header.Get("Cache-Control"), "private, max-age=")
+
+	body, resp = mock.GetRequest(mockServer, "/files/private/disk_private/2022/doc.pdf")
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Equal(t, "The link is invalid or has expired", body)
+
+	expiredUrl, _ := privateDisk.SignedUrl(context.Background(), "2022/doc.pdf", -time.Minute)
+	_, resp = mock.GetRequest(mockServer, expiredUrl)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	_, resp = mock.GetRequest(mockServer, "/files/private/disk_mock/2022/doc.pdf")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	missingUrl, _ := privateDisk.SignedUrl(context.Background(), "2022/missing.pdf", time.Hour)
+	_, resp = mock.GetRequest(mockServer, missingUrl)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestTusUpload(t *testing.T) {
+	fs.New("disk_mock", []fs.FSDisk{&mock.PrivateDisk{Disk: &mock.Disk{}}})
+	privateDisk := fs.PrivateDisk().(*mock.PrivateDisk)
+	privateDisk.Files = map[string][]byte{}
+	user := &entities.User{ID: 1, Roles: []*entities.Role{{ID: 2, MaxUploadSize: 100}}}
+	withUser := func(handler server.Handler) server.Handler {
+		return func(c server.Context) error {
+			c.Locals("user", user)
+			return handler(c)
+		}
+	}
+	mockServer := mock.CreateServer()
+	mockServer.Options("/files/tus", withUser(web.TusOptions))
+	mockServer.Post("/files/tus", withUser(web.TusCreate))
+	mockServer.Head("/files/tus/:id", withUser(web.TusHead))
+	mockServer.Patch("/files/tus/:id", withUser(web.TusPatch))
+	mockServer.Delete("/files/tus/:id", withUser(web.TusDelete))
+	tusRequest := func(method, uri string, body string, headers map[string]string) (string, *http.Response) {
+		req := httptest.NewRequest(method, uri, strings.NewReader(body))
+		req.Header.Set("Tus-Resumable", "1.0.0")
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
+		return mock.SendRequest(mockServer, req)
+	}
+	patch := func(uri string, offset int, chunk string) (string, *http.Response) {
+		return tusRequest("PATCH", uri, chunk, map[string]string{
+			"Content-Type":  "application/offset+octet-stream",
+			"Upload-Offset": fmt.Sprintf("%d", offset),
+		})
+	}
+
+	_, resp := mock.Request(mockServer, "OPTIONS", "/files/tus")
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, "1.0.0", resp.Header.Get("Tus-Version"))
+	assert.Equal(t, "creation,termination", resp.Header.Get("Tus-Extension"))
+	assert.Equal(t, "100", resp.Header.Get("Tus-Max-Size"))
+
+	_, resp = mock.PostRequest(mockServer, "/files/tus", map[string]string{"Upload-Length": "10"})
+	assert.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+
+	body, resp := tusRequest("POST", "/files/tus", "", map[string]string{"Upload-Length": "200"})
+	assert.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+	assert.Equal(t, `{"error":"The file is too large, the maximum upload size is 100 B"}`, body)
+
+	body, resp = tusRequest("POST", "/files/tus", "", map[string]string{"Upload-Length": "10", "Upload-Metadata": "filename !!!"})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, `{"error":"Invalid upload metadata"}`, body)
+
+	// filename "video.mp4", filetype "video/mp4"
+	_, resp = tusRequest("POST", "/files/tus", "", map[string]string{
+		"Upload-Length":   "10",
+		"Upload-Metadata": "filename dmlkZW8ubXA0,filetype dmlkZW8vbXA0",
+	})
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	location := resp.Header.Get("Location")
+	uploadUrl := strings.TrimPrefix(location, config.Setting("app_base_url"))
+	assert.Regexp(t, `/files/tus/[0-9a-f]{32}$`, location)
+
+	_, resp = patch(uploadUrl, 0, "01234")
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, "5", resp.Header.Get("Upload-Offset"))
+
+	_, resp = tusRequest("HEAD", uploadUrl, "", nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "5", resp.Header.Get("Upload-Offset"))
+	assert.Equal(t, "10", resp.Header.Get("Upload-Length"))
+	assert.Equal(t, "no-store", resp.Header.Get("Cache-Control"))
+
+	_, resp = patch(uploadUrl, 2, "23456")
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	assert.Equal(t, "5", resp.Header.Get("Upload-Offset"))
+
+	_, resp = tusRequest("PATCH", uploadUrl, "56789", map[string]string{"Upload-Offset": "5"})
+	assert.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+
+	body, resp = patch(uploadUrl, 5, "5678901")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, `{"error":"The chunk exceeds the upload length"}`, body)
+
+	_, resp = patch(uploadUrl, 5, "56789")
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, "10", resp.Header.Get("Upload-Offset"))
+	assert.Equal(t, "/disk_mock/video.mp4", resp.Header.Get("X-File-Url"))
+	fileID := resp.Header.Get("X-File-Id")
+	assert.NotEmpty(t, fileID)
+
+	_, resp = tusRequest("HEAD", uploadUrl, "", nil)
+	assert.Equal(t, "10", resp.Header.Get("Upload-Offset"))
+	assert.Equal(t, fileID, resp.Header.Get("X-File-Id"))
+	// only the state of the completed upload is kept
+	assert.Equal(t, 1, len(privateDisk.Files))
+
+	_, resp = tusRequest("HEAD", "/files/tus/0123456789abcdef0123456789abcdef", "", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	user.ID = 2
+	_, resp = tusRequest("HEAD", uploadUrl, "", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	user.ID = 1
+
+	_, resp = tusRequest("DELETE", uploadUrl, "", nil)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, 0, len(privateDisk.Files))
+	_, resp = tusRequest("HEAD", uploadUrl, "", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+type imagesDisk struct {
+	*mock.Disk
+}
+
+func (d *imagesDisk) Name() string {
+	return "disk_images"
+}
+
+func TestImageTransform(t *testing.T) {
+	appKey := config.APP_KEY
+	config.APP_KEY = "image_transform_key"
+	defer func() { config.APP_KEY = appKey }()
+	buf := &bytes.Buffer{}
+	png.Encode(buf, image.NewRGBA(image.Rect(0, 0, 400, 200)))
+	fs.New("disk_mock", []fs.FSDisk{&imagesDisk{&mock.Disk{Files: map[string][]byte{"2022/photo.png": buf.Bytes()}}}})
+	mockServer := mock.CreateServer()
+	mockServer.Get("/img/:params/*", web.ImageTransform)
+	photo := &entities.File{Disk: "disk_images", Path: "2022/photo.png", Type: "image/png"}
+	imageUrl := strings.TrimPrefix(photo.TransformUrl("w_100,f_jpeg"), config.Setting("app_base_url"))
+	assert.Regexp(t, `^/img/w_100,f_jpeg,d_disk_images,s_[0-9a-f]{32}/2022/photo.png$`, imageUrl)
+
+	body, resp := mock.GetRequest(mockServer, imageUrl)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "image/jpeg", resp.Header.Get("Content-Type"))
+	assert.Equal(t, "public, max-age=31536000, immutable", resp.Header.Get("Cache-Control"))
+	cfg, _, _ := image.DecodeConfig(strings.NewReader(body))
+	assert.Equal(t, []int{100, 50}, []int{cfg.Width, cfg.Height})
+
+	etag := resp.Header.Get("ETag")
+	assert.NotEmpty(t, etag)
+	_, resp = mock.GetRequest(mockServer, imageUrl, map[string]string{"If-None-Match": etag})
+	assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+
+	body, resp = mock.GetRequest(mockServer, strings.Replace(imageUrl, "w_100", "w_1000", 1))
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Equal(t, "Invalid image signature", body)
+
+	_, resp = mock.GetRequest(mockServer, "/img/w_abc/2022/photo.png")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	missing := &entities.File{Disk: "disk_images", Path: "2022/missing.png", Type: "image/png"}
+	_, resp = mock.GetRequest(mockServer, strings.TrimPrefix(missing.TransformUrl("w_100"), config.Setting("app_base_url")))
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestInvite(t *testing.T) {
+	mockServer := mock.CreateServer()
+	withUser := func(handler server.Handler) server.Handler {
+		return func(c server.Context) error {
+			c.Locals("user", mock.NormalUser2)
+			return handler(c)
+		}
+	}
+	mockServer.Get("/invites", withUser(web.InviteList))
+	mockServer.Post("/invites", withUser(web.InviteSave))
+	mockServer.Delete("/invites/:id", withUser(web.InviteDelete))
+
+	req := httptest.NewRequest("POST", "/invites", strings.NewReader("code=welcome&max_uses=2&expires_in=3&role_id=1"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	_, resp := mock.SendRequest(mockServer, req)
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+
+	invite, err := repositories.Invite.ByCode(context.Background(), "welcome")
+	assert.Nil(t, err)
+	assert.Equal(t, mock.NormalUser2.ID, invite.UserID)
+	assert.Equal(t, auth.ROLE_USER.ID, invite.RoleID)
+	assert.Equal(t, 2, invite.MaxUses)
+	assert.NotNil(t, invite.ExpiresAt)
+	assert.Equal(t, true, invite.Usable())
+
+	req = httptest.NewRequest("POST", "/invites", strings.NewReader("max_uses=0"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	mock.SendRequest(mockServer, req)
+	invites, _ := repositories.Invite.Find(context.Background(), &entities.InviteFilter{
+		Filter:  &entities.Filter{},
+		UserIDs: []int{mock.NormalUser2.ID},
+	})
+	assert.Equal(t, 2, len(invites))
+	assert.Equal(t, 16, len(invites[1].Code))
+	assert.Nil(t, invites[1].ExpiresAt)
+
+	body, resp := mock.GetRequest(mockServer, "/invites")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, true, strings.Contains(body, invite.Url()))
+
+	body, resp = mock.Request(mockServer, "DELETE", fmt.Sprintf("/invites/%d", invite.ID))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, `{"type":"success","message":"Invite deleted"}`, body)
+
+	_, err = repositories.Invite.ByCode(context.Background(), "welcome")
+	assert.Equal(t, true, entities.IsNotFound(err))
 }
 
 func TestIndex(t *testing.T) {
@@ -457,3 +784,31 @@ func TestTopicFeedError(t *testing.T) {
 	))
 	assert.Equal(t, expectFeed, body)
 }
+
+func TestViewPagePath(t *testing.T) {
+	mockServer := mock.CreateServer()
+	mockServer.Get("/*", func(c server.Context) error {
+		return webpost.ViewPagePath(c)
+	})
+	docs, _ := repositories.Page.Create(context.Background(), &entities.Page{ID: 101, Name: "Docs", Slug: "docs"})
+	guides, _ := repositories.Page.Create(context.Background(), &entities.Page{ID: 102, Name: "Guides", Slug: "guides"})
+	install, _ := repositories.Page.Create(context.Background(), &entities.Page{ID: 103, Name: "Install", Slug: "install", ParentID: docs.ID, Parent: docs})
+
+	body, resp := mock.GetRequest(mockServer, "/docs/install")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, body, "Install")
+
+	_, resp = mock.GetRequest(mockServer, "/docs")
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, docs.Url(), resp.Header.Get("Location"))
+
+	for _, path := range []string{"/guides/install", "/install", "/docs/guides/install", "/guides/docs", "/docs/missing"} {
+		body, resp = mock.GetRequest(mockServer, path)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode, path)
+		assert.Contains(t, body, "Page not found", path)
+	}
+
+	for _, page := range []*entities.Page{docs, guides, install} {
+		repositories.Page.DeleteByID(context.Background(), page.ID)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path"
 	"sort"
+	"time"
 
 	_ "ariga.io/sqlcomment"
 	_ "github.com/Joker/hpp"
@@ -19,41 +20,84 @@ import (
 	"github.com/ngocphuongnb/tetua/app/fs"
 	"github.com/ngocphuongnb/tetua/app/logger"
 	"github.com/ngocphuongnb/tetua/app/repositories"
+	"github.com/ngocphuongnb/tetua/app/services"
 
 	"github.com/ngocphuongnb/tetua/app/web"
 	sa "github.com/ngocphuongnb/tetua/packages/auth"
 	ent "github.com/ngocphuongnb/tetua/packages/entrepository"
 	"github.com/ngocphuongnb/tetua/packages/rclonefs"
+	"github.com/ngocphuongnb/tetua/packages/redistransport"
 	zap "github.com/ngocphuongnb/tetua/packages/zaplogger"
 	"github.com/urfave/cli/v2"
 )
 
-func prepare(workingDir string) {
+// prepareDatabase loads the config and connects the repositories,
+// the pending migrations are applied when autoMigrate is true and the db_auto_migrate config is enabled
+func prepareDatabase(workingDir string, autoMigrate bool) {
 	config.Init(workingDir)
-	themeDir := path.Join(config.ROOT_DIR, "app/themes", config.APP_THEME)
 	logger.New(zap.New(zap.Config{
 		Development: config.DEVELOPMENT,
 		LogFile:     path.Join(config.PRIVATE_DIR, "logs/tetua.log"),
 	}))
-	repositories.New(ent.New(ent.Config{DB_DSN: config.DB_DSN}))
+	repositories.New(ent.New(ent.Config{
+		DB_DSN:          config.DB_DSN,
+		DB_DRIVER:       config.DB_DRIVER,
+		DB_AUTO_MIGRATE: autoMigrate && config.DB_AUTO_MIGRATE,
+	}))
+}
+
+func prepare(workingDir string, beforeCache ...func() error) {
+	prepareDatabase(workingDir, true)
+	themeDir := path.Join(config.ROOT_DIR, "app/themes", config.APP_THEME)
 	config.Settings(repositories.Setting.All(context.Background()))
 	asset.Load(themeDir, false)
 	fs.New(
 		config.STORAGES.DefaultDisk,
 		rclonefs.NewFromConfig(config.STORAGES),
 	)
+	fs.AddUploadFilter(services.StripImageMetadata)
+	fs.AddUploadFilter(services.SanitizeSVG)
 	auth.New(map[string]auth.NewProviderFn{
 		"local":   sa.NewLocal,
 		"github":  sa.NewGithub,
 		"google":  sa.NewGoogle,
 		"twitter": sa.NewTwitter,
+		"passkey": sa.NewPasskey,
 	})
 
+	if err := cache.Listen(cacheTransport()); err != nil {
+		log.Fatal("Cache transport error", err)
+	}
+
+	for _, fn := range beforeCache {
+		if err := fn(); err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	if err := cache.All(); err != nil {
 		log.Fatal("Cache error", err)
 	}
 }
 
+func cacheTransport() cache.Transport {
+	if config.Cache != nil && config.Cache.Transport == "redis" {
+		return redistransport.New(redistransport.Config{
+			Addr:     config.Cache.RedisAddr,
+			Password: config.Cache.RedisPassword,
+			Channel:  config.Cache.RedisChannel,
+			// the invalidation events of the other instances are missed while the connection is down
+			OnReconnect: func() {
+				if err := cache.All(); err != nil {
+					logger.Error("Error rebuilding the cache after the transport reconnected", err)
+				}
+			},
+		})
+	}
+
+	return cache.NewLocalTransport()
+}
+
 func getWd(c *cli.Context) string {
 	workingDir := c.Args().First()
 
@@ -78,7 +122,9 @@ func main() {
 				Aliases: []string{"r"},
 				Usage:   "Start tetua server",
 				Action: func(c *cli.Context) error {
-					prepare(getWd(c))
+					prepare(getWd(c), cmd.CheckPermissions)
+					cmd.ScheduleAuditLogPrune(24 * time.Hour)
+					cmd.ScheduleFilesGC(24 * time.Hour)
 
 					web.NewServer(web.Config{
 						JwtSigningKey: config.APP_KEY,
@@ -118,6 +164,433 @@ func main() {
 					return cmd.Setup(c.String("username"), c.String("password"))
 				},
 			},
+			{
+				Name:  "permissions",
+				Usage: "Manage the role permissions",
+				Subcommands: []*cli.Command{
+					{
+						Name:  "sync",
+						Usage: "Create the missing permissions for new actions and report orphaned permissions",
+						Flags: []cli.Flag{
+							&cli.BoolFlag{
+								Name:  "dry-run",
+								Usage: "Only report the changes",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							prepare(getWd(c))
+							result, err := cmd.SyncPermissions(c.Bool("dry-run"))
+
+							if err != nil {
+								return err
+							}
+
+							for _, permission := range result.Created {
+								fmt.Printf("created: role %d, %s = %s\n", permission.RoleID, permission.Action, permission.Value)
+							}
+
+							for _, permission := range result.Orphaned {
+								fmt.Printf("orphaned: role %d, %s = %s\n", permission.RoleID, permission.Action, permission.Value)
+							}
+
+							fmt.Printf("%d created, %d orphaned\n", len(result.Created), len(result.Orphaned))
+							return nil
+						},
+					},
+				},
+			},
+			{
+				Name:  "audit",
+				Usage: "Manage the audit logs",
+				Subcommands: []*cli.Command{
+					{
+						Name:  "prune",
+						Usage: "Delete the audit logs that are older than the retention setting",
+						Action: func(c *cli.Context) error {
+							prepare(getWd(c))
+							count, err := cmd.PruneAuditLogs()
+
+							if err != nil {
+								return err
+							}
+
+							fmt.Printf("%d audit logs deleted\n", count)
+							return nil
+						},
+					},
+				},
+			},
+			{
+				Name:  "files",
+				Usage: "Manage the stored files",
+				Subcommands: []*cli.Command{
+					{
+						Name:  "strip-metadata",
+						Usage: "Remove the EXIF, GPS and other metadata of the stored JPEG and PNG images",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:  "disk",
+								Usage: "Disk name, the default disk when it's empty",
+							},
+							&cli.BoolFlag{
+								Name:  "dry-run",
+								Usage: "Only report the files that have metadata",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							prepare(getWd(c))
+							diskName := c.String("disk")
+
+							if diskName == "" {
+								diskName = config.STORAGES.DefaultDisk
+							}
+
+							result, err := cmd.StripFilesMetadata(diskName, c.Bool("dry-run"))
+
+							if err != nil {
+								return err
+							}
+
+							for _, file := range result.Stripped {
+								fmt.Printf("stripped: %d %s\n", file.ID, file.Path)
+							}
+
+							for id, err := range result.Failed {
+								fmt.Printf("failed: %d %v\n", id, err)
+							}
+
+							fmt.Printf("%d files stripped, %d bytes removed, %d failed\n", len(result.Stripped), result.Removed, len(result.Failed))
+							return nil
+						},
+					},
+					{
+						Name:  "gc",
+						Usage: "Delete the files that aren't used by any post, page, avatar or setting and the expired resumable uploads",
+						Flags: []cli.Flag{
+							&cli.IntFlag{
+								Name:  "grace-days",
+								Value: -1,
+								Usage: "Keep the files uploaded within this number of days, the file_gc_grace_days setting when it's negative",
+							},
+							&cli.BoolFlag{
+								Name:  "dry-run",
+								Usage: "Only report the orphaned files and their sizes",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							prepare(getWd(c))
+							gracePeriod := cmd.FilesGCGracePeriod()
+
+							if days := c.Int("grace-days"); days >= 0 {
+								gracePeriod = time.Duration(days) * 24 * time.Hour
+							}
+
+							result, err := cmd.GCFiles(gracePeriod, c.Bool("dry-run"))
+
+							if err != nil {
+								return err
+							}
+
+							for _, file := range result.Orphaned {
+								fmt.Printf("orphaned: %d %s:%s %d bytes\n", file.ID, file.Disk, file.Path, file.Size)
+							}
+
+							for id, err := range result.Failed {
+								fmt.Printf("failed: %d %v\n", id, err)
+							}
+
+							fmt.Printf("%d orphaned files, %d bytes, %d deleted, %d failed\n", len(result.Orphaned), result.Size, len(result.Deleted), len(result.Failed))
+
+							if c.Bool("dry-run") {
+								return nil
+							}
+
+							expired, err := services.ExpireTusUploads(context.Background(), cmd.TusUploadTTL())
+
+							if err != nil {
+								return err
+							}
+
+							fmt.Printf("%d expired uploads removed\n", expired)
+							return nil
+						},
+					},
+					{
+						Name:  "migrate",
+						Usage: "Move the files of a disk to another disk and rewrite their urls, it can be run again to resume",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "from",
+								Usage:    "Source disk name",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "to",
+								Usage:    "Target disk name",
+								Required: true,
+							},
+							&cli.BoolFlag{
+								Name:  "dry-run",
+								Usage: "Only report the files that would be migrated",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							prepare(getWd(c))
+							result, err := cmd.MigrateFiles(c.String("from"), c.String("to"), c.Bool("dry-run"))
+
+							if err != nil {
+								return err
+							}
+
+							for _, file := range result.Migrated {
+								fmt.Printf("migrated: %d %s %d bytes\n", file.ID, file.Path, file.Size)
+							}
+
+							for id, err := range result.Failed {
+								fmt.Printf("failed: %d %v\n", id, err)
+							}
+
+							fmt.Printf(
+								"%d files migrated, %d bytes, %d failed, urls rewritten in %d posts, %d pages, %d users, %d menus and %d settings\n",
+								len(result.Migrated), result.Size, len(result.Failed), result.Posts, result.Pages, result.Users, result.Menus, result.Settings,
+							)
+							return nil
+						},
+					},
+				},
+			},
+			{
+				Name:  "storage",
+				Usage: "Manage the storage disks",
+				Subcommands: []*cli.Command{
+					{
+						Name:  "test",
+						Usage: "Put, get and delete a small file to check the connection and the permissions of a disk",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:  "disk",
+								Usage: "Disk name, the default disk when it's empty",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							prepare(getWd(c))
+							diskName := c.String("disk")
+
+							if diskName == "" {
+								diskName = config.STORAGES.DefaultDisk
+							}
+
+							result, err := cmd.CheckStorage(diskName)
+
+							if err != nil {
+								return err
+							}
+
+							fmt.Printf("disk %s is working: %s, %d bytes put, read back and deleted in %s\n", result.Disk, result.Url, result.Size, result.Elapsed)
+							return nil
+						},
+					},
+				},
+			},
+			{
+				Name:  "migrate",
+				Usage: "Manage the database migrations",
+				Subcommands: []*cli.Command{
+					{
+						Name:  "status",
+						Usage: "List the migrations and the time they were applied at",
+						Action: func(c *cli.Context) error {
+							prepareDatabase(getWd(c), false)
+							migrations, err := ent.Migrations.Status(c.Context)
+
+							if err != nil {
+								return err
+							}
+
+							pending := 0
+
+							for _, migration := range migrations {
+								switch {
+								case migration.Name == "":
+									fmt.Printf("missing: %d, applied at %s but its files are not found\n", migration.Version, migration.AppliedAt.Format(time.RFC3339))
+								case migration.AppliedAt == nil:
+									pending++
+									fmt.Printf("pending: %s\n", migration)
+								default:
+									fmt.Printf("applied: %s at %s\n", migration, migration.AppliedAt.Format(time.RFC3339))
+								}
+							}
+
+							fmt.Printf("%d migrations, %d pending\n", len(migrations), pending)
+							return nil
+						},
+					},
+					{
+						Name:  "up",
+						Usage: "Apply the pending migrations",
+						Flags: []cli.Flag{
+							&cli.IntFlag{
+								Name:  "steps",
+								Usage: "Number of the migrations to apply, all pending migrations when it's 0",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							prepareDatabase(getWd(c), false)
+							migrations, err := ent.Migrations.Up(c.Context, c.Int("steps"))
+
+							for _, migration := range migrations {
+								fmt.Printf("applied: %s\n", migration)
+							}
+
+							if err != nil {
+								return err
+							}
+
+							fmt.Printf("%d migrations applied\n", len(migrations))
+							return nil
+						},
+					},
+					{
+						Name:  "down",
+						Usage: "Roll back the latest applied migrations",
+						Flags: []cli.Flag{
+							&cli.IntFlag{
+								Name:  "steps",
+								Value: 1,
+								Usage: "Number of the migrations to roll back",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							prepareDatabase(getWd(c), false)
+							migrations, err := ent.Migrations.Down(c.Context, c.Int("steps"))
+
+							for _, migration := range migrations {
+								fmt.Printf("rolled back: %s\n", migration)
+							}
+
+							if err != nil {
+								return err
+							}
+
+							fmt.Printf("%d migrations rolled back\n", len(migrations))
+							return nil
+						},
+					},
+					{
+						Name:  "diff",
+						Usage: "Generate a migration from the difference of the up to date database and the ent schema",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "name",
+								Usage:    "Migration name, e.g. add_post_subtitle",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:  "dir",
+								Value: "packages/entrepository/migrations",
+								Usage: "Migrations directory, the files are written to its driver subdirectory",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							prepareDatabase(getWd(c), false)
+							files, err := ent.Migrations.Diff(c.Context, c.String("dir"), c.String("name"))
+
+							if err != nil {
+								return err
+							}
+
+							for _, file := range files {
+								fmt.Printf("created: %s\n", file)
+							}
+
+							return nil
+						},
+					},
+				},
+			},
+			{
+				Name:  "backup",
+				Usage: "Write the database and the stored files to a backup archive",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "output",
+						Usage: "Archive path, tetua-backup-{time}.tar.gz in the current directory when it's empty",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					prepare(getWd(c))
+					output := c.String("output")
+
+					if output == "" {
+						output = fmt.Sprintf("tetua-backup-%s.tar.gz", time.Now().Format("20060102150405"))
+					}
+
+					result, err := cmd.CreateBackup(output, c.Context)
+
+					if err != nil {
+						return err
+					}
+
+					for key, err := range result.Failed {
+						fmt.Printf("failed: %s %v\n", key, err)
+					}
+
+					rows := 0
+
+					for _, table := range result.Manifest.Tables {
+						rows += table.Rows
+					}
+
+					fmt.Printf(
+						"backup written to %s: %d rows, %d files, %d bytes, %d failed\n",
+						output, rows, len(result.Manifest.Objects), result.Size, len(result.Failed),
+					)
+					return nil
+				},
+			},
+			{
+				Name:  "restore",
+				Usage: "Restore a backup archive to an empty database and the configured disks",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "input",
+						Usage:    "Archive path",
+						Required: true,
+					},
+				},
+				Action: func(c *cli.Context) error {
+					prepare(getWd(c))
+					result, err := cmd.RestoreBackup(c.String("input"), c.Context)
+
+					if err != nil {
+						return err
+					}
+
+					names := make([]string, 0, len(result.Tables))
+
+					for name := range result.Tables {
+						names = append(names, name)
+					}
+
+					sort.Strings(names)
+
+					for _, name := range names {
+						table := result.Tables[name]
+						fmt.Printf("restored: %s %d rows, %d skipped, %d remapped\n", name, table.Rows, table.Skipped, table.Remapped)
+					}
+
+					if posts := result.Tables["posts"]; posts != nil && posts.Remapped > 0 {
+						fmt.Printf("warning: %d posts have new ids, their urls have changed\n", posts.Remapped)
+					}
+
+					for key, err := range result.Failed {
+						fmt.Printf("failed: %s %v\n", key, err)
+					}
+
+					fmt.Printf("%d files restored, %d bytes, %d failed\n", len(result.Restored), result.Size, len(result.Failed))
+					return nil
+				},
+			},
 			{
 				Name:  "bundlestatic",
 				Usage: "Bundle static files",
@@ -0,0 +1,352 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/ngocphuongnb/tetua/app/config"
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+	"github.com/ngocphuongnb/tetua/app/server"
+	"github.com/ngocphuongnb/tetua/app/utils"
+)
+
+const PASSKEY_LOGIN_SESSION = "passkey_login_session"
+const PASSKEY_REGISTER_SESSION = "passkey_register_session"
+
+type PasskeyAuthProvider struct {
+	webauthn *webauthn.WebAuthn
+}
+
+// passkeyUser adapts a user and their passkeys to the webauthn.User interface
+type passkeyUser struct {
+	user     *entities.User
+	passkeys []*entities.Passkey
+}
+
+func (u *passkeyUser) WebAuthnID() []byte {
+	return []byte(strconv.Itoa(u.user.ID))
+}
+
+func (u *passkeyUser) WebAuthnName() string {
+	return u.user.Username
+}
+
+func (u *passkeyUser) WebAuthnDisplayName() string {
+	return u.user.Name()
+}
+
+func (u *passkeyUser) WebAuthnIcon() string {
+	return ""
+}
+
+func (u *passkeyUser) WebAuthnCredentials() []webauthn.Credential {
+	credentials := make([]webauthn.Credential, 0)
+
+	for _, passkey := range u.passkeys {
+		var transports []protocol.AuthenticatorTransport
+		for _, transport := range passkey.Transports {
+			transports = append(transports, protocol.AuthenticatorTransport(transport))
+		}
+
+		credentials = append(credentials, webauthn.Credential{
+			ID:              passkey.CredentialID,
+			PublicKey:       passkey.PublicKey,
+			AttestationType: passkey.AttestationType,
+			Transport:       transports,
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    passkey.AAGUID,
+				SignCount: passkey.SignCount,
+			},
+		})
+	}
+
+	return credentials
+}
+
+func (u *passkeyUser) descriptors() []protocol.CredentialDescriptor {
+	descriptors := make([]protocol.CredentialDescriptor, 0)
+
+	for _, credential := range u.WebAuthnCredentials() {
+		descriptors = append(descriptors, credential.Descriptor())
+	}
+
+	return descriptors
+}
+
+// NewPasskey creates the passkey provider.
+// The relying party is derived from app_base_url, it can be overridden with rp_id, rp_origin and rp_display_name.
+func NewPasskey(cfg map[string]string) server.AuthProvider {
+	baseUrl, err := url.Parse(utils.Url(""))
+
+	if err != nil {
+		panic(fmt.Sprintf("Invalid app base url: %v", err))
+	}
+
+	rpID := cfg["rp_id"]
+	rpOrigin := cfg["rp_origin"]
+	rpDisplayName := cfg["rp_display_name"]
+
+	if rpID == "" {
+		rpID = baseUrl.Hostname()
+	}
+
+	if rpOrigin == "" && baseUrl.Host != "" {
+		rpOrigin = baseUrl.Scheme + "://" + baseUrl.Host
+	}
+
+	if rpOrigin == "" {
+		rpOrigin = "https://" + rpID
+	}
+
+	if rpDisplayName == "" {
+		rpDisplayName = config.Setting("app_name", "Tetua")
+	}
+
+	w, err := webauthn.New(&webauthn.Config{
+		RPID:          rpID,
+		RPOrigin:      rpOrigin,
+		RPDisplayName: rpDisplayName,
+	})
+
+	if err != nil {
+		panic(fmt.Sprintf("Passkey config error: %v", err))
+	}
+
+	return &PasskeyAuthProvider{webauthn: w}
+}
+
+func (p *PasskeyAuthProvider) Name() string {
+	return "passkey"
+}
+
+// Login starts a discoverable login ceremony and responds with the assertion options
+func (p *PasskeyAuthProvider) Login(c server.Context) error {
+	options, session, err := p.webauthn.BeginDiscoverableLogin()
+
+	if err != nil {
+		return err
+	}
+
+	if err := setPasskeySession(c, PASSKEY_LOGIN_SESSION, session); err != nil {
+		return err
+	}
+
+	return c.Json(options)
+}
+
+// Callback verifies the assertion posted by the browser and returns the passkey owner
+func (p *PasskeyAuthProvider) Callback(c server.Context) (*entities.User, error) {
+	session, err := getPasskeySession(c, PASSKEY_LOGIN_SESSION)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedResponse, err := protocol.ParseCredentialRequestResponseBody(bytes.NewReader(c.Body()))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var passkey *entities.Passkey
+	var user *entities.User
+	credential, err := p.webauthn.ValidateDiscoverableLogin(func(rawID, userHandle []byte) (webauthn.User, error) {
+		if passkey, err = repositories.Passkey.ByCredentialID(c.Context(), rawID); err != nil {
+			return nil, err
+		}
+
+		if string(userHandle) != strconv.Itoa(passkey.UserID) {
+			return nil, errors.New("passkey does not belong to user")
+		}
+
+		if user, err = repositories.User.ByID(c.Context(), passkey.UserID); err != nil {
+			return nil, err
+		}
+
+		return &passkeyUser{user: user, passkeys: []*entities.Passkey{passkey}}, nil
+	}, *session, parsedResponse)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if credential.Authenticator.CloneWarning {
+		return nil, errors.New("passkey sign count is invalid, the authenticator may be cloned")
+	}
+
+	if !user.IsRoot() && !user.Active {
+		return nil, errors.New("user is not active")
+	}
+
+	now := time.Now()
+	passkey.SignCount = credential.Authenticator.SignCount
+	passkey.LastUsedAt = &now
+
+	if _, err := repositories.Passkey.Update(c.Context(), passkey); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// BeginRegistration responds with the credential creation options for the current user
+func (p *PasskeyAuthProvider) BeginRegistration(c server.Context) error {
+	user, err := getPasskeyUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	options, session, err := p.webauthn.BeginRegistration(
+		user,
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementRequired),
+		webauthn.WithExclusions(user.descriptors()),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if err := setPasskeySession(c, PASSKEY_REGISTER_SESSION, session); err != nil {
+		return err
+	}
+
+	return c.Json(options)
+}
+
+// FinishRegistration verifies the attestation posted by the browser and stores the new passkey.
+// The passkey name is read from the name query parameter.
+func (p *PasskeyAuthProvider) FinishRegistration(c server.Context) (*entities.Passkey, error) {
+	session, err := getPasskeySession(c, PASSKEY_REGISTER_SESSION)
+
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := getPasskeyUser(c)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedResponse, err := protocol.ParseCredentialCreationResponseBody(bytes.NewReader(c.Body()))
+
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := p.webauthn.CreateCredential(user, *session, parsedResponse)
+
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(utils.SanitizePlainText(c.Query("name")))
+
+	if name == "" {
+		name = "Passkey"
+	}
+
+	var transports []string
+	for _, transport := range credential.Transport {
+		transports = append(transports, string(transport))
+	}
+
+	return repositories.Passkey.Create(c.Context(), &entities.Passkey{
+		UserID:          user.user.ID,
+		Name:            name,
+		CredentialID:    credential.ID,
+		PublicKey:       credential.PublicKey,
+		AttestationType: credential.AttestationType,
+		AAGUID:          credential.Authenticator.AAGUID,
+		SignCount:       credential.Authenticator.SignCount,
+		Transports:      transports,
+	})
+}
+
+func getPasskeyUser(c server.Context) (*passkeyUser, error) {
+	if c.User() == nil || c.User().ID == 0 {
+		return nil, errors.New("user is not logged in")
+	}
+
+	user, err := repositories.User.ByID(c.Context(), c.User().ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	passkeys, err := repositories.Passkey.ByUserID(c.Context(), user.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &passkeyUser{user: user, passkeys: passkeys}, nil
+}
+
+// setPasskeySession stores the ceremony session data in a short lived encrypted cookie
+func setPasskeySession(c server.Context, name string, session *webauthn.SessionData) error {
+	sessionJSON, err := json.Marshal(session)
+
+	if err != nil {
+		return err
+	}
+
+	encryptedSession, err := utils.Encrypt(string(sessionJSON))
+
+	if err != nil {
+		return err
+	}
+
+	c.Cookie(&server.Cookie{
+		Name:     name,
+		Value:    encryptedSession,
+		Path:     "/",
+		Expires:  time.Now().Add(5 * time.Minute),
+		HTTPOnly: true,
+		SameSite: "lax",
+		Secure:   true,
+	})
+
+	return nil
+}
+
+// getPasskeySession reads and clears the ceremony session data, so that each challenge can only be used once
+func getPasskeySession(c server.Context, name string) (*webauthn.SessionData, error) {
+	encryptedSession := c.Cookies(name)
+	c.Cookie(&server.Cookie{
+		Name:     name,
+		Value:    "",
+		Path:     "/",
+		Expires:  time.Now().Add(-time.Hour),
+		HTTPOnly: true,
+		SameSite: "lax",
+		Secure:   true,
+	})
+
+	if encryptedSession == "" {
+		return nil, errors.New("passkey session not found")
+	}
+
+	sessionJSON, err := utils.Decrypt(encryptedSession)
+
+	if err != nil {
+		return nil, err
+	}
+
+	session := &webauthn.SessionData{}
+	if err := json.Unmarshal([]byte(sessionJSON), session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
@@ -0,0 +1,297 @@
+package auth_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/ngocphuongnb/tetua/app/auth"
+	"github.com/ngocphuongnb/tetua/app/cache"
+	"github.com/ngocphuongnb/tetua/app/config"
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/middlewares"
+	"github.com/ngocphuongnb/tetua/app/mock"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+	"github.com/ngocphuongnb/tetua/app/server"
+	ga "github.com/ngocphuongnb/tetua/packages/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+const passkeyOrigin = "http://localhost"
+const passkeyRPID = "localhost"
+
+// softAuthenticator is a software authenticator that creates and uses
+// a single ES256 credential with "none" attestation
+type softAuthenticator struct {
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	userHandle   []byte
+	signCount    uint32
+}
+
+func newSoftAuthenticator() *softAuthenticator {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	credentialID := make([]byte, 16)
+	rand.Read(credentialID)
+
+	return &softAuthenticator{key: key, credentialID: credentialID}
+}
+
+func (a *softAuthenticator) authData(flags byte, attestedCredentialData []byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(passkeyRPID))
+	counter := make([]byte, 4)
+	binary.BigEndian.PutUint32(counter, a.signCount)
+
+	data := append([]byte{}, rpIDHash[:]...)
+	data = append(data, flags)
+	data = append(data, counter...)
+
+	return append(data, attestedCredentialData...)
+}
+
+func clientData(ceremonyType string, challenge []byte) []byte {
+	data, _ := json.Marshal(map[string]string{
+		"type":      ceremonyType,
+		"challenge": base64.RawURLEncoding.EncodeToString(challenge),
+		"origin":    passkeyOrigin,
+	})
+
+	return data
+}
+
+func encode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func (a *softAuthenticator) create(options *protocol.CredentialCreation) []byte {
+	a.userHandle = options.Response.User.ID
+	publicKey, _ := cbor.Marshal(map[int]interface{}{
+		1:  2,
+		3:  -7,
+		-1: 1,
+		-2: a.key.PublicKey.X.FillBytes(make([]byte, 32)),
+		-3: a.key.PublicKey.Y.FillBytes(make([]byte, 32)),
+	})
+
+	credentialIDLength := make([]byte, 2)
+	binary.BigEndian.PutUint16(credentialIDLength, uint16(len(a.credentialID)))
+	attestedCredentialData := append(make([]byte, 16), credentialIDLength...)
+	attestedCredentialData = append(attestedCredentialData, a.credentialID...)
+	attestedCredentialData = append(attestedCredentialData, publicKey...)
+
+	attestationObject, _ := cbor.Marshal(map[string]interface{}{
+		"fmt":      "none",
+		"attStmt":  map[string]interface{}{},
+		"authData": a.authData(0x45, attestedCredentialData),
+	})
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"id":         encode(a.credentialID),
+		"rawId":      encode(a.credentialID),
+		"type":       "public-key",
+		"transports": []string{"internal"},
+		"response": map[string]string{
+			"attestationObject": encode(attestationObject),
+			"clientDataJSON":    encode(clientData("webauthn.create", options.Response.Challenge)),
+		},
+	})
+
+	return body
+}
+
+func (a *softAuthenticator) get(options *protocol.CredentialAssertion) []byte {
+	a.signCount++
+	authData := a.authData(0x05, nil)
+	clientDataJSON := clientData("webauthn.get", options.Response.Challenge)
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, _ := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"id":    encode(a.credentialID),
+		"rawId": encode(a.credentialID),
+		"type":  "public-key",
+		"response": map[string]string{
+			"authenticatorData": encode(authData),
+			"clientDataJSON":    encode(clientDataJSON),
+			"signature":         encode(signature),
+			"userHandle":        encode(a.userHandle),
+		},
+	})
+
+	return body
+}
+
+func sessionCookie(resp *http.Response, name string) string {
+	for _, cookie := range resp.Cookies() {
+		if cookie.Name == name {
+			return name + "=" + cookie.Value
+		}
+	}
+
+	return ""
+}
+
+func passkeyRequest(s server.Server, method, uri string, body []byte, cookies ...string) (string, *http.Response) {
+	req := httptest.NewRequest(method, uri, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Cookie", strings.Join(cookies, "; "))
+
+	return mock.SendRequest(s, req)
+}
+
+func createPasskeyServer(handlers ...server.Handler) server.Server {
+	config.APP_KEY = "sesj5JYrRxrB2yUWkBFM7KKWCY2ykxBw"
+	config.Auth = &config.AuthConfig{
+		EnabledProviders: []string{"passkey"},
+		Providers: map[string]map[string]string{
+			"passkey": {"rp_id": passkeyRPID, "rp_origin": passkeyOrigin},
+		},
+	}
+	mock.CreateRepositories()
+	repositories.User.Create(context.Background(), mock.RootUser)
+	repositories.User.Create(context.Background(), mock.NormalUser2)
+	cache.SetPermissions([]*entities.Role{auth.ROLE_ADMIN, auth.ROLE_USER, auth.ROLE_GUEST}, cache.RolesPermissions())
+	auth.New(map[string]auth.NewProviderFn{"passkey": ga.NewPasskey})
+	provider := auth.GetProvider("passkey").(server.PasskeyAuthProvider)
+
+	s := mock.CreateServer()
+	s.Use(handlers...)
+	auth.Routes(s)
+	s.Post("/passkeys/options", func(c server.Context) error {
+		return provider.BeginRegistration(c)
+	})
+	s.Post("/passkeys", func(c server.Context) error {
+		passkey, err := provider.FinishRegistration(c)
+		if err != nil {
+			return c.Status(http.StatusBadRequest).SendString(err.Error())
+		}
+		return c.Json(passkey)
+	})
+
+	return s
+}
+
+func TestPasskey(t *testing.T) {
+	mock.CreateLogger(true)
+	s := createPasskeyServer()
+	authenticator := newSoftAuthenticator()
+	exp := time.Now().Add(time.Hour)
+	jwtToken, _ := mock.NormalUser2.JwtClaim(exp)
+	loginCookie := config.APP_TOKEN_KEY + "=" + jwtToken
+
+	assert.Equal(t, "passkey", ga.NewPasskey(map[string]string{"rp_id": passkeyRPID}).Name())
+
+	// Registration requires a logged in user
+	_, resp := passkeyRequest(s, "POST", "/passkeys/options", nil)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+	// Registration without a ceremony session is rejected
+	body, resp := passkeyRequest(s, "POST", "/passkeys", authenticator.create(&protocol.CredentialCreation{}), loginCookie)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, "passkey session not found", body)
+
+	body, resp = passkeyRequest(s, "POST", "/passkeys/options", nil, loginCookie)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	creationOptions := &protocol.CredentialCreation{}
+	assert.Nil(t, json.Unmarshal([]byte(body), creationOptions))
+	assert.Equal(t, passkeyRPID, creationOptions.Response.RelyingParty.ID)
+	assert.Equal(t, []byte("2"), []byte(creationOptions.Response.User.ID))
+	registerSession := sessionCookie(resp, ga.PASSKEY_REGISTER_SESSION)
+
+	body, resp = passkeyRequest(s, "POST", "/passkeys?name=My+laptop", authenticator.create(creationOptions), loginCookie, registerSession)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, body)
+
+	passkeys, _ := repositories.Passkey.ByUserID(context.Background(), mock.NormalUser2.ID)
+	assert.Equal(t, 1, len(passkeys))
+	assert.Equal(t, "My laptop", passkeys[0].Name)
+	assert.Equal(t, authenticator.credentialID, passkeys[0].CredentialID)
+	assert.Equal(t, []string{"internal"}, passkeys[0].Transports)
+	assert.Equal(t, "none", passkeys[0].AttestationType)
+
+	// Login with the registered passkey
+	body, resp = passkeyRequest(s, "GET", "/auth/passkey", nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assertionOptions := &protocol.CredentialAssertion{}
+	assert.Nil(t, json.Unmarshal([]byte(body), assertionOptions))
+	loginSession := sessionCookie(resp, ga.PASSKEY_LOGIN_SESSION)
+
+	assertion := authenticator.get(assertionOptions)
+	_, resp = passkeyRequest(s, "POST", "/auth/passkey/callback", assertion, loginSession)
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.NotEqual(t, "", sessionCookie(resp, config.APP_TOKEN_KEY))
+
+	passkey, _ := repositories.Passkey.ByCredentialID(context.Background(), authenticator.credentialID)
+	assert.Equal(t, uint32(1), passkey.SignCount)
+	assert.NotNil(t, passkey.LastUsedAt)
+
+	// Login without a session is rejected
+	_, resp = passkeyRequest(s, "POST", "/auth/passkey/callback", assertion)
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+
+	// Login with an invalid signature is rejected
+	body, _ = passkeyRequest(s, "GET", "/auth/passkey", nil)
+	assertionOptions = &protocol.CredentialAssertion{}
+	assert.Nil(t, json.Unmarshal([]byte(body), assertionOptions))
+	loginSession = sessionCookie(resp, ga.PASSKEY_LOGIN_SESSION)
+	authenticator.key, _ = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	_, resp = passkeyRequest(s, "POST", "/auth/passkey/callback", authenticator.get(assertionOptions), loginSession)
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+}
+
+// The passkey requests are unsafe requests, they're sent with the CSRF token like the other requests of the theme
+func TestPasskeyCsrf(t *testing.T) {
+	mock.CreateLogger(true)
+	s := createPasskeyServer(middlewares.All()...)
+	authenticator := newSoftAuthenticator()
+	jwtToken, _ := mock.NormalUser2.JwtClaim(time.Now().Add(time.Hour))
+	loginCookie := config.APP_TOKEN_KEY + "=" + jwtToken
+
+	_, resp := passkeyRequest(s, "GET", "/auth/passkey", nil)
+	csrfCookie := sessionCookie(resp, middlewares.CSRF_COOKIE)
+	csrfToken := middlewares.CsrfToken(strings.TrimPrefix(csrfCookie, middlewares.CSRF_COOKIE+"="))
+	csrfRequest := func(uri string, body []byte, token string, cookies ...string) (string, *http.Response) {
+		req := httptest.NewRequest("POST", uri, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(middlewares.HEADER_CSRF_TOKEN, token)
+		req.Header.Set("Cookie", strings.Join(append(cookies, csrfCookie), "; "))
+		return mock.SendRequest(s, req)
+	}
+
+	body, resp := csrfRequest("/passkeys/options", nil, csrfToken, loginCookie)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, body)
+	creationOptions := &protocol.CredentialCreation{}
+	assert.Nil(t, json.Unmarshal([]byte(body), creationOptions))
+	registerSession := sessionCookie(resp, ga.PASSKEY_REGISTER_SESSION)
+
+	body, resp = csrfRequest("/passkeys?name=Phone", authenticator.create(creationOptions), csrfToken, loginCookie, registerSession)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, body)
+
+	body, resp = passkeyRequest(s, "GET", "/auth/passkey", nil, csrfCookie)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assertionOptions := &protocol.CredentialAssertion{}
+	assert.Nil(t, json.Unmarshal([]byte(body), assertionOptions))
+	loginSession := sessionCookie(resp, ga.PASSKEY_LOGIN_SESSION)
+	assertion := authenticator.get(assertionOptions)
+
+	body, resp = csrfRequest("/auth/passkey/callback", assertion, "", loginSession)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Equal(t, "Invalid CSRF token", body)
+
+	_, resp = csrfRequest("/auth/passkey/callback", assertion, csrfToken, loginSession)
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.NotEqual(t, "", sessionCookie(resp, config.APP_TOKEN_KEY))
+}
@@ -3,8 +3,15 @@ import Image from '@tiptap/extension-image';
 import { createNodeViewBlock } from '../utils';
 
 export type ImageUploadHandler = (file: File, callback: (url: string, err?: Error) => void) => void;
+export interface PickedImage {
+  url: string;
+  alt?: string;
+  title?: string;
+}
+export type ImagePickHandler = (callback: (image: PickedImage) => void) => void;
 export interface ImageExtensionProps {
   uploadHandler?: ImageUploadHandler;
+  pickHandler?: ImagePickHandler;
   disableTitle?: boolean;
 }
 
@@ -94,6 +101,32 @@ const createImageUploadElm = (dom: HTMLDivElement, uploadHandler: ImageUploadHan
   return uploadElm;
 }
 
+const createImagePickElm = (pickHandler: ImagePickHandler, editor: Editor, getPos: boolean | (() => number)) => {
+  const pickElm = document.createElement('div');
+  const pickBtn = document.createElement('button');
+
+  pickElm.className = 'mely-editor-img-pick';
+  pickBtn.setAttribute('type', 'button');
+  pickBtn.setAttribute('class', 'mely-editor-img-pick-btn');
+  pickBtn.innerText = 'Choose from library';
+  pickBtn.addEventListener('click', (e) => {
+    e.preventDefault();
+    pickHandler((image) => {
+      if (typeof getPos === 'function') {
+        editor.view.dispatch(editor.view.state.tr.setNodeMarkup(getPos(), undefined, {
+          src: image.url,
+          alt: image.alt || '',
+          title: image.title || '',
+        }))
+        editor.commands.focus();
+      }
+    });
+  });
+
+  pickElm.append(pickBtn);
+  return pickElm;
+}
+
 export const getImageExtension = (props: ImageExtensionProps = {}) => {
   const uploadHandler = props.uploadHandler || (() => console.log('Upload handler not set'));
 
@@ -188,6 +221,10 @@ export const getImageExtension = (props: ImageExtensionProps = {}) => {
           createImageUploadElm(dom, uploadHandler, editor, getPos)
         );
 
+        if (props.pickHandler) {
+          view.append(createOrTextElm(), createImagePickElm(props.pickHandler, editor, getPos));
+        }
+
         return {
           dom,
           contentDOM: contentDomElm,
@@ -25,6 +25,7 @@ export function getExtensions(props: TetuaEditorProps) {
     Typography,
     getImageExtension({
       uploadHandler: props.uploadHandler,
+      pickHandler: props.pickHandler,
     }),
     Iframe,
     TableRow,
@@ -0,0 +1,97 @@
+package entrepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	e "github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/auditlog"
+)
+
+type AuditLogRepository struct {
+	*BaseRepository[e.AuditLog, ent.AuditLog, *ent.AuditLogQuery, *e.AuditLogFilter]
+}
+
+// DeleteBefore removes the logs that were created before the given time
+func (a *AuditLogRepository) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	return a.Client.AuditLog.Delete().Where(auditlog.CreatedAtLT(before)).Exec(ctx)
+}
+
+func CreateAuditLogRepository(client *ent.Client) *AuditLogRepository {
+	return &AuditLogRepository{
+		BaseRepository: &BaseRepository[e.AuditLog, ent.AuditLog, *ent.AuditLogQuery, *e.AuditLogFilter]{
+			Name:      "audit_log",
+			Client:    client,
+			ConvertFn: entAuditLogToAuditLog,
+			ByIDFn: func(ctx context.Context, client *ent.Client, id int) (*ent.AuditLog, error) {
+				return client.AuditLog.Query().Where(auditlog.IDEQ(id)).WithUser().Only(ctx)
+			},
+			DeleteByIDFn: func(ctx context.Context, client *ent.Client, id int) error {
+				return client.AuditLog.DeleteOneID(id).Exec(ctx)
+			},
+			CreateFn: func(ctx context.Context, client *ent.Client, data *e.AuditLog) (*ent.AuditLog, error) {
+				ac := client.AuditLog.Create().
+					SetAction(data.Action).
+					SetTargetType(data.TargetType).
+					SetTargetID(data.TargetID).
+					SetBefore(data.Before).
+					SetAfter(data.After).
+					SetIP(data.IP).
+					SetRequestID(data.RequestID)
+
+				if data.UserID > 0 {
+					ac.SetUserID(data.UserID)
+				}
+
+				return ac.Save(ctx)
+			},
+			UpdateFn: func(ctx context.Context, client *ent.Client, data *e.AuditLog) (*ent.AuditLog, error) {
+				return nil, errors.New("audit logs can't be updated")
+			},
+			QueryFilterFn: func(client *ent.Client, filters ...*e.AuditLogFilter) *ent.AuditLogQuery {
+				query := client.AuditLog.Query()
+				if len(filters) > 0 {
+					if len(filters[0].UserIDs) > 0 {
+						query = query.Where(auditlog.UserIDIn(filters[0].UserIDs...))
+					}
+
+					if len(filters[0].Actions) > 0 {
+						query = query.Where(auditlog.ActionIn(filters[0].Actions...))
+					}
+
+					if len(filters[0].TargetTypes) > 0 {
+						query = query.Where(auditlog.TargetTypeIn(filters[0].TargetTypes...))
+					}
+
+					if len(filters[0].TargetIDs) > 0 {
+						query = query.Where(auditlog.TargetIDIn(filters[0].TargetIDs...))
+					}
+
+					if len(filters[0].ExcludeIDs) > 0 {
+						query = query.Where(auditlog.IDNotIn(filters[0].ExcludeIDs...))
+					}
+
+					if filters[0].Search != "" {
+						query = query.Where(auditlog.Or(
+							auditlog.RequestIDEQ(filters[0].Search),
+							auditlog.IPEQ(filters[0].Search),
+							auditlog.ActionContainsFold(filters[0].Search),
+						))
+					}
+				}
+
+				return query
+			},
+			FindFn: func(ctx context.Context, query *ent.AuditLogQuery, filters ...*e.AuditLogFilter) ([]*ent.AuditLog, error) {
+				page, limit, sorts := getPaginateParams(filters...)
+				return query.
+					WithUser().
+					Limit(limit).
+					Offset((page - 1) * limit).
+					Order(sorts...).All(ctx)
+			},
+		},
+	}
+}
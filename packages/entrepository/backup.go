@@ -0,0 +1,848 @@
+package entrepository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/auditlog"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/comment"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/customfield"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/customfieldvalue"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/file"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/invite"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menu"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menuitem"
+	entmigrate "github.com/ngocphuongnb/tetua/packages/entrepository/ent/migrate"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/page"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/passkey"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/permission"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/post"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/role"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/setting"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/topic"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/user"
+)
+
+const backupBatchSize = 100
+
+// backupTargetTables maps the target types of the audit logs, the custom field values and the menu items to their tables
+var backupTargetTables = map[string]string{
+	"comment":      comment.Table,
+	"custom_field": customfield.Table,
+	"file":         file.Table,
+	"invite":       invite.Table,
+	"menu":         menu.Table,
+	"page":         page.Table,
+	"post":         post.Table,
+	"role":         role.Table,
+	"topic":        topic.Table,
+	"user":         user.Table,
+}
+
+type backupSetter func(m ent.Mutation) error
+
+// backupRef is a column that holds the id of a row
+type backupRef struct {
+	column string
+	table  string
+	// typeColumn holds the target type of a polymorphic reference, its table is found in backupTargetTables
+	typeColumn string
+	// deferred references point to the same table or to a table that is restored later,
+	// they are set after all the rows are restored
+	deferred bool
+	// weak references have no foreign key, they are cleared when the row they point to isn't in the dump
+	weak bool
+	// list references hold a JSON list of ids, the ids that aren't in the dump are removed
+	list bool
+}
+
+// backupEdge is a many to many edge, the ids of the other side are dumped with the row
+type backupEdge struct {
+	name  string
+	table string
+	ids   func(entity interface{}) []int
+	add   func(m ent.Mutation, ids []int)
+}
+
+type backupTable struct {
+	name    string
+	entity  interface{}
+	columns []string
+	refs    []*backupRef
+	edges   []*backupEdge
+	// skipOrphans skips the rows that have a polymorphic reference to a row that isn't in the dump
+	skipOrphans bool
+	query       func(ctx context.Context, client *ent.Client, offset, limit int) ([]interface{}, error)
+	create      func(ctx context.Context, client *ent.Client, set backupSetter) (int, error)
+	update      func(ctx context.Context, client *ent.Client, id int, set backupSetter) error
+}
+
+// backupRow is a line of the dump, the values are keyed by their columns and the unset times are null
+type backupRow struct {
+	Table  string                     `json:"table"`
+	ID     int                        `json:"id"`
+	Values map[string]json.RawMessage `json:"values"`
+	Edges  map[string][]int           `json:"edges,omitempty"`
+}
+
+// backupTables are dumped and restored in this order, the rows are restored after the rows they reference
+// except the deferred references
+var backupTables = []*backupTable{
+	{
+		name:    role.Table,
+		entity:  &ent.Role{},
+		columns: role.Columns,
+		query: func(ctx context.Context, client *ent.Client, offset, limit int) ([]interface{}, error) {
+			return backupEntities(client.Role.Query().Order(ent.Asc(role.FieldID)).Offset(offset).Limit(limit).All(ctx))
+		},
+		create: func(ctx context.Context, client *ent.Client, set backupSetter) (int, error) {
+			create := client.Role.Create()
+			return backupSave(ctx, create.Mutation(), create.Save, set)
+		},
+	},
+	{
+		name:    user.Table,
+		entity:  &ent.User{},
+		columns: user.Columns,
+		refs: []*backupRef{
+			{column: user.FieldAvatarImageID, table: file.Table, deferred: true},
+			{column: user.FieldInviteID, table: invite.Table, deferred: true},
+		},
+		edges: []*backupEdge{{
+			name:  user.EdgeRoles,
+			table: role.Table,
+			ids: func(entity interface{}) []int {
+				return backupIDs(entity.(*ent.User).Edges.Roles)
+			},
+			add: func(m ent.Mutation, ids []int) {
+				m.(*ent.UserMutation).AddRoleIDs(ids...)
+			},
+		}},
+		query: func(ctx context.Context, client *ent.Client, offset, limit int) ([]interface{}, error) {
+			return backupEntities(client.User.Query().WithRoles().Order(ent.Asc(user.FieldID)).Offset(offset).Limit(limit).All(ctx))
+		},
+		create: func(ctx context.Context, client *ent.Client, set backupSetter) (int, error) {
+			create := client.User.Create()
+			return backupSave(ctx, create.Mutation(), create.Save, set)
+		},
+		update: func(ctx context.Context, client *ent.Client, id int, set backupSetter) error {
+			update := client.User.UpdateOneID(id)
+			_, err := backupSave(ctx, update.Mutation(), update.Save, set)
+			return err
+		},
+	},
+	{
+		name:    file.Table,
+		entity:  &ent.File{},
+		columns: file.Columns,
+		refs:    []*backupRef{{column: file.FieldUserID, table: user.Table}},
+		query: func(ctx context.Context, client *ent.Client, offset, limit int) ([]interface{}, error) {
+			return backupEntities(client.File.Query().Order(ent.Asc(file.FieldID)).Offset(offset).Limit(limit).All(ctx))
+		},
+		create: func(ctx context.Context, client *ent.Client, set backupSetter) (int, error) {
+			create := client.File.Create()
+			return backupSave(ctx, create.Mutation(), create.Save, set)
+		},
+	},
+	{
+		name:    invite.Table,
+		entity:  &ent.Invite{},
+		columns: invite.Columns,
+		refs: []*backupRef{
+			{column: invite.FieldUserID, table: user.Table},
+			{column: invite.FieldRoleID, table: role.Table},
+		},
+		query: func(ctx context.Context, client *ent.Client, offset, limit int) ([]interface{}, error) {
+			return backupEntities(client.Invite.Query().Order(ent.Asc(invite.FieldID)).Offset(offset).Limit(limit).All(ctx))
+		},
+		create: func(ctx context.Context, client *ent.Client, set backupSetter) (int, error) {
+			create := client.Invite.Create()
+			return backupSave(ctx, create.Mutation(), create.Save, set)
+		},
+	},
+	{
+		name:    passkey.Table,
+		entity:  &ent.Passkey{},
+		columns: passkey.Columns,
+		refs:    []*backupRef{{column: passkey.FieldUserID, table: user.Table}},
+		query: func(ctx context.Context, client *ent.Client, offset, limit int) ([]interface{}, error) {
+			return backupEntities(client.Passkey.Query().Order(ent.Asc(passkey.FieldID)).Offset(offset).Limit(limit).All(ctx))
+		},
+		create: func(ctx context.Context, client *ent.Client, set backupSetter) (int, error) {
+			create := client.Passkey.Create()
+			return backupSave(ctx, create.Mutation(), create.Save, set)
+		},
+	},
+	{
+		name:    topic.Table,
+		entity:  &ent.Topic{},
+		columns: topic.Columns,
+		refs:    []*backupRef{{column: topic.FieldParentID, table: topic.Table, deferred: true}},
+		query: func(ctx context.Context, client *ent.Client, offset, limit int) ([]interface{}, error) {
+			return backupEntities(client.Topic.Query().Order(ent.Asc(topic.FieldID)).Offset(offset).Limit(limit).All(ctx))
+		},
+		create: func(ctx context.Context, client *ent.Client, set backupSetter) (int, error) {
+			create := client.Topic.Create()
+			return backupSave(ctx, create.Mutation(), create.Save, set)
+		},
+		update: func(ctx context.Context, client *ent.Client, id int, set backupSetter) error {
+			update := client.Topic.UpdateOneID(id)
+			_, err := backupSave(ctx, update.Mutation(), update.Save, set)
+			return err
+		},
+	},
+	{
+		name:    permission.Table,
+		entity:  &ent.Permission{},
+		columns: permission.Columns,
+		refs: []*backupRef{
+			{column: permission.FieldRoleID, table: role.Table},
+			{column: permission.FieldTopicIds, table: topic.Table, list: true},
+		},
+		query: func(ctx context.Context, client *ent.Client, offset, limit int) ([]interface{}, error) {
+			return backupEntities(client.Permission.Query().Order(ent.Asc(permission.FieldID)).Offset(offset).Limit(limit).All(ctx))
+		},
+		create: func(ctx context.Context, client *ent.Client, set backupSetter) (int, error) {
+			create := client.Permission.Create()
+			return backupSave(ctx, create.Mutation(), create.Save, set)
+		},
+	},
+	{
+		name:    post.Table,
+		entity:  &ent.Post{},
+		columns: post.Columns,
+		refs: []*backupRef{
+			{column: post.FieldUserID, table: user.Table},
+			{column: post.FieldFeaturedImageID, table: file.Table},
+		},
+		edges: []*backupEdge{{
+			name:  post.EdgeTopics,
+			table: topic.Table,
+			ids: func(entity interface{}) []int {
+				return backupIDs(entity.(*ent.Post).Edges.Topics)
+			},
+			add: func(m ent.Mutation, ids []int) {
+				m.(*ent.PostMutation).AddTopicIDs(ids...)
+			},
+		}},
+		query: func(ctx context.Context, client *ent.Client, offset, limit int) ([]interface{}, error) {
+			return backupEntities(client.Post.Query().WithTopics().Order(ent.Asc(post.FieldID)).Offset(offset).Limit(limit).All(ctx))
+		},
+		create: func(ctx context.Context, client *ent.Client, set backupSetter) (int, error) {
+			create := client.Post.Create()
+			return backupSave(ctx, create.Mutation(), create.Save, set)
+		},
+	},
+	{
+		name:    comment.Table,
+		entity:  &ent.Comment{},
+		columns: comment.Columns,
+		refs: []*backupRef{
+			{column: comment.FieldPostID, table: post.Table},
+			{column: comment.FieldUserID, table: user.Table},
+			{column: comment.FieldParentID, table: comment.Table, deferred: true},
+		},
+		query: func(ctx context.Context, client *ent.Client, offset, limit int) ([]interface{}, error) {
+			return backupEntities(client.Comment.Query().Order(ent.Asc(comment.FieldID)).Offset(offset).Limit(limit).All(ctx))
+		},
+		create: func(ctx context.Context, client *ent.Client, set backupSetter) (int, error) {
+			create := client.Comment.Create()
+			return backupSave(ctx, create.Mutation(), create.Save, set)
+		},
+		update: func(ctx context.Context, client *ent.Client, id int, set backupSetter) error {
+			update := client.Comment.UpdateOneID(id)
+			_, err := backupSave(ctx, update.Mutation(), update.Save, set)
+			return err
+		},
+	},
+	{
+		name:    page.Table,
+		entity:  &ent.Page{},
+		columns: page.Columns,
+		refs: []*backupRef{
+			{column: page.FieldFeaturedImageID, table: file.Table},
+			{column: page.FieldParentID, table: page.Table, deferred: true},
+		},
+		query: func(ctx context.Context, client *ent.Client, offset, limit int) ([]interface{}, error) {
+			return backupEntities(client.Page.Query().Order(ent.Asc(page.FieldID)).Offset(offset).Limit(limit).All(ctx))
+		},
+		create: func(ctx context.Context, client *ent.Client, set backupSetter) (int, error) {
+			create := client.Page.Create()
+			return backupSave(ctx, create.Mutation(), create.Save, set)
+		},
+		update: func(ctx context.Context, client *ent.Client, id int, set backupSetter) error {
+			update := client.Page.UpdateOneID(id)
+			_, err := backupSave(ctx, update.Mutation(), update.Save, set)
+			return err
+		},
+	},
+	{
+		name:    menu.Table,
+		entity:  &ent.Menu{},
+		columns: menu.Columns,
+		query: func(ctx context.Context, client *ent.Client, offset, limit int) ([]interface{}, error) {
+			return backupEntities(client.Menu.Query().Order(ent.Asc(menu.FieldID)).Offset(offset).Limit(limit).All(ctx))
+		},
+		create: func(ctx context.Context, client *ent.Client, set backupSetter) (int, error) {
+			create := client.Menu.Create()
+			return backupSave(ctx, create.Mutation(), create.Save, set)
+		},
+	},
+	{
+		name:    menuitem.Table,
+		entity:  &ent.MenuItem{},
+		columns: menuitem.Columns,
+		refs: []*backupRef{
+			{column: menuitem.FieldMenuID, table: menu.Table},
+			{column: menuitem.FieldParentID, table: menuitem.Table, deferred: true, weak: true},
+			{column: menuitem.FieldTargetID, typeColumn: menuitem.FieldType, weak: true},
+		},
+		query: func(ctx context.Context, client *ent.Client, offset, limit int) ([]interface{}, error) {
+			return backupEntities(client.MenuItem.Query().Order(ent.Asc(menuitem.FieldID)).Offset(offset).Limit(limit).All(ctx))
+		},
+		create: func(ctx context.Context, client *ent.Client, set backupSetter) (int, error) {
+			create := client.MenuItem.Create()
+			return backupSave(ctx, create.Mutation(), create.Save, set)
+		},
+		update: func(ctx context.Context, client *ent.Client, id int, set backupSetter) error {
+			update := client.MenuItem.UpdateOneID(id)
+			_, err := backupSave(ctx, update.Mutation(), update.Save, set)
+			return err
+		},
+	},
+	{
+		name:    setting.Table,
+		entity:  &ent.Setting{},
+		columns: setting.Columns,
+		query: func(ctx context.Context, client *ent.Client, offset, limit int) ([]interface{}, error) {
+			return backupEntities(client.Setting.Query().Order(ent.Asc(setting.FieldID)).Offset(offset).Limit(limit).All(ctx))
+		},
+		create: func(ctx context.Context, client *ent.Client, set backupSetter) (int, error) {
+			create := client.Setting.Create()
+			return backupSave(ctx, create.Mutation(), create.Save, set)
+		},
+	},
+	{
+		name:    customfield.Table,
+		entity:  &ent.CustomField{},
+		columns: customfield.Columns,
+		query: func(ctx context.Context, client *ent.Client, offset, limit int) ([]interface{}, error) {
+			return backupEntities(client.CustomField.Query().Order(ent.Asc(customfield.FieldID)).Offset(offset).Limit(limit).All(ctx))
+		},
+		create: func(ctx context.Context, client *ent.Client, set backupSetter) (int, error) {
+			create := client.CustomField.Create()
+			return backupSave(ctx, create.Mutation(), create.Save, set)
+		},
+	},
+	{
+		name:        customfieldvalue.Table,
+		entity:      &ent.CustomFieldValue{},
+		columns:     customfieldvalue.Columns,
+		refs:        []*backupRef{{column: customfieldvalue.FieldTargetID, typeColumn: customfieldvalue.FieldTargetType}},
+		skipOrphans: true,
+		query: func(ctx context.Context, client *ent.Client, offset, limit int) ([]interface{}, error) {
+			return backupEntities(client.CustomFieldValue.Query().Order(ent.Asc(customfieldvalue.FieldID)).Offset(offset).Limit(limit).All(ctx))
+		},
+		create: func(ctx context.Context, client *ent.Client, set backupSetter) (int, error) {
+			create := client.CustomFieldValue.Create()
+			return backupSave(ctx, create.Mutation(), create.Save, set)
+		},
+	},
+	{
+		name:    auditlog.Table,
+		entity:  &ent.AuditLog{},
+		columns: auditlog.Columns,
+		refs: []*backupRef{
+			{column: auditlog.FieldUserID, table: user.Table},
+			{column: auditlog.FieldTargetID, typeColumn: auditlog.FieldTargetType, weak: true},
+		},
+		query: func(ctx context.Context, client *ent.Client, offset, limit int) ([]interface{}, error) {
+			return backupEntities(client.AuditLog.Query().Order(ent.Asc(auditlog.FieldID)).Offset(offset).Limit(limit).All(ctx))
+		},
+		create: func(ctx context.Context, client *ent.Client, set backupSetter) (int, error) {
+			create := client.AuditLog.Create()
+			return backupSave(ctx, create.Mutation(), create.Save, set)
+		},
+	},
+}
+
+type BackupRepository struct {
+	*Repository
+	Migrations *Migrator
+}
+
+func CreateBackupRepository(client *ent.Client, migrations *Migrator) *BackupRepository {
+	return &BackupRepository{Repository: &Repository{Client: client}, Migrations: migrations}
+}
+
+func (b *BackupRepository) Driver() string {
+	return b.Migrations.Driver
+}
+
+func (b *BackupRepository) SchemaVersion(ctx context.Context) (int64, error) {
+	return b.Migrations.Version(ctx)
+}
+
+// Dump writes a line for each row of the backup tables, the rows are read in a transaction
+// so the references between them are consistent
+func (b *BackupRepository) Dump(ctx context.Context, w io.Writer) (map[string]*entities.BackupTable, error) {
+	options := &sql.TxOptions{}
+
+	// the default isolation level of PostgreSQL reads the rows committed by the other transactions
+	if b.Migrations.Driver == dialect.Postgres {
+		options.Isolation = sql.LevelRepeatableRead
+	}
+
+	tx, err := b.Client.BeginTx(ctx, options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+	client := tx.Client()
+	encoder := json.NewEncoder(w)
+	result := map[string]*entities.BackupTable{}
+
+	for _, table := range backupTables {
+		fields, err := table.fields()
+
+		if err != nil {
+			return nil, err
+		}
+
+		result[table.name] = &entities.BackupTable{}
+
+		for offset := 0; ; offset += backupBatchSize {
+			rows, err := table.query(ctx, client, offset, backupBatchSize)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, entity := range rows {
+				row, err := table.row(entity, fields)
+
+				if err != nil {
+					return nil, err
+				}
+
+				if err := encoder.Encode(row); err != nil {
+					return nil, err
+				}
+
+				result[table.name].Rows++
+			}
+
+			if len(rows) < backupBatchSize {
+				break
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// Restore loads a dump in a transaction, nothing is restored when the dump is invalid or a reference can't be resolved
+func (b *BackupRepository) Restore(ctx context.Context, r io.Reader) (result map[string]*entities.BackupTable, err error) {
+	for _, table := range backupTables {
+		rows, err := table.query(ctx, b.Client, 0, 1)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rows) > 0 {
+			return nil, fmt.Errorf("the %s table isn't empty, a backup can only be restored to an empty database", table.name)
+		}
+	}
+
+	tx, err := b.Client.Tx(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	restore := &backupRestore{
+		client: tx.Client(),
+		ids:    map[string]map[int]int{},
+		result: map[string]*entities.BackupTable{},
+	}
+	decoder := json.NewDecoder(r)
+	tableIndex := 0
+
+	for _, table := range backupTables {
+		restore.ids[table.name] = map[int]int{}
+		restore.result[table.name] = &entities.BackupTable{}
+	}
+
+	for {
+		row := &backupRow{}
+
+		if err := decoder.Decode(row); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("invalid dump: %w", err)
+		}
+
+		// the tables are dumped in order, a table can't appear again after the next table
+		for tableIndex < len(backupTables) && backupTables[tableIndex].name != row.Table {
+			tableIndex++
+		}
+
+		if tableIndex == len(backupTables) {
+			return nil, fmt.Errorf("invalid dump: unknown table %s or the tables are out of order", row.Table)
+		}
+
+		if err := restore.row(ctx, backupTables[tableIndex], row); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := restore.deferred(ctx); err != nil {
+		return nil, err
+	}
+
+	return restore.result, tx.Commit()
+}
+
+// fields returns the index of the struct field of each column,
+// the exported fields of the ent entities are generated in the order of their columns
+func (t *backupTable) fields() (map[string]int, error) {
+	entityType := reflect.TypeOf(t.entity).Elem()
+	fields := map[string]int{}
+
+	for i := 0; i < entityType.NumField(); i++ {
+		if field := entityType.Field(i); field.IsExported() && field.Name != "Edges" {
+			if len(fields) == len(t.columns) {
+				return nil, fmt.Errorf("the %s entity has more fields than columns", t.name)
+			}
+
+			fields[t.columns[len(fields)]] = i
+		}
+	}
+
+	if len(fields) != len(t.columns) {
+		return nil, fmt.Errorf("the %s entity has less fields than columns", t.name)
+	}
+
+	return fields, nil
+}
+
+// nullColumns returns the nullable columns that have no default value
+func (t *backupTable) nullColumns() map[string]bool {
+	columns := map[string]bool{}
+
+	for _, table := range entmigrate.Tables {
+		if table.Name != t.name {
+			continue
+		}
+
+		for _, column := range table.Columns {
+			columns[column.Name] = column.Nullable && column.Default == nil
+		}
+	}
+
+	return columns
+}
+
+func (t *backupTable) row(entity interface{}, fields map[string]int) (*backupRow, error) {
+	value := reflect.ValueOf(entity).Elem()
+	row := &backupRow{Table: t.name, Values: map[string]json.RawMessage{}}
+
+	for column, index := range fields {
+		field := value.Field(index).Interface()
+
+		if column == "id" {
+			row.ID = field.(int)
+			continue
+		}
+
+		if fieldTime, ok := field.(time.Time); ok && fieldTime.IsZero() {
+			field = nil
+		}
+
+		data, err := json.Marshal(field)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s %d: %w", t.name, row.ID, err)
+		}
+
+		row.Values[column] = data
+	}
+
+	for _, edge := range t.edges {
+		if ids := edge.ids(entity); len(ids) > 0 {
+			if row.Edges == nil {
+				row.Edges = map[string][]int{}
+			}
+
+			row.Edges[edge.name] = ids
+		}
+	}
+
+	return row, nil
+}
+
+// backupPending holds the deferred references of a restored row
+type backupPending struct {
+	table     *backupTable
+	id        int
+	refs      map[*backupRef]int
+	updatedAt interface{}
+}
+
+type backupRestore struct {
+	client *ent.Client
+	// ids maps the ids of the dump to the ids of the restored rows
+	ids     map[string]map[int]int
+	pending []*backupPending
+	result  map[string]*entities.BackupTable
+}
+
+func (r *backupRestore) row(ctx context.Context, table *backupTable, row *backupRow) error {
+	fields, err := table.fields()
+
+	if err != nil {
+		return err
+	}
+
+	result := r.result[table.name]
+	result.Rows++
+
+	if _, ok := r.ids[table.name][row.ID]; ok || row.ID <= 0 {
+		return fmt.Errorf("invalid dump: %s %d is duplicated or has an invalid id", table.name, row.ID)
+	}
+
+	values := map[string]interface{}{}
+	entityType := reflect.TypeOf(table.entity).Elem()
+	nullColumns := table.nullColumns()
+
+	for column, data := range row.Values {
+		index, ok := fields[column]
+
+		if !ok || column == "id" {
+			return fmt.Errorf("invalid dump: %s has no %s column", table.name, column)
+		}
+
+		if string(data) == "null" {
+			continue
+		}
+
+		value := reflect.New(entityType.Field(index).Type)
+
+		if err := json.Unmarshal(data, value.Interface()); err != nil {
+			return fmt.Errorf("invalid dump: %s %d %s: %w", table.name, row.ID, column, err)
+		}
+
+		// the nillable fields are set with their values
+		if value = value.Elem(); value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				continue
+			}
+
+			value = value.Elem()
+		}
+
+		// ent reads the null columns as zero values, they are kept null so the unique indexes don't match them
+		if value.IsZero() && nullColumns[column] {
+			continue
+		}
+
+		values[column] = value.Interface()
+	}
+
+	pending := &backupPending{table: table, id: row.ID, refs: map[*backupRef]int{}, updatedAt: values["updated_at"]}
+
+	for _, ref := range table.refs {
+		value, ok := values[ref.column]
+
+		if !ok {
+			continue
+		}
+
+		if ref.list {
+			ids := []int{}
+
+			for _, id := range value.([]int) {
+				if newID, ok := r.ids[ref.table][id]; ok {
+					ids = append(ids, newID)
+				}
+			}
+
+			values[ref.column] = ids
+			continue
+		}
+
+		id := value.(int)
+		refTable := ref.table
+		delete(values, ref.column)
+
+		if ref.typeColumn != "" {
+			targetType, _ := values[ref.typeColumn].(string)
+			refTable = backupTargetTables[targetType]
+		}
+
+		if id == 0 {
+			continue
+		}
+
+		if ref.deferred {
+			pending.refs[ref] = id
+			continue
+		}
+
+		newID, ok := r.ids[refTable][id]
+
+		switch {
+		case ok:
+			values[ref.column] = newID
+		case table.skipOrphans:
+			result.Skipped++
+			return nil
+		case !ref.weak:
+			return fmt.Errorf("integrity check failed: %s %d references %s %d that isn't in the backup", table.name, row.ID, refTable, id)
+		}
+	}
+
+	edges := map[*backupEdge][]int{}
+
+	for _, edge := range table.edges {
+		for _, id := range row.Edges[edge.name] {
+			newID, ok := r.ids[edge.table][id]
+
+			if !ok {
+				return fmt.Errorf("integrity check failed: %s %d references %s %d that isn't in the backup", table.name, row.ID, edge.table, id)
+			}
+
+			edges[edge] = append(edges[edge], newID)
+		}
+	}
+
+	id, err := table.create(ctx, r.client, func(m ent.Mutation) error {
+		for column, value := range values {
+			if err := m.SetField(column, value); err != nil {
+				return err
+			}
+		}
+
+		for edge, ids := range edges {
+			edge.add(m, ids)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s %d: %w", table.name, row.ID, err)
+	}
+
+	r.ids[table.name][row.ID] = id
+
+	if id != row.ID {
+		result.Remapped++
+	}
+
+	if len(pending.refs) > 0 {
+		r.pending = append(r.pending, pending)
+	}
+
+	return nil
+}
+
+// deferred sets the deferred references once all the rows are restored, the update time of the rows is kept
+func (r *backupRestore) deferred(ctx context.Context) error {
+	for _, pending := range r.pending {
+		values := map[string]interface{}{}
+
+		for ref, id := range pending.refs {
+			newID, ok := r.ids[ref.table][id]
+
+			if !ok && !ref.weak {
+				return fmt.Errorf("integrity check failed: %s %d references %s %d that isn't in the backup", pending.table.name, pending.id, ref.table, id)
+			}
+
+			if ok {
+				values[ref.column] = newID
+			}
+		}
+
+		if len(values) == 0 {
+			continue
+		}
+
+		if pending.updatedAt != nil {
+			values["updated_at"] = pending.updatedAt
+		}
+
+		err := pending.table.update(ctx, r.client, r.ids[pending.table.name][pending.id], func(m ent.Mutation) error {
+			for column, value := range values {
+				if err := m.SetField(column, value); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return fmt.Errorf("%s %d: %w", pending.table.name, pending.id, err)
+		}
+	}
+
+	return nil
+}
+
+func backupEntities[E any](entities []*E, err error) ([]interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]interface{}, 0, len(entities))
+
+	for _, entity := range entities {
+		result = append(result, entity)
+	}
+
+	return result, nil
+}
+
+func backupIDs[E any](entities []*E) []int {
+	ids := make([]int, 0, len(entities))
+
+	for _, entity := range entities {
+		ids = append(ids, int(reflect.ValueOf(entity).Elem().FieldByName("ID").Int()))
+	}
+
+	return ids
+}
+
+// backupSave applies the setter to the mutation of a create or an update builder and saves it
+func backupSave[E any](ctx context.Context, m ent.Mutation, save func(ctx context.Context) (*E, error), set backupSetter) (int, error) {
+	if err := set(m); err != nil {
+		return 0, err
+	}
+
+	entity, err := save(ctx)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return int(reflect.ValueOf(entity).Elem().FieldByName("ID").Int()), nil
+}
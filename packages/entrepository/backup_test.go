@@ -0,0 +1,173 @@
+package entrepository_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/fs"
+	"github.com/ngocphuongnb/tetua/packages/entrepository"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/comment"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/customfieldvalue"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menuitem"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/page"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/post"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/topic"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestBackupRepository(t *testing.T, name string) *entrepository.BackupRepository {
+	migrator := newTestMigrator(t, name)
+	_, err := migrator.Up(context.Background(), 0)
+	assert.NoError(t, err)
+
+	return entrepository.CreateBackupRepository(migrator.Client, migrator)
+}
+
+func TestBackupDumpRestore(t *testing.T) {
+	ctx := context.Background()
+	source := newTestBackupRepository(t, "backup_source_test")
+	client := source.Client
+	deletedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// the deleted rows shift the ids of the restored rows
+	client.Topic.Create().SetName("Deleted").SetSlug("deleted").SetContent("-").SetContentHTML("-").ExecX(ctx)
+	client.Topic.DeleteOneID(1).ExecX(ctx)
+	client.Post.Create().SetName("Deleted").SetSlug("deleted").SetContent("-").SetContentHTML("-").ExecX(ctx)
+	client.Post.DeleteOneID(1).ExecX(ctx)
+
+	role := client.Role.Create().SetName("Writer").SetUploadMimeGroups([]string{"image"}).SaveX(ctx)
+	author := client.User.Create().SetUsername("author").SetPassword("hash").AddRoleIDs(role.ID).SaveX(ctx)
+	avatar := client.File.Create().SetDisk("local_public").SetPath("avatar.jpg").SetType("image/jpeg").SetSize(10).SetUserID(author.ID).
+		SetVariants([]*fs.ImageVariant{{Name: "thumb", Path: "avatar-thumb.jpg", Size: 5}}).SaveX(ctx)
+	author = author.Update().SetAvatarImageID(avatar.ID).SaveX(ctx)
+	invite := client.Invite.Create().SetCode("code").SetUserID(author.ID).SetRoleID(role.ID).SaveX(ctx)
+	invited := client.User.Create().SetUsername("invited").SetActive(false).SetInviteID(invite.ID).SaveX(ctx)
+	client.Passkey.Create().SetUserID(author.ID).SetName("Key").SetCredentialID("credential").SetPublicKey([]byte{1, 2, 3}).SetSignCount(7).ExecX(ctx)
+	parentTopic := client.Topic.Create().SetName("Go").SetSlug("go").SetContent("Go").SetContentHTML("Go").SaveX(ctx)
+	childTopic := client.Topic.Create().SetName("Ent").SetSlug("ent").SetContent("Ent").SetContentHTML("Ent").SetParentID(parentTopic.ID).SaveX(ctx)
+	client.Permission.Create().SetRoleID(role.ID).SetAction("post.create").SetValue("own").SetTopicIds([]int{childTopic.ID, 100}).ExecX(ctx)
+	hello := client.Post.Create().SetName("Hello").SetSlug("hello").SetContent("Hello").SetContentHTML("Hello").SetApproved(true).
+		SetUserID(author.ID).SetFeaturedImageID(avatar.ID).AddTopicIDs(parentTopic.ID, childTopic.ID).SaveX(ctx)
+	reply := client.Comment.Create().SetContent("Hi").SetContentHTML("Hi").SetPostID(hello.ID).SetUserID(invited.ID).SaveX(ctx)
+	client.Comment.Create().SetContent("Re").SetContentHTML("Re").SetPostID(hello.ID).SetParentID(reply.ID).SetDeletedAt(deletedAt).ExecX(ctx)
+	about := client.Page.Create().SetName("About").SetSlug("about").SetContent("About").SetContentHTML("About").SaveX(ctx)
+	client.Page.Create().SetName("Team").SetSlug("team").SetContent("Team").SetContentHTML("Team").SetParentID(about.ID).ExecX(ctx)
+	mainMenu := client.Menu.Create().SetName("Main").SetLocation("header").SaveX(ctx)
+	postItem := client.MenuItem.Create().SetMenuID(mainMenu.ID).SetLabel("Hello").SetType(entities.MENU_ITEM_POST).SetTargetID(hello.ID).SaveX(ctx)
+	client.MenuItem.Create().SetMenuID(mainMenu.ID).SetParentID(postItem.ID).SetLabel("Home").SetType(entities.MENU_ITEM_CUSTOM).SetURL("/").ExecX(ctx)
+	client.Setting.Create().SetName("app_name").SetValue("Tetua").ExecX(ctx)
+	client.CustomField.Create().SetName("subtitle").SetLabel("Subtitle").SetType("text").SetTarget("post").ExecX(ctx)
+	client.CustomFieldValue.Create().SetTargetType("post").SetTargetID(hello.ID).SetName("subtitle").SetValue("World").ExecX(ctx)
+	client.CustomFieldValue.Create().SetTargetType("post").SetTargetID(100).SetName("subtitle").SetValue("Orphan").ExecX(ctx)
+	client.AuditLog.Create().SetAction("post.approve").SetTargetType("post").SetTargetID(hello.ID).SetUserID(author.ID).ExecX(ctx)
+	client.AuditLog.Create().SetAction("post.delete").SetTargetType("post").SetTargetID(1).ExecX(ctx)
+
+	dump := &bytes.Buffer{}
+	tables, err := source.Dump(ctx, dump)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, tables["users"].Rows)
+	assert.Equal(t, 1, tables["posts"].Rows)
+	assert.Equal(t, 2, tables["custom_field_values"].Rows)
+	assert.Equal(t, 2, tables["audit_logs"].Rows)
+
+	target := newTestBackupRepository(t, "backup_target_test")
+	tables, err = target.Restore(ctx, bytes.NewReader(dump.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, &entities.BackupTable{Rows: 1, Remapped: 1}, tables["posts"])
+	assert.Equal(t, &entities.BackupTable{Rows: 2, Remapped: 2}, tables["topics"])
+	assert.Equal(t, &entities.BackupTable{Rows: 2, Skipped: 1}, tables["custom_field_values"])
+	assert.Equal(t, &entities.BackupTable{Rows: 2}, tables["users"])
+
+	restored := target.Client
+	restoredAuthor := restored.User.Query().Where(user.Username("author")).WithRoles().OnlyX(ctx)
+	assert.Equal(t, "hash", restoredAuthor.Password)
+	assert.Equal(t, author.UpdatedAt.Unix(), restoredAuthor.UpdatedAt.Unix())
+	assert.Equal(t, "Writer", restoredAuthor.Edges.Roles[0].Name)
+	restoredAvatar := restored.File.GetX(ctx, restoredAuthor.AvatarImageID)
+	assert.Equal(t, "avatar.jpg", restoredAvatar.Path)
+	assert.Equal(t, "avatar-thumb.jpg", restoredAvatar.Variants[0].Path)
+	restoredInvited := restored.User.Query().Where(user.Username("invited")).OnlyX(ctx)
+	assert.False(t, restoredInvited.Active)
+	assert.Equal(t, restoredAuthor.ID, restored.Invite.GetX(ctx, restoredInvited.InviteID).UserID)
+	assert.Equal(t, []byte{1, 2, 3}, restored.Passkey.Query().OnlyX(ctx).PublicKey)
+	assert.Equal(t, uint32(7), restored.Passkey.Query().OnlyX(ctx).SignCount)
+
+	restoredChild := restored.Topic.Query().Where(topic.Slug("ent")).OnlyX(ctx)
+	restoredParent := restored.Topic.GetX(ctx, restoredChild.ParentID)
+	assert.Equal(t, "go", restoredParent.Slug)
+	assert.Equal(t, []int{restoredChild.ID}, restored.Permission.Query().OnlyX(ctx).TopicIds)
+
+	restoredPost := restored.Post.Query().WithTopics().OnlyX(ctx)
+	assert.Equal(t, 1, restoredPost.ID)
+	assert.True(t, restoredPost.Approved)
+	assert.Equal(t, restoredAuthor.ID, restoredPost.UserID)
+	assert.Equal(t, restoredAvatar.ID, restoredPost.FeaturedImageID)
+	assert.Equal(t, 2, len(restoredPost.Edges.Topics))
+
+	restoredReply := restored.Comment.Query().Where(comment.Content("Re")).OnlyX(ctx)
+	assert.Equal(t, "Hi", restored.Comment.GetX(ctx, restoredReply.ParentID).Content)
+	assert.Equal(t, deletedAt.Unix(), restoredReply.DeletedAt.Unix())
+	assert.Equal(t, 1, restored.Comment.Query().Where(comment.DeletedAtIsNil()).CountX(ctx))
+	assert.Equal(t, "about", restored.Page.GetX(ctx, restored.Page.Query().Where(page.Slug("team")).OnlyX(ctx).ParentID).Slug)
+
+	restoredItems := restored.MenuItem.Query().Order(ent.Asc(menuitem.FieldID)).AllX(ctx)
+	assert.Equal(t, restoredPost.ID, restoredItems[0].TargetID)
+	assert.Equal(t, restoredItems[0].ID, restoredItems[1].ParentID)
+	assert.Equal(t, "World", restored.CustomFieldValue.Query().Where(customfieldvalue.TargetID(restoredPost.ID)).OnlyX(ctx).Value)
+
+	logs := restored.AuditLog.Query().AllX(ctx)
+	assert.Equal(t, restoredPost.ID, logs[0].TargetID)
+	assert.Equal(t, restoredAuthor.ID, logs[0].UserID)
+	assert.Equal(t, 0, logs[1].TargetID)
+	assert.Equal(t, 1, restored.Post.Query().Where(post.DeletedAtIsNil()).CountX(ctx))
+
+	_, err = target.Restore(ctx, bytes.NewReader(dump.Bytes()))
+	assert.EqualError(t, err, "the roles table isn't empty, a backup can only be restored to an empty database")
+}
+
+func TestBackupRestoreIntegrity(t *testing.T) {
+	ctx := context.Background()
+	target := newTestBackupRepository(t, "backup_integrity_test")
+	restore := func(lines ...string) error {
+		_, err := target.Restore(ctx, strings.NewReader(strings.Join(lines, "\n")))
+		return err
+	}
+
+	assert.EqualError(t, restore(
+		`{"table":"roles","id":1,"values":{"name":"Writer"}}`,
+		`{"table":"invites","id":1,"values":{"code":"code","user_id":5}}`,
+	), "integrity check failed: invites 1 references users 5 that isn't in the backup")
+	assert.Equal(t, 0, target.Client.Role.Query().CountX(ctx))
+
+	assert.EqualError(t, restore(
+		`{"table":"users","id":1,"values":{"username":"author","avatar_image_id":3}}`,
+	), "integrity check failed: users 1 references files 3 that isn't in the backup")
+
+	assert.EqualError(t, restore(
+		`{"table":"users","id":1,"values":{"username":"author"}}`,
+		`{"table":"roles","id":1,"values":{"name":"Writer"}}`,
+	), "invalid dump: unknown table roles or the tables are out of order")
+
+	assert.EqualError(t, restore(
+		`{"table":"roles","id":1,"values":{"title":"Writer"}}`,
+	), "invalid dump: roles has no title column")
+
+	assert.EqualError(t, restore(
+		`{"table":"roles","id":1,"values":{"name":"Writer"}}`,
+		`{"table":"roles","id":1,"values":{"name":"Editor"}}`,
+	), "invalid dump: roles 1 is duplicated or has an invalid id")
+
+	assert.NoError(t, restore(
+		`{"table":"roles","id":4,"values":{"name":"Writer","created_at":"2026-01-02T03:04:05Z","deleted_at":null}}`,
+	))
+	role := target.Client.Role.Query().OnlyX(ctx)
+	assert.Equal(t, 1, role.ID)
+	assert.Equal(t, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC).Unix(), role.CreatedAt.Unix())
+	assert.True(t, role.DeletedAt.IsZero())
+}
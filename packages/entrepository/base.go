@@ -6,12 +6,13 @@ import (
 	"sync"
 
 	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/repositories"
 	"github.com/ngocphuongnb/tetua/app/utils"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent"
 )
 
 type EntityType interface {
-	ent.Comment | ent.File | ent.Permission | ent.Post | ent.Page | ent.Role | ent.Setting | ent.Topic | ent.User
+	ent.AuditLog | ent.Comment | ent.CustomField | ent.File | ent.Invite | ent.Menu | ent.Passkey | ent.Permission | ent.Post | ent.Page | ent.Role | ent.Setting | ent.Topic | ent.User
 }
 
 type QueryFilter interface {
@@ -25,7 +26,7 @@ type QueryFilter interface {
 }
 
 type EntityQuery[EE EntityType] interface {
-	*ent.CommentQuery | *ent.FileQuery | *ent.PermissionQuery | *ent.PostQuery | *ent.PageQuery | *ent.RoleQuery | *ent.SettingQuery | *ent.TopicQuery | *ent.UserQuery
+	*ent.AuditLogQuery | *ent.CommentQuery | *ent.CustomFieldQuery | *ent.FileQuery | *ent.InviteQuery | *ent.MenuQuery | *ent.PasskeyQuery | *ent.PermissionQuery | *ent.PostQuery | *ent.PageQuery | *ent.RoleQuery | *ent.SettingQuery | *ent.TopicQuery | *ent.UserQuery
 	Count(context.Context) (int, error)
 	All(context.Context) ([]*EE, error)
 }
@@ -40,6 +41,45 @@ type BaseRepository[E entities.Entity, EE EntityType, EQ EntityQuery[EE], QF Que
 	UpdateFn      func(ctx context.Context, client *ent.Client, data *E) (*EE, error)
 	FindFn        func(ctx context.Context, query EQ, filters ...QF) ([]*EE, error)
 	QueryFilterFn func(client *ent.Client, filters ...QF) EQ
+	// LoadFn and SaveFn are optional, they load and save the data stored outside of the entity table
+	LoadFn func(ctx context.Context, client *ent.Client, items []*E) error
+	SaveFn func(ctx context.Context, client *ent.Client, data *E, saved *E) error
+}
+
+func (b *BaseRepository[E, EE, EQ, QF]) load(ctx context.Context, items []*E) ([]*E, error) {
+	if b.LoadFn == nil || len(items) == 0 {
+		return items, nil
+	}
+
+	if err := b.LoadFn(ctx, b.Client, items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (b *BaseRepository[E, EE, EQ, QF]) loadOne(ctx context.Context, item *E) (*E, error) {
+	items, err := b.load(ctx, []*E{item})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return items[0], nil
+}
+
+func (b *BaseRepository[E, EE, EQ, QF]) save(ctx context.Context, data *E, entity *EE) (*E, error) {
+	saved := b.ConvertFn(entity)
+
+	if b.SaveFn != nil {
+		if err := b.SaveFn(ctx, b.Client, data, saved); err != nil {
+			return nil, err
+		}
+	}
+
+	repositories.Changed(ctx, b.Name)
+
+	return saved, nil
 }
 
 func (b *BaseRepository[E, EE, EQ, QF]) ByID(ctx context.Context, id int) (*E, error) {
@@ -49,11 +89,17 @@ func (b *BaseRepository[E, EE, EQ, QF]) ByID(ctx context.Context, id int) (*E, e
 		return nil, EntError(err, fmt.Sprintf("%s not found with id: %d", b.Name, id))
 	}
 
-	return b.ConvertFn(entity), nil
+	return b.loadOne(ctx, b.ConvertFn(entity))
 }
 
 func (b *BaseRepository[E, EE, EQ, QF]) DeleteByID(ctx context.Context, id int) error {
-	return b.DeleteByIDFn(ctx, b.Client, id)
+	if err := b.DeleteByIDFn(ctx, b.Client, id); err != nil {
+		return err
+	}
+
+	repositories.Changed(ctx, b.Name)
+
+	return nil
 }
 
 func (b *BaseRepository[E, EE, EQ, QF]) Create(ctx context.Context, data *E) (*E, error) {
@@ -62,7 +108,7 @@ func (b *BaseRepository[E, EE, EQ, QF]) Create(ctx context.Context, data *E) (*E
 		return nil, err
 	}
 
-	return b.ConvertFn(entity), nil
+	return b.save(ctx, data, entity)
 }
 
 func (b *BaseRepository[E, EE, EQ, QF]) Update(ctx context.Context, data *E) (*E, error) {
@@ -71,7 +117,7 @@ func (b *BaseRepository[E, EE, EQ, QF]) Update(ctx context.Context, data *E) (*E
 		return nil, err
 	}
 
-	return b.ConvertFn(entity), nil
+	return b.save(ctx, data, entity)
 }
 
 func (b *BaseRepository[E, EE, EQ, QF]) Count(ctx context.Context, filters ...QF) (int, error) {
@@ -105,7 +151,7 @@ func (b *BaseRepository[E, EE, EQ, QF]) All(ctx context.Context) ([]*E, error) {
 	if items, err := query.All(ctx); err != nil {
 		return nil, err
 	} else {
-		return utils.SliceMap(items, b.ConvertFn), nil
+		return b.load(ctx, utils.SliceMap(items, b.ConvertFn))
 	}
 }
 
@@ -114,7 +160,7 @@ func (b *BaseRepository[E, EE, EQ, QF]) Find(ctx context.Context, filters ...QF)
 	if items, err := b.FindFn(ctx, query, filters...); err != nil {
 		return nil, err
 	} else {
-		return utils.SliceMap(items, b.ConvertFn), nil
+		return b.load(ctx, utils.SliceMap(items, b.ConvertFn))
 	}
 }
 
@@ -146,8 +192,14 @@ func (b *BaseRepository[E, EE, EQ, QF]) Paginate(ctx context.Context, filters ..
 		base = filters[0].Base()
 	}
 
+	data, err := b.load(ctx, utils.SliceMap(items, b.ConvertFn))
+
+	if err != nil {
+		return nil, err
+	}
+
 	return &entities.Paginate[E]{
-		Data:        utils.SliceMap(items, b.ConvertFn),
+		Data:        data,
 		BaseUrl:     base,
 		Total:       total,
 		PageSize:    limit,
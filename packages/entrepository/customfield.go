@@ -0,0 +1,251 @@
+package entrepository
+
+import (
+	"context"
+	"errors"
+
+	e "github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/customfield"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/customfieldvalue"
+)
+
+type CustomFieldRepository struct {
+	*BaseRepository[e.CustomField, ent.CustomField, *ent.CustomFieldQuery, *e.CustomFieldFilter]
+}
+
+// loadCustomFields sets the custom field values of the entities of the target type,
+// fields returns the id of an entity and the map that holds its values
+func loadCustomFields[E any](
+	ctx context.Context,
+	client *ent.Client,
+	target string,
+	items []*E,
+	fields func(item *E) (int, *map[string]string),
+) error {
+	ids := []int{}
+
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+
+		id, _ := fields(item)
+		ids = append(ids, id)
+	}
+
+	values, err := client.CustomFieldValue.Query().
+		Where(customfieldvalue.TargetTypeEQ(target)).
+		Where(customfieldvalue.TargetIDIn(ids...)).
+		All(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+
+		id, itemValues := fields(item)
+		*itemValues = map[string]string{}
+
+		for _, value := range values {
+			if value.TargetID == id {
+				(*itemValues)[value.Name] = value.Value
+			}
+		}
+	}
+
+	return nil
+}
+
+// saveCustomFields stores the custom field values of an entity, empty values are removed.
+// Nothing is changed when values is nil so saving an entity without its custom fields keeps them.
+func saveCustomFields(ctx context.Context, client *ent.Client, target string, id int, values map[string]string) error {
+	if values == nil {
+		return nil
+	}
+
+	// the values are replaced in a transaction so that a failed save keeps the previous values
+	tx, err := client.Tx(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+	names := []string{}
+	creates := []*ent.CustomFieldValueCreate{}
+
+	for name, value := range values {
+		names = append(names, name)
+
+		if value != "" {
+			creates = append(creates, tx.CustomFieldValue.Create().
+				SetTargetType(target).
+				SetTargetID(id).
+				SetName(name).
+				SetValue(value))
+		}
+	}
+
+	if _, err := tx.CustomFieldValue.Delete().
+		Where(customfieldvalue.TargetTypeEQ(target)).
+		Where(customfieldvalue.TargetIDEQ(id)).
+		Where(customfieldvalue.NameIn(names...)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	if len(creates) > 0 {
+		if err := tx.CustomFieldValue.CreateBulk(creates...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// customFieldsHooks returns the LoadFn and SaveFn of the repositories whose entities have custom fields
+func customFieldsHooks[E any](target string, fields func(item *E) (int, *map[string]string)) (
+	func(ctx context.Context, client *ent.Client, items []*E) error,
+	func(ctx context.Context, client *ent.Client, data *E, saved *E) error,
+) {
+	loadFn := func(ctx context.Context, client *ent.Client, items []*E) error {
+		return loadCustomFields(ctx, client, target, items, fields)
+	}
+
+	saveFn := func(ctx context.Context, client *ent.Client, data *E, saved *E) error {
+		id, _ := fields(saved)
+		_, values := fields(data)
+
+		if err := saveCustomFields(ctx, client, target, id, *values); err != nil {
+			return err
+		}
+
+		return loadFn(ctx, client, []*E{saved})
+	}
+
+	return loadFn, saveFn
+}
+
+// deleteCustomFields removes the custom field values of a deleted entity
+func deleteCustomFields(ctx context.Context, client *ent.Client, target string, id int) error {
+	_, err := client.CustomFieldValue.Delete().
+		Where(customfieldvalue.TargetTypeEQ(target)).
+		Where(customfieldvalue.TargetIDEQ(id)).
+		Exec(ctx)
+
+	return err
+}
+
+func CreateCustomFieldRepository(client *ent.Client) *CustomFieldRepository {
+	return &CustomFieldRepository{
+		BaseRepository: &BaseRepository[e.CustomField, ent.CustomField, *ent.CustomFieldQuery, *e.CustomFieldFilter]{
+			Name:      "custom_field",
+			Client:    client,
+			ConvertFn: entCustomFieldToCustomField,
+			ByIDFn: func(ctx context.Context, client *ent.Client, id int) (*ent.CustomField, error) {
+				return client.CustomField.Query().Where(customfield.IDEQ(id)).Only(ctx)
+			},
+			DeleteByIDFn: func(ctx context.Context, client *ent.Client, id int) error {
+				field, err := client.CustomField.Get(ctx, id)
+
+				if err != nil {
+					return err
+				}
+
+				if _, err := client.CustomFieldValue.Delete().
+					Where(customfieldvalue.TargetTypeEQ(field.Target)).
+					Where(customfieldvalue.NameEQ(field.Name)).
+					Exec(ctx); err != nil {
+					return err
+				}
+
+				return client.CustomField.DeleteOneID(id).Exec(ctx)
+			},
+			CreateFn: func(ctx context.Context, client *ent.Client, data *e.CustomField) (*ent.CustomField, error) {
+				return client.CustomField.Create().
+					SetName(data.Name).
+					SetLabel(data.Label).
+					SetDescription(data.Description).
+					SetType(data.Type).
+					SetTarget(data.Target).
+					SetRequired(data.Required).
+					SetMaxLength(data.MaxLength).
+					SetPattern(data.Pattern).
+					SetOptions(data.Options).
+					SetSortOrder(data.Order).
+					Save(ctx)
+			},
+			UpdateFn: func(ctx context.Context, client *ent.Client, data *e.CustomField) (*ent.CustomField, error) {
+				if data.ID == 0 {
+					return nil, errors.New("custom field id is required in order to update")
+				}
+
+				field, err := client.CustomField.Get(ctx, data.ID)
+
+				if err != nil {
+					return nil, err
+				}
+
+				// the values are keyed by the field target and name, they follow the renamed field
+				if field.Name != data.Name || field.Target != data.Target {
+					if _, err := client.CustomFieldValue.Update().
+						Where(customfieldvalue.TargetTypeEQ(field.Target)).
+						Where(customfieldvalue.NameEQ(field.Name)).
+						SetTargetType(data.Target).
+						SetName(data.Name).
+						Save(ctx); err != nil {
+						return nil, err
+					}
+				}
+
+				return client.CustomField.UpdateOneID(data.ID).
+					SetName(data.Name).
+					SetLabel(data.Label).
+					SetDescription(data.Description).
+					SetType(data.Type).
+					SetTarget(data.Target).
+					SetRequired(data.Required).
+					SetMaxLength(data.MaxLength).
+					SetPattern(data.Pattern).
+					SetOptions(data.Options).
+					SetSortOrder(data.Order).
+					Save(ctx)
+			},
+			QueryFilterFn: func(client *ent.Client, filters ...*e.CustomFieldFilter) *ent.CustomFieldQuery {
+				query := client.CustomField.Query()
+
+				if len(filters) > 0 {
+					if filters[0].Search != "" {
+						query = query.Where(customfield.Or(
+							customfield.NameContainsFold(filters[0].Search),
+							customfield.LabelContainsFold(filters[0].Search),
+						))
+					}
+
+					if len(filters[0].Targets) > 0 {
+						query = query.Where(customfield.TargetIn(filters[0].Targets...))
+					}
+
+					if len(filters[0].ExcludeIDs) > 0 {
+						query = query.Where(customfield.IDNotIn(filters[0].ExcludeIDs...))
+					}
+				}
+
+				return query
+			},
+			FindFn: func(ctx context.Context, query *ent.CustomFieldQuery, filters ...*e.CustomFieldFilter) ([]*ent.CustomField, error) {
+				page, limit, sorts := getPaginateParams(filters...)
+				return query.
+					Limit(limit).
+					Offset((page - 1) * limit).
+					Order(sorts...).
+					All(ctx)
+			},
+		},
+	}
+}
@@ -0,0 +1,955 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/auditlog"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/predicate"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/user"
+)
+
+// AuditLogUpdate is the builder for updating AuditLog entities.
+type AuditLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (alu *AuditLogUpdate) Where(ps ...predicate.AuditLog) *AuditLogUpdate {
+	alu.mutation.Where(ps...)
+	return alu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (alu *AuditLogUpdate) SetUpdatedAt(t time.Time) *AuditLogUpdate {
+	alu.mutation.SetUpdatedAt(t)
+	return alu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (alu *AuditLogUpdate) SetDeletedAt(t time.Time) *AuditLogUpdate {
+	alu.mutation.SetDeletedAt(t)
+	return alu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableDeletedAt(t *time.Time) *AuditLogUpdate {
+	if t != nil {
+		alu.SetDeletedAt(*t)
+	}
+	return alu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (alu *AuditLogUpdate) ClearDeletedAt() *AuditLogUpdate {
+	alu.mutation.ClearDeletedAt()
+	return alu
+}
+
+// SetUserID sets the "user_id" field.
+func (alu *AuditLogUpdate) SetUserID(i int) *AuditLogUpdate {
+	alu.mutation.SetUserID(i)
+	return alu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableUserID(i *int) *AuditLogUpdate {
+	if i != nil {
+		alu.SetUserID(*i)
+	}
+	return alu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (alu *AuditLogUpdate) ClearUserID() *AuditLogUpdate {
+	alu.mutation.ClearUserID()
+	return alu
+}
+
+// SetAction sets the "action" field.
+func (alu *AuditLogUpdate) SetAction(s string) *AuditLogUpdate {
+	alu.mutation.SetAction(s)
+	return alu
+}
+
+// SetTargetType sets the "target_type" field.
+func (alu *AuditLogUpdate) SetTargetType(s string) *AuditLogUpdate {
+	alu.mutation.SetTargetType(s)
+	return alu
+}
+
+// SetTargetID sets the "target_id" field.
+func (alu *AuditLogUpdate) SetTargetID(i int) *AuditLogUpdate {
+	alu.mutation.ResetTargetID()
+	alu.mutation.SetTargetID(i)
+	return alu
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableTargetID(i *int) *AuditLogUpdate {
+	if i != nil {
+		alu.SetTargetID(*i)
+	}
+	return alu
+}
+
+// AddTargetID adds i to the "target_id" field.
+func (alu *AuditLogUpdate) AddTargetID(i int) *AuditLogUpdate {
+	alu.mutation.AddTargetID(i)
+	return alu
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (alu *AuditLogUpdate) ClearTargetID() *AuditLogUpdate {
+	alu.mutation.ClearTargetID()
+	return alu
+}
+
+// SetBefore sets the "before" field.
+func (alu *AuditLogUpdate) SetBefore(s string) *AuditLogUpdate {
+	alu.mutation.SetBefore(s)
+	return alu
+}
+
+// SetNillableBefore sets the "before" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableBefore(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetBefore(*s)
+	}
+	return alu
+}
+
+// ClearBefore clears the value of the "before" field.
+func (alu *AuditLogUpdate) ClearBefore() *AuditLogUpdate {
+	alu.mutation.ClearBefore()
+	return alu
+}
+
+// SetAfter sets the "after" field.
+func (alu *AuditLogUpdate) SetAfter(s string) *AuditLogUpdate {
+	alu.mutation.SetAfter(s)
+	return alu
+}
+
+// SetNillableAfter sets the "after" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableAfter(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetAfter(*s)
+	}
+	return alu
+}
+
+// ClearAfter clears the value of the "after" field.
+func (alu *AuditLogUpdate) ClearAfter() *AuditLogUpdate {
+	alu.mutation.ClearAfter()
+	return alu
+}
+
+// SetIP sets the "ip" field.
+func (alu *AuditLogUpdate) SetIP(s string) *AuditLogUpdate {
+	alu.mutation.SetIP(s)
+	return alu
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableIP(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetIP(*s)
+	}
+	return alu
+}
+
+// ClearIP clears the value of the "ip" field.
+func (alu *AuditLogUpdate) ClearIP() *AuditLogUpdate {
+	alu.mutation.ClearIP()
+	return alu
+}
+
+// SetRequestID sets the "request_id" field.
+func (alu *AuditLogUpdate) SetRequestID(s string) *AuditLogUpdate {
+	alu.mutation.SetRequestID(s)
+	return alu
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableRequestID(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetRequestID(*s)
+	}
+	return alu
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (alu *AuditLogUpdate) ClearRequestID() *AuditLogUpdate {
+	alu.mutation.ClearRequestID()
+	return alu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (alu *AuditLogUpdate) SetUser(u *User) *AuditLogUpdate {
+	return alu.SetUserID(u.ID)
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alu *AuditLogUpdate) Mutation() *AuditLogMutation {
+	return alu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (alu *AuditLogUpdate) ClearUser() *AuditLogUpdate {
+	alu.mutation.ClearUser()
+	return alu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (alu *AuditLogUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	alu.defaults()
+	if len(alu.hooks) == 0 {
+		if err = alu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = alu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditLogMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = alu.check(); err != nil {
+				return 0, err
+			}
+			alu.mutation = mutation
+			affected, err = alu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(alu.hooks) - 1; i >= 0; i-- {
+			if alu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = alu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, alu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alu *AuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := alu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (alu *AuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := alu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alu *AuditLogUpdate) ExecX(ctx context.Context) {
+	if err := alu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (alu *AuditLogUpdate) defaults() {
+	if _, ok := alu.mutation.UpdatedAt(); !ok {
+		v := auditlog.UpdateDefaultUpdatedAt()
+		alu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alu *AuditLogUpdate) check() error {
+	if v, ok := alu.mutation.Action(); ok {
+		if err := auditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AuditLog.action": %w`, err)}
+		}
+	}
+	if v, ok := alu.mutation.TargetType(); ok {
+		if err := auditlog.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "AuditLog.target_type": %w`, err)}
+		}
+	}
+	if v, ok := alu.mutation.IP(); ok {
+		if err := auditlog.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "AuditLog.ip": %w`, err)}
+		}
+	}
+	if v, ok := alu.mutation.RequestID(); ok {
+		if err := auditlog.RequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "request_id", err: fmt.Errorf(`ent: validator failed for field "AuditLog.request_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (alu *AuditLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   auditlog.Table,
+			Columns: auditlog.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: auditlog.FieldID,
+			},
+		},
+	}
+	if ps := alu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := alu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: auditlog.FieldUpdatedAt,
+		})
+	}
+	if value, ok := alu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: auditlog.FieldDeletedAt,
+		})
+	}
+	if alu.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: auditlog.FieldDeletedAt,
+		})
+	}
+	if value, ok := alu.mutation.Action(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldAction,
+		})
+	}
+	if value, ok := alu.mutation.TargetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldTargetType,
+		})
+	}
+	if value, ok := alu.mutation.TargetID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldTargetID,
+		})
+	}
+	if value, ok := alu.mutation.AddedTargetID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldTargetID,
+		})
+	}
+	if alu.mutation.TargetIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: auditlog.FieldTargetID,
+		})
+	}
+	if value, ok := alu.mutation.Before(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldBefore,
+		})
+	}
+	if alu.mutation.BeforeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldBefore,
+		})
+	}
+	if value, ok := alu.mutation.After(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldAfter,
+		})
+	}
+	if alu.mutation.AfterCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldAfter,
+		})
+	}
+	if value, ok := alu.mutation.IP(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldIP,
+		})
+	}
+	if alu.mutation.IPCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldIP,
+		})
+	}
+	if value, ok := alu.mutation.RequestID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldRequestID,
+		})
+	}
+	if alu.mutation.RequestIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldRequestID,
+		})
+	}
+	if alu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditlog.UserTable,
+			Columns: []string{auditlog.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := alu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditlog.UserTable,
+			Columns: []string{auditlog.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, alu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// AuditLogUpdateOne is the builder for updating a single AuditLog entity.
+type AuditLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aluo *AuditLogUpdateOne) SetUpdatedAt(t time.Time) *AuditLogUpdateOne {
+	aluo.mutation.SetUpdatedAt(t)
+	return aluo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (aluo *AuditLogUpdateOne) SetDeletedAt(t time.Time) *AuditLogUpdateOne {
+	aluo.mutation.SetDeletedAt(t)
+	return aluo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableDeletedAt(t *time.Time) *AuditLogUpdateOne {
+	if t != nil {
+		aluo.SetDeletedAt(*t)
+	}
+	return aluo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (aluo *AuditLogUpdateOne) ClearDeletedAt() *AuditLogUpdateOne {
+	aluo.mutation.ClearDeletedAt()
+	return aluo
+}
+
+// SetUserID sets the "user_id" field.
+func (aluo *AuditLogUpdateOne) SetUserID(i int) *AuditLogUpdateOne {
+	aluo.mutation.SetUserID(i)
+	return aluo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableUserID(i *int) *AuditLogUpdateOne {
+	if i != nil {
+		aluo.SetUserID(*i)
+	}
+	return aluo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (aluo *AuditLogUpdateOne) ClearUserID() *AuditLogUpdateOne {
+	aluo.mutation.ClearUserID()
+	return aluo
+}
+
+// SetAction sets the "action" field.
+func (aluo *AuditLogUpdateOne) SetAction(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetAction(s)
+	return aluo
+}
+
+// SetTargetType sets the "target_type" field.
+func (aluo *AuditLogUpdateOne) SetTargetType(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetTargetType(s)
+	return aluo
+}
+
+// SetTargetID sets the "target_id" field.
+func (aluo *AuditLogUpdateOne) SetTargetID(i int) *AuditLogUpdateOne {
+	aluo.mutation.ResetTargetID()
+	aluo.mutation.SetTargetID(i)
+	return aluo
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableTargetID(i *int) *AuditLogUpdateOne {
+	if i != nil {
+		aluo.SetTargetID(*i)
+	}
+	return aluo
+}
+
+// AddTargetID adds i to the "target_id" field.
+func (aluo *AuditLogUpdateOne) AddTargetID(i int) *AuditLogUpdateOne {
+	aluo.mutation.AddTargetID(i)
+	return aluo
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (aluo *AuditLogUpdateOne) ClearTargetID() *AuditLogUpdateOne {
+	aluo.mutation.ClearTargetID()
+	return aluo
+}
+
+// SetBefore sets the "before" field.
+func (aluo *AuditLogUpdateOne) SetBefore(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetBefore(s)
+	return aluo
+}
+
+// SetNillableBefore sets the "before" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableBefore(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetBefore(*s)
+	}
+	return aluo
+}
+
+// ClearBefore clears the value of the "before" field.
+func (aluo *AuditLogUpdateOne) ClearBefore() *AuditLogUpdateOne {
+	aluo.mutation.ClearBefore()
+	return aluo
+}
+
+// SetAfter sets the "after" field.
+func (aluo *AuditLogUpdateOne) SetAfter(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetAfter(s)
+	return aluo
+}
+
+// SetNillableAfter sets the "after" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableAfter(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetAfter(*s)
+	}
+	return aluo
+}
+
+// ClearAfter clears the value of the "after" field.
+func (aluo *AuditLogUpdateOne) ClearAfter() *AuditLogUpdateOne {
+	aluo.mutation.ClearAfter()
+	return aluo
+}
+
+// SetIP sets the "ip" field.
+func (aluo *AuditLogUpdateOne) SetIP(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetIP(s)
+	return aluo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableIP(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetIP(*s)
+	}
+	return aluo
+}
+
+// ClearIP clears the value of the "ip" field.
+func (aluo *AuditLogUpdateOne) ClearIP() *AuditLogUpdateOne {
+	aluo.mutation.ClearIP()
+	return aluo
+}
+
+// SetRequestID sets the "request_id" field.
+func (aluo *AuditLogUpdateOne) SetRequestID(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetRequestID(s)
+	return aluo
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableRequestID(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetRequestID(*s)
+	}
+	return aluo
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (aluo *AuditLogUpdateOne) ClearRequestID() *AuditLogUpdateOne {
+	aluo.mutation.ClearRequestID()
+	return aluo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (aluo *AuditLogUpdateOne) SetUser(u *User) *AuditLogUpdateOne {
+	return aluo.SetUserID(u.ID)
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (aluo *AuditLogUpdateOne) Mutation() *AuditLogMutation {
+	return aluo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (aluo *AuditLogUpdateOne) ClearUser() *AuditLogUpdateOne {
+	aluo.mutation.ClearUser()
+	return aluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aluo *AuditLogUpdateOne) Select(field string, fields ...string) *AuditLogUpdateOne {
+	aluo.fields = append([]string{field}, fields...)
+	return aluo
+}
+
+// Save executes the query and returns the updated AuditLog entity.
+func (aluo *AuditLogUpdateOne) Save(ctx context.Context) (*AuditLog, error) {
+	var (
+		err  error
+		node *AuditLog
+	)
+	aluo.defaults()
+	if len(aluo.hooks) == 0 {
+		if err = aluo.check(); err != nil {
+			return nil, err
+		}
+		node, err = aluo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditLogMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = aluo.check(); err != nil {
+				return nil, err
+			}
+			aluo.mutation = mutation
+			node, err = aluo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(aluo.hooks) - 1; i >= 0; i-- {
+			if aluo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = aluo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, aluo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) SaveX(ctx context.Context) *AuditLog {
+	node, err := aluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aluo *AuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := aluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := aluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aluo *AuditLogUpdateOne) defaults() {
+	if _, ok := aluo.mutation.UpdatedAt(); !ok {
+		v := auditlog.UpdateDefaultUpdatedAt()
+		aluo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aluo *AuditLogUpdateOne) check() error {
+	if v, ok := aluo.mutation.Action(); ok {
+		if err := auditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AuditLog.action": %w`, err)}
+		}
+	}
+	if v, ok := aluo.mutation.TargetType(); ok {
+		if err := auditlog.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "AuditLog.target_type": %w`, err)}
+		}
+	}
+	if v, ok := aluo.mutation.IP(); ok {
+		if err := auditlog.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "AuditLog.ip": %w`, err)}
+		}
+	}
+	if v, ok := aluo.mutation.RequestID(); ok {
+		if err := auditlog.RequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "request_id", err: fmt.Errorf(`ent: validator failed for field "AuditLog.request_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aluo *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   auditlog.Table,
+			Columns: auditlog.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: auditlog.FieldID,
+			},
+		},
+	}
+	id, ok := aluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlog.FieldID)
+		for _, f := range fields {
+			if !auditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aluo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: auditlog.FieldUpdatedAt,
+		})
+	}
+	if value, ok := aluo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: auditlog.FieldDeletedAt,
+		})
+	}
+	if aluo.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: auditlog.FieldDeletedAt,
+		})
+	}
+	if value, ok := aluo.mutation.Action(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldAction,
+		})
+	}
+	if value, ok := aluo.mutation.TargetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldTargetType,
+		})
+	}
+	if value, ok := aluo.mutation.TargetID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldTargetID,
+		})
+	}
+	if value, ok := aluo.mutation.AddedTargetID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldTargetID,
+		})
+	}
+	if aluo.mutation.TargetIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: auditlog.FieldTargetID,
+		})
+	}
+	if value, ok := aluo.mutation.Before(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldBefore,
+		})
+	}
+	if aluo.mutation.BeforeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldBefore,
+		})
+	}
+	if value, ok := aluo.mutation.After(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldAfter,
+		})
+	}
+	if aluo.mutation.AfterCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldAfter,
+		})
+	}
+	if value, ok := aluo.mutation.IP(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldIP,
+		})
+	}
+	if aluo.mutation.IPCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldIP,
+		})
+	}
+	if value, ok := aluo.mutation.RequestID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldRequestID,
+		})
+	}
+	if aluo.mutation.RequestIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldRequestID,
+		})
+	}
+	if aluo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditlog.UserTable,
+			Columns: []string{auditlog.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aluo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditlog.UserTable,
+			Columns: []string{auditlog.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AuditLog{config: aluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
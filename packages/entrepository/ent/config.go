@@ -24,15 +24,22 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	Comment    []ent.Hook
-	File       []ent.Hook
-	Page       []ent.Hook
-	Permission []ent.Hook
-	Post       []ent.Hook
-	Role       []ent.Hook
-	Setting    []ent.Hook
-	Topic      []ent.Hook
-	User       []ent.Hook
+	AuditLog         []ent.Hook
+	Comment          []ent.Hook
+	CustomField      []ent.Hook
+	CustomFieldValue []ent.Hook
+	File             []ent.Hook
+	Invite           []ent.Hook
+	Menu             []ent.Hook
+	MenuItem         []ent.Hook
+	Page             []ent.Hook
+	Passkey          []ent.Hook
+	Permission       []ent.Hook
+	Post             []ent.Hook
+	Role             []ent.Hook
+	Setting          []ent.Hook
+	Topic            []ent.Hook
+	User             []ent.Hook
 }
 
 // Options applies the options on the config object.
@@ -0,0 +1,1406 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/customfield"
+)
+
+// CustomFieldCreate is the builder for creating a CustomField entity.
+type CustomFieldCreate struct {
+	config
+	mutation *CustomFieldMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cfc *CustomFieldCreate) SetCreatedAt(t time.Time) *CustomFieldCreate {
+	cfc.mutation.SetCreatedAt(t)
+	return cfc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cfc *CustomFieldCreate) SetNillableCreatedAt(t *time.Time) *CustomFieldCreate {
+	if t != nil {
+		cfc.SetCreatedAt(*t)
+	}
+	return cfc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cfc *CustomFieldCreate) SetUpdatedAt(t time.Time) *CustomFieldCreate {
+	cfc.mutation.SetUpdatedAt(t)
+	return cfc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cfc *CustomFieldCreate) SetNillableUpdatedAt(t *time.Time) *CustomFieldCreate {
+	if t != nil {
+		cfc.SetUpdatedAt(*t)
+	}
+	return cfc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cfc *CustomFieldCreate) SetDeletedAt(t time.Time) *CustomFieldCreate {
+	cfc.mutation.SetDeletedAt(t)
+	return cfc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cfc *CustomFieldCreate) SetNillableDeletedAt(t *time.Time) *CustomFieldCreate {
+	if t != nil {
+		cfc.SetDeletedAt(*t)
+	}
+	return cfc
+}
+
+// SetName sets the "name" field.
+func (cfc *CustomFieldCreate) SetName(s string) *CustomFieldCreate {
+	cfc.mutation.SetName(s)
+	return cfc
+}
+
+// SetLabel sets the "label" field.
+func (cfc *CustomFieldCreate) SetLabel(s string) *CustomFieldCreate {
+	cfc.mutation.SetLabel(s)
+	return cfc
+}
+
+// SetDescription sets the "description" field.
+func (cfc *CustomFieldCreate) SetDescription(s string) *CustomFieldCreate {
+	cfc.mutation.SetDescription(s)
+	return cfc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cfc *CustomFieldCreate) SetNillableDescription(s *string) *CustomFieldCreate {
+	if s != nil {
+		cfc.SetDescription(*s)
+	}
+	return cfc
+}
+
+// SetType sets the "type" field.
+func (cfc *CustomFieldCreate) SetType(s string) *CustomFieldCreate {
+	cfc.mutation.SetType(s)
+	return cfc
+}
+
+// SetTarget sets the "target" field.
+func (cfc *CustomFieldCreate) SetTarget(s string) *CustomFieldCreate {
+	cfc.mutation.SetTarget(s)
+	return cfc
+}
+
+// SetRequired sets the "required" field.
+func (cfc *CustomFieldCreate) SetRequired(b bool) *CustomFieldCreate {
+	cfc.mutation.SetRequired(b)
+	return cfc
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (cfc *CustomFieldCreate) SetNillableRequired(b *bool) *CustomFieldCreate {
+	if b != nil {
+		cfc.SetRequired(*b)
+	}
+	return cfc
+}
+
+// SetMaxLength sets the "max_length" field.
+func (cfc *CustomFieldCreate) SetMaxLength(i int) *CustomFieldCreate {
+	cfc.mutation.SetMaxLength(i)
+	return cfc
+}
+
+// SetNillableMaxLength sets the "max_length" field if the given value is not nil.
+func (cfc *CustomFieldCreate) SetNillableMaxLength(i *int) *CustomFieldCreate {
+	if i != nil {
+		cfc.SetMaxLength(*i)
+	}
+	return cfc
+}
+
+// SetPattern sets the "pattern" field.
+func (cfc *CustomFieldCreate) SetPattern(s string) *CustomFieldCreate {
+	cfc.mutation.SetPattern(s)
+	return cfc
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (cfc *CustomFieldCreate) SetNillablePattern(s *string) *CustomFieldCreate {
+	if s != nil {
+		cfc.SetPattern(*s)
+	}
+	return cfc
+}
+
+// SetOptions sets the "options" field.
+func (cfc *CustomFieldCreate) SetOptions(s []string) *CustomFieldCreate {
+	cfc.mutation.SetOptions(s)
+	return cfc
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (cfc *CustomFieldCreate) SetSortOrder(i int) *CustomFieldCreate {
+	cfc.mutation.SetSortOrder(i)
+	return cfc
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (cfc *CustomFieldCreate) SetNillableSortOrder(i *int) *CustomFieldCreate {
+	if i != nil {
+		cfc.SetSortOrder(*i)
+	}
+	return cfc
+}
+
+// Mutation returns the CustomFieldMutation object of the builder.
+func (cfc *CustomFieldCreate) Mutation() *CustomFieldMutation {
+	return cfc.mutation
+}
+
+// Save creates the CustomField in the database.
+func (cfc *CustomFieldCreate) Save(ctx context.Context) (*CustomField, error) {
+	var (
+		err  error
+		node *CustomField
+	)
+	cfc.defaults()
+	if len(cfc.hooks) == 0 {
+		if err = cfc.check(); err != nil {
+			return nil, err
+		}
+		node, err = cfc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CustomFieldMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cfc.check(); err != nil {
+				return nil, err
+			}
+			cfc.mutation = mutation
+			if node, err = cfc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cfc.hooks) - 1; i >= 0; i-- {
+			if cfc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cfc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cfc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cfc *CustomFieldCreate) SaveX(ctx context.Context) *CustomField {
+	v, err := cfc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cfc *CustomFieldCreate) Exec(ctx context.Context) error {
+	_, err := cfc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfc *CustomFieldCreate) ExecX(ctx context.Context) {
+	if err := cfc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cfc *CustomFieldCreate) defaults() {
+	if _, ok := cfc.mutation.CreatedAt(); !ok {
+		v := customfield.DefaultCreatedAt()
+		cfc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cfc.mutation.UpdatedAt(); !ok {
+		v := customfield.DefaultUpdatedAt()
+		cfc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := cfc.mutation.Required(); !ok {
+		v := customfield.DefaultRequired
+		cfc.mutation.SetRequired(v)
+	}
+	if _, ok := cfc.mutation.MaxLength(); !ok {
+		v := customfield.DefaultMaxLength
+		cfc.mutation.SetMaxLength(v)
+	}
+	if _, ok := cfc.mutation.SortOrder(); !ok {
+		v := customfield.DefaultSortOrder
+		cfc.mutation.SetSortOrder(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cfc *CustomFieldCreate) check() error {
+	if _, ok := cfc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CustomField.created_at"`)}
+	}
+	if _, ok := cfc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CustomField.updated_at"`)}
+	}
+	if _, ok := cfc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "CustomField.name"`)}
+	}
+	if v, ok := cfc.mutation.Name(); ok {
+		if err := customfield.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CustomField.name": %w`, err)}
+		}
+	}
+	if _, ok := cfc.mutation.Label(); !ok {
+		return &ValidationError{Name: "label", err: errors.New(`ent: missing required field "CustomField.label"`)}
+	}
+	if _, ok := cfc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "CustomField.type"`)}
+	}
+	if v, ok := cfc.mutation.GetType(); ok {
+		if err := customfield.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "CustomField.type": %w`, err)}
+		}
+	}
+	if _, ok := cfc.mutation.Target(); !ok {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required field "CustomField.target"`)}
+	}
+	if v, ok := cfc.mutation.Target(); ok {
+		if err := customfield.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "CustomField.target": %w`, err)}
+		}
+	}
+	if _, ok := cfc.mutation.Required(); !ok {
+		return &ValidationError{Name: "required", err: errors.New(`ent: missing required field "CustomField.required"`)}
+	}
+	if _, ok := cfc.mutation.MaxLength(); !ok {
+		return &ValidationError{Name: "max_length", err: errors.New(`ent: missing required field "CustomField.max_length"`)}
+	}
+	if _, ok := cfc.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "CustomField.sort_order"`)}
+	}
+	return nil
+}
+
+func (cfc *CustomFieldCreate) sqlSave(ctx context.Context) (*CustomField, error) {
+	_node, _spec := cfc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cfc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (cfc *CustomFieldCreate) createSpec() (*CustomField, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CustomField{config: cfc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: customfield.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: customfield.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = cfc.conflict
+	if value, ok := cfc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customfield.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := cfc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customfield.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := cfc.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customfield.FieldDeletedAt,
+		})
+		_node.DeletedAt = value
+	}
+	if value, ok := cfc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfield.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := cfc.mutation.Label(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfield.FieldLabel,
+		})
+		_node.Label = value
+	}
+	if value, ok := cfc.mutation.Description(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfield.FieldDescription,
+		})
+		_node.Description = value
+	}
+	if value, ok := cfc.mutation.GetType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfield.FieldType,
+		})
+		_node.Type = value
+	}
+	if value, ok := cfc.mutation.Target(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfield.FieldTarget,
+		})
+		_node.Target = value
+	}
+	if value, ok := cfc.mutation.Required(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: customfield.FieldRequired,
+		})
+		_node.Required = value
+	}
+	if value, ok := cfc.mutation.MaxLength(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: customfield.FieldMaxLength,
+		})
+		_node.MaxLength = value
+	}
+	if value, ok := cfc.mutation.Pattern(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfield.FieldPattern,
+		})
+		_node.Pattern = value
+	}
+	if value, ok := cfc.mutation.Options(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: customfield.FieldOptions,
+		})
+		_node.Options = value
+	}
+	if value, ok := cfc.mutation.SortOrder(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: customfield.FieldSortOrder,
+		})
+		_node.SortOrder = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CustomField.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CustomFieldUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (cfc *CustomFieldCreate) OnConflict(opts ...sql.ConflictOption) *CustomFieldUpsertOne {
+	cfc.conflict = opts
+	return &CustomFieldUpsertOne{
+		create: cfc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CustomField.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (cfc *CustomFieldCreate) OnConflictColumns(columns ...string) *CustomFieldUpsertOne {
+	cfc.conflict = append(cfc.conflict, sql.ConflictColumns(columns...))
+	return &CustomFieldUpsertOne{
+		create: cfc,
+	}
+}
+
+type (
+	// CustomFieldUpsertOne is the builder for "upsert"-ing
+	//  one CustomField node.
+	CustomFieldUpsertOne struct {
+		create *CustomFieldCreate
+	}
+
+	// CustomFieldUpsert is the "OnConflict" setter.
+	CustomFieldUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CustomFieldUpsert) SetCreatedAt(v time.Time) *CustomFieldUpsert {
+	u.Set(customfield.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CustomFieldUpsert) UpdateCreatedAt() *CustomFieldUpsert {
+	u.SetExcluded(customfield.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CustomFieldUpsert) SetUpdatedAt(v time.Time) *CustomFieldUpsert {
+	u.Set(customfield.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CustomFieldUpsert) UpdateUpdatedAt() *CustomFieldUpsert {
+	u.SetExcluded(customfield.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CustomFieldUpsert) SetDeletedAt(v time.Time) *CustomFieldUpsert {
+	u.Set(customfield.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CustomFieldUpsert) UpdateDeletedAt() *CustomFieldUpsert {
+	u.SetExcluded(customfield.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CustomFieldUpsert) ClearDeletedAt() *CustomFieldUpsert {
+	u.SetNull(customfield.FieldDeletedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CustomFieldUpsert) SetName(v string) *CustomFieldUpsert {
+	u.Set(customfield.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CustomFieldUpsert) UpdateName() *CustomFieldUpsert {
+	u.SetExcluded(customfield.FieldName)
+	return u
+}
+
+// SetLabel sets the "label" field.
+func (u *CustomFieldUpsert) SetLabel(v string) *CustomFieldUpsert {
+	u.Set(customfield.FieldLabel, v)
+	return u
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *CustomFieldUpsert) UpdateLabel() *CustomFieldUpsert {
+	u.SetExcluded(customfield.FieldLabel)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *CustomFieldUpsert) SetDescription(v string) *CustomFieldUpsert {
+	u.Set(customfield.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CustomFieldUpsert) UpdateDescription() *CustomFieldUpsert {
+	u.SetExcluded(customfield.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CustomFieldUpsert) ClearDescription() *CustomFieldUpsert {
+	u.SetNull(customfield.FieldDescription)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *CustomFieldUpsert) SetType(v string) *CustomFieldUpsert {
+	u.Set(customfield.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *CustomFieldUpsert) UpdateType() *CustomFieldUpsert {
+	u.SetExcluded(customfield.FieldType)
+	return u
+}
+
+// SetTarget sets the "target" field.
+func (u *CustomFieldUpsert) SetTarget(v string) *CustomFieldUpsert {
+	u.Set(customfield.FieldTarget, v)
+	return u
+}
+
+// UpdateTarget sets the "target" field to the value that was provided on create.
+func (u *CustomFieldUpsert) UpdateTarget() *CustomFieldUpsert {
+	u.SetExcluded(customfield.FieldTarget)
+	return u
+}
+
+// SetRequired sets the "required" field.
+func (u *CustomFieldUpsert) SetRequired(v bool) *CustomFieldUpsert {
+	u.Set(customfield.FieldRequired, v)
+	return u
+}
+
+// UpdateRequired sets the "required" field to the value that was provided on create.
+func (u *CustomFieldUpsert) UpdateRequired() *CustomFieldUpsert {
+	u.SetExcluded(customfield.FieldRequired)
+	return u
+}
+
+// SetMaxLength sets the "max_length" field.
+func (u *CustomFieldUpsert) SetMaxLength(v int) *CustomFieldUpsert {
+	u.Set(customfield.FieldMaxLength, v)
+	return u
+}
+
+// UpdateMaxLength sets the "max_length" field to the value that was provided on create.
+func (u *CustomFieldUpsert) UpdateMaxLength() *CustomFieldUpsert {
+	u.SetExcluded(customfield.FieldMaxLength)
+	return u
+}
+
+// AddMaxLength adds v to the "max_length" field.
+func (u *CustomFieldUpsert) AddMaxLength(v int) *CustomFieldUpsert {
+	u.Add(customfield.FieldMaxLength, v)
+	return u
+}
+
+// SetPattern sets the "pattern" field.
+func (u *CustomFieldUpsert) SetPattern(v string) *CustomFieldUpsert {
+	u.Set(customfield.FieldPattern, v)
+	return u
+}
+
+// UpdatePattern sets the "pattern" field to the value that was provided on create.
+func (u *CustomFieldUpsert) UpdatePattern() *CustomFieldUpsert {
+	u.SetExcluded(customfield.FieldPattern)
+	return u
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (u *CustomFieldUpsert) ClearPattern() *CustomFieldUpsert {
+	u.SetNull(customfield.FieldPattern)
+	return u
+}
+
+// SetOptions sets the "options" field.
+func (u *CustomFieldUpsert) SetOptions(v []string) *CustomFieldUpsert {
+	u.Set(customfield.FieldOptions, v)
+	return u
+}
+
+// UpdateOptions sets the "options" field to the value that was provided on create.
+func (u *CustomFieldUpsert) UpdateOptions() *CustomFieldUpsert {
+	u.SetExcluded(customfield.FieldOptions)
+	return u
+}
+
+// ClearOptions clears the value of the "options" field.
+func (u *CustomFieldUpsert) ClearOptions() *CustomFieldUpsert {
+	u.SetNull(customfield.FieldOptions)
+	return u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *CustomFieldUpsert) SetSortOrder(v int) *CustomFieldUpsert {
+	u.Set(customfield.FieldSortOrder, v)
+	return u
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *CustomFieldUpsert) UpdateSortOrder() *CustomFieldUpsert {
+	u.SetExcluded(customfield.FieldSortOrder)
+	return u
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *CustomFieldUpsert) AddSortOrder(v int) *CustomFieldUpsert {
+	u.Add(customfield.FieldSortOrder, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.CustomField.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *CustomFieldUpsertOne) UpdateNewValues() *CustomFieldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(customfield.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//  client.CustomField.Create().
+//      OnConflict(sql.ResolveWithIgnore()).
+//      Exec(ctx)
+//
+func (u *CustomFieldUpsertOne) Ignore() *CustomFieldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CustomFieldUpsertOne) DoNothing() *CustomFieldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CustomFieldCreate.OnConflict
+// documentation for more info.
+func (u *CustomFieldUpsertOne) Update(set func(*CustomFieldUpsert)) *CustomFieldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CustomFieldUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CustomFieldUpsertOne) SetCreatedAt(v time.Time) *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CustomFieldUpsertOne) UpdateCreatedAt() *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CustomFieldUpsertOne) SetUpdatedAt(v time.Time) *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CustomFieldUpsertOne) UpdateUpdatedAt() *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CustomFieldUpsertOne) SetDeletedAt(v time.Time) *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CustomFieldUpsertOne) UpdateDeletedAt() *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CustomFieldUpsertOne) ClearDeletedAt() *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CustomFieldUpsertOne) SetName(v string) *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CustomFieldUpsertOne) UpdateName() *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetLabel sets the "label" field.
+func (u *CustomFieldUpsertOne) SetLabel(v string) *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetLabel(v)
+	})
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *CustomFieldUpsertOne) UpdateLabel() *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateLabel()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *CustomFieldUpsertOne) SetDescription(v string) *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CustomFieldUpsertOne) UpdateDescription() *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CustomFieldUpsertOne) ClearDescription() *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *CustomFieldUpsertOne) SetType(v string) *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *CustomFieldUpsertOne) UpdateType() *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetTarget sets the "target" field.
+func (u *CustomFieldUpsertOne) SetTarget(v string) *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetTarget(v)
+	})
+}
+
+// UpdateTarget sets the "target" field to the value that was provided on create.
+func (u *CustomFieldUpsertOne) UpdateTarget() *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateTarget()
+	})
+}
+
+// SetRequired sets the "required" field.
+func (u *CustomFieldUpsertOne) SetRequired(v bool) *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetRequired(v)
+	})
+}
+
+// UpdateRequired sets the "required" field to the value that was provided on create.
+func (u *CustomFieldUpsertOne) UpdateRequired() *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateRequired()
+	})
+}
+
+// SetMaxLength sets the "max_length" field.
+func (u *CustomFieldUpsertOne) SetMaxLength(v int) *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetMaxLength(v)
+	})
+}
+
+// AddMaxLength adds v to the "max_length" field.
+func (u *CustomFieldUpsertOne) AddMaxLength(v int) *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.AddMaxLength(v)
+	})
+}
+
+// UpdateMaxLength sets the "max_length" field to the value that was provided on create.
+func (u *CustomFieldUpsertOne) UpdateMaxLength() *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateMaxLength()
+	})
+}
+
+// SetPattern sets the "pattern" field.
+func (u *CustomFieldUpsertOne) SetPattern(v string) *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetPattern(v)
+	})
+}
+
+// UpdatePattern sets the "pattern" field to the value that was provided on create.
+func (u *CustomFieldUpsertOne) UpdatePattern() *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdatePattern()
+	})
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (u *CustomFieldUpsertOne) ClearPattern() *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.ClearPattern()
+	})
+}
+
+// SetOptions sets the "options" field.
+func (u *CustomFieldUpsertOne) SetOptions(v []string) *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetOptions(v)
+	})
+}
+
+// UpdateOptions sets the "options" field to the value that was provided on create.
+func (u *CustomFieldUpsertOne) UpdateOptions() *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateOptions()
+	})
+}
+
+// ClearOptions clears the value of the "options" field.
+func (u *CustomFieldUpsertOne) ClearOptions() *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.ClearOptions()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *CustomFieldUpsertOne) SetSortOrder(v int) *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *CustomFieldUpsertOne) AddSortOrder(v int) *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *CustomFieldUpsertOne) UpdateSortOrder() *CustomFieldUpsertOne {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// Exec executes the query.
+func (u *CustomFieldUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CustomFieldCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CustomFieldUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CustomFieldUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CustomFieldUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CustomFieldCreateBulk is the builder for creating many CustomField entities in bulk.
+type CustomFieldCreateBulk struct {
+	config
+	builders []*CustomFieldCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CustomField entities in the database.
+func (cfcb *CustomFieldCreateBulk) Save(ctx context.Context) ([]*CustomField, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(cfcb.builders))
+	nodes := make([]*CustomField, len(cfcb.builders))
+	mutators := make([]Mutator, len(cfcb.builders))
+	for i := range cfcb.builders {
+		func(i int, root context.Context) {
+			builder := cfcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CustomFieldMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cfcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cfcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cfcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cfcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfcb *CustomFieldCreateBulk) SaveX(ctx context.Context) []*CustomField {
+	v, err := cfcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cfcb *CustomFieldCreateBulk) Exec(ctx context.Context) error {
+	_, err := cfcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfcb *CustomFieldCreateBulk) ExecX(ctx context.Context) {
+	if err := cfcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CustomField.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CustomFieldUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (cfcb *CustomFieldCreateBulk) OnConflict(opts ...sql.ConflictOption) *CustomFieldUpsertBulk {
+	cfcb.conflict = opts
+	return &CustomFieldUpsertBulk{
+		create: cfcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CustomField.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (cfcb *CustomFieldCreateBulk) OnConflictColumns(columns ...string) *CustomFieldUpsertBulk {
+	cfcb.conflict = append(cfcb.conflict, sql.ConflictColumns(columns...))
+	return &CustomFieldUpsertBulk{
+		create: cfcb,
+	}
+}
+
+// CustomFieldUpsertBulk is the builder for "upsert"-ing
+// a bulk of CustomField nodes.
+type CustomFieldUpsertBulk struct {
+	create *CustomFieldCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CustomField.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *CustomFieldUpsertBulk) UpdateNewValues() *CustomFieldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(customfield.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CustomField.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *CustomFieldUpsertBulk) Ignore() *CustomFieldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CustomFieldUpsertBulk) DoNothing() *CustomFieldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CustomFieldCreateBulk.OnConflict
+// documentation for more info.
+func (u *CustomFieldUpsertBulk) Update(set func(*CustomFieldUpsert)) *CustomFieldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CustomFieldUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CustomFieldUpsertBulk) SetCreatedAt(v time.Time) *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CustomFieldUpsertBulk) UpdateCreatedAt() *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CustomFieldUpsertBulk) SetUpdatedAt(v time.Time) *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CustomFieldUpsertBulk) UpdateUpdatedAt() *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CustomFieldUpsertBulk) SetDeletedAt(v time.Time) *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CustomFieldUpsertBulk) UpdateDeletedAt() *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CustomFieldUpsertBulk) ClearDeletedAt() *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CustomFieldUpsertBulk) SetName(v string) *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CustomFieldUpsertBulk) UpdateName() *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetLabel sets the "label" field.
+func (u *CustomFieldUpsertBulk) SetLabel(v string) *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetLabel(v)
+	})
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *CustomFieldUpsertBulk) UpdateLabel() *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateLabel()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *CustomFieldUpsertBulk) SetDescription(v string) *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CustomFieldUpsertBulk) UpdateDescription() *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CustomFieldUpsertBulk) ClearDescription() *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *CustomFieldUpsertBulk) SetType(v string) *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *CustomFieldUpsertBulk) UpdateType() *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetTarget sets the "target" field.
+func (u *CustomFieldUpsertBulk) SetTarget(v string) *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetTarget(v)
+	})
+}
+
+// UpdateTarget sets the "target" field to the value that was provided on create.
+func (u *CustomFieldUpsertBulk) UpdateTarget() *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateTarget()
+	})
+}
+
+// SetRequired sets the "required" field.
+func (u *CustomFieldUpsertBulk) SetRequired(v bool) *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetRequired(v)
+	})
+}
+
+// UpdateRequired sets the "required" field to the value that was provided on create.
+func (u *CustomFieldUpsertBulk) UpdateRequired() *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateRequired()
+	})
+}
+
+// SetMaxLength sets the "max_length" field.
+func (u *CustomFieldUpsertBulk) SetMaxLength(v int) *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetMaxLength(v)
+	})
+}
+
+// AddMaxLength adds v to the "max_length" field.
+func (u *CustomFieldUpsertBulk) AddMaxLength(v int) *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.AddMaxLength(v)
+	})
+}
+
+// UpdateMaxLength sets the "max_length" field to the value that was provided on create.
+func (u *CustomFieldUpsertBulk) UpdateMaxLength() *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateMaxLength()
+	})
+}
+
+// SetPattern sets the "pattern" field.
+func (u *CustomFieldUpsertBulk) SetPattern(v string) *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetPattern(v)
+	})
+}
+
+// UpdatePattern sets the "pattern" field to the value that was provided on create.
+func (u *CustomFieldUpsertBulk) UpdatePattern() *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdatePattern()
+	})
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (u *CustomFieldUpsertBulk) ClearPattern() *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.ClearPattern()
+	})
+}
+
+// SetOptions sets the "options" field.
+func (u *CustomFieldUpsertBulk) SetOptions(v []string) *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetOptions(v)
+	})
+}
+
+// UpdateOptions sets the "options" field to the value that was provided on create.
+func (u *CustomFieldUpsertBulk) UpdateOptions() *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateOptions()
+	})
+}
+
+// ClearOptions clears the value of the "options" field.
+func (u *CustomFieldUpsertBulk) ClearOptions() *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.ClearOptions()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *CustomFieldUpsertBulk) SetSortOrder(v int) *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *CustomFieldUpsertBulk) AddSortOrder(v int) *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *CustomFieldUpsertBulk) UpdateSortOrder() *CustomFieldUpsertBulk {
+	return u.Update(func(s *CustomFieldUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// Exec executes the query.
+func (u *CustomFieldUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CustomFieldCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CustomFieldCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CustomFieldUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
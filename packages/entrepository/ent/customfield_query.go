@@ -0,0 +1,921 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/customfield"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/predicate"
+)
+
+// CustomFieldQuery is the builder for querying CustomField entities.
+type CustomFieldQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.CustomField
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CustomFieldQuery builder.
+func (cfq *CustomFieldQuery) Where(ps ...predicate.CustomField) *CustomFieldQuery {
+	cfq.predicates = append(cfq.predicates, ps...)
+	return cfq
+}
+
+// Limit adds a limit step to the query.
+func (cfq *CustomFieldQuery) Limit(limit int) *CustomFieldQuery {
+	cfq.limit = &limit
+	return cfq
+}
+
+// Offset adds an offset step to the query.
+func (cfq *CustomFieldQuery) Offset(offset int) *CustomFieldQuery {
+	cfq.offset = &offset
+	return cfq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cfq *CustomFieldQuery) Unique(unique bool) *CustomFieldQuery {
+	cfq.unique = &unique
+	return cfq
+}
+
+// Order adds an order step to the query.
+func (cfq *CustomFieldQuery) Order(o ...OrderFunc) *CustomFieldQuery {
+	cfq.order = append(cfq.order, o...)
+	return cfq
+}
+
+// First returns the first CustomField entity from the query.
+// Returns a *NotFoundError when no CustomField was found.
+func (cfq *CustomFieldQuery) First(ctx context.Context) (*CustomField, error) {
+	nodes, err := cfq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{customfield.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cfq *CustomFieldQuery) FirstX(ctx context.Context) *CustomField {
+	node, err := cfq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CustomField ID from the query.
+// Returns a *NotFoundError when no CustomField ID was found.
+func (cfq *CustomFieldQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cfq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{customfield.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cfq *CustomFieldQuery) FirstIDX(ctx context.Context) int {
+	id, err := cfq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CustomField entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CustomField entity is found.
+// Returns a *NotFoundError when no CustomField entities are found.
+func (cfq *CustomFieldQuery) Only(ctx context.Context) (*CustomField, error) {
+	nodes, err := cfq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{customfield.Label}
+	default:
+		return nil, &NotSingularError{customfield.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cfq *CustomFieldQuery) OnlyX(ctx context.Context) *CustomField {
+	node, err := cfq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CustomField ID in the query.
+// Returns a *NotSingularError when more than one CustomField ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cfq *CustomFieldQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cfq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{customfield.Label}
+	default:
+		err = &NotSingularError{customfield.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cfq *CustomFieldQuery) OnlyIDX(ctx context.Context) int {
+	id, err := cfq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CustomFields.
+func (cfq *CustomFieldQuery) All(ctx context.Context) ([]*CustomField, error) {
+	if err := cfq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return cfq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cfq *CustomFieldQuery) AllX(ctx context.Context) []*CustomField {
+	nodes, err := cfq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CustomField IDs.
+func (cfq *CustomFieldQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := cfq.Select(customfield.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cfq *CustomFieldQuery) IDsX(ctx context.Context) []int {
+	ids, err := cfq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cfq *CustomFieldQuery) Count(ctx context.Context) (int, error) {
+	if err := cfq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return cfq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cfq *CustomFieldQuery) CountX(ctx context.Context) int {
+	count, err := cfq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cfq *CustomFieldQuery) Exist(ctx context.Context) (bool, error) {
+	if err := cfq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return cfq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cfq *CustomFieldQuery) ExistX(ctx context.Context) bool {
+	exist, err := cfq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CustomFieldQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cfq *CustomFieldQuery) Clone() *CustomFieldQuery {
+	if cfq == nil {
+		return nil
+	}
+	return &CustomFieldQuery{
+		config:     cfq.config,
+		limit:      cfq.limit,
+		offset:     cfq.offset,
+		order:      append([]OrderFunc{}, cfq.order...),
+		predicates: append([]predicate.CustomField{}, cfq.predicates...),
+		// clone intermediate query.
+		sql:    cfq.sql.Clone(),
+		path:   cfq.path,
+		unique: cfq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CustomField.Query().
+//		GroupBy(customfield.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (cfq *CustomFieldQuery) GroupBy(field string, fields ...string) *CustomFieldGroupBy {
+	group := &CustomFieldGroupBy{config: cfq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := cfq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return cfq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"omitempty"`
+//	}
+//
+//	client.CustomField.Query().
+//		Select(customfield.FieldCreatedAt).
+//		Scan(ctx, &v)
+//
+func (cfq *CustomFieldQuery) Select(fields ...string) *CustomFieldSelect {
+	cfq.fields = append(cfq.fields, fields...)
+	return &CustomFieldSelect{CustomFieldQuery: cfq}
+}
+
+func (cfq *CustomFieldQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range cfq.fields {
+		if !customfield.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cfq.path != nil {
+		prev, err := cfq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cfq.sql = prev
+	}
+	return nil
+}
+
+func (cfq *CustomFieldQuery) sqlAll(ctx context.Context) ([]*CustomField, error) {
+	var (
+		nodes = []*CustomField{}
+		_spec = cfq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &CustomField{config: cfq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cfq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (cfq *CustomFieldQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cfq.querySpec()
+	_spec.Node.Columns = cfq.fields
+	if len(cfq.fields) > 0 {
+		_spec.Unique = cfq.unique != nil && *cfq.unique
+	}
+	return sqlgraph.CountNodes(ctx, cfq.driver, _spec)
+}
+
+func (cfq *CustomFieldQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := cfq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (cfq *CustomFieldQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   customfield.Table,
+			Columns: customfield.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: customfield.FieldID,
+			},
+		},
+		From:   cfq.sql,
+		Unique: true,
+	}
+	if unique := cfq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := cfq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, customfield.FieldID)
+		for i := range fields {
+			if fields[i] != customfield.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cfq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cfq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cfq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cfq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cfq *CustomFieldQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cfq.driver.Dialect())
+	t1 := builder.Table(customfield.Table)
+	columns := cfq.fields
+	if len(columns) == 0 {
+		columns = customfield.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cfq.sql != nil {
+		selector = cfq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cfq.unique != nil && *cfq.unique {
+		selector.Distinct()
+	}
+	for _, p := range cfq.predicates {
+		p(selector)
+	}
+	for _, p := range cfq.order {
+		p(selector)
+	}
+	if offset := cfq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cfq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CustomFieldGroupBy is the group-by builder for CustomField entities.
+type CustomFieldGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cfgb *CustomFieldGroupBy) Aggregate(fns ...AggregateFunc) *CustomFieldGroupBy {
+	cfgb.fns = append(cfgb.fns, fns...)
+	return cfgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (cfgb *CustomFieldGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := cfgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	cfgb.sql = query
+	return cfgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (cfgb *CustomFieldGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := cfgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cfgb *CustomFieldGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(cfgb.fields) > 1 {
+		return nil, errors.New("ent: CustomFieldGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := cfgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (cfgb *CustomFieldGroupBy) StringsX(ctx context.Context) []string {
+	v, err := cfgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cfgb *CustomFieldGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = cfgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customfield.Label}
+	default:
+		err = fmt.Errorf("ent: CustomFieldGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (cfgb *CustomFieldGroupBy) StringX(ctx context.Context) string {
+	v, err := cfgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cfgb *CustomFieldGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(cfgb.fields) > 1 {
+		return nil, errors.New("ent: CustomFieldGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := cfgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (cfgb *CustomFieldGroupBy) IntsX(ctx context.Context) []int {
+	v, err := cfgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cfgb *CustomFieldGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = cfgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customfield.Label}
+	default:
+		err = fmt.Errorf("ent: CustomFieldGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (cfgb *CustomFieldGroupBy) IntX(ctx context.Context) int {
+	v, err := cfgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cfgb *CustomFieldGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(cfgb.fields) > 1 {
+		return nil, errors.New("ent: CustomFieldGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := cfgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (cfgb *CustomFieldGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := cfgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cfgb *CustomFieldGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = cfgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customfield.Label}
+	default:
+		err = fmt.Errorf("ent: CustomFieldGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (cfgb *CustomFieldGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := cfgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cfgb *CustomFieldGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(cfgb.fields) > 1 {
+		return nil, errors.New("ent: CustomFieldGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := cfgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (cfgb *CustomFieldGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := cfgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cfgb *CustomFieldGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = cfgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customfield.Label}
+	default:
+		err = fmt.Errorf("ent: CustomFieldGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (cfgb *CustomFieldGroupBy) BoolX(ctx context.Context) bool {
+	v, err := cfgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (cfgb *CustomFieldGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range cfgb.fields {
+		if !customfield.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := cfgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cfgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (cfgb *CustomFieldGroupBy) sqlQuery() *sql.Selector {
+	selector := cfgb.sql.Select()
+	aggregation := make([]string, 0, len(cfgb.fns))
+	for _, fn := range cfgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(cfgb.fields)+len(cfgb.fns))
+		for _, f := range cfgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(cfgb.fields...)...)
+}
+
+// CustomFieldSelect is the builder for selecting fields of CustomField entities.
+type CustomFieldSelect struct {
+	*CustomFieldQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cfs *CustomFieldSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := cfs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	cfs.sql = cfs.CustomFieldQuery.sqlQuery(ctx)
+	return cfs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (cfs *CustomFieldSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := cfs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (cfs *CustomFieldSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(cfs.fields) > 1 {
+		return nil, errors.New("ent: CustomFieldSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := cfs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (cfs *CustomFieldSelect) StringsX(ctx context.Context) []string {
+	v, err := cfs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (cfs *CustomFieldSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = cfs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customfield.Label}
+	default:
+		err = fmt.Errorf("ent: CustomFieldSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (cfs *CustomFieldSelect) StringX(ctx context.Context) string {
+	v, err := cfs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (cfs *CustomFieldSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(cfs.fields) > 1 {
+		return nil, errors.New("ent: CustomFieldSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := cfs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (cfs *CustomFieldSelect) IntsX(ctx context.Context) []int {
+	v, err := cfs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (cfs *CustomFieldSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = cfs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customfield.Label}
+	default:
+		err = fmt.Errorf("ent: CustomFieldSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (cfs *CustomFieldSelect) IntX(ctx context.Context) int {
+	v, err := cfs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (cfs *CustomFieldSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(cfs.fields) > 1 {
+		return nil, errors.New("ent: CustomFieldSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := cfs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (cfs *CustomFieldSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := cfs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (cfs *CustomFieldSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = cfs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customfield.Label}
+	default:
+		err = fmt.Errorf("ent: CustomFieldSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (cfs *CustomFieldSelect) Float64X(ctx context.Context) float64 {
+	v, err := cfs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (cfs *CustomFieldSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(cfs.fields) > 1 {
+		return nil, errors.New("ent: CustomFieldSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := cfs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (cfs *CustomFieldSelect) BoolsX(ctx context.Context) []bool {
+	v, err := cfs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (cfs *CustomFieldSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = cfs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customfield.Label}
+	default:
+		err = fmt.Errorf("ent: CustomFieldSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (cfs *CustomFieldSelect) BoolX(ctx context.Context) bool {
+	v, err := cfs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (cfs *CustomFieldSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := cfs.sql.Query()
+	if err := cfs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
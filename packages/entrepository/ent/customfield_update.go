@@ -0,0 +1,870 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/customfield"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/predicate"
+)
+
+// CustomFieldUpdate is the builder for updating CustomField entities.
+type CustomFieldUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CustomFieldMutation
+}
+
+// Where appends a list predicates to the CustomFieldUpdate builder.
+func (cfu *CustomFieldUpdate) Where(ps ...predicate.CustomField) *CustomFieldUpdate {
+	cfu.mutation.Where(ps...)
+	return cfu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cfu *CustomFieldUpdate) SetUpdatedAt(t time.Time) *CustomFieldUpdate {
+	cfu.mutation.SetUpdatedAt(t)
+	return cfu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cfu *CustomFieldUpdate) SetDeletedAt(t time.Time) *CustomFieldUpdate {
+	cfu.mutation.SetDeletedAt(t)
+	return cfu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cfu *CustomFieldUpdate) SetNillableDeletedAt(t *time.Time) *CustomFieldUpdate {
+	if t != nil {
+		cfu.SetDeletedAt(*t)
+	}
+	return cfu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cfu *CustomFieldUpdate) ClearDeletedAt() *CustomFieldUpdate {
+	cfu.mutation.ClearDeletedAt()
+	return cfu
+}
+
+// SetName sets the "name" field.
+func (cfu *CustomFieldUpdate) SetName(s string) *CustomFieldUpdate {
+	cfu.mutation.SetName(s)
+	return cfu
+}
+
+// SetLabel sets the "label" field.
+func (cfu *CustomFieldUpdate) SetLabel(s string) *CustomFieldUpdate {
+	cfu.mutation.SetLabel(s)
+	return cfu
+}
+
+// SetDescription sets the "description" field.
+func (cfu *CustomFieldUpdate) SetDescription(s string) *CustomFieldUpdate {
+	cfu.mutation.SetDescription(s)
+	return cfu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cfu *CustomFieldUpdate) SetNillableDescription(s *string) *CustomFieldUpdate {
+	if s != nil {
+		cfu.SetDescription(*s)
+	}
+	return cfu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (cfu *CustomFieldUpdate) ClearDescription() *CustomFieldUpdate {
+	cfu.mutation.ClearDescription()
+	return cfu
+}
+
+// SetType sets the "type" field.
+func (cfu *CustomFieldUpdate) SetType(s string) *CustomFieldUpdate {
+	cfu.mutation.SetType(s)
+	return cfu
+}
+
+// SetTarget sets the "target" field.
+func (cfu *CustomFieldUpdate) SetTarget(s string) *CustomFieldUpdate {
+	cfu.mutation.SetTarget(s)
+	return cfu
+}
+
+// SetRequired sets the "required" field.
+func (cfu *CustomFieldUpdate) SetRequired(b bool) *CustomFieldUpdate {
+	cfu.mutation.SetRequired(b)
+	return cfu
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (cfu *CustomFieldUpdate) SetNillableRequired(b *bool) *CustomFieldUpdate {
+	if b != nil {
+		cfu.SetRequired(*b)
+	}
+	return cfu
+}
+
+// SetMaxLength sets the "max_length" field.
+func (cfu *CustomFieldUpdate) SetMaxLength(i int) *CustomFieldUpdate {
+	cfu.mutation.ResetMaxLength()
+	cfu.mutation.SetMaxLength(i)
+	return cfu
+}
+
+// SetNillableMaxLength sets the "max_length" field if the given value is not nil.
+func (cfu *CustomFieldUpdate) SetNillableMaxLength(i *int) *CustomFieldUpdate {
+	if i != nil {
+		cfu.SetMaxLength(*i)
+	}
+	return cfu
+}
+
+// AddMaxLength adds i to the "max_length" field.
+func (cfu *CustomFieldUpdate) AddMaxLength(i int) *CustomFieldUpdate {
+	cfu.mutation.AddMaxLength(i)
+	return cfu
+}
+
+// SetPattern sets the "pattern" field.
+func (cfu *CustomFieldUpdate) SetPattern(s string) *CustomFieldUpdate {
+	cfu.mutation.SetPattern(s)
+	return cfu
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (cfu *CustomFieldUpdate) SetNillablePattern(s *string) *CustomFieldUpdate {
+	if s != nil {
+		cfu.SetPattern(*s)
+	}
+	return cfu
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (cfu *CustomFieldUpdate) ClearPattern() *CustomFieldUpdate {
+	cfu.mutation.ClearPattern()
+	return cfu
+}
+
+// SetOptions sets the "options" field.
+func (cfu *CustomFieldUpdate) SetOptions(s []string) *CustomFieldUpdate {
+	cfu.mutation.SetOptions(s)
+	return cfu
+}
+
+// ClearOptions clears the value of the "options" field.
+func (cfu *CustomFieldUpdate) ClearOptions() *CustomFieldUpdate {
+	cfu.mutation.ClearOptions()
+	return cfu
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (cfu *CustomFieldUpdate) SetSortOrder(i int) *CustomFieldUpdate {
+	cfu.mutation.ResetSortOrder()
+	cfu.mutation.SetSortOrder(i)
+	return cfu
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (cfu *CustomFieldUpdate) SetNillableSortOrder(i *int) *CustomFieldUpdate {
+	if i != nil {
+		cfu.SetSortOrder(*i)
+	}
+	return cfu
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (cfu *CustomFieldUpdate) AddSortOrder(i int) *CustomFieldUpdate {
+	cfu.mutation.AddSortOrder(i)
+	return cfu
+}
+
+// Mutation returns the CustomFieldMutation object of the builder.
+func (cfu *CustomFieldUpdate) Mutation() *CustomFieldMutation {
+	return cfu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cfu *CustomFieldUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	cfu.defaults()
+	if len(cfu.hooks) == 0 {
+		if err = cfu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = cfu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CustomFieldMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cfu.check(); err != nil {
+				return 0, err
+			}
+			cfu.mutation = mutation
+			affected, err = cfu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cfu.hooks) - 1; i >= 0; i-- {
+			if cfu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cfu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cfu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfu *CustomFieldUpdate) SaveX(ctx context.Context) int {
+	affected, err := cfu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cfu *CustomFieldUpdate) Exec(ctx context.Context) error {
+	_, err := cfu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfu *CustomFieldUpdate) ExecX(ctx context.Context) {
+	if err := cfu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cfu *CustomFieldUpdate) defaults() {
+	if _, ok := cfu.mutation.UpdatedAt(); !ok {
+		v := customfield.UpdateDefaultUpdatedAt()
+		cfu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cfu *CustomFieldUpdate) check() error {
+	if v, ok := cfu.mutation.Name(); ok {
+		if err := customfield.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CustomField.name": %w`, err)}
+		}
+	}
+	if v, ok := cfu.mutation.GetType(); ok {
+		if err := customfield.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "CustomField.type": %w`, err)}
+		}
+	}
+	if v, ok := cfu.mutation.Target(); ok {
+		if err := customfield.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "CustomField.target": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cfu *CustomFieldUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   customfield.Table,
+			Columns: customfield.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: customfield.FieldID,
+			},
+		},
+	}
+	if ps := cfu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cfu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customfield.FieldUpdatedAt,
+		})
+	}
+	if value, ok := cfu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customfield.FieldDeletedAt,
+		})
+	}
+	if cfu.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: customfield.FieldDeletedAt,
+		})
+	}
+	if value, ok := cfu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfield.FieldName,
+		})
+	}
+	if value, ok := cfu.mutation.Label(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfield.FieldLabel,
+		})
+	}
+	if value, ok := cfu.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfield.FieldDescription,
+		})
+	}
+	if cfu.mutation.DescriptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: customfield.FieldDescription,
+		})
+	}
+	if value, ok := cfu.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfield.FieldType,
+		})
+	}
+	if value, ok := cfu.mutation.Target(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfield.FieldTarget,
+		})
+	}
+	if value, ok := cfu.mutation.Required(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: customfield.FieldRequired,
+		})
+	}
+	if value, ok := cfu.mutation.MaxLength(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: customfield.FieldMaxLength,
+		})
+	}
+	if value, ok := cfu.mutation.AddedMaxLength(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: customfield.FieldMaxLength,
+		})
+	}
+	if value, ok := cfu.mutation.Pattern(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfield.FieldPattern,
+		})
+	}
+	if cfu.mutation.PatternCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: customfield.FieldPattern,
+		})
+	}
+	if value, ok := cfu.mutation.Options(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: customfield.FieldOptions,
+		})
+	}
+	if cfu.mutation.OptionsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: customfield.FieldOptions,
+		})
+	}
+	if value, ok := cfu.mutation.SortOrder(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: customfield.FieldSortOrder,
+		})
+	}
+	if value, ok := cfu.mutation.AddedSortOrder(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: customfield.FieldSortOrder,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cfu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customfield.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// CustomFieldUpdateOne is the builder for updating a single CustomField entity.
+type CustomFieldUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CustomFieldMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cfuo *CustomFieldUpdateOne) SetUpdatedAt(t time.Time) *CustomFieldUpdateOne {
+	cfuo.mutation.SetUpdatedAt(t)
+	return cfuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cfuo *CustomFieldUpdateOne) SetDeletedAt(t time.Time) *CustomFieldUpdateOne {
+	cfuo.mutation.SetDeletedAt(t)
+	return cfuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cfuo *CustomFieldUpdateOne) SetNillableDeletedAt(t *time.Time) *CustomFieldUpdateOne {
+	if t != nil {
+		cfuo.SetDeletedAt(*t)
+	}
+	return cfuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cfuo *CustomFieldUpdateOne) ClearDeletedAt() *CustomFieldUpdateOne {
+	cfuo.mutation.ClearDeletedAt()
+	return cfuo
+}
+
+// SetName sets the "name" field.
+func (cfuo *CustomFieldUpdateOne) SetName(s string) *CustomFieldUpdateOne {
+	cfuo.mutation.SetName(s)
+	return cfuo
+}
+
+// SetLabel sets the "label" field.
+func (cfuo *CustomFieldUpdateOne) SetLabel(s string) *CustomFieldUpdateOne {
+	cfuo.mutation.SetLabel(s)
+	return cfuo
+}
+
+// SetDescription sets the "description" field.
+func (cfuo *CustomFieldUpdateOne) SetDescription(s string) *CustomFieldUpdateOne {
+	cfuo.mutation.SetDescription(s)
+	return cfuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cfuo *CustomFieldUpdateOne) SetNillableDescription(s *string) *CustomFieldUpdateOne {
+	if s != nil {
+		cfuo.SetDescription(*s)
+	}
+	return cfuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (cfuo *CustomFieldUpdateOne) ClearDescription() *CustomFieldUpdateOne {
+	cfuo.mutation.ClearDescription()
+	return cfuo
+}
+
+// SetType sets the "type" field.
+func (cfuo *CustomFieldUpdateOne) SetType(s string) *CustomFieldUpdateOne {
+	cfuo.mutation.SetType(s)
+	return cfuo
+}
+
+// SetTarget sets the "target" field.
+func (cfuo *CustomFieldUpdateOne) SetTarget(s string) *CustomFieldUpdateOne {
+	cfuo.mutation.SetTarget(s)
+	return cfuo
+}
+
+// SetRequired sets the "required" field.
+func (cfuo *CustomFieldUpdateOne) SetRequired(b bool) *CustomFieldUpdateOne {
+	cfuo.mutation.SetRequired(b)
+	return cfuo
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (cfuo *CustomFieldUpdateOne) SetNillableRequired(b *bool) *CustomFieldUpdateOne {
+	if b != nil {
+		cfuo.SetRequired(*b)
+	}
+	return cfuo
+}
+
+// SetMaxLength sets the "max_length" field.
+func (cfuo *CustomFieldUpdateOne) SetMaxLength(i int) *CustomFieldUpdateOne {
+	cfuo.mutation.ResetMaxLength()
+	cfuo.mutation.SetMaxLength(i)
+	return cfuo
+}
+
+// SetNillableMaxLength sets the "max_length" field if the given value is not nil.
+func (cfuo *CustomFieldUpdateOne) SetNillableMaxLength(i *int) *CustomFieldUpdateOne {
+	if i != nil {
+		cfuo.SetMaxLength(*i)
+	}
+	return cfuo
+}
+
+// AddMaxLength adds i to the "max_length" field.
+func (cfuo *CustomFieldUpdateOne) AddMaxLength(i int) *CustomFieldUpdateOne {
+	cfuo.mutation.AddMaxLength(i)
+	return cfuo
+}
+
+// SetPattern sets the "pattern" field.
+func (cfuo *CustomFieldUpdateOne) SetPattern(s string) *CustomFieldUpdateOne {
+	cfuo.mutation.SetPattern(s)
+	return cfuo
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (cfuo *CustomFieldUpdateOne) SetNillablePattern(s *string) *CustomFieldUpdateOne {
+	if s != nil {
+		cfuo.SetPattern(*s)
+	}
+	return cfuo
+}
+
+// ClearPattern clears the value of the "pattern" field.
+func (cfuo *CustomFieldUpdateOne) ClearPattern() *CustomFieldUpdateOne {
+	cfuo.mutation.ClearPattern()
+	return cfuo
+}
+
+// SetOptions sets the "options" field.
+func (cfuo *CustomFieldUpdateOne) SetOptions(s []string) *CustomFieldUpdateOne {
+	cfuo.mutation.SetOptions(s)
+	return cfuo
+}
+
+// ClearOptions clears the value of the "options" field.
+func (cfuo *CustomFieldUpdateOne) ClearOptions() *CustomFieldUpdateOne {
+	cfuo.mutation.ClearOptions()
+	return cfuo
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (cfuo *CustomFieldUpdateOne) SetSortOrder(i int) *CustomFieldUpdateOne {
+	cfuo.mutation.ResetSortOrder()
+	cfuo.mutation.SetSortOrder(i)
+	return cfuo
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (cfuo *CustomFieldUpdateOne) SetNillableSortOrder(i *int) *CustomFieldUpdateOne {
+	if i != nil {
+		cfuo.SetSortOrder(*i)
+	}
+	return cfuo
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (cfuo *CustomFieldUpdateOne) AddSortOrder(i int) *CustomFieldUpdateOne {
+	cfuo.mutation.AddSortOrder(i)
+	return cfuo
+}
+
+// Mutation returns the CustomFieldMutation object of the builder.
+func (cfuo *CustomFieldUpdateOne) Mutation() *CustomFieldMutation {
+	return cfuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cfuo *CustomFieldUpdateOne) Select(field string, fields ...string) *CustomFieldUpdateOne {
+	cfuo.fields = append([]string{field}, fields...)
+	return cfuo
+}
+
+// Save executes the query and returns the updated CustomField entity.
+func (cfuo *CustomFieldUpdateOne) Save(ctx context.Context) (*CustomField, error) {
+	var (
+		err  error
+		node *CustomField
+	)
+	cfuo.defaults()
+	if len(cfuo.hooks) == 0 {
+		if err = cfuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = cfuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CustomFieldMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cfuo.check(); err != nil {
+				return nil, err
+			}
+			cfuo.mutation = mutation
+			node, err = cfuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cfuo.hooks) - 1; i >= 0; i-- {
+			if cfuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cfuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cfuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfuo *CustomFieldUpdateOne) SaveX(ctx context.Context) *CustomField {
+	node, err := cfuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cfuo *CustomFieldUpdateOne) Exec(ctx context.Context) error {
+	_, err := cfuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfuo *CustomFieldUpdateOne) ExecX(ctx context.Context) {
+	if err := cfuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cfuo *CustomFieldUpdateOne) defaults() {
+	if _, ok := cfuo.mutation.UpdatedAt(); !ok {
+		v := customfield.UpdateDefaultUpdatedAt()
+		cfuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cfuo *CustomFieldUpdateOne) check() error {
+	if v, ok := cfuo.mutation.Name(); ok {
+		if err := customfield.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CustomField.name": %w`, err)}
+		}
+	}
+	if v, ok := cfuo.mutation.GetType(); ok {
+		if err := customfield.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "CustomField.type": %w`, err)}
+		}
+	}
+	if v, ok := cfuo.mutation.Target(); ok {
+		if err := customfield.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "CustomField.target": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cfuo *CustomFieldUpdateOne) sqlSave(ctx context.Context) (_node *CustomField, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   customfield.Table,
+			Columns: customfield.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: customfield.FieldID,
+			},
+		},
+	}
+	id, ok := cfuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CustomField.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cfuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, customfield.FieldID)
+		for _, f := range fields {
+			if !customfield.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != customfield.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cfuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cfuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customfield.FieldUpdatedAt,
+		})
+	}
+	if value, ok := cfuo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customfield.FieldDeletedAt,
+		})
+	}
+	if cfuo.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: customfield.FieldDeletedAt,
+		})
+	}
+	if value, ok := cfuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfield.FieldName,
+		})
+	}
+	if value, ok := cfuo.mutation.Label(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfield.FieldLabel,
+		})
+	}
+	if value, ok := cfuo.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfield.FieldDescription,
+		})
+	}
+	if cfuo.mutation.DescriptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: customfield.FieldDescription,
+		})
+	}
+	if value, ok := cfuo.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfield.FieldType,
+		})
+	}
+	if value, ok := cfuo.mutation.Target(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfield.FieldTarget,
+		})
+	}
+	if value, ok := cfuo.mutation.Required(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: customfield.FieldRequired,
+		})
+	}
+	if value, ok := cfuo.mutation.MaxLength(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: customfield.FieldMaxLength,
+		})
+	}
+	if value, ok := cfuo.mutation.AddedMaxLength(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: customfield.FieldMaxLength,
+		})
+	}
+	if value, ok := cfuo.mutation.Pattern(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfield.FieldPattern,
+		})
+	}
+	if cfuo.mutation.PatternCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: customfield.FieldPattern,
+		})
+	}
+	if value, ok := cfuo.mutation.Options(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: customfield.FieldOptions,
+		})
+	}
+	if cfuo.mutation.OptionsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: customfield.FieldOptions,
+		})
+	}
+	if value, ok := cfuo.mutation.SortOrder(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: customfield.FieldSortOrder,
+		})
+	}
+	if value, ok := cfuo.mutation.AddedSortOrder(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: customfield.FieldSortOrder,
+		})
+	}
+	_node = &CustomField{config: cfuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cfuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customfield.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,936 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/customfieldvalue"
+)
+
+// CustomFieldValueCreate is the builder for creating a CustomFieldValue entity.
+type CustomFieldValueCreate struct {
+	config
+	mutation *CustomFieldValueMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cfvc *CustomFieldValueCreate) SetCreatedAt(t time.Time) *CustomFieldValueCreate {
+	cfvc.mutation.SetCreatedAt(t)
+	return cfvc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cfvc *CustomFieldValueCreate) SetNillableCreatedAt(t *time.Time) *CustomFieldValueCreate {
+	if t != nil {
+		cfvc.SetCreatedAt(*t)
+	}
+	return cfvc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cfvc *CustomFieldValueCreate) SetUpdatedAt(t time.Time) *CustomFieldValueCreate {
+	cfvc.mutation.SetUpdatedAt(t)
+	return cfvc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cfvc *CustomFieldValueCreate) SetNillableUpdatedAt(t *time.Time) *CustomFieldValueCreate {
+	if t != nil {
+		cfvc.SetUpdatedAt(*t)
+	}
+	return cfvc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cfvc *CustomFieldValueCreate) SetDeletedAt(t time.Time) *CustomFieldValueCreate {
+	cfvc.mutation.SetDeletedAt(t)
+	return cfvc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cfvc *CustomFieldValueCreate) SetNillableDeletedAt(t *time.Time) *CustomFieldValueCreate {
+	if t != nil {
+		cfvc.SetDeletedAt(*t)
+	}
+	return cfvc
+}
+
+// SetTargetType sets the "target_type" field.
+func (cfvc *CustomFieldValueCreate) SetTargetType(s string) *CustomFieldValueCreate {
+	cfvc.mutation.SetTargetType(s)
+	return cfvc
+}
+
+// SetTargetID sets the "target_id" field.
+func (cfvc *CustomFieldValueCreate) SetTargetID(i int) *CustomFieldValueCreate {
+	cfvc.mutation.SetTargetID(i)
+	return cfvc
+}
+
+// SetName sets the "name" field.
+func (cfvc *CustomFieldValueCreate) SetName(s string) *CustomFieldValueCreate {
+	cfvc.mutation.SetName(s)
+	return cfvc
+}
+
+// SetValue sets the "value" field.
+func (cfvc *CustomFieldValueCreate) SetValue(s string) *CustomFieldValueCreate {
+	cfvc.mutation.SetValue(s)
+	return cfvc
+}
+
+// Mutation returns the CustomFieldValueMutation object of the builder.
+func (cfvc *CustomFieldValueCreate) Mutation() *CustomFieldValueMutation {
+	return cfvc.mutation
+}
+
+// Save creates the CustomFieldValue in the database.
+func (cfvc *CustomFieldValueCreate) Save(ctx context.Context) (*CustomFieldValue, error) {
+	var (
+		err  error
+		node *CustomFieldValue
+	)
+	cfvc.defaults()
+	if len(cfvc.hooks) == 0 {
+		if err = cfvc.check(); err != nil {
+			return nil, err
+		}
+		node, err = cfvc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CustomFieldValueMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cfvc.check(); err != nil {
+				return nil, err
+			}
+			cfvc.mutation = mutation
+			if node, err = cfvc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cfvc.hooks) - 1; i >= 0; i-- {
+			if cfvc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cfvc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cfvc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cfvc *CustomFieldValueCreate) SaveX(ctx context.Context) *CustomFieldValue {
+	v, err := cfvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cfvc *CustomFieldValueCreate) Exec(ctx context.Context) error {
+	_, err := cfvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfvc *CustomFieldValueCreate) ExecX(ctx context.Context) {
+	if err := cfvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cfvc *CustomFieldValueCreate) defaults() {
+	if _, ok := cfvc.mutation.CreatedAt(); !ok {
+		v := customfieldvalue.DefaultCreatedAt()
+		cfvc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cfvc.mutation.UpdatedAt(); !ok {
+		v := customfieldvalue.DefaultUpdatedAt()
+		cfvc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cfvc *CustomFieldValueCreate) check() error {
+	if _, ok := cfvc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CustomFieldValue.created_at"`)}
+	}
+	if _, ok := cfvc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CustomFieldValue.updated_at"`)}
+	}
+	if _, ok := cfvc.mutation.TargetType(); !ok {
+		return &ValidationError{Name: "target_type", err: errors.New(`ent: missing required field "CustomFieldValue.target_type"`)}
+	}
+	if v, ok := cfvc.mutation.TargetType(); ok {
+		if err := customfieldvalue.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "CustomFieldValue.target_type": %w`, err)}
+		}
+	}
+	if _, ok := cfvc.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "CustomFieldValue.target_id"`)}
+	}
+	if _, ok := cfvc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "CustomFieldValue.name"`)}
+	}
+	if v, ok := cfvc.mutation.Name(); ok {
+		if err := customfieldvalue.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CustomFieldValue.name": %w`, err)}
+		}
+	}
+	if _, ok := cfvc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "CustomFieldValue.value"`)}
+	}
+	return nil
+}
+
+func (cfvc *CustomFieldValueCreate) sqlSave(ctx context.Context) (*CustomFieldValue, error) {
+	_node, _spec := cfvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cfvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (cfvc *CustomFieldValueCreate) createSpec() (*CustomFieldValue, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CustomFieldValue{config: cfvc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: customfieldvalue.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: customfieldvalue.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = cfvc.conflict
+	if value, ok := cfvc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customfieldvalue.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := cfvc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customfieldvalue.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := cfvc.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customfieldvalue.FieldDeletedAt,
+		})
+		_node.DeletedAt = value
+	}
+	if value, ok := cfvc.mutation.TargetType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfieldvalue.FieldTargetType,
+		})
+		_node.TargetType = value
+	}
+	if value, ok := cfvc.mutation.TargetID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: customfieldvalue.FieldTargetID,
+		})
+		_node.TargetID = value
+	}
+	if value, ok := cfvc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfieldvalue.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := cfvc.mutation.Value(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfieldvalue.FieldValue,
+		})
+		_node.Value = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CustomFieldValue.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CustomFieldValueUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (cfvc *CustomFieldValueCreate) OnConflict(opts ...sql.ConflictOption) *CustomFieldValueUpsertOne {
+	cfvc.conflict = opts
+	return &CustomFieldValueUpsertOne{
+		create: cfvc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CustomFieldValue.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (cfvc *CustomFieldValueCreate) OnConflictColumns(columns ...string) *CustomFieldValueUpsertOne {
+	cfvc.conflict = append(cfvc.conflict, sql.ConflictColumns(columns...))
+	return &CustomFieldValueUpsertOne{
+		create: cfvc,
+	}
+}
+
+type (
+	// CustomFieldValueUpsertOne is the builder for "upsert"-ing
+	//  one CustomFieldValue node.
+	CustomFieldValueUpsertOne struct {
+		create *CustomFieldValueCreate
+	}
+
+	// CustomFieldValueUpsert is the "OnConflict" setter.
+	CustomFieldValueUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CustomFieldValueUpsert) SetCreatedAt(v time.Time) *CustomFieldValueUpsert {
+	u.Set(customfieldvalue.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CustomFieldValueUpsert) UpdateCreatedAt() *CustomFieldValueUpsert {
+	u.SetExcluded(customfieldvalue.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CustomFieldValueUpsert) SetUpdatedAt(v time.Time) *CustomFieldValueUpsert {
+	u.Set(customfieldvalue.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CustomFieldValueUpsert) UpdateUpdatedAt() *CustomFieldValueUpsert {
+	u.SetExcluded(customfieldvalue.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CustomFieldValueUpsert) SetDeletedAt(v time.Time) *CustomFieldValueUpsert {
+	u.Set(customfieldvalue.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CustomFieldValueUpsert) UpdateDeletedAt() *CustomFieldValueUpsert {
+	u.SetExcluded(customfieldvalue.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CustomFieldValueUpsert) ClearDeletedAt() *CustomFieldValueUpsert {
+	u.SetNull(customfieldvalue.FieldDeletedAt)
+	return u
+}
+
+// SetTargetType sets the "target_type" field.
+func (u *CustomFieldValueUpsert) SetTargetType(v string) *CustomFieldValueUpsert {
+	u.Set(customfieldvalue.FieldTargetType, v)
+	return u
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *CustomFieldValueUpsert) UpdateTargetType() *CustomFieldValueUpsert {
+	u.SetExcluded(customfieldvalue.FieldTargetType)
+	return u
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *CustomFieldValueUpsert) SetTargetID(v int) *CustomFieldValueUpsert {
+	u.Set(customfieldvalue.FieldTargetID, v)
+	return u
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *CustomFieldValueUpsert) UpdateTargetID() *CustomFieldValueUpsert {
+	u.SetExcluded(customfieldvalue.FieldTargetID)
+	return u
+}
+
+// AddTargetID adds v to the "target_id" field.
+func (u *CustomFieldValueUpsert) AddTargetID(v int) *CustomFieldValueUpsert {
+	u.Add(customfieldvalue.FieldTargetID, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CustomFieldValueUpsert) SetName(v string) *CustomFieldValueUpsert {
+	u.Set(customfieldvalue.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CustomFieldValueUpsert) UpdateName() *CustomFieldValueUpsert {
+	u.SetExcluded(customfieldvalue.FieldName)
+	return u
+}
+
+// SetValue sets the "value" field.
+func (u *CustomFieldValueUpsert) SetValue(v string) *CustomFieldValueUpsert {
+	u.Set(customfieldvalue.FieldValue, v)
+	return u
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *CustomFieldValueUpsert) UpdateValue() *CustomFieldValueUpsert {
+	u.SetExcluded(customfieldvalue.FieldValue)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.CustomFieldValue.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *CustomFieldValueUpsertOne) UpdateNewValues() *CustomFieldValueUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(customfieldvalue.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//  client.CustomFieldValue.Create().
+//      OnConflict(sql.ResolveWithIgnore()).
+//      Exec(ctx)
+//
+func (u *CustomFieldValueUpsertOne) Ignore() *CustomFieldValueUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CustomFieldValueUpsertOne) DoNothing() *CustomFieldValueUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CustomFieldValueCreate.OnConflict
+// documentation for more info.
+func (u *CustomFieldValueUpsertOne) Update(set func(*CustomFieldValueUpsert)) *CustomFieldValueUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CustomFieldValueUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CustomFieldValueUpsertOne) SetCreatedAt(v time.Time) *CustomFieldValueUpsertOne {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CustomFieldValueUpsertOne) UpdateCreatedAt() *CustomFieldValueUpsertOne {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CustomFieldValueUpsertOne) SetUpdatedAt(v time.Time) *CustomFieldValueUpsertOne {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CustomFieldValueUpsertOne) UpdateUpdatedAt() *CustomFieldValueUpsertOne {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CustomFieldValueUpsertOne) SetDeletedAt(v time.Time) *CustomFieldValueUpsertOne {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CustomFieldValueUpsertOne) UpdateDeletedAt() *CustomFieldValueUpsertOne {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CustomFieldValueUpsertOne) ClearDeletedAt() *CustomFieldValueUpsertOne {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetTargetType sets the "target_type" field.
+func (u *CustomFieldValueUpsertOne) SetTargetType(v string) *CustomFieldValueUpsertOne {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.SetTargetType(v)
+	})
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *CustomFieldValueUpsertOne) UpdateTargetType() *CustomFieldValueUpsertOne {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.UpdateTargetType()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *CustomFieldValueUpsertOne) SetTargetID(v int) *CustomFieldValueUpsertOne {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// AddTargetID adds v to the "target_id" field.
+func (u *CustomFieldValueUpsertOne) AddTargetID(v int) *CustomFieldValueUpsertOne {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.AddTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *CustomFieldValueUpsertOne) UpdateTargetID() *CustomFieldValueUpsertOne {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CustomFieldValueUpsertOne) SetName(v string) *CustomFieldValueUpsertOne {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CustomFieldValueUpsertOne) UpdateName() *CustomFieldValueUpsertOne {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *CustomFieldValueUpsertOne) SetValue(v string) *CustomFieldValueUpsertOne {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *CustomFieldValueUpsertOne) UpdateValue() *CustomFieldValueUpsertOne {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// Exec executes the query.
+func (u *CustomFieldValueUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CustomFieldValueCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CustomFieldValueUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CustomFieldValueUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CustomFieldValueUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CustomFieldValueCreateBulk is the builder for creating many CustomFieldValue entities in bulk.
+type CustomFieldValueCreateBulk struct {
+	config
+	builders []*CustomFieldValueCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CustomFieldValue entities in the database.
+func (cfvcb *CustomFieldValueCreateBulk) Save(ctx context.Context) ([]*CustomFieldValue, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(cfvcb.builders))
+	nodes := make([]*CustomFieldValue, len(cfvcb.builders))
+	mutators := make([]Mutator, len(cfvcb.builders))
+	for i := range cfvcb.builders {
+		func(i int, root context.Context) {
+			builder := cfvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CustomFieldValueMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cfvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cfvcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cfvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cfvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfvcb *CustomFieldValueCreateBulk) SaveX(ctx context.Context) []*CustomFieldValue {
+	v, err := cfvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cfvcb *CustomFieldValueCreateBulk) Exec(ctx context.Context) error {
+	_, err := cfvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfvcb *CustomFieldValueCreateBulk) ExecX(ctx context.Context) {
+	if err := cfvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CustomFieldValue.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CustomFieldValueUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (cfvcb *CustomFieldValueCreateBulk) OnConflict(opts ...sql.ConflictOption) *CustomFieldValueUpsertBulk {
+	cfvcb.conflict = opts
+	return &CustomFieldValueUpsertBulk{
+		create: cfvcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CustomFieldValue.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (cfvcb *CustomFieldValueCreateBulk) OnConflictColumns(columns ...string) *CustomFieldValueUpsertBulk {
+	cfvcb.conflict = append(cfvcb.conflict, sql.ConflictColumns(columns...))
+	return &CustomFieldValueUpsertBulk{
+		create: cfvcb,
+	}
+}
+
+// CustomFieldValueUpsertBulk is the builder for "upsert"-ing
+// a bulk of CustomFieldValue nodes.
+type CustomFieldValueUpsertBulk struct {
+	create *CustomFieldValueCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CustomFieldValue.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *CustomFieldValueUpsertBulk) UpdateNewValues() *CustomFieldValueUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(customfieldvalue.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CustomFieldValue.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *CustomFieldValueUpsertBulk) Ignore() *CustomFieldValueUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CustomFieldValueUpsertBulk) DoNothing() *CustomFieldValueUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CustomFieldValueCreateBulk.OnConflict
+// documentation for more info.
+func (u *CustomFieldValueUpsertBulk) Update(set func(*CustomFieldValueUpsert)) *CustomFieldValueUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CustomFieldValueUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *CustomFieldValueUpsertBulk) SetCreatedAt(v time.Time) *CustomFieldValueUpsertBulk {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *CustomFieldValueUpsertBulk) UpdateCreatedAt() *CustomFieldValueUpsertBulk {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CustomFieldValueUpsertBulk) SetUpdatedAt(v time.Time) *CustomFieldValueUpsertBulk {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CustomFieldValueUpsertBulk) UpdateUpdatedAt() *CustomFieldValueUpsertBulk {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CustomFieldValueUpsertBulk) SetDeletedAt(v time.Time) *CustomFieldValueUpsertBulk {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CustomFieldValueUpsertBulk) UpdateDeletedAt() *CustomFieldValueUpsertBulk {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CustomFieldValueUpsertBulk) ClearDeletedAt() *CustomFieldValueUpsertBulk {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetTargetType sets the "target_type" field.
+func (u *CustomFieldValueUpsertBulk) SetTargetType(v string) *CustomFieldValueUpsertBulk {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.SetTargetType(v)
+	})
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *CustomFieldValueUpsertBulk) UpdateTargetType() *CustomFieldValueUpsertBulk {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.UpdateTargetType()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *CustomFieldValueUpsertBulk) SetTargetID(v int) *CustomFieldValueUpsertBulk {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// AddTargetID adds v to the "target_id" field.
+func (u *CustomFieldValueUpsertBulk) AddTargetID(v int) *CustomFieldValueUpsertBulk {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.AddTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *CustomFieldValueUpsertBulk) UpdateTargetID() *CustomFieldValueUpsertBulk {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CustomFieldValueUpsertBulk) SetName(v string) *CustomFieldValueUpsertBulk {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CustomFieldValueUpsertBulk) UpdateName() *CustomFieldValueUpsertBulk {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *CustomFieldValueUpsertBulk) SetValue(v string) *CustomFieldValueUpsertBulk {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *CustomFieldValueUpsertBulk) UpdateValue() *CustomFieldValueUpsertBulk {
+	return u.Update(func(s *CustomFieldValueUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// Exec executes the query.
+func (u *CustomFieldValueUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CustomFieldValueCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CustomFieldValueCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CustomFieldValueUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,921 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/customfieldvalue"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/predicate"
+)
+
+// CustomFieldValueQuery is the builder for querying CustomFieldValue entities.
+type CustomFieldValueQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.CustomFieldValue
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CustomFieldValueQuery builder.
+func (cfvq *CustomFieldValueQuery) Where(ps ...predicate.CustomFieldValue) *CustomFieldValueQuery {
+	cfvq.predicates = append(cfvq.predicates, ps...)
+	return cfvq
+}
+
+// Limit adds a limit step to the query.
+func (cfvq *CustomFieldValueQuery) Limit(limit int) *CustomFieldValueQuery {
+	cfvq.limit = &limit
+	return cfvq
+}
+
+// Offset adds an offset step to the query.
+func (cfvq *CustomFieldValueQuery) Offset(offset int) *CustomFieldValueQuery {
+	cfvq.offset = &offset
+	return cfvq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cfvq *CustomFieldValueQuery) Unique(unique bool) *CustomFieldValueQuery {
+	cfvq.unique = &unique
+	return cfvq
+}
+
+// Order adds an order step to the query.
+func (cfvq *CustomFieldValueQuery) Order(o ...OrderFunc) *CustomFieldValueQuery {
+	cfvq.order = append(cfvq.order, o...)
+	return cfvq
+}
+
+// First returns the first CustomFieldValue entity from the query.
+// Returns a *NotFoundError when no CustomFieldValue was found.
+func (cfvq *CustomFieldValueQuery) First(ctx context.Context) (*CustomFieldValue, error) {
+	nodes, err := cfvq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{customfieldvalue.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cfvq *CustomFieldValueQuery) FirstX(ctx context.Context) *CustomFieldValue {
+	node, err := cfvq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CustomFieldValue ID from the query.
+// Returns a *NotFoundError when no CustomFieldValue ID was found.
+func (cfvq *CustomFieldValueQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cfvq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{customfieldvalue.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cfvq *CustomFieldValueQuery) FirstIDX(ctx context.Context) int {
+	id, err := cfvq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CustomFieldValue entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CustomFieldValue entity is found.
+// Returns a *NotFoundError when no CustomFieldValue entities are found.
+func (cfvq *CustomFieldValueQuery) Only(ctx context.Context) (*CustomFieldValue, error) {
+	nodes, err := cfvq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{customfieldvalue.Label}
+	default:
+		return nil, &NotSingularError{customfieldvalue.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cfvq *CustomFieldValueQuery) OnlyX(ctx context.Context) *CustomFieldValue {
+	node, err := cfvq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CustomFieldValue ID in the query.
+// Returns a *NotSingularError when more than one CustomFieldValue ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cfvq *CustomFieldValueQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cfvq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{customfieldvalue.Label}
+	default:
+		err = &NotSingularError{customfieldvalue.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cfvq *CustomFieldValueQuery) OnlyIDX(ctx context.Context) int {
+	id, err := cfvq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CustomFieldValues.
+func (cfvq *CustomFieldValueQuery) All(ctx context.Context) ([]*CustomFieldValue, error) {
+	if err := cfvq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return cfvq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cfvq *CustomFieldValueQuery) AllX(ctx context.Context) []*CustomFieldValue {
+	nodes, err := cfvq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CustomFieldValue IDs.
+func (cfvq *CustomFieldValueQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := cfvq.Select(customfieldvalue.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cfvq *CustomFieldValueQuery) IDsX(ctx context.Context) []int {
+	ids, err := cfvq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cfvq *CustomFieldValueQuery) Count(ctx context.Context) (int, error) {
+	if err := cfvq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return cfvq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cfvq *CustomFieldValueQuery) CountX(ctx context.Context) int {
+	count, err := cfvq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cfvq *CustomFieldValueQuery) Exist(ctx context.Context) (bool, error) {
+	if err := cfvq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return cfvq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cfvq *CustomFieldValueQuery) ExistX(ctx context.Context) bool {
+	exist, err := cfvq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CustomFieldValueQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cfvq *CustomFieldValueQuery) Clone() *CustomFieldValueQuery {
+	if cfvq == nil {
+		return nil
+	}
+	return &CustomFieldValueQuery{
+		config:     cfvq.config,
+		limit:      cfvq.limit,
+		offset:     cfvq.offset,
+		order:      append([]OrderFunc{}, cfvq.order...),
+		predicates: append([]predicate.CustomFieldValue{}, cfvq.predicates...),
+		// clone intermediate query.
+		sql:    cfvq.sql.Clone(),
+		path:   cfvq.path,
+		unique: cfvq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CustomFieldValue.Query().
+//		GroupBy(customfieldvalue.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (cfvq *CustomFieldValueQuery) GroupBy(field string, fields ...string) *CustomFieldValueGroupBy {
+	group := &CustomFieldValueGroupBy{config: cfvq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := cfvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return cfvq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"omitempty"`
+//	}
+//
+//	client.CustomFieldValue.Query().
+//		Select(customfieldvalue.FieldCreatedAt).
+//		Scan(ctx, &v)
+//
+func (cfvq *CustomFieldValueQuery) Select(fields ...string) *CustomFieldValueSelect {
+	cfvq.fields = append(cfvq.fields, fields...)
+	return &CustomFieldValueSelect{CustomFieldValueQuery: cfvq}
+}
+
+func (cfvq *CustomFieldValueQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range cfvq.fields {
+		if !customfieldvalue.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cfvq.path != nil {
+		prev, err := cfvq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cfvq.sql = prev
+	}
+	return nil
+}
+
+func (cfvq *CustomFieldValueQuery) sqlAll(ctx context.Context) ([]*CustomFieldValue, error) {
+	var (
+		nodes = []*CustomFieldValue{}
+		_spec = cfvq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &CustomFieldValue{config: cfvq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cfvq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (cfvq *CustomFieldValueQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cfvq.querySpec()
+	_spec.Node.Columns = cfvq.fields
+	if len(cfvq.fields) > 0 {
+		_spec.Unique = cfvq.unique != nil && *cfvq.unique
+	}
+	return sqlgraph.CountNodes(ctx, cfvq.driver, _spec)
+}
+
+func (cfvq *CustomFieldValueQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := cfvq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (cfvq *CustomFieldValueQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   customfieldvalue.Table,
+			Columns: customfieldvalue.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: customfieldvalue.FieldID,
+			},
+		},
+		From:   cfvq.sql,
+		Unique: true,
+	}
+	if unique := cfvq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := cfvq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, customfieldvalue.FieldID)
+		for i := range fields {
+			if fields[i] != customfieldvalue.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cfvq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cfvq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cfvq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cfvq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cfvq *CustomFieldValueQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cfvq.driver.Dialect())
+	t1 := builder.Table(customfieldvalue.Table)
+	columns := cfvq.fields
+	if len(columns) == 0 {
+		columns = customfieldvalue.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cfvq.sql != nil {
+		selector = cfvq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cfvq.unique != nil && *cfvq.unique {
+		selector.Distinct()
+	}
+	for _, p := range cfvq.predicates {
+		p(selector)
+	}
+	for _, p := range cfvq.order {
+		p(selector)
+	}
+	if offset := cfvq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cfvq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CustomFieldValueGroupBy is the group-by builder for CustomFieldValue entities.
+type CustomFieldValueGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cfvgb *CustomFieldValueGroupBy) Aggregate(fns ...AggregateFunc) *CustomFieldValueGroupBy {
+	cfvgb.fns = append(cfvgb.fns, fns...)
+	return cfvgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (cfvgb *CustomFieldValueGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := cfvgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	cfvgb.sql = query
+	return cfvgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (cfvgb *CustomFieldValueGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := cfvgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cfvgb *CustomFieldValueGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(cfvgb.fields) > 1 {
+		return nil, errors.New("ent: CustomFieldValueGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := cfvgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (cfvgb *CustomFieldValueGroupBy) StringsX(ctx context.Context) []string {
+	v, err := cfvgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cfvgb *CustomFieldValueGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = cfvgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customfieldvalue.Label}
+	default:
+		err = fmt.Errorf("ent: CustomFieldValueGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (cfvgb *CustomFieldValueGroupBy) StringX(ctx context.Context) string {
+	v, err := cfvgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cfvgb *CustomFieldValueGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(cfvgb.fields) > 1 {
+		return nil, errors.New("ent: CustomFieldValueGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := cfvgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (cfvgb *CustomFieldValueGroupBy) IntsX(ctx context.Context) []int {
+	v, err := cfvgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cfvgb *CustomFieldValueGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = cfvgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customfieldvalue.Label}
+	default:
+		err = fmt.Errorf("ent: CustomFieldValueGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (cfvgb *CustomFieldValueGroupBy) IntX(ctx context.Context) int {
+	v, err := cfvgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cfvgb *CustomFieldValueGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(cfvgb.fields) > 1 {
+		return nil, errors.New("ent: CustomFieldValueGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := cfvgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (cfvgb *CustomFieldValueGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := cfvgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cfvgb *CustomFieldValueGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = cfvgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customfieldvalue.Label}
+	default:
+		err = fmt.Errorf("ent: CustomFieldValueGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (cfvgb *CustomFieldValueGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := cfvgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (cfvgb *CustomFieldValueGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(cfvgb.fields) > 1 {
+		return nil, errors.New("ent: CustomFieldValueGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := cfvgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (cfvgb *CustomFieldValueGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := cfvgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (cfvgb *CustomFieldValueGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = cfvgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customfieldvalue.Label}
+	default:
+		err = fmt.Errorf("ent: CustomFieldValueGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (cfvgb *CustomFieldValueGroupBy) BoolX(ctx context.Context) bool {
+	v, err := cfvgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (cfvgb *CustomFieldValueGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range cfvgb.fields {
+		if !customfieldvalue.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := cfvgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cfvgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (cfvgb *CustomFieldValueGroupBy) sqlQuery() *sql.Selector {
+	selector := cfvgb.sql.Select()
+	aggregation := make([]string, 0, len(cfvgb.fns))
+	for _, fn := range cfvgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(cfvgb.fields)+len(cfvgb.fns))
+		for _, f := range cfvgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(cfvgb.fields...)...)
+}
+
+// CustomFieldValueSelect is the builder for selecting fields of CustomFieldValue entities.
+type CustomFieldValueSelect struct {
+	*CustomFieldValueQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cfvs *CustomFieldValueSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := cfvs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	cfvs.sql = cfvs.CustomFieldValueQuery.sqlQuery(ctx)
+	return cfvs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (cfvs *CustomFieldValueSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := cfvs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (cfvs *CustomFieldValueSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(cfvs.fields) > 1 {
+		return nil, errors.New("ent: CustomFieldValueSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := cfvs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (cfvs *CustomFieldValueSelect) StringsX(ctx context.Context) []string {
+	v, err := cfvs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (cfvs *CustomFieldValueSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = cfvs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customfieldvalue.Label}
+	default:
+		err = fmt.Errorf("ent: CustomFieldValueSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (cfvs *CustomFieldValueSelect) StringX(ctx context.Context) string {
+	v, err := cfvs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (cfvs *CustomFieldValueSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(cfvs.fields) > 1 {
+		return nil, errors.New("ent: CustomFieldValueSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := cfvs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (cfvs *CustomFieldValueSelect) IntsX(ctx context.Context) []int {
+	v, err := cfvs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (cfvs *CustomFieldValueSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = cfvs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customfieldvalue.Label}
+	default:
+		err = fmt.Errorf("ent: CustomFieldValueSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (cfvs *CustomFieldValueSelect) IntX(ctx context.Context) int {
+	v, err := cfvs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (cfvs *CustomFieldValueSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(cfvs.fields) > 1 {
+		return nil, errors.New("ent: CustomFieldValueSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := cfvs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (cfvs *CustomFieldValueSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := cfvs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (cfvs *CustomFieldValueSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = cfvs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customfieldvalue.Label}
+	default:
+		err = fmt.Errorf("ent: CustomFieldValueSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (cfvs *CustomFieldValueSelect) Float64X(ctx context.Context) float64 {
+	v, err := cfvs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (cfvs *CustomFieldValueSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(cfvs.fields) > 1 {
+		return nil, errors.New("ent: CustomFieldValueSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := cfvs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (cfvs *CustomFieldValueSelect) BoolsX(ctx context.Context) []bool {
+	v, err := cfvs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (cfvs *CustomFieldValueSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = cfvs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{customfieldvalue.Label}
+	default:
+		err = fmt.Errorf("ent: CustomFieldValueSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (cfvs *CustomFieldValueSelect) BoolX(ctx context.Context) bool {
+	v, err := cfvs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (cfvs *CustomFieldValueSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := cfvs.sql.Query()
+	if err := cfvs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,524 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/customfieldvalue"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/predicate"
+)
+
+// CustomFieldValueUpdate is the builder for updating CustomFieldValue entities.
+type CustomFieldValueUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CustomFieldValueMutation
+}
+
+// Where appends a list predicates to the CustomFieldValueUpdate builder.
+func (cfvu *CustomFieldValueUpdate) Where(ps ...predicate.CustomFieldValue) *CustomFieldValueUpdate {
+	cfvu.mutation.Where(ps...)
+	return cfvu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cfvu *CustomFieldValueUpdate) SetUpdatedAt(t time.Time) *CustomFieldValueUpdate {
+	cfvu.mutation.SetUpdatedAt(t)
+	return cfvu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cfvu *CustomFieldValueUpdate) SetDeletedAt(t time.Time) *CustomFieldValueUpdate {
+	cfvu.mutation.SetDeletedAt(t)
+	return cfvu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cfvu *CustomFieldValueUpdate) SetNillableDeletedAt(t *time.Time) *CustomFieldValueUpdate {
+	if t != nil {
+		cfvu.SetDeletedAt(*t)
+	}
+	return cfvu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cfvu *CustomFieldValueUpdate) ClearDeletedAt() *CustomFieldValueUpdate {
+	cfvu.mutation.ClearDeletedAt()
+	return cfvu
+}
+
+// SetTargetType sets the "target_type" field.
+func (cfvu *CustomFieldValueUpdate) SetTargetType(s string) *CustomFieldValueUpdate {
+	cfvu.mutation.SetTargetType(s)
+	return cfvu
+}
+
+// SetTargetID sets the "target_id" field.
+func (cfvu *CustomFieldValueUpdate) SetTargetID(i int) *CustomFieldValueUpdate {
+	cfvu.mutation.ResetTargetID()
+	cfvu.mutation.SetTargetID(i)
+	return cfvu
+}
+
+// AddTargetID adds i to the "target_id" field.
+func (cfvu *CustomFieldValueUpdate) AddTargetID(i int) *CustomFieldValueUpdate {
+	cfvu.mutation.AddTargetID(i)
+	return cfvu
+}
+
+// SetName sets the "name" field.
+func (cfvu *CustomFieldValueUpdate) SetName(s string) *CustomFieldValueUpdate {
+	cfvu.mutation.SetName(s)
+	return cfvu
+}
+
+// SetValue sets the "value" field.
+func (cfvu *CustomFieldValueUpdate) SetValue(s string) *CustomFieldValueUpdate {
+	cfvu.mutation.SetValue(s)
+	return cfvu
+}
+
+// Mutation returns the CustomFieldValueMutation object of the builder.
+func (cfvu *CustomFieldValueUpdate) Mutation() *CustomFieldValueMutation {
+	return cfvu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cfvu *CustomFieldValueUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	cfvu.defaults()
+	if len(cfvu.hooks) == 0 {
+		if err = cfvu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = cfvu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CustomFieldValueMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cfvu.check(); err != nil {
+				return 0, err
+			}
+			cfvu.mutation = mutation
+			affected, err = cfvu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cfvu.hooks) - 1; i >= 0; i-- {
+			if cfvu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cfvu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cfvu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfvu *CustomFieldValueUpdate) SaveX(ctx context.Context) int {
+	affected, err := cfvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cfvu *CustomFieldValueUpdate) Exec(ctx context.Context) error {
+	_, err := cfvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfvu *CustomFieldValueUpdate) ExecX(ctx context.Context) {
+	if err := cfvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cfvu *CustomFieldValueUpdate) defaults() {
+	if _, ok := cfvu.mutation.UpdatedAt(); !ok {
+		v := customfieldvalue.UpdateDefaultUpdatedAt()
+		cfvu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cfvu *CustomFieldValueUpdate) check() error {
+	if v, ok := cfvu.mutation.TargetType(); ok {
+		if err := customfieldvalue.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "CustomFieldValue.target_type": %w`, err)}
+		}
+	}
+	if v, ok := cfvu.mutation.Name(); ok {
+		if err := customfieldvalue.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CustomFieldValue.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cfvu *CustomFieldValueUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   customfieldvalue.Table,
+			Columns: customfieldvalue.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: customfieldvalue.FieldID,
+			},
+		},
+	}
+	if ps := cfvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cfvu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customfieldvalue.FieldUpdatedAt,
+		})
+	}
+	if value, ok := cfvu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customfieldvalue.FieldDeletedAt,
+		})
+	}
+	if cfvu.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: customfieldvalue.FieldDeletedAt,
+		})
+	}
+	if value, ok := cfvu.mutation.TargetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfieldvalue.FieldTargetType,
+		})
+	}
+	if value, ok := cfvu.mutation.TargetID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: customfieldvalue.FieldTargetID,
+		})
+	}
+	if value, ok := cfvu.mutation.AddedTargetID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: customfieldvalue.FieldTargetID,
+		})
+	}
+	if value, ok := cfvu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfieldvalue.FieldName,
+		})
+	}
+	if value, ok := cfvu.mutation.Value(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfieldvalue.FieldValue,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cfvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customfieldvalue.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// CustomFieldValueUpdateOne is the builder for updating a single CustomFieldValue entity.
+type CustomFieldValueUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CustomFieldValueMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cfvuo *CustomFieldValueUpdateOne) SetUpdatedAt(t time.Time) *CustomFieldValueUpdateOne {
+	cfvuo.mutation.SetUpdatedAt(t)
+	return cfvuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cfvuo *CustomFieldValueUpdateOne) SetDeletedAt(t time.Time) *CustomFieldValueUpdateOne {
+	cfvuo.mutation.SetDeletedAt(t)
+	return cfvuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cfvuo *CustomFieldValueUpdateOne) SetNillableDeletedAt(t *time.Time) *CustomFieldValueUpdateOne {
+	if t != nil {
+		cfvuo.SetDeletedAt(*t)
+	}
+	return cfvuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cfvuo *CustomFieldValueUpdateOne) ClearDeletedAt() *CustomFieldValueUpdateOne {
+	cfvuo.mutation.ClearDeletedAt()
+	return cfvuo
+}
+
+// SetTargetType sets the "target_type" field.
+func (cfvuo *CustomFieldValueUpdateOne) SetTargetType(s string) *CustomFieldValueUpdateOne {
+	cfvuo.mutation.SetTargetType(s)
+	return cfvuo
+}
+
+// SetTargetID sets the "target_id" field.
+func (cfvuo *CustomFieldValueUpdateOne) SetTargetID(i int) *CustomFieldValueUpdateOne {
+	cfvuo.mutation.ResetTargetID()
+	cfvuo.mutation.SetTargetID(i)
+	return cfvuo
+}
+
+// AddTargetID adds i to the "target_id" field.
+func (cfvuo *CustomFieldValueUpdateOne) AddTargetID(i int) *CustomFieldValueUpdateOne {
+	cfvuo.mutation.AddTargetID(i)
+	return cfvuo
+}
+
+// SetName sets the "name" field.
+func (cfvuo *CustomFieldValueUpdateOne) SetName(s string) *CustomFieldValueUpdateOne {
+	cfvuo.mutation.SetName(s)
+	return cfvuo
+}
+
+// SetValue sets the "value" field.
+func (cfvuo *CustomFieldValueUpdateOne) SetValue(s string) *CustomFieldValueUpdateOne {
+	cfvuo.mutation.SetValue(s)
+	return cfvuo
+}
+
+// Mutation returns the CustomFieldValueMutation object of the builder.
+func (cfvuo *CustomFieldValueUpdateOne) Mutation() *CustomFieldValueMutation {
+	return cfvuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cfvuo *CustomFieldValueUpdateOne) Select(field string, fields ...string) *CustomFieldValueUpdateOne {
+	cfvuo.fields = append([]string{field}, fields...)
+	return cfvuo
+}
+
+// Save executes the query and returns the updated CustomFieldValue entity.
+func (cfvuo *CustomFieldValueUpdateOne) Save(ctx context.Context) (*CustomFieldValue, error) {
+	var (
+		err  error
+		node *CustomFieldValue
+	)
+	cfvuo.defaults()
+	if len(cfvuo.hooks) == 0 {
+		if err = cfvuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = cfvuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CustomFieldValueMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cfvuo.check(); err != nil {
+				return nil, err
+			}
+			cfvuo.mutation = mutation
+			node, err = cfvuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cfvuo.hooks) - 1; i >= 0; i-- {
+			if cfvuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cfvuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cfvuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cfvuo *CustomFieldValueUpdateOne) SaveX(ctx context.Context) *CustomFieldValue {
+	node, err := cfvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cfvuo *CustomFieldValueUpdateOne) Exec(ctx context.Context) error {
+	_, err := cfvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cfvuo *CustomFieldValueUpdateOne) ExecX(ctx context.Context) {
+	if err := cfvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cfvuo *CustomFieldValueUpdateOne) defaults() {
+	if _, ok := cfvuo.mutation.UpdatedAt(); !ok {
+		v := customfieldvalue.UpdateDefaultUpdatedAt()
+		cfvuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cfvuo *CustomFieldValueUpdateOne) check() error {
+	if v, ok := cfvuo.mutation.TargetType(); ok {
+		if err := customfieldvalue.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "CustomFieldValue.target_type": %w`, err)}
+		}
+	}
+	if v, ok := cfvuo.mutation.Name(); ok {
+		if err := customfieldvalue.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CustomFieldValue.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cfvuo *CustomFieldValueUpdateOne) sqlSave(ctx context.Context) (_node *CustomFieldValue, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   customfieldvalue.Table,
+			Columns: customfieldvalue.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: customfieldvalue.FieldID,
+			},
+		},
+	}
+	id, ok := cfvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CustomFieldValue.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cfvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, customfieldvalue.FieldID)
+		for _, f := range fields {
+			if !customfieldvalue.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != customfieldvalue.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cfvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cfvuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customfieldvalue.FieldUpdatedAt,
+		})
+	}
+	if value, ok := cfvuo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: customfieldvalue.FieldDeletedAt,
+		})
+	}
+	if cfvuo.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: customfieldvalue.FieldDeletedAt,
+		})
+	}
+	if value, ok := cfvuo.mutation.TargetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfieldvalue.FieldTargetType,
+		})
+	}
+	if value, ok := cfvuo.mutation.TargetID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: customfieldvalue.FieldTargetID,
+		})
+	}
+	if value, ok := cfvuo.mutation.AddedTargetID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: customfieldvalue.FieldTargetID,
+		})
+	}
+	if value, ok := cfvuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfieldvalue.FieldName,
+		})
+	}
+	if value, ok := cfvuo.mutation.Value(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: customfieldvalue.FieldValue,
+		})
+	}
+	_node = &CustomFieldValue{config: cfvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cfvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{customfieldvalue.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
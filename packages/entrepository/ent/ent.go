@@ -8,9 +8,16 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/auditlog"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/comment"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/customfield"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/customfieldvalue"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/file"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/invite"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menu"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menuitem"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/page"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/passkey"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/permission"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/post"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/role"
@@ -37,15 +44,22 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		comment.Table:    comment.ValidColumn,
-		file.Table:       file.ValidColumn,
-		page.Table:       page.ValidColumn,
-		permission.Table: permission.ValidColumn,
-		post.Table:       post.ValidColumn,
-		role.Table:       role.ValidColumn,
-		setting.Table:    setting.ValidColumn,
-		topic.Table:      topic.ValidColumn,
-		user.Table:       user.ValidColumn,
+		auditlog.Table:         auditlog.ValidColumn,
+		comment.Table:          comment.ValidColumn,
+		customfield.Table:      customfield.ValidColumn,
+		customfieldvalue.Table: customfieldvalue.ValidColumn,
+		file.Table:             file.ValidColumn,
+		invite.Table:           invite.ValidColumn,
+		menu.Table:             menu.ValidColumn,
+		menuitem.Table:         menuitem.ValidColumn,
+		page.Table:             page.ValidColumn,
+		passkey.Table:          passkey.ValidColumn,
+		permission.Table:       permission.ValidColumn,
+		post.Table:             post.ValidColumn,
+		role.Table:             role.ValidColumn,
+		setting.Table:          setting.ValidColumn,
+		topic.Table:            topic.ValidColumn,
+		user.Table:             user.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
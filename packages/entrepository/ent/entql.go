@@ -3,9 +3,16 @@
 package ent
 
 import (
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/auditlog"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/comment"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/customfield"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/customfieldvalue"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/file"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/invite"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menu"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menuitem"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/page"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/passkey"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/permission"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/post"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/predicate"
@@ -22,8 +29,32 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 9)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 16)}
 	graph.Nodes[0] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   auditlog.Table,
+			Columns: auditlog.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: auditlog.FieldID,
+			},
+		},
+		Type: "AuditLog",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			auditlog.FieldCreatedAt:  {Type: field.TypeTime, Column: auditlog.FieldCreatedAt},
+			auditlog.FieldUpdatedAt:  {Type: field.TypeTime, Column: auditlog.FieldUpdatedAt},
+			auditlog.FieldDeletedAt:  {Type: field.TypeTime, Column: auditlog.FieldDeletedAt},
+			auditlog.FieldUserID:     {Type: field.TypeInt, Column: auditlog.FieldUserID},
+			auditlog.FieldAction:     {Type: field.TypeString, Column: auditlog.FieldAction},
+			auditlog.FieldTargetType: {Type: field.TypeString, Column: auditlog.FieldTargetType},
+			auditlog.FieldTargetID:   {Type: field.TypeInt, Column: auditlog.FieldTargetID},
+			auditlog.FieldBefore:     {Type: field.TypeString, Column: auditlog.FieldBefore},
+			auditlog.FieldAfter:      {Type: field.TypeString, Column: auditlog.FieldAfter},
+			auditlog.FieldIP:         {Type: field.TypeString, Column: auditlog.FieldIP},
+			auditlog.FieldRequestID:  {Type: field.TypeString, Column: auditlog.FieldRequestID},
+		},
+	}
+	graph.Nodes[1] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   comment.Table,
 			Columns: comment.Columns,
@@ -45,7 +76,53 @@ var schemaGraph = func() *sqlgraph.Schema {
 			comment.FieldParentID:    {Type: field.TypeInt, Column: comment.FieldParentID},
 		},
 	}
-	graph.Nodes[1] = &sqlgraph.Node{
+	graph.Nodes[2] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   customfield.Table,
+			Columns: customfield.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: customfield.FieldID,
+			},
+		},
+		Type: "CustomField",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			customfield.FieldCreatedAt:   {Type: field.TypeTime, Column: customfield.FieldCreatedAt},
+			customfield.FieldUpdatedAt:   {Type: field.TypeTime, Column: customfield.FieldUpdatedAt},
+			customfield.FieldDeletedAt:   {Type: field.TypeTime, Column: customfield.FieldDeletedAt},
+			customfield.FieldName:        {Type: field.TypeString, Column: customfield.FieldName},
+			customfield.FieldLabel:       {Type: field.TypeString, Column: customfield.FieldLabel},
+			customfield.FieldDescription: {Type: field.TypeString, Column: customfield.FieldDescription},
+			customfield.FieldType:        {Type: field.TypeString, Column: customfield.FieldType},
+			customfield.FieldTarget:      {Type: field.TypeString, Column: customfield.FieldTarget},
+			customfield.FieldRequired:    {Type: field.TypeBool, Column: customfield.FieldRequired},
+			customfield.FieldMaxLength:   {Type: field.TypeInt, Column: customfield.FieldMaxLength},
+			customfield.FieldPattern:     {Type: field.TypeString, Column: customfield.FieldPattern},
+			customfield.FieldOptions:     {Type: field.TypeJSON, Column: customfield.FieldOptions},
+			customfield.FieldSortOrder:   {Type: field.TypeInt, Column: customfield.FieldSortOrder},
+		},
+	}
+	graph.Nodes[3] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   customfieldvalue.Table,
+			Columns: customfieldvalue.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: customfieldvalue.FieldID,
+			},
+		},
+		Type: "CustomFieldValue",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			customfieldvalue.FieldCreatedAt:  {Type: field.TypeTime, Column: customfieldvalue.FieldCreatedAt},
+			customfieldvalue.FieldUpdatedAt:  {Type: field.TypeTime, Column: customfieldvalue.FieldUpdatedAt},
+			customfieldvalue.FieldDeletedAt:  {Type: field.TypeTime, Column: customfieldvalue.FieldDeletedAt},
+			customfieldvalue.FieldTargetType: {Type: field.TypeString, Column: customfieldvalue.FieldTargetType},
+			customfieldvalue.FieldTargetID:   {Type: field.TypeInt, Column: customfieldvalue.FieldTargetID},
+			customfieldvalue.FieldName:       {Type: field.TypeString, Column: customfieldvalue.FieldName},
+			customfieldvalue.FieldValue:      {Type: field.TypeString, Column: customfieldvalue.FieldValue},
+		},
+	}
+	graph.Nodes[4] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   file.Table,
 			Columns: file.Columns,
@@ -56,17 +133,89 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "File",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			file.FieldCreatedAt: {Type: field.TypeTime, Column: file.FieldCreatedAt},
-			file.FieldUpdatedAt: {Type: field.TypeTime, Column: file.FieldUpdatedAt},
-			file.FieldDeletedAt: {Type: field.TypeTime, Column: file.FieldDeletedAt},
-			file.FieldDisk:      {Type: field.TypeString, Column: file.FieldDisk},
-			file.FieldPath:      {Type: field.TypeString, Column: file.FieldPath},
-			file.FieldType:      {Type: field.TypeString, Column: file.FieldType},
-			file.FieldSize:      {Type: field.TypeInt, Column: file.FieldSize},
-			file.FieldUserID:    {Type: field.TypeInt, Column: file.FieldUserID},
+			file.FieldCreatedAt:    {Type: field.TypeTime, Column: file.FieldCreatedAt},
+			file.FieldUpdatedAt:    {Type: field.TypeTime, Column: file.FieldUpdatedAt},
+			file.FieldDeletedAt:    {Type: field.TypeTime, Column: file.FieldDeletedAt},
+			file.FieldDisk:         {Type: field.TypeString, Column: file.FieldDisk},
+			file.FieldPath:         {Type: field.TypeString, Column: file.FieldPath},
+			file.FieldType:         {Type: field.TypeString, Column: file.FieldType},
+			file.FieldSize:         {Type: field.TypeInt, Column: file.FieldSize},
+			file.FieldHash:         {Type: field.TypeString, Column: file.FieldHash},
+			file.FieldWidth:        {Type: field.TypeInt, Column: file.FieldWidth},
+			file.FieldHeight:       {Type: field.TypeInt, Column: file.FieldHeight},
+			file.FieldVariants:     {Type: field.TypeJSON, Column: file.FieldVariants},
+			file.FieldOriginalName: {Type: field.TypeString, Column: file.FieldOriginalName},
+			file.FieldTitle:        {Type: field.TypeString, Column: file.FieldTitle},
+			file.FieldAlt:          {Type: field.TypeString, Column: file.FieldAlt},
+			file.FieldCaption:      {Type: field.TypeString, Column: file.FieldCaption},
+			file.FieldTags:         {Type: field.TypeJSON, Column: file.FieldTags},
+			file.FieldUserID:       {Type: field.TypeInt, Column: file.FieldUserID},
 		},
 	}
-	graph.Nodes[2] = &sqlgraph.Node{
+	graph.Nodes[5] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   invite.Table,
+			Columns: invite.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invite.FieldID,
+			},
+		},
+		Type: "Invite",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			invite.FieldCreatedAt: {Type: field.TypeTime, Column: invite.FieldCreatedAt},
+			invite.FieldUpdatedAt: {Type: field.TypeTime, Column: invite.FieldUpdatedAt},
+			invite.FieldDeletedAt: {Type: field.TypeTime, Column: invite.FieldDeletedAt},
+			invite.FieldCode:      {Type: field.TypeString, Column: invite.FieldCode},
+			invite.FieldUserID:    {Type: field.TypeInt, Column: invite.FieldUserID},
+			invite.FieldRoleID:    {Type: field.TypeInt, Column: invite.FieldRoleID},
+			invite.FieldMaxUses:   {Type: field.TypeInt, Column: invite.FieldMaxUses},
+			invite.FieldUsed:      {Type: field.TypeInt, Column: invite.FieldUsed},
+			invite.FieldExpiresAt: {Type: field.TypeTime, Column: invite.FieldExpiresAt},
+		},
+	}
+	graph.Nodes[6] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   menu.Table,
+			Columns: menu.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: menu.FieldID,
+			},
+		},
+		Type: "Menu",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			menu.FieldCreatedAt: {Type: field.TypeTime, Column: menu.FieldCreatedAt},
+			menu.FieldUpdatedAt: {Type: field.TypeTime, Column: menu.FieldUpdatedAt},
+			menu.FieldDeletedAt: {Type: field.TypeTime, Column: menu.FieldDeletedAt},
+			menu.FieldName:      {Type: field.TypeString, Column: menu.FieldName},
+			menu.FieldLocation:  {Type: field.TypeString, Column: menu.FieldLocation},
+		},
+	}
+	graph.Nodes[7] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   menuitem.Table,
+			Columns: menuitem.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: menuitem.FieldID,
+			},
+		},
+		Type: "MenuItem",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			menuitem.FieldCreatedAt: {Type: field.TypeTime, Column: menuitem.FieldCreatedAt},
+			menuitem.FieldUpdatedAt: {Type: field.TypeTime, Column: menuitem.FieldUpdatedAt},
+			menuitem.FieldDeletedAt: {Type: field.TypeTime, Column: menuitem.FieldDeletedAt},
+			menuitem.FieldMenuID:    {Type: field.TypeInt, Column: menuitem.FieldMenuID},
+			menuitem.FieldParentID:  {Type: field.TypeInt, Column: menuitem.FieldParentID},
+			menuitem.FieldLabel:     {Type: field.TypeString, Column: menuitem.FieldLabel},
+			menuitem.FieldType:      {Type: field.TypeString, Column: menuitem.FieldType},
+			menuitem.FieldTargetID:  {Type: field.TypeInt, Column: menuitem.FieldTargetID},
+			menuitem.FieldURL:       {Type: field.TypeString, Column: menuitem.FieldURL},
+			menuitem.FieldSortOrder: {Type: field.TypeInt, Column: menuitem.FieldSortOrder},
+		},
+	}
+	graph.Nodes[8] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   page.Table,
 			Columns: page.Columns,
@@ -86,9 +235,37 @@ var schemaGraph = func() *sqlgraph.Schema {
 			page.FieldContentHTML:     {Type: field.TypeString, Column: page.FieldContentHTML},
 			page.FieldDraft:           {Type: field.TypeBool, Column: page.FieldDraft},
 			page.FieldFeaturedImageID: {Type: field.TypeInt, Column: page.FieldFeaturedImageID},
+			page.FieldParentID:        {Type: field.TypeInt, Column: page.FieldParentID},
+			page.FieldSortOrder:       {Type: field.TypeInt, Column: page.FieldSortOrder},
+			page.FieldTemplate:        {Type: field.TypeString, Column: page.FieldTemplate},
 		},
 	}
-	graph.Nodes[3] = &sqlgraph.Node{
+	graph.Nodes[9] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   passkey.Table,
+			Columns: passkey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: passkey.FieldID,
+			},
+		},
+		Type: "Passkey",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			passkey.FieldCreatedAt:       {Type: field.TypeTime, Column: passkey.FieldCreatedAt},
+			passkey.FieldUpdatedAt:       {Type: field.TypeTime, Column: passkey.FieldUpdatedAt},
+			passkey.FieldDeletedAt:       {Type: field.TypeTime, Column: passkey.FieldDeletedAt},
+			passkey.FieldUserID:          {Type: field.TypeInt, Column: passkey.FieldUserID},
+			passkey.FieldName:            {Type: field.TypeString, Column: passkey.FieldName},
+			passkey.FieldCredentialID:    {Type: field.TypeString, Column: passkey.FieldCredentialID},
+			passkey.FieldPublicKey:       {Type: field.TypeBytes, Column: passkey.FieldPublicKey},
+			passkey.FieldAttestationType: {Type: field.TypeString, Column: passkey.FieldAttestationType},
+			passkey.FieldAaguid:          {Type: field.TypeBytes, Column: passkey.FieldAaguid},
+			passkey.FieldSignCount:       {Type: field.TypeUint32, Column: passkey.FieldSignCount},
+			passkey.FieldTransports:      {Type: field.TypeString, Column: passkey.FieldTransports},
+			passkey.FieldLastUsedAt:      {Type: field.TypeTime, Column: passkey.FieldLastUsedAt},
+		},
+	}
+	graph.Nodes[10] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   permission.Table,
 			Columns: permission.Columns,
@@ -105,9 +282,10 @@ var schemaGraph = func() *sqlgraph.Schema {
 			permission.FieldRoleID:    {Type: field.TypeInt, Column: permission.FieldRoleID},
 			permission.FieldAction:    {Type: field.TypeString, Column: permission.FieldAction},
 			permission.FieldValue:     {Type: field.TypeString, Column: permission.FieldValue},
+			permission.FieldTopicIds:  {Type: field.TypeJSON, Column: permission.FieldTopicIds},
 		},
 	}
-	graph.Nodes[4] = &sqlgraph.Node{
+	graph.Nodes[11] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   post.Table,
 			Columns: post.Columns,
@@ -136,7 +314,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			post.FieldUserID:          {Type: field.TypeInt, Column: post.FieldUserID},
 		},
 	}
-	graph.Nodes[5] = &sqlgraph.Node{
+	graph.Nodes[12] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   role.Table,
 			Columns: role.Columns,
@@ -147,15 +325,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "Role",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			role.FieldCreatedAt:   {Type: field.TypeTime, Column: role.FieldCreatedAt},
-			role.FieldUpdatedAt:   {Type: field.TypeTime, Column: role.FieldUpdatedAt},
-			role.FieldDeletedAt:   {Type: field.TypeTime, Column: role.FieldDeletedAt},
-			role.FieldName:        {Type: field.TypeString, Column: role.FieldName},
-			role.FieldDescription: {Type: field.TypeString, Column: role.FieldDescription},
-			role.FieldRoot:        {Type: field.TypeBool, Column: role.FieldRoot},
+			role.FieldCreatedAt:        {Type: field.TypeTime, Column: role.FieldCreatedAt},
+			role.FieldUpdatedAt:        {Type: field.TypeTime, Column: role.FieldUpdatedAt},
+			role.FieldDeletedAt:        {Type: field.TypeTime, Column: role.FieldDeletedAt},
+			role.FieldName:             {Type: field.TypeString, Column: role.FieldName},
+			role.FieldDescription:      {Type: field.TypeString, Column: role.FieldDescription},
+			role.FieldRoot:             {Type: field.TypeBool, Column: role.FieldRoot},
+			role.FieldMaxUploadSize:    {Type: field.TypeInt, Column: role.FieldMaxUploadSize},
+			role.FieldUploadMimeGroups: {Type: field.TypeJSON, Column: role.FieldUploadMimeGroups},
+			role.FieldStorageQuota:     {Type: field.TypeInt, Column: role.FieldStorageQuota},
 		},
 	}
-	graph.Nodes[6] = &sqlgraph.Node{
+	graph.Nodes[13] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   setting.Table,
 			Columns: setting.Columns,
@@ -174,7 +355,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			setting.FieldType:      {Type: field.TypeString, Column: setting.FieldType},
 		},
 	}
-	graph.Nodes[7] = &sqlgraph.Node{
+	graph.Nodes[14] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   topic.Table,
 			Columns: topic.Columns,
@@ -196,7 +377,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			topic.FieldParentID:    {Type: field.TypeInt, Column: topic.FieldParentID},
 		},
 	}
-	graph.Nodes[8] = &sqlgraph.Node{
+	graph.Nodes[15] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   user.Table,
 			Columns: user.Columns,
@@ -223,8 +404,21 @@ var schemaGraph = func() *sqlgraph.Schema {
 			user.FieldBioHTML:          {Type: field.TypeString, Column: user.FieldBioHTML},
 			user.FieldActive:           {Type: field.TypeBool, Column: user.FieldActive},
 			user.FieldAvatarImageID:    {Type: field.TypeInt, Column: user.FieldAvatarImageID},
+			user.FieldInviteID:         {Type: field.TypeInt, Column: user.FieldInviteID},
 		},
 	}
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditlog.UserTable,
+			Columns: []string{auditlog.UserColumn},
+			Bidi:    false,
+		},
+		"AuditLog",
+		"User",
+	)
 	graph.MustAddE(
 		"post",
 		&sqlgraph.EdgeSpec{
@@ -321,6 +515,66 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"File",
 		"User",
 	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.UserTable,
+			Columns: []string{invite.UserColumn},
+			Bidi:    false,
+		},
+		"Invite",
+		"User",
+	)
+	graph.MustAddE(
+		"role",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.RoleTable,
+			Columns: []string{invite.RoleColumn},
+			Bidi:    false,
+		},
+		"Invite",
+		"Role",
+	)
+	graph.MustAddE(
+		"invited_users",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invite.InvitedUsersTable,
+			Columns: []string{invite.InvitedUsersColumn},
+			Bidi:    false,
+		},
+		"Invite",
+		"User",
+	)
+	graph.MustAddE(
+		"items",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menu.ItemsTable,
+			Columns: []string{menu.ItemsColumn},
+			Bidi:    false,
+		},
+		"Menu",
+		"MenuItem",
+	)
+	graph.MustAddE(
+		"menu",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   menuitem.MenuTable,
+			Columns: []string{menuitem.MenuColumn},
+			Bidi:    false,
+		},
+		"MenuItem",
+		"Menu",
+	)
 	graph.MustAddE(
 		"featured_image",
 		&sqlgraph.EdgeSpec{
@@ -333,6 +587,42 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Page",
 		"File",
 	)
+	graph.MustAddE(
+		"children",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.ChildrenTable,
+			Columns: []string{page.ChildrenColumn},
+			Bidi:    true,
+		},
+		"Page",
+		"Page",
+	)
+	graph.MustAddE(
+		"parent",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   page.ParentTable,
+			Columns: []string{page.ParentColumn},
+			Bidi:    false,
+		},
+		"Page",
+		"Page",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passkey.UserTable,
+			Columns: []string{passkey.UserColumn},
+			Bidi:    false,
+		},
+		"Passkey",
+		"User",
+	)
 	graph.MustAddE(
 		"role",
 		&sqlgraph.EdgeSpec{
@@ -417,6 +707,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Role",
 		"User",
 	)
+	graph.MustAddE(
+		"invites",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.InvitesTable,
+			Columns: []string{role.InvitesColumn},
+			Bidi:    false,
+		},
+		"Role",
+		"Invite",
+	)
 	graph.MustAddE(
 		"posts",
 		&sqlgraph.EdgeSpec{
@@ -489,6 +791,42 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"User",
 		"Comment",
 	)
+	graph.MustAddE(
+		"passkeys",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasskeysTable,
+			Columns: []string{user.PasskeysColumn},
+			Bidi:    false,
+		},
+		"User",
+		"Passkey",
+	)
+	graph.MustAddE(
+		"invites",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitesTable,
+			Columns: []string{user.InvitesColumn},
+			Bidi:    false,
+		},
+		"User",
+		"Invite",
+	)
+	graph.MustAddE(
+		"audit_logs",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+		},
+		"User",
+		"AuditLog",
+	)
 	graph.MustAddE(
 		"roles",
 		&sqlgraph.EdgeSpec{
@@ -513,6 +851,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"User",
 		"File",
 	)
+	graph.MustAddE(
+		"invite",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.InviteTable,
+			Columns: []string{user.InviteColumn},
+			Bidi:    false,
+		},
+		"User",
+		"Invite",
+	)
 	return graph
 }()
 
@@ -522,6 +872,114 @@ type predicateAdder interface {
 	addPredicate(func(s *sql.Selector))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (alq *AuditLogQuery) addPredicate(pred func(s *sql.Selector)) {
+	alq.predicates = append(alq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the AuditLogQuery builder.
+func (alq *AuditLogQuery) Filter() *AuditLogFilter {
+	return &AuditLogFilter{alq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *AuditLogMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the AuditLogMutation builder.
+func (m *AuditLogMutation) Filter() *AuditLogFilter {
+	return &AuditLogFilter{m}
+}
+
+// AuditLogFilter provides a generic filtering capability at runtime for AuditLogQuery.
+type AuditLogFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *AuditLogFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *AuditLogFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(auditlog.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *AuditLogFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(auditlog.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *AuditLogFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(auditlog.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *AuditLogFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(auditlog.FieldDeletedAt))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *AuditLogFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(auditlog.FieldUserID))
+}
+
+// WhereAction applies the entql string predicate on the action field.
+func (f *AuditLogFilter) WhereAction(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldAction))
+}
+
+// WhereTargetType applies the entql string predicate on the target_type field.
+func (f *AuditLogFilter) WhereTargetType(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldTargetType))
+}
+
+// WhereTargetID applies the entql int predicate on the target_id field.
+func (f *AuditLogFilter) WhereTargetID(p entql.IntP) {
+	f.Where(p.Field(auditlog.FieldTargetID))
+}
+
+// WhereBefore applies the entql string predicate on the before field.
+func (f *AuditLogFilter) WhereBefore(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldBefore))
+}
+
+// WhereAfter applies the entql string predicate on the after field.
+func (f *AuditLogFilter) WhereAfter(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldAfter))
+}
+
+// WhereIP applies the entql string predicate on the ip field.
+func (f *AuditLogFilter) WhereIP(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldIP))
+}
+
+// WhereRequestID applies the entql string predicate on the request_id field.
+func (f *AuditLogFilter) WhereRequestID(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldRequestID))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *AuditLogFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *AuditLogFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (cq *CommentQuery) addPredicate(pred func(s *sql.Selector)) {
 	cq.predicates = append(cq.predicates, pred)
@@ -550,7 +1008,7 @@ type CommentFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *CommentFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -634,28 +1092,590 @@ func (f *CommentFilter) WhereHasUserWith(preds ...predicate.User) {
 	})))
 }
 
-// WhereHasChildren applies a predicate to check if query has an edge children.
-func (f *CommentFilter) WhereHasChildren() {
-	f.Where(entql.HasEdge("children"))
+// WhereHasChildren applies a predicate to check if query has an edge children.
+func (f *CommentFilter) WhereHasChildren() {
+	f.Where(entql.HasEdge("children"))
+}
+
+// WhereHasChildrenWith applies a predicate to check if query has an edge children with a given conditions (other predicates).
+func (f *CommentFilter) WhereHasChildrenWith(preds ...predicate.Comment) {
+	f.Where(entql.HasEdgeWith("children", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasParent applies a predicate to check if query has an edge parent.
+func (f *CommentFilter) WhereHasParent() {
+	f.Where(entql.HasEdge("parent"))
+}
+
+// WhereHasParentWith applies a predicate to check if query has an edge parent with a given conditions (other predicates).
+func (f *CommentFilter) WhereHasParentWith(preds ...predicate.Comment) {
+	f.Where(entql.HasEdgeWith("parent", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (cfq *CustomFieldQuery) addPredicate(pred func(s *sql.Selector)) {
+	cfq.predicates = append(cfq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the CustomFieldQuery builder.
+func (cfq *CustomFieldQuery) Filter() *CustomFieldFilter {
+	return &CustomFieldFilter{cfq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *CustomFieldMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the CustomFieldMutation builder.
+func (m *CustomFieldMutation) Filter() *CustomFieldFilter {
+	return &CustomFieldFilter{m}
+}
+
+// CustomFieldFilter provides a generic filtering capability at runtime for CustomFieldQuery.
+type CustomFieldFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *CustomFieldFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *CustomFieldFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(customfield.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *CustomFieldFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(customfield.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *CustomFieldFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(customfield.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *CustomFieldFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(customfield.FieldDeletedAt))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *CustomFieldFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(customfield.FieldName))
+}
+
+// WhereLabel applies the entql string predicate on the label field.
+func (f *CustomFieldFilter) WhereLabel(p entql.StringP) {
+	f.Where(p.Field(customfield.FieldLabel))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *CustomFieldFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(customfield.FieldDescription))
+}
+
+// WhereType applies the entql string predicate on the type field.
+func (f *CustomFieldFilter) WhereType(p entql.StringP) {
+	f.Where(p.Field(customfield.FieldType))
+}
+
+// WhereTarget applies the entql string predicate on the target field.
+func (f *CustomFieldFilter) WhereTarget(p entql.StringP) {
+	f.Where(p.Field(customfield.FieldTarget))
+}
+
+// WhereRequired applies the entql bool predicate on the required field.
+func (f *CustomFieldFilter) WhereRequired(p entql.BoolP) {
+	f.Where(p.Field(customfield.FieldRequired))
+}
+
+// WhereMaxLength applies the entql int predicate on the max_length field.
+func (f *CustomFieldFilter) WhereMaxLength(p entql.IntP) {
+	f.Where(p.Field(customfield.FieldMaxLength))
+}
+
+// WherePattern applies the entql string predicate on the pattern field.
+func (f *CustomFieldFilter) WherePattern(p entql.StringP) {
+	f.Where(p.Field(customfield.FieldPattern))
+}
+
+// WhereOptions applies the entql json.RawMessage predicate on the options field.
+func (f *CustomFieldFilter) WhereOptions(p entql.BytesP) {
+	f.Where(p.Field(customfield.FieldOptions))
+}
+
+// WhereSortOrder applies the entql int predicate on the sort_order field.
+func (f *CustomFieldFilter) WhereSortOrder(p entql.IntP) {
+	f.Where(p.Field(customfield.FieldSortOrder))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (cfvq *CustomFieldValueQuery) addPredicate(pred func(s *sql.Selector)) {
+	cfvq.predicates = append(cfvq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the CustomFieldValueQuery builder.
+func (cfvq *CustomFieldValueQuery) Filter() *CustomFieldValueFilter {
+	return &CustomFieldValueFilter{cfvq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *CustomFieldValueMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the CustomFieldValueMutation builder.
+func (m *CustomFieldValueMutation) Filter() *CustomFieldValueFilter {
+	return &CustomFieldValueFilter{m}
+}
+
+// CustomFieldValueFilter provides a generic filtering capability at runtime for CustomFieldValueQuery.
+type CustomFieldValueFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *CustomFieldValueFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *CustomFieldValueFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(customfieldvalue.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *CustomFieldValueFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(customfieldvalue.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *CustomFieldValueFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(customfieldvalue.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *CustomFieldValueFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(customfieldvalue.FieldDeletedAt))
+}
+
+// WhereTargetType applies the entql string predicate on the target_type field.
+func (f *CustomFieldValueFilter) WhereTargetType(p entql.StringP) {
+	f.Where(p.Field(customfieldvalue.FieldTargetType))
+}
+
+// WhereTargetID applies the entql int predicate on the target_id field.
+func (f *CustomFieldValueFilter) WhereTargetID(p entql.IntP) {
+	f.Where(p.Field(customfieldvalue.FieldTargetID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *CustomFieldValueFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(customfieldvalue.FieldName))
+}
+
+// WhereValue applies the entql string predicate on the value field.
+func (f *CustomFieldValueFilter) WhereValue(p entql.StringP) {
+	f.Where(p.Field(customfieldvalue.FieldValue))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (fq *FileQuery) addPredicate(pred func(s *sql.Selector)) {
+	fq.predicates = append(fq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the FileQuery builder.
+func (fq *FileQuery) Filter() *FileFilter {
+	return &FileFilter{fq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *FileMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the FileMutation builder.
+func (m *FileMutation) Filter() *FileFilter {
+	return &FileFilter{m}
+}
+
+// FileFilter provides a generic filtering capability at runtime for FileQuery.
+type FileFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *FileFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *FileFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(file.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *FileFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(file.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *FileFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(file.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *FileFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(file.FieldDeletedAt))
+}
+
+// WhereDisk applies the entql string predicate on the disk field.
+func (f *FileFilter) WhereDisk(p entql.StringP) {
+	f.Where(p.Field(file.FieldDisk))
+}
+
+// WherePath applies the entql string predicate on the path field.
+func (f *FileFilter) WherePath(p entql.StringP) {
+	f.Where(p.Field(file.FieldPath))
+}
+
+// WhereType applies the entql string predicate on the type field.
+func (f *FileFilter) WhereType(p entql.StringP) {
+	f.Where(p.Field(file.FieldType))
+}
+
+// WhereSize applies the entql int predicate on the size field.
+func (f *FileFilter) WhereSize(p entql.IntP) {
+	f.Where(p.Field(file.FieldSize))
+}
+
+// WhereHash applies the entql string predicate on the hash field.
+func (f *FileFilter) WhereHash(p entql.StringP) {
+	f.Where(p.Field(file.FieldHash))
+}
+
+// WhereWidth applies the entql int predicate on the width field.
+func (f *FileFilter) WhereWidth(p entql.IntP) {
+	f.Where(p.Field(file.FieldWidth))
+}
+
+// WhereHeight applies the entql int predicate on the height field.
+func (f *FileFilter) WhereHeight(p entql.IntP) {
+	f.Where(p.Field(file.FieldHeight))
+}
+
+// WhereVariants applies the entql json.RawMessage predicate on the variants field.
+func (f *FileFilter) WhereVariants(p entql.BytesP) {
+	f.Where(p.Field(file.FieldVariants))
+}
+
+// WhereOriginalName applies the entql string predicate on the original_name field.
+func (f *FileFilter) WhereOriginalName(p entql.StringP) {
+	f.Where(p.Field(file.FieldOriginalName))
+}
+
+// WhereTitle applies the entql string predicate on the title field.
+func (f *FileFilter) WhereTitle(p entql.StringP) {
+	f.Where(p.Field(file.FieldTitle))
+}
+
+// WhereAlt applies the entql string predicate on the alt field.
+func (f *FileFilter) WhereAlt(p entql.StringP) {
+	f.Where(p.Field(file.FieldAlt))
+}
+
+// WhereCaption applies the entql string predicate on the caption field.
+func (f *FileFilter) WhereCaption(p entql.StringP) {
+	f.Where(p.Field(file.FieldCaption))
+}
+
+// WhereTags applies the entql json.RawMessage predicate on the tags field.
+func (f *FileFilter) WhereTags(p entql.BytesP) {
+	f.Where(p.Field(file.FieldTags))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *FileFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(file.FieldUserID))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *FileFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *FileFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasPosts applies a predicate to check if query has an edge posts.
+func (f *FileFilter) WhereHasPosts() {
+	f.Where(entql.HasEdge("posts"))
+}
+
+// WhereHasPostsWith applies a predicate to check if query has an edge posts with a given conditions (other predicates).
+func (f *FileFilter) WhereHasPostsWith(preds ...predicate.Post) {
+	f.Where(entql.HasEdgeWith("posts", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasPages applies a predicate to check if query has an edge pages.
+func (f *FileFilter) WhereHasPages() {
+	f.Where(entql.HasEdge("pages"))
+}
+
+// WhereHasPagesWith applies a predicate to check if query has an edge pages with a given conditions (other predicates).
+func (f *FileFilter) WhereHasPagesWith(preds ...predicate.Page) {
+	f.Where(entql.HasEdgeWith("pages", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasUserAvatars applies a predicate to check if query has an edge user_avatars.
+func (f *FileFilter) WhereHasUserAvatars() {
+	f.Where(entql.HasEdge("user_avatars"))
+}
+
+// WhereHasUserAvatarsWith applies a predicate to check if query has an edge user_avatars with a given conditions (other predicates).
+func (f *FileFilter) WhereHasUserAvatarsWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user_avatars", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (iq *InviteQuery) addPredicate(pred func(s *sql.Selector)) {
+	iq.predicates = append(iq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the InviteQuery builder.
+func (iq *InviteQuery) Filter() *InviteFilter {
+	return &InviteFilter{iq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *InviteMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the InviteMutation builder.
+func (m *InviteMutation) Filter() *InviteFilter {
+	return &InviteFilter{m}
+}
+
+// InviteFilter provides a generic filtering capability at runtime for InviteQuery.
+type InviteFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *InviteFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *InviteFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(invite.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *InviteFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(invite.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *InviteFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(invite.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *InviteFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(invite.FieldDeletedAt))
+}
+
+// WhereCode applies the entql string predicate on the code field.
+func (f *InviteFilter) WhereCode(p entql.StringP) {
+	f.Where(p.Field(invite.FieldCode))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *InviteFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(invite.FieldUserID))
+}
+
+// WhereRoleID applies the entql int predicate on the role_id field.
+func (f *InviteFilter) WhereRoleID(p entql.IntP) {
+	f.Where(p.Field(invite.FieldRoleID))
+}
+
+// WhereMaxUses applies the entql int predicate on the max_uses field.
+func (f *InviteFilter) WhereMaxUses(p entql.IntP) {
+	f.Where(p.Field(invite.FieldMaxUses))
+}
+
+// WhereUsed applies the entql int predicate on the used field.
+func (f *InviteFilter) WhereUsed(p entql.IntP) {
+	f.Where(p.Field(invite.FieldUsed))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *InviteFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(invite.FieldExpiresAt))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *InviteFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *InviteFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasRole applies a predicate to check if query has an edge role.
+func (f *InviteFilter) WhereHasRole() {
+	f.Where(entql.HasEdge("role"))
+}
+
+// WhereHasRoleWith applies a predicate to check if query has an edge role with a given conditions (other predicates).
+func (f *InviteFilter) WhereHasRoleWith(preds ...predicate.Role) {
+	f.Where(entql.HasEdgeWith("role", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasInvitedUsers applies a predicate to check if query has an edge invited_users.
+func (f *InviteFilter) WhereHasInvitedUsers() {
+	f.Where(entql.HasEdge("invited_users"))
+}
+
+// WhereHasInvitedUsersWith applies a predicate to check if query has an edge invited_users with a given conditions (other predicates).
+func (f *InviteFilter) WhereHasInvitedUsersWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("invited_users", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (mq *MenuQuery) addPredicate(pred func(s *sql.Selector)) {
+	mq.predicates = append(mq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the MenuQuery builder.
+func (mq *MenuQuery) Filter() *MenuFilter {
+	return &MenuFilter{mq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *MenuMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the MenuMutation builder.
+func (m *MenuMutation) Filter() *MenuFilter {
+	return &MenuFilter{m}
+}
+
+// MenuFilter provides a generic filtering capability at runtime for MenuQuery.
+type MenuFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *MenuFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *MenuFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(menu.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *MenuFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(menu.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *MenuFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(menu.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *MenuFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(menu.FieldDeletedAt))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *MenuFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(menu.FieldName))
 }
 
-// WhereHasChildrenWith applies a predicate to check if query has an edge children with a given conditions (other predicates).
-func (f *CommentFilter) WhereHasChildrenWith(preds ...predicate.Comment) {
-	f.Where(entql.HasEdgeWith("children", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+// WhereLocation applies the entql string predicate on the location field.
+func (f *MenuFilter) WhereLocation(p entql.StringP) {
+	f.Where(p.Field(menu.FieldLocation))
 }
 
-// WhereHasParent applies a predicate to check if query has an edge parent.
-func (f *CommentFilter) WhereHasParent() {
-	f.Where(entql.HasEdge("parent"))
+// WhereHasItems applies a predicate to check if query has an edge items.
+func (f *MenuFilter) WhereHasItems() {
+	f.Where(entql.HasEdge("items"))
 }
 
-// WhereHasParentWith applies a predicate to check if query has an edge parent with a given conditions (other predicates).
-func (f *CommentFilter) WhereHasParentWith(preds ...predicate.Comment) {
-	f.Where(entql.HasEdgeWith("parent", sqlgraph.WrapFunc(func(s *sql.Selector) {
+// WhereHasItemsWith applies a predicate to check if query has an edge items with a given conditions (other predicates).
+func (f *MenuFilter) WhereHasItemsWith(preds ...predicate.MenuItem) {
+	f.Where(entql.HasEdgeWith("items", sqlgraph.WrapFunc(func(s *sql.Selector) {
 		for _, p := range preds {
 			p(s)
 		}
@@ -663,134 +1683,102 @@ func (f *CommentFilter) WhereHasParentWith(preds ...predicate.Comment) {
 }
 
 // addPredicate implements the predicateAdder interface.
-func (fq *FileQuery) addPredicate(pred func(s *sql.Selector)) {
-	fq.predicates = append(fq.predicates, pred)
+func (miq *MenuItemQuery) addPredicate(pred func(s *sql.Selector)) {
+	miq.predicates = append(miq.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the FileQuery builder.
-func (fq *FileQuery) Filter() *FileFilter {
-	return &FileFilter{fq}
+// Filter returns a Filter implementation to apply filters on the MenuItemQuery builder.
+func (miq *MenuItemQuery) Filter() *MenuItemFilter {
+	return &MenuItemFilter{miq}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *FileMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *MenuItemMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the FileMutation builder.
-func (m *FileMutation) Filter() *FileFilter {
-	return &FileFilter{m}
+// Filter returns an entql.Where implementation to apply filters on the MenuItemMutation builder.
+func (m *MenuItemMutation) Filter() *MenuItemFilter {
+	return &MenuItemFilter{m}
 }
 
-// FileFilter provides a generic filtering capability at runtime for FileQuery.
-type FileFilter struct {
+// MenuItemFilter provides a generic filtering capability at runtime for MenuItemQuery.
+type MenuItemFilter struct {
 	predicateAdder
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *FileFilter) Where(p entql.P) {
+func (f *MenuItemFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql int predicate on the id field.
-func (f *FileFilter) WhereID(p entql.IntP) {
-	f.Where(p.Field(file.FieldID))
+func (f *MenuItemFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(menuitem.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *FileFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(file.FieldCreatedAt))
+func (f *MenuItemFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(menuitem.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *FileFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(file.FieldUpdatedAt))
+func (f *MenuItemFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(menuitem.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *FileFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(file.FieldDeletedAt))
-}
-
-// WhereDisk applies the entql string predicate on the disk field.
-func (f *FileFilter) WhereDisk(p entql.StringP) {
-	f.Where(p.Field(file.FieldDisk))
-}
-
-// WherePath applies the entql string predicate on the path field.
-func (f *FileFilter) WherePath(p entql.StringP) {
-	f.Where(p.Field(file.FieldPath))
-}
-
-// WhereType applies the entql string predicate on the type field.
-func (f *FileFilter) WhereType(p entql.StringP) {
-	f.Where(p.Field(file.FieldType))
-}
-
-// WhereSize applies the entql int predicate on the size field.
-func (f *FileFilter) WhereSize(p entql.IntP) {
-	f.Where(p.Field(file.FieldSize))
+func (f *MenuItemFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(menuitem.FieldDeletedAt))
 }
 
-// WhereUserID applies the entql int predicate on the user_id field.
-func (f *FileFilter) WhereUserID(p entql.IntP) {
-	f.Where(p.Field(file.FieldUserID))
+// WhereMenuID applies the entql int predicate on the menu_id field.
+func (f *MenuItemFilter) WhereMenuID(p entql.IntP) {
+	f.Where(p.Field(menuitem.FieldMenuID))
 }
 
-// WhereHasUser applies a predicate to check if query has an edge user.
-func (f *FileFilter) WhereHasUser() {
-	f.Where(entql.HasEdge("user"))
+// WhereParentID applies the entql int predicate on the parent_id field.
+func (f *MenuItemFilter) WhereParentID(p entql.IntP) {
+	f.Where(p.Field(menuitem.FieldParentID))
 }
 
-// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
-func (f *FileFilter) WhereHasUserWith(preds ...predicate.User) {
-	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+// WhereLabel applies the entql string predicate on the label field.
+func (f *MenuItemFilter) WhereLabel(p entql.StringP) {
+	f.Where(p.Field(menuitem.FieldLabel))
 }
 
-// WhereHasPosts applies a predicate to check if query has an edge posts.
-func (f *FileFilter) WhereHasPosts() {
-	f.Where(entql.HasEdge("posts"))
+// WhereType applies the entql string predicate on the type field.
+func (f *MenuItemFilter) WhereType(p entql.StringP) {
+	f.Where(p.Field(menuitem.FieldType))
 }
 
-// WhereHasPostsWith applies a predicate to check if query has an edge posts with a given conditions (other predicates).
-func (f *FileFilter) WhereHasPostsWith(preds ...predicate.Post) {
-	f.Where(entql.HasEdgeWith("posts", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+// WhereTargetID applies the entql int predicate on the target_id field.
+func (f *MenuItemFilter) WhereTargetID(p entql.IntP) {
+	f.Where(p.Field(menuitem.FieldTargetID))
 }
 
-// WhereHasPages applies a predicate to check if query has an edge pages.
-func (f *FileFilter) WhereHasPages() {
-	f.Where(entql.HasEdge("pages"))
+// WhereURL applies the entql string predicate on the url field.
+func (f *MenuItemFilter) WhereURL(p entql.StringP) {
+	f.Where(p.Field(menuitem.FieldURL))
 }
 
-// WhereHasPagesWith applies a predicate to check if query has an edge pages with a given conditions (other predicates).
-func (f *FileFilter) WhereHasPagesWith(preds ...predicate.Page) {
-	f.Where(entql.HasEdgeWith("pages", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+// WhereSortOrder applies the entql int predicate on the sort_order field.
+func (f *MenuItemFilter) WhereSortOrder(p entql.IntP) {
+	f.Where(p.Field(menuitem.FieldSortOrder))
 }
 
-// WhereHasUserAvatars applies a predicate to check if query has an edge user_avatars.
-func (f *FileFilter) WhereHasUserAvatars() {
-	f.Where(entql.HasEdge("user_avatars"))
+// WhereHasMenu applies a predicate to check if query has an edge menu.
+func (f *MenuItemFilter) WhereHasMenu() {
+	f.Where(entql.HasEdge("menu"))
 }
 
-// WhereHasUserAvatarsWith applies a predicate to check if query has an edge user_avatars with a given conditions (other predicates).
-func (f *FileFilter) WhereHasUserAvatarsWith(preds ...predicate.User) {
-	f.Where(entql.HasEdgeWith("user_avatars", sqlgraph.WrapFunc(func(s *sql.Selector) {
+// WhereHasMenuWith applies a predicate to check if query has an edge menu with a given conditions (other predicates).
+func (f *MenuItemFilter) WhereHasMenuWith(preds ...predicate.Menu) {
+	f.Where(entql.HasEdgeWith("menu", sqlgraph.WrapFunc(func(s *sql.Selector) {
 		for _, p := range preds {
 			p(s)
 		}
@@ -825,7 +1813,7 @@ type PageFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PageFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -881,6 +1869,21 @@ func (f *PageFilter) WhereFeaturedImageID(p entql.IntP) {
 	f.Where(p.Field(page.FieldFeaturedImageID))
 }
 
+// WhereParentID applies the entql int predicate on the parent_id field.
+func (f *PageFilter) WhereParentID(p entql.IntP) {
+	f.Where(p.Field(page.FieldParentID))
+}
+
+// WhereSortOrder applies the entql int predicate on the sort_order field.
+func (f *PageFilter) WhereSortOrder(p entql.IntP) {
+	f.Where(p.Field(page.FieldSortOrder))
+}
+
+// WhereTemplate applies the entql string predicate on the template field.
+func (f *PageFilter) WhereTemplate(p entql.StringP) {
+	f.Where(p.Field(page.FieldTemplate))
+}
+
 // WhereHasFeaturedImage applies a predicate to check if query has an edge featured_image.
 func (f *PageFilter) WhereHasFeaturedImage() {
 	f.Where(entql.HasEdge("featured_image"))
@@ -895,6 +1898,147 @@ func (f *PageFilter) WhereHasFeaturedImageWith(preds ...predicate.File) {
 	})))
 }
 
+// WhereHasChildren applies a predicate to check if query has an edge children.
+func (f *PageFilter) WhereHasChildren() {
+	f.Where(entql.HasEdge("children"))
+}
+
+// WhereHasChildrenWith applies a predicate to check if query has an edge children with a given conditions (other predicates).
+func (f *PageFilter) WhereHasChildrenWith(preds ...predicate.Page) {
+	f.Where(entql.HasEdgeWith("children", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasParent applies a predicate to check if query has an edge parent.
+func (f *PageFilter) WhereHasParent() {
+	f.Where(entql.HasEdge("parent"))
+}
+
+// WhereHasParentWith applies a predicate to check if query has an edge parent with a given conditions (other predicates).
+func (f *PageFilter) WhereHasParentWith(preds ...predicate.Page) {
+	f.Where(entql.HasEdgeWith("parent", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (pq *PasskeyQuery) addPredicate(pred func(s *sql.Selector)) {
+	pq.predicates = append(pq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the PasskeyQuery builder.
+func (pq *PasskeyQuery) Filter() *PasskeyFilter {
+	return &PasskeyFilter{pq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *PasskeyMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the PasskeyMutation builder.
+func (m *PasskeyMutation) Filter() *PasskeyFilter {
+	return &PasskeyFilter{m}
+}
+
+// PasskeyFilter provides a generic filtering capability at runtime for PasskeyQuery.
+type PasskeyFilter struct {
+	predicateAdder
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *PasskeyFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *PasskeyFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(passkey.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *PasskeyFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(passkey.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *PasskeyFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(passkey.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *PasskeyFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(passkey.FieldDeletedAt))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *PasskeyFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(passkey.FieldUserID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *PasskeyFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(passkey.FieldName))
+}
+
+// WhereCredentialID applies the entql string predicate on the credential_id field.
+func (f *PasskeyFilter) WhereCredentialID(p entql.StringP) {
+	f.Where(p.Field(passkey.FieldCredentialID))
+}
+
+// WherePublicKey applies the entql []byte predicate on the public_key field.
+func (f *PasskeyFilter) WherePublicKey(p entql.BytesP) {
+	f.Where(p.Field(passkey.FieldPublicKey))
+}
+
+// WhereAttestationType applies the entql string predicate on the attestation_type field.
+func (f *PasskeyFilter) WhereAttestationType(p entql.StringP) {
+	f.Where(p.Field(passkey.FieldAttestationType))
+}
+
+// WhereAaguid applies the entql []byte predicate on the aaguid field.
+func (f *PasskeyFilter) WhereAaguid(p entql.BytesP) {
+	f.Where(p.Field(passkey.FieldAaguid))
+}
+
+// WhereSignCount applies the entql uint32 predicate on the sign_count field.
+func (f *PasskeyFilter) WhereSignCount(p entql.Uint32P) {
+	f.Where(p.Field(passkey.FieldSignCount))
+}
+
+// WhereTransports applies the entql string predicate on the transports field.
+func (f *PasskeyFilter) WhereTransports(p entql.StringP) {
+	f.Where(p.Field(passkey.FieldTransports))
+}
+
+// WhereLastUsedAt applies the entql time.Time predicate on the last_used_at field.
+func (f *PasskeyFilter) WhereLastUsedAt(p entql.TimeP) {
+	f.Where(p.Field(passkey.FieldLastUsedAt))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *PasskeyFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *PasskeyFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (pq *PermissionQuery) addPredicate(pred func(s *sql.Selector)) {
 	pq.predicates = append(pq.predicates, pred)
@@ -923,7 +2067,7 @@ type PermissionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PermissionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -964,6 +2108,11 @@ func (f *PermissionFilter) WhereValue(p entql.StringP) {
 	f.Where(p.Field(permission.FieldValue))
 }
 
+// WhereTopicIds applies the entql json.RawMessage predicate on the topic_ids field.
+func (f *PermissionFilter) WhereTopicIds(p entql.BytesP) {
+	f.Where(p.Field(permission.FieldTopicIds))
+}
+
 // WhereHasRole applies a predicate to check if query has an edge role.
 func (f *PermissionFilter) WhereHasRole() {
 	f.Where(entql.HasEdge("role"))
@@ -1006,7 +2155,7 @@ type PostFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PostFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1181,7 +2330,7 @@ type RoleFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RoleFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1222,6 +2371,21 @@ func (f *RoleFilter) WhereRoot(p entql.BoolP) {
 	f.Where(p.Field(role.FieldRoot))
 }
 
+// WhereMaxUploadSize applies the entql int predicate on the max_upload_size field.
+func (f *RoleFilter) WhereMaxUploadSize(p entql.IntP) {
+	f.Where(p.Field(role.FieldMaxUploadSize))
+}
+
+// WhereUploadMimeGroups applies the entql json.RawMessage predicate on the upload_mime_groups field.
+func (f *RoleFilter) WhereUploadMimeGroups(p entql.BytesP) {
+	f.Where(p.Field(role.FieldUploadMimeGroups))
+}
+
+// WhereStorageQuota applies the entql int predicate on the storage_quota field.
+func (f *RoleFilter) WhereStorageQuota(p entql.IntP) {
+	f.Where(p.Field(role.FieldStorageQuota))
+}
+
 // WhereHasPermissions applies a predicate to check if query has an edge permissions.
 func (f *RoleFilter) WhereHasPermissions() {
 	f.Where(entql.HasEdge("permissions"))
@@ -1250,6 +2414,20 @@ func (f *RoleFilter) WhereHasUsersWith(preds ...predicate.User) {
 	})))
 }
 
+// WhereHasInvites applies a predicate to check if query has an edge invites.
+func (f *RoleFilter) WhereHasInvites() {
+	f.Where(entql.HasEdge("invites"))
+}
+
+// WhereHasInvitesWith applies a predicate to check if query has an edge invites with a given conditions (other predicates).
+func (f *RoleFilter) WhereHasInvitesWith(preds ...predicate.Invite) {
+	f.Where(entql.HasEdgeWith("invites", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (sq *SettingQuery) addPredicate(pred func(s *sql.Selector)) {
 	sq.predicates = append(sq.predicates, pred)
@@ -1278,7 +2456,7 @@ type SettingFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *SettingFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1347,7 +2525,7 @@ type TopicFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *TopicFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1473,7 +2651,7 @@ type UserFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1564,6 +2742,11 @@ func (f *UserFilter) WhereAvatarImageID(p entql.IntP) {
 	f.Where(p.Field(user.FieldAvatarImageID))
 }
 
+// WhereInviteID applies the entql int predicate on the invite_id field.
+func (f *UserFilter) WhereInviteID(p entql.IntP) {
+	f.Where(p.Field(user.FieldInviteID))
+}
+
 // WhereHasPosts applies a predicate to check if query has an edge posts.
 func (f *UserFilter) WhereHasPosts() {
 	f.Where(entql.HasEdge("posts"))
@@ -1606,6 +2789,48 @@ func (f *UserFilter) WhereHasCommentsWith(preds ...predicate.Comment) {
 	})))
 }
 
+// WhereHasPasskeys applies a predicate to check if query has an edge passkeys.
+func (f *UserFilter) WhereHasPasskeys() {
+	f.Where(entql.HasEdge("passkeys"))
+}
+
+// WhereHasPasskeysWith applies a predicate to check if query has an edge passkeys with a given conditions (other predicates).
+func (f *UserFilter) WhereHasPasskeysWith(preds ...predicate.Passkey) {
+	f.Where(entql.HasEdgeWith("passkeys", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasInvites applies a predicate to check if query has an edge invites.
+func (f *UserFilter) WhereHasInvites() {
+	f.Where(entql.HasEdge("invites"))
+}
+
+// WhereHasInvitesWith applies a predicate to check if query has an edge invites with a given conditions (other predicates).
+func (f *UserFilter) WhereHasInvitesWith(preds ...predicate.Invite) {
+	f.Where(entql.HasEdgeWith("invites", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasAuditLogs applies a predicate to check if query has an edge audit_logs.
+func (f *UserFilter) WhereHasAuditLogs() {
+	f.Where(entql.HasEdge("audit_logs"))
+}
+
+// WhereHasAuditLogsWith applies a predicate to check if query has an edge audit_logs with a given conditions (other predicates).
+func (f *UserFilter) WhereHasAuditLogsWith(preds ...predicate.AuditLog) {
+	f.Where(entql.HasEdgeWith("audit_logs", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // WhereHasRoles applies a predicate to check if query has an edge roles.
 func (f *UserFilter) WhereHasRoles() {
 	f.Where(entql.HasEdge("roles"))
@@ -1633,3 +2858,17 @@ func (f *UserFilter) WhereHasAvatarImageWith(preds ...predicate.File) {
 		}
 	})))
 }
+
+// WhereHasInvite applies a predicate to check if query has an edge invite.
+func (f *UserFilter) WhereHasInvite() {
+	f.Where(entql.HasEdge("invite"))
+}
+
+// WhereHasInviteWith applies a predicate to check if query has an edge invite with a given conditions (other predicates).
+func (f *UserFilter) WhereHasInviteWith(preds ...predicate.Invite) {
+	f.Where(entql.HasEdgeWith("invite", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
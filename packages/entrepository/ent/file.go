@@ -3,11 +3,13 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/ngocphuongnb/tetua/app/fs"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/file"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/user"
 )
@@ -31,6 +33,24 @@ type File struct {
 	Type string `json:"type,omitempty"`
 	// Size holds the value of the "size" field.
 	Size int `json:"size,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash string `json:"hash,omitempty"`
+	// Width holds the value of the "width" field.
+	Width int `json:"width,omitempty"`
+	// Height holds the value of the "height" field.
+	Height int `json:"height,omitempty"`
+	// Variants holds the value of the "variants" field.
+	Variants []*fs.ImageVariant `json:"variants,omitempty"`
+	// OriginalName holds the value of the "original_name" field.
+	OriginalName string `json:"original_name,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Alt holds the value of the "alt" field.
+	Alt string `json:"alt,omitempty"`
+	// Caption holds the value of the "caption" field.
+	Caption string `json:"caption,omitempty"`
+	// Tags holds the value of the "tags" field.
+	Tags []string `json:"tags,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID int `json:"user_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -99,9 +119,11 @@ func (*File) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case file.FieldID, file.FieldSize, file.FieldUserID:
+		case file.FieldVariants, file.FieldTags:
+			values[i] = new([]byte)
+		case file.FieldID, file.FieldSize, file.FieldWidth, file.FieldHeight, file.FieldUserID:
 			values[i] = new(sql.NullInt64)
-		case file.FieldDisk, file.FieldPath, file.FieldType:
+		case file.FieldDisk, file.FieldPath, file.FieldType, file.FieldHash, file.FieldOriginalName, file.FieldTitle, file.FieldAlt, file.FieldCaption:
 			values[i] = new(sql.NullString)
 		case file.FieldCreatedAt, file.FieldUpdatedAt, file.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -168,6 +190,64 @@ func (f *File) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				f.Size = int(value.Int64)
 			}
+		case file.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				f.Hash = value.String
+			}
+		case file.FieldWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				f.Width = int(value.Int64)
+			}
+		case file.FieldHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				f.Height = int(value.Int64)
+			}
+		case file.FieldVariants:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field variants", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &f.Variants); err != nil {
+					return fmt.Errorf("unmarshal field variants: %w", err)
+				}
+			}
+		case file.FieldOriginalName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field original_name", values[i])
+			} else if value.Valid {
+				f.OriginalName = value.String
+			}
+		case file.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				f.Title = value.String
+			}
+		case file.FieldAlt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alt", values[i])
+			} else if value.Valid {
+				f.Alt = value.String
+			}
+		case file.FieldCaption:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field caption", values[i])
+			} else if value.Valid {
+				f.Caption = value.String
+			}
+		case file.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &f.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
 		case file.FieldUserID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field user_id", values[i])
@@ -236,6 +316,24 @@ func (f *File) String() string {
 	builder.WriteString(f.Type)
 	builder.WriteString(", size=")
 	builder.WriteString(fmt.Sprintf("%v", f.Size))
+	builder.WriteString(", hash=")
+	builder.WriteString(f.Hash)
+	builder.WriteString(", width=")
+	builder.WriteString(fmt.Sprintf("%v", f.Width))
+	builder.WriteString(", height=")
+	builder.WriteString(fmt.Sprintf("%v", f.Height))
+	builder.WriteString(", variants=")
+	builder.WriteString(fmt.Sprintf("%v", f.Variants))
+	builder.WriteString(", original_name=")
+	builder.WriteString(f.OriginalName)
+	builder.WriteString(", title=")
+	builder.WriteString(f.Title)
+	builder.WriteString(", alt=")
+	builder.WriteString(f.Alt)
+	builder.WriteString(", caption=")
+	builder.WriteString(f.Caption)
+	builder.WriteString(", tags=")
+	builder.WriteString(fmt.Sprintf("%v", f.Tags))
 	builder.WriteString(", user_id=")
 	builder.WriteString(fmt.Sprintf("%v", f.UserID))
 	builder.WriteByte(')')
@@ -25,6 +25,24 @@ const (
 	FieldType = "type"
 	// FieldSize holds the string denoting the size field in the database.
 	FieldSize = "size"
+	// FieldHash holds the string denoting the hash field in the database.
+	FieldHash = "hash"
+	// FieldWidth holds the string denoting the width field in the database.
+	FieldWidth = "width"
+	// FieldHeight holds the string denoting the height field in the database.
+	FieldHeight = "height"
+	// FieldVariants holds the string denoting the variants field in the database.
+	FieldVariants = "variants"
+	// FieldOriginalName holds the string denoting the original_name field in the database.
+	FieldOriginalName = "original_name"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldAlt holds the string denoting the alt field in the database.
+	FieldAlt = "alt"
+	// FieldCaption holds the string denoting the caption field in the database.
+	FieldCaption = "caption"
+	// FieldTags holds the string denoting the tags field in the database.
+	FieldTags = "tags"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -77,6 +95,15 @@ var Columns = []string{
 	FieldPath,
 	FieldType,
 	FieldSize,
+	FieldHash,
+	FieldWidth,
+	FieldHeight,
+	FieldVariants,
+	FieldOriginalName,
+	FieldTitle,
+	FieldAlt,
+	FieldCaption,
+	FieldTags,
 	FieldUserID,
 }
 
@@ -97,4 +124,10 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// PathValidator is a validator for the "path" field. It is called by the builders before save.
+	PathValidator func(string) error
+	// DefaultWidth holds the default value on creation for the "width" field.
+	DefaultWidth int
+	// DefaultHeight holds the default value on creation for the "height" field.
+	DefaultHeight int
 )
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/app/fs"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/file"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/page"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/post"
@@ -91,6 +92,116 @@ func (fc *FileCreate) SetSize(i int) *FileCreate {
 	return fc
 }
 
+// SetHash sets the "hash" field.
+func (fc *FileCreate) SetHash(s string) *FileCreate {
+	fc.mutation.SetHash(s)
+	return fc
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (fc *FileCreate) SetNillableHash(s *string) *FileCreate {
+	if s != nil {
+		fc.SetHash(*s)
+	}
+	return fc
+}
+
+// SetWidth sets the "width" field.
+func (fc *FileCreate) SetWidth(i int) *FileCreate {
+	fc.mutation.SetWidth(i)
+	return fc
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (fc *FileCreate) SetNillableWidth(i *int) *FileCreate {
+	if i != nil {
+		fc.SetWidth(*i)
+	}
+	return fc
+}
+
+// SetHeight sets the "height" field.
+func (fc *FileCreate) SetHeight(i int) *FileCreate {
+	fc.mutation.SetHeight(i)
+	return fc
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (fc *FileCreate) SetNillableHeight(i *int) *FileCreate {
+	if i != nil {
+		fc.SetHeight(*i)
+	}
+	return fc
+}
+
+// SetVariants sets the "variants" field.
+func (fc *FileCreate) SetVariants(fv []*fs.ImageVariant) *FileCreate {
+	fc.mutation.SetVariants(fv)
+	return fc
+}
+
+// SetOriginalName sets the "original_name" field.
+func (fc *FileCreate) SetOriginalName(s string) *FileCreate {
+	fc.mutation.SetOriginalName(s)
+	return fc
+}
+
+// SetNillableOriginalName sets the "original_name" field if the given value is not nil.
+func (fc *FileCreate) SetNillableOriginalName(s *string) *FileCreate {
+	if s != nil {
+		fc.SetOriginalName(*s)
+	}
+	return fc
+}
+
+// SetTitle sets the "title" field.
+func (fc *FileCreate) SetTitle(s string) *FileCreate {
+	fc.mutation.SetTitle(s)
+	return fc
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (fc *FileCreate) SetNillableTitle(s *string) *FileCreate {
+	if s != nil {
+		fc.SetTitle(*s)
+	}
+	return fc
+}
+
+// SetAlt sets the "alt" field.
+func (fc *FileCreate) SetAlt(s string) *FileCreate {
+	fc.mutation.SetAlt(s)
+	return fc
+}
+
+// SetNillableAlt sets the "alt" field if the given value is not nil.
+func (fc *FileCreate) SetNillableAlt(s *string) *FileCreate {
+	if s != nil {
+		fc.SetAlt(*s)
+	}
+	return fc
+}
+
+// SetCaption sets the "caption" field.
+func (fc *FileCreate) SetCaption(s string) *FileCreate {
+	fc.mutation.SetCaption(s)
+	return fc
+}
+
+// SetNillableCaption sets the "caption" field if the given value is not nil.
+func (fc *FileCreate) SetNillableCaption(s *string) *FileCreate {
+	if s != nil {
+		fc.SetCaption(*s)
+	}
+	return fc
+}
+
+// SetTags sets the "tags" field.
+func (fc *FileCreate) SetTags(s []string) *FileCreate {
+	fc.mutation.SetTags(s)
+	return fc
+}
+
 // SetUserID sets the "user_id" field.
 func (fc *FileCreate) SetUserID(i int) *FileCreate {
 	fc.mutation.SetUserID(i)
@@ -234,6 +345,14 @@ func (fc *FileCreate) defaults() {
 		v := file.DefaultUpdatedAt()
 		fc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := fc.mutation.Width(); !ok {
+		v := file.DefaultWidth
+		fc.mutation.SetWidth(v)
+	}
+	if _, ok := fc.mutation.Height(); !ok {
+		v := file.DefaultHeight
+		fc.mutation.SetHeight(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -250,12 +369,23 @@ func (fc *FileCreate) check() error {
 	if _, ok := fc.mutation.Path(); !ok {
 		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "File.path"`)}
 	}
+	if v, ok := fc.mutation.Path(); ok {
+		if err := file.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "File.path": %w`, err)}
+		}
+	}
 	if _, ok := fc.mutation.GetType(); !ok {
 		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "File.type"`)}
 	}
 	if _, ok := fc.mutation.Size(); !ok {
 		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "File.size"`)}
 	}
+	if _, ok := fc.mutation.Width(); !ok {
+		return &ValidationError{Name: "width", err: errors.New(`ent: missing required field "File.width"`)}
+	}
+	if _, ok := fc.mutation.Height(); !ok {
+		return &ValidationError{Name: "height", err: errors.New(`ent: missing required field "File.height"`)}
+	}
 	return nil
 }
 
@@ -340,6 +470,78 @@ func (fc *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 		})
 		_node.Size = value
 	}
+	if value, ok := fc.mutation.Hash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldHash,
+		})
+		_node.Hash = value
+	}
+	if value, ok := fc.mutation.Width(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: file.FieldWidth,
+		})
+		_node.Width = value
+	}
+	if value, ok := fc.mutation.Height(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: file.FieldHeight,
+		})
+		_node.Height = value
+	}
+	if value, ok := fc.mutation.Variants(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: file.FieldVariants,
+		})
+		_node.Variants = value
+	}
+	if value, ok := fc.mutation.OriginalName(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldOriginalName,
+		})
+		_node.OriginalName = value
+	}
+	if value, ok := fc.mutation.Title(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldTitle,
+		})
+		_node.Title = value
+	}
+	if value, ok := fc.mutation.Alt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldAlt,
+		})
+		_node.Alt = value
+	}
+	if value, ok := fc.mutation.Caption(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldCaption,
+		})
+		_node.Caption = value
+	}
+	if value, ok := fc.mutation.Tags(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: file.FieldTags,
+		})
+		_node.Tags = value
+	}
 	if nodes := fc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -567,6 +769,168 @@ func (u *FileUpsert) AddSize(v int) *FileUpsert {
 	return u
 }
 
+// SetHash sets the "hash" field.
+func (u *FileUpsert) SetHash(v string) *FileUpsert {
+	u.Set(file.FieldHash, v)
+	return u
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *FileUpsert) UpdateHash() *FileUpsert {
+	u.SetExcluded(file.FieldHash)
+	return u
+}
+
+// ClearHash clears the value of the "hash" field.
+func (u *FileUpsert) ClearHash() *FileUpsert {
+	u.SetNull(file.FieldHash)
+	return u
+}
+
+// SetWidth sets the "width" field.
+func (u *FileUpsert) SetWidth(v int) *FileUpsert {
+	u.Set(file.FieldWidth, v)
+	return u
+}
+
+// UpdateWidth sets the "width" field to the value that was provided on create.
+func (u *FileUpsert) UpdateWidth() *FileUpsert {
+	u.SetExcluded(file.FieldWidth)
+	return u
+}
+
+// AddWidth adds v to the "width" field.
+func (u *FileUpsert) AddWidth(v int) *FileUpsert {
+	u.Add(file.FieldWidth, v)
+	return u
+}
+
+// SetHeight sets the "height" field.
+func (u *FileUpsert) SetHeight(v int) *FileUpsert {
+	u.Set(file.FieldHeight, v)
+	return u
+}
+
+// UpdateHeight sets the "height" field to the value that was provided on create.
+func (u *FileUpsert) UpdateHeight() *FileUpsert {
+	u.SetExcluded(file.FieldHeight)
+	return u
+}
+
+// AddHeight adds v to the "height" field.
+func (u *FileUpsert) AddHeight(v int) *FileUpsert {
+	u.Add(file.FieldHeight, v)
+	return u
+}
+
+// SetVariants sets the "variants" field.
+func (u *FileUpsert) SetVariants(v []*fs.ImageVariant) *FileUpsert {
+	u.Set(file.FieldVariants, v)
+	return u
+}
+
+// UpdateVariants sets the "variants" field to the value that was provided on create.
+func (u *FileUpsert) UpdateVariants() *FileUpsert {
+	u.SetExcluded(file.FieldVariants)
+	return u
+}
+
+// ClearVariants clears the value of the "variants" field.
+func (u *FileUpsert) ClearVariants() *FileUpsert {
+	u.SetNull(file.FieldVariants)
+	return u
+}
+
+// SetOriginalName sets the "original_name" field.
+func (u *FileUpsert) SetOriginalName(v string) *FileUpsert {
+	u.Set(file.FieldOriginalName, v)
+	return u
+}
+
+// UpdateOriginalName sets the "original_name" field to the value that was provided on create.
+func (u *FileUpsert) UpdateOriginalName() *FileUpsert {
+	u.SetExcluded(file.FieldOriginalName)
+	return u
+}
+
+// ClearOriginalName clears the value of the "original_name" field.
+func (u *FileUpsert) ClearOriginalName() *FileUpsert {
+	u.SetNull(file.FieldOriginalName)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *FileUpsert) SetTitle(v string) *FileUpsert {
+	u.Set(file.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *FileUpsert) UpdateTitle() *FileUpsert {
+	u.SetExcluded(file.FieldTitle)
+	return u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *FileUpsert) ClearTitle() *FileUpsert {
+	u.SetNull(file.FieldTitle)
+	return u
+}
+
+// SetAlt sets the "alt" field.
+func (u *FileUpsert) SetAlt(v string) *FileUpsert {
+	u.Set(file.FieldAlt, v)
+	return u
+}
+
+// UpdateAlt sets the "alt" field to the value that was provided on create.
+func (u *FileUpsert) UpdateAlt() *FileUpsert {
+	u.SetExcluded(file.FieldAlt)
+	return u
+}
+
+// ClearAlt clears the value of the "alt" field.
+func (u *FileUpsert) ClearAlt() *FileUpsert {
+	u.SetNull(file.FieldAlt)
+	return u
+}
+
+// SetCaption sets the "caption" field.
+func (u *FileUpsert) SetCaption(v string) *FileUpsert {
+	u.Set(file.FieldCaption, v)
+	return u
+}
+
+// UpdateCaption sets the "caption" field to the value that was provided on create.
+func (u *FileUpsert) UpdateCaption() *FileUpsert {
+	u.SetExcluded(file.FieldCaption)
+	return u
+}
+
+// ClearCaption clears the value of the "caption" field.
+func (u *FileUpsert) ClearCaption() *FileUpsert {
+	u.SetNull(file.FieldCaption)
+	return u
+}
+
+// SetTags sets the "tags" field.
+func (u *FileUpsert) SetTags(v []string) *FileUpsert {
+	u.Set(file.FieldTags, v)
+	return u
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *FileUpsert) UpdateTags() *FileUpsert {
+	u.SetExcluded(file.FieldTags)
+	return u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *FileUpsert) ClearTags() *FileUpsert {
+	u.SetNull(file.FieldTags)
+	return u
+}
+
 // SetUserID sets the "user_id" field.
 func (u *FileUpsert) SetUserID(v int) *FileUpsert {
 	u.Set(file.FieldUserID, v)
@@ -744,6 +1108,195 @@ func (u *FileUpsertOne) UpdateSize() *FileUpsertOne {
 	})
 }
 
+// SetHash sets the "hash" field.
+func (u *FileUpsertOne) SetHash(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateHash() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// ClearHash clears the value of the "hash" field.
+func (u *FileUpsertOne) ClearHash() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearHash()
+	})
+}
+
+// SetWidth sets the "width" field.
+func (u *FileUpsertOne) SetWidth(v int) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetWidth(v)
+	})
+}
+
+// AddWidth adds v to the "width" field.
+func (u *FileUpsertOne) AddWidth(v int) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.AddWidth(v)
+	})
+}
+
+// UpdateWidth sets the "width" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateWidth() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateWidth()
+	})
+}
+
+// SetHeight sets the "height" field.
+func (u *FileUpsertOne) SetHeight(v int) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetHeight(v)
+	})
+}
+
+// AddHeight adds v to the "height" field.
+func (u *FileUpsertOne) AddHeight(v int) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.AddHeight(v)
+	})
+}
+
+// UpdateHeight sets the "height" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateHeight() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateHeight()
+	})
+}
+
+// SetVariants sets the "variants" field.
+func (u *FileUpsertOne) SetVariants(v []*fs.ImageVariant) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetVariants(v)
+	})
+}
+
+// UpdateVariants sets the "variants" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateVariants() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateVariants()
+	})
+}
+
+// ClearVariants clears the value of the "variants" field.
+func (u *FileUpsertOne) ClearVariants() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearVariants()
+	})
+}
+
+// SetOriginalName sets the "original_name" field.
+func (u *FileUpsertOne) SetOriginalName(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetOriginalName(v)
+	})
+}
+
+// UpdateOriginalName sets the "original_name" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateOriginalName() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateOriginalName()
+	})
+}
+
+// ClearOriginalName clears the value of the "original_name" field.
+func (u *FileUpsertOne) ClearOriginalName() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearOriginalName()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *FileUpsertOne) SetTitle(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateTitle() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *FileUpsertOne) ClearTitle() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetAlt sets the "alt" field.
+func (u *FileUpsertOne) SetAlt(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetAlt(v)
+	})
+}
+
+// UpdateAlt sets the "alt" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateAlt() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateAlt()
+	})
+}
+
+// ClearAlt clears the value of the "alt" field.
+func (u *FileUpsertOne) ClearAlt() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearAlt()
+	})
+}
+
+// SetCaption sets the "caption" field.
+func (u *FileUpsertOne) SetCaption(v string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetCaption(v)
+	})
+}
+
+// UpdateCaption sets the "caption" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateCaption() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateCaption()
+	})
+}
+
+// ClearCaption clears the value of the "caption" field.
+func (u *FileUpsertOne) ClearCaption() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearCaption()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *FileUpsertOne) SetTags(v []string) *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *FileUpsertOne) UpdateTags() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *FileUpsertOne) ClearTags() *FileUpsertOne {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearTags()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *FileUpsertOne) SetUserID(v int) *FileUpsertOne {
 	return u.Update(func(s *FileUpsert) {
@@ -1088,6 +1641,195 @@ func (u *FileUpsertBulk) UpdateSize() *FileUpsertBulk {
 	})
 }
 
+// SetHash sets the "hash" field.
+func (u *FileUpsertBulk) SetHash(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateHash() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// ClearHash clears the value of the "hash" field.
+func (u *FileUpsertBulk) ClearHash() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearHash()
+	})
+}
+
+// SetWidth sets the "width" field.
+func (u *FileUpsertBulk) SetWidth(v int) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetWidth(v)
+	})
+}
+
+// AddWidth adds v to the "width" field.
+func (u *FileUpsertBulk) AddWidth(v int) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.AddWidth(v)
+	})
+}
+
+// UpdateWidth sets the "width" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateWidth() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateWidth()
+	})
+}
+
+// SetHeight sets the "height" field.
+func (u *FileUpsertBulk) SetHeight(v int) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetHeight(v)
+	})
+}
+
+// AddHeight adds v to the "height" field.
+func (u *FileUpsertBulk) AddHeight(v int) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.AddHeight(v)
+	})
+}
+
+// UpdateHeight sets the "height" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateHeight() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateHeight()
+	})
+}
+
+// SetVariants sets the "variants" field.
+func (u *FileUpsertBulk) SetVariants(v []*fs.ImageVariant) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetVariants(v)
+	})
+}
+
+// UpdateVariants sets the "variants" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateVariants() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateVariants()
+	})
+}
+
+// ClearVariants clears the value of the "variants" field.
+func (u *FileUpsertBulk) ClearVariants() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearVariants()
+	})
+}
+
+// SetOriginalName sets the "original_name" field.
+func (u *FileUpsertBulk) SetOriginalName(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetOriginalName(v)
+	})
+}
+
+// UpdateOriginalName sets the "original_name" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateOriginalName() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateOriginalName()
+	})
+}
+
+// ClearOriginalName clears the value of the "original_name" field.
+func (u *FileUpsertBulk) ClearOriginalName() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearOriginalName()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *FileUpsertBulk) SetTitle(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateTitle() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *FileUpsertBulk) ClearTitle() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetAlt sets the "alt" field.
+func (u *FileUpsertBulk) SetAlt(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetAlt(v)
+	})
+}
+
+// UpdateAlt sets the "alt" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateAlt() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateAlt()
+	})
+}
+
+// ClearAlt clears the value of the "alt" field.
+func (u *FileUpsertBulk) ClearAlt() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearAlt()
+	})
+}
+
+// SetCaption sets the "caption" field.
+func (u *FileUpsertBulk) SetCaption(v string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetCaption(v)
+	})
+}
+
+// UpdateCaption sets the "caption" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateCaption() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateCaption()
+	})
+}
+
+// ClearCaption clears the value of the "caption" field.
+func (u *FileUpsertBulk) ClearCaption() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearCaption()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *FileUpsertBulk) SetTags(v []string) *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *FileUpsertBulk) UpdateTags() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *FileUpsertBulk) ClearTags() *FileUpsertBulk {
+	return u.Update(func(s *FileUpsert) {
+		s.ClearTags()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *FileUpsertBulk) SetUserID(v int) *FileUpsertBulk {
 	return u.Update(func(s *FileUpsert) {
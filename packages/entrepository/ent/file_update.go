@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/app/fs"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/file"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/page"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/post"
@@ -88,6 +89,172 @@ func (fu *FileUpdate) AddSize(i int) *FileUpdate {
 	return fu
 }
 
+// SetHash sets the "hash" field.
+func (fu *FileUpdate) SetHash(s string) *FileUpdate {
+	fu.mutation.SetHash(s)
+	return fu
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableHash(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetHash(*s)
+	}
+	return fu
+}
+
+// ClearHash clears the value of the "hash" field.
+func (fu *FileUpdate) ClearHash() *FileUpdate {
+	fu.mutation.ClearHash()
+	return fu
+}
+
+// SetWidth sets the "width" field.
+func (fu *FileUpdate) SetWidth(i int) *FileUpdate {
+	fu.mutation.ResetWidth()
+	fu.mutation.SetWidth(i)
+	return fu
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableWidth(i *int) *FileUpdate {
+	if i != nil {
+		fu.SetWidth(*i)
+	}
+	return fu
+}
+
+// AddWidth adds i to the "width" field.
+func (fu *FileUpdate) AddWidth(i int) *FileUpdate {
+	fu.mutation.AddWidth(i)
+	return fu
+}
+
+// SetHeight sets the "height" field.
+func (fu *FileUpdate) SetHeight(i int) *FileUpdate {
+	fu.mutation.ResetHeight()
+	fu.mutation.SetHeight(i)
+	return fu
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableHeight(i *int) *FileUpdate {
+	if i != nil {
+		fu.SetHeight(*i)
+	}
+	return fu
+}
+
+// AddHeight adds i to the "height" field.
+func (fu *FileUpdate) AddHeight(i int) *FileUpdate {
+	fu.mutation.AddHeight(i)
+	return fu
+}
+
+// SetVariants sets the "variants" field.
+func (fu *FileUpdate) SetVariants(fv []*fs.ImageVariant) *FileUpdate {
+	fu.mutation.SetVariants(fv)
+	return fu
+}
+
+// ClearVariants clears the value of the "variants" field.
+func (fu *FileUpdate) ClearVariants() *FileUpdate {
+	fu.mutation.ClearVariants()
+	return fu
+}
+
+// SetOriginalName sets the "original_name" field.
+func (fu *FileUpdate) SetOriginalName(s string) *FileUpdate {
+	fu.mutation.SetOriginalName(s)
+	return fu
+}
+
+// SetNillableOriginalName sets the "original_name" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableOriginalName(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetOriginalName(*s)
+	}
+	return fu
+}
+
+// ClearOriginalName clears the value of the "original_name" field.
+func (fu *FileUpdate) ClearOriginalName() *FileUpdate {
+	fu.mutation.ClearOriginalName()
+	return fu
+}
+
+// SetTitle sets the "title" field.
+func (fu *FileUpdate) SetTitle(s string) *FileUpdate {
+	fu.mutation.SetTitle(s)
+	return fu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableTitle(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetTitle(*s)
+	}
+	return fu
+}
+
+// ClearTitle clears the value of the "title" field.
+func (fu *FileUpdate) ClearTitle() *FileUpdate {
+	fu.mutation.ClearTitle()
+	return fu
+}
+
+// SetAlt sets the "alt" field.
+func (fu *FileUpdate) SetAlt(s string) *FileUpdate {
+	fu.mutation.SetAlt(s)
+	return fu
+}
+
+// SetNillableAlt sets the "alt" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableAlt(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetAlt(*s)
+	}
+	return fu
+}
+
+// ClearAlt clears the value of the "alt" field.
+func (fu *FileUpdate) ClearAlt() *FileUpdate {
+	fu.mutation.ClearAlt()
+	return fu
+}
+
+// SetCaption sets the "caption" field.
+func (fu *FileUpdate) SetCaption(s string) *FileUpdate {
+	fu.mutation.SetCaption(s)
+	return fu
+}
+
+// SetNillableCaption sets the "caption" field if the given value is not nil.
+func (fu *FileUpdate) SetNillableCaption(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetCaption(*s)
+	}
+	return fu
+}
+
+// ClearCaption clears the value of the "caption" field.
+func (fu *FileUpdate) ClearCaption() *FileUpdate {
+	fu.mutation.ClearCaption()
+	return fu
+}
+
+// SetTags sets the "tags" field.
+func (fu *FileUpdate) SetTags(s []string) *FileUpdate {
+	fu.mutation.SetTags(s)
+	return fu
+}
+
+// ClearTags clears the value of the "tags" field.
+func (fu *FileUpdate) ClearTags() *FileUpdate {
+	fu.mutation.ClearTags()
+	return fu
+}
+
 // SetUserID sets the "user_id" field.
 func (fu *FileUpdate) SetUserID(i int) *FileUpdate {
 	fu.mutation.SetUserID(i)
@@ -240,6 +407,9 @@ func (fu *FileUpdate) Save(ctx context.Context) (int, error) {
 	)
 	fu.defaults()
 	if len(fu.hooks) == 0 {
+		if err = fu.check(); err != nil {
+			return 0, err
+		}
 		affected, err = fu.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -247,6 +417,9 @@ func (fu *FileUpdate) Save(ctx context.Context) (int, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = fu.check(); err != nil {
+				return 0, err
+			}
 			fu.mutation = mutation
 			affected, err = fu.sqlSave(ctx)
 			mutation.done = true
@@ -295,6 +468,16 @@ func (fu *FileUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (fu *FileUpdate) check() error {
+	if v, ok := fu.mutation.Path(); ok {
+		if err := file.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "File.path": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -368,6 +551,125 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: file.FieldSize,
 		})
 	}
+	if value, ok := fu.mutation.Hash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldHash,
+		})
+	}
+	if fu.mutation.HashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: file.FieldHash,
+		})
+	}
+	if value, ok := fu.mutation.Width(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: file.FieldWidth,
+		})
+	}
+	if value, ok := fu.mutation.AddedWidth(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: file.FieldWidth,
+		})
+	}
+	if value, ok := fu.mutation.Height(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: file.FieldHeight,
+		})
+	}
+	if value, ok := fu.mutation.AddedHeight(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: file.FieldHeight,
+		})
+	}
+	if value, ok := fu.mutation.Variants(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: file.FieldVariants,
+		})
+	}
+	if fu.mutation.VariantsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: file.FieldVariants,
+		})
+	}
+	if value, ok := fu.mutation.OriginalName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldOriginalName,
+		})
+	}
+	if fu.mutation.OriginalNameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: file.FieldOriginalName,
+		})
+	}
+	if value, ok := fu.mutation.Title(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldTitle,
+		})
+	}
+	if fu.mutation.TitleCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: file.FieldTitle,
+		})
+	}
+	if value, ok := fu.mutation.Alt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldAlt,
+		})
+	}
+	if fu.mutation.AltCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: file.FieldAlt,
+		})
+	}
+	if value, ok := fu.mutation.Caption(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldCaption,
+		})
+	}
+	if fu.mutation.CaptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: file.FieldCaption,
+		})
+	}
+	if value, ok := fu.mutation.Tags(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: file.FieldTags,
+		})
+	}
+	if fu.mutation.TagsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: file.FieldTags,
+		})
+	}
 	if fu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -641,6 +943,172 @@ func (fuo *FileUpdateOne) AddSize(i int) *FileUpdateOne {
 	return fuo
 }
 
+// SetHash sets the "hash" field.
+func (fuo *FileUpdateOne) SetHash(s string) *FileUpdateOne {
+	fuo.mutation.SetHash(s)
+	return fuo
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableHash(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetHash(*s)
+	}
+	return fuo
+}
+
+// ClearHash clears the value of the "hash" field.
+func (fuo *FileUpdateOne) ClearHash() *FileUpdateOne {
+	fuo.mutation.ClearHash()
+	return fuo
+}
+
+// SetWidth sets the "width" field.
+func (fuo *FileUpdateOne) SetWidth(i int) *FileUpdateOne {
+	fuo.mutation.ResetWidth()
+	fuo.mutation.SetWidth(i)
+	return fuo
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableWidth(i *int) *FileUpdateOne {
+	if i != nil {
+		fuo.SetWidth(*i)
+	}
+	return fuo
+}
+
+// AddWidth adds i to the "width" field.
+func (fuo *FileUpdateOne) AddWidth(i int) *FileUpdateOne {
+	fuo.mutation.AddWidth(i)
+	return fuo
+}
+
+// SetHeight sets the "height" field.
+func (fuo *FileUpdateOne) SetHeight(i int) *FileUpdateOne {
+	fuo.mutation.ResetHeight()
+	fuo.mutation.SetHeight(i)
+	return fuo
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableHeight(i *int) *FileUpdateOne {
+	if i != nil {
+		fuo.SetHeight(*i)
+	}
+	return fuo
+}
+
+// AddHeight adds i to the "height" field.
+func (fuo *FileUpdateOne) AddHeight(i int) *FileUpdateOne {
+	fuo.mutation.AddHeight(i)
+	return fuo
+}
+
+// SetVariants sets the "variants" field.
+func (fuo *FileUpdateOne) SetVariants(fv []*fs.ImageVariant) *FileUpdateOne {
+	fuo.mutation.SetVariants(fv)
+	return fuo
+}
+
+// ClearVariants clears the value of the "variants" field.
+func (fuo *FileUpdateOne) ClearVariants() *FileUpdateOne {
+	fuo.mutation.ClearVariants()
+	return fuo
+}
+
+// SetOriginalName sets the "original_name" field.
+func (fuo *FileUpdateOne) SetOriginalName(s string) *FileUpdateOne {
+	fuo.mutation.SetOriginalName(s)
+	return fuo
+}
+
+// SetNillableOriginalName sets the "original_name" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableOriginalName(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetOriginalName(*s)
+	}
+	return fuo
+}
+
+// ClearOriginalName clears the value of the "original_name" field.
+func (fuo *FileUpdateOne) ClearOriginalName() *FileUpdateOne {
+	fuo.mutation.ClearOriginalName()
+	return fuo
+}
+
+// SetTitle sets the "title" field.
+func (fuo *FileUpdateOne) SetTitle(s string) *FileUpdateOne {
+	fuo.mutation.SetTitle(s)
+	return fuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableTitle(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetTitle(*s)
+	}
+	return fuo
+}
+
+// ClearTitle clears the value of the "title" field.
+func (fuo *FileUpdateOne) ClearTitle() *FileUpdateOne {
+	fuo.mutation.ClearTitle()
+	return fuo
+}
+
+// SetAlt sets the "alt" field.
+func (fuo *FileUpdateOne) SetAlt(s string) *FileUpdateOne {
+	fuo.mutation.SetAlt(s)
+	return fuo
+}
+
+// SetNillableAlt sets the "alt" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableAlt(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetAlt(*s)
+	}
+	return fuo
+}
+
+// ClearAlt clears the value of the "alt" field.
+func (fuo *FileUpdateOne) ClearAlt() *FileUpdateOne {
+	fuo.mutation.ClearAlt()
+	return fuo
+}
+
+// SetCaption sets the "caption" field.
+func (fuo *FileUpdateOne) SetCaption(s string) *FileUpdateOne {
+	fuo.mutation.SetCaption(s)
+	return fuo
+}
+
+// SetNillableCaption sets the "caption" field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableCaption(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetCaption(*s)
+	}
+	return fuo
+}
+
+// ClearCaption clears the value of the "caption" field.
+func (fuo *FileUpdateOne) ClearCaption() *FileUpdateOne {
+	fuo.mutation.ClearCaption()
+	return fuo
+}
+
+// SetTags sets the "tags" field.
+func (fuo *FileUpdateOne) SetTags(s []string) *FileUpdateOne {
+	fuo.mutation.SetTags(s)
+	return fuo
+}
+
+// ClearTags clears the value of the "tags" field.
+func (fuo *FileUpdateOne) ClearTags() *FileUpdateOne {
+	fuo.mutation.ClearTags()
+	return fuo
+}
+
 // SetUserID sets the "user_id" field.
 func (fuo *FileUpdateOne) SetUserID(i int) *FileUpdateOne {
 	fuo.mutation.SetUserID(i)
@@ -800,6 +1268,9 @@ func (fuo *FileUpdateOne) Save(ctx context.Context) (*File, error) {
 	)
 	fuo.defaults()
 	if len(fuo.hooks) == 0 {
+		if err = fuo.check(); err != nil {
+			return nil, err
+		}
 		node, err = fuo.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -807,6 +1278,9 @@ func (fuo *FileUpdateOne) Save(ctx context.Context) (*File, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = fuo.check(); err != nil {
+				return nil, err
+			}
 			fuo.mutation = mutation
 			node, err = fuo.sqlSave(ctx)
 			mutation.done = true
@@ -855,6 +1329,16 @@ func (fuo *FileUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (fuo *FileUpdateOne) check() error {
+	if v, ok := fuo.mutation.Path(); ok {
+		if err := file.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "File.path": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -945,6 +1429,125 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error)
 			Column: file.FieldSize,
 		})
 	}
+	if value, ok := fuo.mutation.Hash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldHash,
+		})
+	}
+	if fuo.mutation.HashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: file.FieldHash,
+		})
+	}
+	if value, ok := fuo.mutation.Width(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: file.FieldWidth,
+		})
+	}
+	if value, ok := fuo.mutation.AddedWidth(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: file.FieldWidth,
+		})
+	}
+	if value, ok := fuo.mutation.Height(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: file.FieldHeight,
+		})
+	}
+	if value, ok := fuo.mutation.AddedHeight(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: file.FieldHeight,
+		})
+	}
+	if value, ok := fuo.mutation.Variants(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: file.FieldVariants,
+		})
+	}
+	if fuo.mutation.VariantsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: file.FieldVariants,
+		})
+	}
+	if value, ok := fuo.mutation.OriginalName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldOriginalName,
+		})
+	}
+	if fuo.mutation.OriginalNameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: file.FieldOriginalName,
+		})
+	}
+	if value, ok := fuo.mutation.Title(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldTitle,
+		})
+	}
+	if fuo.mutation.TitleCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: file.FieldTitle,
+		})
+	}
+	if value, ok := fuo.mutation.Alt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldAlt,
+		})
+	}
+	if fuo.mutation.AltCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: file.FieldAlt,
+		})
+	}
+	if value, ok := fuo.mutation.Caption(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldCaption,
+		})
+	}
+	if fuo.mutation.CaptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: file.FieldCaption,
+		})
+	}
+	if value, ok := fuo.mutation.Tags(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: file.FieldTags,
+		})
+	}
+	if fuo.mutation.TagsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: file.FieldTags,
+		})
+	}
 	if fuo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
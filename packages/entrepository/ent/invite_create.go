@@ -0,0 +1,1212 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/invite"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/role"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/user"
+)
+
+// InviteCreate is the builder for creating a Invite entity.
+type InviteCreate struct {
+	config
+	mutation *InviteMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ic *InviteCreate) SetCreatedAt(t time.Time) *InviteCreate {
+	ic.mutation.SetCreatedAt(t)
+	return ic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableCreatedAt(t *time.Time) *InviteCreate {
+	if t != nil {
+		ic.SetCreatedAt(*t)
+	}
+	return ic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ic *InviteCreate) SetUpdatedAt(t time.Time) *InviteCreate {
+	ic.mutation.SetUpdatedAt(t)
+	return ic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableUpdatedAt(t *time.Time) *InviteCreate {
+	if t != nil {
+		ic.SetUpdatedAt(*t)
+	}
+	return ic
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ic *InviteCreate) SetDeletedAt(t time.Time) *InviteCreate {
+	ic.mutation.SetDeletedAt(t)
+	return ic
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableDeletedAt(t *time.Time) *InviteCreate {
+	if t != nil {
+		ic.SetDeletedAt(*t)
+	}
+	return ic
+}
+
+// SetCode sets the "code" field.
+func (ic *InviteCreate) SetCode(s string) *InviteCreate {
+	ic.mutation.SetCode(s)
+	return ic
+}
+
+// SetUserID sets the "user_id" field.
+func (ic *InviteCreate) SetUserID(i int) *InviteCreate {
+	ic.mutation.SetUserID(i)
+	return ic
+}
+
+// SetRoleID sets the "role_id" field.
+func (ic *InviteCreate) SetRoleID(i int) *InviteCreate {
+	ic.mutation.SetRoleID(i)
+	return ic
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableRoleID(i *int) *InviteCreate {
+	if i != nil {
+		ic.SetRoleID(*i)
+	}
+	return ic
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (ic *InviteCreate) SetMaxUses(i int) *InviteCreate {
+	ic.mutation.SetMaxUses(i)
+	return ic
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableMaxUses(i *int) *InviteCreate {
+	if i != nil {
+		ic.SetMaxUses(*i)
+	}
+	return ic
+}
+
+// SetUsed sets the "used" field.
+func (ic *InviteCreate) SetUsed(i int) *InviteCreate {
+	ic.mutation.SetUsed(i)
+	return ic
+}
+
+// SetNillableUsed sets the "used" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableUsed(i *int) *InviteCreate {
+	if i != nil {
+		ic.SetUsed(*i)
+	}
+	return ic
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ic *InviteCreate) SetExpiresAt(t time.Time) *InviteCreate {
+	ic.mutation.SetExpiresAt(t)
+	return ic
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (ic *InviteCreate) SetNillableExpiresAt(t *time.Time) *InviteCreate {
+	if t != nil {
+		ic.SetExpiresAt(*t)
+	}
+	return ic
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ic *InviteCreate) SetUser(u *User) *InviteCreate {
+	return ic.SetUserID(u.ID)
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (ic *InviteCreate) SetRole(r *Role) *InviteCreate {
+	return ic.SetRoleID(r.ID)
+}
+
+// AddInvitedUserIDs adds the "invited_users" edge to the User entity by IDs.
+func (ic *InviteCreate) AddInvitedUserIDs(ids ...int) *InviteCreate {
+	ic.mutation.AddInvitedUserIDs(ids...)
+	return ic
+}
+
+// AddInvitedUsers adds the "invited_users" edges to the User entity.
+func (ic *InviteCreate) AddInvitedUsers(u ...*User) *InviteCreate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ic.AddInvitedUserIDs(ids...)
+}
+
+// Mutation returns the InviteMutation object of the builder.
+func (ic *InviteCreate) Mutation() *InviteMutation {
+	return ic.mutation
+}
+
+// Save creates the Invite in the database.
+func (ic *InviteCreate) Save(ctx context.Context) (*Invite, error) {
+	var (
+		err  error
+		node *Invite
+	)
+	ic.defaults()
+	if len(ic.hooks) == 0 {
+		if err = ic.check(); err != nil {
+			return nil, err
+		}
+		node, err = ic.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InviteMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ic.check(); err != nil {
+				return nil, err
+			}
+			ic.mutation = mutation
+			if node, err = ic.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ic.hooks) - 1; i >= 0; i-- {
+			if ic.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ic.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ic.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *InviteCreate) SaveX(ctx context.Context) *Invite {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *InviteCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *InviteCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *InviteCreate) defaults() {
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		v := invite.DefaultCreatedAt()
+		ic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ic.mutation.UpdatedAt(); !ok {
+		v := invite.DefaultUpdatedAt()
+		ic.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ic.mutation.MaxUses(); !ok {
+		v := invite.DefaultMaxUses
+		ic.mutation.SetMaxUses(v)
+	}
+	if _, ok := ic.mutation.Used(); !ok {
+		v := invite.DefaultUsed
+		ic.mutation.SetUsed(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *InviteCreate) check() error {
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Invite.created_at"`)}
+	}
+	if _, ok := ic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Invite.updated_at"`)}
+	}
+	if _, ok := ic.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "Invite.code"`)}
+	}
+	if v, ok := ic.mutation.Code(); ok {
+		if err := invite.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Invite.code": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Invite.user_id"`)}
+	}
+	if _, ok := ic.mutation.MaxUses(); !ok {
+		return &ValidationError{Name: "max_uses", err: errors.New(`ent: missing required field "Invite.max_uses"`)}
+	}
+	if _, ok := ic.mutation.Used(); !ok {
+		return &ValidationError{Name: "used", err: errors.New(`ent: missing required field "Invite.used"`)}
+	}
+	if _, ok := ic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Invite.user"`)}
+	}
+	return nil
+}
+
+func (ic *InviteCreate) sqlSave(ctx context.Context) (*Invite, error) {
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (ic *InviteCreate) createSpec() (*Invite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invite{config: ic.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: invite.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invite.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = ic.conflict
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invite.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := ic.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invite.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := ic.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invite.FieldDeletedAt,
+		})
+		_node.DeletedAt = value
+	}
+	if value, ok := ic.mutation.Code(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invite.FieldCode,
+		})
+		_node.Code = value
+	}
+	if value, ok := ic.mutation.MaxUses(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invite.FieldMaxUses,
+		})
+		_node.MaxUses = value
+	}
+	if value, ok := ic.mutation.Used(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invite.FieldUsed,
+		})
+		_node.Used = value
+	}
+	if value, ok := ic.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invite.FieldExpiresAt,
+		})
+		_node.ExpiresAt = &value
+	}
+	if nodes := ic.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.UserTable,
+			Columns: []string{invite.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.RoleTable,
+			Columns: []string{invite.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: role.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.InvitedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invite.InvitedUsersTable,
+			Columns: []string{invite.InvitedUsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invite.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InviteUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (ic *InviteCreate) OnConflict(opts ...sql.ConflictOption) *InviteUpsertOne {
+	ic.conflict = opts
+	return &InviteUpsertOne{
+		create: ic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invite.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (ic *InviteCreate) OnConflictColumns(columns ...string) *InviteUpsertOne {
+	ic.conflict = append(ic.conflict, sql.ConflictColumns(columns...))
+	return &InviteUpsertOne{
+		create: ic,
+	}
+}
+
+type (
+	// InviteUpsertOne is the builder for "upsert"-ing
+	//  one Invite node.
+	InviteUpsertOne struct {
+		create *InviteCreate
+	}
+
+	// InviteUpsert is the "OnConflict" setter.
+	InviteUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *InviteUpsert) SetCreatedAt(v time.Time) *InviteUpsert {
+	u.Set(invite.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *InviteUpsert) UpdateCreatedAt() *InviteUpsert {
+	u.SetExcluded(invite.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InviteUpsert) SetUpdatedAt(v time.Time) *InviteUpsert {
+	u.Set(invite.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InviteUpsert) UpdateUpdatedAt() *InviteUpsert {
+	u.SetExcluded(invite.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *InviteUpsert) SetDeletedAt(v time.Time) *InviteUpsert {
+	u.Set(invite.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *InviteUpsert) UpdateDeletedAt() *InviteUpsert {
+	u.SetExcluded(invite.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *InviteUpsert) ClearDeletedAt() *InviteUpsert {
+	u.SetNull(invite.FieldDeletedAt)
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *InviteUpsert) SetCode(v string) *InviteUpsert {
+	u.Set(invite.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *InviteUpsert) UpdateCode() *InviteUpsert {
+	u.SetExcluded(invite.FieldCode)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *InviteUpsert) SetUserID(v int) *InviteUpsert {
+	u.Set(invite.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *InviteUpsert) UpdateUserID() *InviteUpsert {
+	u.SetExcluded(invite.FieldUserID)
+	return u
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *InviteUpsert) SetRoleID(v int) *InviteUpsert {
+	u.Set(invite.FieldRoleID, v)
+	return u
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *InviteUpsert) UpdateRoleID() *InviteUpsert {
+	u.SetExcluded(invite.FieldRoleID)
+	return u
+}
+
+// ClearRoleID clears the value of the "role_id" field.
+func (u *InviteUpsert) ClearRoleID() *InviteUpsert {
+	u.SetNull(invite.FieldRoleID)
+	return u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *InviteUpsert) SetMaxUses(v int) *InviteUpsert {
+	u.Set(invite.FieldMaxUses, v)
+	return u
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *InviteUpsert) UpdateMaxUses() *InviteUpsert {
+	u.SetExcluded(invite.FieldMaxUses)
+	return u
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *InviteUpsert) AddMaxUses(v int) *InviteUpsert {
+	u.Add(invite.FieldMaxUses, v)
+	return u
+}
+
+// SetUsed sets the "used" field.
+func (u *InviteUpsert) SetUsed(v int) *InviteUpsert {
+	u.Set(invite.FieldUsed, v)
+	return u
+}
+
+// UpdateUsed sets the "used" field to the value that was provided on create.
+func (u *InviteUpsert) UpdateUsed() *InviteUpsert {
+	u.SetExcluded(invite.FieldUsed)
+	return u
+}
+
+// AddUsed adds v to the "used" field.
+func (u *InviteUpsert) AddUsed(v int) *InviteUpsert {
+	u.Add(invite.FieldUsed, v)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *InviteUpsert) SetExpiresAt(v time.Time) *InviteUpsert {
+	u.Set(invite.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *InviteUpsert) UpdateExpiresAt() *InviteUpsert {
+	u.SetExcluded(invite.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *InviteUpsert) ClearExpiresAt() *InviteUpsert {
+	u.SetNull(invite.FieldExpiresAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Invite.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *InviteUpsertOne) UpdateNewValues() *InviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(invite.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//  client.Invite.Create().
+//      OnConflict(sql.ResolveWithIgnore()).
+//      Exec(ctx)
+//
+func (u *InviteUpsertOne) Ignore() *InviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InviteUpsertOne) DoNothing() *InviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InviteCreate.OnConflict
+// documentation for more info.
+func (u *InviteUpsertOne) Update(set func(*InviteUpsert)) *InviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InviteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *InviteUpsertOne) SetCreatedAt(v time.Time) *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *InviteUpsertOne) UpdateCreatedAt() *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InviteUpsertOne) SetUpdatedAt(v time.Time) *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InviteUpsertOne) UpdateUpdatedAt() *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *InviteUpsertOne) SetDeletedAt(v time.Time) *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *InviteUpsertOne) UpdateDeletedAt() *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *InviteUpsertOne) ClearDeletedAt() *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *InviteUpsertOne) SetCode(v string) *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *InviteUpsertOne) UpdateCode() *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *InviteUpsertOne) SetUserID(v int) *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *InviteUpsertOne) UpdateUserID() *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *InviteUpsertOne) SetRoleID(v int) *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetRoleID(v)
+	})
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *InviteUpsertOne) UpdateRoleID() *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateRoleID()
+	})
+}
+
+// ClearRoleID clears the value of the "role_id" field.
+func (u *InviteUpsertOne) ClearRoleID() *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.ClearRoleID()
+	})
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *InviteUpsertOne) SetMaxUses(v int) *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetMaxUses(v)
+	})
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *InviteUpsertOne) AddMaxUses(v int) *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.AddMaxUses(v)
+	})
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *InviteUpsertOne) UpdateMaxUses() *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateMaxUses()
+	})
+}
+
+// SetUsed sets the "used" field.
+func (u *InviteUpsertOne) SetUsed(v int) *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetUsed(v)
+	})
+}
+
+// AddUsed adds v to the "used" field.
+func (u *InviteUpsertOne) AddUsed(v int) *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.AddUsed(v)
+	})
+}
+
+// UpdateUsed sets the "used" field to the value that was provided on create.
+func (u *InviteUpsertOne) UpdateUsed() *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateUsed()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *InviteUpsertOne) SetExpiresAt(v time.Time) *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *InviteUpsertOne) UpdateExpiresAt() *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *InviteUpsertOne) ClearExpiresAt() *InviteUpsertOne {
+	return u.Update(func(s *InviteUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InviteUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InviteCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InviteUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InviteUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InviteUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InviteCreateBulk is the builder for creating many Invite entities in bulk.
+type InviteCreateBulk struct {
+	config
+	builders []*InviteCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Invite entities in the database.
+func (icb *InviteCreateBulk) Save(ctx context.Context) ([]*Invite, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Invite, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InviteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = icb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *InviteCreateBulk) SaveX(ctx context.Context) []*Invite {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *InviteCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *InviteCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invite.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InviteUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (icb *InviteCreateBulk) OnConflict(opts ...sql.ConflictOption) *InviteUpsertBulk {
+	icb.conflict = opts
+	return &InviteUpsertBulk{
+		create: icb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invite.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (icb *InviteCreateBulk) OnConflictColumns(columns ...string) *InviteUpsertBulk {
+	icb.conflict = append(icb.conflict, sql.ConflictColumns(columns...))
+	return &InviteUpsertBulk{
+		create: icb,
+	}
+}
+
+// InviteUpsertBulk is the builder for "upsert"-ing
+// a bulk of Invite nodes.
+type InviteUpsertBulk struct {
+	create *InviteCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Invite.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *InviteUpsertBulk) UpdateNewValues() *InviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(invite.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invite.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *InviteUpsertBulk) Ignore() *InviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InviteUpsertBulk) DoNothing() *InviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InviteCreateBulk.OnConflict
+// documentation for more info.
+func (u *InviteUpsertBulk) Update(set func(*InviteUpsert)) *InviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InviteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *InviteUpsertBulk) SetCreatedAt(v time.Time) *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *InviteUpsertBulk) UpdateCreatedAt() *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InviteUpsertBulk) SetUpdatedAt(v time.Time) *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InviteUpsertBulk) UpdateUpdatedAt() *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *InviteUpsertBulk) SetDeletedAt(v time.Time) *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *InviteUpsertBulk) UpdateDeletedAt() *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *InviteUpsertBulk) ClearDeletedAt() *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *InviteUpsertBulk) SetCode(v string) *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *InviteUpsertBulk) UpdateCode() *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *InviteUpsertBulk) SetUserID(v int) *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *InviteUpsertBulk) UpdateUserID() *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *InviteUpsertBulk) SetRoleID(v int) *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetRoleID(v)
+	})
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *InviteUpsertBulk) UpdateRoleID() *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateRoleID()
+	})
+}
+
+// ClearRoleID clears the value of the "role_id" field.
+func (u *InviteUpsertBulk) ClearRoleID() *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.ClearRoleID()
+	})
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *InviteUpsertBulk) SetMaxUses(v int) *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetMaxUses(v)
+	})
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *InviteUpsertBulk) AddMaxUses(v int) *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.AddMaxUses(v)
+	})
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *InviteUpsertBulk) UpdateMaxUses() *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateMaxUses()
+	})
+}
+
+// SetUsed sets the "used" field.
+func (u *InviteUpsertBulk) SetUsed(v int) *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetUsed(v)
+	})
+}
+
+// AddUsed adds v to the "used" field.
+func (u *InviteUpsertBulk) AddUsed(v int) *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.AddUsed(v)
+	})
+}
+
+// UpdateUsed sets the "used" field to the value that was provided on create.
+func (u *InviteUpsertBulk) UpdateUsed() *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateUsed()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *InviteUpsertBulk) SetExpiresAt(v time.Time) *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *InviteUpsertBulk) UpdateExpiresAt() *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *InviteUpsertBulk) ClearExpiresAt() *InviteUpsertBulk {
+	return u.Update(func(s *InviteUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InviteUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InviteCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InviteCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InviteUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1038 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/invite"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/predicate"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/role"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/user"
+)
+
+// InviteUpdate is the builder for updating Invite entities.
+type InviteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InviteMutation
+}
+
+// Where appends a list predicates to the InviteUpdate builder.
+func (iu *InviteUpdate) Where(ps ...predicate.Invite) *InviteUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iu *InviteUpdate) SetUpdatedAt(t time.Time) *InviteUpdate {
+	iu.mutation.SetUpdatedAt(t)
+	return iu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (iu *InviteUpdate) SetDeletedAt(t time.Time) *InviteUpdate {
+	iu.mutation.SetDeletedAt(t)
+	return iu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableDeletedAt(t *time.Time) *InviteUpdate {
+	if t != nil {
+		iu.SetDeletedAt(*t)
+	}
+	return iu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (iu *InviteUpdate) ClearDeletedAt() *InviteUpdate {
+	iu.mutation.ClearDeletedAt()
+	return iu
+}
+
+// SetCode sets the "code" field.
+func (iu *InviteUpdate) SetCode(s string) *InviteUpdate {
+	iu.mutation.SetCode(s)
+	return iu
+}
+
+// SetUserID sets the "user_id" field.
+func (iu *InviteUpdate) SetUserID(i int) *InviteUpdate {
+	iu.mutation.SetUserID(i)
+	return iu
+}
+
+// SetRoleID sets the "role_id" field.
+func (iu *InviteUpdate) SetRoleID(i int) *InviteUpdate {
+	iu.mutation.SetRoleID(i)
+	return iu
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableRoleID(i *int) *InviteUpdate {
+	if i != nil {
+		iu.SetRoleID(*i)
+	}
+	return iu
+}
+
+// ClearRoleID clears the value of the "role_id" field.
+func (iu *InviteUpdate) ClearRoleID() *InviteUpdate {
+	iu.mutation.ClearRoleID()
+	return iu
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (iu *InviteUpdate) SetMaxUses(i int) *InviteUpdate {
+	iu.mutation.ResetMaxUses()
+	iu.mutation.SetMaxUses(i)
+	return iu
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableMaxUses(i *int) *InviteUpdate {
+	if i != nil {
+		iu.SetMaxUses(*i)
+	}
+	return iu
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (iu *InviteUpdate) AddMaxUses(i int) *InviteUpdate {
+	iu.mutation.AddMaxUses(i)
+	return iu
+}
+
+// SetUsed sets the "used" field.
+func (iu *InviteUpdate) SetUsed(i int) *InviteUpdate {
+	iu.mutation.ResetUsed()
+	iu.mutation.SetUsed(i)
+	return iu
+}
+
+// SetNillableUsed sets the "used" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableUsed(i *int) *InviteUpdate {
+	if i != nil {
+		iu.SetUsed(*i)
+	}
+	return iu
+}
+
+// AddUsed adds i to the "used" field.
+func (iu *InviteUpdate) AddUsed(i int) *InviteUpdate {
+	iu.mutation.AddUsed(i)
+	return iu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iu *InviteUpdate) SetExpiresAt(t time.Time) *InviteUpdate {
+	iu.mutation.SetExpiresAt(t)
+	return iu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (iu *InviteUpdate) SetNillableExpiresAt(t *time.Time) *InviteUpdate {
+	if t != nil {
+		iu.SetExpiresAt(*t)
+	}
+	return iu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (iu *InviteUpdate) ClearExpiresAt() *InviteUpdate {
+	iu.mutation.ClearExpiresAt()
+	return iu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (iu *InviteUpdate) SetUser(u *User) *InviteUpdate {
+	return iu.SetUserID(u.ID)
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (iu *InviteUpdate) SetRole(r *Role) *InviteUpdate {
+	return iu.SetRoleID(r.ID)
+}
+
+// AddInvitedUserIDs adds the "invited_users" edge to the User entity by IDs.
+func (iu *InviteUpdate) AddInvitedUserIDs(ids ...int) *InviteUpdate {
+	iu.mutation.AddInvitedUserIDs(ids...)
+	return iu
+}
+
+// AddInvitedUsers adds the "invited_users" edges to the User entity.
+func (iu *InviteUpdate) AddInvitedUsers(u ...*User) *InviteUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return iu.AddInvitedUserIDs(ids...)
+}
+
+// Mutation returns the InviteMutation object of the builder.
+func (iu *InviteUpdate) Mutation() *InviteMutation {
+	return iu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (iu *InviteUpdate) ClearUser() *InviteUpdate {
+	iu.mutation.ClearUser()
+	return iu
+}
+
+// ClearRole clears the "role" edge to the Role entity.
+func (iu *InviteUpdate) ClearRole() *InviteUpdate {
+	iu.mutation.ClearRole()
+	return iu
+}
+
+// ClearInvitedUsers clears all "invited_users" edges to the User entity.
+func (iu *InviteUpdate) ClearInvitedUsers() *InviteUpdate {
+	iu.mutation.ClearInvitedUsers()
+	return iu
+}
+
+// RemoveInvitedUserIDs removes the "invited_users" edge to User entities by IDs.
+func (iu *InviteUpdate) RemoveInvitedUserIDs(ids ...int) *InviteUpdate {
+	iu.mutation.RemoveInvitedUserIDs(ids...)
+	return iu
+}
+
+// RemoveInvitedUsers removes "invited_users" edges to User entities.
+func (iu *InviteUpdate) RemoveInvitedUsers(u ...*User) *InviteUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return iu.RemoveInvitedUserIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *InviteUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	iu.defaults()
+	if len(iu.hooks) == 0 {
+		if err = iu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = iu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InviteMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = iu.check(); err != nil {
+				return 0, err
+			}
+			iu.mutation = mutation
+			affected, err = iu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(iu.hooks) - 1; i >= 0; i-- {
+			if iu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InviteUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InviteUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InviteUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iu *InviteUpdate) defaults() {
+	if _, ok := iu.mutation.UpdatedAt(); !ok {
+		v := invite.UpdateDefaultUpdatedAt()
+		iu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iu *InviteUpdate) check() error {
+	if v, ok := iu.mutation.Code(); ok {
+		if err := invite.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Invite.code": %w`, err)}
+		}
+	}
+	if _, ok := iu.mutation.UserID(); iu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Invite.user"`)
+	}
+	return nil
+}
+
+func (iu *InviteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invite.Table,
+			Columns: invite.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invite.FieldID,
+			},
+		},
+	}
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invite.FieldUpdatedAt,
+		})
+	}
+	if value, ok := iu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invite.FieldDeletedAt,
+		})
+	}
+	if iu.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: invite.FieldDeletedAt,
+		})
+	}
+	if value, ok := iu.mutation.Code(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invite.FieldCode,
+		})
+	}
+	if value, ok := iu.mutation.MaxUses(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invite.FieldMaxUses,
+		})
+	}
+	if value, ok := iu.mutation.AddedMaxUses(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invite.FieldMaxUses,
+		})
+	}
+	if value, ok := iu.mutation.Used(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invite.FieldUsed,
+		})
+	}
+	if value, ok := iu.mutation.AddedUsed(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invite.FieldUsed,
+		})
+	}
+	if value, ok := iu.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invite.FieldExpiresAt,
+		})
+	}
+	if iu.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: invite.FieldExpiresAt,
+		})
+	}
+	if iu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.UserTable,
+			Columns: []string{invite.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.UserTable,
+			Columns: []string{invite.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.RoleTable,
+			Columns: []string{invite.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: role.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.RoleTable,
+			Columns: []string{invite.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: role.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.InvitedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invite.InvitedUsersTable,
+			Columns: []string{invite.InvitedUsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedInvitedUsersIDs(); len(nodes) > 0 && !iu.mutation.InvitedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invite.InvitedUsersTable,
+			Columns: []string{invite.InvitedUsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.InvitedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invite.InvitedUsersTable,
+			Columns: []string{invite.InvitedUsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// InviteUpdateOne is the builder for updating a single Invite entity.
+type InviteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InviteMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iuo *InviteUpdateOne) SetUpdatedAt(t time.Time) *InviteUpdateOne {
+	iuo.mutation.SetUpdatedAt(t)
+	return iuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (iuo *InviteUpdateOne) SetDeletedAt(t time.Time) *InviteUpdateOne {
+	iuo.mutation.SetDeletedAt(t)
+	return iuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableDeletedAt(t *time.Time) *InviteUpdateOne {
+	if t != nil {
+		iuo.SetDeletedAt(*t)
+	}
+	return iuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (iuo *InviteUpdateOne) ClearDeletedAt() *InviteUpdateOne {
+	iuo.mutation.ClearDeletedAt()
+	return iuo
+}
+
+// SetCode sets the "code" field.
+func (iuo *InviteUpdateOne) SetCode(s string) *InviteUpdateOne {
+	iuo.mutation.SetCode(s)
+	return iuo
+}
+
+// SetUserID sets the "user_id" field.
+func (iuo *InviteUpdateOne) SetUserID(i int) *InviteUpdateOne {
+	iuo.mutation.SetUserID(i)
+	return iuo
+}
+
+// SetRoleID sets the "role_id" field.
+func (iuo *InviteUpdateOne) SetRoleID(i int) *InviteUpdateOne {
+	iuo.mutation.SetRoleID(i)
+	return iuo
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableRoleID(i *int) *InviteUpdateOne {
+	if i != nil {
+		iuo.SetRoleID(*i)
+	}
+	return iuo
+}
+
+// ClearRoleID clears the value of the "role_id" field.
+func (iuo *InviteUpdateOne) ClearRoleID() *InviteUpdateOne {
+	iuo.mutation.ClearRoleID()
+	return iuo
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (iuo *InviteUpdateOne) SetMaxUses(i int) *InviteUpdateOne {
+	iuo.mutation.ResetMaxUses()
+	iuo.mutation.SetMaxUses(i)
+	return iuo
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableMaxUses(i *int) *InviteUpdateOne {
+	if i != nil {
+		iuo.SetMaxUses(*i)
+	}
+	return iuo
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (iuo *InviteUpdateOne) AddMaxUses(i int) *InviteUpdateOne {
+	iuo.mutation.AddMaxUses(i)
+	return iuo
+}
+
+// SetUsed sets the "used" field.
+func (iuo *InviteUpdateOne) SetUsed(i int) *InviteUpdateOne {
+	iuo.mutation.ResetUsed()
+	iuo.mutation.SetUsed(i)
+	return iuo
+}
+
+// SetNillableUsed sets the "used" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableUsed(i *int) *InviteUpdateOne {
+	if i != nil {
+		iuo.SetUsed(*i)
+	}
+	return iuo
+}
+
+// AddUsed adds i to the "used" field.
+func (iuo *InviteUpdateOne) AddUsed(i int) *InviteUpdateOne {
+	iuo.mutation.AddUsed(i)
+	return iuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iuo *InviteUpdateOne) SetExpiresAt(t time.Time) *InviteUpdateOne {
+	iuo.mutation.SetExpiresAt(t)
+	return iuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (iuo *InviteUpdateOne) SetNillableExpiresAt(t *time.Time) *InviteUpdateOne {
+	if t != nil {
+		iuo.SetExpiresAt(*t)
+	}
+	return iuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (iuo *InviteUpdateOne) ClearExpiresAt() *InviteUpdateOne {
+	iuo.mutation.ClearExpiresAt()
+	return iuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (iuo *InviteUpdateOne) SetUser(u *User) *InviteUpdateOne {
+	return iuo.SetUserID(u.ID)
+}
+
+// SetRole sets the "role" edge to the Role entity.
+func (iuo *InviteUpdateOne) SetRole(r *Role) *InviteUpdateOne {
+	return iuo.SetRoleID(r.ID)
+}
+
+// AddInvitedUserIDs adds the "invited_users" edge to the User entity by IDs.
+func (iuo *InviteUpdateOne) AddInvitedUserIDs(ids ...int) *InviteUpdateOne {
+	iuo.mutation.AddInvitedUserIDs(ids...)
+	return iuo
+}
+
+// AddInvitedUsers adds the "invited_users" edges to the User entity.
+func (iuo *InviteUpdateOne) AddInvitedUsers(u ...*User) *InviteUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return iuo.AddInvitedUserIDs(ids...)
+}
+
+// Mutation returns the InviteMutation object of the builder.
+func (iuo *InviteUpdateOne) Mutation() *InviteMutation {
+	return iuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (iuo *InviteUpdateOne) ClearUser() *InviteUpdateOne {
+	iuo.mutation.ClearUser()
+	return iuo
+}
+
+// ClearRole clears the "role" edge to the Role entity.
+func (iuo *InviteUpdateOne) ClearRole() *InviteUpdateOne {
+	iuo.mutation.ClearRole()
+	return iuo
+}
+
+// ClearInvitedUsers clears all "invited_users" edges to the User entity.
+func (iuo *InviteUpdateOne) ClearInvitedUsers() *InviteUpdateOne {
+	iuo.mutation.ClearInvitedUsers()
+	return iuo
+}
+
+// RemoveInvitedUserIDs removes the "invited_users" edge to User entities by IDs.
+func (iuo *InviteUpdateOne) RemoveInvitedUserIDs(ids ...int) *InviteUpdateOne {
+	iuo.mutation.RemoveInvitedUserIDs(ids...)
+	return iuo
+}
+
+// RemoveInvitedUsers removes "invited_users" edges to User entities.
+func (iuo *InviteUpdateOne) RemoveInvitedUsers(u ...*User) *InviteUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return iuo.RemoveInvitedUserIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *InviteUpdateOne) Select(field string, fields ...string) *InviteUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Invite entity.
+func (iuo *InviteUpdateOne) Save(ctx context.Context) (*Invite, error) {
+	var (
+		err  error
+		node *Invite
+	)
+	iuo.defaults()
+	if len(iuo.hooks) == 0 {
+		if err = iuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = iuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InviteMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = iuo.check(); err != nil {
+				return nil, err
+			}
+			iuo.mutation = mutation
+			node, err = iuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(iuo.hooks) - 1; i >= 0; i-- {
+			if iuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InviteUpdateOne) SaveX(ctx context.Context) *Invite {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *InviteUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InviteUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iuo *InviteUpdateOne) defaults() {
+	if _, ok := iuo.mutation.UpdatedAt(); !ok {
+		v := invite.UpdateDefaultUpdatedAt()
+		iuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuo *InviteUpdateOne) check() error {
+	if v, ok := iuo.mutation.Code(); ok {
+		if err := invite.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Invite.code": %w`, err)}
+		}
+	}
+	if _, ok := iuo.mutation.UserID(); iuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Invite.user"`)
+	}
+	return nil
+}
+
+func (iuo *InviteUpdateOne) sqlSave(ctx context.Context) (_node *Invite, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invite.Table,
+			Columns: invite.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invite.FieldID,
+			},
+		},
+	}
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Invite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invite.FieldID)
+		for _, f := range fields {
+			if !invite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invite.FieldUpdatedAt,
+		})
+	}
+	if value, ok := iuo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invite.FieldDeletedAt,
+		})
+	}
+	if iuo.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: invite.FieldDeletedAt,
+		})
+	}
+	if value, ok := iuo.mutation.Code(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: invite.FieldCode,
+		})
+	}
+	if value, ok := iuo.mutation.MaxUses(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invite.FieldMaxUses,
+		})
+	}
+	if value, ok := iuo.mutation.AddedMaxUses(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invite.FieldMaxUses,
+		})
+	}
+	if value, ok := iuo.mutation.Used(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invite.FieldUsed,
+		})
+	}
+	if value, ok := iuo.mutation.AddedUsed(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invite.FieldUsed,
+		})
+	}
+	if value, ok := iuo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: invite.FieldExpiresAt,
+		})
+	}
+	if iuo.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: invite.FieldExpiresAt,
+		})
+	}
+	if iuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.UserTable,
+			Columns: []string{invite.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.UserTable,
+			Columns: []string{invite.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.RoleTable,
+			Columns: []string{invite.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: role.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invite.RoleTable,
+			Columns: []string{invite.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: role.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.InvitedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invite.InvitedUsersTable,
+			Columns: []string{invite.InvitedUsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedInvitedUsersIDs(); len(nodes) > 0 && !iuo.mutation.InvitedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invite.InvitedUsersTable,
+			Columns: []string{invite.InvitedUsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.InvitedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invite.InvitedUsersTable,
+			Columns: []string{invite.InvitedUsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Invite{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
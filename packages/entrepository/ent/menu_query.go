@@ -0,0 +1,989 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menu"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menuitem"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/predicate"
+)
+
+// MenuQuery is the builder for querying Menu entities.
+type MenuQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.Menu
+	// eager-loading edges.
+	withItems *MenuItemQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MenuQuery builder.
+func (mq *MenuQuery) Where(ps ...predicate.Menu) *MenuQuery {
+	mq.predicates = append(mq.predicates, ps...)
+	return mq
+}
+
+// Limit adds a limit step to the query.
+func (mq *MenuQuery) Limit(limit int) *MenuQuery {
+	mq.limit = &limit
+	return mq
+}
+
+// Offset adds an offset step to the query.
+func (mq *MenuQuery) Offset(offset int) *MenuQuery {
+	mq.offset = &offset
+	return mq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mq *MenuQuery) Unique(unique bool) *MenuQuery {
+	mq.unique = &unique
+	return mq
+}
+
+// Order adds an order step to the query.
+func (mq *MenuQuery) Order(o ...OrderFunc) *MenuQuery {
+	mq.order = append(mq.order, o...)
+	return mq
+}
+
+// QueryItems chains the current query on the "items" edge.
+func (mq *MenuQuery) QueryItems() *MenuItemQuery {
+	query := &MenuItemQuery{config: mq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menu.Table, menu.FieldID, selector),
+			sqlgraph.To(menuitem.Table, menuitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, menu.ItemsTable, menu.ItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Menu entity from the query.
+// Returns a *NotFoundError when no Menu was found.
+func (mq *MenuQuery) First(ctx context.Context) (*Menu, error) {
+	nodes, err := mq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{menu.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mq *MenuQuery) FirstX(ctx context.Context) *Menu {
+	node, err := mq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Menu ID from the query.
+// Returns a *NotFoundError when no Menu ID was found.
+func (mq *MenuQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{menu.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mq *MenuQuery) FirstIDX(ctx context.Context) int {
+	id, err := mq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Menu entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Menu entity is found.
+// Returns a *NotFoundError when no Menu entities are found.
+func (mq *MenuQuery) Only(ctx context.Context) (*Menu, error) {
+	nodes, err := mq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{menu.Label}
+	default:
+		return nil, &NotSingularError{menu.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mq *MenuQuery) OnlyX(ctx context.Context) *Menu {
+	node, err := mq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Menu ID in the query.
+// Returns a *NotSingularError when more than one Menu ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mq *MenuQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{menu.Label}
+	default:
+		err = &NotSingularError{menu.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mq *MenuQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Menus.
+func (mq *MenuQuery) All(ctx context.Context) ([]*Menu, error) {
+	if err := mq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return mq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mq *MenuQuery) AllX(ctx context.Context) []*Menu {
+	nodes, err := mq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Menu IDs.
+func (mq *MenuQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := mq.Select(menu.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mq *MenuQuery) IDsX(ctx context.Context) []int {
+	ids, err := mq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mq *MenuQuery) Count(ctx context.Context) (int, error) {
+	if err := mq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return mq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mq *MenuQuery) CountX(ctx context.Context) int {
+	count, err := mq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mq *MenuQuery) Exist(ctx context.Context) (bool, error) {
+	if err := mq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return mq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mq *MenuQuery) ExistX(ctx context.Context) bool {
+	exist, err := mq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MenuQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mq *MenuQuery) Clone() *MenuQuery {
+	if mq == nil {
+		return nil
+	}
+	return &MenuQuery{
+		config:     mq.config,
+		limit:      mq.limit,
+		offset:     mq.offset,
+		order:      append([]OrderFunc{}, mq.order...),
+		predicates: append([]predicate.Menu{}, mq.predicates...),
+		withItems:  mq.withItems.Clone(),
+		// clone intermediate query.
+		sql:    mq.sql.Clone(),
+		path:   mq.path,
+		unique: mq.unique,
+	}
+}
+
+// WithItems tells the query-builder to eager-load the nodes that are connected to
+// the "items" edge. The optional arguments are used to configure the query builder of the edge.
+func (mq *MenuQuery) WithItems(opts ...func(*MenuItemQuery)) *MenuQuery {
+	query := &MenuItemQuery{config: mq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	mq.withItems = query
+	return mq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Menu.Query().
+//		GroupBy(menu.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (mq *MenuQuery) GroupBy(field string, fields ...string) *MenuGroupBy {
+	group := &MenuGroupBy{config: mq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := mq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return mq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"omitempty"`
+//	}
+//
+//	client.Menu.Query().
+//		Select(menu.FieldCreatedAt).
+//		Scan(ctx, &v)
+//
+func (mq *MenuQuery) Select(fields ...string) *MenuSelect {
+	mq.fields = append(mq.fields, fields...)
+	return &MenuSelect{MenuQuery: mq}
+}
+
+func (mq *MenuQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range mq.fields {
+		if !menu.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mq.path != nil {
+		prev, err := mq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mq.sql = prev
+	}
+	return nil
+}
+
+func (mq *MenuQuery) sqlAll(ctx context.Context) ([]*Menu, error) {
+	var (
+		nodes       = []*Menu{}
+		_spec       = mq.querySpec()
+		loadedTypes = [1]bool{
+			mq.withItems != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &Menu{config: mq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := mq.withItems; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Menu)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Items = []*MenuItem{}
+		}
+		query.Where(predicate.MenuItem(func(s *sql.Selector) {
+			s.Where(sql.InValues(menu.ItemsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.MenuID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "menu_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Items = append(node.Edges.Items, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (mq *MenuQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mq.querySpec()
+	_spec.Node.Columns = mq.fields
+	if len(mq.fields) > 0 {
+		_spec.Unique = mq.unique != nil && *mq.unique
+	}
+	return sqlgraph.CountNodes(ctx, mq.driver, _spec)
+}
+
+func (mq *MenuQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := mq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (mq *MenuQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   menu.Table,
+			Columns: menu.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: menu.FieldID,
+			},
+		},
+		From:   mq.sql,
+		Unique: true,
+	}
+	if unique := mq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := mq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, menu.FieldID)
+		for i := range fields {
+			if fields[i] != menu.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mq *MenuQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mq.driver.Dialect())
+	t1 := builder.Table(menu.Table)
+	columns := mq.fields
+	if len(columns) == 0 {
+		columns = menu.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mq.sql != nil {
+		selector = mq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mq.unique != nil && *mq.unique {
+		selector.Distinct()
+	}
+	for _, p := range mq.predicates {
+		p(selector)
+	}
+	for _, p := range mq.order {
+		p(selector)
+	}
+	if offset := mq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MenuGroupBy is the group-by builder for Menu entities.
+type MenuGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mgb *MenuGroupBy) Aggregate(fns ...AggregateFunc) *MenuGroupBy {
+	mgb.fns = append(mgb.fns, fns...)
+	return mgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (mgb *MenuGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := mgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	mgb.sql = query
+	return mgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (mgb *MenuGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := mgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (mgb *MenuGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(mgb.fields) > 1 {
+		return nil, errors.New("ent: MenuGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := mgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (mgb *MenuGroupBy) StringsX(ctx context.Context) []string {
+	v, err := mgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (mgb *MenuGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = mgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{menu.Label}
+	default:
+		err = fmt.Errorf("ent: MenuGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (mgb *MenuGroupBy) StringX(ctx context.Context) string {
+	v, err := mgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (mgb *MenuGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(mgb.fields) > 1 {
+		return nil, errors.New("ent: MenuGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := mgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (mgb *MenuGroupBy) IntsX(ctx context.Context) []int {
+	v, err := mgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (mgb *MenuGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = mgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{menu.Label}
+	default:
+		err = fmt.Errorf("ent: MenuGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (mgb *MenuGroupBy) IntX(ctx context.Context) int {
+	v, err := mgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (mgb *MenuGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(mgb.fields) > 1 {
+		return nil, errors.New("ent: MenuGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := mgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (mgb *MenuGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := mgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (mgb *MenuGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = mgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{menu.Label}
+	default:
+		err = fmt.Errorf("ent: MenuGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (mgb *MenuGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := mgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (mgb *MenuGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(mgb.fields) > 1 {
+		return nil, errors.New("ent: MenuGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := mgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (mgb *MenuGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := mgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (mgb *MenuGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = mgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{menu.Label}
+	default:
+		err = fmt.Errorf("ent: MenuGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (mgb *MenuGroupBy) BoolX(ctx context.Context) bool {
+	v, err := mgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (mgb *MenuGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range mgb.fields {
+		if !menu.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := mgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (mgb *MenuGroupBy) sqlQuery() *sql.Selector {
+	selector := mgb.sql.Select()
+	aggregation := make([]string, 0, len(mgb.fns))
+	for _, fn := range mgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(mgb.fields)+len(mgb.fns))
+		for _, f := range mgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(mgb.fields...)...)
+}
+
+// MenuSelect is the builder for selecting fields of Menu entities.
+type MenuSelect struct {
+	*MenuQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ms *MenuSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ms.sql = ms.MenuQuery.sqlQuery(ctx)
+	return ms.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ms *MenuSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ms.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ms *MenuSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ms.fields) > 1 {
+		return nil, errors.New("ent: MenuSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ms *MenuSelect) StringsX(ctx context.Context) []string {
+	v, err := ms.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ms *MenuSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ms.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{menu.Label}
+	default:
+		err = fmt.Errorf("ent: MenuSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ms *MenuSelect) StringX(ctx context.Context) string {
+	v, err := ms.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ms *MenuSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ms.fields) > 1 {
+		return nil, errors.New("ent: MenuSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ms *MenuSelect) IntsX(ctx context.Context) []int {
+	v, err := ms.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ms *MenuSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ms.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{menu.Label}
+	default:
+		err = fmt.Errorf("ent: MenuSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ms *MenuSelect) IntX(ctx context.Context) int {
+	v, err := ms.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ms *MenuSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ms.fields) > 1 {
+		return nil, errors.New("ent: MenuSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ms *MenuSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ms.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ms *MenuSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ms.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{menu.Label}
+	default:
+		err = fmt.Errorf("ent: MenuSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ms *MenuSelect) Float64X(ctx context.Context) float64 {
+	v, err := ms.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ms *MenuSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ms.fields) > 1 {
+		return nil, errors.New("ent: MenuSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ms *MenuSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ms.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ms *MenuSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ms.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{menu.Label}
+	default:
+		err = fmt.Errorf("ent: MenuSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ms *MenuSelect) BoolX(ctx context.Context) bool {
+	v, err := ms.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ms *MenuSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ms.sql.Query()
+	if err := ms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,625 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menu"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menuitem"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/predicate"
+)
+
+// MenuUpdate is the builder for updating Menu entities.
+type MenuUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MenuMutation
+}
+
+// Where appends a list predicates to the MenuUpdate builder.
+func (mu *MenuUpdate) Where(ps ...predicate.Menu) *MenuUpdate {
+	mu.mutation.Where(ps...)
+	return mu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mu *MenuUpdate) SetUpdatedAt(t time.Time) *MenuUpdate {
+	mu.mutation.SetUpdatedAt(t)
+	return mu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mu *MenuUpdate) SetDeletedAt(t time.Time) *MenuUpdate {
+	mu.mutation.SetDeletedAt(t)
+	return mu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mu *MenuUpdate) SetNillableDeletedAt(t *time.Time) *MenuUpdate {
+	if t != nil {
+		mu.SetDeletedAt(*t)
+	}
+	return mu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (mu *MenuUpdate) ClearDeletedAt() *MenuUpdate {
+	mu.mutation.ClearDeletedAt()
+	return mu
+}
+
+// SetName sets the "name" field.
+func (mu *MenuUpdate) SetName(s string) *MenuUpdate {
+	mu.mutation.SetName(s)
+	return mu
+}
+
+// SetLocation sets the "location" field.
+func (mu *MenuUpdate) SetLocation(s string) *MenuUpdate {
+	mu.mutation.SetLocation(s)
+	return mu
+}
+
+// AddItemIDs adds the "items" edge to the MenuItem entity by IDs.
+func (mu *MenuUpdate) AddItemIDs(ids ...int) *MenuUpdate {
+	mu.mutation.AddItemIDs(ids...)
+	return mu
+}
+
+// AddItems adds the "items" edges to the MenuItem entity.
+func (mu *MenuUpdate) AddItems(m ...*MenuItem) *MenuUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.AddItemIDs(ids...)
+}
+
+// Mutation returns the MenuMutation object of the builder.
+func (mu *MenuUpdate) Mutation() *MenuMutation {
+	return mu.mutation
+}
+
+// ClearItems clears all "items" edges to the MenuItem entity.
+func (mu *MenuUpdate) ClearItems() *MenuUpdate {
+	mu.mutation.ClearItems()
+	return mu
+}
+
+// RemoveItemIDs removes the "items" edge to MenuItem entities by IDs.
+func (mu *MenuUpdate) RemoveItemIDs(ids ...int) *MenuUpdate {
+	mu.mutation.RemoveItemIDs(ids...)
+	return mu
+}
+
+// RemoveItems removes "items" edges to MenuItem entities.
+func (mu *MenuUpdate) RemoveItems(m ...*MenuItem) *MenuUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mu.RemoveItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MenuUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	mu.defaults()
+	if len(mu.hooks) == 0 {
+		if err = mu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = mu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MenuMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = mu.check(); err != nil {
+				return 0, err
+			}
+			mu.mutation = mutation
+			affected, err = mu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(mu.hooks) - 1; i >= 0; i-- {
+			if mu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MenuUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MenuUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MenuUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mu *MenuUpdate) defaults() {
+	if _, ok := mu.mutation.UpdatedAt(); !ok {
+		v := menu.UpdateDefaultUpdatedAt()
+		mu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MenuUpdate) check() error {
+	if v, ok := mu.mutation.Name(); ok {
+		if err := menu.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Menu.name": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Location(); ok {
+		if err := menu.LocationValidator(v); err != nil {
+			return &ValidationError{Name: "location", err: fmt.Errorf(`ent: validator failed for field "Menu.location": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mu *MenuUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   menu.Table,
+			Columns: menu.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: menu.FieldID,
+			},
+		},
+	}
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: menu.FieldUpdatedAt,
+		})
+	}
+	if value, ok := mu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: menu.FieldDeletedAt,
+		})
+	}
+	if mu.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: menu.FieldDeletedAt,
+		})
+	}
+	if value, ok := mu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: menu.FieldName,
+		})
+	}
+	if value, ok := mu.mutation.Location(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: menu.FieldLocation,
+		})
+	}
+	if mu.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menu.ItemsTable,
+			Columns: []string{menu.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: menuitem.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedItemsIDs(); len(nodes) > 0 && !mu.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menu.ItemsTable,
+			Columns: []string{menu.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: menuitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menu.ItemsTable,
+			Columns: []string{menu.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: menuitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{menu.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// MenuUpdateOne is the builder for updating a single Menu entity.
+type MenuUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MenuMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (muo *MenuUpdateOne) SetUpdatedAt(t time.Time) *MenuUpdateOne {
+	muo.mutation.SetUpdatedAt(t)
+	return muo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (muo *MenuUpdateOne) SetDeletedAt(t time.Time) *MenuUpdateOne {
+	muo.mutation.SetDeletedAt(t)
+	return muo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (muo *MenuUpdateOne) SetNillableDeletedAt(t *time.Time) *MenuUpdateOne {
+	if t != nil {
+		muo.SetDeletedAt(*t)
+	}
+	return muo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (muo *MenuUpdateOne) ClearDeletedAt() *MenuUpdateOne {
+	muo.mutation.ClearDeletedAt()
+	return muo
+}
+
+// SetName sets the "name" field.
+func (muo *MenuUpdateOne) SetName(s string) *MenuUpdateOne {
+	muo.mutation.SetName(s)
+	return muo
+}
+
+// SetLocation sets the "location" field.
+func (muo *MenuUpdateOne) SetLocation(s string) *MenuUpdateOne {
+	muo.mutation.SetLocation(s)
+	return muo
+}
+
+// AddItemIDs adds the "items" edge to the MenuItem entity by IDs.
+func (muo *MenuUpdateOne) AddItemIDs(ids ...int) *MenuUpdateOne {
+	muo.mutation.AddItemIDs(ids...)
+	return muo
+}
+
+// AddItems adds the "items" edges to the MenuItem entity.
+func (muo *MenuUpdateOne) AddItems(m ...*MenuItem) *MenuUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.AddItemIDs(ids...)
+}
+
+// Mutation returns the MenuMutation object of the builder.
+func (muo *MenuUpdateOne) Mutation() *MenuMutation {
+	return muo.mutation
+}
+
+// ClearItems clears all "items" edges to the MenuItem entity.
+func (muo *MenuUpdateOne) ClearItems() *MenuUpdateOne {
+	muo.mutation.ClearItems()
+	return muo
+}
+
+// RemoveItemIDs removes the "items" edge to MenuItem entities by IDs.
+func (muo *MenuUpdateOne) RemoveItemIDs(ids ...int) *MenuUpdateOne {
+	muo.mutation.RemoveItemIDs(ids...)
+	return muo
+}
+
+// RemoveItems removes "items" edges to MenuItem entities.
+func (muo *MenuUpdateOne) RemoveItems(m ...*MenuItem) *MenuUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return muo.RemoveItemIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *MenuUpdateOne) Select(field string, fields ...string) *MenuUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Menu entity.
+func (muo *MenuUpdateOne) Save(ctx context.Context) (*Menu, error) {
+	var (
+		err  error
+		node *Menu
+	)
+	muo.defaults()
+	if len(muo.hooks) == 0 {
+		if err = muo.check(); err != nil {
+			return nil, err
+		}
+		node, err = muo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MenuMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = muo.check(); err != nil {
+				return nil, err
+			}
+			muo.mutation = mutation
+			node, err = muo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(muo.hooks) - 1; i >= 0; i-- {
+			if muo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = muo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, muo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *MenuUpdateOne) SaveX(ctx context.Context) *Menu {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *MenuUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *MenuUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (muo *MenuUpdateOne) defaults() {
+	if _, ok := muo.mutation.UpdatedAt(); !ok {
+		v := menu.UpdateDefaultUpdatedAt()
+		muo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *MenuUpdateOne) check() error {
+	if v, ok := muo.mutation.Name(); ok {
+		if err := menu.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Menu.name": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Location(); ok {
+		if err := menu.LocationValidator(v); err != nil {
+			return &ValidationError{Name: "location", err: fmt.Errorf(`ent: validator failed for field "Menu.location": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (muo *MenuUpdateOne) sqlSave(ctx context.Context) (_node *Menu, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   menu.Table,
+			Columns: menu.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: menu.FieldID,
+			},
+		},
+	}
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Menu.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, menu.FieldID)
+		for _, f := range fields {
+			if !menu.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != menu.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: menu.FieldUpdatedAt,
+		})
+	}
+	if value, ok := muo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: menu.FieldDeletedAt,
+		})
+	}
+	if muo.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: menu.FieldDeletedAt,
+		})
+	}
+	if value, ok := muo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: menu.FieldName,
+		})
+	}
+	if value, ok := muo.mutation.Location(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: menu.FieldLocation,
+		})
+	}
+	if muo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menu.ItemsTable,
+			Columns: []string{menu.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: menuitem.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedItemsIDs(); len(nodes) > 0 && !muo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menu.ItemsTable,
+			Columns: []string{menu.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: menuitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   menu.ItemsTable,
+			Columns: []string{menu.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: menuitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Menu{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{menu.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
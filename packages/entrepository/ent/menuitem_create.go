@@ -0,0 +1,1260 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menu"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menuitem"
+)
+
+// MenuItemCreate is the builder for creating a MenuItem entity.
+type MenuItemCreate struct {
+	config
+	mutation *MenuItemMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mic *MenuItemCreate) SetCreatedAt(t time.Time) *MenuItemCreate {
+	mic.mutation.SetCreatedAt(t)
+	return mic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mic *MenuItemCreate) SetNillableCreatedAt(t *time.Time) *MenuItemCreate {
+	if t != nil {
+		mic.SetCreatedAt(*t)
+	}
+	return mic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mic *MenuItemCreate) SetUpdatedAt(t time.Time) *MenuItemCreate {
+	mic.mutation.SetUpdatedAt(t)
+	return mic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mic *MenuItemCreate) SetNillableUpdatedAt(t *time.Time) *MenuItemCreate {
+	if t != nil {
+		mic.SetUpdatedAt(*t)
+	}
+	return mic
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mic *MenuItemCreate) SetDeletedAt(t time.Time) *MenuItemCreate {
+	mic.mutation.SetDeletedAt(t)
+	return mic
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mic *MenuItemCreate) SetNillableDeletedAt(t *time.Time) *MenuItemCreate {
+	if t != nil {
+		mic.SetDeletedAt(*t)
+	}
+	return mic
+}
+
+// SetMenuID sets the "menu_id" field.
+func (mic *MenuItemCreate) SetMenuID(i int) *MenuItemCreate {
+	mic.mutation.SetMenuID(i)
+	return mic
+}
+
+// SetParentID sets the "parent_id" field.
+func (mic *MenuItemCreate) SetParentID(i int) *MenuItemCreate {
+	mic.mutation.SetParentID(i)
+	return mic
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (mic *MenuItemCreate) SetNillableParentID(i *int) *MenuItemCreate {
+	if i != nil {
+		mic.SetParentID(*i)
+	}
+	return mic
+}
+
+// SetLabel sets the "label" field.
+func (mic *MenuItemCreate) SetLabel(s string) *MenuItemCreate {
+	mic.mutation.SetLabel(s)
+	return mic
+}
+
+// SetType sets the "type" field.
+func (mic *MenuItemCreate) SetType(s string) *MenuItemCreate {
+	mic.mutation.SetType(s)
+	return mic
+}
+
+// SetTargetID sets the "target_id" field.
+func (mic *MenuItemCreate) SetTargetID(i int) *MenuItemCreate {
+	mic.mutation.SetTargetID(i)
+	return mic
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (mic *MenuItemCreate) SetNillableTargetID(i *int) *MenuItemCreate {
+	if i != nil {
+		mic.SetTargetID(*i)
+	}
+	return mic
+}
+
+// SetURL sets the "url" field.
+func (mic *MenuItemCreate) SetURL(s string) *MenuItemCreate {
+	mic.mutation.SetURL(s)
+	return mic
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (mic *MenuItemCreate) SetNillableURL(s *string) *MenuItemCreate {
+	if s != nil {
+		mic.SetURL(*s)
+	}
+	return mic
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (mic *MenuItemCreate) SetSortOrder(i int) *MenuItemCreate {
+	mic.mutation.SetSortOrder(i)
+	return mic
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (mic *MenuItemCreate) SetNillableSortOrder(i *int) *MenuItemCreate {
+	if i != nil {
+		mic.SetSortOrder(*i)
+	}
+	return mic
+}
+
+// SetMenu sets the "menu" edge to the Menu entity.
+func (mic *MenuItemCreate) SetMenu(m *Menu) *MenuItemCreate {
+	return mic.SetMenuID(m.ID)
+}
+
+// Mutation returns the MenuItemMutation object of the builder.
+func (mic *MenuItemCreate) Mutation() *MenuItemMutation {
+	return mic.mutation
+}
+
+// Save creates the MenuItem in the database.
+func (mic *MenuItemCreate) Save(ctx context.Context) (*MenuItem, error) {
+	var (
+		err  error
+		node *MenuItem
+	)
+	mic.defaults()
+	if len(mic.hooks) == 0 {
+		if err = mic.check(); err != nil {
+			return nil, err
+		}
+		node, err = mic.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MenuItemMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = mic.check(); err != nil {
+				return nil, err
+			}
+			mic.mutation = mutation
+			if node, err = mic.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(mic.hooks) - 1; i >= 0; i-- {
+			if mic.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mic.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mic.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mic *MenuItemCreate) SaveX(ctx context.Context) *MenuItem {
+	v, err := mic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mic *MenuItemCreate) Exec(ctx context.Context) error {
+	_, err := mic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mic *MenuItemCreate) ExecX(ctx context.Context) {
+	if err := mic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mic *MenuItemCreate) defaults() {
+	if _, ok := mic.mutation.CreatedAt(); !ok {
+		v := menuitem.DefaultCreatedAt()
+		mic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mic.mutation.UpdatedAt(); !ok {
+		v := menuitem.DefaultUpdatedAt()
+		mic.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := mic.mutation.SortOrder(); !ok {
+		v := menuitem.DefaultSortOrder
+		mic.mutation.SetSortOrder(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mic *MenuItemCreate) check() error {
+	if _, ok := mic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MenuItem.created_at"`)}
+	}
+	if _, ok := mic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MenuItem.updated_at"`)}
+	}
+	if _, ok := mic.mutation.MenuID(); !ok {
+		return &ValidationError{Name: "menu_id", err: errors.New(`ent: missing required field "MenuItem.menu_id"`)}
+	}
+	if _, ok := mic.mutation.Label(); !ok {
+		return &ValidationError{Name: "label", err: errors.New(`ent: missing required field "MenuItem.label"`)}
+	}
+	if v, ok := mic.mutation.Label(); ok {
+		if err := menuitem.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "MenuItem.label": %w`, err)}
+		}
+	}
+	if _, ok := mic.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "MenuItem.type"`)}
+	}
+	if v, ok := mic.mutation.GetType(); ok {
+		if err := menuitem.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "MenuItem.type": %w`, err)}
+		}
+	}
+	if v, ok := mic.mutation.URL(); ok {
+		if err := menuitem.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "MenuItem.url": %w`, err)}
+		}
+	}
+	if _, ok := mic.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "MenuItem.sort_order"`)}
+	}
+	if _, ok := mic.mutation.MenuID(); !ok {
+		return &ValidationError{Name: "menu", err: errors.New(`ent: missing required edge "MenuItem.menu"`)}
+	}
+	return nil
+}
+
+func (mic *MenuItemCreate) sqlSave(ctx context.Context) (*MenuItem, error) {
+	_node, _spec := mic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (mic *MenuItemCreate) createSpec() (*MenuItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MenuItem{config: mic.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: menuitem.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: menuitem.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = mic.conflict
+	if value, ok := mic.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: menuitem.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := mic.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: menuitem.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := mic.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: menuitem.FieldDeletedAt,
+		})
+		_node.DeletedAt = value
+	}
+	if value, ok := mic.mutation.ParentID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: menuitem.FieldParentID,
+		})
+		_node.ParentID = value
+	}
+	if value, ok := mic.mutation.Label(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: menuitem.FieldLabel,
+		})
+		_node.Label = value
+	}
+	if value, ok := mic.mutation.GetType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: menuitem.FieldType,
+		})
+		_node.Type = value
+	}
+	if value, ok := mic.mutation.TargetID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: menuitem.FieldTargetID,
+		})
+		_node.TargetID = value
+	}
+	if value, ok := mic.mutation.URL(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: menuitem.FieldURL,
+		})
+		_node.URL = value
+	}
+	if value, ok := mic.mutation.SortOrder(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: menuitem.FieldSortOrder,
+		})
+		_node.SortOrder = value
+	}
+	if nodes := mic.mutation.MenuIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   menuitem.MenuTable,
+			Columns: []string{menuitem.MenuColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: menu.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MenuID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MenuItem.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MenuItemUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (mic *MenuItemCreate) OnConflict(opts ...sql.ConflictOption) *MenuItemUpsertOne {
+	mic.conflict = opts
+	return &MenuItemUpsertOne{
+		create: mic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MenuItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (mic *MenuItemCreate) OnConflictColumns(columns ...string) *MenuItemUpsertOne {
+	mic.conflict = append(mic.conflict, sql.ConflictColumns(columns...))
+	return &MenuItemUpsertOne{
+		create: mic,
+	}
+}
+
+type (
+	// MenuItemUpsertOne is the builder for "upsert"-ing
+	//  one MenuItem node.
+	MenuItemUpsertOne struct {
+		create *MenuItemCreate
+	}
+
+	// MenuItemUpsert is the "OnConflict" setter.
+	MenuItemUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *MenuItemUpsert) SetCreatedAt(v time.Time) *MenuItemUpsert {
+	u.Set(menuitem.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *MenuItemUpsert) UpdateCreatedAt() *MenuItemUpsert {
+	u.SetExcluded(menuitem.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MenuItemUpsert) SetUpdatedAt(v time.Time) *MenuItemUpsert {
+	u.Set(menuitem.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MenuItemUpsert) UpdateUpdatedAt() *MenuItemUpsert {
+	u.SetExcluded(menuitem.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MenuItemUpsert) SetDeletedAt(v time.Time) *MenuItemUpsert {
+	u.Set(menuitem.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MenuItemUpsert) UpdateDeletedAt() *MenuItemUpsert {
+	u.SetExcluded(menuitem.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *MenuItemUpsert) ClearDeletedAt() *MenuItemUpsert {
+	u.SetNull(menuitem.FieldDeletedAt)
+	return u
+}
+
+// SetMenuID sets the "menu_id" field.
+func (u *MenuItemUpsert) SetMenuID(v int) *MenuItemUpsert {
+	u.Set(menuitem.FieldMenuID, v)
+	return u
+}
+
+// UpdateMenuID sets the "menu_id" field to the value that was provided on create.
+func (u *MenuItemUpsert) UpdateMenuID() *MenuItemUpsert {
+	u.SetExcluded(menuitem.FieldMenuID)
+	return u
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *MenuItemUpsert) SetParentID(v int) *MenuItemUpsert {
+	u.Set(menuitem.FieldParentID, v)
+	return u
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *MenuItemUpsert) UpdateParentID() *MenuItemUpsert {
+	u.SetExcluded(menuitem.FieldParentID)
+	return u
+}
+
+// AddParentID adds v to the "parent_id" field.
+func (u *MenuItemUpsert) AddParentID(v int) *MenuItemUpsert {
+	u.Add(menuitem.FieldParentID, v)
+	return u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *MenuItemUpsert) ClearParentID() *MenuItemUpsert {
+	u.SetNull(menuitem.FieldParentID)
+	return u
+}
+
+// SetLabel sets the "label" field.
+func (u *MenuItemUpsert) SetLabel(v string) *MenuItemUpsert {
+	u.Set(menuitem.FieldLabel, v)
+	return u
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *MenuItemUpsert) UpdateLabel() *MenuItemUpsert {
+	u.SetExcluded(menuitem.FieldLabel)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *MenuItemUpsert) SetType(v string) *MenuItemUpsert {
+	u.Set(menuitem.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *MenuItemUpsert) UpdateType() *MenuItemUpsert {
+	u.SetExcluded(menuitem.FieldType)
+	return u
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *MenuItemUpsert) SetTargetID(v int) *MenuItemUpsert {
+	u.Set(menuitem.FieldTargetID, v)
+	return u
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *MenuItemUpsert) UpdateTargetID() *MenuItemUpsert {
+	u.SetExcluded(menuitem.FieldTargetID)
+	return u
+}
+
+// AddTargetID adds v to the "target_id" field.
+func (u *MenuItemUpsert) AddTargetID(v int) *MenuItemUpsert {
+	u.Add(menuitem.FieldTargetID, v)
+	return u
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (u *MenuItemUpsert) ClearTargetID() *MenuItemUpsert {
+	u.SetNull(menuitem.FieldTargetID)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *MenuItemUpsert) SetURL(v string) *MenuItemUpsert {
+	u.Set(menuitem.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *MenuItemUpsert) UpdateURL() *MenuItemUpsert {
+	u.SetExcluded(menuitem.FieldURL)
+	return u
+}
+
+// ClearURL clears the value of the "url" field.
+func (u *MenuItemUpsert) ClearURL() *MenuItemUpsert {
+	u.SetNull(menuitem.FieldURL)
+	return u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *MenuItemUpsert) SetSortOrder(v int) *MenuItemUpsert {
+	u.Set(menuitem.FieldSortOrder, v)
+	return u
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *MenuItemUpsert) UpdateSortOrder() *MenuItemUpsert {
+	u.SetExcluded(menuitem.FieldSortOrder)
+	return u
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *MenuItemUpsert) AddSortOrder(v int) *MenuItemUpsert {
+	u.Add(menuitem.FieldSortOrder, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.MenuItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *MenuItemUpsertOne) UpdateNewValues() *MenuItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(menuitem.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//  client.MenuItem.Create().
+//      OnConflict(sql.ResolveWithIgnore()).
+//      Exec(ctx)
+//
+func (u *MenuItemUpsertOne) Ignore() *MenuItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MenuItemUpsertOne) DoNothing() *MenuItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MenuItemCreate.OnConflict
+// documentation for more info.
+func (u *MenuItemUpsertOne) Update(set func(*MenuItemUpsert)) *MenuItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MenuItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *MenuItemUpsertOne) SetCreatedAt(v time.Time) *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *MenuItemUpsertOne) UpdateCreatedAt() *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MenuItemUpsertOne) SetUpdatedAt(v time.Time) *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MenuItemUpsertOne) UpdateUpdatedAt() *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MenuItemUpsertOne) SetDeletedAt(v time.Time) *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MenuItemUpsertOne) UpdateDeletedAt() *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *MenuItemUpsertOne) ClearDeletedAt() *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetMenuID sets the "menu_id" field.
+func (u *MenuItemUpsertOne) SetMenuID(v int) *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetMenuID(v)
+	})
+}
+
+// UpdateMenuID sets the "menu_id" field to the value that was provided on create.
+func (u *MenuItemUpsertOne) UpdateMenuID() *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdateMenuID()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *MenuItemUpsertOne) SetParentID(v int) *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// AddParentID adds v to the "parent_id" field.
+func (u *MenuItemUpsertOne) AddParentID(v int) *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.AddParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *MenuItemUpsertOne) UpdateParentID() *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *MenuItemUpsertOne) ClearParentID() *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetLabel sets the "label" field.
+func (u *MenuItemUpsertOne) SetLabel(v string) *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetLabel(v)
+	})
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *MenuItemUpsertOne) UpdateLabel() *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdateLabel()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *MenuItemUpsertOne) SetType(v string) *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *MenuItemUpsertOne) UpdateType() *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *MenuItemUpsertOne) SetTargetID(v int) *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// AddTargetID adds v to the "target_id" field.
+func (u *MenuItemUpsertOne) AddTargetID(v int) *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.AddTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *MenuItemUpsertOne) UpdateTargetID() *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (u *MenuItemUpsertOne) ClearTargetID() *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.ClearTargetID()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *MenuItemUpsertOne) SetURL(v string) *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *MenuItemUpsertOne) UpdateURL() *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// ClearURL clears the value of the "url" field.
+func (u *MenuItemUpsertOne) ClearURL() *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.ClearURL()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *MenuItemUpsertOne) SetSortOrder(v int) *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *MenuItemUpsertOne) AddSortOrder(v int) *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *MenuItemUpsertOne) UpdateSortOrder() *MenuItemUpsertOne {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// Exec executes the query.
+func (u *MenuItemUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MenuItemCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MenuItemUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MenuItemUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MenuItemUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MenuItemCreateBulk is the builder for creating many MenuItem entities in bulk.
+type MenuItemCreateBulk struct {
+	config
+	builders []*MenuItemCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MenuItem entities in the database.
+func (micb *MenuItemCreateBulk) Save(ctx context.Context) ([]*MenuItem, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(micb.builders))
+	nodes := make([]*MenuItem, len(micb.builders))
+	mutators := make([]Mutator, len(micb.builders))
+	for i := range micb.builders {
+		func(i int, root context.Context) {
+			builder := micb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MenuItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, micb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = micb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, micb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, micb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (micb *MenuItemCreateBulk) SaveX(ctx context.Context) []*MenuItem {
+	v, err := micb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (micb *MenuItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := micb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (micb *MenuItemCreateBulk) ExecX(ctx context.Context) {
+	if err := micb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MenuItem.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MenuItemUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (micb *MenuItemCreateBulk) OnConflict(opts ...sql.ConflictOption) *MenuItemUpsertBulk {
+	micb.conflict = opts
+	return &MenuItemUpsertBulk{
+		create: micb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MenuItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (micb *MenuItemCreateBulk) OnConflictColumns(columns ...string) *MenuItemUpsertBulk {
+	micb.conflict = append(micb.conflict, sql.ConflictColumns(columns...))
+	return &MenuItemUpsertBulk{
+		create: micb,
+	}
+}
+
+// MenuItemUpsertBulk is the builder for "upsert"-ing
+// a bulk of MenuItem nodes.
+type MenuItemUpsertBulk struct {
+	create *MenuItemCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MenuItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *MenuItemUpsertBulk) UpdateNewValues() *MenuItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(menuitem.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MenuItem.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *MenuItemUpsertBulk) Ignore() *MenuItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MenuItemUpsertBulk) DoNothing() *MenuItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MenuItemCreateBulk.OnConflict
+// documentation for more info.
+func (u *MenuItemUpsertBulk) Update(set func(*MenuItemUpsert)) *MenuItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MenuItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *MenuItemUpsertBulk) SetCreatedAt(v time.Time) *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *MenuItemUpsertBulk) UpdateCreatedAt() *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MenuItemUpsertBulk) SetUpdatedAt(v time.Time) *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MenuItemUpsertBulk) UpdateUpdatedAt() *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MenuItemUpsertBulk) SetDeletedAt(v time.Time) *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MenuItemUpsertBulk) UpdateDeletedAt() *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *MenuItemUpsertBulk) ClearDeletedAt() *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetMenuID sets the "menu_id" field.
+func (u *MenuItemUpsertBulk) SetMenuID(v int) *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetMenuID(v)
+	})
+}
+
+// UpdateMenuID sets the "menu_id" field to the value that was provided on create.
+func (u *MenuItemUpsertBulk) UpdateMenuID() *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdateMenuID()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *MenuItemUpsertBulk) SetParentID(v int) *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// AddParentID adds v to the "parent_id" field.
+func (u *MenuItemUpsertBulk) AddParentID(v int) *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.AddParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *MenuItemUpsertBulk) UpdateParentID() *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *MenuItemUpsertBulk) ClearParentID() *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetLabel sets the "label" field.
+func (u *MenuItemUpsertBulk) SetLabel(v string) *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetLabel(v)
+	})
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *MenuItemUpsertBulk) UpdateLabel() *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdateLabel()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *MenuItemUpsertBulk) SetType(v string) *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *MenuItemUpsertBulk) UpdateType() *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *MenuItemUpsertBulk) SetTargetID(v int) *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// AddTargetID adds v to the "target_id" field.
+func (u *MenuItemUpsertBulk) AddTargetID(v int) *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.AddTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *MenuItemUpsertBulk) UpdateTargetID() *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (u *MenuItemUpsertBulk) ClearTargetID() *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.ClearTargetID()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *MenuItemUpsertBulk) SetURL(v string) *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *MenuItemUpsertBulk) UpdateURL() *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// ClearURL clears the value of the "url" field.
+func (u *MenuItemUpsertBulk) ClearURL() *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.ClearURL()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *MenuItemUpsertBulk) SetSortOrder(v int) *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *MenuItemUpsertBulk) AddSortOrder(v int) *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *MenuItemUpsertBulk) UpdateSortOrder() *MenuItemUpsertBulk {
+	return u.Update(func(s *MenuItemUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// Exec executes the query.
+func (u *MenuItemUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MenuItemCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MenuItemCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MenuItemUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,989 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menu"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menuitem"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/predicate"
+)
+
+// MenuItemQuery is the builder for querying MenuItem entities.
+type MenuItemQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.MenuItem
+	// eager-loading edges.
+	withMenu *MenuQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MenuItemQuery builder.
+func (miq *MenuItemQuery) Where(ps ...predicate.MenuItem) *MenuItemQuery {
+	miq.predicates = append(miq.predicates, ps...)
+	return miq
+}
+
+// Limit adds a limit step to the query.
+func (miq *MenuItemQuery) Limit(limit int) *MenuItemQuery {
+	miq.limit = &limit
+	return miq
+}
+
+// Offset adds an offset step to the query.
+func (miq *MenuItemQuery) Offset(offset int) *MenuItemQuery {
+	miq.offset = &offset
+	return miq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (miq *MenuItemQuery) Unique(unique bool) *MenuItemQuery {
+	miq.unique = &unique
+	return miq
+}
+
+// Order adds an order step to the query.
+func (miq *MenuItemQuery) Order(o ...OrderFunc) *MenuItemQuery {
+	miq.order = append(miq.order, o...)
+	return miq
+}
+
+// QueryMenu chains the current query on the "menu" edge.
+func (miq *MenuItemQuery) QueryMenu() *MenuQuery {
+	query := &MenuQuery{config: miq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := miq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := miq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(menuitem.Table, menuitem.FieldID, selector),
+			sqlgraph.To(menu.Table, menu.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, menuitem.MenuTable, menuitem.MenuColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(miq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MenuItem entity from the query.
+// Returns a *NotFoundError when no MenuItem was found.
+func (miq *MenuItemQuery) First(ctx context.Context) (*MenuItem, error) {
+	nodes, err := miq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{menuitem.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (miq *MenuItemQuery) FirstX(ctx context.Context) *MenuItem {
+	node, err := miq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MenuItem ID from the query.
+// Returns a *NotFoundError when no MenuItem ID was found.
+func (miq *MenuItemQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = miq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{menuitem.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (miq *MenuItemQuery) FirstIDX(ctx context.Context) int {
+	id, err := miq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MenuItem entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MenuItem entity is found.
+// Returns a *NotFoundError when no MenuItem entities are found.
+func (miq *MenuItemQuery) Only(ctx context.Context) (*MenuItem, error) {
+	nodes, err := miq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{menuitem.Label}
+	default:
+		return nil, &NotSingularError{menuitem.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (miq *MenuItemQuery) OnlyX(ctx context.Context) *MenuItem {
+	node, err := miq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MenuItem ID in the query.
+// Returns a *NotSingularError when more than one MenuItem ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (miq *MenuItemQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = miq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{menuitem.Label}
+	default:
+		err = &NotSingularError{menuitem.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (miq *MenuItemQuery) OnlyIDX(ctx context.Context) int {
+	id, err := miq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MenuItems.
+func (miq *MenuItemQuery) All(ctx context.Context) ([]*MenuItem, error) {
+	if err := miq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return miq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (miq *MenuItemQuery) AllX(ctx context.Context) []*MenuItem {
+	nodes, err := miq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MenuItem IDs.
+func (miq *MenuItemQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := miq.Select(menuitem.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (miq *MenuItemQuery) IDsX(ctx context.Context) []int {
+	ids, err := miq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (miq *MenuItemQuery) Count(ctx context.Context) (int, error) {
+	if err := miq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return miq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (miq *MenuItemQuery) CountX(ctx context.Context) int {
+	count, err := miq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (miq *MenuItemQuery) Exist(ctx context.Context) (bool, error) {
+	if err := miq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return miq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (miq *MenuItemQuery) ExistX(ctx context.Context) bool {
+	exist, err := miq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MenuItemQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (miq *MenuItemQuery) Clone() *MenuItemQuery {
+	if miq == nil {
+		return nil
+	}
+	return &MenuItemQuery{
+		config:     miq.config,
+		limit:      miq.limit,
+		offset:     miq.offset,
+		order:      append([]OrderFunc{}, miq.order...),
+		predicates: append([]predicate.MenuItem{}, miq.predicates...),
+		withMenu:   miq.withMenu.Clone(),
+		// clone intermediate query.
+		sql:    miq.sql.Clone(),
+		path:   miq.path,
+		unique: miq.unique,
+	}
+}
+
+// WithMenu tells the query-builder to eager-load the nodes that are connected to
+// the "menu" edge. The optional arguments are used to configure the query builder of the edge.
+func (miq *MenuItemQuery) WithMenu(opts ...func(*MenuQuery)) *MenuItemQuery {
+	query := &MenuQuery{config: miq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	miq.withMenu = query
+	return miq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MenuItem.Query().
+//		GroupBy(menuitem.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (miq *MenuItemQuery) GroupBy(field string, fields ...string) *MenuItemGroupBy {
+	group := &MenuItemGroupBy{config: miq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := miq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return miq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"omitempty"`
+//	}
+//
+//	client.MenuItem.Query().
+//		Select(menuitem.FieldCreatedAt).
+//		Scan(ctx, &v)
+//
+func (miq *MenuItemQuery) Select(fields ...string) *MenuItemSelect {
+	miq.fields = append(miq.fields, fields...)
+	return &MenuItemSelect{MenuItemQuery: miq}
+}
+
+func (miq *MenuItemQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range miq.fields {
+		if !menuitem.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if miq.path != nil {
+		prev, err := miq.path(ctx)
+		if err != nil {
+			return err
+		}
+		miq.sql = prev
+	}
+	return nil
+}
+
+func (miq *MenuItemQuery) sqlAll(ctx context.Context) ([]*MenuItem, error) {
+	var (
+		nodes       = []*MenuItem{}
+		_spec       = miq.querySpec()
+		loadedTypes = [1]bool{
+			miq.withMenu != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &MenuItem{config: miq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, miq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := miq.withMenu; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*MenuItem)
+		for i := range nodes {
+			fk := nodes[i].MenuID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(menu.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "menu_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Menu = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (miq *MenuItemQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := miq.querySpec()
+	_spec.Node.Columns = miq.fields
+	if len(miq.fields) > 0 {
+		_spec.Unique = miq.unique != nil && *miq.unique
+	}
+	return sqlgraph.CountNodes(ctx, miq.driver, _spec)
+}
+
+func (miq *MenuItemQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := miq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (miq *MenuItemQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   menuitem.Table,
+			Columns: menuitem.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: menuitem.FieldID,
+			},
+		},
+		From:   miq.sql,
+		Unique: true,
+	}
+	if unique := miq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := miq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, menuitem.FieldID)
+		for i := range fields {
+			if fields[i] != menuitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := miq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := miq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := miq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := miq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (miq *MenuItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(miq.driver.Dialect())
+	t1 := builder.Table(menuitem.Table)
+	columns := miq.fields
+	if len(columns) == 0 {
+		columns = menuitem.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if miq.sql != nil {
+		selector = miq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if miq.unique != nil && *miq.unique {
+		selector.Distinct()
+	}
+	for _, p := range miq.predicates {
+		p(selector)
+	}
+	for _, p := range miq.order {
+		p(selector)
+	}
+	if offset := miq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := miq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MenuItemGroupBy is the group-by builder for MenuItem entities.
+type MenuItemGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (migb *MenuItemGroupBy) Aggregate(fns ...AggregateFunc) *MenuItemGroupBy {
+	migb.fns = append(migb.fns, fns...)
+	return migb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (migb *MenuItemGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := migb.path(ctx)
+	if err != nil {
+		return err
+	}
+	migb.sql = query
+	return migb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (migb *MenuItemGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := migb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (migb *MenuItemGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(migb.fields) > 1 {
+		return nil, errors.New("ent: MenuItemGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := migb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (migb *MenuItemGroupBy) StringsX(ctx context.Context) []string {
+	v, err := migb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (migb *MenuItemGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = migb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{menuitem.Label}
+	default:
+		err = fmt.Errorf("ent: MenuItemGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (migb *MenuItemGroupBy) StringX(ctx context.Context) string {
+	v, err := migb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (migb *MenuItemGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(migb.fields) > 1 {
+		return nil, errors.New("ent: MenuItemGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := migb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (migb *MenuItemGroupBy) IntsX(ctx context.Context) []int {
+	v, err := migb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (migb *MenuItemGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = migb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{menuitem.Label}
+	default:
+		err = fmt.Errorf("ent: MenuItemGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (migb *MenuItemGroupBy) IntX(ctx context.Context) int {
+	v, err := migb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (migb *MenuItemGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(migb.fields) > 1 {
+		return nil, errors.New("ent: MenuItemGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := migb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (migb *MenuItemGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := migb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (migb *MenuItemGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = migb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{menuitem.Label}
+	default:
+		err = fmt.Errorf("ent: MenuItemGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (migb *MenuItemGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := migb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (migb *MenuItemGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(migb.fields) > 1 {
+		return nil, errors.New("ent: MenuItemGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := migb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (migb *MenuItemGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := migb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (migb *MenuItemGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = migb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{menuitem.Label}
+	default:
+		err = fmt.Errorf("ent: MenuItemGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (migb *MenuItemGroupBy) BoolX(ctx context.Context) bool {
+	v, err := migb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (migb *MenuItemGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range migb.fields {
+		if !menuitem.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := migb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := migb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (migb *MenuItemGroupBy) sqlQuery() *sql.Selector {
+	selector := migb.sql.Select()
+	aggregation := make([]string, 0, len(migb.fns))
+	for _, fn := range migb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(migb.fields)+len(migb.fns))
+		for _, f := range migb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(migb.fields...)...)
+}
+
+// MenuItemSelect is the builder for selecting fields of MenuItem entities.
+type MenuItemSelect struct {
+	*MenuItemQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mis *MenuItemSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := mis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	mis.sql = mis.MenuItemQuery.sqlQuery(ctx)
+	return mis.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (mis *MenuItemSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := mis.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (mis *MenuItemSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(mis.fields) > 1 {
+		return nil, errors.New("ent: MenuItemSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := mis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (mis *MenuItemSelect) StringsX(ctx context.Context) []string {
+	v, err := mis.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (mis *MenuItemSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = mis.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{menuitem.Label}
+	default:
+		err = fmt.Errorf("ent: MenuItemSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (mis *MenuItemSelect) StringX(ctx context.Context) string {
+	v, err := mis.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (mis *MenuItemSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(mis.fields) > 1 {
+		return nil, errors.New("ent: MenuItemSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := mis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (mis *MenuItemSelect) IntsX(ctx context.Context) []int {
+	v, err := mis.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (mis *MenuItemSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = mis.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{menuitem.Label}
+	default:
+		err = fmt.Errorf("ent: MenuItemSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (mis *MenuItemSelect) IntX(ctx context.Context) int {
+	v, err := mis.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (mis *MenuItemSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(mis.fields) > 1 {
+		return nil, errors.New("ent: MenuItemSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := mis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (mis *MenuItemSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := mis.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (mis *MenuItemSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = mis.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{menuitem.Label}
+	default:
+		err = fmt.Errorf("ent: MenuItemSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (mis *MenuItemSelect) Float64X(ctx context.Context) float64 {
+	v, err := mis.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (mis *MenuItemSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(mis.fields) > 1 {
+		return nil, errors.New("ent: MenuItemSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := mis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (mis *MenuItemSelect) BoolsX(ctx context.Context) []bool {
+	v, err := mis.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (mis *MenuItemSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = mis.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{menuitem.Label}
+	default:
+		err = fmt.Errorf("ent: MenuItemSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (mis *MenuItemSelect) BoolX(ctx context.Context) bool {
+	v, err := mis.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (mis *MenuItemSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := mis.sql.Query()
+	if err := mis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,889 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menu"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menuitem"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/predicate"
+)
+
+// MenuItemUpdate is the builder for updating MenuItem entities.
+type MenuItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MenuItemMutation
+}
+
+// Where appends a list predicates to the MenuItemUpdate builder.
+func (miu *MenuItemUpdate) Where(ps ...predicate.MenuItem) *MenuItemUpdate {
+	miu.mutation.Where(ps...)
+	return miu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (miu *MenuItemUpdate) SetUpdatedAt(t time.Time) *MenuItemUpdate {
+	miu.mutation.SetUpdatedAt(t)
+	return miu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (miu *MenuItemUpdate) SetDeletedAt(t time.Time) *MenuItemUpdate {
+	miu.mutation.SetDeletedAt(t)
+	return miu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (miu *MenuItemUpdate) SetNillableDeletedAt(t *time.Time) *MenuItemUpdate {
+	if t != nil {
+		miu.SetDeletedAt(*t)
+	}
+	return miu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (miu *MenuItemUpdate) ClearDeletedAt() *MenuItemUpdate {
+	miu.mutation.ClearDeletedAt()
+	return miu
+}
+
+// SetMenuID sets the "menu_id" field.
+func (miu *MenuItemUpdate) SetMenuID(i int) *MenuItemUpdate {
+	miu.mutation.SetMenuID(i)
+	return miu
+}
+
+// SetParentID sets the "parent_id" field.
+func (miu *MenuItemUpdate) SetParentID(i int) *MenuItemUpdate {
+	miu.mutation.ResetParentID()
+	miu.mutation.SetParentID(i)
+	return miu
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (miu *MenuItemUpdate) SetNillableParentID(i *int) *MenuItemUpdate {
+	if i != nil {
+		miu.SetParentID(*i)
+	}
+	return miu
+}
+
+// AddParentID adds i to the "parent_id" field.
+func (miu *MenuItemUpdate) AddParentID(i int) *MenuItemUpdate {
+	miu.mutation.AddParentID(i)
+	return miu
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (miu *MenuItemUpdate) ClearParentID() *MenuItemUpdate {
+	miu.mutation.ClearParentID()
+	return miu
+}
+
+// SetLabel sets the "label" field.
+func (miu *MenuItemUpdate) SetLabel(s string) *MenuItemUpdate {
+	miu.mutation.SetLabel(s)
+	return miu
+}
+
+// SetType sets the "type" field.
+func (miu *MenuItemUpdate) SetType(s string) *MenuItemUpdate {
+	miu.mutation.SetType(s)
+	return miu
+}
+
+// SetTargetID sets the "target_id" field.
+func (miu *MenuItemUpdate) SetTargetID(i int) *MenuItemUpdate {
+	miu.mutation.ResetTargetID()
+	miu.mutation.SetTargetID(i)
+	return miu
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (miu *MenuItemUpdate) SetNillableTargetID(i *int) *MenuItemUpdate {
+	if i != nil {
+		miu.SetTargetID(*i)
+	}
+	return miu
+}
+
+// AddTargetID adds i to the "target_id" field.
+func (miu *MenuItemUpdate) AddTargetID(i int) *MenuItemUpdate {
+	miu.mutation.AddTargetID(i)
+	return miu
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (miu *MenuItemUpdate) ClearTargetID() *MenuItemUpdate {
+	miu.mutation.ClearTargetID()
+	return miu
+}
+
+// SetURL sets the "url" field.
+func (miu *MenuItemUpdate) SetURL(s string) *MenuItemUpdate {
+	miu.mutation.SetURL(s)
+	return miu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (miu *MenuItemUpdate) SetNillableURL(s *string) *MenuItemUpdate {
+	if s != nil {
+		miu.SetURL(*s)
+	}
+	return miu
+}
+
+// ClearURL clears the value of the "url" field.
+func (miu *MenuItemUpdate) ClearURL() *MenuItemUpdate {
+	miu.mutation.ClearURL()
+	return miu
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (miu *MenuItemUpdate) SetSortOrder(i int) *MenuItemUpdate {
+	miu.mutation.ResetSortOrder()
+	miu.mutation.SetSortOrder(i)
+	return miu
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (miu *MenuItemUpdate) SetNillableSortOrder(i *int) *MenuItemUpdate {
+	if i != nil {
+		miu.SetSortOrder(*i)
+	}
+	return miu
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (miu *MenuItemUpdate) AddSortOrder(i int) *MenuItemUpdate {
+	miu.mutation.AddSortOrder(i)
+	return miu
+}
+
+// SetMenu sets the "menu" edge to the Menu entity.
+func (miu *MenuItemUpdate) SetMenu(m *Menu) *MenuItemUpdate {
+	return miu.SetMenuID(m.ID)
+}
+
+// Mutation returns the MenuItemMutation object of the builder.
+func (miu *MenuItemUpdate) Mutation() *MenuItemMutation {
+	return miu.mutation
+}
+
+// ClearMenu clears the "menu" edge to the Menu entity.
+func (miu *MenuItemUpdate) ClearMenu() *MenuItemUpdate {
+	miu.mutation.ClearMenu()
+	return miu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (miu *MenuItemUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	miu.defaults()
+	if len(miu.hooks) == 0 {
+		if err = miu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = miu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MenuItemMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = miu.check(); err != nil {
+				return 0, err
+			}
+			miu.mutation = mutation
+			affected, err = miu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(miu.hooks) - 1; i >= 0; i-- {
+			if miu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = miu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, miu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (miu *MenuItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := miu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (miu *MenuItemUpdate) Exec(ctx context.Context) error {
+	_, err := miu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (miu *MenuItemUpdate) ExecX(ctx context.Context) {
+	if err := miu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (miu *MenuItemUpdate) defaults() {
+	if _, ok := miu.mutation.UpdatedAt(); !ok {
+		v := menuitem.UpdateDefaultUpdatedAt()
+		miu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (miu *MenuItemUpdate) check() error {
+	if v, ok := miu.mutation.Label(); ok {
+		if err := menuitem.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "MenuItem.label": %w`, err)}
+		}
+	}
+	if v, ok := miu.mutation.GetType(); ok {
+		if err := menuitem.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "MenuItem.type": %w`, err)}
+		}
+	}
+	if v, ok := miu.mutation.URL(); ok {
+		if err := menuitem.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "MenuItem.url": %w`, err)}
+		}
+	}
+	if _, ok := miu.mutation.MenuID(); miu.mutation.MenuCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MenuItem.menu"`)
+	}
+	return nil
+}
+
+func (miu *MenuItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   menuitem.Table,
+			Columns: menuitem.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: menuitem.FieldID,
+			},
+		},
+	}
+	if ps := miu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := miu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: menuitem.FieldUpdatedAt,
+		})
+	}
+	if value, ok := miu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: menuitem.FieldDeletedAt,
+		})
+	}
+	if miu.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: menuitem.FieldDeletedAt,
+		})
+	}
+	if value, ok := miu.mutation.ParentID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: menuitem.FieldParentID,
+		})
+	}
+	if value, ok := miu.mutation.AddedParentID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: menuitem.FieldParentID,
+		})
+	}
+	if miu.mutation.ParentIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: menuitem.FieldParentID,
+		})
+	}
+	if value, ok := miu.mutation.Label(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: menuitem.FieldLabel,
+		})
+	}
+	if value, ok := miu.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: menuitem.FieldType,
+		})
+	}
+	if value, ok := miu.mutation.TargetID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: menuitem.FieldTargetID,
+		})
+	}
+	if value, ok := miu.mutation.AddedTargetID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: menuitem.FieldTargetID,
+		})
+	}
+	if miu.mutation.TargetIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: menuitem.FieldTargetID,
+		})
+	}
+	if value, ok := miu.mutation.URL(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: menuitem.FieldURL,
+		})
+	}
+	if miu.mutation.URLCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: menuitem.FieldURL,
+		})
+	}
+	if value, ok := miu.mutation.SortOrder(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: menuitem.FieldSortOrder,
+		})
+	}
+	if value, ok := miu.mutation.AddedSortOrder(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: menuitem.FieldSortOrder,
+		})
+	}
+	if miu.mutation.MenuCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   menuitem.MenuTable,
+			Columns: []string{menuitem.MenuColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: menu.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := miu.mutation.MenuIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   menuitem.MenuTable,
+			Columns: []string{menuitem.MenuColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: menu.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, miu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{menuitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// MenuItemUpdateOne is the builder for updating a single MenuItem entity.
+type MenuItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MenuItemMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (miuo *MenuItemUpdateOne) SetUpdatedAt(t time.Time) *MenuItemUpdateOne {
+	miuo.mutation.SetUpdatedAt(t)
+	return miuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (miuo *MenuItemUpdateOne) SetDeletedAt(t time.Time) *MenuItemUpdateOne {
+	miuo.mutation.SetDeletedAt(t)
+	return miuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (miuo *MenuItemUpdateOne) SetNillableDeletedAt(t *time.Time) *MenuItemUpdateOne {
+	if t != nil {
+		miuo.SetDeletedAt(*t)
+	}
+	return miuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (miuo *MenuItemUpdateOne) ClearDeletedAt() *MenuItemUpdateOne {
+	miuo.mutation.ClearDeletedAt()
+	return miuo
+}
+
+// SetMenuID sets the "menu_id" field.
+func (miuo *MenuItemUpdateOne) SetMenuID(i int) *MenuItemUpdateOne {
+	miuo.mutation.SetMenuID(i)
+	return miuo
+}
+
+// SetParentID sets the "parent_id" field.
+func (miuo *MenuItemUpdateOne) SetParentID(i int) *MenuItemUpdateOne {
+	miuo.mutation.ResetParentID()
+	miuo.mutation.SetParentID(i)
+	return miuo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (miuo *MenuItemUpdateOne) SetNillableParentID(i *int) *MenuItemUpdateOne {
+	if i != nil {
+		miuo.SetParentID(*i)
+	}
+	return miuo
+}
+
+// AddParentID adds i to the "parent_id" field.
+func (miuo *MenuItemUpdateOne) AddParentID(i int) *MenuItemUpdateOne {
+	miuo.mutation.AddParentID(i)
+	return miuo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (miuo *MenuItemUpdateOne) ClearParentID() *MenuItemUpdateOne {
+	miuo.mutation.ClearParentID()
+	return miuo
+}
+
+// SetLabel sets the "label" field.
+func (miuo *MenuItemUpdateOne) SetLabel(s string) *MenuItemUpdateOne {
+	miuo.mutation.SetLabel(s)
+	return miuo
+}
+
+// SetType sets the "type" field.
+func (miuo *MenuItemUpdateOne) SetType(s string) *MenuItemUpdateOne {
+	miuo.mutation.SetType(s)
+	return miuo
+}
+
+// SetTargetID sets the "target_id" field.
+func (miuo *MenuItemUpdateOne) SetTargetID(i int) *MenuItemUpdateOne {
+	miuo.mutation.ResetTargetID()
+	miuo.mutation.SetTargetID(i)
+	return miuo
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (miuo *MenuItemUpdateOne) SetNillableTargetID(i *int) *MenuItemUpdateOne {
+	if i != nil {
+		miuo.SetTargetID(*i)
+	}
+	return miuo
+}
+
+// AddTargetID adds i to the "target_id" field.
+func (miuo *MenuItemUpdateOne) AddTargetID(i int) *MenuItemUpdateOne {
+	miuo.mutation.AddTargetID(i)
+	return miuo
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (miuo *MenuItemUpdateOne) ClearTargetID() *MenuItemUpdateOne {
+	miuo.mutation.ClearTargetID()
+	return miuo
+}
+
+// SetURL sets the "url" field.
+func (miuo *MenuItemUpdateOne) SetURL(s string) *MenuItemUpdateOne {
+	miuo.mutation.SetURL(s)
+	return miuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (miuo *MenuItemUpdateOne) SetNillableURL(s *string) *MenuItemUpdateOne {
+	if s != nil {
+		miuo.SetURL(*s)
+	}
+	return miuo
+}
+
+// ClearURL clears the value of the "url" field.
+func (miuo *MenuItemUpdateOne) ClearURL() *MenuItemUpdateOne {
+	miuo.mutation.ClearURL()
+	return miuo
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (miuo *MenuItemUpdateOne) SetSortOrder(i int) *MenuItemUpdateOne {
+	miuo.mutation.ResetSortOrder()
+	miuo.mutation.SetSortOrder(i)
+	return miuo
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (miuo *MenuItemUpdateOne) SetNillableSortOrder(i *int) *MenuItemUpdateOne {
+	if i != nil {
+		miuo.SetSortOrder(*i)
+	}
+	return miuo
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (miuo *MenuItemUpdateOne) AddSortOrder(i int) *MenuItemUpdateOne {
+	miuo.mutation.AddSortOrder(i)
+	return miuo
+}
+
+// SetMenu sets the "menu" edge to the Menu entity.
+func (miuo *MenuItemUpdateOne) SetMenu(m *Menu) *MenuItemUpdateOne {
+	return miuo.SetMenuID(m.ID)
+}
+
+// Mutation returns the MenuItemMutation object of the builder.
+func (miuo *MenuItemUpdateOne) Mutation() *MenuItemMutation {
+	return miuo.mutation
+}
+
+// ClearMenu clears the "menu" edge to the Menu entity.
+func (miuo *MenuItemUpdateOne) ClearMenu() *MenuItemUpdateOne {
+	miuo.mutation.ClearMenu()
+	return miuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (miuo *MenuItemUpdateOne) Select(field string, fields ...string) *MenuItemUpdateOne {
+	miuo.fields = append([]string{field}, fields...)
+	return miuo
+}
+
+// Save executes the query and returns the updated MenuItem entity.
+func (miuo *MenuItemUpdateOne) Save(ctx context.Context) (*MenuItem, error) {
+	var (
+		err  error
+		node *MenuItem
+	)
+	miuo.defaults()
+	if len(miuo.hooks) == 0 {
+		if err = miuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = miuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MenuItemMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = miuo.check(); err != nil {
+				return nil, err
+			}
+			miuo.mutation = mutation
+			node, err = miuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(miuo.hooks) - 1; i >= 0; i-- {
+			if miuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = miuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, miuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (miuo *MenuItemUpdateOne) SaveX(ctx context.Context) *MenuItem {
+	node, err := miuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (miuo *MenuItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := miuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (miuo *MenuItemUpdateOne) ExecX(ctx context.Context) {
+	if err := miuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (miuo *MenuItemUpdateOne) defaults() {
+	if _, ok := miuo.mutation.UpdatedAt(); !ok {
+		v := menuitem.UpdateDefaultUpdatedAt()
+		miuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (miuo *MenuItemUpdateOne) check() error {
+	if v, ok := miuo.mutation.Label(); ok {
+		if err := menuitem.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "MenuItem.label": %w`, err)}
+		}
+	}
+	if v, ok := miuo.mutation.GetType(); ok {
+		if err := menuitem.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "MenuItem.type": %w`, err)}
+		}
+	}
+	if v, ok := miuo.mutation.URL(); ok {
+		if err := menuitem.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "MenuItem.url": %w`, err)}
+		}
+	}
+	if _, ok := miuo.mutation.MenuID(); miuo.mutation.MenuCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MenuItem.menu"`)
+	}
+	return nil
+}
+
+func (miuo *MenuItemUpdateOne) sqlSave(ctx context.Context) (_node *MenuItem, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   menuitem.Table,
+			Columns: menuitem.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: menuitem.FieldID,
+			},
+		},
+	}
+	id, ok := miuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MenuItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := miuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, menuitem.FieldID)
+		for _, f := range fields {
+			if !menuitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != menuitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := miuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := miuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: menuitem.FieldUpdatedAt,
+		})
+	}
+	if value, ok := miuo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: menuitem.FieldDeletedAt,
+		})
+	}
+	if miuo.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: menuitem.FieldDeletedAt,
+		})
+	}
+	if value, ok := miuo.mutation.ParentID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: menuitem.FieldParentID,
+		})
+	}
+	if value, ok := miuo.mutation.AddedParentID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: menuitem.FieldParentID,
+		})
+	}
+	if miuo.mutation.ParentIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: menuitem.FieldParentID,
+		})
+	}
+	if value, ok := miuo.mutation.Label(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: menuitem.FieldLabel,
+		})
+	}
+	if value, ok := miuo.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: menuitem.FieldType,
+		})
+	}
+	if value, ok := miuo.mutation.TargetID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: menuitem.FieldTargetID,
+		})
+	}
+	if value, ok := miuo.mutation.AddedTargetID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: menuitem.FieldTargetID,
+		})
+	}
+	if miuo.mutation.TargetIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: menuitem.FieldTargetID,
+		})
+	}
+	if value, ok := miuo.mutation.URL(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: menuitem.FieldURL,
+		})
+	}
+	if miuo.mutation.URLCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: menuitem.FieldURL,
+		})
+	}
+	if value, ok := miuo.mutation.SortOrder(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: menuitem.FieldSortOrder,
+		})
+	}
+	if value, ok := miuo.mutation.AddedSortOrder(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: menuitem.FieldSortOrder,
+		})
+	}
+	if miuo.mutation.MenuCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   menuitem.MenuTable,
+			Columns: []string{menuitem.MenuColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: menu.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := miuo.mutation.MenuIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   menuitem.MenuTable,
+			Columns: []string{menuitem.MenuColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: menu.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MenuItem{config: miuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, miuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{menuitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -35,6 +35,12 @@ type Page struct {
 	Draft bool `json:"draft,omitempty"`
 	// FeaturedImageID holds the value of the "featured_image_id" field.
 	FeaturedImageID int `json:"featured_image_id,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID int `json:"parent_id,omitempty"`
+	// SortOrder holds the value of the "sort_order" field.
+	SortOrder int `json:"sort_order,omitempty"`
+	// Template holds the value of the "template" field.
+	Template string `json:"template,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PageQuery when eager-loading is set.
 	Edges PageEdges `json:"edges"`
@@ -44,9 +50,13 @@ type Page struct {
 type PageEdges struct {
 	// FeaturedImage holds the value of the featured_image edge.
 	FeaturedImage *File `json:"featured_image,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Page `json:"children,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Page `json:"parent,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // FeaturedImageOrErr returns the FeaturedImage value or an error if the edge
@@ -63,6 +73,29 @@ func (e PageEdges) FeaturedImageOrErr() (*File, error) {
 	return nil, &NotLoadedError{edge: "featured_image"}
 }
 
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e PageEdges) ChildrenOrErr() ([]*Page, error) {
+	if e.loadedTypes[1] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PageEdges) ParentOrErr() (*Page, error) {
+	if e.loadedTypes[2] {
+		if e.Parent == nil {
+			// The edge parent was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: page.Label}
+		}
+		return e.Parent, nil
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Page) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
@@ -70,9 +103,9 @@ func (*Page) scanValues(columns []string) ([]interface{}, error) {
 		switch columns[i] {
 		case page.FieldDraft:
 			values[i] = new(sql.NullBool)
-		case page.FieldID, page.FieldFeaturedImageID:
+		case page.FieldID, page.FieldFeaturedImageID, page.FieldParentID, page.FieldSortOrder:
 			values[i] = new(sql.NullInt64)
-		case page.FieldName, page.FieldSlug, page.FieldContent, page.FieldContentHTML:
+		case page.FieldName, page.FieldSlug, page.FieldContent, page.FieldContentHTML, page.FieldTemplate:
 			values[i] = new(sql.NullString)
 		case page.FieldCreatedAt, page.FieldUpdatedAt, page.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -151,6 +184,24 @@ func (pa *Page) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				pa.FeaturedImageID = int(value.Int64)
 			}
+		case page.FieldParentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				pa.ParentID = int(value.Int64)
+			}
+		case page.FieldSortOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_order", values[i])
+			} else if value.Valid {
+				pa.SortOrder = int(value.Int64)
+			}
+		case page.FieldTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field template", values[i])
+			} else if value.Valid {
+				pa.Template = value.String
+			}
 		}
 	}
 	return nil
@@ -161,6 +212,16 @@ func (pa *Page) QueryFeaturedImage() *FileQuery {
 	return (&PageClient{config: pa.config}).QueryFeaturedImage(pa)
 }
 
+// QueryChildren queries the "children" edge of the Page entity.
+func (pa *Page) QueryChildren() *PageQuery {
+	return (&PageClient{config: pa.config}).QueryChildren(pa)
+}
+
+// QueryParent queries the "parent" edge of the Page entity.
+func (pa *Page) QueryParent() *PageQuery {
+	return (&PageClient{config: pa.config}).QueryParent(pa)
+}
+
 // Update returns a builder for updating this Page.
 // Note that you need to call Page.Unwrap() before calling this method if this Page
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -202,6 +263,12 @@ func (pa *Page) String() string {
 	builder.WriteString(fmt.Sprintf("%v", pa.Draft))
 	builder.WriteString(", featured_image_id=")
 	builder.WriteString(fmt.Sprintf("%v", pa.FeaturedImageID))
+	builder.WriteString(", parent_id=")
+	builder.WriteString(fmt.Sprintf("%v", pa.ParentID))
+	builder.WriteString(", sort_order=")
+	builder.WriteString(fmt.Sprintf("%v", pa.SortOrder))
+	builder.WriteString(", template=")
+	builder.WriteString(pa.Template)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -29,8 +29,18 @@ const (
 	FieldDraft = "draft"
 	// FieldFeaturedImageID holds the string denoting the featured_image_id field in the database.
 	FieldFeaturedImageID = "featured_image_id"
+	// FieldParentID holds the string denoting the parent_id field in the database.
+	FieldParentID = "parent_id"
+	// FieldSortOrder holds the string denoting the sort_order field in the database.
+	FieldSortOrder = "sort_order"
+	// FieldTemplate holds the string denoting the template field in the database.
+	FieldTemplate = "template"
 	// EdgeFeaturedImage holds the string denoting the featured_image edge name in mutations.
 	EdgeFeaturedImage = "featured_image"
+	// EdgeChildren holds the string denoting the children edge name in mutations.
+	EdgeChildren = "children"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
 	// Table holds the table name of the page in the database.
 	Table = "pages"
 	// FeaturedImageTable is the table that holds the featured_image relation/edge.
@@ -40,6 +50,14 @@ const (
 	FeaturedImageInverseTable = "files"
 	// FeaturedImageColumn is the table column denoting the featured_image relation/edge.
 	FeaturedImageColumn = "featured_image_id"
+	// ChildrenTable is the table that holds the children relation/edge.
+	ChildrenTable = "pages"
+	// ChildrenColumn is the table column denoting the children relation/edge.
+	ChildrenColumn = "parent_id"
+	// ParentTable is the table that holds the parent relation/edge.
+	ParentTable = "pages"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "parent_id"
 )
 
 // Columns holds all SQL columns for page fields.
@@ -54,6 +72,9 @@ var Columns = []string{
 	FieldContentHTML,
 	FieldDraft,
 	FieldFeaturedImageID,
+	FieldParentID,
+	FieldSortOrder,
+	FieldTemplate,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -75,4 +96,8 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultDraft holds the default value on creation for the "draft" field.
 	DefaultDraft bool
+	// DefaultSortOrder holds the default value on creation for the "sort_order" field.
+	DefaultSortOrder int
+	// TemplateValidator is a validator for the "template" field. It is called by the builders before save.
+	TemplateValidator func(string) error
 )
@@ -117,11 +117,73 @@ func (pc *PageCreate) SetNillableFeaturedImageID(i *int) *PageCreate {
 	return pc
 }
 
+// SetParentID sets the "parent_id" field.
+func (pc *PageCreate) SetParentID(i int) *PageCreate {
+	pc.mutation.SetParentID(i)
+	return pc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (pc *PageCreate) SetNillableParentID(i *int) *PageCreate {
+	if i != nil {
+		pc.SetParentID(*i)
+	}
+	return pc
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (pc *PageCreate) SetSortOrder(i int) *PageCreate {
+	pc.mutation.SetSortOrder(i)
+	return pc
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (pc *PageCreate) SetNillableSortOrder(i *int) *PageCreate {
+	if i != nil {
+		pc.SetSortOrder(*i)
+	}
+	return pc
+}
+
+// SetTemplate sets the "template" field.
+func (pc *PageCreate) SetTemplate(s string) *PageCreate {
+	pc.mutation.SetTemplate(s)
+	return pc
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (pc *PageCreate) SetNillableTemplate(s *string) *PageCreate {
+	if s != nil {
+		pc.SetTemplate(*s)
+	}
+	return pc
+}
+
 // SetFeaturedImage sets the "featured_image" edge to the File entity.
 func (pc *PageCreate) SetFeaturedImage(f *File) *PageCreate {
 	return pc.SetFeaturedImageID(f.ID)
 }
 
+// AddChildIDs adds the "children" edge to the Page entity by IDs.
+func (pc *PageCreate) AddChildIDs(ids ...int) *PageCreate {
+	pc.mutation.AddChildIDs(ids...)
+	return pc
+}
+
+// AddChildren adds the "children" edges to the Page entity.
+func (pc *PageCreate) AddChildren(p ...*Page) *PageCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddChildIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Page entity.
+func (pc *PageCreate) SetParent(p *Page) *PageCreate {
+	return pc.SetParentID(p.ID)
+}
+
 // Mutation returns the PageMutation object of the builder.
 func (pc *PageCreate) Mutation() *PageMutation {
 	return pc.mutation
@@ -205,6 +267,10 @@ func (pc *PageCreate) defaults() {
 		v := page.DefaultDraft
 		pc.mutation.SetDraft(v)
 	}
+	if _, ok := pc.mutation.SortOrder(); !ok {
+		v := page.DefaultSortOrder
+		pc.mutation.SetSortOrder(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -227,6 +293,14 @@ func (pc *PageCreate) check() error {
 	if _, ok := pc.mutation.ContentHTML(); !ok {
 		return &ValidationError{Name: "content_html", err: errors.New(`ent: missing required field "Page.content_html"`)}
 	}
+	if _, ok := pc.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "Page.sort_order"`)}
+	}
+	if v, ok := pc.mutation.Template(); ok {
+		if err := page.TemplateValidator(v); err != nil {
+			return &ValidationError{Name: "template", err: fmt.Errorf(`ent: validator failed for field "Page.template": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -319,6 +393,22 @@ func (pc *PageCreate) createSpec() (*Page, *sqlgraph.CreateSpec) {
 		})
 		_node.Draft = value
 	}
+	if value, ok := pc.mutation.SortOrder(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: page.FieldSortOrder,
+		})
+		_node.SortOrder = value
+	}
+	if value, ok := pc.mutation.Template(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: page.FieldTemplate,
+		})
+		_node.Template = value
+	}
 	if nodes := pc.mutation.FeaturedImageIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -339,6 +429,45 @@ func (pc *PageCreate) createSpec() (*Page, *sqlgraph.CreateSpec) {
 		_node.FeaturedImageID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.ChildrenTable,
+			Columns: []string{page.ChildrenColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: page.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   page.ParentTable,
+			Columns: []string{page.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: page.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -519,6 +648,60 @@ func (u *PageUpsert) ClearFeaturedImageID() *PageUpsert {
 	return u
 }
 
+// SetParentID sets the "parent_id" field.
+func (u *PageUpsert) SetParentID(v int) *PageUpsert {
+	u.Set(page.FieldParentID, v)
+	return u
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *PageUpsert) UpdateParentID() *PageUpsert {
+	u.SetExcluded(page.FieldParentID)
+	return u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *PageUpsert) ClearParentID() *PageUpsert {
+	u.SetNull(page.FieldParentID)
+	return u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *PageUpsert) SetSortOrder(v int) *PageUpsert {
+	u.Set(page.FieldSortOrder, v)
+	return u
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *PageUpsert) UpdateSortOrder() *PageUpsert {
+	u.SetExcluded(page.FieldSortOrder)
+	return u
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *PageUpsert) AddSortOrder(v int) *PageUpsert {
+	u.Add(page.FieldSortOrder, v)
+	return u
+}
+
+// SetTemplate sets the "template" field.
+func (u *PageUpsert) SetTemplate(v string) *PageUpsert {
+	u.Set(page.FieldTemplate, v)
+	return u
+}
+
+// UpdateTemplate sets the "template" field to the value that was provided on create.
+func (u *PageUpsert) UpdateTemplate() *PageUpsert {
+	u.SetExcluded(page.FieldTemplate)
+	return u
+}
+
+// ClearTemplate clears the value of the "template" field.
+func (u *PageUpsert) ClearTemplate() *PageUpsert {
+	u.SetNull(page.FieldTemplate)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -713,6 +896,69 @@ func (u *PageUpsertOne) ClearFeaturedImageID() *PageUpsertOne {
 	})
 }
 
+// SetParentID sets the "parent_id" field.
+func (u *PageUpsertOne) SetParentID(v int) *PageUpsertOne {
+	return u.Update(func(s *PageUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *PageUpsertOne) UpdateParentID() *PageUpsertOne {
+	return u.Update(func(s *PageUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *PageUpsertOne) ClearParentID() *PageUpsertOne {
+	return u.Update(func(s *PageUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *PageUpsertOne) SetSortOrder(v int) *PageUpsertOne {
+	return u.Update(func(s *PageUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *PageUpsertOne) AddSortOrder(v int) *PageUpsertOne {
+	return u.Update(func(s *PageUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *PageUpsertOne) UpdateSortOrder() *PageUpsertOne {
+	return u.Update(func(s *PageUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// SetTemplate sets the "template" field.
+func (u *PageUpsertOne) SetTemplate(v string) *PageUpsertOne {
+	return u.Update(func(s *PageUpsert) {
+		s.SetTemplate(v)
+	})
+}
+
+// UpdateTemplate sets the "template" field to the value that was provided on create.
+func (u *PageUpsertOne) UpdateTemplate() *PageUpsertOne {
+	return u.Update(func(s *PageUpsert) {
+		s.UpdateTemplate()
+	})
+}
+
+// ClearTemplate clears the value of the "template" field.
+func (u *PageUpsertOne) ClearTemplate() *PageUpsertOne {
+	return u.Update(func(s *PageUpsert) {
+		s.ClearTemplate()
+	})
+}
+
 // Exec executes the query.
 func (u *PageUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1071,6 +1317,69 @@ func (u *PageUpsertBulk) ClearFeaturedImageID() *PageUpsertBulk {
 	})
 }
 
+// SetParentID sets the "parent_id" field.
+func (u *PageUpsertBulk) SetParentID(v int) *PageUpsertBulk {
+	return u.Update(func(s *PageUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *PageUpsertBulk) UpdateParentID() *PageUpsertBulk {
+	return u.Update(func(s *PageUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *PageUpsertBulk) ClearParentID() *PageUpsertBulk {
+	return u.Update(func(s *PageUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *PageUpsertBulk) SetSortOrder(v int) *PageUpsertBulk {
+	return u.Update(func(s *PageUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *PageUpsertBulk) AddSortOrder(v int) *PageUpsertBulk {
+	return u.Update(func(s *PageUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *PageUpsertBulk) UpdateSortOrder() *PageUpsertBulk {
+	return u.Update(func(s *PageUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// SetTemplate sets the "template" field.
+func (u *PageUpsertBulk) SetTemplate(v string) *PageUpsertBulk {
+	return u.Update(func(s *PageUpsert) {
+		s.SetTemplate(v)
+	})
+}
+
+// UpdateTemplate sets the "template" field to the value that was provided on create.
+func (u *PageUpsertBulk) UpdateTemplate() *PageUpsertBulk {
+	return u.Update(func(s *PageUpsert) {
+		s.UpdateTemplate()
+	})
+}
+
+// ClearTemplate clears the value of the "template" field.
+func (u *PageUpsertBulk) ClearTemplate() *PageUpsertBulk {
+	return u.Update(func(s *PageUpsert) {
+		s.ClearTemplate()
+	})
+}
+
 // Exec executes the query.
 func (u *PageUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"math"
@@ -27,6 +28,8 @@ type PageQuery struct {
 	predicates []predicate.Page
 	// eager-loading edges.
 	withFeaturedImage *FileQuery
+	withChildren      *PageQuery
+	withParent        *PageQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -85,6 +88,50 @@ func (pq *PageQuery) QueryFeaturedImage() *FileQuery {
 	return query
 }
 
+// QueryChildren chains the current query on the "children" edge.
+func (pq *PageQuery) QueryChildren() *PageQuery {
+	query := &PageQuery{config: pq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(page.Table, page.FieldID, selector),
+			sqlgraph.To(page.Table, page.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, page.ChildrenTable, page.ChildrenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParent chains the current query on the "parent" edge.
+func (pq *PageQuery) QueryParent() *PageQuery {
+	query := &PageQuery{config: pq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(page.Table, page.FieldID, selector),
+			sqlgraph.To(page.Table, page.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, page.ParentTable, page.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Page entity from the query.
 // Returns a *NotFoundError when no Page was found.
 func (pq *PageQuery) First(ctx context.Context) (*Page, error) {
@@ -267,6 +314,8 @@ func (pq *PageQuery) Clone() *PageQuery {
 		order:             append([]OrderFunc{}, pq.order...),
 		predicates:        append([]predicate.Page{}, pq.predicates...),
 		withFeaturedImage: pq.withFeaturedImage.Clone(),
+		withChildren:      pq.withChildren.Clone(),
+		withParent:        pq.withParent.Clone(),
 		// clone intermediate query.
 		sql:    pq.sql.Clone(),
 		path:   pq.path,
@@ -285,6 +334,28 @@ func (pq *PageQuery) WithFeaturedImage(opts ...func(*FileQuery)) *PageQuery {
 	return pq
 }
 
+// WithChildren tells the query-builder to eager-load the nodes that are connected to
+// the "children" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PageQuery) WithChildren(opts ...func(*PageQuery)) *PageQuery {
+	query := &PageQuery{config: pq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withChildren = query
+	return pq
+}
+
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PageQuery) WithParent(opts ...func(*PageQuery)) *PageQuery {
+	query := &PageQuery{config: pq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withParent = query
+	return pq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -350,8 +421,10 @@ func (pq *PageQuery) sqlAll(ctx context.Context) ([]*Page, error) {
 	var (
 		nodes       = []*Page{}
 		_spec       = pq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			pq.withFeaturedImage != nil,
+			pq.withChildren != nil,
+			pq.withParent != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
@@ -400,6 +473,57 @@ func (pq *PageQuery) sqlAll(ctx context.Context) ([]*Page, error) {
 		}
 	}
 
+	if query := pq.withChildren; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Page)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Children = []*Page{}
+		}
+		query.Where(predicate.Page(func(s *sql.Selector) {
+			s.Where(sql.InValues(page.ChildrenColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.ParentID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "parent_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Children = append(node.Edges.Children, n)
+		}
+	}
+
+	if query := pq.withParent; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*Page)
+		for i := range nodes {
+			fk := nodes[i].ParentID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(page.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "parent_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Parent = n
+			}
+		}
+	}
+
 	return nodes, nil
 }
 
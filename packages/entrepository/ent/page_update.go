@@ -119,11 +119,92 @@ func (pu *PageUpdate) ClearFeaturedImageID() *PageUpdate {
 	return pu
 }
 
+// SetParentID sets the "parent_id" field.
+func (pu *PageUpdate) SetParentID(i int) *PageUpdate {
+	pu.mutation.SetParentID(i)
+	return pu
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (pu *PageUpdate) SetNillableParentID(i *int) *PageUpdate {
+	if i != nil {
+		pu.SetParentID(*i)
+	}
+	return pu
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (pu *PageUpdate) ClearParentID() *PageUpdate {
+	pu.mutation.ClearParentID()
+	return pu
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (pu *PageUpdate) SetSortOrder(i int) *PageUpdate {
+	pu.mutation.ResetSortOrder()
+	pu.mutation.SetSortOrder(i)
+	return pu
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (pu *PageUpdate) SetNillableSortOrder(i *int) *PageUpdate {
+	if i != nil {
+		pu.SetSortOrder(*i)
+	}
+	return pu
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (pu *PageUpdate) AddSortOrder(i int) *PageUpdate {
+	pu.mutation.AddSortOrder(i)
+	return pu
+}
+
+// SetTemplate sets the "template" field.
+func (pu *PageUpdate) SetTemplate(s string) *PageUpdate {
+	pu.mutation.SetTemplate(s)
+	return pu
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (pu *PageUpdate) SetNillableTemplate(s *string) *PageUpdate {
+	if s != nil {
+		pu.SetTemplate(*s)
+	}
+	return pu
+}
+
+// ClearTemplate clears the value of the "template" field.
+func (pu *PageUpdate) ClearTemplate() *PageUpdate {
+	pu.mutation.ClearTemplate()
+	return pu
+}
+
 // SetFeaturedImage sets the "featured_image" edge to the File entity.
 func (pu *PageUpdate) SetFeaturedImage(f *File) *PageUpdate {
 	return pu.SetFeaturedImageID(f.ID)
 }
 
+// AddChildIDs adds the "children" edge to the Page entity by IDs.
+func (pu *PageUpdate) AddChildIDs(ids ...int) *PageUpdate {
+	pu.mutation.AddChildIDs(ids...)
+	return pu
+}
+
+// AddChildren adds the "children" edges to the Page entity.
+func (pu *PageUpdate) AddChildren(p ...*Page) *PageUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddChildIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Page entity.
+func (pu *PageUpdate) SetParent(p *Page) *PageUpdate {
+	return pu.SetParentID(p.ID)
+}
+
 // Mutation returns the PageMutation object of the builder.
 func (pu *PageUpdate) Mutation() *PageMutation {
 	return pu.mutation
@@ -135,6 +216,33 @@ func (pu *PageUpdate) ClearFeaturedImage() *PageUpdate {
 	return pu
 }
 
+// ClearChildren clears all "children" edges to the Page entity.
+func (pu *PageUpdate) ClearChildren() *PageUpdate {
+	pu.mutation.ClearChildren()
+	return pu
+}
+
+// RemoveChildIDs removes the "children" edge to Page entities by IDs.
+func (pu *PageUpdate) RemoveChildIDs(ids ...int) *PageUpdate {
+	pu.mutation.RemoveChildIDs(ids...)
+	return pu
+}
+
+// RemoveChildren removes "children" edges to Page entities.
+func (pu *PageUpdate) RemoveChildren(p ...*Page) *PageUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveChildIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the Page entity.
+func (pu *PageUpdate) ClearParent() *PageUpdate {
+	pu.mutation.ClearParent()
+	return pu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *PageUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -143,6 +251,9 @@ func (pu *PageUpdate) Save(ctx context.Context) (int, error) {
 	)
 	pu.defaults()
 	if len(pu.hooks) == 0 {
+		if err = pu.check(); err != nil {
+			return 0, err
+		}
 		affected, err = pu.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -150,6 +261,9 @@ func (pu *PageUpdate) Save(ctx context.Context) (int, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = pu.check(); err != nil {
+				return 0, err
+			}
 			pu.mutation = mutation
 			affected, err = pu.sqlSave(ctx)
 			mutation.done = true
@@ -198,6 +312,16 @@ func (pu *PageUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (pu *PageUpdate) check() error {
+	if v, ok := pu.mutation.Template(); ok {
+		if err := page.TemplateValidator(v); err != nil {
+			return &ValidationError{Name: "template", err: fmt.Errorf(`ent: validator failed for field "Page.template": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (pu *PageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -277,6 +401,33 @@ func (pu *PageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: page.FieldDraft,
 		})
 	}
+	if value, ok := pu.mutation.SortOrder(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: page.FieldSortOrder,
+		})
+	}
+	if value, ok := pu.mutation.AddedSortOrder(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: page.FieldSortOrder,
+		})
+	}
+	if value, ok := pu.mutation.Template(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: page.FieldTemplate,
+		})
+	}
+	if pu.mutation.TemplateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: page.FieldTemplate,
+		})
+	}
 	if pu.mutation.FeaturedImageCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -312,6 +463,95 @@ func (pu *PageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.ChildrenTable,
+			Columns: []string{page.ChildrenColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: page.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !pu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.ChildrenTable,
+			Columns: []string{page.ChildrenColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: page.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.ChildrenTable,
+			Columns: []string{page.ChildrenColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: page.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   page.ParentTable,
+			Columns: []string{page.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: page.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   page.ParentTable,
+			Columns: []string{page.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: page.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{page.Label}
@@ -421,11 +661,92 @@ func (puo *PageUpdateOne) ClearFeaturedImageID() *PageUpdateOne {
 	return puo
 }
 
+// SetParentID sets the "parent_id" field.
+func (puo *PageUpdateOne) SetParentID(i int) *PageUpdateOne {
+	puo.mutation.SetParentID(i)
+	return puo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (puo *PageUpdateOne) SetNillableParentID(i *int) *PageUpdateOne {
+	if i != nil {
+		puo.SetParentID(*i)
+	}
+	return puo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (puo *PageUpdateOne) ClearParentID() *PageUpdateOne {
+	puo.mutation.ClearParentID()
+	return puo
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (puo *PageUpdateOne) SetSortOrder(i int) *PageUpdateOne {
+	puo.mutation.ResetSortOrder()
+	puo.mutation.SetSortOrder(i)
+	return puo
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (puo *PageUpdateOne) SetNillableSortOrder(i *int) *PageUpdateOne {
+	if i != nil {
+		puo.SetSortOrder(*i)
+	}
+	return puo
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (puo *PageUpdateOne) AddSortOrder(i int) *PageUpdateOne {
+	puo.mutation.AddSortOrder(i)
+	return puo
+}
+
+// SetTemplate sets the "template" field.
+func (puo *PageUpdateOne) SetTemplate(s string) *PageUpdateOne {
+	puo.mutation.SetTemplate(s)
+	return puo
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (puo *PageUpdateOne) SetNillableTemplate(s *string) *PageUpdateOne {
+	if s != nil {
+		puo.SetTemplate(*s)
+	}
+	return puo
+}
+
+// ClearTemplate clears the value of the "template" field.
+func (puo *PageUpdateOne) ClearTemplate() *PageUpdateOne {
+	puo.mutation.ClearTemplate()
+	return puo
+}
+
 // SetFeaturedImage sets the "featured_image" edge to the File entity.
 func (puo *PageUpdateOne) SetFeaturedImage(f *File) *PageUpdateOne {
 	return puo.SetFeaturedImageID(f.ID)
 }
 
+// AddChildIDs adds the "children" edge to the Page entity by IDs.
+func (puo *PageUpdateOne) AddChildIDs(ids ...int) *PageUpdateOne {
+	puo.mutation.AddChildIDs(ids...)
+	return puo
+}
+
+// AddChildren adds the "children" edges to the Page entity.
+func (puo *PageUpdateOne) AddChildren(p ...*Page) *PageUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddChildIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Page entity.
+func (puo *PageUpdateOne) SetParent(p *Page) *PageUpdateOne {
+	return puo.SetParentID(p.ID)
+}
+
 // Mutation returns the PageMutation object of the builder.
 func (puo *PageUpdateOne) Mutation() *PageMutation {
 	return puo.mutation
@@ -437,6 +758,33 @@ func (puo *PageUpdateOne) ClearFeaturedImage() *PageUpdateOne {
 	return puo
 }
 
+// ClearChildren clears all "children" edges to the Page entity.
+func (puo *PageUpdateOne) ClearChildren() *PageUpdateOne {
+	puo.mutation.ClearChildren()
+	return puo
+}
+
+// RemoveChildIDs removes the "children" edge to Page entities by IDs.
+func (puo *PageUpdateOne) RemoveChildIDs(ids ...int) *PageUpdateOne {
+	puo.mutation.RemoveChildIDs(ids...)
+	return puo
+}
+
+// RemoveChildren removes "children" edges to Page entities.
+func (puo *PageUpdateOne) RemoveChildren(p ...*Page) *PageUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveChildIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the Page entity.
+func (puo *PageUpdateOne) ClearParent() *PageUpdateOne {
+	puo.mutation.ClearParent()
+	return puo
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (puo *PageUpdateOne) Select(field string, fields ...string) *PageUpdateOne {
@@ -452,6 +800,9 @@ func (puo *PageUpdateOne) Save(ctx context.Context) (*Page, error) {
 	)
 	puo.defaults()
 	if len(puo.hooks) == 0 {
+		if err = puo.check(); err != nil {
+			return nil, err
+		}
 		node, err = puo.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -459,6 +810,9 @@ func (puo *PageUpdateOne) Save(ctx context.Context) (*Page, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = puo.check(); err != nil {
+				return nil, err
+			}
 			puo.mutation = mutation
 			node, err = puo.sqlSave(ctx)
 			mutation.done = true
@@ -507,6 +861,16 @@ func (puo *PageUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (puo *PageUpdateOne) check() error {
+	if v, ok := puo.mutation.Template(); ok {
+		if err := page.TemplateValidator(v); err != nil {
+			return &ValidationError{Name: "template", err: fmt.Errorf(`ent: validator failed for field "Page.template": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (puo *PageUpdateOne) sqlSave(ctx context.Context) (_node *Page, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -603,6 +967,33 @@ func (puo *PageUpdateOne) sqlSave(ctx context.Context) (_node *Page, err error)
 			Column: page.FieldDraft,
 		})
 	}
+	if value, ok := puo.mutation.SortOrder(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: page.FieldSortOrder,
+		})
+	}
+	if value, ok := puo.mutation.AddedSortOrder(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: page.FieldSortOrder,
+		})
+	}
+	if value, ok := puo.mutation.Template(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: page.FieldTemplate,
+		})
+	}
+	if puo.mutation.TemplateCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: page.FieldTemplate,
+		})
+	}
 	if puo.mutation.FeaturedImageCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -638,6 +1029,95 @@ func (puo *PageUpdateOne) sqlSave(ctx context.Context) (_node *Page, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.ChildrenTable,
+			Columns: []string{page.ChildrenColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: page.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !puo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.ChildrenTable,
+			Columns: []string{page.ChildrenColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: page.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   page.ChildrenTable,
+			Columns: []string{page.ChildrenColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: page.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   page.ParentTable,
+			Columns: []string{page.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: page.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   page.ParentTable,
+			Columns: []string{page.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: page.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Page{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,1341 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/passkey"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/user"
+)
+
+// PasskeyCreate is the builder for creating a Passkey entity.
+type PasskeyCreate struct {
+	config
+	mutation *PasskeyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *PasskeyCreate) SetCreatedAt(t time.Time) *PasskeyCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *PasskeyCreate) SetNillableCreatedAt(t *time.Time) *PasskeyCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pc *PasskeyCreate) SetUpdatedAt(t time.Time) *PasskeyCreate {
+	pc.mutation.SetUpdatedAt(t)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pc *PasskeyCreate) SetNillableUpdatedAt(t *time.Time) *PasskeyCreate {
+	if t != nil {
+		pc.SetUpdatedAt(*t)
+	}
+	return pc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pc *PasskeyCreate) SetDeletedAt(t time.Time) *PasskeyCreate {
+	pc.mutation.SetDeletedAt(t)
+	return pc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pc *PasskeyCreate) SetNillableDeletedAt(t *time.Time) *PasskeyCreate {
+	if t != nil {
+		pc.SetDeletedAt(*t)
+	}
+	return pc
+}
+
+// SetUserID sets the "user_id" field.
+func (pc *PasskeyCreate) SetUserID(i int) *PasskeyCreate {
+	pc.mutation.SetUserID(i)
+	return pc
+}
+
+// SetName sets the "name" field.
+func (pc *PasskeyCreate) SetName(s string) *PasskeyCreate {
+	pc.mutation.SetName(s)
+	return pc
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (pc *PasskeyCreate) SetCredentialID(s string) *PasskeyCreate {
+	pc.mutation.SetCredentialID(s)
+	return pc
+}
+
+// SetPublicKey sets the "public_key" field.
+func (pc *PasskeyCreate) SetPublicKey(b []byte) *PasskeyCreate {
+	pc.mutation.SetPublicKey(b)
+	return pc
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (pc *PasskeyCreate) SetAttestationType(s string) *PasskeyCreate {
+	pc.mutation.SetAttestationType(s)
+	return pc
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (pc *PasskeyCreate) SetNillableAttestationType(s *string) *PasskeyCreate {
+	if s != nil {
+		pc.SetAttestationType(*s)
+	}
+	return pc
+}
+
+// SetAaguid sets the "aaguid" field.
+func (pc *PasskeyCreate) SetAaguid(b []byte) *PasskeyCreate {
+	pc.mutation.SetAaguid(b)
+	return pc
+}
+
+// SetSignCount sets the "sign_count" field.
+func (pc *PasskeyCreate) SetSignCount(u uint32) *PasskeyCreate {
+	pc.mutation.SetSignCount(u)
+	return pc
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (pc *PasskeyCreate) SetNillableSignCount(u *uint32) *PasskeyCreate {
+	if u != nil {
+		pc.SetSignCount(*u)
+	}
+	return pc
+}
+
+// SetTransports sets the "transports" field.
+func (pc *PasskeyCreate) SetTransports(s string) *PasskeyCreate {
+	pc.mutation.SetTransports(s)
+	return pc
+}
+
+// SetNillableTransports sets the "transports" field if the given value is not nil.
+func (pc *PasskeyCreate) SetNillableTransports(s *string) *PasskeyCreate {
+	if s != nil {
+		pc.SetTransports(*s)
+	}
+	return pc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (pc *PasskeyCreate) SetLastUsedAt(t time.Time) *PasskeyCreate {
+	pc.mutation.SetLastUsedAt(t)
+	return pc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (pc *PasskeyCreate) SetNillableLastUsedAt(t *time.Time) *PasskeyCreate {
+	if t != nil {
+		pc.SetLastUsedAt(*t)
+	}
+	return pc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pc *PasskeyCreate) SetUser(u *User) *PasskeyCreate {
+	return pc.SetUserID(u.ID)
+}
+
+// Mutation returns the PasskeyMutation object of the builder.
+func (pc *PasskeyCreate) Mutation() *PasskeyMutation {
+	return pc.mutation
+}
+
+// Save creates the Passkey in the database.
+func (pc *PasskeyCreate) Save(ctx context.Context) (*Passkey, error) {
+	var (
+		err  error
+		node *Passkey
+	)
+	pc.defaults()
+	if len(pc.hooks) == 0 {
+		if err = pc.check(); err != nil {
+			return nil, err
+		}
+		node, err = pc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PasskeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pc.check(); err != nil {
+				return nil, err
+			}
+			pc.mutation = mutation
+			if node, err = pc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pc.hooks) - 1; i >= 0; i-- {
+			if pc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PasskeyCreate) SaveX(ctx context.Context) *Passkey {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *PasskeyCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *PasskeyCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PasskeyCreate) defaults() {
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := passkey.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		v := passkey.DefaultUpdatedAt()
+		pc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pc.mutation.SignCount(); !ok {
+		v := passkey.DefaultSignCount
+		pc.mutation.SetSignCount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PasskeyCreate) check() error {
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Passkey.created_at"`)}
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Passkey.updated_at"`)}
+	}
+	if _, ok := pc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Passkey.user_id"`)}
+	}
+	if _, ok := pc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Passkey.name"`)}
+	}
+	if _, ok := pc.mutation.CredentialID(); !ok {
+		return &ValidationError{Name: "credential_id", err: errors.New(`ent: missing required field "Passkey.credential_id"`)}
+	}
+	if v, ok := pc.mutation.CredentialID(); ok {
+		if err := passkey.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "Passkey.credential_id": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`ent: missing required field "Passkey.public_key"`)}
+	}
+	if _, ok := pc.mutation.SignCount(); !ok {
+		return &ValidationError{Name: "sign_count", err: errors.New(`ent: missing required field "Passkey.sign_count"`)}
+	}
+	if _, ok := pc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Passkey.user"`)}
+	}
+	return nil
+}
+
+func (pc *PasskeyCreate) sqlSave(ctx context.Context) (*Passkey, error) {
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (pc *PasskeyCreate) createSpec() (*Passkey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Passkey{config: pc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: passkey.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: passkey.FieldID,
+			},
+		}
+	)
+	_spec.OnConflict = pc.conflict
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: passkey.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: passkey.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if value, ok := pc.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: passkey.FieldDeletedAt,
+		})
+		_node.DeletedAt = value
+	}
+	if value, ok := pc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: passkey.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := pc.mutation.CredentialID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: passkey.FieldCredentialID,
+		})
+		_node.CredentialID = value
+	}
+	if value, ok := pc.mutation.PublicKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: passkey.FieldPublicKey,
+		})
+		_node.PublicKey = value
+	}
+	if value, ok := pc.mutation.AttestationType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: passkey.FieldAttestationType,
+		})
+		_node.AttestationType = value
+	}
+	if value, ok := pc.mutation.Aaguid(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: passkey.FieldAaguid,
+		})
+		_node.Aaguid = value
+	}
+	if value, ok := pc.mutation.SignCount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: passkey.FieldSignCount,
+		})
+		_node.SignCount = value
+	}
+	if value, ok := pc.mutation.Transports(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: passkey.FieldTransports,
+		})
+		_node.Transports = value
+	}
+	if value, ok := pc.mutation.LastUsedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: passkey.FieldLastUsedAt,
+		})
+		_node.LastUsedAt = &value
+	}
+	if nodes := pc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passkey.UserTable,
+			Columns: []string{passkey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Passkey.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PasskeyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (pc *PasskeyCreate) OnConflict(opts ...sql.ConflictOption) *PasskeyUpsertOne {
+	pc.conflict = opts
+	return &PasskeyUpsertOne{
+		create: pc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Passkey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (pc *PasskeyCreate) OnConflictColumns(columns ...string) *PasskeyUpsertOne {
+	pc.conflict = append(pc.conflict, sql.ConflictColumns(columns...))
+	return &PasskeyUpsertOne{
+		create: pc,
+	}
+}
+
+type (
+	// PasskeyUpsertOne is the builder for "upsert"-ing
+	//  one Passkey node.
+	PasskeyUpsertOne struct {
+		create *PasskeyCreate
+	}
+
+	// PasskeyUpsert is the "OnConflict" setter.
+	PasskeyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PasskeyUpsert) SetCreatedAt(v time.Time) *PasskeyUpsert {
+	u.Set(passkey.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PasskeyUpsert) UpdateCreatedAt() *PasskeyUpsert {
+	u.SetExcluded(passkey.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PasskeyUpsert) SetUpdatedAt(v time.Time) *PasskeyUpsert {
+	u.Set(passkey.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PasskeyUpsert) UpdateUpdatedAt() *PasskeyUpsert {
+	u.SetExcluded(passkey.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PasskeyUpsert) SetDeletedAt(v time.Time) *PasskeyUpsert {
+	u.Set(passkey.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PasskeyUpsert) UpdateDeletedAt() *PasskeyUpsert {
+	u.SetExcluded(passkey.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PasskeyUpsert) ClearDeletedAt() *PasskeyUpsert {
+	u.SetNull(passkey.FieldDeletedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PasskeyUpsert) SetUserID(v int) *PasskeyUpsert {
+	u.Set(passkey.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PasskeyUpsert) UpdateUserID() *PasskeyUpsert {
+	u.SetExcluded(passkey.FieldUserID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PasskeyUpsert) SetName(v string) *PasskeyUpsert {
+	u.Set(passkey.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PasskeyUpsert) UpdateName() *PasskeyUpsert {
+	u.SetExcluded(passkey.FieldName)
+	return u
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (u *PasskeyUpsert) SetCredentialID(v string) *PasskeyUpsert {
+	u.Set(passkey.FieldCredentialID, v)
+	return u
+}
+
+// UpdateCredentialID sets the "credential_id" field to the value that was provided on create.
+func (u *PasskeyUpsert) UpdateCredentialID() *PasskeyUpsert {
+	u.SetExcluded(passkey.FieldCredentialID)
+	return u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (u *PasskeyUpsert) SetPublicKey(v []byte) *PasskeyUpsert {
+	u.Set(passkey.FieldPublicKey, v)
+	return u
+}
+
+// UpdatePublicKey sets the "public_key" field to the value that was provided on create.
+func (u *PasskeyUpsert) UpdatePublicKey() *PasskeyUpsert {
+	u.SetExcluded(passkey.FieldPublicKey)
+	return u
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (u *PasskeyUpsert) SetAttestationType(v string) *PasskeyUpsert {
+	u.Set(passkey.FieldAttestationType, v)
+	return u
+}
+
+// UpdateAttestationType sets the "attestation_type" field to the value that was provided on create.
+func (u *PasskeyUpsert) UpdateAttestationType() *PasskeyUpsert {
+	u.SetExcluded(passkey.FieldAttestationType)
+	return u
+}
+
+// ClearAttestationType clears the value of the "attestation_type" field.
+func (u *PasskeyUpsert) ClearAttestationType() *PasskeyUpsert {
+	u.SetNull(passkey.FieldAttestationType)
+	return u
+}
+
+// SetAaguid sets the "aaguid" field.
+func (u *PasskeyUpsert) SetAaguid(v []byte) *PasskeyUpsert {
+	u.Set(passkey.FieldAaguid, v)
+	return u
+}
+
+// UpdateAaguid sets the "aaguid" field to the value that was provided on create.
+func (u *PasskeyUpsert) UpdateAaguid() *PasskeyUpsert {
+	u.SetExcluded(passkey.FieldAaguid)
+	return u
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (u *PasskeyUpsert) ClearAaguid() *PasskeyUpsert {
+	u.SetNull(passkey.FieldAaguid)
+	return u
+}
+
+// SetSignCount sets the "sign_count" field.
+func (u *PasskeyUpsert) SetSignCount(v uint32) *PasskeyUpsert {
+	u.Set(passkey.FieldSignCount, v)
+	return u
+}
+
+// UpdateSignCount sets the "sign_count" field to the value that was provided on create.
+func (u *PasskeyUpsert) UpdateSignCount() *PasskeyUpsert {
+	u.SetExcluded(passkey.FieldSignCount)
+	return u
+}
+
+// AddSignCount adds v to the "sign_count" field.
+func (u *PasskeyUpsert) AddSignCount(v uint32) *PasskeyUpsert {
+	u.Add(passkey.FieldSignCount, v)
+	return u
+}
+
+// SetTransports sets the "transports" field.
+func (u *PasskeyUpsert) SetTransports(v string) *PasskeyUpsert {
+	u.Set(passkey.FieldTransports, v)
+	return u
+}
+
+// UpdateTransports sets the "transports" field to the value that was provided on create.
+func (u *PasskeyUpsert) UpdateTransports() *PasskeyUpsert {
+	u.SetExcluded(passkey.FieldTransports)
+	return u
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (u *PasskeyUpsert) ClearTransports() *PasskeyUpsert {
+	u.SetNull(passkey.FieldTransports)
+	return u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *PasskeyUpsert) SetLastUsedAt(v time.Time) *PasskeyUpsert {
+	u.Set(passkey.FieldLastUsedAt, v)
+	return u
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *PasskeyUpsert) UpdateLastUsedAt() *PasskeyUpsert {
+	u.SetExcluded(passkey.FieldLastUsedAt)
+	return u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *PasskeyUpsert) ClearLastUsedAt() *PasskeyUpsert {
+	u.SetNull(passkey.FieldLastUsedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Passkey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *PasskeyUpsertOne) UpdateNewValues() *PasskeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(passkey.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//  client.Passkey.Create().
+//      OnConflict(sql.ResolveWithIgnore()).
+//      Exec(ctx)
+//
+func (u *PasskeyUpsertOne) Ignore() *PasskeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PasskeyUpsertOne) DoNothing() *PasskeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PasskeyCreate.OnConflict
+// documentation for more info.
+func (u *PasskeyUpsertOne) Update(set func(*PasskeyUpsert)) *PasskeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PasskeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PasskeyUpsertOne) SetCreatedAt(v time.Time) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PasskeyUpsertOne) UpdateCreatedAt() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PasskeyUpsertOne) SetUpdatedAt(v time.Time) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PasskeyUpsertOne) UpdateUpdatedAt() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PasskeyUpsertOne) SetDeletedAt(v time.Time) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PasskeyUpsertOne) UpdateDeletedAt() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PasskeyUpsertOne) ClearDeletedAt() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PasskeyUpsertOne) SetUserID(v int) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PasskeyUpsertOne) UpdateUserID() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PasskeyUpsertOne) SetName(v string) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PasskeyUpsertOne) UpdateName() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (u *PasskeyUpsertOne) SetCredentialID(v string) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetCredentialID(v)
+	})
+}
+
+// UpdateCredentialID sets the "credential_id" field to the value that was provided on create.
+func (u *PasskeyUpsertOne) UpdateCredentialID() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateCredentialID()
+	})
+}
+
+// SetPublicKey sets the "public_key" field.
+func (u *PasskeyUpsertOne) SetPublicKey(v []byte) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetPublicKey(v)
+	})
+}
+
+// UpdatePublicKey sets the "public_key" field to the value that was provided on create.
+func (u *PasskeyUpsertOne) UpdatePublicKey() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdatePublicKey()
+	})
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (u *PasskeyUpsertOne) SetAttestationType(v string) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetAttestationType(v)
+	})
+}
+
+// UpdateAttestationType sets the "attestation_type" field to the value that was provided on create.
+func (u *PasskeyUpsertOne) UpdateAttestationType() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateAttestationType()
+	})
+}
+
+// ClearAttestationType clears the value of the "attestation_type" field.
+func (u *PasskeyUpsertOne) ClearAttestationType() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.ClearAttestationType()
+	})
+}
+
+// SetAaguid sets the "aaguid" field.
+func (u *PasskeyUpsertOne) SetAaguid(v []byte) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetAaguid(v)
+	})
+}
+
+// UpdateAaguid sets the "aaguid" field to the value that was provided on create.
+func (u *PasskeyUpsertOne) UpdateAaguid() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateAaguid()
+	})
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (u *PasskeyUpsertOne) ClearAaguid() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.ClearAaguid()
+	})
+}
+
+// SetSignCount sets the "sign_count" field.
+func (u *PasskeyUpsertOne) SetSignCount(v uint32) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetSignCount(v)
+	})
+}
+
+// AddSignCount adds v to the "sign_count" field.
+func (u *PasskeyUpsertOne) AddSignCount(v uint32) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.AddSignCount(v)
+	})
+}
+
+// UpdateSignCount sets the "sign_count" field to the value that was provided on create.
+func (u *PasskeyUpsertOne) UpdateSignCount() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateSignCount()
+	})
+}
+
+// SetTransports sets the "transports" field.
+func (u *PasskeyUpsertOne) SetTransports(v string) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetTransports(v)
+	})
+}
+
+// UpdateTransports sets the "transports" field to the value that was provided on create.
+func (u *PasskeyUpsertOne) UpdateTransports() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateTransports()
+	})
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (u *PasskeyUpsertOne) ClearTransports() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.ClearTransports()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *PasskeyUpsertOne) SetLastUsedAt(v time.Time) *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *PasskeyUpsertOne) UpdateLastUsedAt() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *PasskeyUpsertOne) ClearLastUsedAt() *PasskeyUpsertOne {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PasskeyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PasskeyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PasskeyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PasskeyUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PasskeyUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PasskeyCreateBulk is the builder for creating many Passkey entities in bulk.
+type PasskeyCreateBulk struct {
+	config
+	builders []*PasskeyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Passkey entities in the database.
+func (pcb *PasskeyCreateBulk) Save(ctx context.Context) ([]*Passkey, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Passkey, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PasskeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PasskeyCreateBulk) SaveX(ctx context.Context) []*Passkey {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *PasskeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *PasskeyCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Passkey.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PasskeyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (pcb *PasskeyCreateBulk) OnConflict(opts ...sql.ConflictOption) *PasskeyUpsertBulk {
+	pcb.conflict = opts
+	return &PasskeyUpsertBulk{
+		create: pcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Passkey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (pcb *PasskeyCreateBulk) OnConflictColumns(columns ...string) *PasskeyUpsertBulk {
+	pcb.conflict = append(pcb.conflict, sql.ConflictColumns(columns...))
+	return &PasskeyUpsertBulk{
+		create: pcb,
+	}
+}
+
+// PasskeyUpsertBulk is the builder for "upsert"-ing
+// a bulk of Passkey nodes.
+type PasskeyUpsertBulk struct {
+	create *PasskeyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Passkey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *PasskeyUpsertBulk) UpdateNewValues() *PasskeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(passkey.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Passkey.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *PasskeyUpsertBulk) Ignore() *PasskeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PasskeyUpsertBulk) DoNothing() *PasskeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PasskeyCreateBulk.OnConflict
+// documentation for more info.
+func (u *PasskeyUpsertBulk) Update(set func(*PasskeyUpsert)) *PasskeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PasskeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PasskeyUpsertBulk) SetCreatedAt(v time.Time) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PasskeyUpsertBulk) UpdateCreatedAt() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PasskeyUpsertBulk) SetUpdatedAt(v time.Time) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PasskeyUpsertBulk) UpdateUpdatedAt() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *PasskeyUpsertBulk) SetDeletedAt(v time.Time) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *PasskeyUpsertBulk) UpdateDeletedAt() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *PasskeyUpsertBulk) ClearDeletedAt() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PasskeyUpsertBulk) SetUserID(v int) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PasskeyUpsertBulk) UpdateUserID() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PasskeyUpsertBulk) SetName(v string) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PasskeyUpsertBulk) UpdateName() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (u *PasskeyUpsertBulk) SetCredentialID(v string) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetCredentialID(v)
+	})
+}
+
+// UpdateCredentialID sets the "credential_id" field to the value that was provided on create.
+func (u *PasskeyUpsertBulk) UpdateCredentialID() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateCredentialID()
+	})
+}
+
+// SetPublicKey sets the "public_key" field.
+func (u *PasskeyUpsertBulk) SetPublicKey(v []byte) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetPublicKey(v)
+	})
+}
+
+// UpdatePublicKey sets the "public_key" field to the value that was provided on create.
+func (u *PasskeyUpsertBulk) UpdatePublicKey() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdatePublicKey()
+	})
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (u *PasskeyUpsertBulk) SetAttestationType(v string) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetAttestationType(v)
+	})
+}
+
+// UpdateAttestationType sets the "attestation_type" field to the value that was provided on create.
+func (u *PasskeyUpsertBulk) UpdateAttestationType() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateAttestationType()
+	})
+}
+
+// ClearAttestationType clears the value of the "attestation_type" field.
+func (u *PasskeyUpsertBulk) ClearAttestationType() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.ClearAttestationType()
+	})
+}
+
+// SetAaguid sets the "aaguid" field.
+func (u *PasskeyUpsertBulk) SetAaguid(v []byte) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetAaguid(v)
+	})
+}
+
+// UpdateAaguid sets the "aaguid" field to the value that was provided on create.
+func (u *PasskeyUpsertBulk) UpdateAaguid() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateAaguid()
+	})
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (u *PasskeyUpsertBulk) ClearAaguid() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.ClearAaguid()
+	})
+}
+
+// SetSignCount sets the "sign_count" field.
+func (u *PasskeyUpsertBulk) SetSignCount(v uint32) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetSignCount(v)
+	})
+}
+
+// AddSignCount adds v to the "sign_count" field.
+func (u *PasskeyUpsertBulk) AddSignCount(v uint32) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.AddSignCount(v)
+	})
+}
+
+// UpdateSignCount sets the "sign_count" field to the value that was provided on create.
+func (u *PasskeyUpsertBulk) UpdateSignCount() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateSignCount()
+	})
+}
+
+// SetTransports sets the "transports" field.
+func (u *PasskeyUpsertBulk) SetTransports(v string) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetTransports(v)
+	})
+}
+
+// UpdateTransports sets the "transports" field to the value that was provided on create.
+func (u *PasskeyUpsertBulk) UpdateTransports() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateTransports()
+	})
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (u *PasskeyUpsertBulk) ClearTransports() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.ClearTransports()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *PasskeyUpsertBulk) SetLastUsedAt(v time.Time) *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *PasskeyUpsertBulk) UpdateLastUsedAt() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *PasskeyUpsertBulk) ClearLastUsedAt() *PasskeyUpsertBulk {
+	return u.Update(func(s *PasskeyUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PasskeyUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PasskeyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PasskeyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PasskeyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,889 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/passkey"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/predicate"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/user"
+)
+
+// PasskeyUpdate is the builder for updating Passkey entities.
+type PasskeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PasskeyMutation
+}
+
+// Where appends a list predicates to the PasskeyUpdate builder.
+func (pu *PasskeyUpdate) Where(ps ...predicate.Passkey) *PasskeyUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *PasskeyUpdate) SetUpdatedAt(t time.Time) *PasskeyUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pu *PasskeyUpdate) SetDeletedAt(t time.Time) *PasskeyUpdate {
+	pu.mutation.SetDeletedAt(t)
+	return pu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pu *PasskeyUpdate) SetNillableDeletedAt(t *time.Time) *PasskeyUpdate {
+	if t != nil {
+		pu.SetDeletedAt(*t)
+	}
+	return pu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pu *PasskeyUpdate) ClearDeletedAt() *PasskeyUpdate {
+	pu.mutation.ClearDeletedAt()
+	return pu
+}
+
+// SetUserID sets the "user_id" field.
+func (pu *PasskeyUpdate) SetUserID(i int) *PasskeyUpdate {
+	pu.mutation.SetUserID(i)
+	return pu
+}
+
+// SetName sets the "name" field.
+func (pu *PasskeyUpdate) SetName(s string) *PasskeyUpdate {
+	pu.mutation.SetName(s)
+	return pu
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (pu *PasskeyUpdate) SetCredentialID(s string) *PasskeyUpdate {
+	pu.mutation.SetCredentialID(s)
+	return pu
+}
+
+// SetPublicKey sets the "public_key" field.
+func (pu *PasskeyUpdate) SetPublicKey(b []byte) *PasskeyUpdate {
+	pu.mutation.SetPublicKey(b)
+	return pu
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (pu *PasskeyUpdate) SetAttestationType(s string) *PasskeyUpdate {
+	pu.mutation.SetAttestationType(s)
+	return pu
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (pu *PasskeyUpdate) SetNillableAttestationType(s *string) *PasskeyUpdate {
+	if s != nil {
+		pu.SetAttestationType(*s)
+	}
+	return pu
+}
+
+// ClearAttestationType clears the value of the "attestation_type" field.
+func (pu *PasskeyUpdate) ClearAttestationType() *PasskeyUpdate {
+	pu.mutation.ClearAttestationType()
+	return pu
+}
+
+// SetAaguid sets the "aaguid" field.
+func (pu *PasskeyUpdate) SetAaguid(b []byte) *PasskeyUpdate {
+	pu.mutation.SetAaguid(b)
+	return pu
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (pu *PasskeyUpdate) ClearAaguid() *PasskeyUpdate {
+	pu.mutation.ClearAaguid()
+	return pu
+}
+
+// SetSignCount sets the "sign_count" field.
+func (pu *PasskeyUpdate) SetSignCount(u uint32) *PasskeyUpdate {
+	pu.mutation.ResetSignCount()
+	pu.mutation.SetSignCount(u)
+	return pu
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (pu *PasskeyUpdate) SetNillableSignCount(u *uint32) *PasskeyUpdate {
+	if u != nil {
+		pu.SetSignCount(*u)
+	}
+	return pu
+}
+
+// AddSignCount adds u to the "sign_count" field.
+func (pu *PasskeyUpdate) AddSignCount(u int32) *PasskeyUpdate {
+	pu.mutation.AddSignCount(u)
+	return pu
+}
+
+// SetTransports sets the "transports" field.
+func (pu *PasskeyUpdate) SetTransports(s string) *PasskeyUpdate {
+	pu.mutation.SetTransports(s)
+	return pu
+}
+
+// SetNillableTransports sets the "transports" field if the given value is not nil.
+func (pu *PasskeyUpdate) SetNillableTransports(s *string) *PasskeyUpdate {
+	if s != nil {
+		pu.SetTransports(*s)
+	}
+	return pu
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (pu *PasskeyUpdate) ClearTransports() *PasskeyUpdate {
+	pu.mutation.ClearTransports()
+	return pu
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (pu *PasskeyUpdate) SetLastUsedAt(t time.Time) *PasskeyUpdate {
+	pu.mutation.SetLastUsedAt(t)
+	return pu
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (pu *PasskeyUpdate) SetNillableLastUsedAt(t *time.Time) *PasskeyUpdate {
+	if t != nil {
+		pu.SetLastUsedAt(*t)
+	}
+	return pu
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (pu *PasskeyUpdate) ClearLastUsedAt() *PasskeyUpdate {
+	pu.mutation.ClearLastUsedAt()
+	return pu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pu *PasskeyUpdate) SetUser(u *User) *PasskeyUpdate {
+	return pu.SetUserID(u.ID)
+}
+
+// Mutation returns the PasskeyMutation object of the builder.
+func (pu *PasskeyUpdate) Mutation() *PasskeyMutation {
+	return pu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (pu *PasskeyUpdate) ClearUser() *PasskeyUpdate {
+	pu.mutation.ClearUser()
+	return pu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PasskeyUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	pu.defaults()
+	if len(pu.hooks) == 0 {
+		if err = pu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = pu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PasskeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pu.check(); err != nil {
+				return 0, err
+			}
+			pu.mutation = mutation
+			affected, err = pu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pu.hooks) - 1; i >= 0; i-- {
+			if pu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PasskeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PasskeyUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PasskeyUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *PasskeyUpdate) defaults() {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		v := passkey.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PasskeyUpdate) check() error {
+	if v, ok := pu.mutation.CredentialID(); ok {
+		if err := passkey.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "Passkey.credential_id": %w`, err)}
+		}
+	}
+	if _, ok := pu.mutation.UserID(); pu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Passkey.user"`)
+	}
+	return nil
+}
+
+func (pu *PasskeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   passkey.Table,
+			Columns: passkey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: passkey.FieldID,
+			},
+		},
+	}
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: passkey.FieldUpdatedAt,
+		})
+	}
+	if value, ok := pu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: passkey.FieldDeletedAt,
+		})
+	}
+	if pu.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: passkey.FieldDeletedAt,
+		})
+	}
+	if value, ok := pu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: passkey.FieldName,
+		})
+	}
+	if value, ok := pu.mutation.CredentialID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: passkey.FieldCredentialID,
+		})
+	}
+	if value, ok := pu.mutation.PublicKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: passkey.FieldPublicKey,
+		})
+	}
+	if value, ok := pu.mutation.AttestationType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: passkey.FieldAttestationType,
+		})
+	}
+	if pu.mutation.AttestationTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: passkey.FieldAttestationType,
+		})
+	}
+	if value, ok := pu.mutation.Aaguid(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: passkey.FieldAaguid,
+		})
+	}
+	if pu.mutation.AaguidCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: passkey.FieldAaguid,
+		})
+	}
+	if value, ok := pu.mutation.SignCount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: passkey.FieldSignCount,
+		})
+	}
+	if value, ok := pu.mutation.AddedSignCount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: passkey.FieldSignCount,
+		})
+	}
+	if value, ok := pu.mutation.Transports(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: passkey.FieldTransports,
+		})
+	}
+	if pu.mutation.TransportsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: passkey.FieldTransports,
+		})
+	}
+	if value, ok := pu.mutation.LastUsedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: passkey.FieldLastUsedAt,
+		})
+	}
+	if pu.mutation.LastUsedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: passkey.FieldLastUsedAt,
+		})
+	}
+	if pu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passkey.UserTable,
+			Columns: []string{passkey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passkey.UserTable,
+			Columns: []string{passkey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// PasskeyUpdateOne is the builder for updating a single Passkey entity.
+type PasskeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PasskeyMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *PasskeyUpdateOne) SetUpdatedAt(t time.Time) *PasskeyUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (puo *PasskeyUpdateOne) SetDeletedAt(t time.Time) *PasskeyUpdateOne {
+	puo.mutation.SetDeletedAt(t)
+	return puo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (puo *PasskeyUpdateOne) SetNillableDeletedAt(t *time.Time) *PasskeyUpdateOne {
+	if t != nil {
+		puo.SetDeletedAt(*t)
+	}
+	return puo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (puo *PasskeyUpdateOne) ClearDeletedAt() *PasskeyUpdateOne {
+	puo.mutation.ClearDeletedAt()
+	return puo
+}
+
+// SetUserID sets the "user_id" field.
+func (puo *PasskeyUpdateOne) SetUserID(i int) *PasskeyUpdateOne {
+	puo.mutation.SetUserID(i)
+	return puo
+}
+
+// SetName sets the "name" field.
+func (puo *PasskeyUpdateOne) SetName(s string) *PasskeyUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (puo *PasskeyUpdateOne) SetCredentialID(s string) *PasskeyUpdateOne {
+	puo.mutation.SetCredentialID(s)
+	return puo
+}
+
+// SetPublicKey sets the "public_key" field.
+func (puo *PasskeyUpdateOne) SetPublicKey(b []byte) *PasskeyUpdateOne {
+	puo.mutation.SetPublicKey(b)
+	return puo
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (puo *PasskeyUpdateOne) SetAttestationType(s string) *PasskeyUpdateOne {
+	puo.mutation.SetAttestationType(s)
+	return puo
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (puo *PasskeyUpdateOne) SetNillableAttestationType(s *string) *PasskeyUpdateOne {
+	if s != nil {
+		puo.SetAttestationType(*s)
+	}
+	return puo
+}
+
+// ClearAttestationType clears the value of the "attestation_type" field.
+func (puo *PasskeyUpdateOne) ClearAttestationType() *PasskeyUpdateOne {
+	puo.mutation.ClearAttestationType()
+	return puo
+}
+
+// SetAaguid sets the "aaguid" field.
+func (puo *PasskeyUpdateOne) SetAaguid(b []byte) *PasskeyUpdateOne {
+	puo.mutation.SetAaguid(b)
+	return puo
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (puo *PasskeyUpdateOne) ClearAaguid() *PasskeyUpdateOne {
+	puo.mutation.ClearAaguid()
+	return puo
+}
+
+// SetSignCount sets the "sign_count" field.
+func (puo *PasskeyUpdateOne) SetSignCount(u uint32) *PasskeyUpdateOne {
+	puo.mutation.ResetSignCount()
+	puo.mutation.SetSignCount(u)
+	return puo
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (puo *PasskeyUpdateOne) SetNillableSignCount(u *uint32) *PasskeyUpdateOne {
+	if u != nil {
+		puo.SetSignCount(*u)
+	}
+	return puo
+}
+
+// AddSignCount adds u to the "sign_count" field.
+func (puo *PasskeyUpdateOne) AddSignCount(u int32) *PasskeyUpdateOne {
+	puo.mutation.AddSignCount(u)
+	return puo
+}
+
+// SetTransports sets the "transports" field.
+func (puo *PasskeyUpdateOne) SetTransports(s string) *PasskeyUpdateOne {
+	puo.mutation.SetTransports(s)
+	return puo
+}
+
+// SetNillableTransports sets the "transports" field if the given value is not nil.
+func (puo *PasskeyUpdateOne) SetNillableTransports(s *string) *PasskeyUpdateOne {
+	if s != nil {
+		puo.SetTransports(*s)
+	}
+	return puo
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (puo *PasskeyUpdateOne) ClearTransports() *PasskeyUpdateOne {
+	puo.mutation.ClearTransports()
+	return puo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (puo *PasskeyUpdateOne) SetLastUsedAt(t time.Time) *PasskeyUpdateOne {
+	puo.mutation.SetLastUsedAt(t)
+	return puo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (puo *PasskeyUpdateOne) SetNillableLastUsedAt(t *time.Time) *PasskeyUpdateOne {
+	if t != nil {
+		puo.SetLastUsedAt(*t)
+	}
+	return puo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (puo *PasskeyUpdateOne) ClearLastUsedAt() *PasskeyUpdateOne {
+	puo.mutation.ClearLastUsedAt()
+	return puo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (puo *PasskeyUpdateOne) SetUser(u *User) *PasskeyUpdateOne {
+	return puo.SetUserID(u.ID)
+}
+
+// Mutation returns the PasskeyMutation object of the builder.
+func (puo *PasskeyUpdateOne) Mutation() *PasskeyMutation {
+	return puo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (puo *PasskeyUpdateOne) ClearUser() *PasskeyUpdateOne {
+	puo.mutation.ClearUser()
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PasskeyUpdateOne) Select(field string, fields ...string) *PasskeyUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Passkey entity.
+func (puo *PasskeyUpdateOne) Save(ctx context.Context) (*Passkey, error) {
+	var (
+		err  error
+		node *Passkey
+	)
+	puo.defaults()
+	if len(puo.hooks) == 0 {
+		if err = puo.check(); err != nil {
+			return nil, err
+		}
+		node, err = puo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PasskeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = puo.check(); err != nil {
+				return nil, err
+			}
+			puo.mutation = mutation
+			node, err = puo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(puo.hooks) - 1; i >= 0; i-- {
+			if puo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = puo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, puo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PasskeyUpdateOne) SaveX(ctx context.Context) *Passkey {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PasskeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PasskeyUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *PasskeyUpdateOne) defaults() {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		v := passkey.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PasskeyUpdateOne) check() error {
+	if v, ok := puo.mutation.CredentialID(); ok {
+		if err := passkey.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "Passkey.credential_id": %w`, err)}
+		}
+	}
+	if _, ok := puo.mutation.UserID(); puo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Passkey.user"`)
+	}
+	return nil
+}
+
+func (puo *PasskeyUpdateOne) sqlSave(ctx context.Context) (_node *Passkey, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   passkey.Table,
+			Columns: passkey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: passkey.FieldID,
+			},
+		},
+	}
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Passkey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, passkey.FieldID)
+		for _, f := range fields {
+			if !passkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != passkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: passkey.FieldUpdatedAt,
+		})
+	}
+	if value, ok := puo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: passkey.FieldDeletedAt,
+		})
+	}
+	if puo.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: passkey.FieldDeletedAt,
+		})
+	}
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: passkey.FieldName,
+		})
+	}
+	if value, ok := puo.mutation.CredentialID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: passkey.FieldCredentialID,
+		})
+	}
+	if value, ok := puo.mutation.PublicKey(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: passkey.FieldPublicKey,
+		})
+	}
+	if value, ok := puo.mutation.AttestationType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: passkey.FieldAttestationType,
+		})
+	}
+	if puo.mutation.AttestationTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: passkey.FieldAttestationType,
+		})
+	}
+	if value, ok := puo.mutation.Aaguid(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: passkey.FieldAaguid,
+		})
+	}
+	if puo.mutation.AaguidCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Column: passkey.FieldAaguid,
+		})
+	}
+	if value, ok := puo.mutation.SignCount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: passkey.FieldSignCount,
+		})
+	}
+	if value, ok := puo.mutation.AddedSignCount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: passkey.FieldSignCount,
+		})
+	}
+	if value, ok := puo.mutation.Transports(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: passkey.FieldTransports,
+		})
+	}
+	if puo.mutation.TransportsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: passkey.FieldTransports,
+		})
+	}
+	if value, ok := puo.mutation.LastUsedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: passkey.FieldLastUsedAt,
+		})
+	}
+	if puo.mutation.LastUsedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: passkey.FieldLastUsedAt,
+		})
+	}
+	if puo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passkey.UserTable,
+			Columns: []string{passkey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passkey.UserTable,
+			Columns: []string{passkey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Passkey{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
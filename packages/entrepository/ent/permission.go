@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -30,6 +31,9 @@ type Permission struct {
 	// Value holds the value of the "value" field.
 	// all | own | none
 	Value string `json:"value,omitempty"`
+	// TopicIds holds the value of the "topic_ids" field.
+	// limit the permission to posts in these topics
+	TopicIds []int `json:"topic_ids,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PermissionQuery when eager-loading is set.
 	Edges PermissionEdges `json:"edges"`
@@ -63,6 +67,8 @@ func (*Permission) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case permission.FieldTopicIds:
+			values[i] = new([]byte)
 		case permission.FieldID, permission.FieldRoleID:
 			values[i] = new(sql.NullInt64)
 		case permission.FieldAction, permission.FieldValue:
@@ -126,6 +132,14 @@ func (pe *Permission) assignValues(columns []string, values []interface{}) error
 			} else if value.Valid {
 				pe.Value = value.String
 			}
+		case permission.FieldTopicIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field topic_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pe.TopicIds); err != nil {
+					return fmt.Errorf("unmarshal field topic_ids: %w", err)
+				}
+			}
 		}
 	}
 	return nil
@@ -171,6 +185,8 @@ func (pe *Permission) String() string {
 	builder.WriteString(pe.Action)
 	builder.WriteString(", value=")
 	builder.WriteString(pe.Value)
+	builder.WriteString(", topic_ids=")
+	builder.WriteString(fmt.Sprintf("%v", pe.TopicIds))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -23,6 +23,8 @@ const (
 	FieldAction = "action"
 	// FieldValue holds the string denoting the value field in the database.
 	FieldValue = "value"
+	// FieldTopicIds holds the string denoting the topic_ids field in the database.
+	FieldTopicIds = "topic_ids"
 	// EdgeRole holds the string denoting the role edge name in mutations.
 	EdgeRole = "role"
 	// Table holds the table name of the permission in the database.
@@ -45,6 +47,7 @@ var Columns = []string{
 	FieldRoleID,
 	FieldAction,
 	FieldValue,
+	FieldTopicIds,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -647,6 +647,20 @@ func ValueContainsFold(v string) predicate.Permission {
 	})
 }
 
+// TopicIdsIsNil applies the IsNil predicate on the "topic_ids" field.
+func TopicIdsIsNil() predicate.Permission {
+	return predicate.Permission(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTopicIds)))
+	})
+}
+
+// TopicIdsNotNil applies the NotNil predicate on the "topic_ids" field.
+func TopicIdsNotNil() predicate.Permission {
+	return predicate.Permission(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTopicIds)))
+	})
+}
+
 // HasRole applies the HasEdge predicate on the "role" edge.
 func HasRole() predicate.Permission {
 	return predicate.Permission(func(s *sql.Selector) {
@@ -83,6 +83,12 @@ func (pc *PermissionCreate) SetValue(s string) *PermissionCreate {
 	return pc
 }
 
+// SetTopicIds sets the "topic_ids" field.
+func (pc *PermissionCreate) SetTopicIds(i []int) *PermissionCreate {
+	pc.mutation.SetTopicIds(i)
+	return pc
+}
+
 // SetRole sets the "role" edge to the Role entity.
 func (pc *PermissionCreate) SetRole(r *Role) *PermissionCreate {
 	return pc.SetRoleID(r.ID)
@@ -257,6 +263,14 @@ func (pc *PermissionCreate) createSpec() (*Permission, *sqlgraph.CreateSpec) {
 		})
 		_node.Value = value
 	}
+	if value, ok := pc.mutation.TopicIds(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: permission.FieldTopicIds,
+		})
+		_node.TopicIds = value
+	}
 	if nodes := pc.mutation.RoleIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -409,6 +423,24 @@ func (u *PermissionUpsert) UpdateValue() *PermissionUpsert {
 	return u
 }
 
+// SetTopicIds sets the "topic_ids" field.
+func (u *PermissionUpsert) SetTopicIds(v []int) *PermissionUpsert {
+	u.Set(permission.FieldTopicIds, v)
+	return u
+}
+
+// UpdateTopicIds sets the "topic_ids" field to the value that was provided on create.
+func (u *PermissionUpsert) UpdateTopicIds() *PermissionUpsert {
+	u.SetExcluded(permission.FieldTopicIds)
+	return u
+}
+
+// ClearTopicIds clears the value of the "topic_ids" field.
+func (u *PermissionUpsert) ClearTopicIds() *PermissionUpsert {
+	u.SetNull(permission.FieldTopicIds)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -547,6 +579,27 @@ func (u *PermissionUpsertOne) UpdateValue() *PermissionUpsertOne {
 	})
 }
 
+// SetTopicIds sets the "topic_ids" field.
+func (u *PermissionUpsertOne) SetTopicIds(v []int) *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.SetTopicIds(v)
+	})
+}
+
+// UpdateTopicIds sets the "topic_ids" field to the value that was provided on create.
+func (u *PermissionUpsertOne) UpdateTopicIds() *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.UpdateTopicIds()
+	})
+}
+
+// ClearTopicIds clears the value of the "topic_ids" field.
+func (u *PermissionUpsertOne) ClearTopicIds() *PermissionUpsertOne {
+	return u.Update(func(s *PermissionUpsert) {
+		s.ClearTopicIds()
+	})
+}
+
 // Exec executes the query.
 func (u *PermissionUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -849,6 +902,27 @@ func (u *PermissionUpsertBulk) UpdateValue() *PermissionUpsertBulk {
 	})
 }
 
+// SetTopicIds sets the "topic_ids" field.
+func (u *PermissionUpsertBulk) SetTopicIds(v []int) *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.SetTopicIds(v)
+	})
+}
+
+// UpdateTopicIds sets the "topic_ids" field to the value that was provided on create.
+func (u *PermissionUpsertBulk) UpdateTopicIds() *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.UpdateTopicIds()
+	})
+}
+
+// ClearTopicIds clears the value of the "topic_ids" field.
+func (u *PermissionUpsertBulk) ClearTopicIds() *PermissionUpsertBulk {
+	return u.Update(func(s *PermissionUpsert) {
+		s.ClearTopicIds()
+	})
+}
+
 // Exec executes the query.
 func (u *PermissionUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -73,6 +73,18 @@ func (pu *PermissionUpdate) SetValue(s string) *PermissionUpdate {
 	return pu
 }
 
+// SetTopicIds sets the "topic_ids" field.
+func (pu *PermissionUpdate) SetTopicIds(i []int) *PermissionUpdate {
+	pu.mutation.SetTopicIds(i)
+	return pu
+}
+
+// ClearTopicIds clears the value of the "topic_ids" field.
+func (pu *PermissionUpdate) ClearTopicIds() *PermissionUpdate {
+	pu.mutation.ClearTopicIds()
+	return pu
+}
+
 // SetRole sets the "role" edge to the Role entity.
 func (pu *PermissionUpdate) SetRole(r *Role) *PermissionUpdate {
 	return pu.SetRoleID(r.ID)
@@ -218,6 +230,19 @@ func (pu *PermissionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: permission.FieldValue,
 		})
 	}
+	if value, ok := pu.mutation.TopicIds(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: permission.FieldTopicIds,
+		})
+	}
+	if pu.mutation.TopicIdsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: permission.FieldTopicIds,
+		})
+	}
 	if pu.mutation.RoleCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -316,6 +341,18 @@ func (puo *PermissionUpdateOne) SetValue(s string) *PermissionUpdateOne {
 	return puo
 }
 
+// SetTopicIds sets the "topic_ids" field.
+func (puo *PermissionUpdateOne) SetTopicIds(i []int) *PermissionUpdateOne {
+	puo.mutation.SetTopicIds(i)
+	return puo
+}
+
+// ClearTopicIds clears the value of the "topic_ids" field.
+func (puo *PermissionUpdateOne) ClearTopicIds() *PermissionUpdateOne {
+	puo.mutation.ClearTopicIds()
+	return puo
+}
+
 // SetRole sets the "role" edge to the Role entity.
 func (puo *PermissionUpdateOne) SetRole(r *Role) *PermissionUpdateOne {
 	return puo.SetRoleID(r.ID)
@@ -485,6 +522,19 @@ func (puo *PermissionUpdateOne) sqlSave(ctx context.Context) (_node *Permission,
 			Column: permission.FieldValue,
 		})
 	}
+	if value, ok := puo.mutation.TopicIds(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: permission.FieldTopicIds,
+		})
+	}
+	if puo.mutation.TopicIdsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: permission.FieldTopicIds,
+		})
+	}
 	if puo.mutation.RoleCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
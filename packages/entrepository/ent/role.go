@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -28,6 +29,15 @@ type Role struct {
 	Description string `json:"description,omitempty" validate:"max=255"`
 	// Root holds the value of the "root" field.
 	Root bool `json:"root,omitempty"`
+	// MaxUploadSize holds the value of the "max_upload_size" field.
+	// max size of a single upload in bytes, 0 for unlimited
+	MaxUploadSize int `json:"max_upload_size,omitempty"`
+	// UploadMimeGroups holds the value of the "upload_mime_groups" field.
+	// allowed mime groups, empty for all groups
+	UploadMimeGroups []string `json:"upload_mime_groups,omitempty"`
+	// StorageQuota holds the value of the "storage_quota" field.
+	// total storage quota in bytes, 0 for unlimited
+	StorageQuota int `json:"storage_quota,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the RoleQuery when eager-loading is set.
 	Edges RoleEdges `json:"edges"`
@@ -39,9 +49,11 @@ type RoleEdges struct {
 	Permissions []*Permission `json:"permissions,omitempty"`
 	// Users holds the value of the users edge.
 	Users []*User `json:"users,omitempty"`
+	// Invites holds the value of the invites edge.
+	Invites []*Invite `json:"invites,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // PermissionsOrErr returns the Permissions value or an error if the edge
@@ -62,14 +74,25 @@ func (e RoleEdges) UsersOrErr() ([]*User, error) {
 	return nil, &NotLoadedError{edge: "users"}
 }
 
+// InvitesOrErr returns the Invites value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoleEdges) InvitesOrErr() ([]*Invite, error) {
+	if e.loadedTypes[2] {
+		return e.Invites, nil
+	}
+	return nil, &NotLoadedError{edge: "invites"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Role) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case role.FieldUploadMimeGroups:
+			values[i] = new([]byte)
 		case role.FieldRoot:
 			values[i] = new(sql.NullBool)
-		case role.FieldID:
+		case role.FieldID, role.FieldMaxUploadSize, role.FieldStorageQuota:
 			values[i] = new(sql.NullInt64)
 		case role.FieldName, role.FieldDescription:
 			values[i] = new(sql.NullString)
@@ -132,6 +155,26 @@ func (r *Role) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				r.Root = value.Bool
 			}
+		case role.FieldMaxUploadSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_upload_size", values[i])
+			} else if value.Valid {
+				r.MaxUploadSize = int(value.Int64)
+			}
+		case role.FieldUploadMimeGroups:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_mime_groups", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.UploadMimeGroups); err != nil {
+					return fmt.Errorf("unmarshal field upload_mime_groups: %w", err)
+				}
+			}
+		case role.FieldStorageQuota:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_quota", values[i])
+			} else if value.Valid {
+				r.StorageQuota = int(value.Int64)
+			}
 		}
 	}
 	return nil
@@ -147,6 +190,11 @@ func (r *Role) QueryUsers() *UserQuery {
 	return (&RoleClient{config: r.config}).QueryUsers(r)
 }
 
+// QueryInvites queries the "invites" edge of the Role entity.
+func (r *Role) QueryInvites() *InviteQuery {
+	return (&RoleClient{config: r.config}).QueryInvites(r)
+}
+
 // Update returns a builder for updating this Role.
 // Note that you need to call Role.Unwrap() before calling this method if this Role
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -182,6 +230,12 @@ func (r *Role) String() string {
 	builder.WriteString(r.Description)
 	builder.WriteString(", root=")
 	builder.WriteString(fmt.Sprintf("%v", r.Root))
+	builder.WriteString(", max_upload_size=")
+	builder.WriteString(fmt.Sprintf("%v", r.MaxUploadSize))
+	builder.WriteString(", upload_mime_groups=")
+	builder.WriteString(fmt.Sprintf("%v", r.UploadMimeGroups))
+	builder.WriteString(", storage_quota=")
+	builder.WriteString(fmt.Sprintf("%v", r.StorageQuota))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -23,10 +23,18 @@ const (
 	FieldDescription = "description"
 	// FieldRoot holds the string denoting the root field in the database.
 	FieldRoot = "root"
+	// FieldMaxUploadSize holds the string denoting the max_upload_size field in the database.
+	FieldMaxUploadSize = "max_upload_size"
+	// FieldUploadMimeGroups holds the string denoting the upload_mime_groups field in the database.
+	FieldUploadMimeGroups = "upload_mime_groups"
+	// FieldStorageQuota holds the string denoting the storage_quota field in the database.
+	FieldStorageQuota = "storage_quota"
 	// EdgePermissions holds the string denoting the permissions edge name in mutations.
 	EdgePermissions = "permissions"
 	// EdgeUsers holds the string denoting the users edge name in mutations.
 	EdgeUsers = "users"
+	// EdgeInvites holds the string denoting the invites edge name in mutations.
+	EdgeInvites = "invites"
 	// Table holds the table name of the role in the database.
 	Table = "roles"
 	// PermissionsTable is the table that holds the permissions relation/edge.
@@ -41,6 +49,13 @@ const (
 	// UsersInverseTable is the table name for the User entity.
 	// It exists in this package in order to avoid circular dependency with the "user" package.
 	UsersInverseTable = "users"
+	// InvitesTable is the table that holds the invites relation/edge.
+	InvitesTable = "invites"
+	// InvitesInverseTable is the table name for the Invite entity.
+	// It exists in this package in order to avoid circular dependency with the "invite" package.
+	InvitesInverseTable = "invites"
+	// InvitesColumn is the table column denoting the invites relation/edge.
+	InvitesColumn = "role_id"
 )
 
 // Columns holds all SQL columns for role fields.
@@ -52,6 +67,9 @@ var Columns = []string{
 	FieldName,
 	FieldDescription,
 	FieldRoot,
+	FieldMaxUploadSize,
+	FieldUploadMimeGroups,
+	FieldStorageQuota,
 }
 
 var (
@@ -77,4 +95,8 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultMaxUploadSize holds the default value on creation for the "max_upload_size" field.
+	DefaultMaxUploadSize int
+	// DefaultStorageQuota holds the default value on creation for the "storage_quota" field.
+	DefaultStorageQuota int
 )
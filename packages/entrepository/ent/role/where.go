@@ -135,6 +135,20 @@ func Root(v bool) predicate.Role {
 	})
 }
 
+// MaxUploadSize applies equality check predicate on the "max_upload_size" field. It's identical to MaxUploadSizeEQ.
+func MaxUploadSize(v int) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMaxUploadSize), v))
+	})
+}
+
+// StorageQuota applies equality check predicate on the "storage_quota" field. It's identical to StorageQuotaEQ.
+func StorageQuota(v int) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStorageQuota), v))
+	})
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Role {
 	return predicate.Role(func(s *sql.Selector) {
@@ -641,6 +655,172 @@ func RootNotNil() predicate.Role {
 	})
 }
 
+// MaxUploadSizeEQ applies the EQ predicate on the "max_upload_size" field.
+func MaxUploadSizeEQ(v int) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMaxUploadSize), v))
+	})
+}
+
+// MaxUploadSizeNEQ applies the NEQ predicate on the "max_upload_size" field.
+func MaxUploadSizeNEQ(v int) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMaxUploadSize), v))
+	})
+}
+
+// MaxUploadSizeIn applies the In predicate on the "max_upload_size" field.
+func MaxUploadSizeIn(vs ...int) predicate.Role {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Role(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMaxUploadSize), v...))
+	})
+}
+
+// MaxUploadSizeNotIn applies the NotIn predicate on the "max_upload_size" field.
+func MaxUploadSizeNotIn(vs ...int) predicate.Role {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Role(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMaxUploadSize), v...))
+	})
+}
+
+// MaxUploadSizeGT applies the GT predicate on the "max_upload_size" field.
+func MaxUploadSizeGT(v int) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMaxUploadSize), v))
+	})
+}
+
+// MaxUploadSizeGTE applies the GTE predicate on the "max_upload_size" field.
+func MaxUploadSizeGTE(v int) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMaxUploadSize), v))
+	})
+}
+
+// MaxUploadSizeLT applies the LT predicate on the "max_upload_size" field.
+func MaxUploadSizeLT(v int) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMaxUploadSize), v))
+	})
+}
+
+// MaxUploadSizeLTE applies the LTE predicate on the "max_upload_size" field.
+func MaxUploadSizeLTE(v int) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMaxUploadSize), v))
+	})
+}
+
+// UploadMimeGroupsIsNil applies the IsNil predicate on the "upload_mime_groups" field.
+func UploadMimeGroupsIsNil() predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldUploadMimeGroups)))
+	})
+}
+
+// UploadMimeGroupsNotNil applies the NotNil predicate on the "upload_mime_groups" field.
+func UploadMimeGroupsNotNil() predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldUploadMimeGroups)))
+	})
+}
+
+// StorageQuotaEQ applies the EQ predicate on the "storage_quota" field.
+func StorageQuotaEQ(v int) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStorageQuota), v))
+	})
+}
+
+// StorageQuotaNEQ applies the NEQ predicate on the "storage_quota" field.
+func StorageQuotaNEQ(v int) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStorageQuota), v))
+	})
+}
+
+// StorageQuotaIn applies the In predicate on the "storage_quota" field.
+func StorageQuotaIn(vs ...int) predicate.Role {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Role(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStorageQuota), v...))
+	})
+}
+
+// StorageQuotaNotIn applies the NotIn predicate on the "storage_quota" field.
+func StorageQuotaNotIn(vs ...int) predicate.Role {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Role(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStorageQuota), v...))
+	})
+}
+
+// StorageQuotaGT applies the GT predicate on the "storage_quota" field.
+func StorageQuotaGT(v int) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStorageQuota), v))
+	})
+}
+
+// StorageQuotaGTE applies the GTE predicate on the "storage_quota" field.
+func StorageQuotaGTE(v int) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStorageQuota), v))
+	})
+}
+
+// StorageQuotaLT applies the LT predicate on the "storage_quota" field.
+func StorageQuotaLT(v int) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStorageQuota), v))
+	})
+}
+
+// StorageQuotaLTE applies the LTE predicate on the "storage_quota" field.
+func StorageQuotaLTE(v int) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStorageQuota), v))
+	})
+}
+
 // HasPermissions applies the HasEdge predicate on the "permissions" edge.
 func HasPermissions() predicate.Role {
 	return predicate.Role(func(s *sql.Selector) {
@@ -697,6 +877,34 @@ func HasUsersWith(preds ...predicate.User) predicate.Role {
 	})
 }
 
+// HasInvites applies the HasEdge predicate on the "invites" edge.
+func HasInvites() predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(InvitesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvitesTable, InvitesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvitesWith applies the HasEdge predicate on the "invites" edge with a given conditions (other predicates).
+func HasInvitesWith(preds ...predicate.Invite) predicate.Role {
+	return predicate.Role(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(InvitesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvitesTable, InvitesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Role) predicate.Role {
 	return predicate.Role(func(s *sql.Selector) {
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/invite"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/permission"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/role"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/user"
@@ -100,6 +101,40 @@ func (rc *RoleCreate) SetNillableRoot(b *bool) *RoleCreate {
 	return rc
 }
 
+// SetMaxUploadSize sets the "max_upload_size" field.
+func (rc *RoleCreate) SetMaxUploadSize(i int) *RoleCreate {
+	rc.mutation.SetMaxUploadSize(i)
+	return rc
+}
+
+// SetNillableMaxUploadSize sets the "max_upload_size" field if the given value is not nil.
+func (rc *RoleCreate) SetNillableMaxUploadSize(i *int) *RoleCreate {
+	if i != nil {
+		rc.SetMaxUploadSize(*i)
+	}
+	return rc
+}
+
+// SetUploadMimeGroups sets the "upload_mime_groups" field.
+func (rc *RoleCreate) SetUploadMimeGroups(s []string) *RoleCreate {
+	rc.mutation.SetUploadMimeGroups(s)
+	return rc
+}
+
+// SetStorageQuota sets the "storage_quota" field.
+func (rc *RoleCreate) SetStorageQuota(i int) *RoleCreate {
+	rc.mutation.SetStorageQuota(i)
+	return rc
+}
+
+// SetNillableStorageQuota sets the "storage_quota" field if the given value is not nil.
+func (rc *RoleCreate) SetNillableStorageQuota(i *int) *RoleCreate {
+	if i != nil {
+		rc.SetStorageQuota(*i)
+	}
+	return rc
+}
+
 // AddPermissionIDs adds the "permissions" edge to the Permission entity by IDs.
 func (rc *RoleCreate) AddPermissionIDs(ids ...int) *RoleCreate {
 	rc.mutation.AddPermissionIDs(ids...)
@@ -130,6 +165,21 @@ func (rc *RoleCreate) AddUsers(u ...*User) *RoleCreate {
 	return rc.AddUserIDs(ids...)
 }
 
+// AddInviteIDs adds the "invites" edge to the Invite entity by IDs.
+func (rc *RoleCreate) AddInviteIDs(ids ...int) *RoleCreate {
+	rc.mutation.AddInviteIDs(ids...)
+	return rc
+}
+
+// AddInvites adds the "invites" edges to the Invite entity.
+func (rc *RoleCreate) AddInvites(i ...*Invite) *RoleCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return rc.AddInviteIDs(ids...)
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (rc *RoleCreate) Mutation() *RoleMutation {
 	return rc.mutation
@@ -209,6 +259,14 @@ func (rc *RoleCreate) defaults() {
 		v := role.DefaultUpdatedAt()
 		rc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := rc.mutation.MaxUploadSize(); !ok {
+		v := role.DefaultMaxUploadSize
+		rc.mutation.SetMaxUploadSize(v)
+	}
+	if _, ok := rc.mutation.StorageQuota(); !ok {
+		v := role.DefaultStorageQuota
+		rc.mutation.SetStorageQuota(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -222,6 +280,12 @@ func (rc *RoleCreate) check() error {
 	if _, ok := rc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Role.name"`)}
 	}
+	if _, ok := rc.mutation.MaxUploadSize(); !ok {
+		return &ValidationError{Name: "max_upload_size", err: errors.New(`ent: missing required field "Role.max_upload_size"`)}
+	}
+	if _, ok := rc.mutation.StorageQuota(); !ok {
+		return &ValidationError{Name: "storage_quota", err: errors.New(`ent: missing required field "Role.storage_quota"`)}
+	}
 	return nil
 }
 
@@ -298,6 +362,30 @@ func (rc *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		})
 		_node.Root = value
 	}
+	if value, ok := rc.mutation.MaxUploadSize(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: role.FieldMaxUploadSize,
+		})
+		_node.MaxUploadSize = value
+	}
+	if value, ok := rc.mutation.UploadMimeGroups(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: role.FieldUploadMimeGroups,
+		})
+		_node.UploadMimeGroups = value
+	}
+	if value, ok := rc.mutation.StorageQuota(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: role.FieldStorageQuota,
+		})
+		_node.StorageQuota = value
+	}
 	if nodes := rc.mutation.PermissionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -336,6 +424,25 @@ func (rc *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := rc.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.InvitesTable,
+			Columns: []string{role.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invite.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -480,6 +587,60 @@ func (u *RoleUpsert) ClearRoot() *RoleUpsert {
 	return u
 }
 
+// SetMaxUploadSize sets the "max_upload_size" field.
+func (u *RoleUpsert) SetMaxUploadSize(v int) *RoleUpsert {
+	u.Set(role.FieldMaxUploadSize, v)
+	return u
+}
+
+// UpdateMaxUploadSize sets the "max_upload_size" field to the value that was provided on create.
+func (u *RoleUpsert) UpdateMaxUploadSize() *RoleUpsert {
+	u.SetExcluded(role.FieldMaxUploadSize)
+	return u
+}
+
+// AddMaxUploadSize adds v to the "max_upload_size" field.
+func (u *RoleUpsert) AddMaxUploadSize(v int) *RoleUpsert {
+	u.Add(role.FieldMaxUploadSize, v)
+	return u
+}
+
+// SetUploadMimeGroups sets the "upload_mime_groups" field.
+func (u *RoleUpsert) SetUploadMimeGroups(v []string) *RoleUpsert {
+	u.Set(role.FieldUploadMimeGroups, v)
+	return u
+}
+
+// UpdateUploadMimeGroups sets the "upload_mime_groups" field to the value that was provided on create.
+func (u *RoleUpsert) UpdateUploadMimeGroups() *RoleUpsert {
+	u.SetExcluded(role.FieldUploadMimeGroups)
+	return u
+}
+
+// ClearUploadMimeGroups clears the value of the "upload_mime_groups" field.
+func (u *RoleUpsert) ClearUploadMimeGroups() *RoleUpsert {
+	u.SetNull(role.FieldUploadMimeGroups)
+	return u
+}
+
+// SetStorageQuota sets the "storage_quota" field.
+func (u *RoleUpsert) SetStorageQuota(v int) *RoleUpsert {
+	u.Set(role.FieldStorageQuota, v)
+	return u
+}
+
+// UpdateStorageQuota sets the "storage_quota" field to the value that was provided on create.
+func (u *RoleUpsert) UpdateStorageQuota() *RoleUpsert {
+	u.SetExcluded(role.FieldStorageQuota)
+	return u
+}
+
+// AddStorageQuota adds v to the "storage_quota" field.
+func (u *RoleUpsert) AddStorageQuota(v int) *RoleUpsert {
+	u.Add(role.FieldStorageQuota, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -632,6 +793,69 @@ func (u *RoleUpsertOne) ClearRoot() *RoleUpsertOne {
 	})
 }
 
+// SetMaxUploadSize sets the "max_upload_size" field.
+func (u *RoleUpsertOne) SetMaxUploadSize(v int) *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetMaxUploadSize(v)
+	})
+}
+
+// AddMaxUploadSize adds v to the "max_upload_size" field.
+func (u *RoleUpsertOne) AddMaxUploadSize(v int) *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.AddMaxUploadSize(v)
+	})
+}
+
+// UpdateMaxUploadSize sets the "max_upload_size" field to the value that was provided on create.
+func (u *RoleUpsertOne) UpdateMaxUploadSize() *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateMaxUploadSize()
+	})
+}
+
+// SetUploadMimeGroups sets the "upload_mime_groups" field.
+func (u *RoleUpsertOne) SetUploadMimeGroups(v []string) *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetUploadMimeGroups(v)
+	})
+}
+
+// UpdateUploadMimeGroups sets the "upload_mime_groups" field to the value that was provided on create.
+func (u *RoleUpsertOne) UpdateUploadMimeGroups() *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateUploadMimeGroups()
+	})
+}
+
+// ClearUploadMimeGroups clears the value of the "upload_mime_groups" field.
+func (u *RoleUpsertOne) ClearUploadMimeGroups() *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.ClearUploadMimeGroups()
+	})
+}
+
+// SetStorageQuota sets the "storage_quota" field.
+func (u *RoleUpsertOne) SetStorageQuota(v int) *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetStorageQuota(v)
+	})
+}
+
+// AddStorageQuota adds v to the "storage_quota" field.
+func (u *RoleUpsertOne) AddStorageQuota(v int) *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.AddStorageQuota(v)
+	})
+}
+
+// UpdateStorageQuota sets the "storage_quota" field to the value that was provided on create.
+func (u *RoleUpsertOne) UpdateStorageQuota() *RoleUpsertOne {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateStorageQuota()
+	})
+}
+
 // Exec executes the query.
 func (u *RoleUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -948,6 +1172,69 @@ func (u *RoleUpsertBulk) ClearRoot() *RoleUpsertBulk {
 	})
 }
 
+// SetMaxUploadSize sets the "max_upload_size" field.
+func (u *RoleUpsertBulk) SetMaxUploadSize(v int) *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetMaxUploadSize(v)
+	})
+}
+
+// AddMaxUploadSize adds v to the "max_upload_size" field.
+func (u *RoleUpsertBulk) AddMaxUploadSize(v int) *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.AddMaxUploadSize(v)
+	})
+}
+
+// UpdateMaxUploadSize sets the "max_upload_size" field to the value that was provided on create.
+func (u *RoleUpsertBulk) UpdateMaxUploadSize() *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateMaxUploadSize()
+	})
+}
+
+// SetUploadMimeGroups sets the "upload_mime_groups" field.
+func (u *RoleUpsertBulk) SetUploadMimeGroups(v []string) *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetUploadMimeGroups(v)
+	})
+}
+
+// UpdateUploadMimeGroups sets the "upload_mime_groups" field to the value that was provided on create.
+func (u *RoleUpsertBulk) UpdateUploadMimeGroups() *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateUploadMimeGroups()
+	})
+}
+
+// ClearUploadMimeGroups clears the value of the "upload_mime_groups" field.
+func (u *RoleUpsertBulk) ClearUploadMimeGroups() *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.ClearUploadMimeGroups()
+	})
+}
+
+// SetStorageQuota sets the "storage_quota" field.
+func (u *RoleUpsertBulk) SetStorageQuota(v int) *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.SetStorageQuota(v)
+	})
+}
+
+// AddStorageQuota adds v to the "storage_quota" field.
+func (u *RoleUpsertBulk) AddStorageQuota(v int) *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.AddStorageQuota(v)
+	})
+}
+
+// UpdateStorageQuota sets the "storage_quota" field to the value that was provided on create.
+func (u *RoleUpsertBulk) UpdateStorageQuota() *RoleUpsertBulk {
+	return u.Update(func(s *RoleUpsert) {
+		s.UpdateStorageQuota()
+	})
+}
+
 // Exec executes the query.
 func (u *RoleUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
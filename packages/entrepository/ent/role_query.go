@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/invite"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/permission"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/predicate"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/role"
@@ -30,6 +31,7 @@ type RoleQuery struct {
 	// eager-loading edges.
 	withPermissions *PermissionQuery
 	withUsers       *UserQuery
+	withInvites     *InviteQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -110,6 +112,28 @@ func (rq *RoleQuery) QueryUsers() *UserQuery {
 	return query
 }
 
+// QueryInvites chains the current query on the "invites" edge.
+func (rq *RoleQuery) QueryInvites() *InviteQuery {
+	query := &InviteQuery{config: rq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(role.Table, role.FieldID, selector),
+			sqlgraph.To(invite.Table, invite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, role.InvitesTable, role.InvitesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Role entity from the query.
 // Returns a *NotFoundError when no Role was found.
 func (rq *RoleQuery) First(ctx context.Context) (*Role, error) {
@@ -293,6 +317,7 @@ func (rq *RoleQuery) Clone() *RoleQuery {
 		predicates:      append([]predicate.Role{}, rq.predicates...),
 		withPermissions: rq.withPermissions.Clone(),
 		withUsers:       rq.withUsers.Clone(),
+		withInvites:     rq.withInvites.Clone(),
 		// clone intermediate query.
 		sql:    rq.sql.Clone(),
 		path:   rq.path,
@@ -322,6 +347,17 @@ func (rq *RoleQuery) WithUsers(opts ...func(*UserQuery)) *RoleQuery {
 	return rq
 }
 
+// WithInvites tells the query-builder to eager-load the nodes that are connected to
+// the "invites" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RoleQuery) WithInvites(opts ...func(*InviteQuery)) *RoleQuery {
+	query := &InviteQuery{config: rq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withInvites = query
+	return rq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -387,9 +423,10 @@ func (rq *RoleQuery) sqlAll(ctx context.Context) ([]*Role, error) {
 	var (
 		nodes       = []*Role{}
 		_spec       = rq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			rq.withPermissions != nil,
 			rq.withUsers != nil,
+			rq.withInvites != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
@@ -502,6 +539,31 @@ func (rq *RoleQuery) sqlAll(ctx context.Context) ([]*Role, error) {
 		}
 	}
 
+	if query := rq.withInvites; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Role)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Invites = []*Invite{}
+		}
+		query.Where(predicate.Invite(func(s *sql.Selector) {
+			s.Where(sql.InValues(role.InvitesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.RoleID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "role_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Invites = append(node.Edges.Invites, n)
+		}
+	}
+
 	return nodes, nil
 }
 
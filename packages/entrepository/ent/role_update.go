@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/invite"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/permission"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/predicate"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/role"
@@ -102,6 +103,60 @@ func (ru *RoleUpdate) ClearRoot() *RoleUpdate {
 	return ru
 }
 
+// SetMaxUploadSize sets the "max_upload_size" field.
+func (ru *RoleUpdate) SetMaxUploadSize(i int) *RoleUpdate {
+	ru.mutation.ResetMaxUploadSize()
+	ru.mutation.SetMaxUploadSize(i)
+	return ru
+}
+
+// SetNillableMaxUploadSize sets the "max_upload_size" field if the given value is not nil.
+func (ru *RoleUpdate) SetNillableMaxUploadSize(i *int) *RoleUpdate {
+	if i != nil {
+		ru.SetMaxUploadSize(*i)
+	}
+	return ru
+}
+
+// AddMaxUploadSize adds i to the "max_upload_size" field.
+func (ru *RoleUpdate) AddMaxUploadSize(i int) *RoleUpdate {
+	ru.mutation.AddMaxUploadSize(i)
+	return ru
+}
+
+// SetUploadMimeGroups sets the "upload_mime_groups" field.
+func (ru *RoleUpdate) SetUploadMimeGroups(s []string) *RoleUpdate {
+	ru.mutation.SetUploadMimeGroups(s)
+	return ru
+}
+
+// ClearUploadMimeGroups clears the value of the "upload_mime_groups" field.
+func (ru *RoleUpdate) ClearUploadMimeGroups() *RoleUpdate {
+	ru.mutation.ClearUploadMimeGroups()
+	return ru
+}
+
+// SetStorageQuota sets the "storage_quota" field.
+func (ru *RoleUpdate) SetStorageQuota(i int) *RoleUpdate {
+	ru.mutation.ResetStorageQuota()
+	ru.mutation.SetStorageQuota(i)
+	return ru
+}
+
+// SetNillableStorageQuota sets the "storage_quota" field if the given value is not nil.
+func (ru *RoleUpdate) SetNillableStorageQuota(i *int) *RoleUpdate {
+	if i != nil {
+		ru.SetStorageQuota(*i)
+	}
+	return ru
+}
+
+// AddStorageQuota adds i to the "storage_quota" field.
+func (ru *RoleUpdate) AddStorageQuota(i int) *RoleUpdate {
+	ru.mutation.AddStorageQuota(i)
+	return ru
+}
+
 // AddPermissionIDs adds the "permissions" edge to the Permission entity by IDs.
 func (ru *RoleUpdate) AddPermissionIDs(ids ...int) *RoleUpdate {
 	ru.mutation.AddPermissionIDs(ids...)
@@ -132,6 +187,21 @@ func (ru *RoleUpdate) AddUsers(u ...*User) *RoleUpdate {
 	return ru.AddUserIDs(ids...)
 }
 
+// AddInviteIDs adds the "invites" edge to the Invite entity by IDs.
+func (ru *RoleUpdate) AddInviteIDs(ids ...int) *RoleUpdate {
+	ru.mutation.AddInviteIDs(ids...)
+	return ru
+}
+
+// AddInvites adds the "invites" edges to the Invite entity.
+func (ru *RoleUpdate) AddInvites(i ...*Invite) *RoleUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ru.AddInviteIDs(ids...)
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (ru *RoleUpdate) Mutation() *RoleMutation {
 	return ru.mutation
@@ -179,6 +249,27 @@ func (ru *RoleUpdate) RemoveUsers(u ...*User) *RoleUpdate {
 	return ru.RemoveUserIDs(ids...)
 }
 
+// ClearInvites clears all "invites" edges to the Invite entity.
+func (ru *RoleUpdate) ClearInvites() *RoleUpdate {
+	ru.mutation.ClearInvites()
+	return ru
+}
+
+// RemoveInviteIDs removes the "invites" edge to Invite entities by IDs.
+func (ru *RoleUpdate) RemoveInviteIDs(ids ...int) *RoleUpdate {
+	ru.mutation.RemoveInviteIDs(ids...)
+	return ru
+}
+
+// RemoveInvites removes "invites" edges to Invite entities.
+func (ru *RoleUpdate) RemoveInvites(i ...*Invite) *RoleUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ru.RemoveInviteIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ru *RoleUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -313,6 +404,47 @@ func (ru *RoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: role.FieldRoot,
 		})
 	}
+	if value, ok := ru.mutation.MaxUploadSize(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: role.FieldMaxUploadSize,
+		})
+	}
+	if value, ok := ru.mutation.AddedMaxUploadSize(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: role.FieldMaxUploadSize,
+		})
+	}
+	if value, ok := ru.mutation.UploadMimeGroups(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: role.FieldUploadMimeGroups,
+		})
+	}
+	if ru.mutation.UploadMimeGroupsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: role.FieldUploadMimeGroups,
+		})
+	}
+	if value, ok := ru.mutation.StorageQuota(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: role.FieldStorageQuota,
+		})
+	}
+	if value, ok := ru.mutation.AddedStorageQuota(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: role.FieldStorageQuota,
+		})
+	}
 	if ru.mutation.PermissionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -421,6 +553,60 @@ func (ru *RoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ru.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.InvitesTable,
+			Columns: []string{role.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invite.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedInvitesIDs(); len(nodes) > 0 && !ru.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.InvitesTable,
+			Columns: []string{role.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invite.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.InvitesTable,
+			Columns: []string{role.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invite.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{role.Label}
@@ -512,6 +698,60 @@ func (ruo *RoleUpdateOne) ClearRoot() *RoleUpdateOne {
 	return ruo
 }
 
+// SetMaxUploadSize sets the "max_upload_size" field.
+func (ruo *RoleUpdateOne) SetMaxUploadSize(i int) *RoleUpdateOne {
+	ruo.mutation.ResetMaxUploadSize()
+	ruo.mutation.SetMaxUploadSize(i)
+	return ruo
+}
+
+// SetNillableMaxUploadSize sets the "max_upload_size" field if the given value is not nil.
+func (ruo *RoleUpdateOne) SetNillableMaxUploadSize(i *int) *RoleUpdateOne {
+	if i != nil {
+		ruo.SetMaxUploadSize(*i)
+	}
+	return ruo
+}
+
+// AddMaxUploadSize adds i to the "max_upload_size" field.
+func (ruo *RoleUpdateOne) AddMaxUploadSize(i int) *RoleUpdateOne {
+	ruo.mutation.AddMaxUploadSize(i)
+	return ruo
+}
+
+// SetUploadMimeGroups sets the "upload_mime_groups" field.
+func (ruo *RoleUpdateOne) SetUploadMimeGroups(s []string) *RoleUpdateOne {
+	ruo.mutation.SetUploadMimeGroups(s)
+	return ruo
+}
+
+// ClearUploadMimeGroups clears the value of the "upload_mime_groups" field.
+func (ruo *RoleUpdateOne) ClearUploadMimeGroups() *RoleUpdateOne {
+	ruo.mutation.ClearUploadMimeGroups()
+	return ruo
+}
+
+// SetStorageQuota sets the "storage_quota" field.
+func (ruo *RoleUpdateOne) SetStorageQuota(i int) *RoleUpdateOne {
+	ruo.mutation.ResetStorageQuota()
+	ruo.mutation.SetStorageQuota(i)
+	return ruo
+}
+
+// SetNillableStorageQuota sets the "storage_quota" field if the given value is not nil.
+func (ruo *RoleUpdateOne) SetNillableStorageQuota(i *int) *RoleUpdateOne {
+	if i != nil {
+		ruo.SetStorageQuota(*i)
+	}
+	return ruo
+}
+
+// AddStorageQuota adds i to the "storage_quota" field.
+func (ruo *RoleUpdateOne) AddStorageQuota(i int) *RoleUpdateOne {
+	ruo.mutation.AddStorageQuota(i)
+	return ruo
+}
+
 // AddPermissionIDs adds the "permissions" edge to the Permission entity by IDs.
 func (ruo *RoleUpdateOne) AddPermissionIDs(ids ...int) *RoleUpdateOne {
 	ruo.mutation.AddPermissionIDs(ids...)
@@ -542,6 +782,21 @@ func (ruo *RoleUpdateOne) AddUsers(u ...*User) *RoleUpdateOne {
 	return ruo.AddUserIDs(ids...)
 }
 
+// AddInviteIDs adds the "invites" edge to the Invite entity by IDs.
+func (ruo *RoleUpdateOne) AddInviteIDs(ids ...int) *RoleUpdateOne {
+	ruo.mutation.AddInviteIDs(ids...)
+	return ruo
+}
+
+// AddInvites adds the "invites" edges to the Invite entity.
+func (ruo *RoleUpdateOne) AddInvites(i ...*Invite) *RoleUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ruo.AddInviteIDs(ids...)
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (ruo *RoleUpdateOne) Mutation() *RoleMutation {
 	return ruo.mutation
@@ -589,6 +844,27 @@ func (ruo *RoleUpdateOne) RemoveUsers(u ...*User) *RoleUpdateOne {
 	return ruo.RemoveUserIDs(ids...)
 }
 
+// ClearInvites clears all "invites" edges to the Invite entity.
+func (ruo *RoleUpdateOne) ClearInvites() *RoleUpdateOne {
+	ruo.mutation.ClearInvites()
+	return ruo
+}
+
+// RemoveInviteIDs removes the "invites" edge to Invite entities by IDs.
+func (ruo *RoleUpdateOne) RemoveInviteIDs(ids ...int) *RoleUpdateOne {
+	ruo.mutation.RemoveInviteIDs(ids...)
+	return ruo
+}
+
+// RemoveInvites removes "invites" edges to Invite entities.
+func (ruo *RoleUpdateOne) RemoveInvites(i ...*Invite) *RoleUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ruo.RemoveInviteIDs(ids...)
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (ruo *RoleUpdateOne) Select(field string, fields ...string) *RoleUpdateOne {
@@ -747,6 +1023,47 @@ func (ruo *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error)
 			Column: role.FieldRoot,
 		})
 	}
+	if value, ok := ruo.mutation.MaxUploadSize(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: role.FieldMaxUploadSize,
+		})
+	}
+	if value, ok := ruo.mutation.AddedMaxUploadSize(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: role.FieldMaxUploadSize,
+		})
+	}
+	if value, ok := ruo.mutation.UploadMimeGroups(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: role.FieldUploadMimeGroups,
+		})
+	}
+	if ruo.mutation.UploadMimeGroupsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: role.FieldUploadMimeGroups,
+		})
+	}
+	if value, ok := ruo.mutation.StorageQuota(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: role.FieldStorageQuota,
+		})
+	}
+	if value, ok := ruo.mutation.AddedStorageQuota(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: role.FieldStorageQuota,
+		})
+	}
 	if ruo.mutation.PermissionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -855,6 +1172,60 @@ func (ruo *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ruo.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.InvitesTable,
+			Columns: []string{role.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invite.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedInvitesIDs(); len(nodes) > 0 && !ruo.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.InvitesTable,
+			Columns: []string{role.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invite.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   role.InvitesTable,
+			Columns: []string{role.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invite.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Role{config: ruo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
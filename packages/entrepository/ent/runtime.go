@@ -5,9 +5,16 @@ package ent
 import (
 	"time"
 
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/auditlog"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/comment"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/customfield"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/customfieldvalue"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/file"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/invite"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menu"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menuitem"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/page"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/passkey"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/permission"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/post"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/role"
@@ -21,6 +28,37 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	auditlogMixin := schema.AuditLog{}.Mixin()
+	auditlogMixinFields0 := auditlogMixin[0].Fields()
+	_ = auditlogMixinFields0
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogMixinFields0[0].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	// auditlogDescUpdatedAt is the schema descriptor for updated_at field.
+	auditlogDescUpdatedAt := auditlogMixinFields0[1].Descriptor()
+	// auditlog.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	auditlog.DefaultUpdatedAt = auditlogDescUpdatedAt.Default.(func() time.Time)
+	// auditlog.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	auditlog.UpdateDefaultUpdatedAt = auditlogDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// auditlogDescAction is the schema descriptor for action field.
+	auditlogDescAction := auditlogFields[1].Descriptor()
+	// auditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditlog.ActionValidator = auditlogDescAction.Validators[0].(func(string) error)
+	// auditlogDescTargetType is the schema descriptor for target_type field.
+	auditlogDescTargetType := auditlogFields[2].Descriptor()
+	// auditlog.TargetTypeValidator is a validator for the "target_type" field. It is called by the builders before save.
+	auditlog.TargetTypeValidator = auditlogDescTargetType.Validators[0].(func(string) error)
+	// auditlogDescIP is the schema descriptor for ip field.
+	auditlogDescIP := auditlogFields[6].Descriptor()
+	// auditlog.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	auditlog.IPValidator = auditlogDescIP.Validators[0].(func(string) error)
+	// auditlogDescRequestID is the schema descriptor for request_id field.
+	auditlogDescRequestID := auditlogFields[7].Descriptor()
+	// auditlog.RequestIDValidator is a validator for the "request_id" field. It is called by the builders before save.
+	auditlog.RequestIDValidator = auditlogDescRequestID.Validators[0].(func(string) error)
 	commentMixin := schema.Comment{}.Mixin()
 	commentMixinFields0 := commentMixin[0].Fields()
 	_ = commentMixinFields0
@@ -40,6 +78,68 @@ func init() {
 	commentDescVotes := commentFields[2].Descriptor()
 	// comment.DefaultVotes holds the default value on creation for the votes field.
 	comment.DefaultVotes = commentDescVotes.Default.(int64)
+	customfieldMixin := schema.CustomField{}.Mixin()
+	customfieldMixinFields0 := customfieldMixin[0].Fields()
+	_ = customfieldMixinFields0
+	customfieldFields := schema.CustomField{}.Fields()
+	_ = customfieldFields
+	// customfieldDescCreatedAt is the schema descriptor for created_at field.
+	customfieldDescCreatedAt := customfieldMixinFields0[0].Descriptor()
+	// customfield.DefaultCreatedAt holds the default value on creation for the created_at field.
+	customfield.DefaultCreatedAt = customfieldDescCreatedAt.Default.(func() time.Time)
+	// customfieldDescUpdatedAt is the schema descriptor for updated_at field.
+	customfieldDescUpdatedAt := customfieldMixinFields0[1].Descriptor()
+	// customfield.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	customfield.DefaultUpdatedAt = customfieldDescUpdatedAt.Default.(func() time.Time)
+	// customfield.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	customfield.UpdateDefaultUpdatedAt = customfieldDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// customfieldDescName is the schema descriptor for name field.
+	customfieldDescName := customfieldFields[0].Descriptor()
+	// customfield.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	customfield.NameValidator = customfieldDescName.Validators[0].(func(string) error)
+	// customfieldDescType is the schema descriptor for type field.
+	customfieldDescType := customfieldFields[3].Descriptor()
+	// customfield.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	customfield.TypeValidator = customfieldDescType.Validators[0].(func(string) error)
+	// customfieldDescTarget is the schema descriptor for target field.
+	customfieldDescTarget := customfieldFields[4].Descriptor()
+	// customfield.TargetValidator is a validator for the "target" field. It is called by the builders before save.
+	customfield.TargetValidator = customfieldDescTarget.Validators[0].(func(string) error)
+	// customfieldDescRequired is the schema descriptor for required field.
+	customfieldDescRequired := customfieldFields[5].Descriptor()
+	// customfield.DefaultRequired holds the default value on creation for the required field.
+	customfield.DefaultRequired = customfieldDescRequired.Default.(bool)
+	// customfieldDescMaxLength is the schema descriptor for max_length field.
+	customfieldDescMaxLength := customfieldFields[6].Descriptor()
+	// customfield.DefaultMaxLength holds the default value on creation for the max_length field.
+	customfield.DefaultMaxLength = customfieldDescMaxLength.Default.(int)
+	// customfieldDescSortOrder is the schema descriptor for sort_order field.
+	customfieldDescSortOrder := customfieldFields[9].Descriptor()
+	// customfield.DefaultSortOrder holds the default value on creation for the sort_order field.
+	customfield.DefaultSortOrder = customfieldDescSortOrder.Default.(int)
+	customfieldvalueMixin := schema.CustomFieldValue{}.Mixin()
+	customfieldvalueMixinFields0 := customfieldvalueMixin[0].Fields()
+	_ = customfieldvalueMixinFields0
+	customfieldvalueFields := schema.CustomFieldValue{}.Fields()
+	_ = customfieldvalueFields
+	// customfieldvalueDescCreatedAt is the schema descriptor for created_at field.
+	customfieldvalueDescCreatedAt := customfieldvalueMixinFields0[0].Descriptor()
+	// customfieldvalue.DefaultCreatedAt holds the default value on creation for the created_at field.
+	customfieldvalue.DefaultCreatedAt = customfieldvalueDescCreatedAt.Default.(func() time.Time)
+	// customfieldvalueDescUpdatedAt is the schema descriptor for updated_at field.
+	customfieldvalueDescUpdatedAt := customfieldvalueMixinFields0[1].Descriptor()
+	// customfieldvalue.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	customfieldvalue.DefaultUpdatedAt = customfieldvalueDescUpdatedAt.Default.(func() time.Time)
+	// customfieldvalue.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	customfieldvalue.UpdateDefaultUpdatedAt = customfieldvalueDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// customfieldvalueDescTargetType is the schema descriptor for target_type field.
+	customfieldvalueDescTargetType := customfieldvalueFields[0].Descriptor()
+	// customfieldvalue.TargetTypeValidator is a validator for the "target_type" field. It is called by the builders before save.
+	customfieldvalue.TargetTypeValidator = customfieldvalueDescTargetType.Validators[0].(func(string) error)
+	// customfieldvalueDescName is the schema descriptor for name field.
+	customfieldvalueDescName := customfieldvalueFields[2].Descriptor()
+	// customfieldvalue.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	customfieldvalue.NameValidator = customfieldvalueDescName.Validators[0].(func(string) error)
 	fileMixin := schema.File{}.Mixin()
 	fileMixinFields0 := fileMixin[0].Fields()
 	_ = fileMixinFields0
@@ -55,6 +155,99 @@ func init() {
 	file.DefaultUpdatedAt = fileDescUpdatedAt.Default.(func() time.Time)
 	// file.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	file.UpdateDefaultUpdatedAt = fileDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// fileDescPath is the schema descriptor for path field.
+	fileDescPath := fileFields[1].Descriptor()
+	// file.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	file.PathValidator = fileDescPath.Validators[0].(func(string) error)
+	// fileDescWidth is the schema descriptor for width field.
+	fileDescWidth := fileFields[5].Descriptor()
+	// file.DefaultWidth holds the default value on creation for the width field.
+	file.DefaultWidth = fileDescWidth.Default.(int)
+	// fileDescHeight is the schema descriptor for height field.
+	fileDescHeight := fileFields[6].Descriptor()
+	// file.DefaultHeight holds the default value on creation for the height field.
+	file.DefaultHeight = fileDescHeight.Default.(int)
+	inviteMixin := schema.Invite{}.Mixin()
+	inviteMixinFields0 := inviteMixin[0].Fields()
+	_ = inviteMixinFields0
+	inviteFields := schema.Invite{}.Fields()
+	_ = inviteFields
+	// inviteDescCreatedAt is the schema descriptor for created_at field.
+	inviteDescCreatedAt := inviteMixinFields0[0].Descriptor()
+	// invite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invite.DefaultCreatedAt = inviteDescCreatedAt.Default.(func() time.Time)
+	// inviteDescUpdatedAt is the schema descriptor for updated_at field.
+	inviteDescUpdatedAt := inviteMixinFields0[1].Descriptor()
+	// invite.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	invite.DefaultUpdatedAt = inviteDescUpdatedAt.Default.(func() time.Time)
+	// invite.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	invite.UpdateDefaultUpdatedAt = inviteDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// inviteDescCode is the schema descriptor for code field.
+	inviteDescCode := inviteFields[0].Descriptor()
+	// invite.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	invite.CodeValidator = inviteDescCode.Validators[0].(func(string) error)
+	// inviteDescMaxUses is the schema descriptor for max_uses field.
+	inviteDescMaxUses := inviteFields[3].Descriptor()
+	// invite.DefaultMaxUses holds the default value on creation for the max_uses field.
+	invite.DefaultMaxUses = inviteDescMaxUses.Default.(int)
+	// inviteDescUsed is the schema descriptor for used field.
+	inviteDescUsed := inviteFields[4].Descriptor()
+	// invite.DefaultUsed holds the default value on creation for the used field.
+	invite.DefaultUsed = inviteDescUsed.Default.(int)
+	menuMixin := schema.Menu{}.Mixin()
+	menuMixinFields0 := menuMixin[0].Fields()
+	_ = menuMixinFields0
+	menuFields := schema.Menu{}.Fields()
+	_ = menuFields
+	// menuDescCreatedAt is the schema descriptor for created_at field.
+	menuDescCreatedAt := menuMixinFields0[0].Descriptor()
+	// menu.DefaultCreatedAt holds the default value on creation for the created_at field.
+	menu.DefaultCreatedAt = menuDescCreatedAt.Default.(func() time.Time)
+	// menuDescUpdatedAt is the schema descriptor for updated_at field.
+	menuDescUpdatedAt := menuMixinFields0[1].Descriptor()
+	// menu.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	menu.DefaultUpdatedAt = menuDescUpdatedAt.Default.(func() time.Time)
+	// menu.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	menu.UpdateDefaultUpdatedAt = menuDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// menuDescName is the schema descriptor for name field.
+	menuDescName := menuFields[0].Descriptor()
+	// menu.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	menu.NameValidator = menuDescName.Validators[0].(func(string) error)
+	// menuDescLocation is the schema descriptor for location field.
+	menuDescLocation := menuFields[1].Descriptor()
+	// menu.LocationValidator is a validator for the "location" field. It is called by the builders before save.
+	menu.LocationValidator = menuDescLocation.Validators[0].(func(string) error)
+	menuitemMixin := schema.MenuItem{}.Mixin()
+	menuitemMixinFields0 := menuitemMixin[0].Fields()
+	_ = menuitemMixinFields0
+	menuitemFields := schema.MenuItem{}.Fields()
+	_ = menuitemFields
+	// menuitemDescCreatedAt is the schema descriptor for created_at field.
+	menuitemDescCreatedAt := menuitemMixinFields0[0].Descriptor()
+	// menuitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	menuitem.DefaultCreatedAt = menuitemDescCreatedAt.Default.(func() time.Time)
+	// menuitemDescUpdatedAt is the schema descriptor for updated_at field.
+	menuitemDescUpdatedAt := menuitemMixinFields0[1].Descriptor()
+	// menuitem.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	menuitem.DefaultUpdatedAt = menuitemDescUpdatedAt.Default.(func() time.Time)
+	// menuitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	menuitem.UpdateDefaultUpdatedAt = menuitemDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// menuitemDescLabel is the schema descriptor for label field.
+	menuitemDescLabel := menuitemFields[2].Descriptor()
+	// menuitem.LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	menuitem.LabelValidator = menuitemDescLabel.Validators[0].(func(string) error)
+	// menuitemDescType is the schema descriptor for type field.
+	menuitemDescType := menuitemFields[3].Descriptor()
+	// menuitem.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	menuitem.TypeValidator = menuitemDescType.Validators[0].(func(string) error)
+	// menuitemDescURL is the schema descriptor for url field.
+	menuitemDescURL := menuitemFields[5].Descriptor()
+	// menuitem.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	menuitem.URLValidator = menuitemDescURL.Validators[0].(func(string) error)
+	// menuitemDescSortOrder is the schema descriptor for sort_order field.
+	menuitemDescSortOrder := menuitemFields[6].Descriptor()
+	// menuitem.DefaultSortOrder holds the default value on creation for the sort_order field.
+	menuitem.DefaultSortOrder = menuitemDescSortOrder.Default.(int)
 	pageMixin := schema.Page{}.Mixin()
 	pageMixinFields0 := pageMixin[0].Fields()
 	_ = pageMixinFields0
@@ -74,6 +267,37 @@ func init() {
 	pageDescDraft := pageFields[4].Descriptor()
 	// page.DefaultDraft holds the default value on creation for the draft field.
 	page.DefaultDraft = pageDescDraft.Default.(bool)
+	// pageDescSortOrder is the schema descriptor for sort_order field.
+	pageDescSortOrder := pageFields[7].Descriptor()
+	// page.DefaultSortOrder holds the default value on creation for the sort_order field.
+	page.DefaultSortOrder = pageDescSortOrder.Default.(int)
+	// pageDescTemplate is the schema descriptor for template field.
+	pageDescTemplate := pageFields[8].Descriptor()
+	// page.TemplateValidator is a validator for the "template" field. It is called by the builders before save.
+	page.TemplateValidator = pageDescTemplate.Validators[0].(func(string) error)
+	passkeyMixin := schema.Passkey{}.Mixin()
+	passkeyMixinFields0 := passkeyMixin[0].Fields()
+	_ = passkeyMixinFields0
+	passkeyFields := schema.Passkey{}.Fields()
+	_ = passkeyFields
+	// passkeyDescCreatedAt is the schema descriptor for created_at field.
+	passkeyDescCreatedAt := passkeyMixinFields0[0].Descriptor()
+	// passkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passkey.DefaultCreatedAt = passkeyDescCreatedAt.Default.(func() time.Time)
+	// passkeyDescUpdatedAt is the schema descriptor for updated_at field.
+	passkeyDescUpdatedAt := passkeyMixinFields0[1].Descriptor()
+	// passkey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	passkey.DefaultUpdatedAt = passkeyDescUpdatedAt.Default.(func() time.Time)
+	// passkey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	passkey.UpdateDefaultUpdatedAt = passkeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// passkeyDescCredentialID is the schema descriptor for credential_id field.
+	passkeyDescCredentialID := passkeyFields[2].Descriptor()
+	// passkey.CredentialIDValidator is a validator for the "credential_id" field. It is called by the builders before save.
+	passkey.CredentialIDValidator = passkeyDescCredentialID.Validators[0].(func(string) error)
+	// passkeyDescSignCount is the schema descriptor for sign_count field.
+	passkeyDescSignCount := passkeyFields[6].Descriptor()
+	// passkey.DefaultSignCount holds the default value on creation for the sign_count field.
+	passkey.DefaultSignCount = passkeyDescSignCount.Default.(uint32)
 	permissionMixin := schema.Permission{}.Mixin()
 	permissionMixinFields0 := permissionMixin[0].Fields()
 	_ = permissionMixinFields0
@@ -143,6 +367,14 @@ func init() {
 	role.DefaultUpdatedAt = roleDescUpdatedAt.Default.(func() time.Time)
 	// role.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	role.UpdateDefaultUpdatedAt = roleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// roleDescMaxUploadSize is the schema descriptor for max_upload_size field.
+	roleDescMaxUploadSize := roleFields[3].Descriptor()
+	// role.DefaultMaxUploadSize holds the default value on creation for the max_upload_size field.
+	role.DefaultMaxUploadSize = roleDescMaxUploadSize.Default.(int)
+	// roleDescStorageQuota is the schema descriptor for storage_quota field.
+	roleDescStorageQuota := roleFields[5].Descriptor()
+	// role.DefaultStorageQuota holds the default value on creation for the storage_quota field.
+	role.DefaultStorageQuota = roleDescStorageQuota.Default.(int)
 	settingMixin := schema.Setting{}.Mixin()
 	settingMixinFields0 := settingMixin[0].Fields()
 	_ = settingMixinFields0
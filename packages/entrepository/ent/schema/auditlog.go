@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").Optional(),
+		field.String("action").MaxLen(255),
+		field.String("target_type").MaxLen(64),
+		field.Int("target_id").Optional(),
+		field.Text("before").Optional(),
+		field.Text("after").Optional(),
+		field.String("ip").Optional().MaxLen(64),
+		field.String("request_id").Optional().MaxLen(64),
+	}
+}
+
+// Edges of the AuditLog.
+func (AuditLog) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("audit_logs").Field("user_id").Unique(),
+	}
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("action"),
+		index.Fields("target_type", "target_id"),
+		index.Fields("created_at"),
+	}
+}
+
+func (AuditLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeStamp{},
+	}
+}
@@ -2,8 +2,6 @@ package schema
 
 import (
 	"entgo.io/ent"
-	"entgo.io/ent/dialect/entsql"
-	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -40,15 +38,6 @@ func (Comment) Edges() []ent.Edge {
 	}
 }
 
-func (Comment) Annotations() []schema.Annotation {
-	return []schema.Annotation{
-		entsql.Annotation{
-			Charset:   "utf8mb4",
-			Collation: "utf8mb4_unicode_ci",
-		},
-	}
-}
-
 func (Comment) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		TimeStamp{},
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CustomField holds the schema definition for the CustomField entity.
+type CustomField struct {
+	ent.Schema
+}
+
+// Fields of the CustomField.
+func (CustomField) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(64),
+		field.String("label"),
+		field.String("description").Optional(),
+		field.String("type").MaxLen(32),
+		field.String("target").MaxLen(32),
+		field.Bool("required").Default(false),
+		field.Int("max_length").Default(0),
+		field.String("pattern").Optional(),
+		field.JSON("options", []string{}).Optional(),
+		field.Int("sort_order").Default(0),
+	}
+}
+
+func (CustomField) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target", "name").StorageKey("custom_field_target_name_unique").Unique(),
+	}
+}
+
+func (CustomField) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeStamp{},
+	}
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CustomFieldValue holds the value of a custom field for a post, page or user,
+// the values are joined to the entities by their target type and id.
+type CustomFieldValue struct {
+	ent.Schema
+}
+
+// Fields of the CustomFieldValue.
+func (CustomFieldValue) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("target_type").MaxLen(32),
+		field.Int("target_id"),
+		field.String("name").MaxLen(64),
+		field.Text("value"),
+	}
+}
+
+func (CustomFieldValue) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target_type", "target_id", "name").StorageKey("custom_field_value_target_name_unique").Unique(),
+		index.Fields("target_type", "name").StorageKey("target_type_name_idx"),
+	}
+}
+
+func (CustomFieldValue) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeStamp{},
+	}
+}
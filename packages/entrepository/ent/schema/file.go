@@ -2,12 +2,10 @@ package schema
 
 import (
 	"entgo.io/ent"
-	"entgo.io/ent/dialect"
-	"entgo.io/ent/dialect/entsql"
-	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/ngocphuongnb/tetua/app/fs"
 )
 
 // File holds the schema definition for the File entity.
@@ -19,11 +17,18 @@ type File struct {
 func (File) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("disk"),
-		field.String("path").SchemaType(map[string]string{
-			dialect.MySQL: "varchar(500)",
-		}),
+		field.String("path").MaxLen(500),
 		field.String("type"),
 		field.Int("size"),
+		field.String("hash").Optional(),
+		field.Int("width").Default(0),
+		field.Int("height").Default(0),
+		field.JSON("variants", []*fs.ImageVariant{}).Optional(),
+		field.String("original_name").Optional(),
+		field.String("title").Optional(),
+		field.String("alt").Optional(),
+		field.Text("caption").Optional(),
+		field.JSON("tags", []string{}).Optional(),
 		field.Int("user_id").Optional(),
 	}
 }
@@ -50,15 +55,7 @@ func (File) Edges() []ent.Edge {
 func (File) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("path").StorageKey("path_idx"),
-	}
-}
-
-func (File) Annotations() []schema.Annotation {
-	return []schema.Annotation{
-		entsql.Annotation{
-			Charset:   "utf8mb4",
-			Collation: "utf8mb4_unicode_ci",
-		},
+		index.Fields("disk", "hash").StorageKey("disk_hash_idx"),
 	}
 }
 
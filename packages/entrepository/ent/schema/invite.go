@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Invite holds the schema definition for the Invite entity.
+type Invite struct {
+	ent.Schema
+}
+
+// Fields of the Invite.
+func (Invite) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code").Unique().MaxLen(64),
+		field.Int("user_id"),
+		field.Int("role_id").Optional(),
+		field.Int("max_uses").Default(1),
+		field.Int("used").Default(0),
+		field.Time("expires_at").Optional().Nillable().SchemaType(datetime),
+	}
+}
+
+// Edges of the Invite.
+func (Invite) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("invites").Field("user_id").Unique().Required(),
+		edge.From("role", Role.Type).Ref("invites").Field("role_id").Unique(),
+		edge.To("invited_users", User.Type).
+			Annotations(ondeleteSetNull).
+			StorageKey(edge.Column("invite_id"), edge.Symbol("user_invite")),
+	}
+}
+
+func (Invite) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeStamp{},
+	}
+}
@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Menu holds the schema definition for the Menu entity.
+type Menu struct {
+	ent.Schema
+}
+
+// Fields of the Menu.
+func (Menu) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(255),
+		field.String("location").Unique().MaxLen(64),
+	}
+}
+
+// Edges of the Menu.
+func (Menu) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("items", MenuItem.Type).
+			Annotations(ondeleteCascade).
+			StorageKey(edge.Column("menu_id"), edge.Symbol("menu_item_menu")),
+	}
+}
+
+func (Menu) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeStamp{},
+	}
+}
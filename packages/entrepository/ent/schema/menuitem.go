@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// MenuItem holds the schema definition for the MenuItem entity.
+type MenuItem struct {
+	ent.Schema
+}
+
+// Fields of the MenuItem.
+func (MenuItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("menu_id"),
+		field.Int("parent_id").Optional(),
+		field.String("label").MaxLen(255),
+		field.String("type").MaxLen(32),
+		field.Int("target_id").Optional(),
+		field.String("url").Optional().MaxLen(1024),
+		field.Int("sort_order").Default(0),
+	}
+}
+
+// Edges of the MenuItem.
+func (MenuItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("menu", Menu.Type).Ref("items").Field("menu_id").Unique().Required(),
+	}
+}
+
+func (MenuItem) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeStamp{},
+	}
+}
@@ -2,8 +2,6 @@ package schema
 
 import (
 	"entgo.io/ent"
-	"entgo.io/ent/dialect/entsql"
-	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -23,6 +21,9 @@ func (Page) Fields() []ent.Field {
 		field.Text("content_html"),
 		field.Bool("draft").Optional().Default(false),
 		field.Int("featured_image_id").Optional(),
+		field.Int("parent_id").Optional(),
+		field.Int("sort_order").Default(0),
+		field.String("template").Optional().MaxLen(64),
 	}
 }
 
@@ -30,25 +31,23 @@ func (Page) Fields() []ent.Field {
 func (Page) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("featured_image", File.Type).Ref("pages").Field("featured_image_id").Unique(),
+		edge.To("children", Page.Type).
+			Annotations(ondeleteSetNull).
+			StorageKey(edge.Column("parent_id"), edge.Symbol("page_parent")),
+		edge.From("parent", Page.Type).
+			Field("parent_id").
+			Ref("children").
+			Unique(),
 	}
 }
 
 func (Page) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("name").StorageKey("name_idx"),
+		index.Fields("name").StorageKey("page_name_idx"),
 		index.Fields("slug").StorageKey("slug_unique").Unique(),
 	}
 }
 
-func (Page) Annotations() []schema.Annotation {
-	return []schema.Annotation{
-		entsql.Annotation{
-			Charset:   "utf8mb4",
-			Collation: "utf8mb4_unicode_ci",
-		},
-	}
-}
-
 func (Page) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		TimeStamp{},
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Passkey holds the schema definition for the Passkey entity.
+type Passkey struct {
+	ent.Schema
+}
+
+// Fields of the Passkey.
+func (Passkey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("name"),
+		field.String("credential_id").Unique().MaxLen(255),
+		field.Bytes("public_key"),
+		field.String("attestation_type").Optional(),
+		field.Bytes("aaguid").Optional(),
+		field.Uint32("sign_count").Default(0),
+		field.String("transports").Optional(),
+		field.Time("last_used_at").Optional().Nillable().SchemaType(datetime),
+	}
+}
+
+// Edges of the Passkey.
+func (Passkey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("passkeys").Field("user_id").Unique().Required(),
+	}
+}
+
+func (Passkey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id").StorageKey("passkey_user_idx"),
+	}
+}
+
+func (Passkey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeStamp{},
+	}
+}
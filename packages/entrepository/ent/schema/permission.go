@@ -2,8 +2,6 @@ package schema
 
 import (
 	"entgo.io/ent"
-	"entgo.io/ent/dialect/entsql"
-	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -20,6 +18,7 @@ func (Permission) Fields() []ent.Field {
 		field.Int("role_id"),
 		field.String("action").StructTag(`validate:"max=255"`),
 		field.String("value").Comment("all | own | none"),
+		field.JSON("topic_ids", []int{}).Optional().Comment("limit the permission to posts in these topics"),
 	}
 }
 
@@ -36,15 +35,6 @@ func (Permission) Indexes() []ent.Index {
 	}
 }
 
-func (Permission) Annotations() []schema.Annotation {
-	return []schema.Annotation{
-		entsql.Annotation{
-			Charset:   "utf8mb4",
-			Collation: "utf8mb4_unicode_ci",
-		},
-	}
-}
-
 func (Permission) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		TimeStamp{},
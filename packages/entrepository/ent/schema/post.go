@@ -2,8 +2,6 @@ package schema
 
 import (
 	"entgo.io/ent"
-	"entgo.io/ent/dialect/entsql"
-	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -47,20 +45,11 @@ func (Post) Edges() []ent.Edge {
 
 func (Post) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("name").StorageKey("name_idx"),
+		index.Fields("name").StorageKey("post_name_idx"),
 		index.Fields("view_count").StorageKey("view_count_idx"),
 	}
 }
 
-func (Post) Annotations() []schema.Annotation {
-	return []schema.Annotation{
-		entsql.Annotation{
-			Charset:   "utf8mb4",
-			Collation: "utf8mb4_unicode_ci",
-		},
-	}
-}
-
 func (Post) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		TimeStamp{},
@@ -2,8 +2,6 @@ package schema
 
 import (
 	"entgo.io/ent"
-	"entgo.io/ent/dialect/entsql"
-	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +17,9 @@ func (Role) Fields() []ent.Field {
 		field.String("name").Unique().StructTag(`validate:"max=255"`),
 		field.String("description").Optional().StructTag(`validate:"max=255"`),
 		field.Bool("root").Optional(),
+		field.Int("max_upload_size").Default(0).Comment("max size of a single upload in bytes, 0 for unlimited"),
+		field.JSON("upload_mime_groups", []string{}).Optional().Comment("allowed mime groups, empty for all groups"),
+		field.Int("storage_quota").Default(0).Comment("total storage quota in bytes, 0 for unlimited"),
 	}
 }
 
@@ -30,15 +31,9 @@ func (Role) Edges() []ent.Edge {
 			StorageKey(edge.Column("role_id"), edge.Symbol("permission_role")),
 		edge.To("users", User.Type).
 			Annotations(ondeleteSetNull),
-	}
-}
-
-func (Role) Annotations() []schema.Annotation {
-	return []schema.Annotation{
-		entsql.Annotation{
-			Charset:   "utf8mb4",
-			Collation: "utf8mb4_unicode_ci",
-		},
+		edge.To("invites", Invite.Type).
+			Annotations(ondeleteSetNull).
+			StorageKey(edge.Column("role_id"), edge.Symbol("invite_role")),
 	}
 }
 
@@ -10,6 +10,13 @@ import (
 	"entgo.io/ent/schema/mixin"
 )
 
+// datetime is the column type of the time fields, the MySQL timestamp columns are limited to 2038
+var datetime = map[string]string{
+	dialect.MySQL:    "datetime",
+	dialect.Postgres: "timestamp with time zone",
+	dialect.SQLite:   "datetime",
+}
+
 type TimeStamp struct {
 	mixin.Schema
 }
@@ -22,15 +29,9 @@ func (TimeStamp) Fields() []ent.Field {
 		// field.Time("updated_at").Default(time.Now).SchemaType(map[string]string{
 		// 	dialect.MySQL: "datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP",
 		// }).UpdateDefault(time.Now).StructTag(`json:"omitempty"`),
-		field.Time("created_at").Default(time.Now).SchemaType(map[string]string{
-			dialect.MySQL: "datetime",
-		}).Immutable().StructTag(`json:"omitempty"`),
-		field.Time("updated_at").Default(time.Now).SchemaType(map[string]string{
-			dialect.MySQL: "datetime",
-		}).UpdateDefault(time.Now).StructTag(`json:"omitempty"`),
-		field.Time("deleted_at").SchemaType(map[string]string{
-			dialect.MySQL: "datetime",
-		}).Optional().StructTag(`json:"omitempty"`),
+		field.Time("created_at").Default(time.Now).SchemaType(datetime).Immutable().StructTag(`json:"omitempty"`),
+		field.Time("updated_at").Default(time.Now).SchemaType(datetime).UpdateDefault(time.Now).StructTag(`json:"omitempty"`),
+		field.Time("deleted_at").SchemaType(datetime).Optional().StructTag(`json:"omitempty"`),
 	}
 }
 
@@ -2,8 +2,6 @@ package schema
 
 import (
 	"entgo.io/ent"
-	"entgo.io/ent/dialect/entsql"
-	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 )
@@ -28,15 +26,6 @@ func (Setting) Indexes() []ent.Index {
 	}
 }
 
-func (Setting) Annotations() []schema.Annotation {
-	return []schema.Annotation{
-		entsql.Annotation{
-			Charset:   "utf8mb4",
-			Collation: "utf8mb4_unicode_ci",
-		},
-	}
-}
-
 // Edges of the Setting.
 func (Setting) Mixin() []ent.Mixin {
 	return []ent.Mixin{
@@ -2,8 +2,6 @@ package schema
 
 import (
 	"entgo.io/ent"
-	"entgo.io/ent/dialect/entsql"
-	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -30,6 +28,7 @@ func (User) Fields() []ent.Field {
 		field.Text("bio_html").Optional(),
 		field.Bool("active").Default(true),
 		field.Int("avatar_image_id").Optional(),
+		field.Int("invite_id").Optional(),
 	}
 }
 
@@ -45,8 +44,18 @@ func (User) Edges() []ent.Edge {
 		edge.To("comments", Comment.Type).
 			Annotations(ondeleteSetNull).
 			StorageKey(edge.Column("user_id"), edge.Symbol("comment_user")),
+		edge.To("passkeys", Passkey.Type).
+			Annotations(ondeleteCascade).
+			StorageKey(edge.Column("user_id"), edge.Symbol("passkey_user")),
+		edge.To("invites", Invite.Type).
+			Annotations(ondeleteCascade).
+			StorageKey(edge.Column("user_id"), edge.Symbol("invite_user")),
+		edge.To("audit_logs", AuditLog.Type).
+			Annotations(ondeleteSetNull).
+			StorageKey(edge.Column("user_id"), edge.Symbol("audit_log_user")),
 		edge.From("roles", Role.Type).Ref("users"),
 		edge.From("avatar_image", File.Type).Ref("user_avatars").Field("avatar_image_id").Unique(),
+		edge.From("invite", Invite.Type).Ref("invited_users").Field("invite_id").Unique(),
 	}
 }
 
@@ -56,15 +65,6 @@ func (User) Indexes() []ent.Index {
 	}
 }
 
-func (User) Annotations() []schema.Annotation {
-	return []schema.Annotation{
-		entsql.Annotation{
-			Charset:   "utf8mb4",
-			Collation: "utf8mb4_unicode_ci",
-		},
-	}
-}
-
 func (User) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		TimeStamp{},
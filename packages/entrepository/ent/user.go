@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/file"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/invite"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/user"
 )
 
@@ -49,6 +50,8 @@ type User struct {
 	Active bool `json:"active,omitempty"`
 	// AvatarImageID holds the value of the "avatar_image_id" field.
 	AvatarImageID int `json:"avatar_image_id,omitempty"`
+	// InviteID holds the value of the "invite_id" field.
+	InviteID int `json:"invite_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges UserEdges `json:"edges"`
@@ -62,13 +65,21 @@ type UserEdges struct {
 	Files []*File `json:"files,omitempty"`
 	// Comments holds the value of the comments edge.
 	Comments []*Comment `json:"comments,omitempty"`
+	// Passkeys holds the value of the passkeys edge.
+	Passkeys []*Passkey `json:"passkeys,omitempty"`
+	// Invites holds the value of the invites edge.
+	Invites []*Invite `json:"invites,omitempty"`
+	// AuditLogs holds the value of the audit_logs edge.
+	AuditLogs []*AuditLog `json:"audit_logs,omitempty"`
 	// Roles holds the value of the roles edge.
 	Roles []*Role `json:"roles,omitempty"`
 	// AvatarImage holds the value of the avatar_image edge.
 	AvatarImage *File `json:"avatar_image,omitempty"`
+	// Invite holds the value of the invite edge.
+	Invite *Invite `json:"invite,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [9]bool
 }
 
 // PostsOrErr returns the Posts value or an error if the edge
@@ -98,10 +109,37 @@ func (e UserEdges) CommentsOrErr() ([]*Comment, error) {
 	return nil, &NotLoadedError{edge: "comments"}
 }
 
+// PasskeysOrErr returns the Passkeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PasskeysOrErr() ([]*Passkey, error) {
+	if e.loadedTypes[3] {
+		return e.Passkeys, nil
+	}
+	return nil, &NotLoadedError{edge: "passkeys"}
+}
+
+// InvitesOrErr returns the Invites value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) InvitesOrErr() ([]*Invite, error) {
+	if e.loadedTypes[4] {
+		return e.Invites, nil
+	}
+	return nil, &NotLoadedError{edge: "invites"}
+}
+
+// AuditLogsOrErr returns the AuditLogs value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AuditLogsOrErr() ([]*AuditLog, error) {
+	if e.loadedTypes[5] {
+		return e.AuditLogs, nil
+	}
+	return nil, &NotLoadedError{edge: "audit_logs"}
+}
+
 // RolesOrErr returns the Roles value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) RolesOrErr() ([]*Role, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[6] {
 		return e.Roles, nil
 	}
 	return nil, &NotLoadedError{edge: "roles"}
@@ -110,7 +148,7 @@ func (e UserEdges) RolesOrErr() ([]*Role, error) {
 // AvatarImageOrErr returns the AvatarImage value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e UserEdges) AvatarImageOrErr() (*File, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[7] {
 		if e.AvatarImage == nil {
 			// The edge avatar_image was loaded in eager-loading,
 			// but was not found.
@@ -121,6 +159,20 @@ func (e UserEdges) AvatarImageOrErr() (*File, error) {
 	return nil, &NotLoadedError{edge: "avatar_image"}
 }
 
+// InviteOrErr returns the Invite value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) InviteOrErr() (*Invite, error) {
+	if e.loadedTypes[8] {
+		if e.Invite == nil {
+			// The edge invite was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: invite.Label}
+		}
+		return e.Invite, nil
+	}
+	return nil, &NotLoadedError{edge: "invite"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
@@ -128,7 +180,7 @@ func (*User) scanValues(columns []string) ([]interface{}, error) {
 		switch columns[i] {
 		case user.FieldActive:
 			values[i] = new(sql.NullBool)
-		case user.FieldID, user.FieldAvatarImageID:
+		case user.FieldID, user.FieldAvatarImageID, user.FieldInviteID:
 			values[i] = new(sql.NullInt64)
 		case user.FieldUsername, user.FieldDisplayName, user.FieldURL, user.FieldProvider, user.FieldProviderID, user.FieldProviderUsername, user.FieldProviderAvatar, user.FieldEmail, user.FieldPassword, user.FieldBio, user.FieldBioHTML:
 			values[i] = new(sql.NullString)
@@ -251,6 +303,12 @@ func (u *User) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				u.AvatarImageID = int(value.Int64)
 			}
+		case user.FieldInviteID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invite_id", values[i])
+			} else if value.Valid {
+				u.InviteID = int(value.Int64)
+			}
 		}
 	}
 	return nil
@@ -271,6 +329,21 @@ func (u *User) QueryComments() *CommentQuery {
 	return (&UserClient{config: u.config}).QueryComments(u)
 }
 
+// QueryPasskeys queries the "passkeys" edge of the User entity.
+func (u *User) QueryPasskeys() *PasskeyQuery {
+	return (&UserClient{config: u.config}).QueryPasskeys(u)
+}
+
+// QueryInvites queries the "invites" edge of the User entity.
+func (u *User) QueryInvites() *InviteQuery {
+	return (&UserClient{config: u.config}).QueryInvites(u)
+}
+
+// QueryAuditLogs queries the "audit_logs" edge of the User entity.
+func (u *User) QueryAuditLogs() *AuditLogQuery {
+	return (&UserClient{config: u.config}).QueryAuditLogs(u)
+}
+
 // QueryRoles queries the "roles" edge of the User entity.
 func (u *User) QueryRoles() *RoleQuery {
 	return (&UserClient{config: u.config}).QueryRoles(u)
@@ -281,6 +354,11 @@ func (u *User) QueryAvatarImage() *FileQuery {
 	return (&UserClient{config: u.config}).QueryAvatarImage(u)
 }
 
+// QueryInvite queries the "invite" edge of the User entity.
+func (u *User) QueryInvite() *InviteQuery {
+	return (&UserClient{config: u.config}).QueryInvite(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -336,6 +414,8 @@ func (u *User) String() string {
 	builder.WriteString(fmt.Sprintf("%v", u.Active))
 	builder.WriteString(", avatar_image_id=")
 	builder.WriteString(fmt.Sprintf("%v", u.AvatarImageID))
+	builder.WriteString(", invite_id=")
+	builder.WriteString(fmt.Sprintf("%v", u.InviteID))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -43,16 +43,26 @@ const (
 	FieldActive = "active"
 	// FieldAvatarImageID holds the string denoting the avatar_image_id field in the database.
 	FieldAvatarImageID = "avatar_image_id"
+	// FieldInviteID holds the string denoting the invite_id field in the database.
+	FieldInviteID = "invite_id"
 	// EdgePosts holds the string denoting the posts edge name in mutations.
 	EdgePosts = "posts"
 	// EdgeFiles holds the string denoting the files edge name in mutations.
 	EdgeFiles = "files"
 	// EdgeComments holds the string denoting the comments edge name in mutations.
 	EdgeComments = "comments"
+	// EdgePasskeys holds the string denoting the passkeys edge name in mutations.
+	EdgePasskeys = "passkeys"
+	// EdgeInvites holds the string denoting the invites edge name in mutations.
+	EdgeInvites = "invites"
+	// EdgeAuditLogs holds the string denoting the audit_logs edge name in mutations.
+	EdgeAuditLogs = "audit_logs"
 	// EdgeRoles holds the string denoting the roles edge name in mutations.
 	EdgeRoles = "roles"
 	// EdgeAvatarImage holds the string denoting the avatar_image edge name in mutations.
 	EdgeAvatarImage = "avatar_image"
+	// EdgeInvite holds the string denoting the invite edge name in mutations.
+	EdgeInvite = "invite"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// PostsTable is the table that holds the posts relation/edge.
@@ -76,6 +86,27 @@ const (
 	CommentsInverseTable = "comments"
 	// CommentsColumn is the table column denoting the comments relation/edge.
 	CommentsColumn = "user_id"
+	// PasskeysTable is the table that holds the passkeys relation/edge.
+	PasskeysTable = "passkeys"
+	// PasskeysInverseTable is the table name for the Passkey entity.
+	// It exists in this package in order to avoid circular dependency with the "passkey" package.
+	PasskeysInverseTable = "passkeys"
+	// PasskeysColumn is the table column denoting the passkeys relation/edge.
+	PasskeysColumn = "user_id"
+	// InvitesTable is the table that holds the invites relation/edge.
+	InvitesTable = "invites"
+	// InvitesInverseTable is the table name for the Invite entity.
+	// It exists in this package in order to avoid circular dependency with the "invite" package.
+	InvitesInverseTable = "invites"
+	// InvitesColumn is the table column denoting the invites relation/edge.
+	InvitesColumn = "user_id"
+	// AuditLogsTable is the table that holds the audit_logs relation/edge.
+	AuditLogsTable = "audit_logs"
+	// AuditLogsInverseTable is the table name for the AuditLog entity.
+	// It exists in this package in order to avoid circular dependency with the "auditlog" package.
+	AuditLogsInverseTable = "audit_logs"
+	// AuditLogsColumn is the table column denoting the audit_logs relation/edge.
+	AuditLogsColumn = "user_id"
 	// RolesTable is the table that holds the roles relation/edge. The primary key declared below.
 	RolesTable = "role_users"
 	// RolesInverseTable is the table name for the Role entity.
@@ -88,6 +119,13 @@ const (
 	AvatarImageInverseTable = "files"
 	// AvatarImageColumn is the table column denoting the avatar_image relation/edge.
 	AvatarImageColumn = "avatar_image_id"
+	// InviteTable is the table that holds the invite relation/edge.
+	InviteTable = "users"
+	// InviteInverseTable is the table name for the Invite entity.
+	// It exists in this package in order to avoid circular dependency with the "invite" package.
+	InviteInverseTable = "invites"
+	// InviteColumn is the table column denoting the invite relation/edge.
+	InviteColumn = "invite_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -109,6 +147,7 @@ var Columns = []string{
 	FieldBioHTML,
 	FieldActive,
 	FieldAvatarImageID,
+	FieldInviteID,
 }
 
 var (
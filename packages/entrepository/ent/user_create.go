@@ -11,8 +11,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/auditlog"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/comment"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/file"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/invite"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/passkey"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/post"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/role"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/user"
@@ -242,6 +245,20 @@ func (uc *UserCreate) SetNillableAvatarImageID(i *int) *UserCreate {
 	return uc
 }
 
+// SetInviteID sets the "invite_id" field.
+func (uc *UserCreate) SetInviteID(i int) *UserCreate {
+	uc.mutation.SetInviteID(i)
+	return uc
+}
+
+// SetNillableInviteID sets the "invite_id" field if the given value is not nil.
+func (uc *UserCreate) SetNillableInviteID(i *int) *UserCreate {
+	if i != nil {
+		uc.SetInviteID(*i)
+	}
+	return uc
+}
+
 // AddPostIDs adds the "posts" edge to the Post entity by IDs.
 func (uc *UserCreate) AddPostIDs(ids ...int) *UserCreate {
 	uc.mutation.AddPostIDs(ids...)
@@ -287,6 +304,51 @@ func (uc *UserCreate) AddComments(c ...*Comment) *UserCreate {
 	return uc.AddCommentIDs(ids...)
 }
 
+// AddPasskeyIDs adds the "passkeys" edge to the Passkey entity by IDs.
+func (uc *UserCreate) AddPasskeyIDs(ids ...int) *UserCreate {
+	uc.mutation.AddPasskeyIDs(ids...)
+	return uc
+}
+
+// AddPasskeys adds the "passkeys" edges to the Passkey entity.
+func (uc *UserCreate) AddPasskeys(p ...*Passkey) *UserCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uc.AddPasskeyIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the Invite entity by IDs.
+func (uc *UserCreate) AddInviteIDs(ids ...int) *UserCreate {
+	uc.mutation.AddInviteIDs(ids...)
+	return uc
+}
+
+// AddInvites adds the "invites" edges to the Invite entity.
+func (uc *UserCreate) AddInvites(i ...*Invite) *UserCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uc.AddInviteIDs(ids...)
+}
+
+// AddAuditLogIDs adds the "audit_logs" edge to the AuditLog entity by IDs.
+func (uc *UserCreate) AddAuditLogIDs(ids ...int) *UserCreate {
+	uc.mutation.AddAuditLogIDs(ids...)
+	return uc
+}
+
+// AddAuditLogs adds the "audit_logs" edges to the AuditLog entity.
+func (uc *UserCreate) AddAuditLogs(a ...*AuditLog) *UserCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddAuditLogIDs(ids...)
+}
+
 // AddRoleIDs adds the "roles" edge to the Role entity by IDs.
 func (uc *UserCreate) AddRoleIDs(ids ...int) *UserCreate {
 	uc.mutation.AddRoleIDs(ids...)
@@ -307,6 +369,11 @@ func (uc *UserCreate) SetAvatarImage(f *File) *UserCreate {
 	return uc.SetAvatarImageID(f.ID)
 }
 
+// SetInvite sets the "invite" edge to the Invite entity.
+func (uc *UserCreate) SetInvite(i *Invite) *UserCreate {
+	return uc.SetInviteID(i.ID)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -611,6 +678,63 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.PasskeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasskeysTable,
+			Columns: []string{user.PasskeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: passkey.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitesTable,
+			Columns: []string{user.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invite.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.AuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: auditlog.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := uc.mutation.RolesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -650,6 +774,26 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_node.AvatarImageID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.InviteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.InviteTable,
+			Columns: []string{user.InviteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invite.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.InviteID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -968,6 +1112,24 @@ func (u *UserUpsert) ClearAvatarImageID() *UserUpsert {
 	return u
 }
 
+// SetInviteID sets the "invite_id" field.
+func (u *UserUpsert) SetInviteID(v int) *UserUpsert {
+	u.Set(user.FieldInviteID, v)
+	return u
+}
+
+// UpdateInviteID sets the "invite_id" field to the value that was provided on create.
+func (u *UserUpsert) UpdateInviteID() *UserUpsert {
+	u.SetExcluded(user.FieldInviteID)
+	return u
+}
+
+// ClearInviteID clears the value of the "invite_id" field.
+func (u *UserUpsert) ClearInviteID() *UserUpsert {
+	u.SetNull(user.FieldInviteID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -1323,6 +1485,27 @@ func (u *UserUpsertOne) ClearAvatarImageID() *UserUpsertOne {
 	})
 }
 
+// SetInviteID sets the "invite_id" field.
+func (u *UserUpsertOne) SetInviteID(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetInviteID(v)
+	})
+}
+
+// UpdateInviteID sets the "invite_id" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateInviteID() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateInviteID()
+	})
+}
+
+// ClearInviteID clears the value of the "invite_id" field.
+func (u *UserUpsertOne) ClearInviteID() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearInviteID()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1842,6 +2025,27 @@ func (u *UserUpsertBulk) ClearAvatarImageID() *UserUpsertBulk {
 	})
 }
 
+// SetInviteID sets the "invite_id" field.
+func (u *UserUpsertBulk) SetInviteID(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetInviteID(v)
+	})
+}
+
+// UpdateInviteID sets the "invite_id" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateInviteID() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateInviteID()
+	})
+}
+
+// ClearInviteID clears the value of the "invite_id" field.
+func (u *UserUpsertBulk) ClearInviteID() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearInviteID()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -12,8 +12,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/auditlog"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/comment"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/file"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/invite"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/passkey"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/post"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/predicate"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/role"
@@ -33,8 +36,12 @@ type UserQuery struct {
 	withPosts       *PostQuery
 	withFiles       *FileQuery
 	withComments    *CommentQuery
+	withPasskeys    *PasskeyQuery
+	withInvites     *InviteQuery
+	withAuditLogs   *AuditLogQuery
 	withRoles       *RoleQuery
 	withAvatarImage *FileQuery
+	withInvite      *InviteQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -137,6 +144,72 @@ func (uq *UserQuery) QueryComments() *CommentQuery {
 	return query
 }
 
+// QueryPasskeys chains the current query on the "passkeys" edge.
+func (uq *UserQuery) QueryPasskeys() *PasskeyQuery {
+	query := &PasskeyQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(passkey.Table, passkey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PasskeysTable, user.PasskeysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvites chains the current query on the "invites" edge.
+func (uq *UserQuery) QueryInvites() *InviteQuery {
+	query := &InviteQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(invite.Table, invite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.InvitesTable, user.InvitesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAuditLogs chains the current query on the "audit_logs" edge.
+func (uq *UserQuery) QueryAuditLogs() *AuditLogQuery {
+	query := &AuditLogQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(auditlog.Table, auditlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AuditLogsTable, user.AuditLogsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryRoles chains the current query on the "roles" edge.
 func (uq *UserQuery) QueryRoles() *RoleQuery {
 	query := &RoleQuery{config: uq.config}
@@ -181,6 +254,28 @@ func (uq *UserQuery) QueryAvatarImage() *FileQuery {
 	return query
 }
 
+// QueryInvite chains the current query on the "invite" edge.
+func (uq *UserQuery) QueryInvite() *InviteQuery {
+	query := &InviteQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(invite.Table, invite.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, user.InviteTable, user.InviteColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
@@ -365,8 +460,12 @@ func (uq *UserQuery) Clone() *UserQuery {
 		withPosts:       uq.withPosts.Clone(),
 		withFiles:       uq.withFiles.Clone(),
 		withComments:    uq.withComments.Clone(),
+		withPasskeys:    uq.withPasskeys.Clone(),
+		withInvites:     uq.withInvites.Clone(),
+		withAuditLogs:   uq.withAuditLogs.Clone(),
 		withRoles:       uq.withRoles.Clone(),
 		withAvatarImage: uq.withAvatarImage.Clone(),
+		withInvite:      uq.withInvite.Clone(),
 		// clone intermediate query.
 		sql:    uq.sql.Clone(),
 		path:   uq.path,
@@ -407,6 +506,39 @@ func (uq *UserQuery) WithComments(opts ...func(*CommentQuery)) *UserQuery {
 	return uq
 }
 
+// WithPasskeys tells the query-builder to eager-load the nodes that are connected to
+// the "passkeys" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithPasskeys(opts ...func(*PasskeyQuery)) *UserQuery {
+	query := &PasskeyQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withPasskeys = query
+	return uq
+}
+
+// WithInvites tells the query-builder to eager-load the nodes that are connected to
+// the "invites" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithInvites(opts ...func(*InviteQuery)) *UserQuery {
+	query := &InviteQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withInvites = query
+	return uq
+}
+
+// WithAuditLogs tells the query-builder to eager-load the nodes that are connected to
+// the "audit_logs" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithAuditLogs(opts ...func(*AuditLogQuery)) *UserQuery {
+	query := &AuditLogQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withAuditLogs = query
+	return uq
+}
+
 // WithRoles tells the query-builder to eager-load the nodes that are connected to
 // the "roles" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithRoles(opts ...func(*RoleQuery)) *UserQuery {
@@ -429,6 +561,17 @@ func (uq *UserQuery) WithAvatarImage(opts ...func(*FileQuery)) *UserQuery {
 	return uq
 }
 
+// WithInvite tells the query-builder to eager-load the nodes that are connected to
+// the "invite" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithInvite(opts ...func(*InviteQuery)) *UserQuery {
+	query := &InviteQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withInvite = query
+	return uq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -494,12 +637,16 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 	var (
 		nodes       = []*User{}
 		_spec       = uq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [9]bool{
 			uq.withPosts != nil,
 			uq.withFiles != nil,
 			uq.withComments != nil,
+			uq.withPasskeys != nil,
+			uq.withInvites != nil,
+			uq.withAuditLogs != nil,
 			uq.withRoles != nil,
 			uq.withAvatarImage != nil,
+			uq.withInvite != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
@@ -597,6 +744,81 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 		}
 	}
 
+	if query := uq.withPasskeys; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Passkeys = []*Passkey{}
+		}
+		query.Where(predicate.Passkey(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.PasskeysColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.UserID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Passkeys = append(node.Edges.Passkeys, n)
+		}
+	}
+
+	if query := uq.withInvites; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Invites = []*Invite{}
+		}
+		query.Where(predicate.Invite(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.InvitesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.UserID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Invites = append(node.Edges.Invites, n)
+		}
+	}
+
+	if query := uq.withAuditLogs; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.AuditLogs = []*AuditLog{}
+		}
+		query.Where(predicate.AuditLog(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.AuditLogsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.UserID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.AuditLogs = append(node.Edges.AuditLogs, n)
+		}
+	}
+
 	if query := uq.withRoles; query != nil {
 		fks := make([]driver.Value, 0, len(nodes))
 		ids := make(map[int]*User, len(nodes))
@@ -688,6 +910,32 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 		}
 	}
 
+	if query := uq.withInvite; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*User)
+		for i := range nodes {
+			fk := nodes[i].InviteID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(invite.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "invite_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Invite = n
+			}
+		}
+	}
+
 	return nodes, nil
 }
 
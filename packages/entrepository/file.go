@@ -3,16 +3,169 @@ package entrepository
 import (
 	"context"
 	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 
 	e "github.com/ngocphuongnb/tetua/app/entities"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/customfield"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/customfieldvalue"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/file"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menu"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menuitem"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/page"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/post"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/predicate"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/user"
 )
 
-type FileRepository = BaseRepository[e.File, ent.File, *ent.FileQuery, *e.FileFilter]
+type FileRepository struct {
+	*BaseRepository[e.File, ent.File, *ent.FileQuery, *e.FileFilter]
+}
+
+// Usages returns the posts and pages that use the file as their featured image or in their content,
+// the users that use it as their avatar, the url custom field values and the menus that link to it
+func (f *FileRepository) Usages(ctx context.Context, data *e.File) ([]*e.FileUsage, error) {
+	usages := []*e.FileUsage{}
+	paths := []string{data.Path}
+	for _, variant := range data.Variants {
+		paths = append(paths, variant.Path)
+	}
+
+	postPredicates := []predicate.Post{post.FeaturedImageIDEQ(data.ID)}
+	pagePredicates := []predicate.Page{page.FeaturedImageIDEQ(data.ID)}
+	for _, filePath := range paths {
+		postPredicates = append(postPredicates, post.ContentHTMLContains(filePath))
+		pagePredicates = append(pagePredicates, page.ContentHTMLContains(filePath))
+	}
+
+	posts, err := f.Client.Post.Query().
+		Where(post.DeletedAtIsNil(), post.Or(postPredicates...)).
+		Select(post.FieldID, post.FieldName).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range posts {
+		usages = append(usages, &e.FileUsage{Type: "post", ID: p.ID, Name: p.Name})
+	}
+
+	pages, err := f.Client.Page.Query().
+		Where(page.DeletedAtIsNil(), page.Or(pagePredicates...)).
+		Select(page.FieldID, page.FieldName).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range pages {
+		usages = append(usages, &e.FileUsage{Type: "page", ID: p.ID, Name: p.Name})
+	}
+
+	users, err := f.Client.User.Query().
+		Where(user.DeletedAtIsNil(), user.AvatarImageIDEQ(data.ID)).
+		Select(user.FieldID, user.FieldUsername).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		usages = append(usages, &e.FileUsage{Type: "avatar", ID: u.ID, Name: u.Username})
+	}
+
+	fieldUsages, err := f.customFieldUsages(ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+	usages = append(usages, fieldUsages...)
+
+	menuUsages, err := f.menuUsages(ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(usages, menuUsages...), nil
+}
+
+// customFieldUsages returns the values of the url custom fields that link to one of the paths
+func (f *FileRepository) customFieldUsages(ctx context.Context, paths []string) ([]*e.FileUsage, error) {
+	usages := []*e.FileUsage{}
+	fields, err := f.Client.CustomField.Query().Where(customfield.TypeEQ(e.CUSTOM_FIELD_URL)).All(ctx)
+	if err != nil || len(fields) == 0 {
+		return usages, err
+	}
+
+	fieldPredicates := []predicate.CustomFieldValue{}
+	for _, field := range fields {
+		fieldPredicates = append(fieldPredicates, customfieldvalue.And(
+			customfieldvalue.TargetTypeEQ(field.Target),
+			customfieldvalue.NameEQ(field.Name),
+		))
+	}
+
+	valuePredicates := []predicate.CustomFieldValue{}
+	for _, filePath := range paths {
+		valuePredicates = append(valuePredicates, customfieldvalue.ValueContains(filePath))
+	}
+
+	values, err := f.Client.CustomFieldValue.Query().
+		Where(customfieldvalue.Or(fieldPredicates...), customfieldvalue.Or(valuePredicates...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range values {
+		usages = append(usages, &e.FileUsage{
+			Type:   "custom_field",
+			Target: v.TargetType,
+			ID:     v.TargetID,
+			Name:   fmt.Sprintf("%s #%d: %s", v.TargetType, v.TargetID, v.Name),
+		})
+	}
+
+	return usages, nil
+}
+
+// menuUsages returns the menus whose custom items link to one of the paths
+func (f *FileRepository) menuUsages(ctx context.Context, paths []string) ([]*e.FileUsage, error) {
+	usages := []*e.FileUsage{}
+	urlPredicates := []predicate.MenuItem{}
+	for _, filePath := range paths {
+		urlPredicates = append(urlPredicates, menuitem.URLContains(filePath))
+	}
+
+	menus, err := f.Client.Menu.Query().
+		Where(menu.HasItemsWith(menuitem.TypeEQ(e.MENU_ITEM_CUSTOM), menuitem.Or(urlPredicates...))).
+		Select(menu.FieldID, menu.FieldName).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range menus {
+		usages = append(usages, &e.FileUsage{Type: "menu", ID: m.ID, Name: m.Name})
+	}
+
+	return usages, nil
+}
+
+// TotalSize returns the sum of the sizes of the files matching the filters
+func (f *FileRepository) TotalSize(ctx context.Context, filters ...*e.FileFilter) (int, error) {
+	sizes, err := f.QueryFilterFn(f.Client, filters...).Select(file.FieldSize).Ints(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, size := range sizes {
+		total += size
+	}
+
+	return total, nil
+}
 
 func CreateFileRepository(client *ent.Client) *FileRepository {
-	return &FileRepository{
+	return &FileRepository{BaseRepository: &BaseRepository[e.File, ent.File, *ent.FileQuery, *e.FileFilter]{
 		Name:      "file",
 		Client:    client,
 		ConvertFn: entFileToFile,
@@ -30,7 +183,16 @@ func CreateFileRepository(client *ent.Client) *FileRepository {
 				SetDisk(data.Disk).
 				SetPath(data.Path).
 				SetSize(data.Size).
+				SetHash(data.Hash).
 				SetType(data.Type).
+				SetWidth(data.Width).
+				SetHeight(data.Height).
+				SetVariants(data.Variants).
+				SetOriginalName(data.OriginalName).
+				SetTitle(data.Title).
+				SetAlt(data.Alt).
+				SetCaption(data.Caption).
+				SetTags(data.Tags).
 				SetUserID(data.UserID).
 				Save(ctx)
 		},
@@ -42,14 +204,58 @@ func CreateFileRepository(client *ent.Client) *FileRepository {
 				SetDisk(data.Disk).
 				SetPath(data.Path).
 				SetSize(data.Size).
+				SetHash(data.Hash).
 				SetType(data.Type).
+				SetWidth(data.Width).
+				SetHeight(data.Height).
+				SetVariants(data.Variants).
+				SetOriginalName(data.OriginalName).
+				SetTitle(data.Title).
+				SetAlt(data.Alt).
+				SetCaption(data.Caption).
+				SetTags(data.Tags).
 				Save(ctx)
 		},
 		QueryFilterFn: func(client *ent.Client, filters ...*e.FileFilter) *ent.FileQuery {
 			query := client.File.Query()
 			if len(filters) > 0 {
 				if filters[0].Search != "" {
-					query = query.Where(file.PathContainsFold(filters[0].Search))
+					query = query.Where(file.Or(
+						file.PathContainsFold(filters[0].Search),
+						file.OriginalNameContainsFold(filters[0].Search),
+						file.TitleContainsFold(filters[0].Search),
+						file.AltContainsFold(filters[0].Search),
+					))
+				}
+				if len(filters[0].Types) > 0 {
+					typePredicates := []predicate.File{}
+					for _, group := range filters[0].Types {
+						for _, prefix := range e.FileTypeGroups[group] {
+							typePredicates = append(typePredicates, file.TypeHasPrefix(prefix))
+						}
+					}
+					query = query.Where(file.Or(typePredicates...))
+				}
+				for _, tag := range filters[0].Tags {
+					tag := tag
+					query = query.Where(func(s *sql.Selector) {
+						s.Where(sqljson.ValueContains(s.C(file.FieldTags), tag))
+					})
+				}
+				if filters[0].From != nil {
+					query = query.Where(file.CreatedAtGTE(*filters[0].From))
+				}
+				if filters[0].To != nil {
+					query = query.Where(file.CreatedAtLT(filters[0].To.AddDate(0, 0, 1)))
+				}
+				if len(filters[0].Disks) > 0 {
+					query = query.Where(file.DiskIn(filters[0].Disks...))
+				}
+				if len(filters[0].Paths) > 0 {
+					query = query.Where(file.PathIn(filters[0].Paths...))
+				}
+				if len(filters[0].Hashes) > 0 {
+					query = query.Where(file.HashIn(filters[0].Hashes...))
 				}
 				if len(filters[0].UserIDs) > 0 {
 					query = query.Where(file.UserIDIn(filters[0].UserIDs...))
@@ -69,5 +275,5 @@ func CreateFileRepository(client *ent.Client) *FileRepository {
 				Offset((page - 1) * limit).
 				Order(sorts...).All(ctx)
 		},
-	}
+	}}
 }
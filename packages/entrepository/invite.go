@@ -0,0 +1,138 @@
+package entrepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	e "github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/invite"
+)
+
+type InviteRepository struct {
+	*BaseRepository[e.Invite, ent.Invite, *ent.InviteQuery, *e.InviteFilter]
+}
+
+func (i *InviteRepository) ByCode(ctx context.Context, code string) (*e.Invite, error) {
+	inv, err := i.Client.Invite.
+		Query().
+		Where(invite.CodeEQ(code)).
+		WithRole().
+		Only(ctx)
+
+	if err != nil {
+		return nil, EntError(err, fmt.Sprintf("invite not found with code: %s", code))
+	}
+
+	return entInviteToInvite(inv), nil
+}
+
+// Use atomically increases the used counter of an invite that is neither expired nor used up
+func (i *InviteRepository) Use(ctx context.Context, id int) error {
+	affected, err := i.Client.Invite.
+		Update().
+		Where(
+			invite.IDEQ(id),
+			invite.Or(invite.ExpiresAtIsNil(), invite.ExpiresAtGT(time.Now())),
+			invite.Or(invite.MaxUsesEQ(0), func(s *sql.Selector) {
+				s.Where(sql.ColumnsLT(s.C(invite.FieldUsed), s.C(invite.FieldMaxUses)))
+			}),
+		).
+		AddUsed(1).
+		Save(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return errors.New("invite is expired or has been used up")
+	}
+
+	return nil
+}
+
+// Release gives back a use of an invite that was claimed by a registration that failed
+func (i *InviteRepository) Release(ctx context.Context, id int) error {
+	_, err := i.Client.Invite.
+		Update().
+		Where(invite.IDEQ(id), invite.UsedGT(0)).
+		AddUsed(-1).
+		Save(ctx)
+
+	return err
+}
+
+func CreateInviteRepository(client *ent.Client) *InviteRepository {
+	return &InviteRepository{
+		BaseRepository: &BaseRepository[e.Invite, ent.Invite, *ent.InviteQuery, *e.InviteFilter]{
+			Name:      "invite",
+			Client:    client,
+			ConvertFn: entInviteToInvite,
+			ByIDFn: func(ctx context.Context, client *ent.Client, id int) (*ent.Invite, error) {
+				return client.Invite.Query().Where(invite.IDEQ(id)).WithRole().Only(ctx)
+			},
+			DeleteByIDFn: func(ctx context.Context, client *ent.Client, id int) error {
+				return client.Invite.DeleteOneID(id).Exec(ctx)
+			},
+			CreateFn: func(ctx context.Context, client *ent.Client, data *e.Invite) (*ent.Invite, error) {
+				ic := client.Invite.Create().
+					SetCode(data.Code).
+					SetUserID(data.UserID).
+					SetMaxUses(data.MaxUses).
+					SetNillableExpiresAt(data.ExpiresAt)
+
+				if data.RoleID > 0 {
+					ic.SetRoleID(data.RoleID)
+				}
+
+				return ic.Save(ctx)
+			},
+			UpdateFn: func(ctx context.Context, client *ent.Client, data *e.Invite) (*ent.Invite, error) {
+				iu := client.Invite.UpdateOneID(data.ID).
+					SetMaxUses(data.MaxUses).
+					SetUsed(data.Used).
+					SetNillableExpiresAt(data.ExpiresAt)
+
+				if data.RoleID > 0 {
+					iu.SetRoleID(data.RoleID)
+				} else {
+					iu.ClearRoleID()
+				}
+
+				return iu.Save(ctx)
+			},
+			QueryFilterFn: func(client *ent.Client, filters ...*e.InviteFilter) *ent.InviteQuery {
+				query := client.Invite.Query()
+				if len(filters) > 0 {
+					if len(filters[0].UserIDs) > 0 {
+						query = query.Where(invite.UserIDIn(filters[0].UserIDs...))
+					}
+
+					if len(filters[0].ExcludeIDs) > 0 {
+						query = query.Where(invite.IDNotIn(filters[0].ExcludeIDs...))
+					}
+
+					if filters[0].Search != "" {
+						query = query.Where(invite.CodeContainsFold(filters[0].Search))
+					}
+				}
+
+				return query
+			},
+			FindFn: func(ctx context.Context, query *ent.InviteQuery, filters ...*e.InviteFilter) ([]*ent.Invite, error) {
+				page, limit, sorts := getPaginateParams(filters[0])
+				return query.
+					WithUser().
+					WithRole().
+					WithInvitedUsers().
+					Limit(limit).
+					Offset((page - 1) * limit).
+					Order(sorts...).All(ctx)
+			},
+		},
+	}
+}
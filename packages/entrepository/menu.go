@@ -0,0 +1,119 @@
+package entrepository
+
+import (
+	"context"
+
+	e "github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/repositories"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menu"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/menuitem"
+)
+
+type MenuRepository struct {
+	*BaseRepository[e.Menu, ent.Menu, *ent.MenuQuery, *e.MenuFilter]
+}
+
+func (m *MenuRepository) SetItems(ctx context.Context, id int, items []*e.MenuItem) error {
+	// the items are replaced in a transaction so that a failed save keeps the previous items
+	tx, err := m.Client.Tx(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+	client := tx.Client()
+
+	if _, err := client.MenuItem.Delete().Where(menuitem.MenuIDEQ(id)).Exec(ctx); err != nil {
+		return err
+	}
+
+	if err := createMenuItems(ctx, client, id, 0, items); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	repositories.Changed(ctx, "menu")
+
+	return nil
+}
+
+func createMenuItems(ctx context.Context, client *ent.Client, menuID, parentID int, items []*e.MenuItem) error {
+	for order, item := range items {
+		ic := client.MenuItem.Create().
+			SetMenuID(menuID).
+			SetLabel(item.Label).
+			SetType(item.Type).
+			SetTargetID(item.TargetID).
+			SetURL(item.Url).
+			SetSortOrder(order)
+
+		if parentID > 0 {
+			ic.SetParentID(parentID)
+		}
+
+		created, err := ic.Save(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		if err := createMenuItems(ctx, client, menuID, created.ID, item.Children); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func CreateMenuRepository(client *ent.Client) *MenuRepository {
+	return &MenuRepository{
+		BaseRepository: &BaseRepository[e.Menu, ent.Menu, *ent.MenuQuery, *e.MenuFilter]{
+			Name:      "menu",
+			Client:    client,
+			ConvertFn: entMenuToMenu,
+			ByIDFn: func(ctx context.Context, client *ent.Client, id int) (*ent.Menu, error) {
+				return client.Menu.Query().Where(menu.IDEQ(id)).WithItems().Only(ctx)
+			},
+			DeleteByIDFn: func(ctx context.Context, client *ent.Client, id int) error {
+				return client.Menu.DeleteOneID(id).Exec(ctx)
+			},
+			CreateFn: func(ctx context.Context, client *ent.Client, data *e.Menu) (*ent.Menu, error) {
+				return client.Menu.Create().
+					SetName(data.Name).
+					SetLocation(data.Location).
+					Save(ctx)
+			},
+			UpdateFn: func(ctx context.Context, client *ent.Client, data *e.Menu) (*ent.Menu, error) {
+				return client.Menu.UpdateOneID(data.ID).
+					SetName(data.Name).
+					SetLocation(data.Location).
+					Save(ctx)
+			},
+			QueryFilterFn: func(client *ent.Client, filters ...*e.MenuFilter) *ent.MenuQuery {
+				query := client.Menu.Query().WithItems()
+
+				if len(filters) > 0 && filters[0].Search != "" {
+					query = query.Where(menu.Or(
+						menu.NameContainsFold(filters[0].Search),
+						menu.LocationContainsFold(filters[0].Search),
+					))
+				}
+
+				return query
+			},
+			FindFn: func(ctx context.Context, query *ent.MenuQuery, filters ...*e.MenuFilter) ([]*ent.Menu, error) {
+				page, limit, sorts := getPaginateParams(filters...)
+				return query.
+					Limit(limit).
+					Offset((page - 1) * limit).
+					Order(sorts...).
+					All(ctx)
+			},
+		},
+	}
+}
@@ -0,0 +1,742 @@
+package entrepository
+
+import (
+	"bytes"
+	"context"
+	stdsql "database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io/fs"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+	atlas "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent"
+)
+
+// MigrationsTable is the table of the applied migration versions
+const MigrationsTable = "schema_migrations"
+
+// migrationsLockTable holds the lock of the SQLite databases, MySQL and PostgreSQL use their advisory locks
+const migrationsLockTable = "schema_migrations_lock"
+const migrationsLockName = "tetua_migrations"
+
+//go:embed migrations
+var migrationFiles embed.FS
+
+var migrationFileRegexp = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+var migrationNameRegexp = regexp.MustCompile(`^\w+$`)
+
+var ErrMigrationsLocked = errors.New("the migrations are locked by another run")
+var ErrNoSchemaChanges = errors.New("the database schema is up to date with the ent schema")
+
+// Migration is a versioned change of the database schema, its statements are read from the
+// migrations/{driver}/{version}_{name}.up.sql and .down.sql files
+type Migration struct {
+	Version   int64
+	Name      string
+	Up        string
+	Down      string
+	AppliedAt *time.Time
+}
+
+// Migrator applies and rolls back the migrations of a driver, the applied versions are recorded in the MigrationsTable.
+// The runs hold a lock, so the instances that start at the same time don't apply the same migrations.
+// The SQLite lock is a row that isn't released when a run is killed, it's removed when it's older than LockExpiry.
+type Migrator struct {
+	DB          *stdsql.DB
+	Driver      string
+	Client      *ent.Client
+	Files       fs.FS
+	LockTimeout time.Duration
+	LockExpiry  time.Duration
+}
+
+func NewMigrator(db *stdsql.DB, driver string, client *ent.Client) *Migrator {
+	files, _ := fs.Sub(migrationFiles, "migrations")
+
+	return &Migrator{
+		DB:          db,
+		Driver:      driver,
+		Client:      client,
+		Files:       files,
+		LockTimeout: time.Minute,
+		LockExpiry:  10 * time.Minute,
+	}
+}
+
+// String returns the file name of the migration without the direction
+func (m *Migration) String() string {
+	return fmt.Sprintf("%d_%s", m.Version, m.Name)
+}
+
+// Migrations returns the migrations of the driver sorted by version
+func (m *Migrator) Migrations() ([]*Migration, error) {
+	entries, err := fs.ReadDir(m.Files, m.Driver)
+
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	migrationsByVersion := map[int64]*Migration{}
+
+	for _, entry := range entries {
+		matches := migrationFileRegexp.FindStringSubmatch(entry.Name())
+
+		if entry.IsDir() || matches == nil {
+			continue
+		}
+
+		version, _ := strconv.ParseInt(matches[1], 10, 64)
+		content, err := fs.ReadFile(m.Files, path.Join(m.Driver, entry.Name()))
+
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := migrationsByVersion[version]
+
+		if !ok {
+			migration = &Migration{Version: version, Name: matches[2]}
+			migrationsByVersion[version] = migration
+		}
+
+		if migration.Name != matches[2] {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, migration.Name, matches[2])
+		}
+
+		if matches[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(migrationsByVersion))
+
+	for _, migration := range migrationsByVersion {
+		migrations = append(migrations, migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Status returns the migrations with the time they were applied at,
+// the applied versions that have no migration files are returned without a name
+func (m *Migrator) Status(ctx context.Context) ([]*Migration, error) {
+	migrations, err := m.Migrations()
+
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := m.applied(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, migration := range migrations {
+		if appliedAt, ok := applied[migration.Version]; ok {
+			migration.AppliedAt = &appliedAt
+			delete(applied, migration.Version)
+		}
+	}
+
+	for version, appliedAt := range applied {
+		appliedAt := appliedAt
+		migrations = append(migrations, &Migration{Version: version, AppliedAt: &appliedAt})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Pending returns the migrations that are not applied yet
+func (m *Migrator) Pending(ctx context.Context) ([]*Migration, error) {
+	migrations, err := m.Status(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pending := []*Migration{}
+
+	for _, migration := range migrations {
+		if migration.AppliedAt == nil {
+			pending = append(pending, migration)
+		}
+	}
+
+	return pending, nil
+}
+
+// Version returns the latest applied migration version, 0 when no migration is applied
+func (m *Migrator) Version(ctx context.Context) (int64, error) {
+	applied, err := m.applied(ctx)
+
+	if err != nil {
+		return 0, err
+	}
+
+	version := int64(0)
+
+	for appliedVersion := range applied {
+		if appliedVersion > version {
+			version = appliedVersion
+		}
+	}
+
+	return version, nil
+}
+
+// Up applies the pending migrations in order, steps limits the number of the applied migrations when it's positive.
+// The first migration creates the tables of the initial schema, it's only recorded as applied
+// when the tables were already created by the auto-migration of the older versions.
+func (m *Migrator) Up(ctx context.Context, steps int) (migrations []*Migration, err error) {
+	unlock, err := m.lock(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if unlockErr := unlock(); err == nil {
+			err = unlockErr
+		}
+	}()
+
+	if _, err := m.DB.ExecContext(ctx, m.createMigrationsTableQuery()); err != nil {
+		return nil, err
+	}
+
+	pending, err := m.Pending(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pending) > 0 {
+		if err := m.adoptInitialSchema(ctx, pending[0]); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, migration := range pending {
+		if steps > 0 && len(migrations) == steps {
+			break
+		}
+
+		if migration.AppliedAt == nil {
+			if err := m.run(ctx, migration, migration.Up, true); err != nil {
+				return migrations, err
+			}
+		}
+
+		migrations = append(migrations, migration)
+	}
+
+	return migrations, nil
+}
+
+// Down rolls back the latest applied migrations, steps is the number of the migrations to roll back
+func (m *Migrator) Down(ctx context.Context, steps int) (migrations []*Migration, err error) {
+	if steps < 1 {
+		steps = 1
+	}
+
+	unlock, err := m.lock(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if unlockErr := unlock(); err == nil {
+			err = unlockErr
+		}
+	}()
+
+	status, err := m.Status(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(status) - 1; i >= 0 && len(migrations) < steps; i-- {
+		migration := status[i]
+
+		if migration.AppliedAt == nil {
+			continue
+		}
+
+		if migration.Name == "" {
+			return migrations, fmt.Errorf("the files of the applied migration %d are not found", migration.Version)
+		}
+
+		if len(splitStatements(migration.Down)) == 0 {
+			return migrations, fmt.Errorf("migration %s can't be rolled back, it has no down statements", migration)
+		}
+
+		if err := m.run(ctx, migration, migration.Down, false); err != nil {
+			return migrations, err
+		}
+
+		migrations = append(migrations, migration)
+	}
+
+	return migrations, nil
+}
+
+// Diff writes a new migration from the difference of the database and the ent schema to the {dir}/{driver} directory,
+// the database must be up to date. The generated files should be reviewed, e.g. to rename columns or to move data.
+func (m *Migrator) Diff(ctx context.Context, dir, name string) ([]string, error) {
+	if !migrationNameRegexp.MatchString(name) {
+		return nil, fmt.Errorf("invalid migration name %s, only letters, digits and underscores are allowed", name)
+	}
+
+	if pending, err := m.Pending(ctx); err != nil {
+		return nil, err
+	} else if len(pending) > 0 {
+		return nil, fmt.Errorf("%d migrations are pending, apply them before generating a new migration", len(pending))
+	}
+
+	dir = path.Join(dir, m.Driver)
+
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	migrationDir, err := migrate.NewLocalDir(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	formatter := &migrationFormatter{version: time.Now().Unix(), name: name}
+
+	if err := m.Client.Schema.Diff(
+		ctx,
+		schema.WithDir(migrationDir),
+		schema.WithFormatter(formatter),
+		schema.WithDropColumn(true),
+		schema.WithDropIndex(true),
+		schema.WithDiffHook(skipSQLiteAutoIndexes),
+	); err != nil {
+		return nil, err
+	}
+
+	if len(formatter.files) == 0 {
+		return nil, ErrNoSchemaChanges
+	}
+
+	files := make([]string, 0, len(formatter.files))
+
+	for _, file := range formatter.files {
+		files = append(files, path.Join(dir, file))
+	}
+
+	return files, nil
+}
+
+// skipSQLiteAutoIndexes removes the changes that replace the indexes SQLite creates for the unique columns
+// with the same indexes by name, the inspected sqlite_autoindex_* indexes never match the ent schema
+func skipSQLiteAutoIndexes(next schema.Differ) schema.Differ {
+	return schema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+		changes, err := next.Diff(current, desired)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, change := range changes {
+			modify, ok := change.(*atlas.ModifyTable)
+
+			if !ok {
+				continue
+			}
+
+			dropped, added := map[string]bool{}, map[string]bool{}
+
+			for _, change := range modify.Changes {
+				switch change := change.(type) {
+				case *atlas.DropIndex:
+					if strings.HasPrefix(change.I.Name, "sqlite_autoindex_") {
+						dropped[indexColumns(change.I)] = true
+					}
+				case *atlas.AddIndex:
+					if change.I.Unique {
+						added[indexColumns(change.I)] = true
+					}
+				}
+			}
+
+			tableChanges := make([]atlas.Change, 0, len(modify.Changes))
+
+			for _, change := range modify.Changes {
+				switch change := change.(type) {
+				case *atlas.DropIndex:
+					if strings.HasPrefix(change.I.Name, "sqlite_autoindex_") && added[indexColumns(change.I)] {
+						continue
+					}
+				case *atlas.AddIndex:
+					if change.I.Unique && dropped[indexColumns(change.I)] {
+						continue
+					}
+				}
+
+				tableChanges = append(tableChanges, change)
+			}
+
+			modify.Changes = tableChanges
+		}
+
+		filtered := make([]atlas.Change, 0, len(changes))
+
+		for _, change := range changes {
+			if modify, ok := change.(*atlas.ModifyTable); ok && len(modify.Changes) == 0 {
+				continue
+			}
+
+			filtered = append(filtered, change)
+		}
+
+		return filtered, nil
+	})
+}
+
+func indexColumns(index *atlas.Index) string {
+	columns := make([]string, 0, len(index.Parts))
+
+	for _, part := range index.Parts {
+		if part.C != nil {
+			columns = append(columns, part.C.Name)
+		}
+	}
+
+	return strings.Join(columns, ",")
+}
+
+// adoptInitialSchema records the initial migration as applied when its tables exist,
+// the databases of the older versions were created by the auto-migration.
+// Those databases can be from a release that didn't have all the tables and columns of the initial migration yet,
+// so the auto-migration runs once more to add them before the migration is recorded,
+// it also drops the indexes that were renamed since.
+func (m *Migrator) adoptInitialSchema(ctx context.Context, migration *Migration) error {
+	migrations, err := m.Migrations()
+
+	if err != nil || len(migrations) == 0 || migrations[0].Version != migration.Version {
+		return err
+	}
+
+	exists, err := m.tableExists(ctx, "users")
+
+	if err != nil || !exists {
+		return err
+	}
+
+	// the renamed indexes are dropped in a second run, once the tables that were added since exist
+	if err := m.Client.Schema.Create(ctx); err != nil {
+		return fmt.Errorf("migration %s: %w", migration, err)
+	}
+
+	if err := m.Client.Schema.Create(
+		ctx,
+		schema.WithDropIndex(true),
+		schema.WithDiffHook(skipSQLiteAutoIndexes),
+	); err != nil {
+		return fmt.Errorf("migration %s: %w", migration, err)
+	}
+
+	if err := m.run(ctx, migration, "", true); err != nil {
+		return err
+	}
+
+	appliedAt := time.Now()
+	migration.AppliedAt = &appliedAt
+
+	return nil
+}
+
+// run executes the statements of the migration and records or deletes its version in a transaction,
+// the MySQL statements that change the schema are committed implicitly
+func (m *Migrator) run(ctx context.Context, migration *Migration, statements string, up bool) error {
+	tx, err := m.DB.BeginTx(ctx, nil)
+
+	if err != nil {
+		return err
+	}
+
+	for _, statement := range splitStatements(statements) {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %s: %w", migration, err)
+		}
+	}
+
+	var query string
+	var args []interface{}
+
+	if up {
+		query, args = sql.Dialect(m.Driver).
+			Insert(MigrationsTable).
+			Columns("version", "name", "applied_at").
+			Values(migration.Version, migration.Name, time.Now().UTC()).
+			Query()
+	} else {
+		query, args = sql.Dialect(m.Driver).
+			Delete(MigrationsTable).
+			Where(sql.EQ("version", migration.Version)).
+			Query()
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("migration %s: %w", migration, err)
+	}
+
+	return tx.Commit()
+}
+
+// applied returns the applied versions and the time they were applied at
+func (m *Migrator) applied(ctx context.Context) (map[int64]time.Time, error) {
+	applied := map[int64]time.Time{}
+
+	if exists, err := m.tableExists(ctx, MigrationsTable); err != nil || !exists {
+		return applied, err
+	}
+
+	query, args := sql.Dialect(m.Driver).
+		Select("version", "applied_at").
+		From(sql.Table(MigrationsTable)).
+		Query()
+	rows, err := m.DB.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var version int64
+		var appliedAt time.Time
+
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+
+		applied[version] = appliedAt
+	}
+
+	return applied, rows.Err()
+}
+
+func (m *Migrator) tableExists(ctx context.Context, table string) (bool, error) {
+	var query string
+
+	switch m.Driver {
+	case dialect.MySQL:
+		query = "SELECT COUNT(*) FROM INFORMATION_SCHEMA.TABLES WHERE TABLE_SCHEMA = (SELECT DATABASE()) AND TABLE_NAME = ?"
+	case dialect.Postgres:
+		query = "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = CURRENT_SCHEMA() AND table_name = $1"
+	default:
+		query = "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?"
+	}
+
+	count := 0
+
+	if err := m.DB.QueryRowContext(ctx, query, table).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (m *Migrator) createMigrationsTableQuery() string {
+	timeType := "timestamp"
+
+	if m.Driver == dialect.MySQL {
+		timeType = "datetime"
+	}
+
+	return fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %s (version bigint NOT NULL PRIMARY KEY, name varchar(255) NOT NULL, applied_at %s NOT NULL)",
+		MigrationsTable,
+		timeType,
+	)
+}
+
+// lock waits for the migrations lock until the lock timeout and returns the function that releases it
+func (m *Migrator) lock(ctx context.Context) (func() error, error) {
+	conn, err := m.DB.Conn(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var lockQuery, unlockQuery, staleQuery string
+	var lockArgs, unlockArgs []interface{}
+
+	switch m.Driver {
+	case dialect.MySQL:
+		lockQuery, unlockQuery = "SELECT GET_LOCK(?, ?) = 1", "SELECT RELEASE_LOCK(?)"
+		lockArgs = []interface{}{migrationsLockName, int(m.LockTimeout.Seconds())}
+		unlockArgs = []interface{}{migrationsLockName}
+	case dialect.Postgres:
+		key := int64(crc32.ChecksumIEEE([]byte(migrationsLockName)))
+		lockQuery, unlockQuery = "SELECT pg_try_advisory_lock($1)", "SELECT pg_advisory_unlock($1)"
+		lockArgs = []interface{}{key}
+		unlockArgs = []interface{}{key}
+	default:
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf(
+			"CREATE TABLE IF NOT EXISTS %s (id integer NOT NULL PRIMARY KEY, locked_at timestamp NOT NULL)",
+			migrationsLockTable,
+		)); err != nil {
+			conn.Close()
+			return nil, err
+		}
+
+		lockQuery = fmt.Sprintf("INSERT INTO %s (id, locked_at) VALUES (1, ?) ON CONFLICT DO NOTHING RETURNING true", migrationsLockTable)
+		unlockQuery = fmt.Sprintf("DELETE FROM %s WHERE id = 1", migrationsLockTable)
+		staleQuery = fmt.Sprintf("DELETE FROM %s WHERE id = 1 AND locked_at < ?", migrationsLockTable)
+	}
+
+	deadline := time.Now().Add(m.LockTimeout)
+
+	for {
+		if staleQuery != "" {
+			now := time.Now().UTC()
+			lockArgs = []interface{}{now}
+
+			if _, err := conn.ExecContext(ctx, staleQuery, now.Add(-m.LockExpiry)); err != nil {
+				conn.Close()
+				return nil, err
+			}
+		}
+
+		locked := false
+		err := conn.QueryRowContext(ctx, lockQuery, lockArgs...).Scan(&locked)
+
+		if err != nil && !errors.Is(err, stdsql.ErrNoRows) {
+			conn.Close()
+			return nil, err
+		}
+
+		if locked {
+			break
+		}
+
+		if m.Driver == dialect.MySQL || time.Now().After(deadline) {
+			conn.Close()
+			return nil, ErrMigrationsLocked
+		}
+
+		time.Sleep(migrationsLockRetryInterval(m.LockTimeout))
+	}
+
+	return func() error {
+		defer conn.Close()
+		_, err := conn.ExecContext(context.Background(), unlockQuery, unlockArgs...)
+		return err
+	}, nil
+}
+
+func migrationsLockRetryInterval(timeout time.Duration) time.Duration {
+	if interval := timeout / 10; interval < time.Second {
+		return interval
+	}
+
+	return time.Second
+}
+
+// splitStatements splits the content of a migration file to its statements,
+// the statements end with a semicolon at the end of a line and the comment lines are skipped
+func splitStatements(content string) []string {
+	statements := []string{}
+	statement := []string{}
+
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if len(statement) == 0 && (trimmed == "" || strings.HasPrefix(trimmed, "--")) {
+			continue
+		}
+
+		statement = append(statement, line)
+
+		if strings.HasSuffix(trimmed, ";") {
+			statements = append(statements, strings.TrimSuffix(strings.TrimSpace(strings.Join(statement, "\n")), ";"))
+			statement = []string{}
+		}
+	}
+
+	if len(statement) > 0 {
+		statements = append(statements, strings.TrimSpace(strings.Join(statement, "\n")))
+	}
+
+	return statements
+}
+
+// migrationFormatter writes the planned changes of a diff to the up and down files of a migration
+type migrationFormatter struct {
+	version int64
+	name    string
+	files   []string
+}
+
+type migrationFile struct {
+	*bytes.Buffer
+	name string
+}
+
+func (f *migrationFile) Name() string {
+	return f.name
+}
+
+func (f *migrationFormatter) Format(plan *migrate.Plan) ([]migrate.File, error) {
+	if len(plan.Changes) == 0 {
+		return nil, nil
+	}
+
+	up := &migrationFile{Buffer: &bytes.Buffer{}, name: fmt.Sprintf("%d_%s.up.sql", f.version, f.name)}
+	down := &migrationFile{Buffer: &bytes.Buffer{}, name: fmt.Sprintf("%d_%s.down.sql", f.version, f.name)}
+
+	for _, change := range plan.Changes {
+		if change.Comment != "" {
+			fmt.Fprintf(up, "-- %s\n", change.Comment)
+		}
+
+		fmt.Fprintf(up, "%s;\n", strings.TrimSuffix(change.Cmd, ";"))
+	}
+
+	for i := len(plan.Changes) - 1; i >= 0; i-- {
+		if reverse := plan.Changes[i].Reverse; reverse != "" {
+			fmt.Fprintf(down, "%s;\n", strings.TrimSuffix(reverse, ";"))
+		} else {
+			fmt.Fprintf(down, "-- irreversible: %s\n", strings.TrimSuffix(plan.Changes[i].Cmd, ";"))
+		}
+	}
+
+	f.files = []string{up.name, down.name}
+
+	return []migrate.File{up, down}, nil
+}
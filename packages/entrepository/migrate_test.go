@@ -0,0 +1,228 @@
+package entrepository_test
+
+import (
+	"context"
+	stdsql "database/sql"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/ngocphuongnb/tetua/packages/entrepository"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestMigrator(t *testing.T, name string) *entrepository.Migrator {
+	db, err := stdsql.Open(dialect.SQLite, "file:"+name+"?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	assert.NoError(t, err)
+	// the in-memory database is removed when its last connection is closed
+	db.SetConnMaxIdleTime(0)
+	db.SetMaxIdleConns(10)
+	t.Cleanup(func() { db.Close() })
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+
+	return entrepository.NewMigrator(db, dialect.SQLite, client)
+}
+
+func tableExists(t *testing.T, migrator *entrepository.Migrator, table string) bool {
+	count := 0
+	err := migrator.DB.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?", table).Scan(&count)
+	assert.NoError(t, err)
+	return count > 0
+}
+
+func TestMigratorUpDown(t *testing.T) {
+	ctx := context.Background()
+	migrator := newTestMigrator(t, "migrator_up_down_test")
+
+	migrations, err := migrator.Status(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(migrations))
+	assert.Equal(t, "1792368000_initial", migrations[0].String())
+	assert.Nil(t, migrations[0].AppliedAt)
+
+	migrations, err = migrator.Up(ctx, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(migrations))
+	assert.True(t, tableExists(t, migrator, "users"))
+	assert.True(t, tableExists(t, migrator, "posts"))
+
+	pending, err := migrator.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(pending))
+
+	_, err = migrator.Diff(ctx, t.TempDir(), "nothing")
+	assert.ErrorIs(t, err, entrepository.ErrNoSchemaChanges)
+
+	_, err = migrator.Diff(ctx, t.TempDir(), "bad-name")
+	assert.EqualError(t, err, "invalid migration name bad-name, only letters, digits and underscores are allowed")
+
+	migrations, err = migrator.Down(ctx, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(migrations))
+	assert.False(t, tableExists(t, migrator, "users"))
+
+	pending, err = migrator.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(pending))
+
+	migrations, err = migrator.Up(ctx, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(migrations))
+	assert.True(t, tableExists(t, migrator, "users"))
+}
+
+func TestMigratorAdoptsAutoMigratedSchema(t *testing.T) {
+	ctx := context.Background()
+	migrator := newTestMigrator(t, "migrator_adopt_test")
+	assert.NoError(t, migrator.Client.Schema.Create(ctx))
+
+	migrations, err := migrator.Up(ctx, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(migrations))
+	assert.NotNil(t, migrations[0].AppliedAt)
+
+	pending, err := migrator.Pending(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(pending))
+}
+
+func TestMigratorUpgradesBaselineSchema(t *testing.T) {
+	ctx := context.Background()
+	migrator := newTestMigrator(t, "migrator_baseline_test")
+	baseline, err := os.ReadFile("testdata/baseline.sqlite3.sql")
+	assert.NoError(t, err)
+
+	for _, statement := range strings.Split(string(baseline), ";\n") {
+		if statement = strings.TrimSpace(statement); statement != "" && !strings.HasPrefix(statement, "--") {
+			_, err := migrator.DB.Exec(statement)
+			assert.NoError(t, err)
+		}
+	}
+
+	_, err = migrator.DB.Exec("INSERT INTO users (created_at, updated_at, username, active) VALUES (?, ?, 'admin', true)", time.Now(), time.Now())
+	assert.NoError(t, err)
+	assert.False(t, tableExists(t, migrator, "passkeys"))
+
+	migrations, err := migrator.Up(ctx, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(migrations))
+
+	for _, table := range []string{"passkeys", "invites", "audit_logs", "menus", "menu_items", "custom_fields", "custom_field_values"} {
+		assert.True(t, tableExists(t, migrator, table), table)
+	}
+
+	username := ""
+	assert.NoError(t, migrator.DB.QueryRow("SELECT username FROM users WHERE id = 1").Scan(&username))
+	assert.Equal(t, "admin", username)
+
+	for _, query := range []string{
+		"SELECT hash, width, variants, original_name FROM files",
+		"SELECT storage_quota, upload_mime_groups FROM roles",
+		"SELECT parent_id, sort_order, template FROM pages",
+		"SELECT topic_ids FROM permissions",
+	} {
+		_, err := migrator.DB.Exec(query)
+		assert.NoError(t, err, query)
+	}
+
+	_, err = migrator.Diff(ctx, t.TempDir(), "nothing")
+	assert.ErrorIs(t, err, entrepository.ErrNoSchemaChanges)
+}
+
+func TestMigratorFiles(t *testing.T) {
+	ctx := context.Background()
+	migrator := newTestMigrator(t, "migrator_files_test")
+	migrator.Files = fstest.MapFS{
+		"sqlite3/1_create_notes.up.sql": &fstest.MapFile{Data: []byte(
+			"-- create the notes\nCREATE TABLE notes (\n  id integer PRIMARY KEY,\n  body text\n);\n\nINSERT INTO notes (body) VALUES ('a;b');\n",
+		)},
+		"sqlite3/1_create_notes.down.sql": &fstest.MapFile{Data: []byte("DROP TABLE notes;\n")},
+		"sqlite3/2_create_tags.up.sql":    &fstest.MapFile{Data: []byte("CREATE TABLE tags (id integer PRIMARY KEY);\n")},
+		"sqlite3/2_create_tags.down.sql":  &fstest.MapFile{Data: []byte("-- irreversible: DROP TABLE tags\n")},
+		"sqlite3/notes.txt":               &fstest.MapFile{Data: []byte("not a migration")},
+	}
+
+	migrations, err := migrator.Up(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(migrations))
+	assert.Equal(t, "1_create_notes", migrations[0].String())
+
+	body := ""
+	assert.NoError(t, migrator.DB.QueryRow("SELECT body FROM notes").Scan(&body))
+	assert.Equal(t, "a;b", body)
+	assert.False(t, tableExists(t, migrator, "tags"))
+
+	migrations, err = migrator.Up(ctx, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(migrations))
+	assert.True(t, tableExists(t, migrator, "tags"))
+
+	_, err = migrator.Down(ctx, 1)
+	assert.EqualError(t, err, "migration 2_create_tags can't be rolled back, it has no down statements")
+
+	delete(migrator.Files.(fstest.MapFS), "sqlite3/2_create_tags.up.sql")
+	delete(migrator.Files.(fstest.MapFS), "sqlite3/2_create_tags.down.sql")
+	migrations, err = migrator.Status(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(migrations))
+	assert.Equal(t, "", migrations[1].Name)
+	assert.NotNil(t, migrations[1].AppliedAt)
+
+	_, err = migrator.Down(ctx, 1)
+	assert.EqualError(t, err, "the files of the applied migration 2 are not found")
+}
+
+func TestMigratorLock(t *testing.T) {
+	ctx := context.Background()
+	migrator := newTestMigrator(t, "migrator_lock_test")
+	migrator.LockTimeout = 50 * time.Millisecond
+
+	_, err := migrator.DB.Exec("CREATE TABLE schema_migrations_lock (id integer NOT NULL PRIMARY KEY, locked_at timestamp NOT NULL)")
+	assert.NoError(t, err)
+	_, err = migrator.DB.Exec("INSERT INTO schema_migrations_lock (id, locked_at) VALUES (1, ?)", time.Now().UTC())
+	assert.NoError(t, err)
+
+	_, err = migrator.Up(ctx, 0)
+	assert.ErrorIs(t, err, entrepository.ErrMigrationsLocked)
+	assert.False(t, tableExists(t, migrator, "users"))
+
+	// the lock of a killed run expires
+	_, err = migrator.DB.Exec("UPDATE schema_migrations_lock SET locked_at = ?", time.Now().UTC().Add(-migrator.LockExpiry-time.Second))
+	assert.NoError(t, err)
+
+	_, err = migrator.Up(ctx, 0)
+	assert.NoError(t, err)
+	assert.True(t, tableExists(t, migrator, "users"))
+
+	count := 0
+	assert.NoError(t, migrator.DB.QueryRow("SELECT COUNT(*) FROM schema_migrations_lock").Scan(&count))
+	assert.Equal(t, 0, count)
+}
+
+func TestMigratorDiff(t *testing.T) {
+	ctx := context.Background()
+	migrator := newTestMigrator(t, "migrator_diff_test")
+
+	_, err := migrator.Diff(ctx, t.TempDir(), "pending")
+	assert.EqualError(t, err, "1 migrations are pending, apply them before generating a new migration")
+
+	_, err = migrator.Up(ctx, 0)
+	assert.NoError(t, err)
+	_, err = migrator.DB.Exec("DROP INDEX post_name_idx")
+	assert.NoError(t, err)
+
+	dir := t.TempDir()
+	files, err := migrator.Diff(ctx, dir, "add_post_name_index")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(files))
+
+	up, err := os.ReadFile(path.Join(dir, "sqlite3", path.Base(files[0])))
+	assert.NoError(t, err)
+	assert.Contains(t, string(up), "post_name_idx")
+}
@@ -0,0 +1,47 @@
+CREATE TABLE IF NOT EXISTS `audit_logs`(`id` bigint AUTO_INCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `action` varchar(255) NOT NULL, `target_type` varchar(64) NOT NULL, `target_id` bigint NULL, `before` longtext NULL, `after` longtext NULL, `ip` varchar(64) NULL, `request_id` varchar(64) NULL, `user_id` bigint NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX `auditlog_action` ON `audit_logs`(`action`);
+CREATE INDEX `auditlog_target_type_target_id` ON `audit_logs`(`target_type`, `target_id`);
+CREATE INDEX `auditlog_created_at` ON `audit_logs`(`created_at`);
+CREATE TABLE IF NOT EXISTS `comments`(`id` bigint AUTO_INCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `content` longtext NOT NULL, `content_html` longtext NOT NULL, `votes` bigint NOT NULL DEFAULT 0, `parent_id` bigint NULL, `post_id` bigint NULL, `user_id` bigint NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE TABLE IF NOT EXISTS `custom_fields`(`id` bigint AUTO_INCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(64) NOT NULL, `label` varchar(255) NOT NULL, `description` varchar(255) NULL, `type` varchar(32) NOT NULL, `target` varchar(32) NOT NULL, `required` boolean NOT NULL DEFAULT false, `max_length` bigint NOT NULL DEFAULT 0, `pattern` varchar(255) NULL, `options` json NULL, `sort_order` bigint NOT NULL DEFAULT 0, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE UNIQUE INDEX `custom_field_target_name_unique` ON `custom_fields`(`target`, `name`);
+CREATE TABLE IF NOT EXISTS `custom_field_values`(`id` bigint AUTO_INCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `target_type` varchar(32) NOT NULL, `target_id` bigint NOT NULL, `name` varchar(64) NOT NULL, `value` longtext NOT NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE UNIQUE INDEX `custom_field_value_target_name_unique` ON `custom_field_values`(`target_type`, `target_id`, `name`);
+CREATE INDEX `target_type_name_idx` ON `custom_field_values`(`target_type`, `name`);
+CREATE TABLE IF NOT EXISTS `files`(`id` bigint AUTO_INCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `disk` varchar(255) NOT NULL, `path` varchar(500) NOT NULL, `type` varchar(255) NOT NULL, `size` bigint NOT NULL, `hash` varchar(255) NULL, `width` bigint NOT NULL DEFAULT 0, `height` bigint NOT NULL DEFAULT 0, `variants` json NULL, `original_name` varchar(255) NULL, `title` varchar(255) NULL, `alt` varchar(255) NULL, `caption` longtext NULL, `tags` json NULL, `user_id` bigint NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX `path_idx` ON `files`(`path`);
+CREATE INDEX `disk_hash_idx` ON `files`(`disk`, `hash`);
+CREATE TABLE IF NOT EXISTS `invites`(`id` bigint AUTO_INCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `code` varchar(64) UNIQUE NOT NULL, `max_uses` bigint NOT NULL DEFAULT 1, `used` bigint NOT NULL DEFAULT 0, `expires_at` datetime NULL, `role_id` bigint NULL, `user_id` bigint NOT NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE TABLE IF NOT EXISTS `menus`(`id` bigint AUTO_INCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(255) NOT NULL, `location` varchar(64) UNIQUE NOT NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE TABLE IF NOT EXISTS `menu_items`(`id` bigint AUTO_INCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `parent_id` bigint NULL, `label` varchar(255) NOT NULL, `type` varchar(32) NOT NULL, `target_id` bigint NULL, `url` varchar(1024) NULL, `sort_order` bigint NOT NULL DEFAULT 0, `menu_id` bigint NOT NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE TABLE IF NOT EXISTS `pages`(`id` bigint AUTO_INCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(255) NOT NULL, `slug` varchar(255) NOT NULL, `content` longtext NOT NULL, `content_html` longtext NOT NULL, `draft` boolean NULL DEFAULT false, `sort_order` bigint NOT NULL DEFAULT 0, `template` varchar(64) NULL, `featured_image_id` bigint NULL, `parent_id` bigint NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX `page_name_idx` ON `pages`(`name`);
+CREATE UNIQUE INDEX `slug_unique` ON `pages`(`slug`);
+CREATE TABLE IF NOT EXISTS `passkeys`(`id` bigint AUTO_INCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(255) NOT NULL, `credential_id` varchar(255) UNIQUE NOT NULL, `public_key` blob NOT NULL, `attestation_type` varchar(255) NULL, `aaguid` blob NULL, `sign_count` int unsigned NOT NULL DEFAULT 0, `transports` varchar(255) NULL, `last_used_at` datetime NULL, `user_id` bigint NOT NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX `passkey_user_idx` ON `passkeys`(`user_id`);
+CREATE TABLE IF NOT EXISTS `permissions`(`id` bigint AUTO_INCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `action` varchar(255) NOT NULL, `value` varchar(255) NOT NULL, `topic_ids` json NULL, `role_id` bigint NOT NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE UNIQUE INDEX `role_action_unique_idx` ON `permissions`(`role_id`, `action`);
+CREATE TABLE IF NOT EXISTS `posts`(`id` bigint AUTO_INCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(255) NOT NULL, `slug` varchar(255) NOT NULL, `description` varchar(255) NULL, `content` longtext NOT NULL, `content_html` longtext NOT NULL, `view_count` bigint NOT NULL DEFAULT 0, `comment_count` bigint NOT NULL DEFAULT 0, `rating_count` bigint NULL DEFAULT 0, `rating_total` bigint NULL DEFAULT 0, `draft` boolean NULL DEFAULT false, `approved` boolean NULL DEFAULT false, `featured_image_id` bigint NULL, `user_id` bigint NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE INDEX `post_name_idx` ON `posts`(`name`);
+CREATE INDEX `view_count_idx` ON `posts`(`view_count`);
+CREATE TABLE IF NOT EXISTS `roles`(`id` bigint AUTO_INCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(255) UNIQUE NOT NULL, `description` varchar(255) NULL, `root` boolean NULL, `max_upload_size` bigint NOT NULL DEFAULT 0, `upload_mime_groups` json NULL, `storage_quota` bigint NOT NULL DEFAULT 0, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE TABLE IF NOT EXISTS `settings`(`id` bigint AUTO_INCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(255) NOT NULL, `value` longtext NULL, `type` varchar(255) NULL DEFAULT 'input', PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE UNIQUE INDEX `setting_name` ON `settings`(`name`);
+CREATE TABLE IF NOT EXISTS `topics`(`id` bigint AUTO_INCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(255) UNIQUE NOT NULL, `slug` varchar(255) UNIQUE NOT NULL, `description` varchar(255) NULL, `content` longtext NOT NULL, `content_html` longtext NOT NULL, `parent_id` bigint NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE TABLE IF NOT EXISTS `users`(`id` bigint AUTO_INCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `username` varchar(255) UNIQUE NOT NULL, `display_name` varchar(255) NULL, `url` varchar(255) NULL, `provider` varchar(255) NULL, `provider_id` varchar(255) NULL, `provider_username` varchar(255) NULL, `provider_avatar` varchar(255) NULL, `email` varchar(255) NULL, `password` varchar(255) NULL, `bio` longtext NULL, `bio_html` longtext NULL, `active` boolean NOT NULL DEFAULT true, `avatar_image_id` bigint NULL, `invite_id` bigint NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE UNIQUE INDEX `provider_provider_id_unique` ON `users`(`provider`, `provider_id`);
+CREATE TABLE IF NOT EXISTS `role_users`(`role_id` bigint NOT NULL, `user_id` bigint NOT NULL, PRIMARY KEY(`role_id`, `user_id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+CREATE TABLE IF NOT EXISTS `topic_posts`(`topic_id` bigint NOT NULL, `post_id` bigint NOT NULL, PRIMARY KEY(`topic_id`, `post_id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;
+ALTER TABLE `audit_logs` ADD CONSTRAINT `audit_log_user` FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE SET NULL;
+ALTER TABLE `comments` ADD CONSTRAINT `comment_parent` FOREIGN KEY(`parent_id`) REFERENCES `comments`(`id`) ON DELETE SET NULL, ADD CONSTRAINT `comment_post` FOREIGN KEY(`post_id`) REFERENCES `posts`(`id`) ON DELETE SET NULL, ADD CONSTRAINT `comment_user` FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE SET NULL;
+ALTER TABLE `files` ADD CONSTRAINT `file_user` FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE SET NULL;
+ALTER TABLE `invites` ADD CONSTRAINT `invite_role` FOREIGN KEY(`role_id`) REFERENCES `roles`(`id`) ON DELETE SET NULL, ADD CONSTRAINT `invite_user` FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE CASCADE;
+ALTER TABLE `menu_items` ADD CONSTRAINT `menu_item_menu` FOREIGN KEY(`menu_id`) REFERENCES `menus`(`id`) ON DELETE CASCADE;
+ALTER TABLE `pages` ADD CONSTRAINT `page_featured_image` FOREIGN KEY(`featured_image_id`) REFERENCES `files`(`id`) ON DELETE SET NULL, ADD CONSTRAINT `page_parent` FOREIGN KEY(`parent_id`) REFERENCES `pages`(`id`) ON DELETE SET NULL;
+ALTER TABLE `passkeys` ADD CONSTRAINT `passkey_user` FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE CASCADE;
+ALTER TABLE `permissions` ADD CONSTRAINT `permission_role` FOREIGN KEY(`role_id`) REFERENCES `roles`(`id`) ON DELETE CASCADE;
+ALTER TABLE `posts` ADD CONSTRAINT `post_featured_image` FOREIGN KEY(`featured_image_id`) REFERENCES `files`(`id`) ON DELETE SET NULL, ADD CONSTRAINT `post_user` FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE SET NULL;
+ALTER TABLE `topics` ADD CONSTRAINT `topic_parent` FOREIGN KEY(`parent_id`) REFERENCES `topics`(`id`) ON DELETE SET NULL;
+ALTER TABLE `users` ADD CONSTRAINT `user_avatar_image` FOREIGN KEY(`avatar_image_id`) REFERENCES `files`(`id`) ON DELETE SET NULL, ADD CONSTRAINT `user_invite` FOREIGN KEY(`invite_id`) REFERENCES `invites`(`id`) ON DELETE SET NULL;
+ALTER TABLE `role_users` ADD CONSTRAINT `role_users_role_id` FOREIGN KEY(`role_id`) REFERENCES `roles`(`id`) ON DELETE CASCADE, ADD CONSTRAINT `role_users_user_id` FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE CASCADE;
+ALTER TABLE `topic_posts` ADD CONSTRAINT `topic_posts_topic_id` FOREIGN KEY(`topic_id`) REFERENCES `topics`(`id`) ON DELETE CASCADE, ADD CONSTRAINT `topic_posts_post_id` FOREIGN KEY(`post_id`) REFERENCES `posts`(`id`) ON DELETE CASCADE;
@@ -0,0 +1,18 @@
+DROP TABLE IF EXISTS "topic_posts" CASCADE;
+DROP TABLE IF EXISTS "role_users" CASCADE;
+DROP TABLE IF EXISTS "users" CASCADE;
+DROP TABLE IF EXISTS "topics" CASCADE;
+DROP TABLE IF EXISTS "settings" CASCADE;
+DROP TABLE IF EXISTS "roles" CASCADE;
+DROP TABLE IF EXISTS "posts" CASCADE;
+DROP TABLE IF EXISTS "permissions" CASCADE;
+DROP TABLE IF EXISTS "passkeys" CASCADE;
+DROP TABLE IF EXISTS "pages" CASCADE;
+DROP TABLE IF EXISTS "menu_items" CASCADE;
+DROP TABLE IF EXISTS "menus" CASCADE;
+DROP TABLE IF EXISTS "invites" CASCADE;
+DROP TABLE IF EXISTS "files" CASCADE;
+DROP TABLE IF EXISTS "custom_field_values" CASCADE;
+DROP TABLE IF EXISTS "custom_fields" CASCADE;
+DROP TABLE IF EXISTS "comments" CASCADE;
+DROP TABLE IF EXISTS "audit_logs" CASCADE;
@@ -0,0 +1,47 @@
+CREATE TABLE IF NOT EXISTS "audit_logs"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "created_at" timestamp with time zone NOT NULL, "updated_at" timestamp with time zone NOT NULL, "deleted_at" timestamp with time zone NULL, "action" varchar NOT NULL, "target_type" varchar NOT NULL, "target_id" bigint NULL, "before" text NULL, "after" text NULL, "ip" varchar NULL, "request_id" varchar NULL, "user_id" bigint NULL, PRIMARY KEY("id"));
+CREATE INDEX IF NOT EXISTS "auditlog_action" ON "audit_logs"("action");
+CREATE INDEX IF NOT EXISTS "auditlog_target_type_target_id" ON "audit_logs"("target_type", "target_id");
+CREATE INDEX IF NOT EXISTS "auditlog_created_at" ON "audit_logs"("created_at");
+CREATE TABLE IF NOT EXISTS "comments"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "created_at" timestamp with time zone NOT NULL, "updated_at" timestamp with time zone NOT NULL, "deleted_at" timestamp with time zone NULL, "content" text NOT NULL, "content_html" text NOT NULL, "votes" bigint NOT NULL DEFAULT 0, "parent_id" bigint NULL, "post_id" bigint NULL, "user_id" bigint NULL, PRIMARY KEY("id"));
+CREATE TABLE IF NOT EXISTS "custom_fields"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "created_at" timestamp with time zone NOT NULL, "updated_at" timestamp with time zone NOT NULL, "deleted_at" timestamp with time zone NULL, "name" varchar NOT NULL, "label" varchar NOT NULL, "description" varchar NULL, "type" varchar NOT NULL, "target" varchar NOT NULL, "required" boolean NOT NULL DEFAULT false, "max_length" bigint NOT NULL DEFAULT 0, "pattern" varchar NULL, "options" jsonb NULL, "sort_order" bigint NOT NULL DEFAULT 0, PRIMARY KEY("id"));
+CREATE UNIQUE INDEX IF NOT EXISTS "custom_field_target_name_unique" ON "custom_fields"("target", "name");
+CREATE TABLE IF NOT EXISTS "custom_field_values"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "created_at" timestamp with time zone NOT NULL, "updated_at" timestamp with time zone NOT NULL, "deleted_at" timestamp with time zone NULL, "target_type" varchar NOT NULL, "target_id" bigint NOT NULL, "name" varchar NOT NULL, "value" text NOT NULL, PRIMARY KEY("id"));
+CREATE UNIQUE INDEX IF NOT EXISTS "custom_field_value_target_name_unique" ON "custom_field_values"("target_type", "target_id", "name");
+CREATE INDEX IF NOT EXISTS "target_type_name_idx" ON "custom_field_values"("target_type", "name");
+CREATE TABLE IF NOT EXISTS "files"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "created_at" timestamp with time zone NOT NULL, "updated_at" timestamp with time zone NOT NULL, "deleted_at" timestamp with time zone NULL, "disk" varchar NOT NULL, "path" varchar NOT NULL, "type" varchar NOT NULL, "size" bigint NOT NULL, "hash" varchar NULL, "width" bigint NOT NULL DEFAULT 0, "height" bigint NOT NULL DEFAULT 0, "variants" jsonb NULL, "original_name" varchar NULL, "title" varchar NULL, "alt" varchar NULL, "caption" text NULL, "tags" jsonb NULL, "user_id" bigint NULL, PRIMARY KEY("id"));
+CREATE INDEX IF NOT EXISTS "path_idx" ON "files"("path");
+CREATE INDEX IF NOT EXISTS "disk_hash_idx" ON "files"("disk", "hash");
+CREATE TABLE IF NOT EXISTS "invites"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "created_at" timestamp with time zone NOT NULL, "updated_at" timestamp with time zone NOT NULL, "deleted_at" timestamp with time zone NULL, "code" varchar UNIQUE NOT NULL, "max_uses" bigint NOT NULL DEFAULT 1, "used" bigint NOT NULL DEFAULT 0, "expires_at" timestamp with time zone NULL, "role_id" bigint NULL, "user_id" bigint NOT NULL, PRIMARY KEY("id"));
+CREATE TABLE IF NOT EXISTS "menus"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "created_at" timestamp with time zone NOT NULL, "updated_at" timestamp with time zone NOT NULL, "deleted_at" timestamp with time zone NULL, "name" varchar NOT NULL, "location" varchar UNIQUE NOT NULL, PRIMARY KEY("id"));
+CREATE TABLE IF NOT EXISTS "menu_items"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "created_at" timestamp with time zone NOT NULL, "updated_at" timestamp with time zone NOT NULL, "deleted_at" timestamp with time zone NULL, "parent_id" bigint NULL, "label" varchar NOT NULL, "type" varchar NOT NULL, "target_id" bigint NULL, "url" varchar NULL, "sort_order" bigint NOT NULL DEFAULT 0, "menu_id" bigint NOT NULL, PRIMARY KEY("id"));
+CREATE TABLE IF NOT EXISTS "pages"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "created_at" timestamp with time zone NOT NULL, "updated_at" timestamp with time zone NOT NULL, "deleted_at" timestamp with time zone NULL, "name" varchar NOT NULL, "slug" varchar NOT NULL, "content" text NOT NULL, "content_html" text NOT NULL, "draft" boolean NULL DEFAULT false, "sort_order" bigint NOT NULL DEFAULT 0, "template" varchar NULL, "featured_image_id" bigint NULL, "parent_id" bigint NULL, PRIMARY KEY("id"));
+CREATE INDEX IF NOT EXISTS "page_name_idx" ON "pages"("name");
+CREATE UNIQUE INDEX IF NOT EXISTS "slug_unique" ON "pages"("slug");
+CREATE TABLE IF NOT EXISTS "passkeys"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "created_at" timestamp with time zone NOT NULL, "updated_at" timestamp with time zone NOT NULL, "deleted_at" timestamp with time zone NULL, "name" varchar NOT NULL, "credential_id" varchar UNIQUE NOT NULL, "public_key" bytea NOT NULL, "attestation_type" varchar NULL, "aaguid" bytea NULL, "sign_count" int NOT NULL DEFAULT 0, "transports" varchar NULL, "last_used_at" timestamp with time zone NULL, "user_id" bigint NOT NULL, PRIMARY KEY("id"));
+CREATE INDEX IF NOT EXISTS "passkey_user_idx" ON "passkeys"("user_id");
+CREATE TABLE IF NOT EXISTS "permissions"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "created_at" timestamp with time zone NOT NULL, "updated_at" timestamp with time zone NOT NULL, "deleted_at" timestamp with time zone NULL, "action" varchar NOT NULL, "value" varchar NOT NULL, "topic_ids" jsonb NULL, "role_id" bigint NOT NULL, PRIMARY KEY("id"));
+CREATE UNIQUE INDEX IF NOT EXISTS "role_action_unique_idx" ON "permissions"("role_id", "action");
+CREATE TABLE IF NOT EXISTS "posts"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "created_at" timestamp with time zone NOT NULL, "updated_at" timestamp with time zone NOT NULL, "deleted_at" timestamp with time zone NULL, "name" varchar NOT NULL, "slug" varchar NOT NULL, "description" varchar NULL, "content" text NOT NULL, "content_html" text NOT NULL, "view_count" bigint NOT NULL DEFAULT 0, "comment_count" bigint NOT NULL DEFAULT 0, "rating_count" bigint NULL DEFAULT 0, "rating_total" bigint NULL DEFAULT 0, "draft" boolean NULL DEFAULT false, "approved" boolean NULL DEFAULT false, "featured_image_id" bigint NULL, "user_id" bigint NULL, PRIMARY KEY("id"));
+CREATE INDEX IF NOT EXISTS "post_name_idx" ON "posts"("name");
+CREATE INDEX IF NOT EXISTS "view_count_idx" ON "posts"("view_count");
+CREATE TABLE IF NOT EXISTS "roles"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "created_at" timestamp with time zone NOT NULL, "updated_at" timestamp with time zone NOT NULL, "deleted_at" timestamp with time zone NULL, "name" varchar UNIQUE NOT NULL, "description" varchar NULL, "root" boolean NULL, "max_upload_size" bigint NOT NULL DEFAULT 0, "upload_mime_groups" jsonb NULL, "storage_quota" bigint NOT NULL DEFAULT 0, PRIMARY KEY("id"));
+CREATE TABLE IF NOT EXISTS "settings"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "created_at" timestamp with time zone NOT NULL, "updated_at" timestamp with time zone NOT NULL, "deleted_at" timestamp with time zone NULL, "name" varchar NOT NULL, "value" text NULL, "type" varchar NULL DEFAULT 'input', PRIMARY KEY("id"));
+CREATE UNIQUE INDEX IF NOT EXISTS "setting_name" ON "settings"("name");
+CREATE TABLE IF NOT EXISTS "topics"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "created_at" timestamp with time zone NOT NULL, "updated_at" timestamp with time zone NOT NULL, "deleted_at" timestamp with time zone NULL, "name" varchar UNIQUE NOT NULL, "slug" varchar UNIQUE NOT NULL, "description" varchar NULL, "content" text NOT NULL, "content_html" text NOT NULL, "parent_id" bigint NULL, PRIMARY KEY("id"));
+CREATE TABLE IF NOT EXISTS "users"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "created_at" timestamp with time zone NOT NULL, "updated_at" timestamp with time zone NOT NULL, "deleted_at" timestamp with time zone NULL, "username" varchar UNIQUE NOT NULL, "display_name" varchar NULL, "url" varchar NULL, "provider" varchar NULL, "provider_id" varchar NULL, "provider_username" varchar NULL, "provider_avatar" varchar NULL, "email" varchar NULL, "password" varchar NULL, "bio" text NULL, "bio_html" text NULL, "active" boolean NOT NULL DEFAULT true, "avatar_image_id" bigint NULL, "invite_id" bigint NULL, PRIMARY KEY("id"));
+CREATE UNIQUE INDEX IF NOT EXISTS "provider_provider_id_unique" ON "users"("provider", "provider_id");
+CREATE TABLE IF NOT EXISTS "role_users"("role_id" bigint NOT NULL, "user_id" bigint NOT NULL, PRIMARY KEY("role_id", "user_id"));
+CREATE TABLE IF NOT EXISTS "topic_posts"("topic_id" bigint NOT NULL, "post_id" bigint NOT NULL, PRIMARY KEY("topic_id", "post_id"));
+ALTER TABLE "audit_logs" ADD CONSTRAINT "audit_log_user" FOREIGN KEY("user_id") REFERENCES "users"("id") ON DELETE SET NULL;
+ALTER TABLE "comments" ADD CONSTRAINT "comment_parent" FOREIGN KEY("parent_id") REFERENCES "comments"("id") ON DELETE SET NULL, ADD CONSTRAINT "comment_post" FOREIGN KEY("post_id") REFERENCES "posts"("id") ON DELETE SET NULL, ADD CONSTRAINT "comment_user" FOREIGN KEY("user_id") REFERENCES "users"("id") ON DELETE SET NULL;
+ALTER TABLE "files" ADD CONSTRAINT "file_user" FOREIGN KEY("user_id") REFERENCES "users"("id") ON DELETE SET NULL;
+ALTER TABLE "invites" ADD CONSTRAINT "invite_role" FOREIGN KEY("role_id") REFERENCES "roles"("id") ON DELETE SET NULL, ADD CONSTRAINT "invite_user" FOREIGN KEY("user_id") REFERENCES "users"("id") ON DELETE CASCADE;
+ALTER TABLE "menu_items" ADD CONSTRAINT "menu_item_menu" FOREIGN KEY("menu_id") REFERENCES "menus"("id") ON DELETE CASCADE;
+ALTER TABLE "pages" ADD CONSTRAINT "page_featured_image" FOREIGN KEY("featured_image_id") REFERENCES "files"("id") ON DELETE SET NULL, ADD CONSTRAINT "page_parent" FOREIGN KEY("parent_id") REFERENCES "pages"("id") ON DELETE SET NULL;
+ALTER TABLE "passkeys" ADD CONSTRAINT "passkey_user" FOREIGN KEY("user_id") REFERENCES "users"("id") ON DELETE CASCADE;
+ALTER TABLE "permissions" ADD CONSTRAINT "permission_role" FOREIGN KEY("role_id") REFERENCES "roles"("id") ON DELETE CASCADE;
+ALTER TABLE "posts" ADD CONSTRAINT "post_featured_image" FOREIGN KEY("featured_image_id") REFERENCES "files"("id") ON DELETE SET NULL, ADD CONSTRAINT "post_user" FOREIGN KEY("user_id") REFERENCES "users"("id") ON DELETE SET NULL;
+ALTER TABLE "topics" ADD CONSTRAINT "topic_parent" FOREIGN KEY("parent_id") REFERENCES "topics"("id") ON DELETE SET NULL;
+ALTER TABLE "users" ADD CONSTRAINT "user_avatar_image" FOREIGN KEY("avatar_image_id") REFERENCES "files"("id") ON DELETE SET NULL, ADD CONSTRAINT "user_invite" FOREIGN KEY("invite_id") REFERENCES "invites"("id") ON DELETE SET NULL;
+ALTER TABLE "role_users" ADD CONSTRAINT "role_users_role_id" FOREIGN KEY("role_id") REFERENCES "roles"("id") ON DELETE CASCADE, ADD CONSTRAINT "role_users_user_id" FOREIGN KEY("user_id") REFERENCES "users"("id") ON DELETE CASCADE;
+ALTER TABLE "topic_posts" ADD CONSTRAINT "topic_posts_topic_id" FOREIGN KEY("topic_id") REFERENCES "topics"("id") ON DELETE CASCADE, ADD CONSTRAINT "topic_posts_post_id" FOREIGN KEY("post_id") REFERENCES "posts"("id") ON DELETE CASCADE;
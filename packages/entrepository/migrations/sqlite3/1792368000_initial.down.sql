@@ -0,0 +1,18 @@
+DROP TABLE IF EXISTS `topic_posts`;
+DROP TABLE IF EXISTS `role_users`;
+DROP TABLE IF EXISTS `users`;
+DROP TABLE IF EXISTS `topics`;
+DROP TABLE IF EXISTS `settings`;
+DROP TABLE IF EXISTS `roles`;
+DROP TABLE IF EXISTS `posts`;
+DROP TABLE IF EXISTS `permissions`;
+DROP TABLE IF EXISTS `passkeys`;
+DROP TABLE IF EXISTS `pages`;
+DROP TABLE IF EXISTS `menu_items`;
+DROP TABLE IF EXISTS `menus`;
+DROP TABLE IF EXISTS `invites`;
+DROP TABLE IF EXISTS `files`;
+DROP TABLE IF EXISTS `custom_field_values`;
+DROP TABLE IF EXISTS `custom_fields`;
+DROP TABLE IF EXISTS `comments`;
+DROP TABLE IF EXISTS `audit_logs`;
@@ -0,0 +1,34 @@
+CREATE TABLE `audit_logs`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `action` varchar(255) NOT NULL, `target_type` varchar(255) NOT NULL, `target_id` integer NULL, `before` varchar(255) NULL, `after` varchar(255) NULL, `ip` varchar(255) NULL, `request_id` varchar(255) NULL, `user_id` integer NULL, FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE SET NULL);
+CREATE INDEX IF NOT EXISTS `auditlog_action` ON `audit_logs`(`action`);
+CREATE INDEX IF NOT EXISTS `auditlog_target_type_target_id` ON `audit_logs`(`target_type`, `target_id`);
+CREATE INDEX IF NOT EXISTS `auditlog_created_at` ON `audit_logs`(`created_at`);
+CREATE TABLE `comments`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `content` varchar(255) NOT NULL, `content_html` varchar(255) NOT NULL, `votes` integer NOT NULL DEFAULT 0, `parent_id` integer NULL, `post_id` integer NULL, `user_id` integer NULL, FOREIGN KEY(`parent_id`) REFERENCES `comments`(`id`) ON DELETE SET NULL, FOREIGN KEY(`post_id`) REFERENCES `posts`(`id`) ON DELETE SET NULL, FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE SET NULL);
+CREATE TABLE `custom_fields`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(255) NOT NULL, `label` varchar(255) NOT NULL, `description` varchar(255) NULL, `type` varchar(255) NOT NULL, `target` varchar(255) NOT NULL, `required` bool NOT NULL DEFAULT false, `max_length` integer NOT NULL DEFAULT 0, `pattern` varchar(255) NULL, `options` json NULL, `sort_order` integer NOT NULL DEFAULT 0);
+CREATE UNIQUE INDEX IF NOT EXISTS `custom_field_target_name_unique` ON `custom_fields`(`target`, `name`);
+CREATE TABLE `custom_field_values`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `target_type` varchar(255) NOT NULL, `target_id` integer NOT NULL, `name` varchar(255) NOT NULL, `value` varchar(255) NOT NULL);
+CREATE UNIQUE INDEX IF NOT EXISTS `custom_field_value_target_name_unique` ON `custom_field_values`(`target_type`, `target_id`, `name`);
+CREATE INDEX IF NOT EXISTS `target_type_name_idx` ON `custom_field_values`(`target_type`, `name`);
+CREATE TABLE `files`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `disk` varchar(255) NOT NULL, `path` varchar(255) NOT NULL, `type` varchar(255) NOT NULL, `size` integer NOT NULL, `hash` varchar(255) NULL, `width` integer NOT NULL DEFAULT 0, `height` integer NOT NULL DEFAULT 0, `variants` json NULL, `original_name` varchar(255) NULL, `title` varchar(255) NULL, `alt` varchar(255) NULL, `caption` varchar(255) NULL, `tags` json NULL, `user_id` integer NULL, FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE SET NULL);
+CREATE INDEX IF NOT EXISTS `path_idx` ON `files`(`path`);
+CREATE INDEX IF NOT EXISTS `disk_hash_idx` ON `files`(`disk`, `hash`);
+CREATE TABLE `invites`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `code` varchar(255) UNIQUE NOT NULL, `max_uses` integer NOT NULL DEFAULT 1, `used` integer NOT NULL DEFAULT 0, `expires_at` datetime NULL, `role_id` integer NULL, `user_id` integer NOT NULL, FOREIGN KEY(`role_id`) REFERENCES `roles`(`id`) ON DELETE SET NULL, FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE CASCADE);
+CREATE TABLE `menus`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(255) NOT NULL, `location` varchar(255) UNIQUE NOT NULL);
+CREATE TABLE `menu_items`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `parent_id` integer NULL, `label` varchar(255) NOT NULL, `type` varchar(255) NOT NULL, `target_id` integer NULL, `url` varchar(255) NULL, `sort_order` integer NOT NULL DEFAULT 0, `menu_id` integer NOT NULL, FOREIGN KEY(`menu_id`) REFERENCES `menus`(`id`) ON DELETE CASCADE);
+CREATE TABLE `pages`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(255) NOT NULL, `slug` varchar(255) NOT NULL, `content` varchar(255) NOT NULL, `content_html` varchar(255) NOT NULL, `draft` bool NULL DEFAULT false, `sort_order` integer NOT NULL DEFAULT 0, `template` varchar(255) NULL, `featured_image_id` integer NULL, `parent_id` integer NULL, FOREIGN KEY(`featured_image_id`) REFERENCES `files`(`id`) ON DELETE SET NULL, FOREIGN KEY(`parent_id`) REFERENCES `pages`(`id`) ON DELETE SET NULL);
+CREATE INDEX IF NOT EXISTS `page_name_idx` ON `pages`(`name`);
+CREATE UNIQUE INDEX IF NOT EXISTS `slug_unique` ON `pages`(`slug`);
+CREATE TABLE `passkeys`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(255) NOT NULL, `credential_id` varchar(255) UNIQUE NOT NULL, `public_key` blob NOT NULL, `attestation_type` varchar(255) NULL, `aaguid` blob NULL, `sign_count` integer NOT NULL DEFAULT 0, `transports` varchar(255) NULL, `last_used_at` datetime NULL, `user_id` integer NOT NULL, FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE CASCADE);
+CREATE INDEX IF NOT EXISTS `passkey_user_idx` ON `passkeys`(`user_id`);
+CREATE TABLE `permissions`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `action` varchar(255) NOT NULL, `value` varchar(255) NOT NULL, `topic_ids` json NULL, `role_id` integer NOT NULL, FOREIGN KEY(`role_id`) REFERENCES `roles`(`id`) ON DELETE CASCADE);
+CREATE UNIQUE INDEX IF NOT EXISTS `role_action_unique_idx` ON `permissions`(`role_id`, `action`);
+CREATE TABLE `posts`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(255) NOT NULL, `slug` varchar(255) NOT NULL, `description` varchar(255) NULL, `content` varchar(255) NOT NULL, `content_html` varchar(255) NOT NULL, `view_count` integer NOT NULL DEFAULT 0, `comment_count` integer NOT NULL DEFAULT 0, `rating_count` integer NULL DEFAULT 0, `rating_total` integer NULL DEFAULT 0, `draft` bool NULL DEFAULT false, `approved` bool NULL DEFAULT false, `featured_image_id` integer NULL, `user_id` integer NULL, FOREIGN KEY(`featured_image_id`) REFERENCES `files`(`id`) ON DELETE SET NULL, FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE SET NULL);
+CREATE INDEX IF NOT EXISTS `post_name_idx` ON `posts`(`name`);
+CREATE INDEX IF NOT EXISTS `view_count_idx` ON `posts`(`view_count`);
+CREATE TABLE `roles`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(255) UNIQUE NOT NULL, `description` varchar(255) NULL, `root` bool NULL, `max_upload_size` integer NOT NULL DEFAULT 0, `upload_mime_groups` json NULL, `storage_quota` integer NOT NULL DEFAULT 0);
+CREATE TABLE `settings`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(255) NOT NULL, `value` varchar(255) NULL, `type` varchar(255) NULL DEFAULT 'input');
+CREATE UNIQUE INDEX IF NOT EXISTS `setting_name` ON `settings`(`name`);
+CREATE TABLE `topics`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(255) UNIQUE NOT NULL, `slug` varchar(255) UNIQUE NOT NULL, `description` varchar(255) NULL, `content` varchar(255) NOT NULL, `content_html` varchar(255) NOT NULL, `parent_id` integer NULL, FOREIGN KEY(`parent_id`) REFERENCES `topics`(`id`) ON DELETE SET NULL);
+CREATE TABLE `users`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `username` varchar(255) UNIQUE NOT NULL, `display_name` varchar(255) NULL, `url` varchar(255) NULL, `provider` varchar(255) NULL, `provider_id` varchar(255) NULL, `provider_username` varchar(255) NULL, `provider_avatar` varchar(255) NULL, `email` varchar(255) NULL, `password` varchar(255) NULL, `bio` varchar(255) NULL, `bio_html` varchar(255) NULL, `active` bool NOT NULL DEFAULT true, `avatar_image_id` integer NULL, `invite_id` integer NULL, FOREIGN KEY(`avatar_image_id`) REFERENCES `files`(`id`) ON DELETE SET NULL, FOREIGN KEY(`invite_id`) REFERENCES `invites`(`id`) ON DELETE SET NULL);
+CREATE UNIQUE INDEX IF NOT EXISTS `provider_provider_id_unique` ON `users`(`provider`, `provider_id`);
+CREATE TABLE `role_users`(`role_id` integer NOT NULL, `user_id` integer NOT NULL, PRIMARY KEY(`role_id`, `user_id`), FOREIGN KEY(`role_id`) REFERENCES `roles`(`id`) ON DELETE CASCADE, FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE CASCADE);
+CREATE TABLE `topic_posts`(`topic_id` integer NOT NULL, `post_id` integer NOT NULL, PRIMARY KEY(`topic_id`, `post_id`), FOREIGN KEY(`topic_id`) REFERENCES `topics`(`id`) ON DELETE CASCADE, FOREIGN KEY(`post_id`) REFERENCES `posts`(`id`) ON DELETE CASCADE);
@@ -22,29 +22,53 @@ func (p *PageRepository) PublishedPageBySlug(ctx context.Context, slug string) (
 		Where(page.SlugEQ(slug)).
 		Where(page.DraftEQ(false)).
 		WithFeaturedImage().
+		WithParent(withPageParents(entities.PAGE_MAX_DEPTH)).
 		Only(ctx)
 
 	if err != nil {
 		return nil, EntError(err, fmt.Sprintf("page not found with slug: %s", slug))
 	}
 
-	return entPageToPage(page), nil
+	return p.loadOne(ctx, entPageToPage(page))
+}
+
+func pageCustomFields(page *e.Page) (int, *map[string]string) {
+	return page.ID, &page.CustomFields
+}
+
+// withPageParents loads the parents of the pages up to the given depth, so the page urls
+// can be built through the hierarchy
+func withPageParents(depth int) func(*ent.PageQuery) {
+	return func(query *ent.PageQuery) {
+		if depth > 1 {
+			query.WithParent(withPageParents(depth - 1))
+		}
+	}
 }
 
 func CreatePageRepository(client *ent.Client) *PageRepository {
+	loadFn, saveFn := customFieldsHooks(e.CUSTOM_FIELD_TARGET_PAGE, pageCustomFields)
+
 	return &PageRepository{
 		BaseRepository: &BaseRepository[e.Page, ent.Page, *ent.PageQuery, *e.PageFilter]{
 			Name:      "page",
 			Client:    client,
 			ConvertFn: entPageToPage,
+			LoadFn:    loadFn,
+			SaveFn:    saveFn,
 			ByIDFn: func(ctx context.Context, client *ent.Client, id int) (*ent.Page, error) {
 				return client.Page.Query().
 					Where(page.DeletedAtIsNil()).
 					Where(page.IDEQ(id)).
 					WithFeaturedImage().
+					WithParent(withPageParents(entities.PAGE_MAX_DEPTH)).
 					Only(ctx)
 			},
 			DeleteByIDFn: func(ctx context.Context, client *ent.Client, id int) error {
+				if err := deleteCustomFields(ctx, client, e.CUSTOM_FIELD_TARGET_PAGE, id); err != nil {
+					return err
+				}
+
 				return client.Page.DeleteOneID(id).Exec(ctx)
 			},
 			CreateFn: func(ctx context.Context, client *ent.Client, data *e.Page) (*ent.Page, error) {
@@ -53,12 +77,18 @@ func CreatePageRepository(client *ent.Client) *PageRepository {
 					SetSlug(data.Slug).
 					SetContentHTML(data.ContentHTML).
 					SetContent(data.Content).
-					SetDraft(data.Draft)
+					SetDraft(data.Draft).
+					SetSortOrder(data.Order).
+					SetTemplate(data.Template)
 
 				if data.FeaturedImageID != 0 {
 					cq.SetFeaturedImageID(data.FeaturedImageID)
 				}
 
+				if data.ParentID != 0 {
+					cq.SetParentID(data.ParentID)
+				}
+
 				return cq.Save(ctx)
 			},
 			UpdateFn: func(ctx context.Context, client *ent.Client, data *e.Page) (*ent.Page, error) {
@@ -70,12 +100,20 @@ func CreatePageRepository(client *ent.Client) *PageRepository {
 					SetSlug(data.Slug).
 					SetContentHTML(data.ContentHTML).
 					SetContent(data.Content).
-					SetDraft(data.Draft)
+					SetDraft(data.Draft).
+					SetSortOrder(data.Order).
+					SetTemplate(data.Template)
 
 				if data.FeaturedImageID != 0 {
 					uq.SetFeaturedImageID(data.FeaturedImageID)
 				}
 
+				if data.ParentID != 0 {
+					uq.SetParentID(data.ParentID)
+				} else {
+					uq.ClearParent()
+				}
+
 				return uq.Save(ctx)
 			},
 			QueryFilterFn: func(client *ent.Client, filters ...*e.PageFilter) *ent.PageQuery {
@@ -90,6 +128,10 @@ func CreatePageRepository(client *ent.Client) *PageRepository {
 					if len(filters[0].ExcludeIDs) > 0 {
 						query = query.Where(page.IDNotIn(filters[0].ExcludeIDs...))
 					}
+
+					if len(filters[0].ParentIDs) > 0 {
+						query = query.Where(page.ParentIDIn(filters[0].ParentIDs...))
+					}
 				}
 
 				if publish != "all" {
@@ -108,6 +150,7 @@ func CreatePageRepository(client *ent.Client) *PageRepository {
 				page, limit, sorts := getPaginateParams(filters[0])
 				return query.
 					WithFeaturedImage().
+					WithParent(withPageParents(entities.PAGE_MAX_DEPTH)).
 					Limit(limit).
 					Offset((page - 1) * limit).
 					Order(sorts...).
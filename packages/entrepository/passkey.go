@@ -0,0 +1,106 @@
+package entrepository
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	e "github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/passkey"
+)
+
+type PasskeyRepository struct {
+	*BaseRepository[e.Passkey, ent.Passkey, *ent.PasskeyQuery, *e.PasskeyFilter]
+}
+
+func (p *PasskeyRepository) ByCredentialID(ctx context.Context, credentialID []byte) (*e.Passkey, error) {
+	encodedID := base64.RawURLEncoding.EncodeToString(credentialID)
+	pk, err := p.Client.Passkey.
+		Query().
+		Where(passkey.CredentialIDEQ(encodedID)).
+		Only(ctx)
+
+	if err != nil {
+		return nil, EntError(err, fmt.Sprintf("passkey not found with credential id: %s", encodedID))
+	}
+
+	return entPasskeyToPasskey(pk), nil
+}
+
+func (p *PasskeyRepository) ByUserID(ctx context.Context, userID int) ([]*e.Passkey, error) {
+	passkeys, err := p.Client.Passkey.
+		Query().
+		Where(passkey.UserIDEQ(userID)).
+		Order(ent.Asc(passkey.FieldID)).
+		All(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return entPasskeysToPasskeys(passkeys), nil
+}
+
+func CreatePasskeyRepository(client *ent.Client) *PasskeyRepository {
+	return &PasskeyRepository{
+		BaseRepository: &BaseRepository[e.Passkey, ent.Passkey, *ent.PasskeyQuery, *e.PasskeyFilter]{
+			Name:      "passkey",
+			Client:    client,
+			ConvertFn: entPasskeyToPasskey,
+			ByIDFn: func(ctx context.Context, client *ent.Client, id int) (*ent.Passkey, error) {
+				return client.Passkey.Query().Where(passkey.IDEQ(id)).Only(ctx)
+			},
+			DeleteByIDFn: func(ctx context.Context, client *ent.Client, id int) error {
+				return client.Passkey.DeleteOneID(id).Exec(ctx)
+			},
+			CreateFn: func(ctx context.Context, client *ent.Client, data *e.Passkey) (*ent.Passkey, error) {
+				return client.Passkey.Create().
+					SetUserID(data.UserID).
+					SetName(data.Name).
+					SetCredentialID(base64.RawURLEncoding.EncodeToString(data.CredentialID)).
+					SetPublicKey(data.PublicKey).
+					SetAttestationType(data.AttestationType).
+					SetAaguid(data.AAGUID).
+					SetSignCount(data.SignCount).
+					SetTransports(strings.Join(data.Transports, ",")).
+					SetNillableLastUsedAt(data.LastUsedAt).
+					Save(ctx)
+			},
+			UpdateFn: func(ctx context.Context, client *ent.Client, data *e.Passkey) (*ent.Passkey, error) {
+				return client.Passkey.UpdateOneID(data.ID).
+					SetName(data.Name).
+					SetSignCount(data.SignCount).
+					SetNillableLastUsedAt(data.LastUsedAt).
+					Save(ctx)
+			},
+			QueryFilterFn: func(client *ent.Client, filters ...*e.PasskeyFilter) *ent.PasskeyQuery {
+				query := client.Passkey.Query()
+				if len(filters) > 0 {
+					if len(filters[0].UserIDs) > 0 {
+						query = query.Where(passkey.UserIDIn(filters[0].UserIDs...))
+					}
+
+					if len(filters[0].ExcludeIDs) > 0 {
+						query = query.Where(passkey.IDNotIn(filters[0].ExcludeIDs...))
+					}
+
+					if filters[0].Search != "" {
+						query = query.Where(passkey.NameContainsFold(filters[0].Search))
+					}
+				}
+
+				return query
+			},
+			FindFn: func(ctx context.Context, query *ent.PasskeyQuery, filters ...*e.PasskeyFilter) ([]*ent.Passkey, error) {
+				page, limit, sorts := getPaginateParams(filters[0])
+				return query.
+					WithUser().
+					Limit(limit).
+					Offset((page - 1) * limit).
+					Order(sorts...).All(ctx)
+			},
+		},
+	}
+}
@@ -46,15 +46,23 @@ func (p *PostRepository) PublishedPostByID(ctx context.Context, id int) (*entiti
 		return nil, EntError(err, fmt.Sprintf("post not found with id: %d", id))
 	}
 
-	return entPostToPost(post), nil
+	return p.loadOne(ctx, entPostToPost(post))
+}
+
+func postCustomFields(post *e.Post) (int, *map[string]string) {
+	return post.ID, &post.CustomFields
 }
 
 func CreatePostRepository(client *ent.Client) *PostRepository {
+	loadFn, saveFn := customFieldsHooks(e.CUSTOM_FIELD_TARGET_POST, postCustomFields)
+
 	return &PostRepository{
 		BaseRepository: &BaseRepository[e.Post, ent.Post, *ent.PostQuery, *e.PostFilter]{
 			Name:      "post",
 			Client:    client,
 			ConvertFn: entPostToPost,
+			LoadFn:    loadFn,
+			SaveFn:    saveFn,
 			ByIDFn: func(ctx context.Context, client *ent.Client, id int) (*ent.Post, error) {
 				return client.Post.Query().
 					Where(post.DeletedAtIsNil()).
@@ -8,7 +8,6 @@ import (
 	"ariga.io/sqlcomment"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
-	_ "github.com/go-sql-driver/mysql"
 	"github.com/ngocphuongnb/tetua/app/config"
 	"github.com/ngocphuongnb/tetua/app/logger"
 	"github.com/ngocphuongnb/tetua/app/repositories"
@@ -16,10 +15,13 @@ import (
 )
 
 var Client *ent.Client
+var Migrations *Migrator
 
 type Config struct {
 	DB_DSN    string
 	DB_DRIVER string
+	// DB_AUTO_MIGRATE applies the pending migrations, the server logs a warning about them when it's disabled
+	DB_AUTO_MIGRATE bool
 }
 
 type Repository struct {
@@ -27,11 +29,13 @@ type Repository struct {
 }
 
 func New(cfg Config) repositories.Repositories {
-	driverName := cfg.DB_DRIVER
-	if driverName == "" {
-		driverName = "mysql"
+	driverName, dsn, err := Driver(cfg.DB_DRIVER, cfg.DB_DSN)
+
+	if err != nil {
+		log.Fatal(err)
 	}
-	db, err := sql.Open(driverName, cfg.DB_DSN)
+
+	db, err := sql.Open(driverName, dsn)
 
 	if err != nil {
 		log.Fatal(err)
@@ -55,19 +59,33 @@ func New(cfg Config) repositories.Repositories {
 		Client = ent.NewClient(ent.Driver(db))
 	}
 
-	if err := Client.Schema.Create(context.Background()); err != nil {
+	Migrations = NewMigrator(db.DB(), driverName, Client)
+
+	if cfg.DB_AUTO_MIGRATE {
+		if _, err := Migrations.Up(context.Background(), 0); err != nil {
+			log.Fatal(err)
+		}
+	} else if pending, err := Migrations.Pending(context.Background()); err != nil {
 		log.Fatal(err)
+	} else if len(pending) > 0 {
+		logger.Warn(fmt.Sprintf("%d database migrations are pending, apply them with: tetua migrate up", len(pending)))
 	}
 
 	return repositories.Repositories{
-		File:       CreateFileRepository(Client),
-		User:       CreateUserRepository(Client),
-		Post:       CreatePostRepository(Client),
-		Page:       CreatePageRepository(Client),
-		Role:       CreateRoleRepository(Client),
-		Topic:      CreateTopicRepository(Client),
-		Comment:    CreateCommentRepository(Client),
-		Setting:    &SettingRepository{&Repository{Client: Client}},
-		Permission: CreatePermissionRepository(Client),
+		File:        CreateFileRepository(Client),
+		User:        CreateUserRepository(Client),
+		Post:        CreatePostRepository(Client),
+		Page:        CreatePageRepository(Client),
+		Role:        CreateRoleRepository(Client),
+		Topic:       CreateTopicRepository(Client),
+		Comment:     CreateCommentRepository(Client),
+		Setting:     &SettingRepository{&Repository{Client: Client}},
+		Permission:  CreatePermissionRepository(Client),
+		Passkey:     CreatePasskeyRepository(Client),
+		Invite:      CreateInviteRepository(Client),
+		AuditLog:    CreateAuditLogRepository(Client),
+		Menu:        CreateMenuRepository(Client),
+		CustomField: CreateCustomFieldRepository(Client),
+		Backup:      CreateBackupRepository(Client, Migrations),
 	}
 }
@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	e "github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/repositories"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/permission"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/role"
 )
 
@@ -29,15 +31,22 @@ func (p *RoleRepository) SetPermissions(ctx context.Context, id int, permissions
 			Create().
 			SetRoleID(id).
 			SetAction(permission.Action).
-			SetValue(string(permission.Value))
+			SetValue(string(permission.Value)).
+			SetTopicIds(permission.TopicIDs)
 		builders = append(builders, us)
 	}
 
-	return p.Client.Permission.
+	if err := p.Client.Permission.
 		CreateBulk(builders...).
-		OnConflict().
+		OnConflictColumns(permission.FieldRoleID, permission.FieldAction).
 		UpdateNewValues().
-		Exec(ctx)
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	repositories.Changed(ctx, "permission")
+
+	return nil
 }
 
 func CreateRoleRepository(client *ent.Client) *RoleRepository {
@@ -57,6 +66,9 @@ func CreateRoleRepository(client *ent.Client) *RoleRepository {
 					SetName(data.Name).
 					SetDescription(data.Description).
 					SetRoot(data.Root).
+					SetMaxUploadSize(data.MaxUploadSize).
+					SetUploadMimeGroups(data.UploadMimeGroups).
+					SetStorageQuota(data.StorageQuota).
 					Save(ctx)
 			},
 			UpdateFn: func(ctx context.Context, client *ent.Client, data *e.Role) (*ent.Role, error) {
@@ -64,6 +76,9 @@ func CreateRoleRepository(client *ent.Client) *RoleRepository {
 					SetName(data.Name).
 					SetDescription(data.Description).
 					SetRoot(data.Root).
+					SetMaxUploadSize(data.MaxUploadSize).
+					SetUploadMimeGroups(data.UploadMimeGroups).
+					SetStorageQuota(data.StorageQuota).
 					Save(ctx)
 			},
 			QueryFilterFn: func(client *ent.Client, filters ...*e.RoleFilter) *ent.RoleQuery {
@@ -7,6 +7,7 @@ import (
 	"github.com/ngocphuongnb/tetua/app/entities"
 	"github.com/ngocphuongnb/tetua/app/logger"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent"
+	"github.com/ngocphuongnb/tetua/packages/entrepository/ent/setting"
 )
 
 type SettingRepository struct {
@@ -47,7 +48,7 @@ func (ur *SettingRepository) Save(ctx context.Context, settings []*entities.Sett
 
 	return ur.Client.Setting.
 		CreateBulk(builders...).
-		OnConflict().
+		OnConflictColumns(setting.FieldName).
 		UpdateNewValues().
 		Exec(ctx)
 }
@@ -0,0 +1,19 @@
+-- the schema that the auto-migration of the releases before the versioned migrations created
+CREATE TABLE `comments`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `content` varchar(255) NOT NULL, `content_html` varchar(255) NOT NULL, `votes` integer NOT NULL DEFAULT 0, `parent_id` integer NULL, `post_id` integer NULL, `user_id` integer NULL, FOREIGN KEY(`parent_id`) REFERENCES `comments`(`id`) ON DELETE SET NULL, FOREIGN KEY(`post_id`) REFERENCES `posts`(`id`) ON DELETE SET NULL, FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE SET NULL);
+CREATE TABLE `files`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `disk` varchar(255) NOT NULL, `path` varchar(255) NOT NULL, `type` varchar(255) NOT NULL, `size` integer NOT NULL, `user_id` integer NULL, FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE SET NULL);
+CREATE INDEX `path_idx` ON `files`(`path`);
+CREATE TABLE `pages`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(255) NOT NULL, `slug` varchar(255) NOT NULL, `content` varchar(255) NOT NULL, `content_html` varchar(255) NOT NULL, `draft` bool NULL DEFAULT false, `featured_image_id` integer NULL, FOREIGN KEY(`featured_image_id`) REFERENCES `files`(`id`) ON DELETE SET NULL);
+CREATE INDEX `name_idx` ON `pages`(`name`);
+CREATE UNIQUE INDEX `slug_unique` ON `pages`(`slug`);
+CREATE TABLE `permissions`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `action` varchar(255) NOT NULL, `value` varchar(255) NOT NULL, `role_id` integer NOT NULL, FOREIGN KEY(`role_id`) REFERENCES `roles`(`id`) ON DELETE CASCADE);
+CREATE UNIQUE INDEX `role_action_unique_idx` ON `permissions`(`role_id`, `action`);
+CREATE TABLE `posts`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(255) NOT NULL, `slug` varchar(255) NOT NULL, `description` varchar(255) NULL, `content` varchar(255) NOT NULL, `content_html` varchar(255) NOT NULL, `view_count` integer NOT NULL DEFAULT 0, `comment_count` integer NOT NULL DEFAULT 0, `rating_count` integer NULL DEFAULT 0, `rating_total` integer NULL DEFAULT 0, `draft` bool NULL DEFAULT false, `approved` bool NULL DEFAULT false, `featured_image_id` integer NULL, `user_id` integer NULL, FOREIGN KEY(`featured_image_id`) REFERENCES `files`(`id`) ON DELETE SET NULL, FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE SET NULL);
+CREATE INDEX `view_count_idx` ON `posts`(`view_count`);
+CREATE TABLE `roles`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(255) UNIQUE NOT NULL, `description` varchar(255) NULL, `root` bool NULL);
+CREATE TABLE `settings`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(255) NOT NULL, `value` varchar(255) NULL, `type` varchar(255) NULL DEFAULT 'input');
+CREATE UNIQUE INDEX `setting_name` ON `settings`(`name`);
+CREATE TABLE `topics`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `name` varchar(255) UNIQUE NOT NULL, `slug` varchar(255) UNIQUE NOT NULL, `description` varchar(255) NULL, `content` varchar(255) NOT NULL, `content_html` varchar(255) NOT NULL, `parent_id` integer NULL, FOREIGN KEY(`parent_id`) REFERENCES `topics`(`id`) ON DELETE SET NULL);
+CREATE TABLE `users`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted_at` datetime NULL, `username` varchar(255) UNIQUE NOT NULL, `display_name` varchar(255) NULL, `url` varchar(255) NULL, `provider` varchar(255) NULL, `provider_id` varchar(255) NULL, `provider_username` varchar(255) NULL, `provider_avatar` varchar(255) NULL, `email` varchar(255) NULL, `password` varchar(255) NULL, `bio` varchar(255) NULL, `bio_html` varchar(255) NULL, `active` bool NOT NULL DEFAULT true, `avatar_image_id` integer NULL, FOREIGN KEY(`avatar_image_id`) REFERENCES `files`(`id`) ON DELETE SET NULL);
+CREATE UNIQUE INDEX `provider_provider_id_unique` ON `users`(`provider`, `provider_id`);
+CREATE TABLE `role_users`(`role_id` integer NOT NULL, `user_id` integer NOT NULL, PRIMARY KEY(`role_id`, `user_id`), FOREIGN KEY(`role_id`) REFERENCES `roles`(`id`) ON DELETE CASCADE, FOREIGN KEY(`user_id`) REFERENCES `users`(`id`) ON DELETE CASCADE);
+CREATE TABLE `topic_posts`(`topic_id` integer NOT NULL, `post_id` integer NOT NULL, PRIMARY KEY(`topic_id`, `post_id`), FOREIGN KEY(`topic_id`) REFERENCES `topics`(`id`) ON DELETE CASCADE, FOREIGN KEY(`post_id`) REFERENCES `posts`(`id`) ON DELETE CASCADE);
@@ -35,7 +35,7 @@ func (u *UserRepository) ByUsername(ctx context.Context, username string) (*enti
 		return nil, EntError(err, fmt.Sprintf("user not found with username: %s", username))
 	}
 
-	return entUserToUser(user), nil
+	return u.loadOne(ctx, entUserToUser(user))
 }
 
 func (u *UserRepository) ByUsernameOrEmail(ctx context.Context, username, email string) ([]*entities.User, error) {
@@ -62,7 +62,7 @@ func (u *UserRepository) ByProvider(ctx context.Context, providerName, providerI
 		Query().
 		Where(
 			user.Provider(providerName),
-			user.Provider(providerId),
+			user.ProviderID(providerId),
 		).
 		WithRoles().
 		WithAvatarImage().
@@ -120,17 +120,29 @@ func (ur *UserRepository) Setting(ctx context.Context, id int, userData *entitie
 		return nil, err
 	}
 
-	return entUserToUser(user), nil
+	return ur.loadOne(ctx, entUserToUser(user))
+}
+
+func userCustomFields(user *e.User) (int, *map[string]string) {
+	return user.ID, &user.CustomFields
 }
 
 func CreateUserRepository(client *ent.Client) *UserRepository {
+	loadFn, saveFn := customFieldsHooks(e.CUSTOM_FIELD_TARGET_USER, userCustomFields)
+
 	return &UserRepository{
 		BaseRepository: &BaseRepository[e.User, ent.User, *ent.UserQuery, *e.UserFilter]{
 			Name:      "user",
 			Client:    client,
 			ConvertFn: entUserToUser,
+			LoadFn:    loadFn,
+			SaveFn:    saveFn,
 			ByIDFn:    userById,
 			DeleteByIDFn: func(ctx context.Context, client *ent.Client, id int) error {
+				if err := deleteCustomFields(ctx, client, e.CUSTOM_FIELD_TARGET_USER, id); err != nil {
+					return err
+				}
+
 				return client.User.DeleteOneID(id).Exec(ctx)
 			},
 			CreateFn: func(ctx context.Context, client *ent.Client, data *e.User) (*ent.User, error) {
@@ -151,6 +163,10 @@ func CreateUserRepository(client *ent.Client) *UserRepository {
 					uc.SetAvatarImageID(data.AvatarImageID)
 				}
 
+				if data.InviteID > 0 {
+					uc.SetInviteID(data.InviteID)
+				}
+
 				if data.Provider == "local" {
 					uc.SetProviderID(fmt.Sprintf("%d", time.Now().UnixMicro()))
 				}
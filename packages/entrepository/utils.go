@@ -1,7 +1,11 @@
 package entrepository
 
 import (
+	"encoding/base64"
+	"strings"
+
 	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/utils"
 	"github.com/ngocphuongnb/tetua/packages/entrepository/ent"
 )
 
@@ -10,15 +14,24 @@ func entFileToFile(file *ent.File) *entities.File {
 		return nil
 	}
 	f := &entities.File{
-		ID:        file.ID,
-		CreatedAt: &file.CreatedAt,
-		UpdatedAt: &file.UpdatedAt,
-		DeletedAt: &file.DeletedAt,
-		Disk:      file.Disk,
-		Path:      file.Path,
-		Type:      file.Type,
-		Size:      file.Size,
-		UserID:    file.UserID,
+		ID:           file.ID,
+		CreatedAt:    &file.CreatedAt,
+		UpdatedAt:    &file.UpdatedAt,
+		DeletedAt:    &file.DeletedAt,
+		Disk:         file.Disk,
+		Path:         file.Path,
+		Type:         file.Type,
+		Size:         file.Size,
+		Hash:         file.Hash,
+		Width:        file.Width,
+		Height:       file.Height,
+		Variants:     file.Variants,
+		UserID:       file.UserID,
+		OriginalName: file.OriginalName,
+		Title:        file.Title,
+		Alt:          file.Alt,
+		Caption:      file.Caption,
+		Tags:         file.Tags,
 	}
 
 	if file.Edges.Posts != nil {
@@ -50,6 +63,7 @@ func entPermissionToPermission(permission *ent.Permission) *entities.Permission
 		RoleID:    permission.RoleID,
 		Action:    permission.Action,
 		Value:     permission.Value,
+		TopicIDs:  permission.TopicIds,
 		CreatedAt: &permission.CreatedAt,
 		UpdatedAt: &permission.UpdatedAt,
 		DeletedAt: &permission.DeletedAt,
@@ -132,6 +146,9 @@ func entPageToPage(post *ent.Page) *entities.Page {
 		ContentHTML:     post.ContentHTML,
 		Draft:           post.Draft,
 		FeaturedImageID: post.FeaturedImageID,
+		ParentID:        post.ParentID,
+		Order:           post.SortOrder,
+		Template:        post.Template,
 		CreatedAt:       &post.CreatedAt,
 		UpdatedAt:       &post.UpdatedAt,
 		DeletedAt:       &post.DeletedAt,
@@ -142,6 +159,14 @@ func entPageToPage(post *ent.Page) *entities.Page {
 		p.FeaturedImage = entFileToFile(post.Edges.FeaturedImage)
 	}
 
+	if post.Edges.Parent != nil {
+		p.Parent = entPageToPage(post.Edges.Parent)
+	}
+
+	if post.Edges.Children != nil {
+		p.Children = entPagesToPages(post.Edges.Children)
+	}
+
 	return p
 }
 
@@ -167,6 +192,10 @@ func entRoleToRole(role *ent.Role) *entities.Role {
 		CreatedAt:   &role.CreatedAt,
 		UpdatedAt:   &role.UpdatedAt,
 		DeletedAt:   &role.DeletedAt,
+
+		MaxUploadSize:    role.MaxUploadSize,
+		UploadMimeGroups: role.UploadMimeGroups,
+		StorageQuota:     role.StorageQuota,
 	}
 
 	if role.Edges.Users != nil {
@@ -246,6 +275,7 @@ func entUserToUser(user *ent.User) *entities.User {
 		Roles:            []*entities.Role{},
 		Active:           user.Active,
 		AvatarImageID:    user.AvatarImageID,
+		InviteID:         user.InviteID,
 		CreatedAt:        &user.CreatedAt,
 		UpdatedAt:        &user.UpdatedAt,
 		DeletedAt:        &user.DeletedAt,
@@ -259,6 +289,10 @@ func entUserToUser(user *ent.User) *entities.User {
 		u.AvatarImage = entFileToFile(user.Edges.AvatarImage)
 	}
 
+	if user.Edges.Invite != nil {
+		u.Invite = entInviteToInvite(user.Edges.Invite)
+	}
+
 	return u
 }
 
@@ -353,3 +387,161 @@ func getSortFNs(sorts []*entities.Sort) []ent.OrderFunc {
 
 	return result
 }
+
+func entPasskeyToPasskey(passkey *ent.Passkey) *entities.Passkey {
+	if passkey == nil {
+		return nil
+	}
+	credentialID, _ := base64.RawURLEncoding.DecodeString(passkey.CredentialID)
+	p := &entities.Passkey{
+		ID:              passkey.ID,
+		CreatedAt:       &passkey.CreatedAt,
+		UpdatedAt:       &passkey.UpdatedAt,
+		DeletedAt:       &passkey.DeletedAt,
+		UserID:          passkey.UserID,
+		Name:            passkey.Name,
+		CredentialID:    credentialID,
+		PublicKey:       passkey.PublicKey,
+		AttestationType: passkey.AttestationType,
+		AAGUID:          passkey.Aaguid,
+		SignCount:       passkey.SignCount,
+		LastUsedAt:      passkey.LastUsedAt,
+	}
+
+	if passkey.Transports != "" {
+		p.Transports = strings.Split(passkey.Transports, ",")
+	}
+
+	if passkey.Edges.User != nil {
+		p.User = entUserToUser(passkey.Edges.User)
+	}
+
+	return p
+}
+
+func entPasskeysToPasskeys(passkeys []*ent.Passkey) []*entities.Passkey {
+	var result []*entities.Passkey
+
+	for _, passkey := range passkeys {
+		result = append(result, entPasskeyToPasskey(passkey))
+	}
+
+	return result
+}
+
+func entInviteToInvite(invite *ent.Invite) *entities.Invite {
+	if invite == nil {
+		return nil
+	}
+	i := &entities.Invite{
+		ID:        invite.ID,
+		CreatedAt: &invite.CreatedAt,
+		UpdatedAt: &invite.UpdatedAt,
+		DeletedAt: &invite.DeletedAt,
+		Code:      invite.Code,
+		UserID:    invite.UserID,
+		RoleID:    invite.RoleID,
+		MaxUses:   invite.MaxUses,
+		Used:      invite.Used,
+		ExpiresAt: invite.ExpiresAt,
+	}
+
+	if invite.Edges.User != nil {
+		i.User = entUserToUser(invite.Edges.User)
+	}
+
+	if invite.Edges.Role != nil {
+		i.Role = entRoleToRole(invite.Edges.Role)
+	}
+
+	if invite.Edges.InvitedUsers != nil {
+		i.InvitedUsers = entUsersToUsers(invite.Edges.InvitedUsers)
+	}
+
+	return i
+}
+
+func entAuditLogToAuditLog(log *ent.AuditLog) *entities.AuditLog {
+	if log == nil {
+		return nil
+	}
+	l := &entities.AuditLog{
+		ID:         log.ID,
+		CreatedAt:  &log.CreatedAt,
+		UpdatedAt:  &log.UpdatedAt,
+		DeletedAt:  &log.DeletedAt,
+		UserID:     log.UserID,
+		Action:     log.Action,
+		TargetType: log.TargetType,
+		TargetID:   log.TargetID,
+		Before:     log.Before,
+		After:      log.After,
+		IP:         log.IP,
+		RequestID:  log.RequestID,
+	}
+
+	if log.Edges.User != nil {
+		l.User = entUserToUser(log.Edges.User)
+	}
+
+	return l
+}
+
+func entMenuToMenu(menu *ent.Menu) *entities.Menu {
+	if menu == nil {
+		return nil
+	}
+	m := &entities.Menu{
+		ID:        menu.ID,
+		CreatedAt: &menu.CreatedAt,
+		UpdatedAt: &menu.UpdatedAt,
+		DeletedAt: &menu.DeletedAt,
+		Name:      menu.Name,
+		Location:  menu.Location,
+	}
+
+	if menu.Edges.Items != nil {
+		m.Items = entities.GetMenuItemsTree(utils.SliceMap(menu.Edges.Items, entMenuItemToMenuItem), 0)
+	}
+
+	return m
+}
+
+func entMenuItemToMenuItem(item *ent.MenuItem) *entities.MenuItem {
+	return &entities.MenuItem{
+		ID:        item.ID,
+		CreatedAt: &item.CreatedAt,
+		UpdatedAt: &item.UpdatedAt,
+		DeletedAt: &item.DeletedAt,
+		MenuID:    item.MenuID,
+		ParentID:  item.ParentID,
+		Label:     item.Label,
+		Type:      item.Type,
+		TargetID:  item.TargetID,
+		Url:       item.URL,
+		Order:     item.SortOrder,
+	}
+}
+
+func entCustomFieldToCustomField(field *ent.CustomField) *entities.CustomField {
+	if field == nil {
+		return nil
+	}
+
+	return &entities.CustomField{
+		ID:          field.ID,
+		Name:        field.Name,
+		Label:       field.Label,
+		Description: field.Description,
+		Type:        field.Type,
+		Target:      field.Target,
+		Required:    field.Required,
+		MaxLength:   field.MaxLength,
+		Pattern:     field.Pattern,
+		Options:     field.Options,
+		Order:       field.SortOrder,
+		CreatedAt:   &field.CreatedAt,
+		UpdatedAt:   &field.UpdatedAt,
+		DeletedAt:   &field.DeletedAt,
+	}
+}
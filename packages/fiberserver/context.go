@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"runtime"
 	"strconv"
@@ -67,6 +68,7 @@ func (c *Context) Meta(metas ...*entities.Meta) *entities.Meta {
 	if len(metas) > 0 {
 		c.MetaData = metas[0]
 		c.MetaData.User = c.User()
+		c.MetaData.CsrfToken = c.CsrfToken()
 
 		if c.MetaData.Messages == nil {
 			c.MetaData.Messages = &entities.Messages{}
@@ -81,6 +83,7 @@ func (c *Context) Meta(metas ...*entities.Meta) *entities.Meta {
 			Description: appName,
 			User:        c.User(),
 			Messages:    &entities.Messages{},
+			CsrfToken:   c.CsrfToken(),
 		}
 	}
 
@@ -121,6 +124,11 @@ func (c *Context) Render(fn func(meta *entities.Meta, wr *bufio.Writer)) error {
 	return nil
 }
 
+func (c *Context) CsrfToken() string {
+	token, _ := c.Locals("csrf_token").(string)
+	return token
+}
+
 func (c *Context) User() *entities.User {
 	if user, ok := c.Locals("user").(*entities.User); ok {
 		return user
@@ -232,6 +240,10 @@ func (c *Context) Send(data []byte) error {
 	return c.Ctx.Send(data)
 }
 
+func (c *Context) SendStream(stream io.Reader, size ...int) error {
+	return c.Ctx.SendStream(stream, size...)
+}
+
 func (c *Context) SendString(data string) error {
 	return c.Ctx.SendString(data)
 }
@@ -269,6 +281,14 @@ func (c *Context) BodyParser(v interface{}) error {
 	return c.Ctx.BodyParser(v)
 }
 
+func (c *Context) Body() []byte {
+	return c.Ctx.Body()
+}
+
+func (c *Context) FormValue(key string) string {
+	return c.Ctx.FormValue(key)
+}
+
 func (r *Response) Header(key string, vals ...string) string {
 	if len(vals) > 0 {
 		r.Response.Header.Add(key, vals[0])
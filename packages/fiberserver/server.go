@@ -135,5 +135,5 @@ func (s *Server) Options(path string, handler server.Handler, authConfigs ...*se
 
 func (s *Server) Head(path string, handler server.Handler, authConfigs ...*server.AuthConfig) {
 	authConfigs = append(authConfigs, &server.AuthConfig{Action: ""})
-	s.App.Options(path, transformHandlers([]server.Handler{handler}, s.middlewares...)...).Name(authConfigs[0].Action)
+	s.App.Head(path, transformHandlers([]server.Handler{handler}, s.middlewares...)...).Name(authConfigs[0].Action)
 }
@@ -35,11 +35,14 @@ func createContext(c *fiber.Ctx) server.Context {
 		user = c.Locals("user").(*entities.User)
 	}
 
+	csrfToken, _ := c.Locals("csrf_token").(string)
+
 	return &Context{
 		Ctx: c,
 		MetaData: &entities.Meta{
-			Messages: &entities.Messages{},
-			User:     user,
+			Messages:  &entities.Messages{},
+			User:      user,
+			CsrfToken: csrfToken,
 		},
 	}
 }
@@ -2,11 +2,12 @@ package rclonefs
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
-	"net/http"
 	"path"
 	"regexp"
 	"strconv"
@@ -18,59 +19,11 @@ import (
 
 	rclonefs "github.com/rclone/rclone/fs"
 	"github.com/rclone/rclone/fs/object"
+	"github.com/rclone/rclone/fs/walk"
 )
 
 var filenameRemoveCharsRegexp = regexp.MustCompile(`[^a-zA-Z0-9_\-\.]`)
 var dashRegexp = regexp.MustCompile(`\-+`)
-var allowedMimes = []string{
-	"text/xml",
-	"text/xml; charset=utf-8",
-	"image/svg+xml",
-	"image/jpeg",
-	"image/pjpeg",
-	"image/png",
-	"image/gif",
-	"image/x-icon",
-	"application/pdf",
-	"application/msword",
-	"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
-	"application/powerpoint",
-	"application/x-mspowerpoint",
-	"application/vnd.openxmlformats-officedocument.presentationml.presentation",
-	"application/mspowerpoint",
-	"application/vnd.ms-powerpoint",
-	"application/vnd.openxmlformats-officedocument.presentationml.slideshow",
-	"application/vnd.oasis.opendocument.text",
-	"application/excel",
-	"application/vnd.ms-excel",
-	"application/x-excel",
-	"application/x-msexcel",
-	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
-	// "application/octet-stream",
-	"audio/mpeg3",
-	"audio/x-mpeg-3",
-	"video/x-mpeg",
-	"audio/m4a",
-	"audio/ogg",
-	"audio/wav",
-	"audio/x-wav",
-	"video/mp4",
-	"video/x-m4v",
-	"video/quicktime",
-	"video/x-ms-asf",
-	"video/x-ms-wmv",
-	"application/x-troff-msvideo",
-	"video/avi",
-	"video/msvideo",
-	"video/x-msvideo",
-	"audio/mpeg",
-	"video/mpeg",
-	"video/ogg",
-	"video/3gpp",
-	"audio/3gpp",
-	"video/3gpp2",
-	"audio/3gpp2",
-}
 
 type BaseRcloneDisk struct {
 	rclonefs.Fs
@@ -82,7 +35,10 @@ func (r *BaseRcloneDisk) Name() string {
 	return r.DiskName
 }
 
+// Put stores the content at dst, the SHA-256 hash of the content is calculated while it's streamed to the disk
 func (r *BaseRcloneDisk) Put(ctx context.Context, reader io.Reader, size int64, mime, dst string) (*fs.FileInfo, error) {
+	hash := sha256.New()
+	reader = io.TeeReader(reader, hash)
 	objectInfo := object.NewStaticObjectInfo(
 		dst,
 		time.Now(),
@@ -103,6 +59,7 @@ func (r *BaseRcloneDisk) Put(ctx context.Context, reader io.Reader, size int64,
 		Path: dst,
 		Type: mime,
 		Size: int(rs.Size()),
+		Hash: hex.EncodeToString(hash.Sum(nil)),
 	}, nil
 }
 
@@ -114,8 +71,9 @@ func (r *BaseRcloneDisk) PutMultipart(ctx context.Context, m *multipart.FileHead
 	}
 
 	fileHeader := make([]byte, 512)
+	n, err := f.Read(fileHeader)
 
-	if _, err := f.Read(fileHeader); err != nil {
+	if err != nil {
 		return nil, err
 	}
 
@@ -124,10 +82,26 @@ func (r *BaseRcloneDisk) PutMultipart(ctx context.Context, m *multipart.FileHead
 	}
 
 	dst := ""
-	mime := http.DetectContentType(fileHeader)
+	mime := fs.DetectContentType(fileHeader[:n], m.Filename)
+
+	if strings.HasPrefix(mime, "text/xml") {
+		data, err := io.ReadAll(f)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := f.Seek(0, 0); err != nil {
+			return nil, err
+		}
+
+		if mime, err = fs.DetectXMLContentType(data, mime); err != nil {
+			return nil, err
+		}
+	}
 
-	if !utils.SliceContains(allowedMimes, strings.ToLower(mime)) {
-		return nil, errors.New("file type is not allowed")
+	if !utils.SliceContains(fs.AllowedMimes(ctx), strings.ToLower(mime)) {
+		return nil, fs.ErrFileTypeNotAllowed
 	}
 
 	if len(dsts) > 0 {
@@ -136,7 +110,41 @@ func (r *BaseRcloneDisk) PutMultipart(ctx context.Context, m *multipart.FileHead
 		dst = r.UploadFilePath(m.Filename)
 	}
 
-	return r.Put(ctx, f, m.Size, mime, dst)
+	in, size, err := fs.FilterUpload(mime, f, m.Size)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Put(ctx, in, size, mime, dst)
+}
+
+func (r *BaseRcloneDisk) Open(ctx context.Context, filepath string) (io.ReadCloser, error) {
+	obj, err := r.Fs.NewObject(ctx, filepath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.Open(ctx)
+}
+
+// List returns the paths of the objects under the directory and its subdirectories
+func (r *BaseRcloneDisk) List(ctx context.Context, dir string) ([]string, error) {
+	paths := []string{}
+	err := walk.ListR(ctx, r.Fs, dir, true, -1, walk.ListObjects, func(entries rclonefs.DirEntries) error {
+		for _, entry := range entries {
+			paths = append(paths, entry.Remote())
+		}
+
+		return nil
+	})
+
+	if errors.Is(err, rclonefs.ErrorDirNotFound) {
+		return paths, nil
+	}
+
+	return paths, err
 }
 
 func (r *BaseRcloneDisk) UploadFilePath(filename string) string {
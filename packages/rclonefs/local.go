@@ -2,7 +2,9 @@ package rclonefs
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
 	"github.com/ngocphuongnb/tetua/app/fs"
 	"github.com/rclone/rclone/backend/local"
@@ -14,6 +16,8 @@ type RcloneLocal struct {
 	Root      string        `json:"root"`
 	BaseUrl   string        `json:"base_url"`
 	BaseUrlFn func() string `json:"-"`
+	IsPrivate bool          `json:"private"`
+	SignKey   string        `json:"-"`
 }
 
 type RcloneLocalConfig struct {
@@ -21,6 +25,8 @@ type RcloneLocalConfig struct {
 	Root      string        `json:"root"`
 	BaseUrl   string        `json:"base_url"`
 	BaseUrlFn func() string `json:"-"`
+	Private   bool          `json:"private"`
+	SignKey   string        `json:"-"`
 }
 
 func NewLocal(cfg *RcloneLocalConfig) fs.FSDisk {
@@ -31,6 +37,8 @@ func NewLocal(cfg *RcloneLocalConfig) fs.FSDisk {
 		Root:      cfg.Root,
 		BaseUrl:   cfg.BaseUrl,
 		BaseUrlFn: cfg.BaseUrlFn,
+		IsPrivate: cfg.Private,
+		SignKey:   cfg.SignKey,
 	}
 
 	if err := os.MkdirAll(cfg.Root, os.ModePerm); err != nil {
@@ -66,3 +74,20 @@ func (r *RcloneLocal) Delete(ctx context.Context, filepath string) error {
 
 	return obj.Remove(ctx)
 }
+
+func (r *RcloneLocal) Private() bool {
+	return r.IsPrivate
+}
+
+// SignedUrl adds the expiry time and the signature to the url of the file,
+// the files of a private local disk are served by the app after the signature is verified
+func (r *RcloneLocal) SignedUrl(ctx context.Context, filepath string, expiry time.Duration) (string, error) {
+	if r.SignKey == "" {
+		return "", fs.ErrSignedUrlNotSupported
+	}
+
+	expires := time.Now().Add(expiry).Unix()
+	signature := fs.Sign(r.SignKey, r.DiskName, filepath, expires)
+
+	return fmt.Sprintf("%s?expires=%d&signature=%s", r.Url(filepath), expires, signature), nil
+}
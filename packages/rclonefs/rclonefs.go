@@ -21,6 +21,7 @@ func NewFromConfig(config *fs.StorageConfig) []fs.FSDisk {
 				SecretAccessKey: diskConfig.SecretAccessKey,
 				BaseUrl:         diskConfig.BaseUrl,
 				ACL:             diskConfig.ACL,
+				Private:         diskConfig.Private,
 			}))
 		case "local":
 			disks = append(disks, NewLocal(&RcloneLocalConfig{
@@ -28,6 +29,43 @@ func NewFromConfig(config *fs.StorageConfig) []fs.FSDisk {
 				Root:      diskConfig.Root,
 				BaseUrl:   diskConfig.BaseUrl,
 				BaseUrlFn: diskConfig.BaseUrlFn,
+				Private:   diskConfig.Private,
+				SignKey:   diskConfig.SignKey,
+			}))
+		case "sftp":
+			disks = append(disks, NewSFTP(&RcloneSFTPConfig{
+				Name:     diskConfig.Name,
+				Root:     diskConfig.Root,
+				Host:     diskConfig.Host,
+				Port:     diskConfig.Port,
+				User:     diskConfig.User,
+				Password: diskConfig.Password,
+				KeyFile:  diskConfig.KeyFile,
+				BaseUrl:  diskConfig.BaseUrl,
+				Private:  diskConfig.Private,
+				Options:  diskConfig.Options,
+			}))
+		case "webdav":
+			disks = append(disks, NewWebDAV(&RcloneWebDAVConfig{
+				Name:     diskConfig.Name,
+				Root:     diskConfig.Root,
+				Url:      diskConfig.Url,
+				Vendor:   diskConfig.Vendor,
+				User:     diskConfig.User,
+				Password: diskConfig.Password,
+				BaseUrl:  diskConfig.BaseUrl,
+				Private:  diskConfig.Private,
+				Options:  diskConfig.Options,
+			}))
+		default:
+			// Any other rclone backend, e.g. azureblob, is configured with the options of the disk
+			disks = append(disks, NewRemote(&RcloneRemoteConfig{
+				Name:    diskConfig.Name,
+				Type:    diskConfig.Driver,
+				Root:    diskConfig.Root,
+				BaseUrl: diskConfig.BaseUrl,
+				Private: diskConfig.Private,
+				Options: diskConfig.Options,
 			}))
 		}
 	}
@@ -0,0 +1,132 @@
+package rclonefs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ngocphuongnb/tetua/app/fs"
+	rclonefs "github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/configmap"
+	"github.com/rclone/rclone/fs/config/obscure"
+
+	// Backends that can be used as the driver of a generic remote disk
+	_ "github.com/rclone/rclone/backend/azureblob"
+	_ "github.com/rclone/rclone/backend/b2"
+	_ "github.com/rclone/rclone/backend/ftp"
+	_ "github.com/rclone/rclone/backend/googlecloudstorage"
+	_ "github.com/rclone/rclone/backend/sftp"
+	_ "github.com/rclone/rclone/backend/swift"
+	_ "github.com/rclone/rclone/backend/webdav"
+)
+
+// RcloneRemote is a disk backed by any registered rclone backend
+type RcloneRemote struct {
+	*BaseRcloneDisk
+	Type      string `json:"type"`
+	Root      string `json:"root"`
+	BaseUrl   string `json:"base_url"`
+	IsPrivate bool   `json:"private"`
+}
+
+type RcloneRemoteConfig struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Root    string `json:"root"`
+	BaseUrl string `json:"base_url"`
+	Private bool   `json:"private"`
+	// Options are the plain option values of the rclone backend,
+	// the password options are obscured before they're passed to rclone
+	Options map[string]string `json:"options"`
+}
+
+func NewRemote(cfg *RcloneRemoteConfig) fs.FSDisk {
+	rr, err := newRemote(cfg)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return rr
+}
+
+func newRemote(cfg *RcloneRemoteConfig) (*RcloneRemote, error) {
+	regInfo, err := rclonefs.Find(cfg.Type)
+
+	if err != nil {
+		return nil, fmt.Errorf("unknown storage driver %s of disk %s: %w", cfg.Type, cfg.Name, err)
+	}
+
+	options := configmap.Simple{}
+
+	for key, value := range cfg.Options {
+		if value != "" && isPasswordOption(regInfo, key) {
+			if value, err = obscure.Obscure(value); err != nil {
+				return nil, err
+			}
+		}
+
+		options.Set(key, value)
+	}
+
+	fsDriver, err := regInfo.NewFs(
+		context.Background(),
+		cfg.Name,
+		cfg.Root,
+		rclonefs.ConfigMap(regInfo, cfg.Name, options),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &RcloneRemote{
+		BaseRcloneDisk: &BaseRcloneDisk{
+			Fs:       fsDriver,
+			DiskName: cfg.Name,
+		},
+		Type:      cfg.Type,
+		Root:      cfg.Root,
+		BaseUrl:   cfg.BaseUrl,
+		IsPrivate: cfg.Private,
+	}, nil
+}
+
+func isPasswordOption(regInfo *rclonefs.RegInfo, name string) bool {
+	for _, option := range regInfo.Options {
+		if option.Name == name {
+			return option.IsPassword
+		}
+	}
+
+	return false
+}
+
+func (r *RcloneRemote) Url(filepath string) string {
+	return r.BaseUrl + "/" + filepath
+}
+
+func (r *RcloneRemote) Delete(ctx context.Context, filepath string) error {
+	obj, err := r.Fs.NewObject(ctx, filepath)
+
+	if err != nil {
+		return err
+	}
+
+	return obj.Remove(ctx)
+}
+
+func (r *RcloneRemote) Private() bool {
+	return r.IsPrivate
+}
+
+// SignedUrl returns a public link of the file if the backend supports it
+func (r *RcloneRemote) SignedUrl(ctx context.Context, filepath string, expiry time.Duration) (string, error) {
+	publicLink := r.Fs.Features().PublicLink
+
+	if publicLink == nil {
+		return "", fs.ErrSignedUrlNotSupported
+	}
+
+	return publicLink(ctx, filepath, rclonefs.Duration(expiry), false)
+}
@@ -2,6 +2,7 @@ package rclonefs
 
 import (
 	"context"
+	"time"
 
 	"github.com/ngocphuongnb/tetua/app/fs"
 	"github.com/rclone/rclone/backend/s3"
@@ -21,6 +22,7 @@ type RcloneS3 struct {
 	SecretAccessKey string              `json:"secret_access_key"`
 	BaseUrl         string              `json:"base_url"`
 	ACL             string              `json:"acl"`
+	IsPrivate       bool                `json:"private"`
 }
 
 type RcloneS3Config struct {
@@ -35,6 +37,7 @@ type RcloneS3Config struct {
 	SecretAccessKey string              `json:"secret_access_key"`
 	BaseUrl         string              `json:"base_url"`
 	ACL             string              `json:"acl"`
+	Private         bool                `json:"private"`
 }
 
 func NewS3(cfg *RcloneS3Config) fs.FSDisk {
@@ -56,6 +59,7 @@ func NewS3(cfg *RcloneS3Config) fs.FSDisk {
 		SecretAccessKey: cfg.SecretAccessKey,
 		BaseUrl:         cfg.BaseUrl,
 		ACL:             cfg.ACL,
+		IsPrivate:       cfg.Private,
 	}
 
 	cfgMap := &configmap.Simple{}
@@ -87,3 +91,18 @@ func (r *RcloneS3) Url(filepath string) string {
 func (r *RcloneS3) Delete(ctx context.Context, filepath string) error {
 	return nil
 }
+
+func (r *RcloneS3) Private() bool {
+	return r.IsPrivate
+}
+
+// SignedUrl returns a presigned url of the file
+func (r *RcloneS3) SignedUrl(ctx context.Context, filepath string, expiry time.Duration) (string, error) {
+	publicLink := r.Fs.Features().PublicLink
+
+	if publicLink == nil {
+		return "", fs.ErrSignedUrlNotSupported
+	}
+
+	return publicLink(ctx, filepath, rclonefs.Duration(expiry), false)
+}
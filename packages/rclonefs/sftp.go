@@ -0,0 +1,53 @@
+package rclonefs
+
+import (
+	"strconv"
+
+	"github.com/ngocphuongnb/tetua/app/fs"
+)
+
+type RcloneSFTPConfig struct {
+	Name     string `json:"name"`
+	Root     string `json:"root"`
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	User     string `json:"user"`
+	Password string `json:"password"`
+	KeyFile  string `json:"key_file"`
+	BaseUrl  string `json:"base_url"`
+	Private  bool   `json:"private"`
+	// Options are the extra options of the rclone sftp backend
+	Options map[string]string `json:"options"`
+}
+
+func NewSFTP(cfg *RcloneSFTPConfig) fs.FSDisk {
+	options := map[string]string{}
+
+	for key, value := range cfg.Options {
+		options[key] = value
+	}
+
+	options["host"] = cfg.Host
+	options["user"] = cfg.User
+
+	if cfg.Port > 0 {
+		options["port"] = strconv.Itoa(cfg.Port)
+	}
+
+	if cfg.Password != "" {
+		options["pass"] = cfg.Password
+	}
+
+	if cfg.KeyFile != "" {
+		options["key_file"] = cfg.KeyFile
+	}
+
+	return NewRemote(&RcloneRemoteConfig{
+		Name:    cfg.Name,
+		Type:    "sftp",
+		Root:    cfg.Root,
+		BaseUrl: cfg.BaseUrl,
+		Private: cfg.Private,
+		Options: options,
+	})
+}
@@ -0,0 +1,49 @@
+package rclonefs
+
+import (
+	"github.com/ngocphuongnb/tetua/app/fs"
+)
+
+type RcloneWebDAVConfig struct {
+	Name     string `json:"name"`
+	Root     string `json:"root"`
+	Url      string `json:"url"`
+	Vendor   string `json:"vendor"`
+	User     string `json:"user"`
+	Password string `json:"password"`
+	BaseUrl  string `json:"base_url"`
+	Private  bool   `json:"private"`
+	// Options are the extra options of the rclone webdav backend
+	Options map[string]string `json:"options"`
+}
+
+func NewWebDAV(cfg *RcloneWebDAVConfig) fs.FSDisk {
+	options := map[string]string{}
+
+	for key, value := range cfg.Options {
+		options[key] = value
+	}
+
+	options["url"] = cfg.Url
+
+	if cfg.Vendor != "" {
+		options["vendor"] = cfg.Vendor
+	}
+
+	if cfg.User != "" {
+		options["user"] = cfg.User
+	}
+
+	if cfg.Password != "" {
+		options["pass"] = cfg.Password
+	}
+
+	return NewRemote(&RcloneRemoteConfig{
+		Name:    cfg.Name,
+		Type:    "webdav",
+		Root:    cfg.Root,
+		BaseUrl: cfg.BaseUrl,
+		Private: cfg.Private,
+		Options: options,
+	})
+}
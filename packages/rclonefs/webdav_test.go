@@ -0,0 +1,134 @@
+package rclonefs
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ngocphuongnb/tetua/app/fs"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/webdav"
+)
+
+func newWebDAVServer() *httptest.Server {
+	return httptest.NewServer(&webdav.Handler{
+		FileSystem: webdav.NewMemFS(),
+		LockSystem: webdav.NewMemLS(),
+	})
+}
+
+func TestWebDAV(t *testing.T) {
+	server := newWebDAVServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	content := []byte("webdav file content")
+	disk := NewWebDAV(&RcloneWebDAVConfig{
+		Name:    "webdav_disk",
+		Root:    "files",
+		Url:     server.URL,
+		BaseUrl: "https://cdn.site.local",
+	})
+
+	assert.Equal(t, "webdav_disk", disk.Name())
+	assert.Equal(t, "https://cdn.site.local/2022/05/photo.txt", disk.Url("2022/05/photo.txt"))
+
+	info, err := disk.Put(ctx, bytes.NewReader(content), int64(len(content)), "text/plain", "2022/05/photo.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, "webdav_disk", info.Disk)
+	assert.Equal(t, len(content), info.Size)
+	assert.NotEmpty(t, info.Hash)
+
+	reader, err := disk.Open(ctx, "2022/05/photo.txt")
+	assert.Nil(t, err)
+	data, _ := io.ReadAll(reader)
+	reader.Close()
+	assert.Equal(t, content, data)
+
+	_, err = disk.Put(ctx, bytes.NewReader(content), int64(len(content)), "text/plain", "2022/06/note.txt")
+	assert.Nil(t, err)
+	paths, err := fs.List(ctx, disk, "2022")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"2022/05/photo.txt", "2022/06/note.txt"}, paths)
+	paths, err = fs.List(ctx, disk, "2023")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{}, paths)
+
+	_, err = disk.(fs.SignedUrlDisk).SignedUrl(ctx, "2022/05/photo.txt", time.Hour)
+	assert.Equal(t, fs.ErrSignedUrlNotSupported, err)
+
+	assert.Nil(t, disk.Delete(ctx, "2022/05/photo.txt"))
+	_, err = disk.Open(ctx, "2022/05/photo.txt")
+	assert.NotNil(t, err)
+	assert.NotNil(t, disk.Delete(ctx, "2022/05/photo.txt"))
+}
+
+func newFileHeader(t *testing.T, filename, content string) *multipart.FileHeader {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", filename)
+	assert.Nil(t, err)
+	part.Write([]byte(content))
+	writer.Close()
+
+	form, err := multipart.NewReader(body, writer.Boundary()).ReadForm(1 << 20)
+	assert.Nil(t, err)
+	return form.File["file"][0]
+}
+
+func TestPutMultipartXML(t *testing.T) {
+	server := newWebDAVServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	disk := NewWebDAV(&RcloneWebDAVConfig{Name: "webdav_disk", Url: server.URL})
+	padding := `<?xml version="1.0"?><!-- ` + strings.Repeat("padding ", 100) + ` -->`
+
+	info, err := disk.PutMultipart(ctx, newFileHeader(t, "feed.xml", padding+`<feed></feed>`), "feed.xml")
+	assert.Nil(t, err)
+	assert.Equal(t, "text/xml; charset=utf-8", info.Type)
+
+	info, err = disk.PutMultipart(ctx, newFileHeader(t, "icon.xml", padding+`<svg xmlns="http://www.w3.org/2000/svg"></svg>`), "icon.xml")
+	assert.Nil(t, err)
+	assert.Equal(t, "image/svg+xml", info.Type)
+
+	_, err = disk.PutMultipart(ctx, newFileHeader(t, "page.xml", padding+`<html xmlns="http://www.w3.org/1999/xhtml"></html>`), "page.xml")
+	assert.Equal(t, fs.ErrFileTypeNotAllowed, err)
+}
+
+func TestNewFromConfig(t *testing.T) {
+	server := newWebDAVServer()
+	defer server.Close()
+
+	disks := NewFromConfig(&fs.StorageConfig{
+		DiskConfigs: []*fs.DiskConfig{{
+			Name:     "webdav_disk",
+			Driver:   "webdav",
+			Url:      server.URL,
+			User:     "user",
+			Password: "password",
+		}, {
+			Name:    "remote_disk",
+			Driver:  "webdav",
+			BaseUrl: "https://files.site.local",
+			Private: true,
+			Options: map[string]string{"url": server.URL, "pass": "password"},
+		}},
+	})
+
+	assert.Equal(t, 2, len(disks))
+	assert.Equal(t, "webdav_disk", disks[0].Name())
+	assert.Equal(t, "https://files.site.local/photo.jpg", disks[1].Url("photo.jpg"))
+	assert.Equal(t, true, fs.IsPrivate(disks[1]))
+
+	assert.PanicsWithError(t, `unknown storage driver unknown of disk unknown_disk: didn't find backend called "unknown"`, func() {
+		NewFromConfig(&fs.StorageConfig{
+			DiskConfigs: []*fs.DiskConfig{{Name: "unknown_disk", Driver: "unknown"}},
+		})
+	})
+}
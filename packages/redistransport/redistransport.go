@@ -0,0 +1,286 @@
+package redistransport
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+)
+
+type Config struct {
+	Addr     string
+	Password string
+	Channel  string
+	// OnReconnect is called when a dropped subscription is restored, the messages
+	// published while it was down are lost so the subscriber can rebuild its state
+	OnReconnect func()
+}
+
+// Transport broadcasts messages through the Redis pub/sub commands,
+// it only needs a server that speaks the Redis protocol
+type Transport struct {
+	config Config
+	mu     sync.Mutex
+	conn   net.Conn
+	reader *bufio.Reader
+	closed chan struct{}
+	once   sync.Once
+	subs   sync.WaitGroup
+	subMu  sync.Mutex
+	sub    map[net.Conn]struct{}
+}
+
+var ErrClosed = errors.New("redis transport is closed")
+
+const dialTimeout = 5 * time.Second
+const retryInterval = time.Second
+
+func New(config Config) *Transport {
+	if config.Channel == "" {
+		config.Channel = "tetua:cache"
+	}
+
+	return &Transport{
+		config: config,
+		closed: make(chan struct{}),
+		sub:    map[net.Conn]struct{}{},
+	}
+}
+
+// Publish sends the message to the channel, the connection is dialed on first use
+// and redialed once when the previous one was broken
+func (t *Transport) Publish(ctx context.Context, message string) (err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for attempt := 0; attempt < 2; attempt++ {
+		if t.isClosed() {
+			return ErrClosed
+		}
+
+		if t.conn == nil {
+			if t.conn, err = t.dial(ctx); err != nil {
+				return err
+			}
+
+			t.reader = bufio.NewReader(t.conn)
+		}
+
+		if err = t.publish(ctx, message); err == nil {
+			return nil
+		}
+
+		t.conn.Close()
+		t.conn = nil
+	}
+
+	return err
+}
+
+func (t *Transport) publish(ctx context.Context, message string) error {
+	if deadline, ok := ctx.Deadline(); ok {
+		t.conn.SetDeadline(deadline)
+	} else {
+		t.conn.SetDeadline(time.Now().Add(dialTimeout))
+	}
+
+	if err := writeCommand(t.conn, "PUBLISH", t.config.Channel, message); err != nil {
+		return err
+	}
+
+	reply, err := readValue(t.reader)
+
+	if err != nil {
+		return err
+	}
+
+	if err, ok := reply.(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+// Subscribe listens to the channel in the background until the transport is closed,
+// the subscription is restored when the connection drops and Config.OnReconnect is called
+func (t *Transport) Subscribe(handler func(message string)) error {
+	conn, reader, err := t.subscribe()
+
+	if err != nil {
+		return err
+	}
+
+	t.subs.Add(1)
+	go func() {
+		defer t.subs.Done()
+
+		for {
+			t.receive(reader, handler)
+			t.unsubscribe(conn)
+
+			for {
+				select {
+				case <-t.closed:
+					return
+				case <-time.After(retryInterval):
+				}
+
+				if conn, reader, err = t.subscribe(); err == nil {
+					break
+				}
+			}
+
+			if t.config.OnReconnect != nil {
+				t.config.OnReconnect()
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (t *Transport) receive(reader *bufio.Reader, handler func(message string)) {
+	for {
+		reply, err := readValue(reader)
+
+		if err != nil {
+			return
+		}
+
+		values, ok := reply.([]interface{})
+
+		if !ok || len(values) != 3 {
+			continue
+		}
+
+		if kind, _ := values[0].(string); kind != "message" {
+			continue
+		}
+
+		if message, ok := values[2].(string); ok {
+			handler(message)
+		}
+	}
+}
+
+func (t *Transport) subscribe() (net.Conn, *bufio.Reader, error) {
+	if t.isClosed() {
+		return nil, nil, ErrClosed
+	}
+
+	conn, err := t.dial(context.Background())
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reader := bufio.NewReader(conn)
+	conn.SetDeadline(time.Now().Add(dialTimeout))
+
+	if err := writeCommand(conn, "SUBSCRIBE", t.config.Channel); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+
+	reply, err := readValue(reader)
+
+	if err == nil {
+		if replyErr, ok := reply.(error); ok {
+			err = replyErr
+		}
+	}
+
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+
+	conn.SetDeadline(time.Time{})
+	t.subMu.Lock()
+	defer t.subMu.Unlock()
+
+	if t.isClosed() {
+		conn.Close()
+		return nil, nil, ErrClosed
+	}
+
+	t.sub[conn] = struct{}{}
+
+	return conn, reader, nil
+}
+
+func (t *Transport) unsubscribe(conn net.Conn) {
+	t.subMu.Lock()
+	defer t.subMu.Unlock()
+	delete(t.sub, conn)
+	conn.Close()
+}
+
+func (t *Transport) dial(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", t.config.Addr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if t.config.Password == "" {
+		return conn, nil
+	}
+
+	conn.SetDeadline(time.Now().Add(dialTimeout))
+
+	if err := writeCommand(conn, "AUTH", t.config.Password); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	reply, err := readValue(bufio.NewReader(conn))
+
+	if err == nil {
+		if replyErr, ok := reply.(error); ok {
+			err = replyErr
+		}
+	}
+
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+func (t *Transport) isClosed() bool {
+	select {
+	case <-t.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Close stops the subscriptions and closes the connections
+func (t *Transport) Close() error {
+	t.once.Do(func() {
+		close(t.closed)
+
+		t.subMu.Lock()
+		for conn := range t.sub {
+			conn.Close()
+		}
+		t.subMu.Unlock()
+
+		t.mu.Lock()
+		if t.conn != nil {
+			t.conn.Close()
+			t.conn = nil
+		}
+		t.mu.Unlock()
+	})
+	t.subs.Wait()
+
+	return nil
+}
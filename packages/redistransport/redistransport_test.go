@@ -0,0 +1,244 @@
+package redistransport
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// standInServer implements the subset of the Redis protocol used by the transport
+type standInServer struct {
+	listener    net.Listener
+	password    string
+	mu          sync.Mutex
+	conns       map[net.Conn]struct{}
+	subscribers map[string]map[net.Conn]struct{}
+}
+
+func newStandInServer(t *testing.T, password string) *standInServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &standInServer{
+		listener:    listener,
+		password:    password,
+		conns:       map[net.Conn]struct{}{},
+		subscribers: map[string]map[net.Conn]struct{}{},
+	}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+
+			if err != nil {
+				return
+			}
+
+			s.mu.Lock()
+			s.conns[conn] = struct{}{}
+			s.mu.Unlock()
+			go s.serve(conn)
+		}
+	}()
+
+	t.Cleanup(func() {
+		listener.Close()
+		s.dropConnections()
+	})
+
+	return s
+}
+
+func (s *standInServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *standInServer) serve(conn net.Conn) {
+	defer s.remove(conn)
+	reader := bufio.NewReader(conn)
+	authenticated := s.password == ""
+
+	for {
+		value, err := readValue(reader)
+
+		if err != nil {
+			return
+		}
+
+		args, _ := value.([]interface{})
+
+		if len(args) == 0 {
+			return
+		}
+
+		command, _ := args[0].(string)
+
+		switch strings.ToUpper(command) {
+		case "AUTH":
+			if args[1] == s.password {
+				authenticated = true
+				io.WriteString(conn, "+OK\r\n")
+			} else {
+				io.WriteString(conn, "-WRONGPASS invalid password\r\n")
+			}
+		case "SUBSCRIBE":
+			if !authenticated {
+				io.WriteString(conn, "-NOAUTH Authentication required.\r\n")
+				continue
+			}
+
+			channel := args[1].(string)
+			s.mu.Lock()
+			if s.subscribers[channel] == nil {
+				s.subscribers[channel] = map[net.Conn]struct{}{}
+			}
+			s.subscribers[channel][conn] = struct{}{}
+			s.mu.Unlock()
+			writeCommand(conn, "subscribe", channel)
+		case "PUBLISH":
+			if !authenticated {
+				io.WriteString(conn, "-NOAUTH Authentication required.\r\n")
+				continue
+			}
+
+			channel, message := args[1].(string), args[2].(string)
+			s.mu.Lock()
+			for subscriber := range s.subscribers[channel] {
+				writeCommand(subscriber, "message", channel, message)
+			}
+			count := len(s.subscribers[channel])
+			s.mu.Unlock()
+			io.WriteString(conn, ":"+strconv.Itoa(count)+"\r\n")
+		default:
+			io.WriteString(conn, "-ERR unknown command\r\n")
+		}
+	}
+}
+
+func (s *standInServer) remove(conn net.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	conn.Close()
+	delete(s.conns, conn)
+
+	for _, subscribers := range s.subscribers {
+		delete(subscribers, conn)
+	}
+}
+
+func (s *standInServer) dropConnections() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for conn := range s.conns {
+		conn.Close()
+	}
+}
+
+func receiver() (func(string), chan string) {
+	messages := make(chan string, 10)
+	return func(message string) { messages <- message }, messages
+}
+
+func waitMessage(t *testing.T, messages chan string) string {
+	select {
+	case message := <-messages:
+		return message
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for message")
+		return ""
+	}
+}
+
+func TestPublishSubscribe(t *testing.T) {
+	server := newStandInServer(t, "secret")
+	publisher := New(Config{Addr: server.Addr(), Password: "secret"})
+	subscriber := New(Config{Addr: server.Addr(), Password: "secret"})
+	other := New(Config{Addr: server.Addr(), Password: "secret", Channel: "other"})
+	defer publisher.Close()
+	defer subscriber.Close()
+	defer other.Close()
+
+	handler, messages := receiver()
+	otherHandler, otherMessages := receiver()
+	assert.NoError(t, subscriber.Subscribe(handler))
+	assert.NoError(t, other.Subscribe(otherHandler))
+
+	assert.NoError(t, publisher.Publish(context.Background(), "node1:topics"))
+	assert.NoError(t, publisher.Publish(context.Background(), "node1:permissions"))
+	assert.Equal(t, "node1:topics", waitMessage(t, messages))
+	assert.Equal(t, "node1:permissions", waitMessage(t, messages))
+	assert.Equal(t, 0, len(otherMessages))
+}
+
+func TestAuthError(t *testing.T) {
+	server := newStandInServer(t, "secret")
+	transport := New(Config{Addr: server.Addr(), Password: "wrong"})
+	defer transport.Close()
+
+	handler, _ := receiver()
+	assert.Equal(t, errors.New("WRONGPASS invalid password"), transport.Subscribe(handler))
+	assert.Equal(t, errors.New("WRONGPASS invalid password"), transport.Publish(context.Background(), "message"))
+
+	transport = New(Config{Addr: server.Addr()})
+	defer transport.Close()
+	assert.Equal(t, errors.New("NOAUTH Authentication required."), transport.Publish(context.Background(), "message"))
+}
+
+func TestReconnect(t *testing.T) {
+	server := newStandInServer(t, "")
+	reconnected := make(chan string, 10)
+	publisher := New(Config{Addr: server.Addr()})
+	subscriber := New(Config{Addr: server.Addr(), OnReconnect: func() { reconnected <- "reconnected" }})
+	defer publisher.Close()
+	defer subscriber.Close()
+
+	handler, messages := receiver()
+	assert.NoError(t, subscriber.Subscribe(handler))
+	assert.NoError(t, publisher.Publish(context.Background(), "before"))
+	assert.Equal(t, "before", waitMessage(t, messages))
+	assert.Equal(t, 0, len(reconnected))
+
+	server.dropConnections()
+	assert.Equal(t, "reconnected", waitMessage(t, reconnected))
+
+	// the publisher redials and the subscriber restores its subscription in the background
+	deadline := time.Now().Add(5 * time.Second)
+
+	for time.Now().Before(deadline) {
+		assert.NoError(t, publisher.Publish(context.Background(), "after"))
+
+		select {
+		case message := <-messages:
+			assert.Equal(t, "after", message)
+			return
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	t.Fatal("subscription was not restored")
+}
+
+func TestClose(t *testing.T) {
+	server := newStandInServer(t, "")
+	transport := New(Config{Addr: server.Addr()})
+	handler, _ := receiver()
+
+	assert.NoError(t, transport.Subscribe(handler))
+	assert.NoError(t, transport.Publish(context.Background(), "message"))
+	assert.NoError(t, transport.Close())
+	assert.Equal(t, ErrClosed, transport.Publish(context.Background(), "message"))
+	assert.Equal(t, ErrClosed, transport.Subscribe(handler))
+}
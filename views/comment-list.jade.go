@@ -15,106 +15,117 @@ import (
 
 const (
 	commentlist__0   = `<!DOCTYPE html><html lang="en">`
-	commentlist__1   = `<head><meta charset="utf-8"/><meta name="viewport" content="width=device-width, initial-scale=1.0, viewport-fit=cover"/><title>`
-	commentlist__2   = `</title><meta name="keywords" content="software development, devloper community"/><link rel="canonical" href="`
-	commentlist__3   = `"/><meta property="og:type" content="`
-	commentlist__4   = `"/><meta property="og:url" content="`
-	commentlist__5   = `"/><meta property="og:title" content="`
-	commentlist__6   = `"/><meta property="og:site_name" content="`
-	commentlist__7   = `"/><meta name="twitter:site" content="`
-	commentlist__8   = `"/><meta name="twitter:title" content="`
-	commentlist__9   = `"/><meta name="twitter:card" content="summary_large_image"/><meta name="apple-mobile-web-app-title" content="`
-	commentlist__10  = `"/><meta name="application-name" content="`
-	commentlist__11  = `"/><link rel="alternate" type="application/rss+xml" title="`
-	commentlist__12  = `" href="`
-	commentlist__13  = `"/>`
-	commentlist__14  = `</head><body><header><nav class="main container"><a class="logo" href="`
-	commentlist__15  = `" title="Home">`
-	commentlist__16  = `</a><form class="search-form" method="get" action="/search" accept-charset="UTF-8"><input class="search-input" type="text" name="q" placeholder="Search..." autocomplete="off" value="`
-	commentlist__17  = `"/><button class="search-btn" type="submit" aria-label="Search"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M9.5,3A6.5,6.5 0 0,1 16,9.5C16,11.11 15.41,12.59 14.44,13.73L14.71,14H15.5L20.5,19L19,20.5L14,15.5V14.71L13.73,14.44C12.59,15.41 11.11,16 9.5,16A6.5,6.5 0 0,1 3,9.5A6.5,6.5 0 0,1 9.5,3M9.5,5C7,5 5,7 5,9.5C5,12 7,14 9.5,14C12,14 14,12 14,9.5C14,7 12,5 9.5,5Z"></path></svg></button></form><ul><li class="search-mobile"><a href="`
-	commentlist__18  = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M9.5,3A6.5,6.5 0 0,1 16,9.5C16,11.11 15.41,12.59 14.44,13.73L14.71,14H15.5L20.5,19L19,20.5L14,15.5V14.71L13.73,14.44C12.59,15.41 11.11,16 9.5,16A6.5,6.5 0 0,1 3,9.5A6.5,6.5 0 0,1 9.5,3M9.5,5C7,5 5,7 5,9.5C5,12 7,14 9.5,14C12,14 14,12 14,9.5C14,7 12,5 9.5,5Z"></path></svg></a></li>`
-	commentlist__19  = `</ul><label class="menu-trigger"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M3,6H21V8H3V6M3,11H21V13H3V11M3,16H21V18H3V16Z"></path></svg></label></nav></header><div class="wrapper"><div class="container"><div class="layout two-left"><div class="left"><div class="box fixed-sidebar">`
-	commentlist__20  = `</div></div><main class="main"><div class="box"><h1>My Comments</h1>`
-	commentlist__21  = `<div class="comments">`
-	commentlist__22  = `</div>`
-	commentlist__23  = `<ul class="paginate">`
-	commentlist__24  = `</ul></div></main></div></div><div class="mobile-menu"><div class="menu-head">`
-	commentlist__25  = `<label class="menu-trigger menu-close"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M19,6.41L17.59,5L12,10.59L6.41,5L5,6.41L10.59,12L5,17.59L6.41,19L12,13.41L17.59,19L19,17.59L13.41,12L19,6.41Z"></path></svg></label></div>`
-	commentlist__26  = `<strong>Topics</strong><div class="menu-topics">`
-	commentlist__27  = `</div></div></div><div class="overlay menu-trigger"></div><footer><div class="container"><div>`
-	commentlist__28  = `</div><p>Proudly powered by <a href="https://tetua.net" title="Tetua - CMS Platform for Blogging">Tetua</a></p></div></footer>`
-	commentlist__29  = `<script>listenDeleteNodeEvents('comment', '/comments', '/comments')</script></body></html>`
-	commentlist__30  = `<link rel="icon" type="image/png" href="`
-	commentlist__31  = `"/><link rel="apple-touch-icon" href="`
-	commentlist__33  = `<meta name="description" content="`
-	commentlist__34  = `"/><meta property="og:description" content="`
-	commentlist__35  = `"/><meta name="twitter:description" content="`
-	commentlist__37  = `<meta property="og:image" content="`
-	commentlist__38  = `"/><meta name="twitter:image:src" content="`
-	commentlist__40  = `<img src="`
-	commentlist__41  = `" alt="`
-	commentlist__43  = `<svg viewBox="0 0 24 24"><path fill="#164e63" d="M11,6.5V9.33L8.33,12L11,14.67V17.5L5.5,12M13,6.43L18.57,12L13,17.57V14.74L15.74,12L13,9.26M5,3C3.89,3 3,3.9 3,5V19A2,2 0 0,0 5,21H19A2,2 0 0,0 21,19V5A2,2 0 0,0 19,3H5Z"></path></svg>`
-	commentlist__44  = `<li><a href="`
-	commentlist__45  = `">Login</a></li><li><a href="`
-	commentlist__46  = `">Register</a></li>`
-	commentlist__48  = `">New</a></li><li><div class="user-menu"><a href="`
-	commentlist__49  = `" title="`
-	commentlist__50  = `">`
-	commentlist__51  = `</a><svg viewBox="0 0 24 24"><path fill="currentColor" d="M7.41,8.58L12,13.17L16.59,8.58L18,10L12,16L6,10L7.41,8.58Z"></path></svg><ul class="sub">`
-	commentlist__53  = `">Profile</a></li><li><a href="`
-	commentlist__54  = `">Posts</a></li><li><a href="`
-	commentlist__55  = `">Setting</a></li><li><a href="`
-	commentlist__56  = `">Logout</a></li></ul></div></li>`
-	commentlist__57  = `<img class="avatar" src="`
-	commentlist__60  = `<span class="avatar none"></span>`
-	commentlist__62  = `">Manage</a></li>`
-	commentlist__63  = `<div class="meta flex">`
-	commentlist__64  = `<div><a class="author" href="`
-	commentlist__66  = `</a><div class="stat flex"><span>`
-	commentlist__67  = `</span></div></div></div><ul class="manage-features"><li><a href="`
-	commentlist__68  = `"><svg style="width:24px;height:24px" viewBox="0 0 24 24"><path fill="currentColor" d="M12,20C7.59,20 4,16.41 4,12C4,7.59 7.59,4 12,4C16.41,4 20,7.59 20,12C20,16.41 16.41,20 12,20M12,2A10,10 0 0,0 2,12A10,10 0 0,0 12,22A10,10 0 0,0 22,12A10,10 0 0,0 12,2M13,7H11V11H7V13H11V17H13V13H17V11H13V7Z"></path></svg>New post</a></li><li><a href="`
-	commentlist__69  = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M20 5L20 19L4 19L4 5H20M20 3H4C2.89 3 2 3.89 2 5V19C2 20.11 2.89 21 4 21H20C21.11 21 22 20.11 22 19V5C22 3.89 21.11 3 20 3M18 15H6V17H18V15M10 7H6V13H10V7M12 9H18V7H12V9M18 11H12V13H18V11Z"></path></svg>My Posts</a></li><li><a href="`
-	commentlist__70  = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M20 2H4C2.9 2 2 2.9 2 4V22L6 18H20C21.1 18 22 17.1 22 16V4C22 2.9 21.1 2 20 2M20 16H5.2L4 17.2V4H20V16Z"></path></svg>My Comments</a></li><li><a href="`
-	commentlist__71  = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M21,17H7V3H21M21,1H7A2,2 0 0,0 5,3V17A2,2 0 0,0 7,19H21A2,2 0 0,0 23,17V3A2,2 0 0,0 21,1M3,5H1V21A2,2 0 0,0 3,23H19V21H3M15.96,10.29L13.21,13.83L11.25,11.47L8.5,15H19.5L15.96,10.29Z"></path></svg>My Files</a></li><li><a href="`
-	commentlist__72  = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M12,8A4,4 0 0,1 16,12A4,4 0 0,1 12,16A4,4 0 0,1 8,12A4,4 0 0,1 12,8M12,10A2,2 0 0,0 10,12A2,2 0 0,0 12,14A2,2 0 0,0 14,12A2,2 0 0,0 12,10M10,22C9.75,22 9.54,21.82 9.5,21.58L9.13,18.93C8.5,18.68 7.96,18.34 7.44,17.94L4.95,18.95C4.73,19.03 4.46,18.95 4.34,18.73L2.34,15.27C2.21,15.05 2.27,14.78 2.46,14.63L4.57,12.97L4.5,12L4.57,11L2.46,9.37C2.27,9.22 2.21,8.95 2.34,8.73L4.34,5.27C4.46,5.05 4.73,4.96 4.95,5.05L7.44,6.05C7.96,5.66 8.5,5.32 9.13,5.07L9.5,2.42C9.54,2.18 9.75,2 10,2H14C14.25,2 14.46,2.18 14.5,2.42L14.87,5.07C15.5,5.32 16.04,5.66 16.56,6.05L19.05,5.05C19.27,4.96 19.54,5.05 19.66,5.27L21.66,8.73C21.79,8.95 21.73,9.22 21.54,9.37L19.43,11L19.5,12L19.43,13L21.54,14.63C21.73,14.78 21.79,15.05 21.66,15.27L19.66,18.73C19.54,18.95 19.27,19.04 19.05,18.95L16.56,17.95C16.04,18.34 15.5,18.68 14.87,18.93L14.5,21.58C14.46,21.82 14.25,22 14,22H10M11.25,4L10.88,6.61C9.68,6.86 8.62,7.5 7.85,8.39L5.44,7.35L4.69,8.65L6.8,10.2C6.4,11.37 6.4,12.64 6.8,13.8L4.68,15.36L5.43,16.66L7.86,15.62C8.63,16.5 9.68,17.14 10.87,17.38L11.24,20H12.76L13.13,17.39C14.32,17.14 15.37,16.5 16.14,15.62L18.57,16.66L19.32,15.36L17.2,13.81C17.6,12.64 17.6,11.37 17.2,10.2L19.31,8.65L18.56,7.35L16.15,8.39C15.38,7.5 14.32,6.86 13.12,6.62L12.75,4H11.25Z"></path></svg>Settings</a></li></ul>`
-	commentlist__73  = `<ul class="messages">`
-	commentlist__74  = `</ul>`
-	commentlist__75  = `<li class="`
-	commentlist__77  = `</li>`
-	commentlist__78  = `<div class="comment box flex" id="`
-	commentlist__82  = `</a>&nbsp;&nbsp;<span class="date">`
-	commentlist__83  = `</span><div class="content">`
-	commentlist__85  = `</div></div>`
-	commentlist__86  = `<h4 style="margin:0 0 10px"><a href="`
-	commentlist__87  = `" target="_blank">`
-	commentlist__88  = `</a></h4>`
-	commentlist__89  = `<div class="actions"><a class="edit-comment" href="#" data-id="`
-	commentlist__90  = `">Edit</a>&nbsp;&nbsp;<a class="delete-comment" href="#" data-id="`
-	commentlist__91  = `">Delete</a>`
-	commentlist__93  = `&nbsp;&nbsp;<a class="view-comment" href="`
-	commentlist__94  = `" target="_blank">View</a>`
-	commentlist__95  = `&nbsp;&nbsp;<a class="post-comments" href="`
-	commentlist__96  = `">All Post comments</a>&nbsp;&nbsp;<a class="post-comments" href="`
-	commentlist__97  = `">All User comments</a>`
-	commentlist__98  = `<form method="post" action="`
-	commentlist__99  = `"><input type="hidden" name="post_id" value="`
-	commentlist__100 = `"/><textarea name="content" placeholder="Write your comment here...">`
-	commentlist__101 = `</textarea><button type="submit">Update</button></form>`
-	commentlist__103 = `" class="`
-	commentlist__105 = `</a></li>`
-	commentlist__106 = `<a href="`
-	commentlist__107 = `">Login</a><a href="`
-	commentlist__108 = `">Register</a>`
-	commentlist__119 = `<h2 class="header"><a href="`
-	commentlist__120 = `">Manage</a></h2><ul class="manage-features"><li><a href="`
-	commentlist__121 = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M9,1H19A2,2 0 0,1 21,3V19L19,18.13V3H7A2,2 0 0,1 9,1M15,20V7H5V20L10,17.82L15,20M15,5C16.11,5 17,5.9 17,7V23L10,20L3,23V7A2,2 0 0,1 5,5H15Z"></path></svg>Topics</a></li><li><a href="`
-	commentlist__122 = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M20 5L20 19L4 19L4 5H20M20 3H4C2.89 3 2 3.89 2 5V19C2 20.11 2.89 21 4 21H20C21.11 21 22 20.11 22 19V5C22 3.89 21.11 3 20 3M18 15H6V17H18V15M10 7H6V13H10V7M12 9H18V7H12V9M18 11H12V13H18V11Z"></path></svg>Posts</a></li><li><a href="`
-	commentlist__123 = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M14,2H6A2,2 0 0,0 4,4V20A2,2 0 0,0 6,22H18A2,2 0 0,0 20,20V8L14,2M18,20H6V4H13V9H18V20Z"></path></svg>Pages</a></li><li><a href="`
-	commentlist__124 = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M17 14.4C17.6 14.4 18.1 14.9 18.1 15.5S17.6 16.6 17 16.6 15.9 16.1 15.9 15.5 16.4 14.4 17 14.4M17 17.5C16.3 17.5 14.8 17.9 14.8 18.6C15.3 19.3 16.1 19.8 17 19.8S18.7 19.3 19.2 18.6C19.2 17.9 17.7 17.5 17 17.5M18 11.1V6.3L10.5 3L3 6.3V11.2C3 15.7 6.2 20 10.5 21C11.1 20.9 11.6 20.7 12.1 20.5C13.2 22 15 23 17 23C20.3 23 23 20.3 23 17C23 14 20.8 11.6 18 11.1M11 17C11 17.6 11.1 18.1 11.2 18.6C11 18.7 10.7 18.8 10.5 18.9C7.3 17.9 5 14.7 5 11.2V7.6L10.5 5.2L16 7.6V11.1C13.2 11.6 11 14 11 17M17 21C14.8 21 13 19.2 13 17S14.8 13 17 13 21 14.8 21 17 19.2 21 17 21Z"></path></svg>Roles</a></li><li><a href="`
-	commentlist__125 = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M13.07 10.41A5 5 0 0 0 13.07 4.59A3.39 3.39 0 0 1 15 4A3.5 3.5 0 0 1 15 11A3.39 3.39 0 0 1 13.07 10.41M5.5 7.5A3.5 3.5 0 1 1 9 11A3.5 3.5 0 0 1 5.5 7.5M7.5 7.5A1.5 1.5 0 1 0 9 6A1.5 1.5 0 0 0 7.5 7.5M16 17V19H2V17S2 13 9 13 16 17 16 17M14 17C13.86 16.22 12.67 15 9 15S4.07 16.31 4 17M15.95 13A5.32 5.32 0 0 1 18 17V19H22V17S22 13.37 15.94 13Z"></path></svg>Users</a></li><li><a href="`
-	commentlist__126 = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M20 2H4C2.9 2 2 2.9 2 4V22L6 18H20C21.1 18 22 17.1 22 16V4C22 2.9 21.1 2 20 2M20 16H5.2L4 17.2V4H20V16Z"></path></svg>Comments</a></li><li><a href="`
-	commentlist__127 = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M21,17H7V3H21M21,1H7A2,2 0 0,0 5,3V17A2,2 0 0,0 7,19H21A2,2 0 0,0 23,17V3A2,2 0 0,0 21,1M3,5H1V21A2,2 0 0,0 3,23H19V21H3M15.96,10.29L13.21,13.83L11.25,11.47L8.5,15H19.5L15.96,10.29Z"></path></svg>Files</a></li><li><a href="`
-	commentlist__132 = `</a>`
+	commentlist__1   = `<head><meta charset="utf-8"/><meta name="viewport" content="width=device-width, initial-scale=1.0, viewport-fit=cover"/><meta name="csrf-token" content="`
+	commentlist__2   = `"/><title>`
+	commentlist__3   = `</title><meta name="keywords" content="software development, devloper community"/><link rel="canonical" href="`
+	commentlist__4   = `"/><meta property="og:type" content="`
+	commentlist__5   = `"/><meta property="og:url" content="`
+	commentlist__6   = `"/><meta property="og:title" content="`
+	commentlist__7   = `"/><meta property="og:site_name" content="`
+	commentlist__8   = `"/><meta name="twitter:site" content="`
+	commentlist__9   = `"/><meta name="twitter:title" content="`
+	commentlist__10  = `"/><meta name="twitter:card" content="summary_large_image"/><meta name="apple-mobile-web-app-title" content="`
+	commentlist__11  = `"/><meta name="application-name" content="`
+	commentlist__12  = `"/><link rel="alternate" type="application/rss+xml" title="`
+	commentlist__13  = `" href="`
+	commentlist__14  = `"/>`
+	commentlist__15  = `</head><body><header><nav class="main container"><a class="logo" href="`
+	commentlist__16  = `" title="Home">`
+	commentlist__17  = `</a><form class="search-form" method="get" action="/search" accept-charset="UTF-8"><input class="search-input" type="text" name="q" placeholder="Search..." autocomplete="off" value="`
+	commentlist__18  = `"/><button class="search-btn" type="submit" aria-label="Search"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M9.5,3A6.5,6.5 0 0,1 16,9.5C16,11.11 15.41,12.59 14.44,13.73L14.71,14H15.5L20.5,19L19,20.5L14,15.5V14.71L13.73,14.44C12.59,15.41 11.11,16 9.5,16A6.5,6.5 0 0,1 3,9.5A6.5,6.5 0 0,1 9.5,3M9.5,5C7,5 5,7 5,9.5C5,12 7,14 9.5,14C12,14 14,12 14,9.5C14,7 12,5 9.5,5Z"></path></svg></button></form>`
+	commentlist__19  = `<ul><li class="search-mobile"><a href="`
+	commentlist__20  = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M9.5,3A6.5,6.5 0 0,1 16,9.5C16,11.11 15.41,12.59 14.44,13.73L14.71,14H15.5L20.5,19L19,20.5L14,15.5V14.71L13.73,14.44C12.59,15.41 11.11,16 9.5,16A6.5,6.5 0 0,1 3,9.5A6.5,6.5 0 0,1 9.5,3M9.5,5C7,5 5,7 5,9.5C5,12 7,14 9.5,14C12,14 14,12 14,9.5C14,7 12,5 9.5,5Z"></path></svg></a></li>`
+	commentlist__21  = `</ul><label class="menu-trigger"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M3,6H21V8H3V6M3,11H21V13H3V11M3,16H21V18H3V16Z"></path></svg></label></nav></header><div class="wrapper"><div class="container"><div class="layout two-left"><div class="left"><div class="box fixed-sidebar">`
+	commentlist__22  = `</div></div><main class="main"><div class="box"><h1>My Comments</h1>`
+	commentlist__23  = `<div class="comments">`
+	commentlist__24  = `</div>`
+	commentlist__25  = `<ul class="paginate">`
+	commentlist__26  = `</ul></div></main></div></div><div class="mobile-menu"><div class="menu-head">`
+	commentlist__27  = `<label class="menu-trigger menu-close"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M19,6.41L17.59,5L12,10.59L6.41,5L5,6.41L10.59,12L5,17.59L6.41,19L12,13.41L17.59,19L19,17.59L13.41,12L19,6.41Z"></path></svg></label></div>`
+	commentlist__28  = `<strong>Topics</strong><div class="menu-topics">`
+	commentlist__29  = `</div></div></div><div class="overlay menu-trigger"></div><footer><div class="container">`
+	commentlist__30  = `<div>`
+	commentlist__31  = `</div><p>Proudly powered by <a href="https://tetua.net" title="Tetua - CMS Platform for Blogging">Tetua</a></p></div></footer>`
+	commentlist__32  = `<script>listenDeleteNodeEvents('comment', '/comments', '/comments')</script></body></html>`
+	commentlist__33  = `<link rel="icon" type="image/png" href="`
+	commentlist__34  = `"/><link rel="apple-touch-icon" href="`
+	commentlist__36  = `<meta name="description" content="`
+	commentlist__37  = `"/><meta property="og:description" content="`
+	commentlist__38  = `"/><meta name="twitter:description" content="`
+	commentlist__40  = `<meta property="og:image" content="`
+	commentlist__41  = `"/><meta name="twitter:image:src" content="`
+	commentlist__43  = `<img src="`
+	commentlist__44  = `" alt="`
+	commentlist__46  = `<svg viewBox="0 0 24 24"><path fill="#164e63" d="M11,6.5V9.33L8.33,12L11,14.67V17.5L5.5,12M13,6.43L18.57,12L13,17.57V14.74L15.74,12L13,9.26M5,3C3.89,3 3,3.9 3,5V19A2,2 0 0,0 5,21H19A2,2 0 0,0 21,19V5A2,2 0 0,0 19,3H5Z"></path></svg>`
+	commentlist__47  = `<ul class="`
+	commentlist__48  = `">`
+	commentlist__49  = `</ul>`
+	commentlist__50  = `<li><a href="`
+	commentlist__52  = `</a>`
+	commentlist__53  = `</li>`
+	commentlist__54  = `<ul class="sub">`
+	commentlist__64  = `</a></li>`
+	commentlist__66  = `">Login</a></li><li><a href="`
+	commentlist__67  = `">Register</a></li>`
+	commentlist__69  = `">New</a></li><li><div class="user-menu"><a href="`
+	commentlist__70  = `" title="`
+	commentlist__72  = `</a><svg viewBox="0 0 24 24"><path fill="currentColor" d="M7.41,8.58L12,13.17L16.59,8.58L18,10L12,16L6,10L7.41,8.58Z"></path></svg><ul class="sub">`
+	commentlist__74  = `">Profile</a></li><li><a href="`
+	commentlist__75  = `">Posts</a></li><li><a href="`
+	commentlist__76  = `">Setting</a></li><li><a href="`
+	commentlist__77  = `">Logout</a></li></ul></div></li>`
+	commentlist__78  = `<img class="avatar" src="`
+	commentlist__81  = `<span class="avatar none"></span>`
+	commentlist__83  = `">Manage</a></li>`
+	commentlist__84  = `<div class="meta flex">`
+	commentlist__85  = `<div><a class="author" href="`
+	commentlist__87  = `</a><div class="stat flex"><span>`
+	commentlist__88  = `</span></div></div></div><ul class="manage-features"><li><a href="`
+	commentlist__89  = `"><svg style="width:24px;height:24px" viewBox="0 0 24 24"><path fill="currentColor" d="M12,20C7.59,20 4,16.41 4,12C4,7.59 7.59,4 12,4C16.41,4 20,7.59 20,12C20,16.41 16.41,20 12,20M12,2A10,10 0 0,0 2,12A10,10 0 0,0 12,22A10,10 0 0,0 22,12A10,10 0 0,0 12,2M13,7H11V11H7V13H11V17H13V13H17V11H13V7Z"></path></svg>New post</a></li><li><a href="`
+	commentlist__90  = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M20 5L20 19L4 19L4 5H20M20 3H4C2.89 3 2 3.89 2 5V19C2 20.11 2.89 21 4 21H20C21.11 21 22 20.11 22 19V5C22 3.89 21.11 3 20 3M18 15H6V17H18V15M10 7H6V13H10V7M12 9H18V7H12V9M18 11H12V13H18V11Z"></path></svg>My Posts</a></li><li><a href="`
+	commentlist__91  = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M20 2H4C2.9 2 2 2.9 2 4V22L6 18H20C21.1 18 22 17.1 22 16V4C22 2.9 21.1 2 20 2M20 16H5.2L4 17.2V4H20V16Z"></path></svg>My Comments</a></li><li><a href="`
+	commentlist__92  = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M21,17H7V3H21M21,1H7A2,2 0 0,0 5,3V17A2,2 0 0,0 7,19H21A2,2 0 0,0 23,17V3A2,2 0 0,0 21,1M3,5H1V21A2,2 0 0,0 3,23H19V21H3M15.96,10.29L13.21,13.83L11.25,11.47L8.5,15H19.5L15.96,10.29Z"></path></svg>My Files</a></li><li><a href="`
+	commentlist__93  = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M15,14C12.33,14 7,15.33 7,18V20H23V18C23,15.33 17.67,14 15,14M6,10V7H4V10H1V12H4V15H6V12H9V10M15,12A4,4 0 0,0 19,8A4,4 0 0,0 15,4A4,4 0 0,0 11,8A4,4 0 0,0 15,12Z"></path></svg>Invites</a></li><li><a href="`
+	commentlist__94  = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M12,8A4,4 0 0,1 16,12A4,4 0 0,1 12,16A4,4 0 0,1 8,12A4,4 0 0,1 12,8M12,10A2,2 0 0,0 10,12A2,2 0 0,0 12,14A2,2 0 0,0 14,12A2,2 0 0,0 12,10M10,22C9.75,22 9.54,21.82 9.5,21.58L9.13,18.93C8.5,18.68 7.96,18.34 7.44,17.94L4.95,18.95C4.73,19.03 4.46,18.95 4.34,18.73L2.34,15.27C2.21,15.05 2.27,14.78 2.46,14.63L4.57,12.97L4.5,12L4.57,11L2.46,9.37C2.27,9.22 2.21,8.95 2.34,8.73L4.34,5.27C4.46,5.05 4.73,4.96 4.95,5.05L7.44,6.05C7.96,5.66 8.5,5.32 9.13,5.07L9.5,2.42C9.54,2.18 9.75,2 10,2H14C14.25,2 14.46,2.18 14.5,2.42L14.87,5.07C15.5,5.32 16.04,5.66 16.56,6.05L19.05,5.05C19.27,4.96 19.54,5.05 19.66,5.27L21.66,8.73C21.79,8.95 21.73,9.22 21.54,9.37L19.43,11L19.5,12L19.43,13L21.54,14.63C21.73,14.78 21.79,15.05 21.66,15.27L19.66,18.73C19.54,18.95 19.27,19.04 19.05,18.95L16.56,17.95C16.04,18.34 15.5,18.68 14.87,18.93L14.5,21.58C14.46,21.82 14.25,22 14,22H10M11.25,4L10.88,6.61C9.68,6.86 8.62,7.5 7.85,8.39L5.44,7.35L4.69,8.65L6.8,10.2C6.4,11.37 6.4,12.64 6.8,13.8L4.68,15.36L5.43,16.66L7.86,15.62C8.63,16.5 9.68,17.14 10.87,17.38L11.24,20H12.76L13.13,17.39C14.32,17.14 15.37,16.5 16.14,15.62L18.57,16.66L19.32,15.36L17.2,13.81C17.6,12.64 17.6,11.37 17.2,10.2L19.31,8.65L18.56,7.35L16.15,8.39C15.38,7.5 14.32,6.86 13.12,6.62L12.75,4H11.25Z"></path></svg>Settings</a></li></ul>`
+	commentlist__95  = `<ul class="messages">`
+	commentlist__97  = `<li class="`
+	commentlist__100 = `<div class="comment box flex" id="`
+	commentlist__104 = `</a>&nbsp;&nbsp;<span class="date">`
+	commentlist__105 = `</span><div class="content">`
+	commentlist__107 = `</div></div>`
+	commentlist__108 = `<h4 style="margin:0 0 10px"><a href="`
+	commentlist__109 = `" target="_blank">`
+	commentlist__110 = `</a></h4>`
+	commentlist__111 = `<div class="actions"><a class="edit-comment" href="#" data-id="`
+	commentlist__112 = `">Edit</a>&nbsp;&nbsp;<a class="delete-comment" href="#" data-id="`
+	commentlist__113 = `">Delete</a>`
+	commentlist__115 = `&nbsp;&nbsp;<a class="view-comment" href="`
+	commentlist__116 = `" target="_blank">View</a>`
+	commentlist__117 = `&nbsp;&nbsp;<a class="post-comments" href="`
+	commentlist__118 = `">All Post comments</a>&nbsp;&nbsp;<a class="post-comments" href="`
+	commentlist__119 = `">All User comments</a>`
+	commentlist__120 = `<form method="post" action="`
+	commentlist__122 = `<input type="hidden" name="post_id" value="`
+	commentlist__123 = `"/><textarea name="content" placeholder="Write your comment here...">`
+	commentlist__124 = `</textarea><button type="submit">Update</button></form>`
+	commentlist__125 = `<input type="hidden" name="_csrf" value="`
+	commentlist__128 = `" class="`
+	commentlist__131 = `<a href="`
+	commentlist__132 = `">Login</a><a href="`
+	commentlist__133 = `">Register</a>`
+	commentlist__145 = `<h2 class="header"><a href="`
+	commentlist__146 = `">Manage</a></h2><ul class="manage-features"><li><a href="`
+	commentlist__147 = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M9,1H19A2,2 0 0,1 21,3V19L19,18.13V3H7A2,2 0 0,1 9,1M15,20V7H5V20L10,17.82L15,20M15,5C16.11,5 17,5.9 17,7V23L10,20L3,23V7A2,2 0 0,1 5,5H15Z"></path></svg>Topics</a></li><li><a href="`
+	commentlist__148 = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M20 5L20 19L4 19L4 5H20M20 3H4C2.89 3 2 3.89 2 5V19C2 20.11 2.89 21 4 21H20C21.11 21 22 20.11 22 19V5C22 3.89 21.11 3 20 3M18 15H6V17H18V15M10 7H6V13H10V7M12 9H18V7H12V9M18 11H12V13H18V11Z"></path></svg>Posts</a></li><li><a href="`
+	commentlist__149 = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M14,2H6A2,2 0 0,0 4,4V20A2,2 0 0,0 6,22H18A2,2 0 0,0 20,20V8L14,2M18,20H6V4H13V9H18V20Z"></path></svg>Pages</a></li><li><a href="`
+	commentlist__150 = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M17 14.4C17.6 14.4 18.1 14.9 18.1 15.5S17.6 16.6 17 16.6 15.9 16.1 15.9 15.5 16.4 14.4 17 14.4M17 17.5C16.3 17.5 14.8 17.9 14.8 18.6C15.3 19.3 16.1 19.8 17 19.8S18.7 19.3 19.2 18.6C19.2 17.9 17.7 17.5 17 17.5M18 11.1V6.3L10.5 3L3 6.3V11.2C3 15.7 6.2 20 10.5 21C11.1 20.9 11.6 20.7 12.1 20.5C13.2 22 15 23 17 23C20.3 23 23 20.3 23 17C23 14 20.8 11.6 18 11.1M11 17C11 17.6 11.1 18.1 11.2 18.6C11 18.7 10.7 18.8 10.5 18.9C7.3 17.9 5 14.7 5 11.2V7.6L10.5 5.2L16 7.6V11.1C13.2 11.6 11 14 11 17M17 21C14.8 21 13 19.2 13 17S14.8 13 17 13 21 14.8 21 17 19.2 21 17 21Z"></path></svg>Roles</a></li><li><a href="`
+	commentlist__151 = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M13.07 10.41A5 5 0 0 0 13.07 4.59A3.39 3.39 0 0 1 15 4A3.5 3.5 0 0 1 15 11A3.39 3.39 0 0 1 13.07 10.41M5.5 7.5A3.5 3.5 0 1 1 9 11A3.5 3.5 0 0 1 5.5 7.5M7.5 7.5A1.5 1.5 0 1 0 9 6A1.5 1.5 0 0 0 7.5 7.5M16 17V19H2V17S2 13 9 13 16 17 16 17M14 17C13.86 16.22 12.67 15 9 15S4.07 16.31 4 17M15.95 13A5.32 5.32 0 0 1 18 17V19H22V17S22 13.37 15.94 13Z"></path></svg>Users</a></li><li><a href="`
+	commentlist__152 = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M20 2H4C2.9 2 2 2.9 2 4V22L6 18H20C21.1 18 22 17.1 22 16V4C22 2.9 21.1 2 20 2M20 16H5.2L4 17.2V4H20V16Z"></path></svg>Comments</a></li><li><a href="`
+	commentlist__153 = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M21,17H7V3H21M21,1H7A2,2 0 0,0 5,3V17A2,2 0 0,0 7,19H21A2,2 0 0,0 23,17V3A2,2 0 0,0 21,1M3,5H1V21A2,2 0 0,0 3,23H19V21H3M15.96,10.29L13.21,13.83L11.25,11.47L8.5,15H19.5L15.96,10.29Z"></path></svg>Files</a></li><li><a href="`
+	commentlist__154 = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M12,8A4,4 0 0,1 16,12A4,4 0 0,1 12,16A4,4 0 0,1 8,12A4,4 0 0,1 12,8M12,10A2,2 0 0,0 10,12A2,2 0 0,0 12,14A2,2 0 0,0 14,12A2,2 0 0,0 12,10M10,22C9.75,22 9.54,21.82 9.5,21.58L9.13,18.93C8.5,18.68 7.96,18.34 7.44,17.94L4.95,18.95C4.73,19.03 4.46,18.95 4.34,18.73L2.34,15.27C2.21,15.05 2.27,14.78 2.46,14.63L4.57,12.97L4.5,12L4.57,11L2.46,9.37C2.27,9.22 2.21,8.95 2.34,8.73L4.34,5.27C4.46,5.05 4.73,4.96 4.95,5.05L7.44,6.05C7.96,5.66 8.5,5.32 9.13,5.07L9.5,2.42C9.54,2.18 9.75,2 10,2H14C14.25,2 14.46,2.18 14.5,2.42L14.87,5.07C15.5,5.32 16.04,5.66 16.56,6.05L19.05,5.05C19.27,4.96 19.54,5.05 19.66,5.27L21.66,8.73C21.79,8.95 21.73,9.22 21.54,9.37L19.43,11L19.5,12L19.43,13L21.54,14.63C21.73,14.78 21.79,15.05 21.66,15.27L19.66,18.73C19.54,18.95 19.27,19.04 19.05,18.95L16.56,17.95C16.04,18.34 15.5,18.68 14.87,18.93L14.5,21.58C14.46,21.82 14.25,22 14,22H10M11.25,4L10.88,6.61C9.68,6.86 8.62,7.5 7.85,8.39L5.44,7.35L4.69,8.65L6.8,10.2C6.4,11.37 6.4,12.64 6.8,13.8L4.68,15.36L5.43,16.66L7.86,15.62C8.63,16.5 9.68,17.14 10.87,17.38L11.24,20H12.76L13.13,17.39C14.32,17.14 15.37,16.5 16.14,15.62L18.57,16.66L19.32,15.36L17.2,13.81C17.6,12.64 17.6,11.37 17.2,10.2L19.31,8.65L18.56,7.35L16.15,8.39C15.38,7.5 14.32,6.86 13.12,6.62L12.75,4H11.25Z"></path></svg>Settings</a></li><li><a href="`
+	commentlist__155 = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M3,6H21V8H3V6M3,11H21V13H3V11M3,16H21V18H3V16Z"></path></svg>Menus</a></li><li><a href="`
+	commentlist__156 = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M5,3H19A2,2 0 0,1 21,5V19A2,2 0 0,1 19,21H5A2,2 0 0,1 3,19V5A2,2 0 0,1 5,3M5,5V19H19V5H5M7,7H17V9H7V7M7,11H17V13H7V11M7,15H14V17H7V15Z"></path></svg>Custom fields</a></li><li><a href="`
+	commentlist__157 = `"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M13.5,8H12V13L16.28,15.54L17,14.33L13.5,12.25V8M13,3A9,9 0 0,0 4,12H1L4.96,16.03L9,12H6A7,7 0 0,1 13,5A7,7 0 0,1 20,12A7,7 0 0,1 13,19C11.07,19 9.32,18.21 8.06,16.94L6.64,18.36C8.27,20 10.5,21 13,21A9,9 0 0,0 22,12A9,9 0 0,0 13,3"></path></svg>Audit log</a></li></ul>`
 )
 
 func CommentList(paginate *entities.Paginate[entities.Comment]) func(meta *entities.Meta, wr *bufio.Writer) {
@@ -127,146 +138,197 @@ func CommentList(paginate *entities.Paginate[entities.Comment]) func(meta *entit
 		var appName = config.Setting("app_name")
 		var appLogo = config.Setting("app_logo")
 		buffer.WriteString(commentlist__1)
-		WriteAll(title, true, buffer)
+		WriteAll(meta.CsrfToken, true, buffer)
 		buffer.WriteString(commentlist__2)
-		WriteAll(meta.Canonical, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__3)
-		WriteAll(meta.Type, true, buffer)
-		buffer.WriteString(commentlist__4)
 		WriteAll(meta.Canonical, true, buffer)
+		buffer.WriteString(commentlist__4)
+		WriteAll(meta.Type, true, buffer)
 		buffer.WriteString(commentlist__5)
-		WriteAll(title, true, buffer)
+		WriteAll(meta.Canonical, true, buffer)
 		buffer.WriteString(commentlist__6)
-		WriteAll(appName, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__7)
-		WriteAll(config.Setting("twitter_site"), true, buffer)
+		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__8)
-		WriteAll(title, true, buffer)
+		WriteAll(config.Setting("twitter_site"), true, buffer)
 		buffer.WriteString(commentlist__9)
-		WriteAll(appName, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__10)
 		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__11)
-		WriteAll(appName+" Feed", true, buffer)
+		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__12)
-		WriteAll(utils.Url("/feed"), true, buffer)
+		WriteAll(appName+" Feed", true, buffer)
 		buffer.WriteString(commentlist__13)
+		WriteAll(utils.Url("/feed"), true, buffer)
+		buffer.WriteString(commentlist__14)
 		if appLogo != "" {
-			buffer.WriteString(commentlist__30)
+			buffer.WriteString(commentlist__33)
 			WriteAll(appLogo, true, buffer)
-			buffer.WriteString(commentlist__31)
+			buffer.WriteString(commentlist__34)
 			WriteAll(appLogo, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		if meta.Description != "" {
-			buffer.WriteString(commentlist__33)
+			buffer.WriteString(commentlist__36)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__34)
+			buffer.WriteString(commentlist__37)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__35)
+			buffer.WriteString(commentlist__38)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		if meta.Image != "" {
-			buffer.WriteString(commentlist__37)
+			buffer.WriteString(commentlist__40)
 			WriteAll(meta.Image, true, buffer)
-			buffer.WriteString(commentlist__38)
+			buffer.WriteString(commentlist__41)
 			WriteAll(meta.Image, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		WriteAll(asset.CssFile("css/light.min.css"), false, buffer)
 		WriteAll(asset.CssFile("css/style.css"), false, buffer)
 		WriteAll(config.Setting("inject_header"), false, buffer)
-		buffer.WriteString(commentlist__14)
-		WriteAll(utils.Url(""), true, buffer)
 		buffer.WriteString(commentlist__15)
+		WriteAll(utils.Url(""), true, buffer)
+		buffer.WriteString(commentlist__16)
 		var logoUrl = config.Setting("app_logo")
 		if logoUrl != "" {
-			buffer.WriteString(commentlist__40)
+			buffer.WriteString(commentlist__43)
 			WriteAll(logoUrl, true, buffer)
-			buffer.WriteString(commentlist__41)
+			buffer.WriteString(commentlist__44)
 			WriteAll(config.Setting("app_name"), true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		} else {
-			buffer.WriteString(commentlist__43)
+			buffer.WriteString(commentlist__46)
 
 		}
-		buffer.WriteString(commentlist__16)
-		WriteAll(meta.Query, true, buffer)
 		buffer.WriteString(commentlist__17)
-		WriteAll(utils.Url("/search"), true, buffer)
+		WriteAll(meta.Query, true, buffer)
 		buffer.WriteString(commentlist__18)
 
+		{
+			var (
+				location = "header"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__19)
+		WriteAll(utils.Url("/search"), true, buffer)
+		buffer.WriteString(commentlist__20)
+
 		if meta.User == nil || meta.User.ID == 0 {
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(utils.Url("/login"), true, buffer)
-			buffer.WriteString(commentlist__45)
+			buffer.WriteString(commentlist__66)
 			WriteAll(utils.Url("/register"), true, buffer)
-			buffer.WriteString(commentlist__46)
+			buffer.WriteString(commentlist__67)
 
 		} else {
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(utils.Url("/posts/new"), true, buffer)
-			buffer.WriteString(commentlist__48)
+			buffer.WriteString(commentlist__69)
 			WriteAll(meta.User.Url(), true, buffer)
-			buffer.WriteString(commentlist__49)
+			buffer.WriteString(commentlist__70)
 			WriteAll(meta.User.Username, true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			if meta.User.AvatarImageUrl != "" {
-				buffer.WriteString(commentlist__57)
+				buffer.WriteString(commentlist__78)
 				WriteAll(meta.User.AvatarImageUrl, true, buffer)
-				buffer.WriteString(commentlist__41)
+				buffer.WriteString(commentlist__44)
 				WriteAll(meta.User.Username, true, buffer)
-				buffer.WriteString(commentlist__13)
+				buffer.WriteString(commentlist__14)
 			} else {
-				buffer.WriteString(commentlist__60)
+				buffer.WriteString(commentlist__81)
 
 			}
-			buffer.WriteString(commentlist__51)
+			buffer.WriteString(commentlist__72)
 
 			if meta.User != nil && meta.User.IsRoot() {
-				buffer.WriteString(commentlist__44)
+				buffer.WriteString(commentlist__50)
 				WriteAll(utils.Url("/manage"), true, buffer)
-				buffer.WriteString(commentlist__62)
+				buffer.WriteString(commentlist__83)
 
 			}
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(meta.User.Url(), true, buffer)
-			buffer.WriteString(commentlist__53)
+			buffer.WriteString(commentlist__74)
 			WriteAll(utils.Url("/posts"), true, buffer)
-			buffer.WriteString(commentlist__54)
+			buffer.WriteString(commentlist__75)
 			WriteAll(utils.Url("/settings"), true, buffer)
-			buffer.WriteString(commentlist__55)
+			buffer.WriteString(commentlist__76)
 			WriteAll(utils.Url("/logout"), true, buffer)
-			buffer.WriteString(commentlist__56)
+			buffer.WriteString(commentlist__77)
 
 		}
-		buffer.WriteString(commentlist__19)
+		buffer.WriteString(commentlist__21)
 
 		{
-			buffer.WriteString(commentlist__63)
+			buffer.WriteString(commentlist__84)
 			WriteAll(meta.User.AvatarElm("32", "32", false), false, buffer)
-			buffer.WriteString(commentlist__64)
+			buffer.WriteString(commentlist__85)
 			WriteAll(meta.User.Url(), true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			WriteAll(meta.User.Name(), true, buffer)
-			buffer.WriteString(commentlist__66)
+			buffer.WriteString(commentlist__87)
 			WriteAll("@"+meta.User.Username, true, buffer)
-			buffer.WriteString(commentlist__67)
+			buffer.WriteString(commentlist__88)
 			WriteAll(utils.Url("/posts/new"), true, buffer)
-			buffer.WriteString(commentlist__68)
+			buffer.WriteString(commentlist__89)
 			WriteAll(utils.Url("/posts"), true, buffer)
-			buffer.WriteString(commentlist__69)
+			buffer.WriteString(commentlist__90)
 			WriteAll(utils.Url("/comments"), true, buffer)
-			buffer.WriteString(commentlist__70)
+			buffer.WriteString(commentlist__91)
 			WriteAll(utils.Url("/files"), true, buffer)
-			buffer.WriteString(commentlist__71)
+			buffer.WriteString(commentlist__92)
+			WriteAll(utils.Url("/invites"), true, buffer)
+			buffer.WriteString(commentlist__93)
 			WriteAll(utils.Url("/settings"), true, buffer)
-			buffer.WriteString(commentlist__72)
+			buffer.WriteString(commentlist__94)
 
 		}
 
-		buffer.WriteString(commentlist__20)
+		buffer.WriteString(commentlist__22)
 
 		{
 			var (
@@ -274,20 +336,20 @@ func CommentList(paginate *entities.Paginate[entities.Comment]) func(meta *entit
 			)
 
 			if msgs.Length() > 0 {
-				buffer.WriteString(commentlist__73)
+				buffer.WriteString(commentlist__95)
 				var messages = msgs.Get()
 				for _, msg := range messages {
-					buffer.WriteString(commentlist__75)
+					buffer.WriteString(commentlist__97)
 					WriteAll(msg.Type, true, buffer)
-					buffer.WriteString(commentlist__50)
+					buffer.WriteString(commentlist__48)
 					WriteAll(msg.Message, true, buffer)
-					buffer.WriteString(commentlist__77)
+					buffer.WriteString(commentlist__53)
 				}
-				buffer.WriteString(commentlist__74)
+				buffer.WriteString(commentlist__49)
 			}
 		}
 
-		buffer.WriteString(commentlist__21)
+		buffer.WriteString(commentlist__23)
 		for _, comment := range paginate.Data {
 			{
 				var (
@@ -298,158 +360,220 @@ func CommentList(paginate *entities.Paginate[entities.Comment]) func(meta *entit
 				)
 
 				if extraInfo {
-					buffer.WriteString(commentlist__86)
+					buffer.WriteString(commentlist__108)
 					WriteAll(comment.Post.Url(), true, buffer)
-					buffer.WriteString(commentlist__87)
+					buffer.WriteString(commentlist__109)
 					WriteAll(comment.Post.Name, true, buffer)
-					buffer.WriteString(commentlist__88)
+					buffer.WriteString(commentlist__110)
 
 				}
-				buffer.WriteString(commentlist__78)
+				buffer.WriteString(commentlist__100)
 				WriteEscString(fmt.Sprintf("comment-%d", comment.ID), buffer)
-				buffer.WriteString(commentlist__50)
+				buffer.WriteString(commentlist__48)
 				WriteAll(comment.User.AvatarElm("30", "30", false), false, buffer)
-				buffer.WriteString(commentlist__64)
+				buffer.WriteString(commentlist__85)
 				WriteAll(comment.User.Url(), true, buffer)
-				buffer.WriteString(commentlist__50)
+				buffer.WriteString(commentlist__48)
 				WriteAll(comment.User.Name(), true, buffer)
-				buffer.WriteString(commentlist__82)
+				buffer.WriteString(commentlist__104)
 				WriteAll(comment.CreatedAt.Format("January 2, 2006 15:04 MST"), true, buffer)
-				buffer.WriteString(commentlist__83)
+				buffer.WriteString(commentlist__105)
 				WriteAll(comment.ContentHTML, false, buffer)
 				if editCondition {
-					buffer.WriteString(commentlist__89)
+					buffer.WriteString(commentlist__111)
 					WriteAll(comment.ID, true, buffer)
-					buffer.WriteString(commentlist__90)
+					buffer.WriteString(commentlist__112)
 					WriteAll(comment.ID, true, buffer)
-					buffer.WriteString(commentlist__91)
+					buffer.WriteString(commentlist__113)
 
 					if extraInfo {
 						var commentUrl = fmt.Sprintf("%s#comment-%d", comment.Post.Url(), comment.ID)
 						var postCommentsUrl = fmt.Sprintf("/manage/comments?post=%d", postID)
 						var userCommentsUrl = fmt.Sprintf("/manage/comments?user=%d", postID)
-						buffer.WriteString(commentlist__93)
+						buffer.WriteString(commentlist__115)
 						WriteEscString(commentUrl, buffer)
-						buffer.WriteString(commentlist__94)
+						buffer.WriteString(commentlist__116)
 
 						if meta.User.IsRoot() {
-							buffer.WriteString(commentlist__95)
+							buffer.WriteString(commentlist__117)
 							WriteEscString(postCommentsUrl, buffer)
-							buffer.WriteString(commentlist__96)
+							buffer.WriteString(commentlist__118)
 							WriteEscString(userCommentsUrl, buffer)
-							buffer.WriteString(commentlist__97)
+							buffer.WriteString(commentlist__119)
 
 						}
 					}
-					buffer.WriteString(commentlist__22)
+					buffer.WriteString(commentlist__24)
 				}
-				buffer.WriteString(commentlist__22)
+				buffer.WriteString(commentlist__24)
 				if editCondition {
-					buffer.WriteString(commentlist__98)
+					buffer.WriteString(commentlist__120)
 					WriteEscString(fmt.Sprintf("/comments/%d", comment.ID), buffer)
-					buffer.WriteString(commentlist__99)
+					buffer.WriteString(commentlist__48)
+					{
+						buffer.WriteString(commentlist__125)
+						WriteAll(meta.CsrfToken, true, buffer)
+						buffer.WriteString(commentlist__14)
+					}
+
+					buffer.WriteString(commentlist__122)
 					WriteAll(postID, true, buffer)
-					buffer.WriteString(commentlist__100)
+					buffer.WriteString(commentlist__123)
 					WriteAll(comment.Content, true, buffer)
-					buffer.WriteString(commentlist__101)
+					buffer.WriteString(commentlist__124)
 
 				}
-				buffer.WriteString(commentlist__85)
+				buffer.WriteString(commentlist__107)
 			}
 
 		}
-		buffer.WriteString(commentlist__22)
+		buffer.WriteString(commentlist__24)
 		var links = paginate.Links()
-		buffer.WriteString(commentlist__23)
+		buffer.WriteString(commentlist__25)
 		for _, link := range links {
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(link.Link, true, buffer)
-			buffer.WriteString(commentlist__103)
+			buffer.WriteString(commentlist__128)
 			WriteAll(link.Class, true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			WriteAll(link.Label, true, buffer)
-			buffer.WriteString(commentlist__105)
+			buffer.WriteString(commentlist__64)
 
 		}
-		buffer.WriteString(commentlist__24)
+		buffer.WriteString(commentlist__26)
 		WriteAll(config.Setting("app_name"), true, buffer)
-		buffer.WriteString(commentlist__25)
+		buffer.WriteString(commentlist__27)
 
 		if meta.User == nil || meta.User.ID == 0 {
-			buffer.WriteString(commentlist__106)
+			buffer.WriteString(commentlist__131)
 			WriteAll(utils.Url("/login"), true, buffer)
-			buffer.WriteString(commentlist__107)
+			buffer.WriteString(commentlist__132)
 			WriteAll(utils.Url("/register"), true, buffer)
-			buffer.WriteString(commentlist__108)
+			buffer.WriteString(commentlist__133)
 
 		} else {
 			{
-				buffer.WriteString(commentlist__63)
+				buffer.WriteString(commentlist__84)
 				WriteAll(meta.User.AvatarElm("32", "32", false), false, buffer)
-				buffer.WriteString(commentlist__64)
+				buffer.WriteString(commentlist__85)
 				WriteAll(meta.User.Url(), true, buffer)
-				buffer.WriteString(commentlist__50)
+				buffer.WriteString(commentlist__48)
 				WriteAll(meta.User.Name(), true, buffer)
-				buffer.WriteString(commentlist__66)
+				buffer.WriteString(commentlist__87)
 				WriteAll("@"+meta.User.Username, true, buffer)
-				buffer.WriteString(commentlist__67)
+				buffer.WriteString(commentlist__88)
 				WriteAll(utils.Url("/posts/new"), true, buffer)
-				buffer.WriteString(commentlist__68)
+				buffer.WriteString(commentlist__89)
 				WriteAll(utils.Url("/posts"), true, buffer)
-				buffer.WriteString(commentlist__69)
+				buffer.WriteString(commentlist__90)
 				WriteAll(utils.Url("/comments"), true, buffer)
-				buffer.WriteString(commentlist__70)
+				buffer.WriteString(commentlist__91)
 				WriteAll(utils.Url("/files"), true, buffer)
-				buffer.WriteString(commentlist__71)
+				buffer.WriteString(commentlist__92)
+				WriteAll(utils.Url("/invites"), true, buffer)
+				buffer.WriteString(commentlist__93)
 				WriteAll(utils.Url("/settings"), true, buffer)
-				buffer.WriteString(commentlist__72)
+				buffer.WriteString(commentlist__94)
 
 			}
 
 			if meta.User.IsRoot() {
 				{
-					buffer.WriteString(commentlist__119)
+					buffer.WriteString(commentlist__145)
 					WriteAll(utils.Url("/manage"), true, buffer)
-					buffer.WriteString(commentlist__120)
+					buffer.WriteString(commentlist__146)
 					WriteAll(utils.Url("/manage/topics"), true, buffer)
-					buffer.WriteString(commentlist__121)
+					buffer.WriteString(commentlist__147)
 					WriteAll(utils.Url("/manage/posts"), true, buffer)
-					buffer.WriteString(commentlist__122)
+					buffer.WriteString(commentlist__148)
 					WriteAll(utils.Url("/manage/pages"), true, buffer)
-					buffer.WriteString(commentlist__123)
+					buffer.WriteString(commentlist__149)
 					WriteAll(utils.Url("/manage/roles"), true, buffer)
-					buffer.WriteString(commentlist__124)
+					buffer.WriteString(commentlist__150)
 					WriteAll(utils.Url("/manage/users"), true, buffer)
-					buffer.WriteString(commentlist__125)
+					buffer.WriteString(commentlist__151)
 					WriteAll(utils.Url("/manage/comments"), true, buffer)
-					buffer.WriteString(commentlist__126)
+					buffer.WriteString(commentlist__152)
 					WriteAll(utils.Url("/manage/files"), true, buffer)
-					buffer.WriteString(commentlist__127)
+					buffer.WriteString(commentlist__153)
 					WriteAll(utils.Url("/manage/settings"), true, buffer)
-					buffer.WriteString(commentlist__72)
+					buffer.WriteString(commentlist__154)
+					WriteAll(utils.Url("/manage/menus"), true, buffer)
+					buffer.WriteString(commentlist__155)
+					WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+					buffer.WriteString(commentlist__156)
+					WriteAll(utils.Url("/manage/audit"), true, buffer)
+					buffer.WriteString(commentlist__157)
 
 				}
 
 			}
 		}
-		buffer.WriteString(commentlist__26)
+		buffer.WriteString(commentlist__28)
 
-		for _, topic := range cache.Topics {
-			buffer.WriteString(commentlist__106)
+		for _, topic := range cache.Topics() {
+			buffer.WriteString(commentlist__131)
 			WriteAll(topic.Url(), true, buffer)
-			buffer.WriteString(commentlist__49)
+			buffer.WriteString(commentlist__70)
 			WriteAll(topic.Name, true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			WriteAll("#"+topic.Name, true, buffer)
-			buffer.WriteString(commentlist__132)
+			buffer.WriteString(commentlist__52)
 		}
-		buffer.WriteString(commentlist__27)
+		buffer.WriteString(commentlist__29)
+
+		{
+			var (
+				location = "footer"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__30)
 		WriteAll(config.Setting("footer_content"), false, buffer)
-		buffer.WriteString(commentlist__28)
+		buffer.WriteString(commentlist__31)
 		WriteAll(config.Setting("inject_footer"), false, buffer)
 		WriteAll(asset.JsFile("js/layout.js"), false, buffer)
 		WriteAll(asset.JsFile("js/main.js"), false, buffer)
-		buffer.WriteString(commentlist__29)
+		buffer.WriteString(commentlist__32)
 
 	}
 }
@@ -0,0 +1,528 @@
+// Code generated by "jade.go"; DO NOT EDIT.
+
+package views
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/ngocphuongnb/tetua/app/asset"
+	"github.com/ngocphuongnb/tetua/app/cache"
+	"github.com/ngocphuongnb/tetua/app/config"
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/utils"
+)
+
+const (
+	fileedit__21  = `</ul><label class="menu-trigger"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M3,6H21V8H3V6M3,11H21V13H3V11M3,16H21V18H3V16Z"></path></svg></label></nav></header><div class="wrapper"><div class="container"><form method="POST">`
+	fileedit__22  = `<div class="layout"><div class="left"><div class="box fixed-sidebar">`
+	fileedit__23  = `</div></div><div class="main"><div class="box"><h1>`
+	fileedit__25  = `<div class="file-preview"><a href="`
+	fileedit__27  = `</a></div>`
+	fileedit__28  = `</div></div><div class="right"><div class="box fixed-sidebar"><div class="save-actions"><button>Save</button></div><ul class="file-info">`
+	fileedit__29  = `<li><strong>Type:&nbsp;</strong>`
+	fileedit__30  = `</li><li><strong>Size:&nbsp;</strong>`
+	fileedit__32  = `</ul><div><strong>Used in</strong>`
+	fileedit__33  = `</div></div></div></div></form></div><div class="mobile-menu"><div class="menu-head">`
+	fileedit__112 = `<p><label>`
+	fileedit__113 = `</label><input name="`
+	fileedit__114 = `" value="`
+	fileedit__115 = `"/></p>`
+	fileedit__121 = `</label><textarea name="`
+	fileedit__123 = `</textarea></p>`
+	fileedit__128 = `<li><strong>Original name:&nbsp;</strong>`
+	fileedit__130 = `<li><strong>Dimensions:&nbsp;</strong>`
+	fileedit__132 = `<li><strong>Uploaded:&nbsp;</strong>`
+	fileedit__134 = `<p>Not used anywhere</p>`
+	fileedit__135 = `<ul class="file-usages">`
+	fileedit__137 = `<li><span class="status">`
+	fileedit__138 = `</span>&nbsp;<a href="`
+)
+
+func FileEdit(file *entities.File) func(meta *entities.Meta, wr *bufio.Writer) {
+	return func(meta *entities.Meta, wr *bufio.Writer) {
+		buffer := &WriterAsBuffer{wr}
+
+		buffer.WriteString(commentlist__0)
+
+		var title = meta.GetTitle()
+		var appName = config.Setting("app_name")
+		var appLogo = config.Setting("app_logo")
+		buffer.WriteString(commentlist__1)
+		WriteAll(meta.CsrfToken, true, buffer)
+		buffer.WriteString(commentlist__2)
+		WriteAll(title, true, buffer)
+		buffer.WriteString(commentlist__3)
+		WriteAll(meta.Canonical, true, buffer)
+		buffer.WriteString(commentlist__4)
+		WriteAll(meta.Type, true, buffer)
+		buffer.WriteString(commentlist__5)
+		WriteAll(meta.Canonical, true, buffer)
+		buffer.WriteString(commentlist__6)
+		WriteAll(title, true, buffer)
+		buffer.WriteString(commentlist__7)
+		WriteAll(appName, true, buffer)
+		buffer.WriteString(commentlist__8)
+		WriteAll(config.Setting("twitter_site"), true, buffer)
+		buffer.WriteString(commentlist__9)
+		WriteAll(title, true, buffer)
+		buffer.WriteString(commentlist__10)
+		WriteAll(appName, true, buffer)
+		buffer.WriteString(commentlist__11)
+		WriteAll(appName, true, buffer)
+		buffer.WriteString(commentlist__12)
+		WriteAll(appName+" Feed", true, buffer)
+		buffer.WriteString(commentlist__13)
+		WriteAll(utils.Url("/feed"), true, buffer)
+		buffer.WriteString(commentlist__14)
+		if appLogo != "" {
+			buffer.WriteString(commentlist__33)
+			WriteAll(appLogo, true, buffer)
+			buffer.WriteString(commentlist__34)
+			WriteAll(appLogo, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+		if meta.Description != "" {
+			buffer.WriteString(commentlist__36)
+			WriteAll(meta.Description, true, buffer)
+			buffer.WriteString(commentlist__37)
+			WriteAll(meta.Description, true, buffer)
+			buffer.WriteString(commentlist__38)
+			WriteAll(meta.Description, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+		if meta.Image != "" {
+			buffer.WriteString(commentlist__40)
+			WriteAll(meta.Image, true, buffer)
+			buffer.WriteString(commentlist__41)
+			WriteAll(meta.Image, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+		WriteAll(asset.CssFile("css/light.min.css"), false, buffer)
+		WriteAll(asset.CssFile("css/style.css"), false, buffer)
+		WriteAll(config.Setting("inject_header"), false, buffer)
+		buffer.WriteString(commentlist__15)
+		WriteAll(utils.Url(""), true, buffer)
+		buffer.WriteString(commentlist__16)
+		var logoUrl = config.Setting("app_logo")
+		if logoUrl != "" {
+			buffer.WriteString(commentlist__43)
+			WriteAll(logoUrl, true, buffer)
+			buffer.WriteString(commentlist__44)
+			WriteAll(config.Setting("app_name"), true, buffer)
+			buffer.WriteString(commentlist__14)
+		} else {
+			buffer.WriteString(commentlist__46)
+
+		}
+		buffer.WriteString(commentlist__17)
+		WriteAll(meta.Query, true, buffer)
+		buffer.WriteString(commentlist__18)
+
+		{
+			var (
+				location = "header"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__19)
+		WriteAll(utils.Url("/search"), true, buffer)
+		buffer.WriteString(commentlist__20)
+
+		if meta.User == nil || meta.User.ID == 0 {
+			buffer.WriteString(commentlist__50)
+			WriteAll(utils.Url("/login"), true, buffer)
+			buffer.WriteString(commentlist__66)
+			WriteAll(utils.Url("/register"), true, buffer)
+			buffer.WriteString(commentlist__67)
+
+		} else {
+			buffer.WriteString(commentlist__50)
+			WriteAll(utils.Url("/posts/new"), true, buffer)
+			buffer.WriteString(commentlist__69)
+			WriteAll(meta.User.Url(), true, buffer)
+			buffer.WriteString(commentlist__70)
+			WriteAll(meta.User.Username, true, buffer)
+			buffer.WriteString(commentlist__48)
+			if meta.User.AvatarImageUrl != "" {
+				buffer.WriteString(commentlist__78)
+				WriteAll(meta.User.AvatarImageUrl, true, buffer)
+				buffer.WriteString(commentlist__44)
+				WriteAll(meta.User.Username, true, buffer)
+				buffer.WriteString(commentlist__14)
+			} else {
+				buffer.WriteString(commentlist__81)
+
+			}
+			buffer.WriteString(commentlist__72)
+
+			if meta.User != nil && meta.User.IsRoot() {
+				buffer.WriteString(commentlist__50)
+				WriteAll(utils.Url("/manage"), true, buffer)
+				buffer.WriteString(commentlist__83)
+
+			}
+			buffer.WriteString(commentlist__50)
+			WriteAll(meta.User.Url(), true, buffer)
+			buffer.WriteString(commentlist__74)
+			WriteAll(utils.Url("/posts"), true, buffer)
+			buffer.WriteString(commentlist__75)
+			WriteAll(utils.Url("/settings"), true, buffer)
+			buffer.WriteString(commentlist__76)
+			WriteAll(utils.Url("/logout"), true, buffer)
+			buffer.WriteString(commentlist__77)
+
+		}
+		buffer.WriteString(fileedit__21)
+
+		{
+			buffer.WriteString(commentlist__125)
+			WriteAll(meta.CsrfToken, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+
+		buffer.WriteString(fileedit__22)
+
+		{
+			buffer.WriteString(commentlist__84)
+			WriteAll(meta.User.AvatarElm("32", "32", false), false, buffer)
+			buffer.WriteString(commentlist__85)
+			WriteAll(meta.User.Url(), true, buffer)
+			buffer.WriteString(commentlist__48)
+			WriteAll(meta.User.Name(), true, buffer)
+			buffer.WriteString(commentlist__87)
+			WriteAll("@"+meta.User.Username, true, buffer)
+			buffer.WriteString(commentlist__88)
+			WriteAll(utils.Url("/posts/new"), true, buffer)
+			buffer.WriteString(commentlist__89)
+			WriteAll(utils.Url("/posts"), true, buffer)
+			buffer.WriteString(commentlist__90)
+			WriteAll(utils.Url("/comments"), true, buffer)
+			buffer.WriteString(commentlist__91)
+			WriteAll(utils.Url("/files"), true, buffer)
+			buffer.WriteString(commentlist__92)
+			WriteAll(utils.Url("/invites"), true, buffer)
+			buffer.WriteString(commentlist__93)
+			WriteAll(utils.Url("/settings"), true, buffer)
+			buffer.WriteString(commentlist__94)
+
+		}
+
+		buffer.WriteString(fileedit__23)
+		WriteAll(file.Name(), true, buffer)
+		buffer.WriteString(error__22)
+		{
+			var (
+				msgs = meta.Messages
+			)
+
+			if msgs.Length() > 0 {
+				buffer.WriteString(commentlist__95)
+				var messages = msgs.Get()
+				for _, msg := range messages {
+					buffer.WriteString(commentlist__97)
+					WriteAll(msg.Type, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(msg.Message, true, buffer)
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(fileedit__25)
+		WriteAll(file.PreviewUrl(), true, buffer)
+		buffer.WriteString(commentlist__109)
+		if file.IsImage() {
+			buffer.WriteString(commentlist__43)
+			WriteAll(file.PreviewUrl("large"), true, buffer)
+			buffer.WriteString(commentlist__44)
+			WriteAll(file.Alt, true, buffer)
+			buffer.WriteString(commentlist__14)
+		} else {
+			WriteAll(file.Url(), true, buffer)
+		}
+		buffer.WriteString(fileedit__27)
+
+		{
+			var (
+				name  = "title"
+				value = file.Title
+				label = "Title"
+			)
+
+			buffer.WriteString(fileedit__112)
+			WriteEscString(label, buffer)
+			buffer.WriteString(fileedit__113)
+			WriteEscString(name, buffer)
+			buffer.WriteString(fileedit__114)
+			WriteAll(value, true, buffer)
+			buffer.WriteString(fileedit__115)
+		}
+
+		{
+			var (
+				name  = "alt"
+				value = file.Alt
+				label = "Alt text"
+			)
+
+			buffer.WriteString(fileedit__112)
+			WriteEscString(label, buffer)
+			buffer.WriteString(fileedit__113)
+			WriteEscString(name, buffer)
+			buffer.WriteString(fileedit__114)
+			WriteAll(value, true, buffer)
+			buffer.WriteString(fileedit__115)
+		}
+
+		{
+			var (
+				name  = "caption"
+				value = file.Caption
+				label = "Caption"
+			)
+
+			buffer.WriteString(fileedit__112)
+			WriteEscString(label, buffer)
+			buffer.WriteString(fileedit__121)
+			WriteEscString(name, buffer)
+			buffer.WriteString(commentlist__48)
+			WriteAll(value, true, buffer)
+			buffer.WriteString(fileedit__123)
+		}
+
+		{
+			var (
+				name  = "tags"
+				value = strings.Join(file.Tags, ", ")
+				label = "Tags (comma separated)"
+			)
+
+			buffer.WriteString(fileedit__112)
+			WriteEscString(label, buffer)
+			buffer.WriteString(fileedit__113)
+			WriteEscString(name, buffer)
+			buffer.WriteString(fileedit__114)
+			WriteAll(value, true, buffer)
+			buffer.WriteString(fileedit__115)
+		}
+
+		buffer.WriteString(fileedit__28)
+
+		if file.OriginalName != "" {
+			buffer.WriteString(fileedit__128)
+			WriteAll(file.OriginalName, true, buffer)
+			buffer.WriteString(commentlist__53)
+		}
+		buffer.WriteString(fileedit__29)
+		WriteAll(file.Type, true, buffer)
+		buffer.WriteString(fileedit__30)
+		WriteEscString(fmt.Sprintf("%.1f KB", float64(file.Size)/1024), buffer)
+		buffer.WriteString(commentlist__53)
+		if file.Width > 0 {
+			buffer.WriteString(fileedit__130)
+			WriteEscString(fmt.Sprintf("%dx%d", file.Width, file.Height), buffer)
+			buffer.WriteString(commentlist__53)
+		}
+		if file.CreatedAt != nil {
+			buffer.WriteString(fileedit__132)
+			WriteAll(file.CreatedAt.Format("2006-01-02 15:04"), true, buffer)
+			buffer.WriteString(commentlist__53)
+		}
+		buffer.WriteString(fileedit__32)
+
+		if len(file.Usages) == 0 {
+			buffer.WriteString(fileedit__134)
+
+		} else {
+			buffer.WriteString(fileedit__135)
+			for _, usage := range file.Usages {
+				buffer.WriteString(fileedit__137)
+				WriteAll(usage.Type, true, buffer)
+				buffer.WriteString(fileedit__138)
+				WriteAll(usage.EditUrl(), true, buffer)
+				buffer.WriteString(commentlist__48)
+				WriteAll(usage.Name, true, buffer)
+				buffer.WriteString(commentlist__64)
+
+			}
+			buffer.WriteString(commentlist__49)
+		}
+		buffer.WriteString(fileedit__33)
+		WriteAll(config.Setting("app_name"), true, buffer)
+		buffer.WriteString(commentlist__27)
+
+		if meta.User == nil || meta.User.ID == 0 {
+			buffer.WriteString(commentlist__131)
+			WriteAll(utils.Url("/login"), true, buffer)
+			buffer.WriteString(commentlist__132)
+			WriteAll(utils.Url("/register"), true, buffer)
+			buffer.WriteString(commentlist__133)
+
+		} else {
+			{
+				buffer.WriteString(commentlist__84)
+				WriteAll(meta.User.AvatarElm("32", "32", false), false, buffer)
+				buffer.WriteString(commentlist__85)
+				WriteAll(meta.User.Url(), true, buffer)
+				buffer.WriteString(commentlist__48)
+				WriteAll(meta.User.Name(), true, buffer)
+				buffer.WriteString(commentlist__87)
+				WriteAll("@"+meta.User.Username, true, buffer)
+				buffer.WriteString(commentlist__88)
+				WriteAll(utils.Url("/posts/new"), true, buffer)
+				buffer.WriteString(commentlist__89)
+				WriteAll(utils.Url("/posts"), true, buffer)
+				buffer.WriteString(commentlist__90)
+				WriteAll(utils.Url("/comments"), true, buffer)
+				buffer.WriteString(commentlist__91)
+				WriteAll(utils.Url("/files"), true, buffer)
+				buffer.WriteString(commentlist__92)
+				WriteAll(utils.Url("/invites"), true, buffer)
+				buffer.WriteString(commentlist__93)
+				WriteAll(utils.Url("/settings"), true, buffer)
+				buffer.WriteString(commentlist__94)
+
+			}
+
+			if meta.User.IsRoot() {
+				{
+					buffer.WriteString(commentlist__145)
+					WriteAll(utils.Url("/manage"), true, buffer)
+					buffer.WriteString(commentlist__146)
+					WriteAll(utils.Url("/manage/topics"), true, buffer)
+					buffer.WriteString(commentlist__147)
+					WriteAll(utils.Url("/manage/posts"), true, buffer)
+					buffer.WriteString(commentlist__148)
+					WriteAll(utils.Url("/manage/pages"), true, buffer)
+					buffer.WriteString(commentlist__149)
+					WriteAll(utils.Url("/manage/roles"), true, buffer)
+					buffer.WriteString(commentlist__150)
+					WriteAll(utils.Url("/manage/users"), true, buffer)
+					buffer.WriteString(commentlist__151)
+					WriteAll(utils.Url("/manage/comments"), true, buffer)
+					buffer.WriteString(commentlist__152)
+					WriteAll(utils.Url("/manage/files"), true, buffer)
+					buffer.WriteString(commentlist__153)
+					WriteAll(utils.Url("/manage/settings"), true, buffer)
+					buffer.WriteString(commentlist__154)
+					WriteAll(utils.Url("/manage/menus"), true, buffer)
+					buffer.WriteString(commentlist__155)
+					WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+					buffer.WriteString(commentlist__156)
+					WriteAll(utils.Url("/manage/audit"), true, buffer)
+					buffer.WriteString(commentlist__157)
+
+				}
+
+			}
+		}
+		buffer.WriteString(commentlist__28)
+
+		for _, topic := range cache.Topics() {
+			buffer.WriteString(commentlist__131)
+			WriteAll(topic.Url(), true, buffer)
+			buffer.WriteString(commentlist__70)
+			WriteAll(topic.Name, true, buffer)
+			buffer.WriteString(commentlist__48)
+			WriteAll("#"+topic.Name, true, buffer)
+			buffer.WriteString(commentlist__52)
+		}
+		buffer.WriteString(commentlist__29)
+
+		{
+			var (
+				location = "footer"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__30)
+		WriteAll(config.Setting("footer_content"), false, buffer)
+		buffer.WriteString(commentlist__31)
+		WriteAll(config.Setting("inject_footer"), false, buffer)
+		WriteAll(asset.JsFile("js/layout.js"), false, buffer)
+		WriteAll(asset.JsFile("js/main.js"), false, buffer)
+		buffer.WriteString(error__29)
+
+	}
+}
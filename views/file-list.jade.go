@@ -4,6 +4,8 @@ package views
 
 import (
 	"bufio"
+	"fmt"
+	"strings"
 
 	"github.com/ngocphuongnb/tetua/app/asset"
 	"github.com/ngocphuongnb/tetua/app/cache"
@@ -13,16 +15,32 @@ import (
 )
 
 const (
-	filelist__20 = `</div></div><main class="main"><div class="box"><h1>My Files</h1>`
-	filelist__21 = `<div class="files-list">`
-	filelist__29 = `<script>listenDeleteNodeEvents('file', '/files', '/files')</script></body></html>`
-	filelist__78 = `<div><a href="`
-	filelist__79 = `" target="_blank"><img src="`
-	filelist__80 = `"/></a><div class="actions"><a class="delete-file" href="#" data-id="`
-	filelist__81 = `">Delete</a></div></div>`
+	filelist__22  = `</div></div><main class="main"><div class="box"><h1>My Files</h1>`
+	filelist__23  = `<div class="storage-usage">`
+	filelist__25  = `<div class="files-list">`
+	filelist__34  = `<script>listenDeleteNodeEvents('file', '/files', '/files')</script></body></html>`
+	filelist__102 = `<span>`
+	filelist__103 = `</span><progress max="100" value="`
+	filelist__104 = `"></progress>`
+	filelist__106 = `</span>`
+	filelist__111 = `<form class="search-form file-filter" method="get" action="" accept-charset="UTF-8" style="width: 100%;overflow:initial;"><input class="search-input" type="text" name="q" placeholder="Name, title or alt text..." value="`
+	filelist__112 = `" style="width: auto;flex-grow: 1;"/><select name="type" style="width:120px"><option value="">All types</option>`
+	filelist__113 = `</select><input type="text" name="tag" placeholder="Tag" value="`
+	filelist__114 = `" style="width:100px"/><input type="date" name="from" title="Uploaded from" value="`
+	filelist__115 = `" style="width:140px"/><input type="date" name="to" title="Uploaded to" value="`
+	filelist__116 = `" style="width:140px"/><button class="search-btn" type="submit" aria-label="Search files"><svg style="width:24px;height:24px" viewBox="0 0 24 24"><path fill="currentColor" d="M9.5,3A6.5,6.5 0 0,1 16,9.5C16,11.11 15.41,12.59 14.44,13.73L14.71,14H15.5L20.5,19L19,20.5L14,15.5V14.71L13.73,14.44C12.59,15.41 11.11,16 9.5,16A6.5,6.5 0 0,1 3,9.5A6.5,6.5 0 0,1 9.5,3M9.5,5C7,5 5,7 5,9.5C5,12 7,14 9.5,14C12,14 14,12 14,9.5C14,7 12,5 9.5,5Z"></path></svg></button></form>`
+	filelist__117 = `<option value="`
+	filelist__118 = `" selected="">`
+	filelist__119 = `</option>`
+	filelist__123 = `<div><a href="`
+	filelist__124 = `" target="_blank" title="`
+	filelist__126 = `</a><div class="actions"><a href="`
+	filelist__127 = `">Edit</a><a class="delete-file" href="#" data-id="`
+	filelist__128 = `">Delete</a></div></div>`
+	filelist__132 = `<span class="file-name">`
 )
 
-func FileList(paginate *entities.Paginate[entities.File]) func(meta *entities.Meta, wr *bufio.Writer) {
+func FileList(paginate *entities.Paginate[entities.File], filter *entities.FileFilter, used int, limits *entities.UploadLimits) func(meta *entities.Meta, wr *bufio.Writer) {
 	return func(meta *entities.Meta, wr *bufio.Writer) {
 		buffer := &WriterAsBuffer{wr}
 
@@ -32,146 +50,197 @@ func FileList(paginate *entities.Paginate[entities.File]) func(meta *entities.Me
 		var appName = config.Setting("app_name")
 		var appLogo = config.Setting("app_logo")
 		buffer.WriteString(commentlist__1)
-		WriteAll(title, true, buffer)
+		WriteAll(meta.CsrfToken, true, buffer)
 		buffer.WriteString(commentlist__2)
-		WriteAll(meta.Canonical, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__3)
-		WriteAll(meta.Type, true, buffer)
-		buffer.WriteString(commentlist__4)
 		WriteAll(meta.Canonical, true, buffer)
+		buffer.WriteString(commentlist__4)
+		WriteAll(meta.Type, true, buffer)
 		buffer.WriteString(commentlist__5)
-		WriteAll(title, true, buffer)
+		WriteAll(meta.Canonical, true, buffer)
 		buffer.WriteString(commentlist__6)
-		WriteAll(appName, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__7)
-		WriteAll(config.Setting("twitter_site"), true, buffer)
+		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__8)
-		WriteAll(title, true, buffer)
+		WriteAll(config.Setting("twitter_site"), true, buffer)
 		buffer.WriteString(commentlist__9)
-		WriteAll(appName, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__10)
 		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__11)
-		WriteAll(appName+" Feed", true, buffer)
+		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__12)
-		WriteAll(utils.Url("/feed"), true, buffer)
+		WriteAll(appName+" Feed", true, buffer)
 		buffer.WriteString(commentlist__13)
+		WriteAll(utils.Url("/feed"), true, buffer)
+		buffer.WriteString(commentlist__14)
 		if appLogo != "" {
-			buffer.WriteString(commentlist__30)
+			buffer.WriteString(commentlist__33)
 			WriteAll(appLogo, true, buffer)
-			buffer.WriteString(commentlist__31)
+			buffer.WriteString(commentlist__34)
 			WriteAll(appLogo, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		if meta.Description != "" {
-			buffer.WriteString(commentlist__33)
+			buffer.WriteString(commentlist__36)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__34)
+			buffer.WriteString(commentlist__37)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__35)
+			buffer.WriteString(commentlist__38)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		if meta.Image != "" {
-			buffer.WriteString(commentlist__37)
+			buffer.WriteString(commentlist__40)
 			WriteAll(meta.Image, true, buffer)
-			buffer.WriteString(commentlist__38)
+			buffer.WriteString(commentlist__41)
 			WriteAll(meta.Image, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		WriteAll(asset.CssFile("css/light.min.css"), false, buffer)
 		WriteAll(asset.CssFile("css/style.css"), false, buffer)
 		WriteAll(config.Setting("inject_header"), false, buffer)
-		buffer.WriteString(commentlist__14)
-		WriteAll(utils.Url(""), true, buffer)
 		buffer.WriteString(commentlist__15)
+		WriteAll(utils.Url(""), true, buffer)
+		buffer.WriteString(commentlist__16)
 		var logoUrl = config.Setting("app_logo")
 		if logoUrl != "" {
-			buffer.WriteString(commentlist__40)
+			buffer.WriteString(commentlist__43)
 			WriteAll(logoUrl, true, buffer)
-			buffer.WriteString(commentlist__41)
+			buffer.WriteString(commentlist__44)
 			WriteAll(config.Setting("app_name"), true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		} else {
-			buffer.WriteString(commentlist__43)
+			buffer.WriteString(commentlist__46)
 
 		}
-		buffer.WriteString(commentlist__16)
-		WriteAll(meta.Query, true, buffer)
 		buffer.WriteString(commentlist__17)
-		WriteAll(utils.Url("/search"), true, buffer)
+		WriteAll(meta.Query, true, buffer)
 		buffer.WriteString(commentlist__18)
 
+		{
+			var (
+				location = "header"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__19)
+		WriteAll(utils.Url("/search"), true, buffer)
+		buffer.WriteString(commentlist__20)
+
 		if meta.User == nil || meta.User.ID == 0 {
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(utils.Url("/login"), true, buffer)
-			buffer.WriteString(commentlist__45)
+			buffer.WriteString(commentlist__66)
 			WriteAll(utils.Url("/register"), true, buffer)
-			buffer.WriteString(commentlist__46)
+			buffer.WriteString(commentlist__67)
 
 		} else {
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(utils.Url("/posts/new"), true, buffer)
-			buffer.WriteString(commentlist__48)
+			buffer.WriteString(commentlist__69)
 			WriteAll(meta.User.Url(), true, buffer)
-			buffer.WriteString(commentlist__49)
+			buffer.WriteString(commentlist__70)
 			WriteAll(meta.User.Username, true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			if meta.User.AvatarImageUrl != "" {
-				buffer.WriteString(commentlist__57)
+				buffer.WriteString(commentlist__78)
 				WriteAll(meta.User.AvatarImageUrl, true, buffer)
-				buffer.WriteString(commentlist__41)
+				buffer.WriteString(commentlist__44)
 				WriteAll(meta.User.Username, true, buffer)
-				buffer.WriteString(commentlist__13)
+				buffer.WriteString(commentlist__14)
 			} else {
-				buffer.WriteString(commentlist__60)
+				buffer.WriteString(commentlist__81)
 
 			}
-			buffer.WriteString(commentlist__51)
+			buffer.WriteString(commentlist__72)
 
 			if meta.User != nil && meta.User.IsRoot() {
-				buffer.WriteString(commentlist__44)
+				buffer.WriteString(commentlist__50)
 				WriteAll(utils.Url("/manage"), true, buffer)
-				buffer.WriteString(commentlist__62)
+				buffer.WriteString(commentlist__83)
 
 			}
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(meta.User.Url(), true, buffer)
-			buffer.WriteString(commentlist__53)
+			buffer.WriteString(commentlist__74)
 			WriteAll(utils.Url("/posts"), true, buffer)
-			buffer.WriteString(commentlist__54)
+			buffer.WriteString(commentlist__75)
 			WriteAll(utils.Url("/settings"), true, buffer)
-			buffer.WriteString(commentlist__55)
+			buffer.WriteString(commentlist__76)
 			WriteAll(utils.Url("/logout"), true, buffer)
-			buffer.WriteString(commentlist__56)
+			buffer.WriteString(commentlist__77)
 
 		}
-		buffer.WriteString(commentlist__19)
+		buffer.WriteString(commentlist__21)
 
 		{
-			buffer.WriteString(commentlist__63)
+			buffer.WriteString(commentlist__84)
 			WriteAll(meta.User.AvatarElm("32", "32", false), false, buffer)
-			buffer.WriteString(commentlist__64)
+			buffer.WriteString(commentlist__85)
 			WriteAll(meta.User.Url(), true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			WriteAll(meta.User.Name(), true, buffer)
-			buffer.WriteString(commentlist__66)
+			buffer.WriteString(commentlist__87)
 			WriteAll("@"+meta.User.Username, true, buffer)
-			buffer.WriteString(commentlist__67)
+			buffer.WriteString(commentlist__88)
 			WriteAll(utils.Url("/posts/new"), true, buffer)
-			buffer.WriteString(commentlist__68)
+			buffer.WriteString(commentlist__89)
 			WriteAll(utils.Url("/posts"), true, buffer)
-			buffer.WriteString(commentlist__69)
+			buffer.WriteString(commentlist__90)
 			WriteAll(utils.Url("/comments"), true, buffer)
-			buffer.WriteString(commentlist__70)
+			buffer.WriteString(commentlist__91)
 			WriteAll(utils.Url("/files"), true, buffer)
-			buffer.WriteString(commentlist__71)
+			buffer.WriteString(commentlist__92)
+			WriteAll(utils.Url("/invites"), true, buffer)
+			buffer.WriteString(commentlist__93)
 			WriteAll(utils.Url("/settings"), true, buffer)
-			buffer.WriteString(commentlist__72)
+			buffer.WriteString(commentlist__94)
 
 		}
 
-		buffer.WriteString(filelist__20)
+		buffer.WriteString(filelist__22)
 
 		{
 			var (
@@ -179,123 +248,251 @@ func FileList(paginate *entities.Paginate[entities.File]) func(meta *entities.Me
 			)
 
 			if msgs.Length() > 0 {
-				buffer.WriteString(commentlist__73)
+				buffer.WriteString(commentlist__95)
 				var messages = msgs.Get()
 				for _, msg := range messages {
-					buffer.WriteString(commentlist__75)
+					buffer.WriteString(commentlist__97)
 					WriteAll(msg.Type, true, buffer)
-					buffer.WriteString(commentlist__50)
+					buffer.WriteString(commentlist__48)
 					WriteAll(msg.Message, true, buffer)
-					buffer.WriteString(commentlist__77)
+					buffer.WriteString(commentlist__53)
 				}
-				buffer.WriteString(commentlist__74)
+				buffer.WriteString(commentlist__49)
 			}
 		}
 
-		buffer.WriteString(filelist__21)
+		buffer.WriteString(filelist__23)
+		if limits.StorageQuota > 0 {
+			var percent = used * 100 / limits.StorageQuota
+			buffer.WriteString(filelist__102)
+			WriteEscString(fmt.Sprintf("Storage: %s of %s used", utils.FormatSize(used), utils.FormatSize(limits.StorageQuota)), buffer)
+			buffer.WriteString(filelist__103)
+			WriteAll(percent, true, buffer)
+			buffer.WriteString(filelist__104)
+
+		} else {
+			buffer.WriteString(filelist__102)
+			WriteEscString(fmt.Sprintf("Storage: %s used", utils.FormatSize(used)), buffer)
+			buffer.WriteString(filelist__106)
+		}
+		if limits.MaxFileSize > 0 {
+			buffer.WriteString(filelist__102)
+			WriteEscString(fmt.Sprintf("Max file size: %s", utils.FormatSize(limits.MaxFileSize)), buffer)
+			buffer.WriteString(filelist__106)
+		}
+		if len(limits.MimeGroups) > 0 {
+			buffer.WriteString(filelist__102)
+			WriteEscString(fmt.Sprintf("Allowed types: %s", strings.Join(limits.MimeGroups, ", ")), buffer)
+			buffer.WriteString(filelist__106)
+		}
+		buffer.WriteString(commentlist__24)
+		{
+			var (
+				filter = filter
+			)
+
+			buffer.WriteString(filelist__111)
+			WriteAll(filter.Search, true, buffer)
+			buffer.WriteString(filelist__112)
+
+			for _, fileType := range []string{"image", "video", "audio", "document"} {
+				if fileType == filter.Type() {
+					buffer.WriteString(filelist__117)
+					WriteEscString(fileType, buffer)
+					buffer.WriteString(filelist__118)
+					WriteEscString(fileType, buffer)
+					buffer.WriteString(filelist__119)
+				} else {
+					buffer.WriteString(filelist__117)
+					WriteEscString(fileType, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteEscString(fileType, buffer)
+					buffer.WriteString(filelist__119)
+				}
+			}
+			buffer.WriteString(filelist__113)
+			WriteAll(filter.Tag(), true, buffer)
+			buffer.WriteString(filelist__114)
+			WriteAll(filter.FromDate(), true, buffer)
+			buffer.WriteString(filelist__115)
+			WriteAll(filter.ToDate(), true, buffer)
+			buffer.WriteString(filelist__116)
+
+		}
+
+		buffer.WriteString(filelist__25)
 		for _, file := range paginate.Data {
-			var fileUrl = file.Url()
-			buffer.WriteString(filelist__78)
+			var fileUrl = file.PreviewUrl()
+			buffer.WriteString(filelist__123)
 			WriteAll(fileUrl, true, buffer)
-			buffer.WriteString(filelist__79)
-			WriteAll(fileUrl, true, buffer)
-			buffer.WriteString(filelist__80)
+			buffer.WriteString(filelist__124)
+			WriteAll(file.Name(), true, buffer)
+			buffer.WriteString(commentlist__48)
+			if file.IsImage() {
+				buffer.WriteString(commentlist__43)
+				WriteAll(file.PreviewUrl("thumbnail"), true, buffer)
+				buffer.WriteString(commentlist__44)
+				WriteAll(file.Alt, true, buffer)
+				buffer.WriteString(commentlist__14)
+			} else {
+				buffer.WriteString(filelist__132)
+				WriteAll(file.Name(), true, buffer)
+				buffer.WriteString(filelist__106)
+			}
+			buffer.WriteString(filelist__126)
+			WriteEscString(fmt.Sprintf("/files/%d", file.ID), buffer)
+			buffer.WriteString(filelist__127)
 			WriteAll(file.ID, true, buffer)
-			buffer.WriteString(filelist__81)
+			buffer.WriteString(filelist__128)
 
 		}
-		buffer.WriteString(commentlist__22)
+		buffer.WriteString(commentlist__24)
 		var links = paginate.Links()
-		buffer.WriteString(commentlist__23)
+		buffer.WriteString(commentlist__25)
 		for _, link := range links {
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(link.Link, true, buffer)
-			buffer.WriteString(commentlist__103)
+			buffer.WriteString(commentlist__128)
 			WriteAll(link.Class, true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			WriteAll(link.Label, true, buffer)
-			buffer.WriteString(commentlist__105)
+			buffer.WriteString(commentlist__64)
 
 		}
-		buffer.WriteString(commentlist__24)
+		buffer.WriteString(commentlist__26)
 		WriteAll(config.Setting("app_name"), true, buffer)
-		buffer.WriteString(commentlist__25)
+		buffer.WriteString(commentlist__27)
 
 		if meta.User == nil || meta.User.ID == 0 {
-			buffer.WriteString(commentlist__106)
+			buffer.WriteString(commentlist__131)
 			WriteAll(utils.Url("/login"), true, buffer)
-			buffer.WriteString(commentlist__107)
+			buffer.WriteString(commentlist__132)
 			WriteAll(utils.Url("/register"), true, buffer)
-			buffer.WriteString(commentlist__108)
+			buffer.WriteString(commentlist__133)
 
 		} else {
 			{
-				buffer.WriteString(commentlist__63)
+				buffer.WriteString(commentlist__84)
 				WriteAll(meta.User.AvatarElm("32", "32", false), false, buffer)
-				buffer.WriteString(commentlist__64)
+				buffer.WriteString(commentlist__85)
 				WriteAll(meta.User.Url(), true, buffer)
-				buffer.WriteString(commentlist__50)
+				buffer.WriteString(commentlist__48)
 				WriteAll(meta.User.Name(), true, buffer)
-				buffer.WriteString(commentlist__66)
+				buffer.WriteString(commentlist__87)
 				WriteAll("@"+meta.User.Username, true, buffer)
-				buffer.WriteString(commentlist__67)
+				buffer.WriteString(commentlist__88)
 				WriteAll(utils.Url("/posts/new"), true, buffer)
-				buffer.WriteString(commentlist__68)
+				buffer.WriteString(commentlist__89)
 				WriteAll(utils.Url("/posts"), true, buffer)
-				buffer.WriteString(commentlist__69)
+				buffer.WriteString(commentlist__90)
 				WriteAll(utils.Url("/comments"), true, buffer)
-				buffer.WriteString(commentlist__70)
+				buffer.WriteString(commentlist__91)
 				WriteAll(utils.Url("/files"), true, buffer)
-				buffer.WriteString(commentlist__71)
+				buffer.WriteString(commentlist__92)
+				WriteAll(utils.Url("/invites"), true, buffer)
+				buffer.WriteString(commentlist__93)
 				WriteAll(utils.Url("/settings"), true, buffer)
-				buffer.WriteString(commentlist__72)
+				buffer.WriteString(commentlist__94)
 
 			}
 
 			if meta.User.IsRoot() {
 				{
-					buffer.WriteString(commentlist__119)
+					buffer.WriteString(commentlist__145)
 					WriteAll(utils.Url("/manage"), true, buffer)
-					buffer.WriteString(commentlist__120)
+					buffer.WriteString(commentlist__146)
 					WriteAll(utils.Url("/manage/topics"), true, buffer)
-					buffer.WriteString(commentlist__121)
+					buffer.WriteString(commentlist__147)
 					WriteAll(utils.Url("/manage/posts"), true, buffer)
-					buffer.WriteString(commentlist__122)
+					buffer.WriteString(commentlist__148)
 					WriteAll(utils.Url("/manage/pages"), true, buffer)
-					buffer.WriteString(commentlist__123)
+					buffer.WriteString(commentlist__149)
 					WriteAll(utils.Url("/manage/roles"), true, buffer)
-					buffer.WriteString(commentlist__124)
+					buffer.WriteString(commentlist__150)
 					WriteAll(utils.Url("/manage/users"), true, buffer)
-					buffer.WriteString(commentlist__125)
+					buffer.WriteString(commentlist__151)
 					WriteAll(utils.Url("/manage/comments"), true, buffer)
-					buffer.WriteString(commentlist__126)
+					buffer.WriteString(commentlist__152)
 					WriteAll(utils.Url("/manage/files"), true, buffer)
-					buffer.WriteString(commentlist__127)
+					buffer.WriteString(commentlist__153)
 					WriteAll(utils.Url("/manage/settings"), true, buffer)
-					buffer.WriteString(commentlist__72)
+					buffer.WriteString(commentlist__154)
+					WriteAll(utils.Url("/manage/menus"), true, buffer)
+					buffer.WriteString(commentlist__155)
+					WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+					buffer.WriteString(commentlist__156)
+					WriteAll(utils.Url("/manage/audit"), true, buffer)
+					buffer.WriteString(commentlist__157)
 
 				}
 
 			}
 		}
-		buffer.WriteString(commentlist__26)
+		buffer.WriteString(commentlist__28)
 
-		for _, topic := range cache.Topics {
-			buffer.WriteString(commentlist__106)
+		for _, topic := range cache.Topics() {
+			buffer.WriteString(commentlist__131)
 			WriteAll(topic.Url(), true, buffer)
-			buffer.WriteString(commentlist__49)
+			buffer.WriteString(commentlist__70)
 			WriteAll(topic.Name, true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			WriteAll("#"+topic.Name, true, buffer)
-			buffer.WriteString(commentlist__132)
+			buffer.WriteString(commentlist__52)
 		}
-		buffer.WriteString(commentlist__27)
+		buffer.WriteString(commentlist__29)
+
+		{
+			var (
+				location = "footer"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__30)
 		WriteAll(config.Setting("footer_content"), false, buffer)
-		buffer.WriteString(commentlist__28)
+		buffer.WriteString(commentlist__31)
 		WriteAll(config.Setting("inject_footer"), false, buffer)
 		WriteAll(asset.JsFile("js/layout.js"), false, buffer)
 		WriteAll(asset.JsFile("js/main.js"), false, buffer)
-		buffer.WriteString(filelist__29)
+		buffer.WriteString(filelist__34)
 
 	}
 }
@@ -0,0 +1,505 @@
+// Code generated by "jade.go"; DO NOT EDIT.
+
+package views
+
+import (
+	"bufio"
+	"fmt"
+
+	"github.com/ngocphuongnb/tetua/app/asset"
+	"github.com/ngocphuongnb/tetua/app/cache"
+	"github.com/ngocphuongnb/tetua/app/config"
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/utils"
+)
+
+const (
+	invitelist__22  = `</div></div><main class="main"><div class="box"><h1>Invites</h1>`
+	invitelist__23  = `<form class="invite-form" method="POST" action="`
+	invitelist__25  = `<p><label>Max uses (0 for unlimited)</label><input name="max_uses" type="number" min="0" value="1"/></p><p><label>Expires in days (0 for never)</label><input name="expires_in" type="number" min="0" value="7"/></p>`
+	invitelist__26  = `<button>Create invite</button></form></div><div class="box"><ul class="nodes-list invites">`
+	invitelist__35  = `<script>listenDeleteNodeEvents('invite', '/invites', '/invites')</script></body></html>`
+	invitelist__109 = `<p><label>Role</label><select name="role_id">`
+	invitelist__110 = `</select></p>`
+	invitelist__114 = `<li><div class="name">`
+	invitelist__115 = `&nbsp;<a href="`
+	invitelist__117 = `</a></div><div class="meta">`
+	invitelist__118 = `&nbsp;<a class="delete-invite" href="#" data-id="`
+	invitelist__119 = `">Delete</a></div>`
+	invitelist__121 = `<span class="status success">Active</span>`
+	invitelist__122 = `<span class="status error">Inactive</span>`
+	invitelist__133 = `<div class="invited-users">`
+	invitelist__137 = `</a>&nbsp;`
+)
+
+func InviteList(paginate *entities.Paginate[entities.Invite], roles []*entities.Role) func(meta *entities.Meta, wr *bufio.Writer) {
+	return func(meta *entities.Meta, wr *bufio.Writer) {
+		buffer := &WriterAsBuffer{wr}
+
+		buffer.WriteString(commentlist__0)
+
+		var title = meta.GetTitle()
+		var appName = config.Setting("app_name")
+		var appLogo = config.Setting("app_logo")
+		buffer.WriteString(commentlist__1)
+		WriteAll(meta.CsrfToken, true, buffer)
+		buffer.WriteString(commentlist__2)
+		WriteAll(title, true, buffer)
+		buffer.WriteString(commentlist__3)
+		WriteAll(meta.Canonical, true, buffer)
+		buffer.WriteString(commentlist__4)
+		WriteAll(meta.Type, true, buffer)
+		buffer.WriteString(commentlist__5)
+		WriteAll(meta.Canonical, true, buffer)
+		buffer.WriteString(commentlist__6)
+		WriteAll(title, true, buffer)
+		buffer.WriteString(commentlist__7)
+		WriteAll(appName, true, buffer)
+		buffer.WriteString(commentlist__8)
+		WriteAll(config.Setting("twitter_site"), true, buffer)
+		buffer.WriteString(commentlist__9)
+		WriteAll(title, true, buffer)
+		buffer.WriteString(commentlist__10)
+		WriteAll(appName, true, buffer)
+		buffer.WriteString(commentlist__11)
+		WriteAll(appName, true, buffer)
+		buffer.WriteString(commentlist__12)
+		WriteAll(appName+" Feed", true, buffer)
+		buffer.WriteString(commentlist__13)
+		WriteAll(utils.Url("/feed"), true, buffer)
+		buffer.WriteString(commentlist__14)
+		if appLogo != "" {
+			buffer.WriteString(commentlist__33)
+			WriteAll(appLogo, true, buffer)
+			buffer.WriteString(commentlist__34)
+			WriteAll(appLogo, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+		if meta.Description != "" {
+			buffer.WriteString(commentlist__36)
+			WriteAll(meta.Description, true, buffer)
+			buffer.WriteString(commentlist__37)
+			WriteAll(meta.Description, true, buffer)
+			buffer.WriteString(commentlist__38)
+			WriteAll(meta.Description, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+		if meta.Image != "" {
+			buffer.WriteString(commentlist__40)
+			WriteAll(meta.Image, true, buffer)
+			buffer.WriteString(commentlist__41)
+			WriteAll(meta.Image, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+		WriteAll(asset.CssFile("css/light.min.css"), false, buffer)
+		WriteAll(asset.CssFile("css/style.css"), false, buffer)
+		WriteAll(config.Setting("inject_header"), false, buffer)
+		buffer.WriteString(commentlist__15)
+		WriteAll(utils.Url(""), true, buffer)
+		buffer.WriteString(commentlist__16)
+		var logoUrl = config.Setting("app_logo")
+		if logoUrl != "" {
+			buffer.WriteString(commentlist__43)
+			WriteAll(logoUrl, true, buffer)
+			buffer.WriteString(commentlist__44)
+			WriteAll(config.Setting("app_name"), true, buffer)
+			buffer.WriteString(commentlist__14)
+		} else {
+			buffer.WriteString(commentlist__46)
+
+		}
+		buffer.WriteString(commentlist__17)
+		WriteAll(meta.Query, true, buffer)
+		buffer.WriteString(commentlist__18)
+
+		{
+			var (
+				location = "header"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__19)
+		WriteAll(utils.Url("/search"), true, buffer)
+		buffer.WriteString(commentlist__20)
+
+		if meta.User == nil || meta.User.ID == 0 {
+			buffer.WriteString(commentlist__50)
+			WriteAll(utils.Url("/login"), true, buffer)
+			buffer.WriteString(commentlist__66)
+			WriteAll(utils.Url("/register"), true, buffer)
+			buffer.WriteString(commentlist__67)
+
+		} else {
+			buffer.WriteString(commentlist__50)
+			WriteAll(utils.Url("/posts/new"), true, buffer)
+			buffer.WriteString(commentlist__69)
+			WriteAll(meta.User.Url(), true, buffer)
+			buffer.WriteString(commentlist__70)
+			WriteAll(meta.User.Username, true, buffer)
+			buffer.WriteString(commentlist__48)
+			if meta.User.AvatarImageUrl != "" {
+				buffer.WriteString(commentlist__78)
+				WriteAll(meta.User.AvatarImageUrl, true, buffer)
+				buffer.WriteString(commentlist__44)
+				WriteAll(meta.User.Username, true, buffer)
+				buffer.WriteString(commentlist__14)
+			} else {
+				buffer.WriteString(commentlist__81)
+
+			}
+			buffer.WriteString(commentlist__72)
+
+			if meta.User != nil && meta.User.IsRoot() {
+				buffer.WriteString(commentlist__50)
+				WriteAll(utils.Url("/manage"), true, buffer)
+				buffer.WriteString(commentlist__83)
+
+			}
+			buffer.WriteString(commentlist__50)
+			WriteAll(meta.User.Url(), true, buffer)
+			buffer.WriteString(commentlist__74)
+			WriteAll(utils.Url("/posts"), true, buffer)
+			buffer.WriteString(commentlist__75)
+			WriteAll(utils.Url("/settings"), true, buffer)
+			buffer.WriteString(commentlist__76)
+			WriteAll(utils.Url("/logout"), true, buffer)
+			buffer.WriteString(commentlist__77)
+
+		}
+		buffer.WriteString(commentlist__21)
+
+		{
+			buffer.WriteString(commentlist__84)
+			WriteAll(meta.User.AvatarElm("32", "32", false), false, buffer)
+			buffer.WriteString(commentlist__85)
+			WriteAll(meta.User.Url(), true, buffer)
+			buffer.WriteString(commentlist__48)
+			WriteAll(meta.User.Name(), true, buffer)
+			buffer.WriteString(commentlist__87)
+			WriteAll("@"+meta.User.Username, true, buffer)
+			buffer.WriteString(commentlist__88)
+			WriteAll(utils.Url("/posts/new"), true, buffer)
+			buffer.WriteString(commentlist__89)
+			WriteAll(utils.Url("/posts"), true, buffer)
+			buffer.WriteString(commentlist__90)
+			WriteAll(utils.Url("/comments"), true, buffer)
+			buffer.WriteString(commentlist__91)
+			WriteAll(utils.Url("/files"), true, buffer)
+			buffer.WriteString(commentlist__92)
+			WriteAll(utils.Url("/invites"), true, buffer)
+			buffer.WriteString(commentlist__93)
+			WriteAll(utils.Url("/settings"), true, buffer)
+			buffer.WriteString(commentlist__94)
+
+		}
+
+		buffer.WriteString(invitelist__22)
+
+		{
+			var (
+				msgs = meta.Messages
+			)
+
+			if msgs.Length() > 0 {
+				buffer.WriteString(commentlist__95)
+				var messages = msgs.Get()
+				for _, msg := range messages {
+					buffer.WriteString(commentlist__97)
+					WriteAll(msg.Type, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(msg.Message, true, buffer)
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(invitelist__23)
+		WriteAll(utils.Url("/invites"), true, buffer)
+		buffer.WriteString(commentlist__48)
+		{
+			buffer.WriteString(commentlist__125)
+			WriteAll(meta.CsrfToken, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+
+		{
+			var (
+				name  = "code"
+				value = ""
+				label = "Code (leave blank to generate one)"
+			)
+
+			buffer.WriteString(fileedit__112)
+			WriteEscString(label, buffer)
+			buffer.WriteString(fileedit__113)
+			WriteEscString(name, buffer)
+			buffer.WriteString(fileedit__114)
+			WriteEscString(value, buffer)
+			buffer.WriteString(fileedit__115)
+		}
+
+		buffer.WriteString(invitelist__25)
+
+		if len(roles) > 0 {
+			buffer.WriteString(invitelist__109)
+
+			for _, role := range roles {
+				buffer.WriteString(filelist__117)
+				WriteAll(role.ID, true, buffer)
+				buffer.WriteString(commentlist__48)
+				WriteAll(role.Name, true, buffer)
+				buffer.WriteString(filelist__119)
+			}
+			buffer.WriteString(invitelist__110)
+
+		}
+		buffer.WriteString(invitelist__26)
+
+		for _, invite := range paginate.Data {
+			buffer.WriteString(invitelist__114)
+
+			if invite.Usable() {
+				buffer.WriteString(invitelist__121)
+
+			} else {
+				buffer.WriteString(invitelist__122)
+
+			}
+			buffer.WriteString(invitelist__115)
+			WriteAll(invite.Url(), true, buffer)
+			buffer.WriteString(commentlist__109)
+			WriteAll(invite.Code, true, buffer)
+			buffer.WriteString(invitelist__117)
+
+			if invite.MaxUses > 0 {
+				buffer.WriteString(filelist__102)
+				WriteEscString(fmt.Sprintf("Used %d / %d", invite.Used, invite.MaxUses), buffer)
+				buffer.WriteString(filelist__106)
+			} else {
+				buffer.WriteString(filelist__102)
+				WriteEscString(fmt.Sprintf("Used %d", invite.Used), buffer)
+				buffer.WriteString(filelist__106)
+			}
+			if invite.ExpiresAt != nil {
+				buffer.WriteString(filelist__102)
+				WriteAll(" - Expires: "+invite.ExpiresAt.Format("2006-01-02 15:04"), true, buffer)
+				buffer.WriteString(filelist__106)
+			}
+			if invite.Role != nil {
+				buffer.WriteString(filelist__102)
+				WriteAll(" - Role: "+invite.Role.Name, true, buffer)
+				buffer.WriteString(filelist__106)
+			}
+			if invite.User != nil && invite.UserID != meta.User.ID {
+				buffer.WriteString(filelist__102)
+				WriteAll(" - By: "+invite.User.Username, true, buffer)
+				buffer.WriteString(filelist__106)
+			}
+			buffer.WriteString(invitelist__118)
+			WriteAll(invite.ID, true, buffer)
+			buffer.WriteString(invitelist__119)
+
+			if len(invite.InvitedUsers) > 0 {
+				buffer.WriteString(invitelist__133)
+				for _, invitedUser := range invite.InvitedUsers {
+					buffer.WriteString(commentlist__131)
+					WriteAll(invitedUser.Url(), true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll("@"+invitedUser.Username, true, buffer)
+					buffer.WriteString(invitelist__137)
+
+				}
+				buffer.WriteString(commentlist__24)
+			}
+			buffer.WriteString(commentlist__53)
+		}
+		buffer.WriteString(commentlist__49)
+		var links = paginate.Links()
+		buffer.WriteString(commentlist__25)
+		for _, link := range links {
+			buffer.WriteString(commentlist__50)
+			WriteAll(link.Link, true, buffer)
+			buffer.WriteString(commentlist__128)
+			WriteAll(link.Class, true, buffer)
+			buffer.WriteString(commentlist__48)
+			WriteAll(link.Label, true, buffer)
+			buffer.WriteString(commentlist__64)
+
+		}
+		buffer.WriteString(commentlist__26)
+		WriteAll(config.Setting("app_name"), true, buffer)
+		buffer.WriteString(commentlist__27)
+
+		if meta.User == nil || meta.User.ID == 0 {
+			buffer.WriteString(commentlist__131)
+			WriteAll(utils.Url("/login"), true, buffer)
+			buffer.WriteString(commentlist__132)
+			WriteAll(utils.Url("/register"), true, buffer)
+			buffer.WriteString(commentlist__133)
+
+		} else {
+			{
+				buffer.WriteString(commentlist__84)
+				WriteAll(meta.User.AvatarElm("32", "32", false), false, buffer)
+				buffer.WriteString(commentlist__85)
+				WriteAll(meta.User.Url(), true, buffer)
+				buffer.WriteString(commentlist__48)
+				WriteAll(meta.User.Name(), true, buffer)
+				buffer.WriteString(commentlist__87)
+				WriteAll("@"+meta.User.Username, true, buffer)
+				buffer.WriteString(commentlist__88)
+				WriteAll(utils.Url("/posts/new"), true, buffer)
+				buffer.WriteString(commentlist__89)
+				WriteAll(utils.Url("/posts"), true, buffer)
+				buffer.WriteString(commentlist__90)
+				WriteAll(utils.Url("/comments"), true, buffer)
+				buffer.WriteString(commentlist__91)
+				WriteAll(utils.Url("/files"), true, buffer)
+				buffer.WriteString(commentlist__92)
+				WriteAll(utils.Url("/invites"), true, buffer)
+				buffer.WriteString(commentlist__93)
+				WriteAll(utils.Url("/settings"), true, buffer)
+				buffer.WriteString(commentlist__94)
+
+			}
+
+			if meta.User.IsRoot() {
+				{
+					buffer.WriteString(commentlist__145)
+					WriteAll(utils.Url("/manage"), true, buffer)
+					buffer.WriteString(commentlist__146)
+					WriteAll(utils.Url("/manage/topics"), true, buffer)
+					buffer.WriteString(commentlist__147)
+					WriteAll(utils.Url("/manage/posts"), true, buffer)
+					buffer.WriteString(commentlist__148)
+					WriteAll(utils.Url("/manage/pages"), true, buffer)
+					buffer.WriteString(commentlist__149)
+					WriteAll(utils.Url("/manage/roles"), true, buffer)
+					buffer.WriteString(commentlist__150)
+					WriteAll(utils.Url("/manage/users"), true, buffer)
+					buffer.WriteString(commentlist__151)
+					WriteAll(utils.Url("/manage/comments"), true, buffer)
+					buffer.WriteString(commentlist__152)
+					WriteAll(utils.Url("/manage/files"), true, buffer)
+					buffer.WriteString(commentlist__153)
+					WriteAll(utils.Url("/manage/settings"), true, buffer)
+					buffer.WriteString(commentlist__154)
+					WriteAll(utils.Url("/manage/menus"), true, buffer)
+					buffer.WriteString(commentlist__155)
+					WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+					buffer.WriteString(commentlist__156)
+					WriteAll(utils.Url("/manage/audit"), true, buffer)
+					buffer.WriteString(commentlist__157)
+
+				}
+
+			}
+		}
+		buffer.WriteString(commentlist__28)
+
+		for _, topic := range cache.Topics() {
+			buffer.WriteString(commentlist__131)
+			WriteAll(topic.Url(), true, buffer)
+			buffer.WriteString(commentlist__70)
+			WriteAll(topic.Name, true, buffer)
+			buffer.WriteString(commentlist__48)
+			WriteAll("#"+topic.Name, true, buffer)
+			buffer.WriteString(commentlist__52)
+		}
+		buffer.WriteString(commentlist__29)
+
+		{
+			var (
+				location = "footer"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__30)
+		WriteAll(config.Setting("footer_content"), false, buffer)
+		buffer.WriteString(commentlist__31)
+		WriteAll(config.Setting("inject_footer"), false, buffer)
+		WriteAll(asset.JsFile("js/layout.js"), false, buffer)
+		WriteAll(asset.JsFile("js/main.js"), false, buffer)
+		buffer.WriteString(invitelist__35)
+
+	}
+}
@@ -0,0 +1,502 @@
+// Code generated by "jade.go"; DO NOT EDIT.
+
+package views
+
+import (
+	"bufio"
+	"fmt"
+
+	"github.com/ngocphuongnb/tetua/app/asset"
+	"github.com/ngocphuongnb/tetua/app/cache"
+	"github.com/ngocphuongnb/tetua/app/config"
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/utils"
+)
+
+const (
+	manageauditindex__22  = `</div></div><div class="main"><div class="box">`
+	manageauditindex__23  = `<h1>Audit log</h1><form class="search-form" method="get" action="" accept-charset="UTF-8" style="width: 100%;overflow:initial;">`
+	manageauditindex__24  = `<input class="search-input" type="text" name="q" placeholder="Request ID, IP or action..." value="`
+	manageauditindex__25  = `" style="width: auto;flex-grow: 1;"/><select name="action" style="width:200px"><option value="">All actions</option>`
+	manageauditindex__26  = `</select><select name="target_type" style="width:120px"><option value="">All targets</option>`
+	manageauditindex__27  = `</select><button class="search-btn" type="submit" aria-label="Search audit logs"><svg style="width:24px;height:24px" viewBox="0 0 24 24"><path fill="currentColor" d="M9.5,3A6.5,6.5 0 0,1 16,9.5C16,11.11 15.41,12.59 14.44,13.73L14.71,14H15.5L20.5,19L19,20.5L14,15.5V14.71L13.73,14.44C12.59,15.41 11.11,16 9.5,16A6.5,6.5 0 0,1 3,9.5A6.5,6.5 0 0,1 9.5,3M9.5,5C7,5 5,7 5,9.5C5,12 7,14 9.5,14C12,14 14,12 14,9.5C14,7 12,5 9.5,5Z"></path></svg></button></form><ul class="nodes-list audit-logs">`
+	manageauditindex__30  = `</ul></div></div></div></div><div class="mobile-menu"><div class="menu-head">`
+	manageauditindex__106 = `<input class="hidden" type="hidden" name="user" value="`
+	manageauditindex__108 = `<input class="hidden" type="hidden" name="target_id" value="`
+	manageauditindex__122 = `<li><div class="name"><a href="`
+	manageauditindex__124 = `</a>&nbsp;<a class="status" href="`
+	manageauditindex__126 = `</a><details><summary>Changes</summary><div class="audit-changes"><div><strong>Before</strong><pre>`
+	manageauditindex__127 = `</pre></div><div><strong>After</strong><pre>`
+	manageauditindex__128 = `</pre></div></div></details></div><div class="info">`
+	manageauditindex__129 = `<div class="date">`
+	manageauditindex__130 = `</div><div>`
+	manageauditindex__131 = `</div><a href="`
+	manageauditindex__132 = `" title="Request ID">`
+	manageauditindex__133 = `</a></div></li>`
+	manageauditindex__134 = `<a class="author" href="`
+)
+
+func ManageAuditIndex(paginate *entities.Paginate[entities.AuditLog], search, action, targetType string, userID, targetID int, actions, targetTypes []string) func(meta *entities.Meta, wr *bufio.Writer) {
+	return func(meta *entities.Meta, wr *bufio.Writer) {
+		buffer := &WriterAsBuffer{wr}
+
+		buffer.WriteString(commentlist__0)
+
+		var title = meta.GetTitle()
+		var appName = config.Setting("app_name")
+		var appLogo = config.Setting("app_logo")
+		buffer.WriteString(commentlist__1)
+		WriteAll(meta.CsrfToken, true, buffer)
+		buffer.WriteString(commentlist__2)
+		WriteAll(title, true, buffer)
+		buffer.WriteString(commentlist__3)
+		WriteAll(meta.Canonical, true, buffer)
+		buffer.WriteString(commentlist__4)
+		WriteAll(meta.Type, true, buffer)
+		buffer.WriteString(commentlist__5)
+		WriteAll(meta.Canonical, true, buffer)
+		buffer.WriteString(commentlist__6)
+		WriteAll(title, true, buffer)
+		buffer.WriteString(commentlist__7)
+		WriteAll(appName, true, buffer)
+		buffer.WriteString(commentlist__8)
+		WriteAll(config.Setting("twitter_site"), true, buffer)
+		buffer.WriteString(commentlist__9)
+		WriteAll(title, true, buffer)
+		buffer.WriteString(commentlist__10)
+		WriteAll(appName, true, buffer)
+		buffer.WriteString(commentlist__11)
+		WriteAll(appName, true, buffer)
+		buffer.WriteString(commentlist__12)
+		WriteAll(appName+" Feed", true, buffer)
+		buffer.WriteString(commentlist__13)
+		WriteAll(utils.Url("/feed"), true, buffer)
+		buffer.WriteString(commentlist__14)
+		if appLogo != "" {
+			buffer.WriteString(commentlist__33)
+			WriteAll(appLogo, true, buffer)
+			buffer.WriteString(commentlist__34)
+			WriteAll(appLogo, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+		if meta.Description != "" {
+			buffer.WriteString(commentlist__36)
+			WriteAll(meta.Description, true, buffer)
+			buffer.WriteString(commentlist__37)
+			WriteAll(meta.Description, true, buffer)
+			buffer.WriteString(commentlist__38)
+			WriteAll(meta.Description, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+		if meta.Image != "" {
+			buffer.WriteString(commentlist__40)
+			WriteAll(meta.Image, true, buffer)
+			buffer.WriteString(commentlist__41)
+			WriteAll(meta.Image, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+		WriteAll(asset.CssFile("css/light.min.css"), false, buffer)
+		WriteAll(asset.CssFile("css/style.css"), false, buffer)
+		WriteAll(config.Setting("inject_header"), false, buffer)
+		buffer.WriteString(commentlist__15)
+		WriteAll(utils.Url(""), true, buffer)
+		buffer.WriteString(commentlist__16)
+		var logoUrl = config.Setting("app_logo")
+		if logoUrl != "" {
+			buffer.WriteString(commentlist__43)
+			WriteAll(logoUrl, true, buffer)
+			buffer.WriteString(commentlist__44)
+			WriteAll(config.Setting("app_name"), true, buffer)
+			buffer.WriteString(commentlist__14)
+		} else {
+			buffer.WriteString(commentlist__46)
+
+		}
+		buffer.WriteString(commentlist__17)
+		WriteAll(meta.Query, true, buffer)
+		buffer.WriteString(commentlist__18)
+
+		{
+			var (
+				location = "header"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__19)
+		WriteAll(utils.Url("/search"), true, buffer)
+		buffer.WriteString(commentlist__20)
+
+		if meta.User == nil || meta.User.ID == 0 {
+			buffer.WriteString(commentlist__50)
+			WriteAll(utils.Url("/login"), true, buffer)
+			buffer.WriteString(commentlist__66)
+			WriteAll(utils.Url("/register"), true, buffer)
+			buffer.WriteString(commentlist__67)
+
+		} else {
+			buffer.WriteString(commentlist__50)
+			WriteAll(utils.Url("/posts/new"), true, buffer)
+			buffer.WriteString(commentlist__69)
+			WriteAll(meta.User.Url(), true, buffer)
+			buffer.WriteString(commentlist__70)
+			WriteAll(meta.User.Username, true, buffer)
+			buffer.WriteString(commentlist__48)
+			if meta.User.AvatarImageUrl != "" {
+				buffer.WriteString(commentlist__78)
+				WriteAll(meta.User.AvatarImageUrl, true, buffer)
+				buffer.WriteString(commentlist__44)
+				WriteAll(meta.User.Username, true, buffer)
+				buffer.WriteString(commentlist__14)
+			} else {
+				buffer.WriteString(commentlist__81)
+
+			}
+			buffer.WriteString(commentlist__72)
+
+			if meta.User != nil && meta.User.IsRoot() {
+				buffer.WriteString(commentlist__50)
+				WriteAll(utils.Url("/manage"), true, buffer)
+				buffer.WriteString(commentlist__83)
+
+			}
+			buffer.WriteString(commentlist__50)
+			WriteAll(meta.User.Url(), true, buffer)
+			buffer.WriteString(commentlist__74)
+			WriteAll(utils.Url("/posts"), true, buffer)
+			buffer.WriteString(commentlist__75)
+			WriteAll(utils.Url("/settings"), true, buffer)
+			buffer.WriteString(commentlist__76)
+			WriteAll(utils.Url("/logout"), true, buffer)
+			buffer.WriteString(commentlist__77)
+
+		}
+		buffer.WriteString(commentlist__21)
+
+		{
+			buffer.WriteString(commentlist__145)
+			WriteAll(utils.Url("/manage"), true, buffer)
+			buffer.WriteString(commentlist__146)
+			WriteAll(utils.Url("/manage/topics"), true, buffer)
+			buffer.WriteString(commentlist__147)
+			WriteAll(utils.Url("/manage/posts"), true, buffer)
+			buffer.WriteString(commentlist__148)
+			WriteAll(utils.Url("/manage/pages"), true, buffer)
+			buffer.WriteString(commentlist__149)
+			WriteAll(utils.Url("/manage/roles"), true, buffer)
+			buffer.WriteString(commentlist__150)
+			WriteAll(utils.Url("/manage/users"), true, buffer)
+			buffer.WriteString(commentlist__151)
+			WriteAll(utils.Url("/manage/comments"), true, buffer)
+			buffer.WriteString(commentlist__152)
+			WriteAll(utils.Url("/manage/files"), true, buffer)
+			buffer.WriteString(commentlist__153)
+			WriteAll(utils.Url("/manage/settings"), true, buffer)
+			buffer.WriteString(commentlist__154)
+			WriteAll(utils.Url("/manage/menus"), true, buffer)
+			buffer.WriteString(commentlist__155)
+			WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+			buffer.WriteString(commentlist__156)
+			WriteAll(utils.Url("/manage/audit"), true, buffer)
+			buffer.WriteString(commentlist__157)
+
+		}
+
+		buffer.WriteString(manageauditindex__22)
+
+		{
+			var (
+				msgs = meta.Messages
+			)
+
+			if msgs.Length() > 0 {
+				buffer.WriteString(commentlist__95)
+				var messages = msgs.Get()
+				for _, msg := range messages {
+					buffer.WriteString(commentlist__97)
+					WriteAll(msg.Type, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(msg.Message, true, buffer)
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(manageauditindex__23)
+
+		if userID > 0 {
+			buffer.WriteString(manageauditindex__106)
+			WriteInt(int64(userID), buffer)
+			buffer.WriteString(commentlist__14)
+		}
+		if targetID > 0 {
+			buffer.WriteString(manageauditindex__108)
+			WriteInt(int64(targetID), buffer)
+			buffer.WriteString(commentlist__14)
+		}
+		buffer.WriteString(manageauditindex__24)
+		WriteEscString(search, buffer)
+		buffer.WriteString(manageauditindex__25)
+
+		for _, item := range actions {
+			if item == action {
+				buffer.WriteString(filelist__117)
+				WriteEscString(item, buffer)
+				buffer.WriteString(filelist__118)
+				WriteEscString(item, buffer)
+				buffer.WriteString(filelist__119)
+			} else {
+				buffer.WriteString(filelist__117)
+				WriteEscString(item, buffer)
+				buffer.WriteString(commentlist__48)
+				WriteEscString(item, buffer)
+				buffer.WriteString(filelist__119)
+			}
+		}
+		buffer.WriteString(manageauditindex__26)
+
+		for _, item := range targetTypes {
+			if item == targetType {
+				buffer.WriteString(filelist__117)
+				WriteEscString(item, buffer)
+				buffer.WriteString(filelist__118)
+				WriteEscString(item, buffer)
+				buffer.WriteString(filelist__119)
+			} else {
+				buffer.WriteString(filelist__117)
+				WriteEscString(item, buffer)
+				buffer.WriteString(commentlist__48)
+				WriteEscString(item, buffer)
+				buffer.WriteString(filelist__119)
+			}
+		}
+		buffer.WriteString(manageauditindex__27)
+
+		for _, log := range paginate.Data {
+			buffer.WriteString(manageauditindex__122)
+			WriteEscString(fmt.Sprintf("/manage/audit?action=%s", log.Action), buffer)
+			buffer.WriteString(commentlist__48)
+			WriteAll(log.Action, true, buffer)
+			buffer.WriteString(manageauditindex__124)
+			WriteEscString(fmt.Sprintf("/manage/audit?target_type=%s&target_id=%d", log.TargetType, log.TargetID), buffer)
+			buffer.WriteString(commentlist__48)
+			WriteEscString(fmt.Sprintf("%s #%d", log.TargetType, log.TargetID), buffer)
+			buffer.WriteString(manageauditindex__126)
+			WriteAll(log.Before, true, buffer)
+			buffer.WriteString(manageauditindex__127)
+			WriteAll(log.After, true, buffer)
+			buffer.WriteString(manageauditindex__128)
+
+			if log.User != nil {
+				buffer.WriteString(manageauditindex__134)
+				WriteEscString(fmt.Sprintf("/manage/audit?user=%d", log.UserID), buffer)
+				buffer.WriteString(commentlist__48)
+				WriteAll(log.User.Username, true, buffer)
+				buffer.WriteString(commentlist__52)
+			} else if log.UserID > 0 {
+				buffer.WriteString(manageauditindex__134)
+				WriteEscString(fmt.Sprintf("/manage/audit?user=%d", log.UserID), buffer)
+				buffer.WriteString(commentlist__48)
+				WriteEscString(fmt.Sprintf("User #%d", log.UserID), buffer)
+				buffer.WriteString(commentlist__52)
+			}
+			buffer.WriteString(manageauditindex__129)
+			WriteAll(log.CreatedAt.Format("2006-01-02 15:04:05"), true, buffer)
+			buffer.WriteString(manageauditindex__130)
+			WriteAll(log.IP, true, buffer)
+			buffer.WriteString(manageauditindex__131)
+			WriteEscString(fmt.Sprintf("/manage/audit?q=%s", log.RequestID), buffer)
+			buffer.WriteString(manageauditindex__132)
+			WriteAll(log.RequestID, true, buffer)
+			buffer.WriteString(manageauditindex__133)
+
+		}
+		buffer.WriteString(commentlist__49)
+		var links = paginate.Links()
+		buffer.WriteString(commentlist__25)
+		for _, link := range links {
+			buffer.WriteString(commentlist__50)
+			WriteAll(link.Link, true, buffer)
+			buffer.WriteString(commentlist__128)
+			WriteAll(link.Class, true, buffer)
+			buffer.WriteString(commentlist__48)
+			WriteAll(link.Label, true, buffer)
+			buffer.WriteString(commentlist__64)
+
+		}
+		buffer.WriteString(manageauditindex__30)
+		WriteAll(config.Setting("app_name"), true, buffer)
+		buffer.WriteString(commentlist__27)
+
+		if meta.User == nil || meta.User.ID == 0 {
+			buffer.WriteString(commentlist__131)
+			WriteAll(utils.Url("/login"), true, buffer)
+			buffer.WriteString(commentlist__132)
+			WriteAll(utils.Url("/register"), true, buffer)
+			buffer.WriteString(commentlist__133)
+
+		} else {
+			{
+				buffer.WriteString(commentlist__84)
+				WriteAll(meta.User.AvatarElm("32", "32", false), false, buffer)
+				buffer.WriteString(commentlist__85)
+				WriteAll(meta.User.Url(), true, buffer)
+				buffer.WriteString(commentlist__48)
+				WriteAll(meta.User.Name(), true, buffer)
+				buffer.WriteString(commentlist__87)
+				WriteAll("@"+meta.User.Username, true, buffer)
+				buffer.WriteString(commentlist__88)
+				WriteAll(utils.Url("/posts/new"), true, buffer)
+				buffer.WriteString(commentlist__89)
+				WriteAll(utils.Url("/posts"), true, buffer)
+				buffer.WriteString(commentlist__90)
+				WriteAll(utils.Url("/comments"), true, buffer)
+				buffer.WriteString(commentlist__91)
+				WriteAll(utils.Url("/files"), true, buffer)
+				buffer.WriteString(commentlist__92)
+				WriteAll(utils.Url("/invites"), true, buffer)
+				buffer.WriteString(commentlist__93)
+				WriteAll(utils.Url("/settings"), true, buffer)
+				buffer.WriteString(commentlist__94)
+
+			}
+
+			if meta.User.IsRoot() {
+				{
+					buffer.WriteString(commentlist__145)
+					WriteAll(utils.Url("/manage"), true, buffer)
+					buffer.WriteString(commentlist__146)
+					WriteAll(utils.Url("/manage/topics"), true, buffer)
+					buffer.WriteString(commentlist__147)
+					WriteAll(utils.Url("/manage/posts"), true, buffer)
+					buffer.WriteString(commentlist__148)
+					WriteAll(utils.Url("/manage/pages"), true, buffer)
+					buffer.WriteString(commentlist__149)
+					WriteAll(utils.Url("/manage/roles"), true, buffer)
+					buffer.WriteString(commentlist__150)
+					WriteAll(utils.Url("/manage/users"), true, buffer)
+					buffer.WriteString(commentlist__151)
+					WriteAll(utils.Url("/manage/comments"), true, buffer)
+					buffer.WriteString(commentlist__152)
+					WriteAll(utils.Url("/manage/files"), true, buffer)
+					buffer.WriteString(commentlist__153)
+					WriteAll(utils.Url("/manage/settings"), true, buffer)
+					buffer.WriteString(commentlist__154)
+					WriteAll(utils.Url("/manage/menus"), true, buffer)
+					buffer.WriteString(commentlist__155)
+					WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+					buffer.WriteString(commentlist__156)
+					WriteAll(utils.Url("/manage/audit"), true, buffer)
+					buffer.WriteString(commentlist__157)
+
+				}
+
+			}
+		}
+		buffer.WriteString(commentlist__28)
+
+		for _, topic := range cache.Topics() {
+			buffer.WriteString(commentlist__131)
+			WriteAll(topic.Url(), true, buffer)
+			buffer.WriteString(commentlist__70)
+			WriteAll(topic.Name, true, buffer)
+			buffer.WriteString(commentlist__48)
+			WriteAll("#"+topic.Name, true, buffer)
+			buffer.WriteString(commentlist__52)
+		}
+		buffer.WriteString(commentlist__29)
+
+		{
+			var (
+				location = "footer"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__30)
+		WriteAll(config.Setting("footer_content"), false, buffer)
+		buffer.WriteString(commentlist__31)
+		WriteAll(config.Setting("inject_footer"), false, buffer)
+		WriteAll(asset.JsFile("js/layout.js"), false, buffer)
+		WriteAll(asset.JsFile("js/main.js"), false, buffer)
+		buffer.WriteString(error__29)
+
+	}
+}
@@ -0,0 +1,556 @@
+// Code generated by "jade.go"; DO NOT EDIT.
+
+package views
+
+import (
+	"bufio"
+	"strings"
+
+	"github.com/ngocphuongnb/tetua/app/asset"
+	"github.com/ngocphuongnb/tetua/app/cache"
+	"github.com/ngocphuongnb/tetua/app/config"
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/utils"
+)
+
+const (
+	managecustomfieldcompose__24  = `<p><label>Options of select fields, one option per line</label><textarea name="options">`
+	managecustomfieldcompose__25  = `</textarea></p><p><label>Pattern (regular expression, optional)</label><input name="pattern" value="`
+	managecustomfieldcompose__26  = `"/></p></div></div><div class="right"><div class="box fixed-sidebar"><div class="flex">`
+	managecustomfieldcompose__27  = `</div><div class="save-actions"><button>Save</button>`
+	managecustomfieldcompose__28  = `</div><div><label>Target</label><select name="target">`
+	managecustomfieldcompose__29  = `</select></div><div><label>Type</label><select name="type">`
+	managecustomfieldcompose__30  = `</select></div><div><label>Max length (0 for no limit)</label><input type="number" min="0" name="max_length" value="`
+	managecustomfieldcompose__31  = `"/></div><div><label>Order</label><input type="number" name="order" value="`
+	managecustomfieldcompose__32  = `"/></div>`
+	managecustomfieldcompose__33  = `</div></div></div></form></div><div class="mobile-menu"><div class="menu-head">`
+	managecustomfieldcompose__39  = `<script>listenDeleteNodeEvents('custom-field', '/manage/custom-fields', '/manage/custom-fields')</script></body></html>`
+	managecustomfieldcompose__106 = `<h1>`
+	managecustomfieldcompose__108 = `<h1>Create new custom field</h1>`
+	managecustomfieldcompose__126 = `<a class="link-icon" href="`
+	managecustomfieldcompose__127 = `"><svg style="width:24px;height:24px" viewBox="0 0 24 24"><path fill="currentColor" d="M17,13H13V17H11V13H7V11H11V7H13V11H17M12,2A10,10 0 0,0 2,12A10,10 0 0,0 12,22A10,10 0 0,0 22,12A10,10 0 0,0 12,2Z"></path></svg>`
+	managecustomfieldcompose__129 = `<button class="danger delete-custom-field" data-id="`
+	managecustomfieldcompose__130 = `">Delete</button>`
+	managecustomfieldcompose__143 = `<label class="switch">`
+	managecustomfieldcompose__144 = `&nbsp;`
+	managecustomfieldcompose__145 = `<span class="slider"></span></label>`
+	managecustomfieldcompose__146 = `<input type="checkbox" name="`
+	managecustomfieldcompose__147 = `" checked="checked"/>`
+)
+
+func ManageCustomFieldCompose(field *entities.CustomField) func(meta *entities.Meta, wr *bufio.Writer) {
+	return func(meta *entities.Meta, wr *bufio.Writer) {
+		buffer := &WriterAsBuffer{wr}
+
+		buffer.WriteString(commentlist__0)
+
+		var title = meta.GetTitle()
+		var appName = config.Setting("app_name")
+		var appLogo = config.Setting("app_logo")
+		buffer.WriteString(commentlist__1)
+		WriteAll(meta.CsrfToken, true, buffer)
+		buffer.WriteString(commentlist__2)
+		WriteAll(title, true, buffer)
+		buffer.WriteString(commentlist__3)
+		WriteAll(meta.Canonical, true, buffer)
+		buffer.WriteString(commentlist__4)
+		WriteAll(meta.Type, true, buffer)
+		buffer.WriteString(commentlist__5)
+		WriteAll(meta.Canonical, true, buffer)
+		buffer.WriteString(commentlist__6)
+		WriteAll(title, true, buffer)
+		buffer.WriteString(commentlist__7)
+		WriteAll(appName, true, buffer)
+		buffer.WriteString(commentlist__8)
+		WriteAll(config.Setting("twitter_site"), true, buffer)
+		buffer.WriteString(commentlist__9)
+		WriteAll(title, true, buffer)
+		buffer.WriteString(commentlist__10)
+		WriteAll(appName, true, buffer)
+		buffer.WriteString(commentlist__11)
+		WriteAll(appName, true, buffer)
+		buffer.WriteString(commentlist__12)
+		WriteAll(appName+" Feed", true, buffer)
+		buffer.WriteString(commentlist__13)
+		WriteAll(utils.Url("/feed"), true, buffer)
+		buffer.WriteString(commentlist__14)
+		if appLogo != "" {
+			buffer.WriteString(commentlist__33)
+			WriteAll(appLogo, true, buffer)
+			buffer.WriteString(commentlist__34)
+			WriteAll(appLogo, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+		if meta.Description != "" {
+			buffer.WriteString(commentlist__36)
+			WriteAll(meta.Description, true, buffer)
+			buffer.WriteString(commentlist__37)
+			WriteAll(meta.Description, true, buffer)
+			buffer.WriteString(commentlist__38)
+			WriteAll(meta.Description, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+		if meta.Image != "" {
+			buffer.WriteString(commentlist__40)
+			WriteAll(meta.Image, true, buffer)
+			buffer.WriteString(commentlist__41)
+			WriteAll(meta.Image, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+		WriteAll(asset.CssFile("css/light.min.css"), false, buffer)
+		WriteAll(asset.CssFile("css/style.css"), false, buffer)
+		WriteAll(config.Setting("inject_header"), false, buffer)
+		buffer.WriteString(commentlist__15)
+		WriteAll(utils.Url(""), true, buffer)
+		buffer.WriteString(commentlist__16)
+		var logoUrl = config.Setting("app_logo")
+		if logoUrl != "" {
+			buffer.WriteString(commentlist__43)
+			WriteAll(logoUrl, true, buffer)
+			buffer.WriteString(commentlist__44)
+			WriteAll(config.Setting("app_name"), true, buffer)
+			buffer.WriteString(commentlist__14)
+		} else {
+			buffer.WriteString(commentlist__46)
+
+		}
+		buffer.WriteString(commentlist__17)
+		WriteAll(meta.Query, true, buffer)
+		buffer.WriteString(commentlist__18)
+
+		{
+			var (
+				location = "header"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__19)
+		WriteAll(utils.Url("/search"), true, buffer)
+		buffer.WriteString(commentlist__20)
+
+		if meta.User == nil || meta.User.ID == 0 {
+			buffer.WriteString(commentlist__50)
+			WriteAll(utils.Url("/login"), true, buffer)
+			buffer.WriteString(commentlist__66)
+			WriteAll(utils.Url("/register"), true, buffer)
+			buffer.WriteString(commentlist__67)
+
+		} else {
+			buffer.WriteString(commentlist__50)
+			WriteAll(utils.Url("/posts/new"), true, buffer)
+			buffer.WriteString(commentlist__69)
+			WriteAll(meta.User.Url(), true, buffer)
+			buffer.WriteString(commentlist__70)
+			WriteAll(meta.User.Username, true, buffer)
+			buffer.WriteString(commentlist__48)
+			if meta.User.AvatarImageUrl != "" {
+				buffer.WriteString(commentlist__78)
+				WriteAll(meta.User.AvatarImageUrl, true, buffer)
+				buffer.WriteString(commentlist__44)
+				WriteAll(meta.User.Username, true, buffer)
+				buffer.WriteString(commentlist__14)
+			} else {
+				buffer.WriteString(commentlist__81)
+
+			}
+			buffer.WriteString(commentlist__72)
+
+			if meta.User != nil && meta.User.IsRoot() {
+				buffer.WriteString(commentlist__50)
+				WriteAll(utils.Url("/manage"), true, buffer)
+				buffer.WriteString(commentlist__83)
+
+			}
+			buffer.WriteString(commentlist__50)
+			WriteAll(meta.User.Url(), true, buffer)
+			buffer.WriteString(commentlist__74)
+			WriteAll(utils.Url("/posts"), true, buffer)
+			buffer.WriteString(commentlist__75)
+			WriteAll(utils.Url("/settings"), true, buffer)
+			buffer.WriteString(commentlist__76)
+			WriteAll(utils.Url("/logout"), true, buffer)
+			buffer.WriteString(commentlist__77)
+
+		}
+		buffer.WriteString(fileedit__21)
+
+		{
+			buffer.WriteString(commentlist__125)
+			WriteAll(meta.CsrfToken, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+
+		buffer.WriteString(fileedit__22)
+
+		{
+			buffer.WriteString(commentlist__145)
+			WriteAll(utils.Url("/manage"), true, buffer)
+			buffer.WriteString(commentlist__146)
+			WriteAll(utils.Url("/manage/topics"), true, buffer)
+			buffer.WriteString(commentlist__147)
+			WriteAll(utils.Url("/manage/posts"), true, buffer)
+			buffer.WriteString(commentlist__148)
+			WriteAll(utils.Url("/manage/pages"), true, buffer)
+			buffer.WriteString(commentlist__149)
+			WriteAll(utils.Url("/manage/roles"), true, buffer)
+			buffer.WriteString(commentlist__150)
+			WriteAll(utils.Url("/manage/users"), true, buffer)
+			buffer.WriteString(commentlist__151)
+			WriteAll(utils.Url("/manage/comments"), true, buffer)
+			buffer.WriteString(commentlist__152)
+			WriteAll(utils.Url("/manage/files"), true, buffer)
+			buffer.WriteString(commentlist__153)
+			WriteAll(utils.Url("/manage/settings"), true, buffer)
+			buffer.WriteString(commentlist__154)
+			WriteAll(utils.Url("/manage/menus"), true, buffer)
+			buffer.WriteString(commentlist__155)
+			WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+			buffer.WriteString(commentlist__156)
+			WriteAll(utils.Url("/manage/audit"), true, buffer)
+			buffer.WriteString(commentlist__157)
+
+		}
+
+		buffer.WriteString(manageauditindex__22)
+
+		if field.ID > 0 {
+			buffer.WriteString(managecustomfieldcompose__106)
+			WriteAll("Editing custom field: "+field.Label, true, buffer)
+			buffer.WriteString(error__22)
+		} else {
+			buffer.WriteString(managecustomfieldcompose__108)
+
+		}
+		{
+			var (
+				msgs = meta.Messages
+			)
+
+			if msgs.Length() > 0 {
+				buffer.WriteString(commentlist__95)
+				var messages = msgs.Get()
+				for _, msg := range messages {
+					buffer.WriteString(commentlist__97)
+					WriteAll(msg.Type, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(msg.Message, true, buffer)
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		{
+			var (
+				name  = "name"
+				value = field.Name
+				label = "Name (lowercase letters, numbers and _)"
+			)
+
+			buffer.WriteString(fileedit__112)
+			WriteEscString(label, buffer)
+			buffer.WriteString(fileedit__113)
+			WriteEscString(name, buffer)
+			buffer.WriteString(fileedit__114)
+			WriteAll(value, true, buffer)
+			buffer.WriteString(fileedit__115)
+		}
+
+		{
+			var (
+				name  = "label"
+				value = field.Label
+				label = "Label"
+			)
+
+			buffer.WriteString(fileedit__112)
+			WriteEscString(label, buffer)
+			buffer.WriteString(fileedit__113)
+			WriteEscString(name, buffer)
+			buffer.WriteString(fileedit__114)
+			WriteAll(value, true, buffer)
+			buffer.WriteString(fileedit__115)
+		}
+
+		{
+			var (
+				name  = "description"
+				value = field.Description
+				label = "Description"
+			)
+
+			buffer.WriteString(fileedit__112)
+			WriteEscString(label, buffer)
+			buffer.WriteString(fileedit__113)
+			WriteEscString(name, buffer)
+			buffer.WriteString(fileedit__114)
+			WriteAll(value, true, buffer)
+			buffer.WriteString(fileedit__115)
+		}
+
+		buffer.WriteString(managecustomfieldcompose__24)
+		WriteAll(field.OptionsText(), true, buffer)
+		buffer.WriteString(managecustomfieldcompose__25)
+		WriteAll(field.Pattern, true, buffer)
+		buffer.WriteString(managecustomfieldcompose__26)
+
+		{
+			var (
+				label = "New Custom Field"
+				link  = "/manage/custom-fields/new"
+			)
+
+			buffer.WriteString(managecustomfieldcompose__126)
+			WriteEscString(link, buffer)
+			buffer.WriteString(managecustomfieldcompose__127)
+			WriteEscString(label, buffer)
+			buffer.WriteString(commentlist__52)
+		}
+
+		buffer.WriteString(managecustomfieldcompose__27)
+
+		if field.ID > 0 {
+			buffer.WriteString(managecustomfieldcompose__129)
+			WriteAll(field.ID, true, buffer)
+			buffer.WriteString(managecustomfieldcompose__130)
+
+		}
+		buffer.WriteString(managecustomfieldcompose__28)
+
+		for _, target := range entities.CustomFieldTargets {
+			if target == field.Target {
+				buffer.WriteString(filelist__117)
+				WriteAll(target, true, buffer)
+				buffer.WriteString(filelist__118)
+				WriteAll(strings.Title(target), true, buffer)
+				buffer.WriteString(filelist__119)
+			} else {
+				buffer.WriteString(filelist__117)
+				WriteAll(target, true, buffer)
+				buffer.WriteString(commentlist__48)
+				WriteAll(strings.Title(target), true, buffer)
+				buffer.WriteString(filelist__119)
+			}
+		}
+		buffer.WriteString(managecustomfieldcompose__29)
+
+		for _, fieldType := range entities.CustomFieldTypes {
+			if fieldType == field.Type {
+				buffer.WriteString(filelist__117)
+				WriteAll(fieldType, true, buffer)
+				buffer.WriteString(filelist__118)
+				WriteAll(strings.Title(fieldType), true, buffer)
+				buffer.WriteString(filelist__119)
+			} else {
+				buffer.WriteString(filelist__117)
+				WriteAll(fieldType, true, buffer)
+				buffer.WriteString(commentlist__48)
+				WriteAll(strings.Title(fieldType), true, buffer)
+				buffer.WriteString(filelist__119)
+			}
+		}
+		buffer.WriteString(managecustomfieldcompose__30)
+		WriteAll(field.MaxLength, true, buffer)
+		buffer.WriteString(managecustomfieldcompose__31)
+		WriteAll(field.Order, true, buffer)
+		buffer.WriteString(managecustomfieldcompose__32)
+
+		{
+			var (
+				name      = "required"
+				condition = field.Required
+				label     = "Required"
+			)
+
+			buffer.WriteString(managecustomfieldcompose__143)
+			WriteEscString(label, buffer)
+			buffer.WriteString(managecustomfieldcompose__144)
+			if condition {
+				buffer.WriteString(managecustomfieldcompose__146)
+				WriteEscString(name, buffer)
+				buffer.WriteString(managecustomfieldcompose__147)
+			} else {
+				buffer.WriteString(managecustomfieldcompose__146)
+				WriteEscString(name, buffer)
+				buffer.WriteString(commentlist__14)
+			}
+			buffer.WriteString(managecustomfieldcompose__145)
+
+		}
+
+		buffer.WriteString(managecustomfieldcompose__33)
+		WriteAll(config.Setting("app_name"), true, buffer)
+		buffer.WriteString(commentlist__27)
+
+		if meta.User == nil || meta.User.ID == 0 {
+			buffer.WriteString(commentlist__131)
+			WriteAll(utils.Url("/login"), true, buffer)
+			buffer.WriteString(commentlist__132)
+			WriteAll(utils.Url("/register"), true, buffer)
+			buffer.WriteString(commentlist__133)
+
+		} else {
+			{
+				buffer.WriteString(commentlist__84)
+				WriteAll(meta.User.AvatarElm("32", "32", false), false, buffer)
+				buffer.WriteString(commentlist__85)
+				WriteAll(meta.User.Url(), true, buffer)
+				buffer.WriteString(commentlist__48)
+				WriteAll(meta.User.Name(), true, buffer)
+				buffer.WriteString(commentlist__87)
+				WriteAll("@"+meta.User.Username, true, buffer)
+				buffer.WriteString(commentlist__88)
+				WriteAll(utils.Url("/posts/new"), true, buffer)
+				buffer.WriteString(commentlist__89)
+				WriteAll(utils.Url("/posts"), true, buffer)
+				buffer.WriteString(commentlist__90)
+				WriteAll(utils.Url("/comments"), true, buffer)
+				buffer.WriteString(commentlist__91)
+				WriteAll(utils.Url("/files"), true, buffer)
+				buffer.WriteString(commentlist__92)
+				WriteAll(utils.Url("/invites"), true, buffer)
+				buffer.WriteString(commentlist__93)
+				WriteAll(utils.Url("/settings"), true, buffer)
+				buffer.WriteString(commentlist__94)
+
+			}
+
+			if meta.User.IsRoot() {
+				{
+					buffer.WriteString(commentlist__145)
+					WriteAll(utils.Url("/manage"), true, buffer)
+					buffer.WriteString(commentlist__146)
+					WriteAll(utils.Url("/manage/topics"), true, buffer)
+					buffer.WriteString(commentlist__147)
+					WriteAll(utils.Url("/manage/posts"), true, buffer)
+					buffer.WriteString(commentlist__148)
+					WriteAll(utils.Url("/manage/pages"), true, buffer)
+					buffer.WriteString(commentlist__149)
+					WriteAll(utils.Url("/manage/roles"), true, buffer)
+					buffer.WriteString(commentlist__150)
+					WriteAll(utils.Url("/manage/users"), true, buffer)
+					buffer.WriteString(commentlist__151)
+					WriteAll(utils.Url("/manage/comments"), true, buffer)
+					buffer.WriteString(commentlist__152)
+					WriteAll(utils.Url("/manage/files"), true, buffer)
+					buffer.WriteString(commentlist__153)
+					WriteAll(utils.Url("/manage/settings"), true, buffer)
+					buffer.WriteString(commentlist__154)
+					WriteAll(utils.Url("/manage/menus"), true, buffer)
+					buffer.WriteString(commentlist__155)
+					WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+					buffer.WriteString(commentlist__156)
+					WriteAll(utils.Url("/manage/audit"), true, buffer)
+					buffer.WriteString(commentlist__157)
+
+				}
+
+			}
+		}
+		buffer.WriteString(commentlist__28)
+
+		for _, topic := range cache.Topics() {
+			buffer.WriteString(commentlist__131)
+			WriteAll(topic.Url(), true, buffer)
+			buffer.WriteString(commentlist__70)
+			WriteAll(topic.Name, true, buffer)
+			buffer.WriteString(commentlist__48)
+			WriteAll("#"+topic.Name, true, buffer)
+			buffer.WriteString(commentlist__52)
+		}
+		buffer.WriteString(commentlist__29)
+
+		{
+			var (
+				location = "footer"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__30)
+		WriteAll(config.Setting("footer_content"), false, buffer)
+		buffer.WriteString(commentlist__31)
+		WriteAll(config.Setting("inject_footer"), false, buffer)
+		WriteAll(asset.JsFile("js/layout.js"), false, buffer)
+		WriteAll(asset.JsFile("js/main.js"), false, buffer)
+		buffer.WriteString(managecustomfieldcompose__39)
+
+	}
+}
@@ -0,0 +1,407 @@
+// Code generated by "jade.go"; DO NOT EDIT.
+
+package views
+
+import (
+	"bufio"
+	"fmt"
+
+	"github.com/ngocphuongnb/tetua/app/asset"
+	"github.com/ngocphuongnb/tetua/app/cache"
+	"github.com/ngocphuongnb/tetua/app/config"
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/utils"
+)
+
+const (
+	managecustomfieldindex__21  = `</ul><label class="menu-trigger"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M3,6H21V8H3V6M3,11H21V13H3V11M3,16H21V18H3V16Z"></path></svg></label></nav></header><div class="wrapper"><div class="container"><div class="layout"><div class="left"><div class="box fixed-sidebar">`
+	managecustomfieldindex__23  = `<h1>Custom fields</h1><a class="btn" href="/manage/custom-fields/new">New Custom Field</a><ul class="nodes-list">`
+	managecustomfieldindex__24  = `</ul></div></div><div class="right"><div class="box fixed-sidebar">`
+	managecustomfieldindex__25  = `</div></div></div></div><div class="mobile-menu"><div class="menu-head">`
+	managecustomfieldindex__103 = `</a><span class="status">`
+	managecustomfieldindex__104 = `</span></li>`
+	managecustomfieldindex__105 = `<strong style="margin-bottom:5px;display:block">Writing a Great Post</strong><ul><li>Using markdown shortcut to compose your post.</li><li>Use the Markdown switch button to toggle between rich text and plain markdown editor mode.</li><li>Select the topics that represent for the post content.</li></ul>`
+)
+
+func ManageCustomFieldIndex(fields []*entities.CustomField) func(meta *entities.Meta, wr *bufio.Writer) {
+	return func(meta *entities.Meta, wr *bufio.Writer) {
+		buffer := &WriterAsBuffer{wr}
+
+		buffer.WriteString(commentlist__0)
+
+		var title = meta.GetTitle()
+		var appName = config.Setting("app_name")
+		var appLogo = config.Setting("app_logo")
+		buffer.WriteString(commentlist__1)
+		WriteAll(meta.CsrfToken, true, buffer)
+		buffer.WriteString(commentlist__2)
+		WriteAll(title, true, buffer)
+		buffer.WriteString(commentlist__3)
+		WriteAll(meta.Canonical, true, buffer)
+		buffer.WriteString(commentlist__4)
+		WriteAll(meta.Type, true, buffer)
+		buffer.WriteString(commentlist__5)
+		WriteAll(meta.Canonical, true, buffer)
+		buffer.WriteString(commentlist__6)
+		WriteAll(title, true, buffer)
+		buffer.WriteString(commentlist__7)
+		WriteAll(appName, true, buffer)
+		buffer.WriteString(commentlist__8)
+		WriteAll(config.Setting("twitter_site"), true, buffer)
+		buffer.WriteString(commentlist__9)
+		WriteAll(title, true, buffer)
+		buffer.WriteString(commentlist__10)
+		WriteAll(appName, true, buffer)
+		buffer.WriteString(commentlist__11)
+		WriteAll(appName, true, buffer)
+		buffer.WriteString(commentlist__12)
+		WriteAll(appName+" Feed", true, buffer)
+		buffer.WriteString(commentlist__13)
+		WriteAll(utils.Url("/feed"), true, buffer)
+		buffer.WriteString(commentlist__14)
+		if appLogo != "" {
+			buffer.WriteString(commentlist__33)
+			WriteAll(appLogo, true, buffer)
+			buffer.WriteString(commentlist__34)
+			WriteAll(appLogo, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+		if meta.Description != "" {
+			buffer.WriteString(commentlist__36)
+			WriteAll(meta.Description, true, buffer)
+			buffer.WriteString(commentlist__37)
+			WriteAll(meta.Description, true, buffer)
+			buffer.WriteString(commentlist__38)
+			WriteAll(meta.Description, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+		if meta.Image != "" {
+			buffer.WriteString(commentlist__40)
+			WriteAll(meta.Image, true, buffer)
+			buffer.WriteString(commentlist__41)
+			WriteAll(meta.Image, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+		WriteAll(asset.CssFile("css/light.min.css"), false, buffer)
+		WriteAll(asset.CssFile("css/style.css"), false, buffer)
+		WriteAll(config.Setting("inject_header"), false, buffer)
+		buffer.WriteString(commentlist__15)
+		WriteAll(utils.Url(""), true, buffer)
+		buffer.WriteString(commentlist__16)
+		var logoUrl = config.Setting("app_logo")
+		if logoUrl != "" {
+			buffer.WriteString(commentlist__43)
+			WriteAll(logoUrl, true, buffer)
+			buffer.WriteString(commentlist__44)
+			WriteAll(config.Setting("app_name"), true, buffer)
+			buffer.WriteString(commentlist__14)
+		} else {
+			buffer.WriteString(commentlist__46)
+
+		}
+		buffer.WriteString(commentlist__17)
+		WriteAll(meta.Query, true, buffer)
+		buffer.WriteString(commentlist__18)
+
+		{
+			var (
+				location = "header"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__19)
+		WriteAll(utils.Url("/search"), true, buffer)
+		buffer.WriteString(commentlist__20)
+
+		if meta.User == nil || meta.User.ID == 0 {
+			buffer.WriteString(commentlist__50)
+			WriteAll(utils.Url("/login"), true, buffer)
+			buffer.WriteString(commentlist__66)
+			WriteAll(utils.Url("/register"), true, buffer)
+			buffer.WriteString(commentlist__67)
+
+		} else {
+			buffer.WriteString(commentlist__50)
+			WriteAll(utils.Url("/posts/new"), true, buffer)
+			buffer.WriteString(commentlist__69)
+			WriteAll(meta.User.Url(), true, buffer)
+			buffer.WriteString(commentlist__70)
+			WriteAll(meta.User.Username, true, buffer)
+			buffer.WriteString(commentlist__48)
+			if meta.User.AvatarImageUrl != "" {
+				buffer.WriteString(commentlist__78)
+				WriteAll(meta.User.AvatarImageUrl, true, buffer)
+				buffer.WriteString(commentlist__44)
+				WriteAll(meta.User.Username, true, buffer)
+				buffer.WriteString(commentlist__14)
+			} else {
+				buffer.WriteString(commentlist__81)
+
+			}
+			buffer.WriteString(commentlist__72)
+
+			if meta.User != nil && meta.User.IsRoot() {
+				buffer.WriteString(commentlist__50)
+				WriteAll(utils.Url("/manage"), true, buffer)
+				buffer.WriteString(commentlist__83)
+
+			}
+			buffer.WriteString(commentlist__50)
+			WriteAll(meta.User.Url(), true, buffer)
+			buffer.WriteString(commentlist__74)
+			WriteAll(utils.Url("/posts"), true, buffer)
+			buffer.WriteString(commentlist__75)
+			WriteAll(utils.Url("/settings"), true, buffer)
+			buffer.WriteString(commentlist__76)
+			WriteAll(utils.Url("/logout"), true, buffer)
+			buffer.WriteString(commentlist__77)
+
+		}
+		buffer.WriteString(managecustomfieldindex__21)
+
+		{
+			buffer.WriteString(commentlist__145)
+			WriteAll(utils.Url("/manage"), true, buffer)
+			buffer.WriteString(commentlist__146)
+			WriteAll(utils.Url("/manage/topics"), true, buffer)
+			buffer.WriteString(commentlist__147)
+			WriteAll(utils.Url("/manage/posts"), true, buffer)
+			buffer.WriteString(commentlist__148)
+			WriteAll(utils.Url("/manage/pages"), true, buffer)
+			buffer.WriteString(commentlist__149)
+			WriteAll(utils.Url("/manage/roles"), true, buffer)
+			buffer.WriteString(commentlist__150)
+			WriteAll(utils.Url("/manage/users"), true, buffer)
+			buffer.WriteString(commentlist__151)
+			WriteAll(utils.Url("/manage/comments"), true, buffer)
+			buffer.WriteString(commentlist__152)
+			WriteAll(utils.Url("/manage/files"), true, buffer)
+			buffer.WriteString(commentlist__153)
+			WriteAll(utils.Url("/manage/settings"), true, buffer)
+			buffer.WriteString(commentlist__154)
+			WriteAll(utils.Url("/manage/menus"), true, buffer)
+			buffer.WriteString(commentlist__155)
+			WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+			buffer.WriteString(commentlist__156)
+			WriteAll(utils.Url("/manage/audit"), true, buffer)
+			buffer.WriteString(commentlist__157)
+
+		}
+
+		buffer.WriteString(manageauditindex__22)
+
+		{
+			var (
+				msgs = meta.Messages
+			)
+
+			if msgs.Length() > 0 {
+				buffer.WriteString(commentlist__95)
+				var messages = msgs.Get()
+				for _, msg := range messages {
+					buffer.WriteString(commentlist__97)
+					WriteAll(msg.Type, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(msg.Message, true, buffer)
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(managecustomfieldindex__23)
+
+		for _, field := range fields {
+			buffer.WriteString(commentlist__50)
+			WriteEscString(fmt.Sprintf("/manage/custom-fields/%d", field.ID), buffer)
+			buffer.WriteString(commentlist__48)
+			WriteAll(field.Label, true, buffer)
+			buffer.WriteString(managecustomfieldindex__103)
+			WriteAll(field.Target+" / "+field.Name+" ("+field.Type+")", true, buffer)
+			buffer.WriteString(managecustomfieldindex__104)
+
+		}
+		buffer.WriteString(managecustomfieldindex__24)
+
+		{
+			{
+				buffer.WriteString(managecustomfieldindex__105)
+
+			}
+		}
+
+		buffer.WriteString(managecustomfieldindex__25)
+		WriteAll(config.Setting("app_name"), true, buffer)
+		buffer.WriteString(commentlist__27)
+
+		if meta.User == nil || meta.User.ID == 0 {
+			buffer.WriteString(commentlist__131)
+			WriteAll(utils.Url("/login"), true, buffer)
+			buffer.WriteString(commentlist__132)
+			WriteAll(utils.Url("/register"), true, buffer)
+			buffer.WriteString(commentlist__133)
+
+		} else {
+			{
+				buffer.WriteString(commentlist__84)
+				WriteAll(meta.User.AvatarElm("32", "32", false), false, buffer)
+				buffer.WriteString(commentlist__85)
+				WriteAll(meta.User.Url(), true, buffer)
+				buffer.WriteString(commentlist__48)
+				WriteAll(meta.User.Name(), true, buffer)
+				buffer.WriteString(commentlist__87)
+				WriteAll("@"+meta.User.Username, true, buffer)
+				buffer.WriteString(commentlist__88)
+				WriteAll(utils.Url("/posts/new"), true, buffer)
+				buffer.WriteString(commentlist__89)
+				WriteAll(utils.Url("/posts"), true, buffer)
+				buffer.WriteString(commentlist__90)
+				WriteAll(utils.Url("/comments"), true, buffer)
+				buffer.WriteString(commentlist__91)
+				WriteAll(utils.Url("/files"), true, buffer)
+				buffer.WriteString(commentlist__92)
+				WriteAll(utils.Url("/invites"), true, buffer)
+				buffer.WriteString(commentlist__93)
+				WriteAll(utils.Url("/settings"), true, buffer)
+				buffer.WriteString(commentlist__94)
+
+			}
+
+			if meta.User.IsRoot() {
+				{
+					buffer.WriteString(commentlist__145)
+					WriteAll(utils.Url("/manage"), true, buffer)
+					buffer.WriteString(commentlist__146)
+					WriteAll(utils.Url("/manage/topics"), true, buffer)
+					buffer.WriteString(commentlist__147)
+					WriteAll(utils.Url("/manage/posts"), true, buffer)
+					buffer.WriteString(commentlist__148)
+					WriteAll(utils.Url("/manage/pages"), true, buffer)
+					buffer.WriteString(commentlist__149)
+					WriteAll(utils.Url("/manage/roles"), true, buffer)
+					buffer.WriteString(commentlist__150)
+					WriteAll(utils.Url("/manage/users"), true, buffer)
+					buffer.WriteString(commentlist__151)
+					WriteAll(utils.Url("/manage/comments"), true, buffer)
+					buffer.WriteString(commentlist__152)
+					WriteAll(utils.Url("/manage/files"), true, buffer)
+					buffer.WriteString(commentlist__153)
+					WriteAll(utils.Url("/manage/settings"), true, buffer)
+					buffer.WriteString(commentlist__154)
+					WriteAll(utils.Url("/manage/menus"), true, buffer)
+					buffer.WriteString(commentlist__155)
+					WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+					buffer.WriteString(commentlist__156)
+					WriteAll(utils.Url("/manage/audit"), true, buffer)
+					buffer.WriteString(commentlist__157)
+
+				}
+
+			}
+		}
+		buffer.WriteString(commentlist__28)
+
+		for _, topic := range cache.Topics() {
+			buffer.WriteString(commentlist__131)
+			WriteAll(topic.Url(), true, buffer)
+			buffer.WriteString(commentlist__70)
+			WriteAll(topic.Name, true, buffer)
+			buffer.WriteString(commentlist__48)
+			WriteAll("#"+topic.Name, true, buffer)
+			buffer.WriteString(commentlist__52)
+		}
+		buffer.WriteString(commentlist__29)
+
+		{
+			var (
+				location = "footer"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__30)
+		WriteAll(config.Setting("footer_content"), false, buffer)
+		buffer.WriteString(commentlist__31)
+		WriteAll(config.Setting("inject_footer"), false, buffer)
+		WriteAll(asset.JsFile("js/layout.js"), false, buffer)
+		buffer.WriteString(error__29)
+
+	}
+}
@@ -0,0 +1,658 @@
+// Code generated by "jade.go"; DO NOT EDIT.
+
+package views
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ngocphuongnb/tetua/app/asset"
+	"github.com/ngocphuongnb/tetua/app/cache"
+	"github.com/ngocphuongnb/tetua/app/config"
+	"github.com/ngocphuongnb/tetua/app/entities"
+	"github.com/ngocphuongnb/tetua/app/utils"
+)
+
+const (
+	managemenucompose__21  = `</ul><label class="menu-trigger"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M3,6H21V8H3V6M3,11H21V13H3V11M3,16H21V18H3V16Z"></path></svg></label></nav></header><div class="wrapper"><div class="container"><form class="menu-form" method="POST">`
+	managemenucompose__24  = `<h2>Items</h2><div class="menu-items">`
+	managemenucompose__25  = `</div><template id="menu-item-template">`
+	managemenucompose__26  = `</template><button class="add-menu-item" type="button">Add item</button></div></div><div class="right"><div class="box fixed-sidebar"><div class="flex">`
+	managemenucompose__28  = `</div><p>Themes render the menu of a location, the default theme shows the <code>header</code> and <code>footer</code> menus.</p></div></div></div></form></div><div class="mobile-menu"><div class="menu-head">`
+	managemenucompose__34  = `<script src="/static/js/manage.js"></script><script>listenDeleteNodeEvents('menu', '/manage/menus', '/manage/menus')</script><script>`
+	managemenucompose__35  = `</script></body></html>`
+	managemenucompose__104 = `<h1>Create new menu</h1>`
+	managemenucompose__118 = `<div class="menu-item" data-depth="`
+	managemenucompose__120 = `"><input class="menu-item-depth" type="hidden" name="`
+	managemenucompose__122 = `"/><div class="flex"><input name="`
+	managemenucompose__124 = `" placeholder="Label"/><select class="menu-item-type" name="`
+	managemenucompose__126 = `</select></div><div class="flex"><select class="menu-item-target" data-type="page" name="`
+	managemenucompose__127 = `"><option value="0">Select a page</option>`
+	managemenucompose__128 = `</select><select class="menu-item-target" data-type="topic" name="`
+	managemenucompose__129 = `"><option value="0">Select a topic</option>`
+	managemenucompose__130 = `</select><input class="menu-item-target" data-type="post" type="number" min="0" name="`
+	managemenucompose__132 = `" placeholder="Post ID"/><input class="menu-item-target" data-type="custom" name="`
+	managemenucompose__134 = `" placeholder="https://example.com or /path"/></div><div class="menu-item-actions"><button type="button" data-action="up" title="Move up">↑</button><button type="button" data-action="down" title="Move down">↓</button><button type="button" data-action="outdent" title="Outdent">←</button><button type="button" data-action="indent" title="Indent">→</button><button class="danger" type="button" data-action="remove" title="Remove">✕</button></div></div>`
+	managemenucompose__191 = `<button class="danger delete-menu" data-id="`
+)
+
+func ManageMenuCompose(menu *entities.Menu, items []*entities.MenuItem, pages []*entities.Page, topics []*entities.Topic) func(meta *entities.Meta, wr *bufio.Writer) {
+	return func(meta *entities.Meta, wr *bufio.Writer) {
+		buffer := &WriterAsBuffer{wr}
+
+		buffer.WriteString(commentlist__0)
+
+		var title = meta.GetTitle()
+		var appName = config.Setting("app_name")
+		var appLogo = config.Setting("app_logo")
+		buffer.WriteString(commentlist__1)
+		WriteAll(meta.CsrfToken, true, buffer)
+		buffer.WriteString(commentlist__2)
+		WriteAll(title, true, buffer)
+		buffer.WriteString(commentlist__3)
+		WriteAll(meta.Canonical, true, buffer)
+		buffer.WriteString(commentlist__4)
+		WriteAll(meta.Type, true, buffer)
+		buffer.WriteString(commentlist__5)
+		WriteAll(meta.Canonical, true, buffer)
+		buffer.WriteString(commentlist__6)
+		WriteAll(title, true, buffer)
+		buffer.WriteString(commentlist__7)
+		WriteAll(appName, true, buffer)
+		buffer.WriteString(commentlist__8)
+		WriteAll(config.Setting("twitter_site"), true, buffer)
+		buffer.WriteString(commentlist__9)
+		WriteAll(title, true, buffer)
+		buffer.WriteString(commentlist__10)
+		WriteAll(appName, true, buffer)
+		buffer.WriteString(commentlist__11)
+		WriteAll(appName, true, buffer)
+		buffer.WriteString(commentlist__12)
+		WriteAll(appName+" Feed", true, buffer)
+		buffer.WriteString(commentlist__13)
+		WriteAll(utils.Url("/feed"), true, buffer)
+		buffer.WriteString(commentlist__14)
+		if appLogo != "" {
+			buffer.WriteString(commentlist__33)
+			WriteAll(appLogo, true, buffer)
+			buffer.WriteString(commentlist__34)
+			WriteAll(appLogo, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+		if meta.Description != "" {
+			buffer.WriteString(commentlist__36)
+			WriteAll(meta.Description, true, buffer)
+			buffer.WriteString(commentlist__37)
+			WriteAll(meta.Description, true, buffer)
+			buffer.WriteString(commentlist__38)
+			WriteAll(meta.Description, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+		if meta.Image != "" {
+			buffer.WriteString(commentlist__40)
+			WriteAll(meta.Image, true, buffer)
+			buffer.WriteString(commentlist__41)
+			WriteAll(meta.Image, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+		WriteAll(asset.CssFile("css/light.min.css"), false, buffer)
+		WriteAll(asset.CssFile("css/style.css"), false, buffer)
+		WriteAll(config.Setting("inject_header"), false, buffer)
+		buffer.WriteString(commentlist__15)
+		WriteAll(utils.Url(""), true, buffer)
+		buffer.WriteString(commentlist__16)
+		var logoUrl = config.Setting("app_logo")
+		if logoUrl != "" {
+			buffer.WriteString(commentlist__43)
+			WriteAll(logoUrl, true, buffer)
+			buffer.WriteString(commentlist__44)
+			WriteAll(config.Setting("app_name"), true, buffer)
+			buffer.WriteString(commentlist__14)
+		} else {
+			buffer.WriteString(commentlist__46)
+
+		}
+		buffer.WriteString(commentlist__17)
+		WriteAll(meta.Query, true, buffer)
+		buffer.WriteString(commentlist__18)
+
+		{
+			var (
+				location = "header"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__19)
+		WriteAll(utils.Url("/search"), true, buffer)
+		buffer.WriteString(commentlist__20)
+
+		if meta.User == nil || meta.User.ID == 0 {
+			buffer.WriteString(commentlist__50)
+			WriteAll(utils.Url("/login"), true, buffer)
+			buffer.WriteString(commentlist__66)
+			WriteAll(utils.Url("/register"), true, buffer)
+			buffer.WriteString(commentlist__67)
+
+		} else {
+			buffer.WriteString(commentlist__50)
+			WriteAll(utils.Url("/posts/new"), true, buffer)
+			buffer.WriteString(commentlist__69)
+			WriteAll(meta.User.Url(), true, buffer)
+			buffer.WriteString(commentlist__70)
+			WriteAll(meta.User.Username, true, buffer)
+			buffer.WriteString(commentlist__48)
+			if meta.User.AvatarImageUrl != "" {
+				buffer.WriteString(commentlist__78)
+				WriteAll(meta.User.AvatarImageUrl, true, buffer)
+				buffer.WriteString(commentlist__44)
+				WriteAll(meta.User.Username, true, buffer)
+				buffer.WriteString(commentlist__14)
+			} else {
+				buffer.WriteString(commentlist__81)
+
+			}
+			buffer.WriteString(commentlist__72)
+
+			if meta.User != nil && meta.User.IsRoot() {
+				buffer.WriteString(commentlist__50)
+				WriteAll(utils.Url("/manage"), true, buffer)
+				buffer.WriteString(commentlist__83)
+
+			}
+			buffer.WriteString(commentlist__50)
+			WriteAll(meta.User.Url(), true, buffer)
+			buffer.WriteString(commentlist__74)
+			WriteAll(utils.Url("/posts"), true, buffer)
+			buffer.WriteString(commentlist__75)
+			WriteAll(utils.Url("/settings"), true, buffer)
+			buffer.WriteString(commentlist__76)
+			WriteAll(utils.Url("/logout"), true, buffer)
+			buffer.WriteString(commentlist__77)
+
+		}
+		buffer.WriteString(managemenucompose__21)
+
+		{
+			buffer.WriteString(commentlist__125)
+			WriteAll(meta.CsrfToken, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+
+		buffer.WriteString(fileedit__22)
+
+		{
+			buffer.WriteString(commentlist__145)
+			WriteAll(utils.Url("/manage"), true, buffer)
+			buffer.WriteString(commentlist__146)
+			WriteAll(utils.Url("/manage/topics"), true, buffer)
+			buffer.WriteString(commentlist__147)
+			WriteAll(utils.Url("/manage/posts"), true, buffer)
+			buffer.WriteString(commentlist__148)
+			WriteAll(utils.Url("/manage/pages"), true, buffer)
+			buffer.WriteString(commentlist__149)
+			WriteAll(utils.Url("/manage/roles"), true, buffer)
+			buffer.WriteString(commentlist__150)
+			WriteAll(utils.Url("/manage/users"), true, buffer)
+			buffer.WriteString(commentlist__151)
+			WriteAll(utils.Url("/manage/comments"), true, buffer)
+			buffer.WriteString(commentlist__152)
+			WriteAll(utils.Url("/manage/files"), true, buffer)
+			buffer.WriteString(commentlist__153)
+			WriteAll(utils.Url("/manage/settings"), true, buffer)
+			buffer.WriteString(commentlist__154)
+			WriteAll(utils.Url("/manage/menus"), true, buffer)
+			buffer.WriteString(commentlist__155)
+			WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+			buffer.WriteString(commentlist__156)
+			WriteAll(utils.Url("/manage/audit"), true, buffer)
+			buffer.WriteString(commentlist__157)
+
+		}
+
+		buffer.WriteString(manageauditindex__22)
+
+		if menu.ID > 0 {
+			buffer.WriteString(managecustomfieldcompose__106)
+			WriteAll("Editing menu: "+menu.Name, true, buffer)
+			buffer.WriteString(error__22)
+		} else {
+			buffer.WriteString(managemenucompose__104)
+
+		}
+		{
+			var (
+				msgs = meta.Messages
+			)
+
+			if msgs.Length() > 0 {
+				buffer.WriteString(commentlist__95)
+				var messages = msgs.Get()
+				for _, msg := range messages {
+					buffer.WriteString(commentlist__97)
+					WriteAll(msg.Type, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(msg.Message, true, buffer)
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		{
+			var (
+				name  = "name"
+				value = menu.Name
+				label = "Name"
+			)
+
+			buffer.WriteString(fileedit__112)
+			WriteEscString(label, buffer)
+			buffer.WriteString(fileedit__113)
+			WriteEscString(name, buffer)
+			buffer.WriteString(fileedit__114)
+			WriteAll(value, true, buffer)
+			buffer.WriteString(fileedit__115)
+		}
+
+		{
+			var (
+				name  = "location"
+				value = menu.Location
+				label = "Location (e.g. header, footer)"
+			)
+
+			buffer.WriteString(fileedit__112)
+			WriteEscString(label, buffer)
+			buffer.WriteString(fileedit__113)
+			WriteEscString(name, buffer)
+			buffer.WriteString(fileedit__114)
+			WriteAll(value, true, buffer)
+			buffer.WriteString(fileedit__115)
+		}
+
+		buffer.WriteString(managemenucompose__24)
+
+		for i, item := range items {
+			{
+				var (
+					item   = item
+					index  = strconv.Itoa(i)
+					pages  = pages
+					topics = topics
+				)
+
+				buffer.WriteString(managemenucompose__118)
+				WriteAll(item.Depth, true, buffer)
+				buffer.WriteString(index__110)
+				WriteEscString(fmt.Sprintf("margin-left:%dem", item.Depth*2), buffer)
+				buffer.WriteString(managemenucompose__120)
+				WriteEscString("items."+index+".depth", buffer)
+				buffer.WriteString(fileedit__114)
+				WriteAll(item.Depth, true, buffer)
+				buffer.WriteString(managemenucompose__122)
+				WriteEscString("items."+index+".label", buffer)
+				buffer.WriteString(fileedit__114)
+				WriteAll(item.Label, true, buffer)
+				buffer.WriteString(managemenucompose__124)
+				WriteEscString("items."+index+".type", buffer)
+				buffer.WriteString(commentlist__48)
+				for _, itemType := range entities.MenuItemTypes {
+					if itemType == item.Type {
+						buffer.WriteString(filelist__117)
+						WriteAll(itemType, true, buffer)
+						buffer.WriteString(filelist__118)
+						WriteAll(strings.Title(itemType), true, buffer)
+						buffer.WriteString(filelist__119)
+					} else {
+						buffer.WriteString(filelist__117)
+						WriteAll(itemType, true, buffer)
+						buffer.WriteString(commentlist__48)
+						WriteAll(strings.Title(itemType), true, buffer)
+						buffer.WriteString(filelist__119)
+					}
+				}
+				buffer.WriteString(managemenucompose__126)
+				WriteEscString("items."+index+".page_id", buffer)
+				buffer.WriteString(managemenucompose__127)
+
+				for _, page := range pages {
+					if page.ID == item.TargetOf("page") {
+						buffer.WriteString(filelist__117)
+						WriteAll(page.ID, true, buffer)
+						buffer.WriteString(filelist__118)
+						WriteAll(page.Name, true, buffer)
+						buffer.WriteString(filelist__119)
+					} else {
+						buffer.WriteString(filelist__117)
+						WriteAll(page.ID, true, buffer)
+						buffer.WriteString(commentlist__48)
+						WriteAll(page.Name, true, buffer)
+						buffer.WriteString(filelist__119)
+					}
+				}
+				buffer.WriteString(managemenucompose__128)
+				WriteEscString("items."+index+".topic_id", buffer)
+				buffer.WriteString(managemenucompose__129)
+
+				for _, topic := range topics {
+					if topic.ID == item.TargetOf("topic") {
+						buffer.WriteString(filelist__117)
+						WriteAll(topic.ID, true, buffer)
+						buffer.WriteString(filelist__118)
+						WriteAll(topic.Name, true, buffer)
+						buffer.WriteString(filelist__119)
+					} else {
+						buffer.WriteString(filelist__117)
+						WriteAll(topic.ID, true, buffer)
+						buffer.WriteString(commentlist__48)
+						WriteAll(topic.Name, true, buffer)
+						buffer.WriteString(filelist__119)
+					}
+				}
+				buffer.WriteString(managemenucompose__130)
+				WriteEscString("items."+index+".post_id", buffer)
+				buffer.WriteString(fileedit__114)
+				WriteAll(item.TargetOf("post"), true, buffer)
+				buffer.WriteString(managemenucompose__132)
+				WriteEscString("items."+index+".url", buffer)
+				buffer.WriteString(fileedit__114)
+				WriteAll(item.Url, true, buffer)
+				buffer.WriteString(managemenucompose__134)
+
+			}
+
+		}
+		buffer.WriteString(managemenucompose__25)
+
+		var newItem = &entities.MenuItem{Type: entities.MENU_ITEM_CUSTOM}
+		{
+			var (
+				item   = newItem
+				index  = "0"
+				pages  = pages
+				topics = topics
+			)
+
+			buffer.WriteString(managemenucompose__118)
+			WriteAll(item.Depth, true, buffer)
+			buffer.WriteString(index__110)
+			WriteEscString(fmt.Sprintf("margin-left:%dem", item.Depth*2), buffer)
+			buffer.WriteString(managemenucompose__120)
+			WriteEscString("items."+index+".depth", buffer)
+			buffer.WriteString(fileedit__114)
+			WriteAll(item.Depth, true, buffer)
+			buffer.WriteString(managemenucompose__122)
+			WriteEscString("items."+index+".label", buffer)
+			buffer.WriteString(fileedit__114)
+			WriteAll(item.Label, true, buffer)
+			buffer.WriteString(managemenucompose__124)
+			WriteEscString("items."+index+".type", buffer)
+			buffer.WriteString(commentlist__48)
+			for _, itemType := range entities.MenuItemTypes {
+				if itemType == item.Type {
+					buffer.WriteString(filelist__117)
+					WriteAll(itemType, true, buffer)
+					buffer.WriteString(filelist__118)
+					WriteAll(strings.Title(itemType), true, buffer)
+					buffer.WriteString(filelist__119)
+				} else {
+					buffer.WriteString(filelist__117)
+					WriteAll(itemType, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(strings.Title(itemType), true, buffer)
+					buffer.WriteString(filelist__119)
+				}
+			}
+			buffer.WriteString(managemenucompose__126)
+			WriteEscString("items."+index+".page_id", buffer)
+			buffer.WriteString(managemenucompose__127)
+
+			for _, page := range pages {
+				if page.ID == item.TargetOf("page") {
+					buffer.WriteString(filelist__117)
+					WriteAll(page.ID, true, buffer)
+					buffer.WriteString(filelist__118)
+					WriteAll(page.Name, true, buffer)
+					buffer.WriteString(filelist__119)
+				} else {
+					buffer.WriteString(filelist__117)
+					WriteAll(page.ID, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(page.Name, true, buffer)
+					buffer.WriteString(filelist__119)
+				}
+			}
+			buffer.WriteString(managemenucompose__128)
+			WriteEscString("items."+index+".topic_id", buffer)
+			buffer.WriteString(managemenucompose__129)
+
+			for _, topic := range topics {
+				if topic.ID == item.TargetOf("topic") {
+					buffer.WriteString(filelist__117)
+					WriteAll(topic.ID, true, buffer)
+					buffer.WriteString(filelist__118)
+					WriteAll(topic.Name, true, buffer)
+					buffer.WriteString(filelist__119)
+				} else {
+					buffer.WriteString(filelist__117)
+					WriteAll(topic.ID, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(topic.Name, true, buffer)
+					buffer.WriteString(filelist__119)
+				}
+			}
+			buffer.WriteString(managemenucompose__130)
+			WriteEscString("items."+index+".post_id", buffer)
+			buffer.WriteString(fileedit__114)
+			WriteAll(item.TargetOf("post"), true, buffer)
+			buffer.WriteString(managemenucompose__132)
+			WriteEscString("items."+index+".url", buffer)
+			buffer.WriteString(fileedit__114)
+			WriteAll(item.Url, true, buffer)
+			buffer.WriteString(managemenucompose__134)
+
+		}
+
+		buffer.WriteString(managemenucompose__26)
+
+		{
+			var (
+				label = "New Menu"
+				link  = "/manage/menus/new"
+			)
+
+			buffer.WriteString(managecustomfieldcompose__126)
+			WriteEscString(link, buffer)
+			buffer.WriteString(managecustomfieldcompose__127)
+			WriteEscString(label, buffer)
+			buffer.WriteString(commentlist__52)
+		}
+
+		buffer.WriteString(managecustomfieldcompose__27)
+
+		if menu.ID > 0 {
+			buffer.WriteString(managemenucompose__191)
+			WriteAll(menu.ID, true, buffer)
+			buffer.WriteString(managecustomfieldcompose__130)
+
+		}
+		buffer.WriteString(managemenucompose__28)
+		WriteAll(config.Setting("app_name"), true, buffer)
+		buffer.WriteString(commentlist__27)
+
+		if meta.User == nil || meta.User.ID == 0 {
+			buffer.WriteString(commentlist__131)
+			WriteAll(utils.Url("/login"), true, buffer)
+			buffer.WriteString(commentlist__132)
+			WriteAll(utils.Url("/register"), true, buffer)
+			buffer.WriteString(commentlist__133)
+
+		} else {
+			{
+				buffer.WriteString(commentlist__84)
+				WriteAll(meta.User.AvatarElm("32", "32", false), false, buffer)
+				buffer.WriteString(commentlist__85)
+				WriteAll(meta.User.Url(), true, buffer)
+				buffer.WriteString(commentlist__48)
+				WriteAll(meta.User.Name(), true, buffer)
+				buffer.WriteString(commentlist__87)
+				WriteAll("@"+meta.User.Username, true, buffer)
+				buffer.WriteString(commentlist__88)
+				WriteAll(utils.Url("/posts/new"), true, buffer)
+				buffer.WriteString(commentlist__89)
+				WriteAll(utils.Url("/posts"), true, buffer)
+				buffer.WriteString(commentlist__90)
+				WriteAll(utils.Url("/comments"), true, buffer)
+				buffer.WriteString(commentlist__91)
+				WriteAll(utils.Url("/files"), true, buffer)
+				buffer.WriteString(commentlist__92)
+				WriteAll(utils.Url("/invites"), true, buffer)
+				buffer.WriteString(commentlist__93)
+				WriteAll(utils.Url("/settings"), true, buffer)
+				buffer.WriteString(commentlist__94)
+
+			}
+
+			if meta.User.IsRoot() {
+				{
+					buffer.WriteString(commentlist__145)
+					WriteAll(utils.Url("/manage"), true, buffer)
+					buffer.WriteString(commentlist__146)
+					WriteAll(utils.Url("/manage/topics"), true, buffer)
+					buffer.WriteString(commentlist__147)
+					WriteAll(utils.Url("/manage/posts"), true, buffer)
+					buffer.WriteString(commentlist__148)
+					WriteAll(utils.Url("/manage/pages"), true, buffer)
+					buffer.WriteString(commentlist__149)
+					WriteAll(utils.Url("/manage/roles"), true, buffer)
+					buffer.WriteString(commentlist__150)
+					WriteAll(utils.Url("/manage/users"), true, buffer)
+					buffer.WriteString(commentlist__151)
+					WriteAll(utils.Url("/manage/comments"), true, buffer)
+					buffer.WriteString(commentlist__152)
+					WriteAll(utils.Url("/manage/files"), true, buffer)
+					buffer.WriteString(commentlist__153)
+					WriteAll(utils.Url("/manage/settings"), true, buffer)
+					buffer.WriteString(commentlist__154)
+					WriteAll(utils.Url("/manage/menus"), true, buffer)
+					buffer.WriteString(commentlist__155)
+					WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+					buffer.WriteString(commentlist__156)
+					WriteAll(utils.Url("/manage/audit"), true, buffer)
+					buffer.WriteString(commentlist__157)
+
+				}
+
+			}
+		}
+		buffer.WriteString(commentlist__28)
+
+		for _, topic := range cache.Topics() {
+			buffer.WriteString(commentlist__131)
+			WriteAll(topic.Url(), true, buffer)
+			buffer.WriteString(commentlist__70)
+			WriteAll(topic.Name, true, buffer)
+			buffer.WriteString(commentlist__48)
+			WriteAll("#"+topic.Name, true, buffer)
+			buffer.WriteString(commentlist__52)
+		}
+		buffer.WriteString(commentlist__29)
+
+		{
+			var (
+				location = "footer"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__30)
+		WriteAll(config.Setting("footer_content"), false, buffer)
+		buffer.WriteString(commentlist__31)
+		WriteAll(config.Setting("inject_footer"), false, buffer)
+		WriteAll(asset.JsFile("js/layout.js"), false, buffer)
+		WriteAll(asset.JsFile("js/main.js"), false, buffer)
+		buffer.WriteString(managemenucompose__34)
+		WriteEscString(fmt.Sprintf("initMenuEditor(%d)", entities.MENU_MAX_DEPTH), buffer)
+		buffer.WriteString(managemenucompose__35)
+
+	}
+}
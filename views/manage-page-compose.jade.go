@@ -13,33 +13,43 @@ import (
 )
 
 const (
-	managepagecompose__19  = `</ul><label class="menu-trigger"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M3,6H21V8H3V6M3,11H21V13H3V11M3,16H21V18H3V16Z"></path></svg></label></nav></header><div class="wrapper"><div class="container"><form method="POST" enctype="multipart/form-data"><div class="layout"><div class="left"><div class="box fixed-sidebar">`
-	managepagecompose__21  = `<textarea class="content" name="content">`
-	managepagecompose__22  = `</textarea></div></div><div class="right"><div class="box fixed-sidebar"><div class="flex">`
-	managepagecompose__23  = `</div><div></div><div class="save-actions"><button>Save</button><label class="switch" for="save-draft">Draft &nbsp;`
-	managepagecompose__24  = `<span class="slider"></span></label></div>`
-	managepagecompose__25  = `<div><strong>Featured Image</strong><input type="hidden" name="featured_image_id" value="`
-	managepagecompose__26  = `"/><input class="image-input" id="featured-image" type="file" name="featured_image"/><div class="image-upload-previewer" for="featured-image"><img src="`
-	managepagecompose__27  = `"/></div></div>`
-	managepagecompose__28  = `</div></div></div></form></div><div class="mobile-menu"><div class="menu-head">`
-	managepagecompose__33  = `<script src="/static/js/manage.js"></script><script>listenDeleteNodeEvents('page', '/manage/pages', '/manage/pages')</script><script>new TetuaEditor('.content', {uploadHandler: uploadHandler, disableTitle: true});</script></body></html>`
-	managepagecompose__77  = `<h1>`
-	managepagecompose__79  = `<h1>New page</h1>`
-	managepagecompose__85  = `<p><label>`
-	managepagecompose__86  = `</label><input name="`
-	managepagecompose__87  = `" value="`
-	managepagecompose__88  = `"/></p>`
-	managepagecompose__93  = `<a class="link-icon" href="`
-	managepagecompose__94  = `"><svg style="width:24px;height:24px" viewBox="0 0 24 24"><path fill="currentColor" d="M17,13H13V17H11V13H7V11H11V7H13V11H17M12,2A10,10 0 0,0 2,12A10,10 0 0,0 12,22A10,10 0 0,0 22,12A10,10 0 0,0 12,2Z"></path></svg>`
-	managepagecompose__96  = `<input id="save-draft" type="checkbox" name="draft" checked="checked"/>`
-	managepagecompose__97  = `<input id="save-draft" type="checkbox" name="draft"/>`
-	managepagecompose__98  = `<div class="flex"><a class="btn" target="_blank" href="`
-	managepagecompose__99  = `">Preview</a><button class="danger delete-page" data-id="`
-	managepagecompose__100 = `">Delete</button></div>`
-	managepagecompose__101 = `<strong style="margin-bottom:5px;display:block">Writing a Great Post</strong><ul><li>Using markdown shortcut to compose your post.</li><li>Use the Markdown switch button to toggle between rich text and plain markdown editor mode.</li><li>Select the topics that represent for the post content.</li></ul>`
+	managepagecompose__21  = `</ul><label class="menu-trigger"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M3,6H21V8H3V6M3,11H21V13H3V11M3,16H21V18H3V16Z"></path></svg></label></nav></header><div class="wrapper"><div class="container"><form method="POST" enctype="multipart/form-data">`
+	managepagecompose__24  = `<textarea class="content" name="content">`
+	managepagecompose__25  = `</textarea></div></div><div class="right"><div class="box fixed-sidebar"><div class="flex">`
+	managepagecompose__26  = `</div><div><label>Parent page</label><select name="parent_id"><option value="0">No parent</option>`
+	managepagecompose__27  = `</select></div><div><label>Template</label><select name="template"><option value="">Default</option>`
+	managepagecompose__28  = `</select></div>`
+	managepagecompose__29  = `<div><label>Order</label><input type="number" name="order" value="`
+	managepagecompose__30  = `"/></div><div class="save-actions"><button>Save</button><label class="switch" for="save-draft">Draft &nbsp;`
+	managepagecompose__31  = `<span class="slider"></span></label></div>`
+	managepagecompose__32  = `<div><strong>Featured Image</strong><input type="hidden" name="featured_image_id" value="`
+	managepagecompose__33  = `"/><input class="image-input" id="featured-image" type="file" name="featured_image"/><div class="image-upload-previewer" for="featured-image"><img src="`
+	managepagecompose__34  = `"/></div><a class="pick-featured-image" href="#">Choose from library</a></div>`
+	managepagecompose__41  = `<script src="/static/js/manage.js"></script><script>listenDeleteNodeEvents('page', '/manage/pages', '/manage/pages')</script><script>new TetuaEditor('.content', {uploadHandler: uploadHandler, pickHandler: imagePickHandler, disableTitle: true});</script></body></html>`
+	managepagecompose__110 = `<h1>New page</h1>`
+	managepagecompose__139 = `<div class="custom-field"><label for="`
+	managepagecompose__141 = `</label>`
+	managepagecompose__143 = ` *`
+	managepagecompose__144 = `<textarea id="`
+	managepagecompose__145 = `" name="`
+	managepagecompose__147 = `</textarea>`
+	managepagecompose__148 = `<input id="`
+	managepagecompose__149 = `" type="checkbox" name="`
+	managepagecompose__154 = `<select id="`
+	managepagecompose__156 = `"><option value="">-</option>`
+	managepagecompose__157 = `</select>`
+	managepagecompose__165 = `" type="number" step="any" name="`
+	managepagecompose__169 = `" type="url" name="`
+	managepagecompose__176 = `<small>`
+	managepagecompose__177 = `</small>`
+	managepagecompose__178 = `<input id="save-draft" type="checkbox" name="draft" checked="checked"/>`
+	managepagecompose__179 = `<input id="save-draft" type="checkbox" name="draft"/>`
+	managepagecompose__180 = `<div class="flex"><a class="btn" target="_blank" href="`
+	managepagecompose__181 = `">Preview</a><button class="danger delete-page" data-id="`
+	managepagecompose__182 = `">Delete</button></div>`
 )
 
-func ManagePageCompose(page *entities.Page, featuredImage *entities.File) func(meta *entities.Meta, wr *bufio.Writer) {
+func ManagePageCompose(page *entities.Page, featuredImage *entities.File, pages []*entities.Page) func(meta *entities.Meta, wr *bufio.Writer) {
 	return func(meta *entities.Meta, wr *bufio.Writer) {
 		buffer := &WriterAsBuffer{wr}
 
@@ -49,52 +59,54 @@ func ManagePageCompose(page *entities.Page, featuredImage *entities.File) func(m
 		var appName = config.Setting("app_name")
 		var appLogo = config.Setting("app_logo")
 		buffer.WriteString(commentlist__1)
-		WriteAll(title, true, buffer)
+		WriteAll(meta.CsrfToken, true, buffer)
 		buffer.WriteString(commentlist__2)
-		WriteAll(meta.Canonical, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__3)
-		WriteAll(meta.Type, true, buffer)
-		buffer.WriteString(commentlist__4)
 		WriteAll(meta.Canonical, true, buffer)
+		buffer.WriteString(commentlist__4)
+		WriteAll(meta.Type, true, buffer)
 		buffer.WriteString(commentlist__5)
-		WriteAll(title, true, buffer)
+		WriteAll(meta.Canonical, true, buffer)
 		buffer.WriteString(commentlist__6)
-		WriteAll(appName, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__7)
-		WriteAll(config.Setting("twitter_site"), true, buffer)
+		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__8)
-		WriteAll(title, true, buffer)
+		WriteAll(config.Setting("twitter_site"), true, buffer)
 		buffer.WriteString(commentlist__9)
-		WriteAll(appName, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__10)
 		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__11)
-		WriteAll(appName+" Feed", true, buffer)
+		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__12)
-		WriteAll(utils.Url("/feed"), true, buffer)
+		WriteAll(appName+" Feed", true, buffer)
 		buffer.WriteString(commentlist__13)
+		WriteAll(utils.Url("/feed"), true, buffer)
+		buffer.WriteString(commentlist__14)
 		if appLogo != "" {
-			buffer.WriteString(commentlist__30)
+			buffer.WriteString(commentlist__33)
 			WriteAll(appLogo, true, buffer)
-			buffer.WriteString(commentlist__31)
+			buffer.WriteString(commentlist__34)
 			WriteAll(appLogo, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		if meta.Description != "" {
-			buffer.WriteString(commentlist__33)
+			buffer.WriteString(commentlist__36)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__34)
+			buffer.WriteString(commentlist__37)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__35)
+			buffer.WriteString(commentlist__38)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		if meta.Image != "" {
-			buffer.WriteString(commentlist__37)
+			buffer.WriteString(commentlist__40)
 			WriteAll(meta.Image, true, buffer)
-			buffer.WriteString(commentlist__38)
+			buffer.WriteString(commentlist__41)
 			WriteAll(meta.Image, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		WriteAll(asset.CssFile("css/light.min.css"), false, buffer)
 		WriteAll(asset.CssFile("editor/tippy-6.3.7.min.css"), false, buffer)
@@ -102,103 +114,164 @@ func ManagePageCompose(page *entities.Page, featuredImage *entities.File) func(m
 		WriteAll(asset.CssFile("editor/style.css"), false, buffer)
 		WriteAll(asset.CssFile("css/style.css"), false, buffer)
 		WriteAll(config.Setting("inject_header"), false, buffer)
-		buffer.WriteString(commentlist__14)
-		WriteAll(utils.Url(""), true, buffer)
 		buffer.WriteString(commentlist__15)
+		WriteAll(utils.Url(""), true, buffer)
+		buffer.WriteString(commentlist__16)
 		var logoUrl = config.Setting("app_logo")
 		if logoUrl != "" {
-			buffer.WriteString(commentlist__40)
+			buffer.WriteString(commentlist__43)
 			WriteAll(logoUrl, true, buffer)
-			buffer.WriteString(commentlist__41)
+			buffer.WriteString(commentlist__44)
 			WriteAll(config.Setting("app_name"), true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		} else {
-			buffer.WriteString(commentlist__43)
+			buffer.WriteString(commentlist__46)
 
 		}
-		buffer.WriteString(commentlist__16)
-		WriteAll(meta.Query, true, buffer)
 		buffer.WriteString(commentlist__17)
-		WriteAll(utils.Url("/search"), true, buffer)
+		WriteAll(meta.Query, true, buffer)
 		buffer.WriteString(commentlist__18)
 
+		{
+			var (
+				location = "header"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__19)
+		WriteAll(utils.Url("/search"), true, buffer)
+		buffer.WriteString(commentlist__20)
+
 		if meta.User == nil || meta.User.ID == 0 {
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(utils.Url("/login"), true, buffer)
-			buffer.WriteString(commentlist__45)
+			buffer.WriteString(commentlist__66)
 			WriteAll(utils.Url("/register"), true, buffer)
-			buffer.WriteString(commentlist__46)
+			buffer.WriteString(commentlist__67)
 
 		} else {
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(utils.Url("/posts/new"), true, buffer)
-			buffer.WriteString(commentlist__48)
+			buffer.WriteString(commentlist__69)
 			WriteAll(meta.User.Url(), true, buffer)
-			buffer.WriteString(commentlist__49)
+			buffer.WriteString(commentlist__70)
 			WriteAll(meta.User.Username, true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			if meta.User.AvatarImageUrl != "" {
-				buffer.WriteString(commentlist__57)
+				buffer.WriteString(commentlist__78)
 				WriteAll(meta.User.AvatarImageUrl, true, buffer)
-				buffer.WriteString(commentlist__41)
+				buffer.WriteString(commentlist__44)
 				WriteAll(meta.User.Username, true, buffer)
-				buffer.WriteString(commentlist__13)
+				buffer.WriteString(commentlist__14)
 			} else {
-				buffer.WriteString(commentlist__60)
+				buffer.WriteString(commentlist__81)
 
 			}
-			buffer.WriteString(commentlist__51)
+			buffer.WriteString(commentlist__72)
 
 			if meta.User != nil && meta.User.IsRoot() {
-				buffer.WriteString(commentlist__44)
+				buffer.WriteString(commentlist__50)
 				WriteAll(utils.Url("/manage"), true, buffer)
-				buffer.WriteString(commentlist__62)
+				buffer.WriteString(commentlist__83)
 
 			}
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(meta.User.Url(), true, buffer)
-			buffer.WriteString(commentlist__53)
+			buffer.WriteString(commentlist__74)
 			WriteAll(utils.Url("/posts"), true, buffer)
-			buffer.WriteString(commentlist__54)
+			buffer.WriteString(commentlist__75)
 			WriteAll(utils.Url("/settings"), true, buffer)
-			buffer.WriteString(commentlist__55)
+			buffer.WriteString(commentlist__76)
 			WriteAll(utils.Url("/logout"), true, buffer)
-			buffer.WriteString(commentlist__56)
+			buffer.WriteString(commentlist__77)
 
 		}
-		buffer.WriteString(managepagecompose__19)
+		buffer.WriteString(managepagecompose__21)
+
+		{
+			buffer.WriteString(commentlist__125)
+			WriteAll(meta.CsrfToken, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+
+		buffer.WriteString(fileedit__22)
 
 		{
-			buffer.WriteString(commentlist__119)
+			buffer.WriteString(commentlist__145)
 			WriteAll(utils.Url("/manage"), true, buffer)
-			buffer.WriteString(commentlist__120)
+			buffer.WriteString(commentlist__146)
 			WriteAll(utils.Url("/manage/topics"), true, buffer)
-			buffer.WriteString(commentlist__121)
+			buffer.WriteString(commentlist__147)
 			WriteAll(utils.Url("/manage/posts"), true, buffer)
-			buffer.WriteString(commentlist__122)
+			buffer.WriteString(commentlist__148)
 			WriteAll(utils.Url("/manage/pages"), true, buffer)
-			buffer.WriteString(commentlist__123)
+			buffer.WriteString(commentlist__149)
 			WriteAll(utils.Url("/manage/roles"), true, buffer)
-			buffer.WriteString(commentlist__124)
+			buffer.WriteString(commentlist__150)
 			WriteAll(utils.Url("/manage/users"), true, buffer)
-			buffer.WriteString(commentlist__125)
+			buffer.WriteString(commentlist__151)
 			WriteAll(utils.Url("/manage/comments"), true, buffer)
-			buffer.WriteString(commentlist__126)
+			buffer.WriteString(commentlist__152)
 			WriteAll(utils.Url("/manage/files"), true, buffer)
-			buffer.WriteString(commentlist__127)
+			buffer.WriteString(commentlist__153)
 			WriteAll(utils.Url("/manage/settings"), true, buffer)
-			buffer.WriteString(commentlist__72)
+			buffer.WriteString(commentlist__154)
+			WriteAll(utils.Url("/manage/menus"), true, buffer)
+			buffer.WriteString(commentlist__155)
+			WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+			buffer.WriteString(commentlist__156)
+			WriteAll(utils.Url("/manage/audit"), true, buffer)
+			buffer.WriteString(commentlist__157)
 
 		}
 
-		buffer.WriteString(managecommentindex__20)
+		buffer.WriteString(manageauditindex__22)
 
 		if page.ID > 0 {
-			buffer.WriteString(managepagecompose__77)
+			buffer.WriteString(managecustomfieldcompose__106)
 			WriteAll(page.Name, true, buffer)
-			buffer.WriteString(error__20)
+			buffer.WriteString(error__22)
 		} else {
-			buffer.WriteString(managepagecompose__79)
+			buffer.WriteString(managepagecompose__110)
 
 		}
 		{
@@ -207,16 +280,16 @@ func ManagePageCompose(page *entities.Page, featuredImage *entities.File) func(m
 			)
 
 			if msgs.Length() > 0 {
-				buffer.WriteString(commentlist__73)
+				buffer.WriteString(commentlist__95)
 				var messages = msgs.Get()
 				for _, msg := range messages {
-					buffer.WriteString(commentlist__75)
+					buffer.WriteString(commentlist__97)
 					WriteAll(msg.Type, true, buffer)
-					buffer.WriteString(commentlist__50)
+					buffer.WriteString(commentlist__48)
 					WriteAll(msg.Message, true, buffer)
-					buffer.WriteString(commentlist__77)
+					buffer.WriteString(commentlist__53)
 				}
-				buffer.WriteString(commentlist__74)
+				buffer.WriteString(commentlist__49)
 			}
 		}
 
@@ -227,13 +300,13 @@ func ManagePageCompose(page *entities.Page, featuredImage *entities.File) func(m
 				label = "Name"
 			)
 
-			buffer.WriteString(managepagecompose__85)
+			buffer.WriteString(fileedit__112)
 			WriteEscString(label, buffer)
-			buffer.WriteString(managepagecompose__86)
+			buffer.WriteString(fileedit__113)
 			WriteEscString(name, buffer)
-			buffer.WriteString(managepagecompose__87)
+			buffer.WriteString(fileedit__114)
 			WriteAll(value, true, buffer)
-			buffer.WriteString(managepagecompose__88)
+			buffer.WriteString(fileedit__115)
 		}
 
 		{
@@ -243,18 +316,18 @@ func ManagePageCompose(page *entities.Page, featuredImage *entities.File) func(m
 				label = "Slug"
 			)
 
-			buffer.WriteString(managepagecompose__85)
+			buffer.WriteString(fileedit__112)
 			WriteEscString(label, buffer)
-			buffer.WriteString(managepagecompose__86)
+			buffer.WriteString(fileedit__113)
 			WriteEscString(name, buffer)
-			buffer.WriteString(managepagecompose__87)
+			buffer.WriteString(fileedit__114)
 			WriteAll(value, true, buffer)
-			buffer.WriteString(managepagecompose__88)
+			buffer.WriteString(fileedit__115)
 		}
 
-		buffer.WriteString(managepagecompose__21)
+		buffer.WriteString(managepagecompose__24)
 		WriteAll(page.Content, true, buffer)
-		buffer.WriteString(managepagecompose__22)
+		buffer.WriteString(managepagecompose__25)
 
 		{
 			var (
@@ -262,122 +335,327 @@ func ManagePageCompose(page *entities.Page, featuredImage *entities.File) func(m
 				link  = "/manage/pages/new"
 			)
 
-			buffer.WriteString(managepagecompose__93)
+			buffer.WriteString(managecustomfieldcompose__126)
 			WriteEscString(link, buffer)
-			buffer.WriteString(managepagecompose__94)
+			buffer.WriteString(managecustomfieldcompose__127)
 			WriteEscString(label, buffer)
-			buffer.WriteString(commentlist__132)
+			buffer.WriteString(commentlist__52)
+		}
+
+		buffer.WriteString(managepagecompose__26)
+
+		for _, parent := range pages {
+			{
+				var (
+					value    = parent.ID
+					selected = page.ParentID
+					label    = parent.Path()
+				)
+
+				if value == selected {
+					buffer.WriteString(filelist__117)
+					WriteAll(value, true, buffer)
+					buffer.WriteString(filelist__118)
+					WriteAll(label, true, buffer)
+					buffer.WriteString(filelist__119)
+				} else {
+					buffer.WriteString(filelist__117)
+					WriteAll(value, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(label, true, buffer)
+					buffer.WriteString(filelist__119)
+				}
+			}
+
+		}
+		buffer.WriteString(managepagecompose__27)
+
+		for _, template := range asset.PageTemplates() {
+			{
+				var (
+					value    = template.Name
+					selected = page.Template
+					label    = template.Label
+				)
+
+				if value == selected {
+					buffer.WriteString(filelist__117)
+					WriteAll(value, true, buffer)
+					buffer.WriteString(filelist__118)
+					WriteAll(label, true, buffer)
+					buffer.WriteString(filelist__119)
+				} else {
+					buffer.WriteString(filelist__117)
+					WriteAll(value, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(label, true, buffer)
+					buffer.WriteString(filelist__119)
+				}
+			}
+
+		}
+		buffer.WriteString(managepagecompose__28)
+
+		{
+			var (
+				target = "page"
+				values = page.CustomFields
+			)
+
+			for _, field := range cache.CustomFields(target) {
+				buffer.WriteString(managepagecompose__139)
+				WriteAll(field.InputName(), true, buffer)
+				buffer.WriteString(commentlist__48)
+				WriteAll(field.Label, true, buffer)
+				if field.Required {
+					buffer.WriteString(managepagecompose__143)
+				}
+				buffer.WriteString(managepagecompose__141)
+				if field.Type == "textarea" {
+					buffer.WriteString(managepagecompose__144)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(managepagecompose__145)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(values[field.Name], true, buffer)
+					buffer.WriteString(managepagecompose__147)
+				} else if field.Type == "boolean" {
+					if values[field.Name] == "true" {
+						buffer.WriteString(managepagecompose__148)
+						WriteAll(field.InputName(), true, buffer)
+						buffer.WriteString(managepagecompose__149)
+						WriteAll(field.InputName(), true, buffer)
+						buffer.WriteString(managecustomfieldcompose__147)
+					} else {
+						buffer.WriteString(managepagecompose__148)
+						WriteAll(field.InputName(), true, buffer)
+						buffer.WriteString(managepagecompose__149)
+						WriteAll(field.InputName(), true, buffer)
+						buffer.WriteString(commentlist__14)
+					}
+				} else if field.Type == "select" {
+					buffer.WriteString(managepagecompose__154)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(managepagecompose__145)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(managepagecompose__156)
+
+					for _, option := range field.Options {
+						if option == values[field.Name] {
+							buffer.WriteString(filelist__117)
+							WriteAll(option, true, buffer)
+							buffer.WriteString(filelist__118)
+							WriteAll(option, true, buffer)
+							buffer.WriteString(filelist__119)
+						} else {
+							buffer.WriteString(filelist__117)
+							WriteAll(option, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(option, true, buffer)
+							buffer.WriteString(filelist__119)
+						}
+					}
+					buffer.WriteString(managepagecompose__157)
+				} else if field.Type == "number" {
+					buffer.WriteString(managepagecompose__148)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(managepagecompose__165)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(fileedit__114)
+					WriteAll(values[field.Name], true, buffer)
+					buffer.WriteString(commentlist__14)
+				} else if field.Type == "url" {
+					buffer.WriteString(managepagecompose__148)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(managepagecompose__169)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(fileedit__114)
+					WriteAll(values[field.Name], true, buffer)
+					buffer.WriteString(commentlist__14)
+				} else {
+					buffer.WriteString(managepagecompose__148)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(managepagecompose__145)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(fileedit__114)
+					WriteAll(values[field.Name], true, buffer)
+					buffer.WriteString(commentlist__14)
+				}
+				if field.Description != "" {
+					buffer.WriteString(managepagecompose__176)
+					WriteAll(field.Description, true, buffer)
+					buffer.WriteString(managepagecompose__177)
+				}
+				buffer.WriteString(commentlist__24)
+			}
 		}
 
-		buffer.WriteString(managepagecompose__23)
+		buffer.WriteString(managepagecompose__29)
+		WriteAll(page.Order, true, buffer)
+		buffer.WriteString(managepagecompose__30)
 
 		if page.Draft {
-			buffer.WriteString(managepagecompose__96)
+			buffer.WriteString(managepagecompose__178)
 		} else {
-			buffer.WriteString(managepagecompose__97)
+			buffer.WriteString(managepagecompose__179)
 		}
-		buffer.WriteString(managepagecompose__24)
+		buffer.WriteString(managepagecompose__31)
 
 		if page.ID > 0 {
-			buffer.WriteString(managepagecompose__98)
+			buffer.WriteString(managepagecompose__180)
 			WriteAll(page.Url(), true, buffer)
-			buffer.WriteString(managepagecompose__99)
+			buffer.WriteString(managepagecompose__181)
 			WriteAll(page.ID, true, buffer)
-			buffer.WriteString(managepagecompose__100)
+			buffer.WriteString(managepagecompose__182)
 
 		}
-		buffer.WriteString(managepagecompose__25)
+		buffer.WriteString(managepagecompose__32)
 		WriteAll(page.FeaturedImageID, true, buffer)
-		buffer.WriteString(managepagecompose__26)
-		WriteAll(featuredImage.Url(), true, buffer)
-		buffer.WriteString(managepagecompose__27)
+		buffer.WriteString(managepagecompose__33)
+		WriteAll(featuredImage.Url("medium"), true, buffer)
+		buffer.WriteString(managepagecompose__34)
 
 		{
-			buffer.WriteString(managepagecompose__101)
+			buffer.WriteString(managecustomfieldindex__105)
 
 		}
 
-		buffer.WriteString(managepagecompose__28)
+		buffer.WriteString(managecustomfieldcompose__33)
 		WriteAll(config.Setting("app_name"), true, buffer)
-		buffer.WriteString(commentlist__25)
+		buffer.WriteString(commentlist__27)
 
 		if meta.User == nil || meta.User.ID == 0 {
-			buffer.WriteString(commentlist__106)
+			buffer.WriteString(commentlist__131)
 			WriteAll(utils.Url("/login"), true, buffer)
-			buffer.WriteString(commentlist__107)
+			buffer.WriteString(commentlist__132)
 			WriteAll(utils.Url("/register"), true, buffer)
-			buffer.WriteString(commentlist__108)
+			buffer.WriteString(commentlist__133)
 
 		} else {
 			{
-				buffer.WriteString(commentlist__63)
+				buffer.WriteString(commentlist__84)
 				WriteAll(meta.User.AvatarElm("32", "32", false), false, buffer)
-				buffer.WriteString(commentlist__64)
+				buffer.WriteString(commentlist__85)
 				WriteAll(meta.User.Url(), true, buffer)
-				buffer.WriteString(commentlist__50)
+				buffer.WriteString(commentlist__48)
 				WriteAll(meta.User.Name(), true, buffer)
-				buffer.WriteString(commentlist__66)
+				buffer.WriteString(commentlist__87)
 				WriteAll("@"+meta.User.Username, true, buffer)
-				buffer.WriteString(commentlist__67)
+				buffer.WriteString(commentlist__88)
 				WriteAll(utils.Url("/posts/new"), true, buffer)
-				buffer.WriteString(commentlist__68)
+				buffer.WriteString(commentlist__89)
 				WriteAll(utils.Url("/posts"), true, buffer)
-				buffer.WriteString(commentlist__69)
+				buffer.WriteString(commentlist__90)
 				WriteAll(utils.Url("/comments"), true, buffer)
-				buffer.WriteString(commentlist__70)
+				buffer.WriteString(commentlist__91)
 				WriteAll(utils.Url("/files"), true, buffer)
-				buffer.WriteString(commentlist__71)
+				buffer.WriteString(commentlist__92)
+				WriteAll(utils.Url("/invites"), true, buffer)
+				buffer.WriteString(commentlist__93)
 				WriteAll(utils.Url("/settings"), true, buffer)
-				buffer.WriteString(commentlist__72)
+				buffer.WriteString(commentlist__94)
 
 			}
 
 			if meta.User.IsRoot() {
 				{
-					buffer.WriteString(commentlist__119)
+					buffer.WriteString(commentlist__145)
 					WriteAll(utils.Url("/manage"), true, buffer)
-					buffer.WriteString(commentlist__120)
+					buffer.WriteString(commentlist__146)
 					WriteAll(utils.Url("/manage/topics"), true, buffer)
-					buffer.WriteString(commentlist__121)
+					buffer.WriteString(commentlist__147)
 					WriteAll(utils.Url("/manage/posts"), true, buffer)
-					buffer.WriteString(commentlist__122)
+					buffer.WriteString(commentlist__148)
 					WriteAll(utils.Url("/manage/pages"), true, buffer)
-					buffer.WriteString(commentlist__123)
+					buffer.WriteString(commentlist__149)
 					WriteAll(utils.Url("/manage/roles"), true, buffer)
-					buffer.WriteString(commentlist__124)
+					buffer.WriteString(commentlist__150)
 					WriteAll(utils.Url("/manage/users"), true, buffer)
-					buffer.WriteString(commentlist__125)
+					buffer.WriteString(commentlist__151)
 					WriteAll(utils.Url("/manage/comments"), true, buffer)
-					buffer.WriteString(commentlist__126)
+					buffer.WriteString(commentlist__152)
 					WriteAll(utils.Url("/manage/files"), true, buffer)
-					buffer.WriteString(commentlist__127)
+					buffer.WriteString(commentlist__153)
 					WriteAll(utils.Url("/manage/settings"), true, buffer)
-					buffer.WriteString(commentlist__72)
+					buffer.WriteString(commentlist__154)
+					WriteAll(utils.Url("/manage/menus"), true, buffer)
+					buffer.WriteString(commentlist__155)
+					WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+					buffer.WriteString(commentlist__156)
+					WriteAll(utils.Url("/manage/audit"), true, buffer)
+					buffer.WriteString(commentlist__157)
 
 				}
 
 			}
 		}
-		buffer.WriteString(commentlist__26)
+		buffer.WriteString(commentlist__28)
 
-		for _, topic := range cache.Topics {
-			buffer.WriteString(commentlist__106)
+		for _, topic := range cache.Topics() {
+			buffer.WriteString(commentlist__131)
 			WriteAll(topic.Url(), true, buffer)
-			buffer.WriteString(commentlist__49)
+			buffer.WriteString(commentlist__70)
 			WriteAll(topic.Name, true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			WriteAll("#"+topic.Name, true, buffer)
-			buffer.WriteString(commentlist__132)
+			buffer.WriteString(commentlist__52)
 		}
-		buffer.WriteString(commentlist__27)
+		buffer.WriteString(commentlist__29)
+
+		{
+			var (
+				location = "footer"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__30)
 		WriteAll(config.Setting("footer_content"), false, buffer)
-		buffer.WriteString(commentlist__28)
+		buffer.WriteString(commentlist__31)
 		WriteAll(config.Setting("inject_footer"), false, buffer)
 		WriteAll(asset.JsFile("js/layout.js"), false, buffer)
 		WriteAll(asset.JsFile("editor/highlight-11.5.0.min.js"), false, buffer)
 		WriteAll(asset.JsFile("editor/editor.js"), false, buffer)
 		WriteAll(asset.JsFile("js/main.js"), false, buffer)
-		buffer.WriteString(managepagecompose__33)
+		buffer.WriteString(managepagecompose__41)
 
 	}
 }
@@ -4,6 +4,7 @@ package views
 
 import (
 	"bufio"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -15,26 +16,29 @@ import (
 )
 
 const (
-	managerolecompose__19  = `</ul><label class="menu-trigger"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M3,6H21V8H3V6M3,11H21V13H3V11M3,16H21V18H3V16Z"></path></svg></label></nav></header><div class="wrapper"><div class="container"><form method="POST"><div class="layout"><div class="left"><div class="box fixed-sidebar">`
-	managerolecompose__21  = `</div></div><div class="right"><div class="box fixed-sidebar"><div class="flex" style="justify-content: space-between">`
-	managerolecompose__22  = `</div><div class="save-actions"><button>Save</button>`
-	managerolecompose__29  = `<script>listenDeleteNodeEvents('role', '/manage/roles', '/manage/roles')</script></body></html>`
-	managerolecompose__90  = `<h2>Role Permissions</h2>`
-	managerolecompose__91  = `<div class="flex"><label style="width:50%">`
-	managerolecompose__92  = `</label><input type="hidden" name="`
-	managerolecompose__94  = `"/><select style="width:50%" name="`
-	managerolecompose__96  = `</select></div>`
-	managerolecompose__98  = `" selected="">`
-	managerolecompose__118 = `<label class="switch">`
-	managerolecompose__119 = `&nbsp;`
-	managerolecompose__120 = `<span class="slider"></span></label>`
-	managerolecompose__121 = `<input type="checkbox" name="`
-	managerolecompose__122 = `" checked="checked"/>`
-	managerolecompose__125 = `<button class="danger delete-role" data-id="`
-	managerolecompose__126 = `" type="button">Delete</button>`
+	managerolecompose__24  = `</div></div><div class="right"><div class="box fixed-sidebar"><div class="flex" style="justify-content: space-between">`
+	managerolecompose__33  = `<script>listenDeleteNodeEvents('role', '/manage/roles', '/manage/roles')</script></body></html>`
+	managerolecompose__117 = `<h2>Upload Limits</h2><div class="flex"><p style="width:50%"><label>Max file size (MB, 0 for unlimited)</label><input type="number" min="0" name="max_upload_size" value="`
+	managerolecompose__118 = `"/></p><p style="width:50%"><label>Storage quota (MB, 0 for unlimited)</label><input type="number" min="0" name="storage_quota" value="`
+	managerolecompose__119 = `"/></p></div><label>Allowed file types (none checked for all types)</label><div class="multi-checkbox">`
+	managerolecompose__120 = `</div><h2>Role Permissions</h2>`
+	managerolecompose__121 = `<label for="`
+	managerolecompose__123 = `<span class="name">`
+	managerolecompose__124 = `</span></label>`
+	managerolecompose__125 = `<input type="checkbox" name="upload_mime_groups" value="`
+	managerolecompose__126 = `" id="`
+	managerolecompose__131 = `<div class="flex"><label style="width:50%">`
+	managerolecompose__132 = `</label><input type="hidden" name="`
+	managerolecompose__134 = `"/><select style="width:50%" name="`
+	managerolecompose__155 = `<details class="permission-topics"><summary>`
+	managerolecompose__156 = `</summary><div class="multi-checkbox scroll">`
+	managerolecompose__157 = `</div></details>`
+	managerolecompose__158 = `All topics`
+	managerolecompose__181 = `<button class="danger delete-role" data-id="`
+	managerolecompose__182 = `" type="button">Delete</button>`
 )
 
-func ManageRoleCompose(ID int, role *entities.RoleMutation, permissions []*entities.PermissionValue) func(meta *entities.Meta, wr *bufio.Writer) {
+func ManageRoleCompose(ID int, role *entities.RoleMutation, permissions []*entities.PermissionValue, topics []*entities.Topic, topicActions []string, mimeGroups []string) func(meta *entities.Meta, wr *bufio.Writer) {
 	return func(meta *entities.Meta, wr *bufio.Writer) {
 		buffer := &WriterAsBuffer{wr}
 
@@ -44,155 +48,218 @@ func ManageRoleCompose(ID int, role *entities.RoleMutation, permissions []*entit
 		var appName = config.Setting("app_name")
 		var appLogo = config.Setting("app_logo")
 		buffer.WriteString(commentlist__1)
-		WriteAll(title, true, buffer)
+		WriteAll(meta.CsrfToken, true, buffer)
 		buffer.WriteString(commentlist__2)
-		WriteAll(meta.Canonical, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__3)
-		WriteAll(meta.Type, true, buffer)
-		buffer.WriteString(commentlist__4)
 		WriteAll(meta.Canonical, true, buffer)
+		buffer.WriteString(commentlist__4)
+		WriteAll(meta.Type, true, buffer)
 		buffer.WriteString(commentlist__5)
-		WriteAll(title, true, buffer)
+		WriteAll(meta.Canonical, true, buffer)
 		buffer.WriteString(commentlist__6)
-		WriteAll(appName, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__7)
-		WriteAll(config.Setting("twitter_site"), true, buffer)
+		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__8)
-		WriteAll(title, true, buffer)
+		WriteAll(config.Setting("twitter_site"), true, buffer)
 		buffer.WriteString(commentlist__9)
-		WriteAll(appName, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__10)
 		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__11)
-		WriteAll(appName+" Feed", true, buffer)
+		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__12)
-		WriteAll(utils.Url("/feed"), true, buffer)
+		WriteAll(appName+" Feed", true, buffer)
 		buffer.WriteString(commentlist__13)
+		WriteAll(utils.Url("/feed"), true, buffer)
+		buffer.WriteString(commentlist__14)
 		if appLogo != "" {
-			buffer.WriteString(commentlist__30)
+			buffer.WriteString(commentlist__33)
 			WriteAll(appLogo, true, buffer)
-			buffer.WriteString(commentlist__31)
+			buffer.WriteString(commentlist__34)
 			WriteAll(appLogo, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		if meta.Description != "" {
-			buffer.WriteString(commentlist__33)
+			buffer.WriteString(commentlist__36)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__34)
+			buffer.WriteString(commentlist__37)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__35)
+			buffer.WriteString(commentlist__38)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		if meta.Image != "" {
-			buffer.WriteString(commentlist__37)
+			buffer.WriteString(commentlist__40)
 			WriteAll(meta.Image, true, buffer)
-			buffer.WriteString(commentlist__38)
+			buffer.WriteString(commentlist__41)
 			WriteAll(meta.Image, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		WriteAll(asset.CssFile("css/light.min.css"), false, buffer)
 		WriteAll(asset.CssFile("css/style.css"), false, buffer)
 		WriteAll(config.Setting("inject_header"), false, buffer)
-		buffer.WriteString(commentlist__14)
-		WriteAll(utils.Url(""), true, buffer)
 		buffer.WriteString(commentlist__15)
+		WriteAll(utils.Url(""), true, buffer)
+		buffer.WriteString(commentlist__16)
 		var logoUrl = config.Setting("app_logo")
 		if logoUrl != "" {
-			buffer.WriteString(commentlist__40)
+			buffer.WriteString(commentlist__43)
 			WriteAll(logoUrl, true, buffer)
-			buffer.WriteString(commentlist__41)
+			buffer.WriteString(commentlist__44)
 			WriteAll(config.Setting("app_name"), true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		} else {
-			buffer.WriteString(commentlist__43)
+			buffer.WriteString(commentlist__46)
 
 		}
-		buffer.WriteString(commentlist__16)
-		WriteAll(meta.Query, true, buffer)
 		buffer.WriteString(commentlist__17)
-		WriteAll(utils.Url("/search"), true, buffer)
+		WriteAll(meta.Query, true, buffer)
 		buffer.WriteString(commentlist__18)
 
+		{
+			var (
+				location = "header"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__19)
+		WriteAll(utils.Url("/search"), true, buffer)
+		buffer.WriteString(commentlist__20)
+
 		if meta.User == nil || meta.User.ID == 0 {
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(utils.Url("/login"), true, buffer)
-			buffer.WriteString(commentlist__45)
+			buffer.WriteString(commentlist__66)
 			WriteAll(utils.Url("/register"), true, buffer)
-			buffer.WriteString(commentlist__46)
+			buffer.WriteString(commentlist__67)
 
 		} else {
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(utils.Url("/posts/new"), true, buffer)
-			buffer.WriteString(commentlist__48)
+			buffer.WriteString(commentlist__69)
 			WriteAll(meta.User.Url(), true, buffer)
-			buffer.WriteString(commentlist__49)
+			buffer.WriteString(commentlist__70)
 			WriteAll(meta.User.Username, true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			if meta.User.AvatarImageUrl != "" {
-				buffer.WriteString(commentlist__57)
+				buffer.WriteString(commentlist__78)
 				WriteAll(meta.User.AvatarImageUrl, true, buffer)
-				buffer.WriteString(commentlist__41)
+				buffer.WriteString(commentlist__44)
 				WriteAll(meta.User.Username, true, buffer)
-				buffer.WriteString(commentlist__13)
+				buffer.WriteString(commentlist__14)
 			} else {
-				buffer.WriteString(commentlist__60)
+				buffer.WriteString(commentlist__81)
 
 			}
-			buffer.WriteString(commentlist__51)
+			buffer.WriteString(commentlist__72)
 
 			if meta.User != nil && meta.User.IsRoot() {
-				buffer.WriteString(commentlist__44)
+				buffer.WriteString(commentlist__50)
 				WriteAll(utils.Url("/manage"), true, buffer)
-				buffer.WriteString(commentlist__62)
+				buffer.WriteString(commentlist__83)
 
 			}
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(meta.User.Url(), true, buffer)
-			buffer.WriteString(commentlist__53)
+			buffer.WriteString(commentlist__74)
 			WriteAll(utils.Url("/posts"), true, buffer)
-			buffer.WriteString(commentlist__54)
+			buffer.WriteString(commentlist__75)
 			WriteAll(utils.Url("/settings"), true, buffer)
-			buffer.WriteString(commentlist__55)
+			buffer.WriteString(commentlist__76)
 			WriteAll(utils.Url("/logout"), true, buffer)
-			buffer.WriteString(commentlist__56)
+			buffer.WriteString(commentlist__77)
+
+		}
+		buffer.WriteString(fileedit__21)
 
+		{
+			buffer.WriteString(commentlist__125)
+			WriteAll(meta.CsrfToken, true, buffer)
+			buffer.WriteString(commentlist__14)
 		}
-		buffer.WriteString(managerolecompose__19)
+
+		buffer.WriteString(fileedit__22)
 
 		{
-			buffer.WriteString(commentlist__119)
+			buffer.WriteString(commentlist__145)
 			WriteAll(utils.Url("/manage"), true, buffer)
-			buffer.WriteString(commentlist__120)
+			buffer.WriteString(commentlist__146)
 			WriteAll(utils.Url("/manage/topics"), true, buffer)
-			buffer.WriteString(commentlist__121)
+			buffer.WriteString(commentlist__147)
 			WriteAll(utils.Url("/manage/posts"), true, buffer)
-			buffer.WriteString(commentlist__122)
+			buffer.WriteString(commentlist__148)
 			WriteAll(utils.Url("/manage/pages"), true, buffer)
-			buffer.WriteString(commentlist__123)
+			buffer.WriteString(commentlist__149)
 			WriteAll(utils.Url("/manage/roles"), true, buffer)
-			buffer.WriteString(commentlist__124)
+			buffer.WriteString(commentlist__150)
 			WriteAll(utils.Url("/manage/users"), true, buffer)
-			buffer.WriteString(commentlist__125)
+			buffer.WriteString(commentlist__151)
 			WriteAll(utils.Url("/manage/comments"), true, buffer)
-			buffer.WriteString(commentlist__126)
+			buffer.WriteString(commentlist__152)
 			WriteAll(utils.Url("/manage/files"), true, buffer)
-			buffer.WriteString(commentlist__127)
+			buffer.WriteString(commentlist__153)
 			WriteAll(utils.Url("/manage/settings"), true, buffer)
-			buffer.WriteString(commentlist__72)
+			buffer.WriteString(commentlist__154)
+			WriteAll(utils.Url("/manage/menus"), true, buffer)
+			buffer.WriteString(commentlist__155)
+			WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+			buffer.WriteString(commentlist__156)
+			WriteAll(utils.Url("/manage/audit"), true, buffer)
+			buffer.WriteString(commentlist__157)
 
 		}
 
-		buffer.WriteString(managecommentindex__20)
+		buffer.WriteString(manageauditindex__22)
 
 		if ID > 0 {
-			buffer.WriteString(managepagecompose__77)
+			buffer.WriteString(managecustomfieldcompose__106)
 			WriteAll("Editing role: "+role.Name, true, buffer)
-			buffer.WriteString(error__20)
+			buffer.WriteString(error__22)
 		} else {
-			buffer.WriteString(managepagecompose__77)
+			buffer.WriteString(managecustomfieldcompose__106)
 			WriteEscString("Create a new role", buffer)
-			buffer.WriteString(error__20)
+			buffer.WriteString(error__22)
 		}
 		{
 			var (
@@ -200,16 +267,16 @@ func ManageRoleCompose(ID int, role *entities.RoleMutation, permissions []*entit
 			)
 
 			if msgs.Length() > 0 {
-				buffer.WriteString(commentlist__73)
+				buffer.WriteString(commentlist__95)
 				var messages = msgs.Get()
 				for _, msg := range messages {
-					buffer.WriteString(commentlist__75)
+					buffer.WriteString(commentlist__97)
 					WriteAll(msg.Type, true, buffer)
-					buffer.WriteString(commentlist__50)
+					buffer.WriteString(commentlist__48)
 					WriteAll(msg.Message, true, buffer)
-					buffer.WriteString(commentlist__77)
+					buffer.WriteString(commentlist__53)
 				}
-				buffer.WriteString(commentlist__74)
+				buffer.WriteString(commentlist__49)
 			}
 		}
 
@@ -220,13 +287,13 @@ func ManageRoleCompose(ID int, role *entities.RoleMutation, permissions []*entit
 				label = "Role Name"
 			)
 
-			buffer.WriteString(managepagecompose__85)
+			buffer.WriteString(fileedit__112)
 			WriteEscString(label, buffer)
-			buffer.WriteString(managepagecompose__86)
+			buffer.WriteString(fileedit__113)
 			WriteEscString(name, buffer)
-			buffer.WriteString(managepagecompose__87)
+			buffer.WriteString(fileedit__114)
 			WriteAll(value, true, buffer)
-			buffer.WriteString(managepagecompose__88)
+			buffer.WriteString(fileedit__115)
 		}
 
 		{
@@ -236,28 +303,57 @@ func ManageRoleCompose(ID int, role *entities.RoleMutation, permissions []*entit
 				label = "Role Description"
 			)
 
-			buffer.WriteString(managepagecompose__85)
+			buffer.WriteString(fileedit__112)
 			WriteEscString(label, buffer)
-			buffer.WriteString(managepagecompose__86)
+			buffer.WriteString(fileedit__113)
 			WriteEscString(name, buffer)
-			buffer.WriteString(managepagecompose__87)
+			buffer.WriteString(fileedit__114)
 			WriteAll(value, true, buffer)
-			buffer.WriteString(managepagecompose__88)
+			buffer.WriteString(fileedit__115)
 		}
 
 		if ID != 1 {
-			buffer.WriteString(managerolecompose__90)
+			buffer.WriteString(managerolecompose__117)
+			WriteEscString(strconv.Itoa(role.MaxUploadSize), buffer)
+			buffer.WriteString(managerolecompose__118)
+			WriteEscString(strconv.Itoa(role.StorageQuota), buffer)
+			buffer.WriteString(managerolecompose__119)
+
+			for _, group := range mimeGroups {
+				var inputId = "upload-mime-group-" + group
+				buffer.WriteString(managerolecompose__121)
+				WriteEscString(inputId, buffer)
+				buffer.WriteString(commentlist__48)
+				if utils.SliceContains(role.UploadMimeGroups, group) {
+					buffer.WriteString(managerolecompose__125)
+					WriteEscString(group, buffer)
+					buffer.WriteString(managerolecompose__126)
+					WriteEscString(inputId, buffer)
+					buffer.WriteString(managecustomfieldcompose__147)
+				} else {
+					buffer.WriteString(managerolecompose__125)
+					WriteEscString(group, buffer)
+					buffer.WriteString(managerolecompose__126)
+					WriteEscString(inputId, buffer)
+					buffer.WriteString(commentlist__14)
+				}
+				buffer.WriteString(managerolecompose__123)
+				WriteAll(strings.Title(group), true, buffer)
+				buffer.WriteString(managerolecompose__124)
+
+			}
+			buffer.WriteString(managerolecompose__120)
 
 			for i, permission := range permissions {
-				buffer.WriteString(managerolecompose__91)
+				buffer.WriteString(managerolecompose__131)
 				WriteAll(strings.Title(strings.Join(strings.Split(permission.Action, "."), " ")), true, buffer)
-				buffer.WriteString(managerolecompose__92)
+				buffer.WriteString(managerolecompose__132)
 				WriteEscString("permissions."+strconv.Itoa(i)+".Action", buffer)
-				buffer.WriteString(managepagecompose__87)
+				buffer.WriteString(fileedit__114)
 				WriteAll(permission.Action, true, buffer)
-				buffer.WriteString(managerolecompose__94)
+				buffer.WriteString(managerolecompose__134)
 				WriteEscString("permissions."+strconv.Itoa(i)+".Value", buffer)
-				buffer.WriteString(commentlist__50)
+				buffer.WriteString(commentlist__48)
 				{
 					var (
 						value    = entities.PERM_NONE
@@ -266,17 +362,17 @@ func ManageRoleCompose(ID int, role *entities.RoleMutation, permissions []*entit
 					)
 
 					if value == selected {
-						buffer.WriteString(managepostindex__85)
+						buffer.WriteString(filelist__117)
 						WriteAll(value, true, buffer)
-						buffer.WriteString(managerolecompose__98)
+						buffer.WriteString(filelist__118)
 						WriteEscString(label, buffer)
-						buffer.WriteString(managepostindex__87)
+						buffer.WriteString(filelist__119)
 					} else {
-						buffer.WriteString(managepostindex__85)
+						buffer.WriteString(filelist__117)
 						WriteAll(value, true, buffer)
-						buffer.WriteString(commentlist__50)
+						buffer.WriteString(commentlist__48)
 						WriteEscString(label, buffer)
-						buffer.WriteString(managepostindex__87)
+						buffer.WriteString(filelist__119)
 					}
 				}
 
@@ -288,17 +384,17 @@ func ManageRoleCompose(ID int, role *entities.RoleMutation, permissions []*entit
 					)
 
 					if value == selected {
-						buffer.WriteString(managepostindex__85)
+						buffer.WriteString(filelist__117)
 						WriteAll(value, true, buffer)
-						buffer.WriteString(managerolecompose__98)
+						buffer.WriteString(filelist__118)
 						WriteEscString(label, buffer)
-						buffer.WriteString(managepostindex__87)
+						buffer.WriteString(filelist__119)
 					} else {
-						buffer.WriteString(managepostindex__85)
+						buffer.WriteString(filelist__117)
 						WriteAll(value, true, buffer)
-						buffer.WriteString(commentlist__50)
+						buffer.WriteString(commentlist__48)
 						WriteEscString(label, buffer)
-						buffer.WriteString(managepostindex__87)
+						buffer.WriteString(filelist__119)
 					}
 				}
 
@@ -310,25 +406,65 @@ func ManageRoleCompose(ID int, role *entities.RoleMutation, permissions []*entit
 					)
 
 					if value == selected {
-						buffer.WriteString(managepostindex__85)
+						buffer.WriteString(filelist__117)
 						WriteAll(value, true, buffer)
-						buffer.WriteString(managerolecompose__98)
+						buffer.WriteString(filelist__118)
 						WriteEscString(label, buffer)
-						buffer.WriteString(managepostindex__87)
+						buffer.WriteString(filelist__119)
 					} else {
-						buffer.WriteString(managepostindex__85)
+						buffer.WriteString(filelist__117)
 						WriteAll(value, true, buffer)
-						buffer.WriteString(commentlist__50)
+						buffer.WriteString(commentlist__48)
 						WriteEscString(label, buffer)
-						buffer.WriteString(managepostindex__87)
+						buffer.WriteString(filelist__119)
 					}
 				}
 
-				buffer.WriteString(managerolecompose__96)
+				buffer.WriteString(managepagecompose__28)
 
+				if utils.SliceContains(topicActions, permission.Action) && len(topics) > 0 {
+					buffer.WriteString(managerolecompose__155)
+
+					if len(permission.TopicIDs) > 0 {
+						WriteEscString(fmt.Sprintf("Limited to %d topics", len(permission.TopicIDs)), buffer)
+					} else {
+						buffer.WriteString(managerolecompose__158)
+					}
+					buffer.WriteString(managerolecompose__156)
+
+					for _, topic := range topics {
+						var inputId = fmt.Sprintf("permission-%d-topic-%d", i, topic.ID)
+						buffer.WriteString(managerolecompose__121)
+						WriteEscString(inputId, buffer)
+						buffer.WriteString(commentlist__48)
+						if utils.SliceContains(permission.TopicIDs, topic.ID) {
+							buffer.WriteString(managecustomfieldcompose__146)
+							WriteEscString("permissions."+strconv.Itoa(i)+".TopicIDs", buffer)
+							buffer.WriteString(fileedit__114)
+							WriteAll(topic.ID, true, buffer)
+							buffer.WriteString(managerolecompose__126)
+							WriteEscString(inputId, buffer)
+							buffer.WriteString(managecustomfieldcompose__147)
+						} else {
+							buffer.WriteString(managecustomfieldcompose__146)
+							WriteEscString("permissions."+strconv.Itoa(i)+".TopicIDs", buffer)
+							buffer.WriteString(fileedit__114)
+							WriteAll(topic.ID, true, buffer)
+							buffer.WriteString(managerolecompose__126)
+							WriteEscString(inputId, buffer)
+							buffer.WriteString(commentlist__14)
+						}
+						buffer.WriteString(managerolecompose__123)
+						WriteAll(topic.Name, true, buffer)
+						buffer.WriteString(managerolecompose__124)
+
+					}
+					buffer.WriteString(managerolecompose__157)
+
+				}
 			}
 		}
-		buffer.WriteString(managerolecompose__21)
+		buffer.WriteString(managerolecompose__24)
 
 		{
 			var (
@@ -336,11 +472,11 @@ func ManageRoleCompose(ID int, role *entities.RoleMutation, permissions []*entit
 				link  = "/manage/roles/new"
 			)
 
-			buffer.WriteString(managepagecompose__93)
+			buffer.WriteString(managecustomfieldcompose__126)
 			WriteEscString(link, buffer)
-			buffer.WriteString(managepagecompose__94)
+			buffer.WriteString(managecustomfieldcompose__127)
 			WriteEscString(label, buffer)
-			buffer.WriteString(commentlist__132)
+			buffer.WriteString(commentlist__52)
 		}
 
 		{
@@ -350,115 +486,170 @@ func ManageRoleCompose(ID int, role *entities.RoleMutation, permissions []*entit
 				label     = "Root"
 			)
 
-			buffer.WriteString(managerolecompose__118)
+			buffer.WriteString(managecustomfieldcompose__143)
 			WriteEscString(label, buffer)
-			buffer.WriteString(managerolecompose__119)
+			buffer.WriteString(managecustomfieldcompose__144)
 			if condition {
-				buffer.WriteString(managerolecompose__121)
+				buffer.WriteString(managecustomfieldcompose__146)
 				WriteEscString(name, buffer)
-				buffer.WriteString(managerolecompose__122)
+				buffer.WriteString(managecustomfieldcompose__147)
 			} else {
-				buffer.WriteString(managerolecompose__121)
+				buffer.WriteString(managecustomfieldcompose__146)
 				WriteEscString(name, buffer)
-				buffer.WriteString(commentlist__13)
+				buffer.WriteString(commentlist__14)
 			}
-			buffer.WriteString(managerolecompose__120)
+			buffer.WriteString(managecustomfieldcompose__145)
 
 		}
 
-		buffer.WriteString(managerolecompose__22)
+		buffer.WriteString(managecustomfieldcompose__27)
 
 		if ID > 3 {
-			buffer.WriteString(managerolecompose__125)
+			buffer.WriteString(managerolecompose__181)
 			WriteInt(int64(ID), buffer)
-			buffer.WriteString(managerolecompose__126)
+			buffer.WriteString(managerolecompose__182)
 
 		}
-		buffer.WriteString(commentlist__22)
+		buffer.WriteString(commentlist__24)
 		{
-			buffer.WriteString(managepagecompose__101)
+			buffer.WriteString(managecustomfieldindex__105)
 
 		}
 
-		buffer.WriteString(managepagecompose__28)
+		buffer.WriteString(managecustomfieldcompose__33)
 		WriteAll(config.Setting("app_name"), true, buffer)
-		buffer.WriteString(commentlist__25)
+		buffer.WriteString(commentlist__27)
 
 		if meta.User == nil || meta.User.ID == 0 {
-			buffer.WriteString(commentlist__106)
+			buffer.WriteString(commentlist__131)
 			WriteAll(utils.Url("/login"), true, buffer)
-			buffer.WriteString(commentlist__107)
+			buffer.WriteString(commentlist__132)
 			WriteAll(utils.Url("/register"), true, buffer)
-			buffer.WriteString(commentlist__108)
+			buffer.WriteString(commentlist__133)
 
 		} else {
 			{
-				buffer.WriteString(commentlist__63)
+				buffer.WriteString(commentlist__84)
 				WriteAll(meta.User.AvatarElm("32", "32", false), false, buffer)
-				buffer.WriteString(commentlist__64)
+				buffer.WriteString(commentlist__85)
 				WriteAll(meta.User.Url(), true, buffer)
-				buffer.WriteString(commentlist__50)
+				buffer.WriteString(commentlist__48)
 				WriteAll(meta.User.Name(), true, buffer)
-				buffer.WriteString(commentlist__66)
+				buffer.WriteString(commentlist__87)
 				WriteAll("@"+meta.User.Username, true, buffer)
-				buffer.WriteString(commentlist__67)
+				buffer.WriteString(commentlist__88)
 				WriteAll(utils.Url("/posts/new"), true, buffer)
-				buffer.WriteString(commentlist__68)
+				buffer.WriteString(commentlist__89)
 				WriteAll(utils.Url("/posts"), true, buffer)
-				buffer.WriteString(commentlist__69)
+				buffer.WriteString(commentlist__90)
 				WriteAll(utils.Url("/comments"), true, buffer)
-				buffer.WriteString(commentlist__70)
+				buffer.WriteString(commentlist__91)
 				WriteAll(utils.Url("/files"), true, buffer)
-				buffer.WriteString(commentlist__71)
+				buffer.WriteString(commentlist__92)
+				WriteAll(utils.Url("/invites"), true, buffer)
+				buffer.WriteString(commentlist__93)
 				WriteAll(utils.Url("/settings"), true, buffer)
-				buffer.WriteString(commentlist__72)
+				buffer.WriteString(commentlist__94)
 
 			}
 
 			if meta.User.IsRoot() {
 				{
-					buffer.WriteString(commentlist__119)
+					buffer.WriteString(commentlist__145)
 					WriteAll(utils.Url("/manage"), true, buffer)
-					buffer.WriteString(commentlist__120)
+					buffer.WriteString(commentlist__146)
 					WriteAll(utils.Url("/manage/topics"), true, buffer)
-					buffer.WriteString(commentlist__121)
+					buffer.WriteString(commentlist__147)
 					WriteAll(utils.Url("/manage/posts"), true, buffer)
-					buffer.WriteString(commentlist__122)
+					buffer.WriteString(commentlist__148)
 					WriteAll(utils.Url("/manage/pages"), true, buffer)
-					buffer.WriteString(commentlist__123)
+					buffer.WriteString(commentlist__149)
 					WriteAll(utils.Url("/manage/roles"), true, buffer)
-					buffer.WriteString(commentlist__124)
+					buffer.WriteString(commentlist__150)
 					WriteAll(utils.Url("/manage/users"), true, buffer)
-					buffer.WriteString(commentlist__125)
+					buffer.WriteString(commentlist__151)
 					WriteAll(utils.Url("/manage/comments"), true, buffer)
-					buffer.WriteString(commentlist__126)
+					buffer.WriteString(commentlist__152)
 					WriteAll(utils.Url("/manage/files"), true, buffer)
-					buffer.WriteString(commentlist__127)
+					buffer.WriteString(commentlist__153)
 					WriteAll(utils.Url("/manage/settings"), true, buffer)
-					buffer.WriteString(commentlist__72)
+					buffer.WriteString(commentlist__154)
+					WriteAll(utils.Url("/manage/menus"), true, buffer)
+					buffer.WriteString(commentlist__155)
+					WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+					buffer.WriteString(commentlist__156)
+					WriteAll(utils.Url("/manage/audit"), true, buffer)
+					buffer.WriteString(commentlist__157)
 
 				}
 
 			}
 		}
-		buffer.WriteString(commentlist__26)
+		buffer.WriteString(commentlist__28)
 
-		for _, topic := range cache.Topics {
-			buffer.WriteString(commentlist__106)
+		for _, topic := range cache.Topics() {
+			buffer.WriteString(commentlist__131)
 			WriteAll(topic.Url(), true, buffer)
-			buffer.WriteString(commentlist__49)
+			buffer.WriteString(commentlist__70)
 			WriteAll(topic.Name, true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			WriteAll("#"+topic.Name, true, buffer)
-			buffer.WriteString(commentlist__132)
+			buffer.WriteString(commentlist__52)
 		}
-		buffer.WriteString(commentlist__27)
+		buffer.WriteString(commentlist__29)
+
+		{
+			var (
+				location = "footer"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__30)
 		WriteAll(config.Setting("footer_content"), false, buffer)
-		buffer.WriteString(commentlist__28)
+		buffer.WriteString(commentlist__31)
 		WriteAll(config.Setting("inject_footer"), false, buffer)
 		WriteAll(asset.JsFile("js/layout.js"), false, buffer)
 		WriteAll(asset.JsFile("js/main.js"), false, buffer)
-		buffer.WriteString(managerolecompose__29)
+		buffer.WriteString(managerolecompose__33)
 
 	}
 }
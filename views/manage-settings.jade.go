@@ -15,26 +15,23 @@ import (
 )
 
 const (
-	managesettings__20  = `</div></div><div class="main"><div class="box"><h1>`
-	managesettings__22  = `</div></div><div class="right"><div class="box fixed-sidebar"><div class="save-actions"><button>Save</button></div></div></div></div></form></div><div class="mobile-menu"><div class="menu-head">`
-	managesettings__76  = `<div><input name="`
-	managesettings__78  = `" type="hidden"/><input name="`
-	managesettings__82  = `" type="hidden"/><label for="`
-	managesettings__84  = `</label><input class="image-input" type="file" id="`
-	managesettings__85  = `" name="`
-	managesettings__86  = `"/><div class="image-upload-previewer" for="`
-	managesettings__87  = `"><img src="`
-	managesettings__89  = `<p><input name="`
-	managesettings__95  = `</label><textarea id="`
-	managesettings__98  = `</textarea></p>`
-	managesettings__103 = `" type="hidden"/><div class="flex"><label class="switch" for="`
-	managesettings__105 = `<span class="slider"></span></label><label for="`
-	managesettings__107 = `</label></div></div>`
-	managesettings__108 = `<input id="`
-	managesettings__109 = `" type="checkbox" name="`
-	managesettings__110 = `" value="yes" checked="checked"/>`
-	managesettings__113 = `" value="yes"/>`
-	managesettings__120 = `</label><input id="`
+	managesettings__25  = `</div></div><div class="right"><div class="box fixed-sidebar"><div class="save-actions"><button>Save</button></div></div></div></div></form></div><div class="mobile-menu"><div class="menu-head">`
+	managesettings__103 = `<div><input name="`
+	managesettings__105 = `" type="hidden"/><input name="`
+	managesettings__109 = `" type="hidden"/><label for="`
+	managesettings__111 = `</label><input class="image-input" type="file" id="`
+	managesettings__113 = `"/><div class="image-upload-previewer" for="`
+	managesettings__114 = `"><img src="`
+	managesettings__115 = `"/></div></div>`
+	managesettings__116 = `<p><input name="`
+	managesettings__122 = `</label><textarea id="`
+	managesettings__130 = `" type="hidden"/><div class="flex"><label class="switch" for="`
+	managesettings__132 = `<span class="slider"></span></label><label for="`
+	managesettings__134 = `</label></div></div>`
+	managesettings__137 = `" value="yes" checked="checked"/>`
+	managesettings__140 = `" value="yes"/>`
+	managesettings__147 = `</label><select id="`
+	managesettings__163 = `</label><input id="`
 )
 
 func ManageSettings(settings []*config.SettingItem) func(meta *entities.Meta, wr *bufio.Writer) {
@@ -47,164 +44,227 @@ func ManageSettings(settings []*config.SettingItem) func(meta *entities.Meta, wr
 		var appName = config.Setting("app_name")
 		var appLogo = config.Setting("app_logo")
 		buffer.WriteString(commentlist__1)
-		WriteAll(title, true, buffer)
+		WriteAll(meta.CsrfToken, true, buffer)
 		buffer.WriteString(commentlist__2)
-		WriteAll(meta.Canonical, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__3)
-		WriteAll(meta.Type, true, buffer)
-		buffer.WriteString(commentlist__4)
 		WriteAll(meta.Canonical, true, buffer)
+		buffer.WriteString(commentlist__4)
+		WriteAll(meta.Type, true, buffer)
 		buffer.WriteString(commentlist__5)
-		WriteAll(title, true, buffer)
+		WriteAll(meta.Canonical, true, buffer)
 		buffer.WriteString(commentlist__6)
-		WriteAll(appName, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__7)
-		WriteAll(config.Setting("twitter_site"), true, buffer)
+		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__8)
-		WriteAll(title, true, buffer)
+		WriteAll(config.Setting("twitter_site"), true, buffer)
 		buffer.WriteString(commentlist__9)
-		WriteAll(appName, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__10)
 		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__11)
-		WriteAll(appName+" Feed", true, buffer)
+		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__12)
-		WriteAll(utils.Url("/feed"), true, buffer)
+		WriteAll(appName+" Feed", true, buffer)
 		buffer.WriteString(commentlist__13)
+		WriteAll(utils.Url("/feed"), true, buffer)
+		buffer.WriteString(commentlist__14)
 		if appLogo != "" {
-			buffer.WriteString(commentlist__30)
+			buffer.WriteString(commentlist__33)
 			WriteAll(appLogo, true, buffer)
-			buffer.WriteString(commentlist__31)
+			buffer.WriteString(commentlist__34)
 			WriteAll(appLogo, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		if meta.Description != "" {
-			buffer.WriteString(commentlist__33)
+			buffer.WriteString(commentlist__36)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__34)
+			buffer.WriteString(commentlist__37)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__35)
+			buffer.WriteString(commentlist__38)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		if meta.Image != "" {
-			buffer.WriteString(commentlist__37)
+			buffer.WriteString(commentlist__40)
 			WriteAll(meta.Image, true, buffer)
-			buffer.WriteString(commentlist__38)
+			buffer.WriteString(commentlist__41)
 			WriteAll(meta.Image, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		WriteAll(asset.CssFile("css/light.min.css"), false, buffer)
 		WriteAll(asset.CssFile("css/style.css"), false, buffer)
 		WriteAll(config.Setting("inject_header"), false, buffer)
-		buffer.WriteString(commentlist__14)
-		WriteAll(utils.Url(""), true, buffer)
 		buffer.WriteString(commentlist__15)
+		WriteAll(utils.Url(""), true, buffer)
+		buffer.WriteString(commentlist__16)
 		var logoUrl = config.Setting("app_logo")
 		if logoUrl != "" {
-			buffer.WriteString(commentlist__40)
+			buffer.WriteString(commentlist__43)
 			WriteAll(logoUrl, true, buffer)
-			buffer.WriteString(commentlist__41)
+			buffer.WriteString(commentlist__44)
 			WriteAll(config.Setting("app_name"), true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		} else {
-			buffer.WriteString(commentlist__43)
+			buffer.WriteString(commentlist__46)
 
 		}
-		buffer.WriteString(commentlist__16)
-		WriteAll(meta.Query, true, buffer)
 		buffer.WriteString(commentlist__17)
-		WriteAll(utils.Url("/search"), true, buffer)
+		WriteAll(meta.Query, true, buffer)
 		buffer.WriteString(commentlist__18)
 
+		{
+			var (
+				location = "header"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__19)
+		WriteAll(utils.Url("/search"), true, buffer)
+		buffer.WriteString(commentlist__20)
+
 		if meta.User == nil || meta.User.ID == 0 {
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(utils.Url("/login"), true, buffer)
-			buffer.WriteString(commentlist__45)
+			buffer.WriteString(commentlist__66)
 			WriteAll(utils.Url("/register"), true, buffer)
-			buffer.WriteString(commentlist__46)
+			buffer.WriteString(commentlist__67)
 
 		} else {
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(utils.Url("/posts/new"), true, buffer)
-			buffer.WriteString(commentlist__48)
+			buffer.WriteString(commentlist__69)
 			WriteAll(meta.User.Url(), true, buffer)
-			buffer.WriteString(commentlist__49)
+			buffer.WriteString(commentlist__70)
 			WriteAll(meta.User.Username, true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			if meta.User.AvatarImageUrl != "" {
-				buffer.WriteString(commentlist__57)
+				buffer.WriteString(commentlist__78)
 				WriteAll(meta.User.AvatarImageUrl, true, buffer)
-				buffer.WriteString(commentlist__41)
+				buffer.WriteString(commentlist__44)
 				WriteAll(meta.User.Username, true, buffer)
-				buffer.WriteString(commentlist__13)
+				buffer.WriteString(commentlist__14)
 			} else {
-				buffer.WriteString(commentlist__60)
+				buffer.WriteString(commentlist__81)
 
 			}
-			buffer.WriteString(commentlist__51)
+			buffer.WriteString(commentlist__72)
 
 			if meta.User != nil && meta.User.IsRoot() {
-				buffer.WriteString(commentlist__44)
+				buffer.WriteString(commentlist__50)
 				WriteAll(utils.Url("/manage"), true, buffer)
-				buffer.WriteString(commentlist__62)
+				buffer.WriteString(commentlist__83)
 
 			}
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(meta.User.Url(), true, buffer)
-			buffer.WriteString(commentlist__53)
+			buffer.WriteString(commentlist__74)
 			WriteAll(utils.Url("/posts"), true, buffer)
-			buffer.WriteString(commentlist__54)
+			buffer.WriteString(commentlist__75)
 			WriteAll(utils.Url("/settings"), true, buffer)
-			buffer.WriteString(commentlist__55)
+			buffer.WriteString(commentlist__76)
 			WriteAll(utils.Url("/logout"), true, buffer)
-			buffer.WriteString(commentlist__56)
+			buffer.WriteString(commentlist__77)
+
+		}
+		buffer.WriteString(managepagecompose__21)
 
+		{
+			buffer.WriteString(commentlist__125)
+			WriteAll(meta.CsrfToken, true, buffer)
+			buffer.WriteString(commentlist__14)
 		}
-		buffer.WriteString(managepagecompose__19)
+
+		buffer.WriteString(fileedit__22)
 
 		{
-			buffer.WriteString(commentlist__119)
+			buffer.WriteString(commentlist__145)
 			WriteAll(utils.Url("/manage"), true, buffer)
-			buffer.WriteString(commentlist__120)
+			buffer.WriteString(commentlist__146)
 			WriteAll(utils.Url("/manage/topics"), true, buffer)
-			buffer.WriteString(commentlist__121)
+			buffer.WriteString(commentlist__147)
 			WriteAll(utils.Url("/manage/posts"), true, buffer)
-			buffer.WriteString(commentlist__122)
+			buffer.WriteString(commentlist__148)
 			WriteAll(utils.Url("/manage/pages"), true, buffer)
-			buffer.WriteString(commentlist__123)
+			buffer.WriteString(commentlist__149)
 			WriteAll(utils.Url("/manage/roles"), true, buffer)
-			buffer.WriteString(commentlist__124)
+			buffer.WriteString(commentlist__150)
 			WriteAll(utils.Url("/manage/users"), true, buffer)
-			buffer.WriteString(commentlist__125)
+			buffer.WriteString(commentlist__151)
 			WriteAll(utils.Url("/manage/comments"), true, buffer)
-			buffer.WriteString(commentlist__126)
+			buffer.WriteString(commentlist__152)
 			WriteAll(utils.Url("/manage/files"), true, buffer)
-			buffer.WriteString(commentlist__127)
+			buffer.WriteString(commentlist__153)
 			WriteAll(utils.Url("/manage/settings"), true, buffer)
-			buffer.WriteString(commentlist__72)
+			buffer.WriteString(commentlist__154)
+			WriteAll(utils.Url("/manage/menus"), true, buffer)
+			buffer.WriteString(commentlist__155)
+			WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+			buffer.WriteString(commentlist__156)
+			WriteAll(utils.Url("/manage/audit"), true, buffer)
+			buffer.WriteString(commentlist__157)
 
 		}
 
-		buffer.WriteString(managesettings__20)
+		buffer.WriteString(fileedit__23)
 		WriteEscString("Settings", buffer)
-		buffer.WriteString(error__20)
+		buffer.WriteString(error__22)
 		{
 			var (
 				msgs = meta.Messages
 			)
 
 			if msgs.Length() > 0 {
-				buffer.WriteString(commentlist__73)
+				buffer.WriteString(commentlist__95)
 				var messages = msgs.Get()
 				for _, msg := range messages {
-					buffer.WriteString(commentlist__75)
+					buffer.WriteString(commentlist__97)
 					WriteAll(msg.Type, true, buffer)
-					buffer.WriteString(commentlist__50)
+					buffer.WriteString(commentlist__48)
 					WriteAll(msg.Message, true, buffer)
-					buffer.WriteString(commentlist__77)
+					buffer.WriteString(commentlist__53)
 				}
-				buffer.WriteString(commentlist__74)
+				buffer.WriteString(commentlist__49)
 			}
 		}
 
@@ -214,186 +274,276 @@ func ManageSettings(settings []*config.SettingItem) func(meta *entities.Meta, wr
 			var settingType = fmt.Sprintf("settings.%d.type", i)
 			var settingValue = fmt.Sprintf("settings.%d.value", i)
 			if setting.Type == "image" {
-				buffer.WriteString(managesettings__76)
+				buffer.WriteString(managesettings__103)
 				WriteEscString(settingName, buffer)
-				buffer.WriteString(managepagecompose__87)
+				buffer.WriteString(fileedit__114)
 				WriteAll(setting.Name, true, buffer)
-				buffer.WriteString(managesettings__78)
+				buffer.WriteString(managesettings__105)
 				WriteEscString(settingType, buffer)
-				buffer.WriteString(managepagecompose__87)
+				buffer.WriteString(fileedit__114)
 				WriteAll(setting.Type, true, buffer)
-				buffer.WriteString(managesettings__78)
+				buffer.WriteString(managesettings__105)
 				WriteEscString(settingValue, buffer)
-				buffer.WriteString(managepagecompose__87)
+				buffer.WriteString(fileedit__114)
 				WriteAll(setting.Value, true, buffer)
-				buffer.WriteString(managesettings__82)
+				buffer.WriteString(managesettings__109)
 				WriteAll(setting.Name, true, buffer)
-				buffer.WriteString(commentlist__50)
+				buffer.WriteString(commentlist__48)
 				WriteAll(label, true, buffer)
-				buffer.WriteString(managesettings__84)
+				buffer.WriteString(managesettings__111)
 				WriteAll(setting.Name, true, buffer)
-				buffer.WriteString(managesettings__85)
+				buffer.WriteString(managepagecompose__145)
 				WriteAll(setting.Name, true, buffer)
-				buffer.WriteString(managesettings__86)
+				buffer.WriteString(managesettings__113)
 				WriteAll(setting.Name, true, buffer)
-				buffer.WriteString(managesettings__87)
+				buffer.WriteString(managesettings__114)
 				WriteAll(setting.Value, true, buffer)
-				buffer.WriteString(managepagecompose__27)
+				buffer.WriteString(managesettings__115)
 
 			} else if setting.Type == "textarea" {
-				buffer.WriteString(managesettings__89)
+				buffer.WriteString(managesettings__116)
 				WriteEscString(settingName, buffer)
-				buffer.WriteString(managepagecompose__87)
+				buffer.WriteString(fileedit__114)
 				WriteAll(setting.Name, true, buffer)
-				buffer.WriteString(managesettings__78)
+				buffer.WriteString(managesettings__105)
 				WriteEscString(settingType, buffer)
-				buffer.WriteString(managepagecompose__87)
+				buffer.WriteString(fileedit__114)
 				WriteAll(setting.Type, true, buffer)
-				buffer.WriteString(managesettings__82)
+				buffer.WriteString(managesettings__109)
 				WriteAll(setting.Name, true, buffer)
-				buffer.WriteString(commentlist__50)
+				buffer.WriteString(commentlist__48)
 				WriteAll(label, true, buffer)
-				buffer.WriteString(managesettings__95)
+				buffer.WriteString(managesettings__122)
 				WriteAll(setting.Name, true, buffer)
-				buffer.WriteString(managesettings__85)
+				buffer.WriteString(managepagecompose__145)
 				WriteEscString(settingValue, buffer)
-				buffer.WriteString(commentlist__50)
+				buffer.WriteString(commentlist__48)
 				WriteAll(setting.Value, true, buffer)
-				buffer.WriteString(managesettings__98)
+				buffer.WriteString(fileedit__123)
 
 			} else if setting.Type == "switch" {
-				buffer.WriteString(managesettings__76)
+				buffer.WriteString(managesettings__103)
 				WriteEscString(settingName, buffer)
-				buffer.WriteString(managepagecompose__87)
+				buffer.WriteString(fileedit__114)
 				WriteAll(setting.Name, true, buffer)
-				buffer.WriteString(managesettings__78)
+				buffer.WriteString(managesettings__105)
 				WriteEscString(settingType, buffer)
-				buffer.WriteString(managepagecompose__87)
+				buffer.WriteString(fileedit__114)
 				WriteAll(setting.Type, true, buffer)
-				buffer.WriteString(managesettings__103)
+				buffer.WriteString(managesettings__130)
 				WriteAll(setting.Name, true, buffer)
-				buffer.WriteString(commentlist__50)
+				buffer.WriteString(commentlist__48)
 				if setting.Value == "yes" {
-					buffer.WriteString(managesettings__108)
+					buffer.WriteString(managepagecompose__148)
 					WriteAll(setting.Name, true, buffer)
-					buffer.WriteString(managesettings__109)
+					buffer.WriteString(managepagecompose__149)
 					WriteEscString(settingValue, buffer)
-					buffer.WriteString(managesettings__110)
+					buffer.WriteString(managesettings__137)
 				} else {
-					buffer.WriteString(managesettings__108)
+					buffer.WriteString(managepagecompose__148)
 					WriteAll(setting.Name, true, buffer)
-					buffer.WriteString(managesettings__109)
+					buffer.WriteString(managepagecompose__149)
 					WriteEscString(settingValue, buffer)
-					buffer.WriteString(managesettings__113)
+					buffer.WriteString(managesettings__140)
 				}
+				buffer.WriteString(managesettings__132)
+				WriteAll(setting.Name, true, buffer)
+				buffer.WriteString(commentlist__48)
+				WriteAll(label, true, buffer)
+				buffer.WriteString(managesettings__134)
+
+			} else if setting.Type == "select" {
+				buffer.WriteString(managesettings__116)
+				WriteEscString(settingName, buffer)
+				buffer.WriteString(fileedit__114)
+				WriteAll(setting.Name, true, buffer)
 				buffer.WriteString(managesettings__105)
+				WriteEscString(settingType, buffer)
+				buffer.WriteString(fileedit__114)
+				WriteAll(setting.Type, true, buffer)
+				buffer.WriteString(managesettings__109)
 				WriteAll(setting.Name, true, buffer)
-				buffer.WriteString(commentlist__50)
+				buffer.WriteString(commentlist__48)
 				WriteAll(label, true, buffer)
-				buffer.WriteString(managesettings__107)
+				buffer.WriteString(managesettings__147)
+				WriteAll(setting.Name, true, buffer)
+				buffer.WriteString(managepagecompose__145)
+				WriteEscString(settingValue, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, option := range config.SettingOptions(setting.Name) {
+					if option == setting.Value {
+						buffer.WriteString(filelist__117)
+						WriteAll(option, true, buffer)
+						buffer.WriteString(managepostindex__110)
+						WriteAll(option, true, buffer)
+						buffer.WriteString(filelist__119)
+					} else {
+						buffer.WriteString(filelist__117)
+						WriteAll(option, true, buffer)
+						buffer.WriteString(commentlist__48)
+						WriteAll(option, true, buffer)
+						buffer.WriteString(filelist__119)
+					}
+				}
+				buffer.WriteString(invitelist__110)
 
 			} else {
-				buffer.WriteString(managesettings__89)
+				buffer.WriteString(managesettings__116)
 				WriteEscString(settingName, buffer)
-				buffer.WriteString(managepagecompose__87)
+				buffer.WriteString(fileedit__114)
 				WriteAll(setting.Name, true, buffer)
-				buffer.WriteString(managesettings__78)
+				buffer.WriteString(managesettings__105)
 				WriteEscString(settingType, buffer)
-				buffer.WriteString(managepagecompose__87)
+				buffer.WriteString(fileedit__114)
 				WriteAll(setting.Type, true, buffer)
-				buffer.WriteString(managesettings__82)
+				buffer.WriteString(managesettings__109)
 				WriteAll(setting.Name, true, buffer)
-				buffer.WriteString(commentlist__50)
+				buffer.WriteString(commentlist__48)
 				WriteAll(label, true, buffer)
-				buffer.WriteString(managesettings__120)
+				buffer.WriteString(managesettings__163)
 				WriteAll(setting.Name, true, buffer)
-				buffer.WriteString(managesettings__85)
+				buffer.WriteString(managepagecompose__145)
 				WriteEscString(settingValue, buffer)
-				buffer.WriteString(managepagecompose__87)
+				buffer.WriteString(fileedit__114)
 				WriteAll(setting.Value, true, buffer)
-				buffer.WriteString(managepagecompose__88)
+				buffer.WriteString(fileedit__115)
 
 			}
 		}
-		buffer.WriteString(managesettings__22)
+		buffer.WriteString(managesettings__25)
 		WriteAll(config.Setting("app_name"), true, buffer)
-		buffer.WriteString(commentlist__25)
+		buffer.WriteString(commentlist__27)
 
 		if meta.User == nil || meta.User.ID == 0 {
-			buffer.WriteString(commentlist__106)
+			buffer.WriteString(commentlist__131)
 			WriteAll(utils.Url("/login"), true, buffer)
-			buffer.WriteString(commentlist__107)
+			buffer.WriteString(commentlist__132)
 			WriteAll(utils.Url("/register"), true, buffer)
-			buffer.WriteString(commentlist__108)
+			buffer.WriteString(commentlist__133)
 
 		} else {
 			{
-				buffer.WriteString(commentlist__63)
+				buffer.WriteString(commentlist__84)
 				WriteAll(meta.User.AvatarElm("32", "32", false), false, buffer)
-				buffer.WriteString(commentlist__64)
+				buffer.WriteString(commentlist__85)
 				WriteAll(meta.User.Url(), true, buffer)
-				buffer.WriteString(commentlist__50)
+				buffer.WriteString(commentlist__48)
 				WriteAll(meta.User.Name(), true, buffer)
-				buffer.WriteString(commentlist__66)
+				buffer.WriteString(commentlist__87)
 				WriteAll("@"+meta.User.Username, true, buffer)
-				buffer.WriteString(commentlist__67)
+				buffer.WriteString(commentlist__88)
 				WriteAll(utils.Url("/posts/new"), true, buffer)
-				buffer.WriteString(commentlist__68)
+				buffer.WriteString(commentlist__89)
 				WriteAll(utils.Url("/posts"), true, buffer)
-				buffer.WriteString(commentlist__69)
+				buffer.WriteString(commentlist__90)
 				WriteAll(utils.Url("/comments"), true, buffer)
-				buffer.WriteString(commentlist__70)
+				buffer.WriteString(commentlist__91)
 				WriteAll(utils.Url("/files"), true, buffer)
-				buffer.WriteString(commentlist__71)
+				buffer.WriteString(commentlist__92)
+				WriteAll(utils.Url("/invites"), true, buffer)
+				buffer.WriteString(commentlist__93)
 				WriteAll(utils.Url("/settings"), true, buffer)
-				buffer.WriteString(commentlist__72)
+				buffer.WriteString(commentlist__94)
 
 			}
 
 			if meta.User.IsRoot() {
 				{
-					buffer.WriteString(commentlist__119)
+					buffer.WriteString(commentlist__145)
 					WriteAll(utils.Url("/manage"), true, buffer)
-					buffer.WriteString(commentlist__120)
+					buffer.WriteString(commentlist__146)
 					WriteAll(utils.Url("/manage/topics"), true, buffer)
-					buffer.WriteString(commentlist__121)
+					buffer.WriteString(commentlist__147)
 					WriteAll(utils.Url("/manage/posts"), true, buffer)
-					buffer.WriteString(commentlist__122)
+					buffer.WriteString(commentlist__148)
 					WriteAll(utils.Url("/manage/pages"), true, buffer)
-					buffer.WriteString(commentlist__123)
+					buffer.WriteString(commentlist__149)
 					WriteAll(utils.Url("/manage/roles"), true, buffer)
-					buffer.WriteString(commentlist__124)
+					buffer.WriteString(commentlist__150)
 					WriteAll(utils.Url("/manage/users"), true, buffer)
-					buffer.WriteString(commentlist__125)
+					buffer.WriteString(commentlist__151)
 					WriteAll(utils.Url("/manage/comments"), true, buffer)
-					buffer.WriteString(commentlist__126)
+					buffer.WriteString(commentlist__152)
 					WriteAll(utils.Url("/manage/files"), true, buffer)
-					buffer.WriteString(commentlist__127)
+					buffer.WriteString(commentlist__153)
 					WriteAll(utils.Url("/manage/settings"), true, buffer)
-					buffer.WriteString(commentlist__72)
+					buffer.WriteString(commentlist__154)
+					WriteAll(utils.Url("/manage/menus"), true, buffer)
+					buffer.WriteString(commentlist__155)
+					WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+					buffer.WriteString(commentlist__156)
+					WriteAll(utils.Url("/manage/audit"), true, buffer)
+					buffer.WriteString(commentlist__157)
 
 				}
 
 			}
 		}
-		buffer.WriteString(commentlist__26)
+		buffer.WriteString(commentlist__28)
 
-		for _, topic := range cache.Topics {
-			buffer.WriteString(commentlist__106)
+		for _, topic := range cache.Topics() {
+			buffer.WriteString(commentlist__131)
 			WriteAll(topic.Url(), true, buffer)
-			buffer.WriteString(commentlist__49)
+			buffer.WriteString(commentlist__70)
 			WriteAll(topic.Name, true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			WriteAll("#"+topic.Name, true, buffer)
-			buffer.WriteString(commentlist__132)
+			buffer.WriteString(commentlist__52)
 		}
-		buffer.WriteString(commentlist__27)
+		buffer.WriteString(commentlist__29)
+
+		{
+			var (
+				location = "footer"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__30)
 		WriteAll(config.Setting("footer_content"), false, buffer)
-		buffer.WriteString(commentlist__28)
+		buffer.WriteString(commentlist__31)
 		WriteAll(config.Setting("inject_footer"), false, buffer)
 		WriteAll(asset.JsFile("js/layout.js"), false, buffer)
 		WriteAll(asset.JsFile("js/main.js"), false, buffer)
-		buffer.WriteString(error__26)
+		buffer.WriteString(error__29)
 
 	}
 }
@@ -15,22 +15,18 @@ import (
 )
 
 const (
-	manageusercompose__21  = `<p>Auth provider<select name="provider">`
-	manageusercompose__22  = `</select></p>`
-	manageusercompose__23  = `<hr/><strong>To keep the old password, leave this field blank.</strong>`
-	manageusercompose__26  = `</div><strong>Select roles</strong>`
-	manageusercompose__27  = `<div><strong>Avatar</strong><input class="image-input" id="avatar-image" type="file" name="avatar_image"/><div class="image-upload-previewer" for="avatar-image">`
-	manageusercompose__28  = `</div></div></div></div></div></form></div><div class="mobile-menu"><div class="menu-head">`
-	manageusercompose__33  = `<script>listenDeleteNodeEvents('user', '/manage/users', '/manage/users')</script></body></html>`
-	manageusercompose__138 = `<button class="danger delete-user" data-id="`
-	manageusercompose__140 = `<div class="multi-checkbox scroll">`
-	manageusercompose__142 = `<label for="`
-	manageusercompose__143 = `"><input type="checkbox" name="`
-	manageusercompose__145 = `" id="`
-	manageusercompose__146 = `" checked="checked"/><span class="name">`
-	manageusercompose__147 = `</span></label>`
-	manageusercompose__152 = `"/><span class="name">`
-	manageusercompose__156 = `<img/>`
+	manageusercompose__24  = `<p>Auth provider<select name="provider">`
+	manageusercompose__26  = `<hr/><strong>To keep the old password, leave this field blank.</strong>`
+	manageusercompose__29  = `</div><strong>Select roles</strong>`
+	manageusercompose__30  = `<div><strong>Avatar</strong><input class="image-input" id="avatar-image" type="file" name="avatar_image"/><div class="image-upload-previewer" for="avatar-image">`
+	manageusercompose__31  = `</div></div></div></div></div></form></div><div class="mobile-menu"><div class="menu-head">`
+	manageusercompose__37  = `<script>listenDeleteNodeEvents('user', '/manage/users', '/manage/users')</script></body></html>`
+	manageusercompose__204 = `<button class="danger delete-user" data-id="`
+	manageusercompose__206 = `<div class="multi-checkbox scroll">`
+	manageusercompose__209 = `"><input type="checkbox" name="`
+	manageusercompose__212 = `" checked="checked"/><span class="name">`
+	manageusercompose__218 = `"/><span class="name">`
+	manageusercompose__222 = `<img/>`
 )
 
 func ManageUserCompose(ID int, user *entities.User, roles []*entities.Role, providers []server.AuthProvider) func(meta *entities.Meta, wr *bufio.Writer) {
@@ -43,155 +39,218 @@ func ManageUserCompose(ID int, user *entities.User, roles []*entities.Role, prov
 		var appName = config.Setting("app_name")
 		var appLogo = config.Setting("app_logo")
 		buffer.WriteString(commentlist__1)
-		WriteAll(title, true, buffer)
+		WriteAll(meta.CsrfToken, true, buffer)
 		buffer.WriteString(commentlist__2)
-		WriteAll(meta.Canonical, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__3)
-		WriteAll(meta.Type, true, buffer)
-		buffer.WriteString(commentlist__4)
 		WriteAll(meta.Canonical, true, buffer)
+		buffer.WriteString(commentlist__4)
+		WriteAll(meta.Type, true, buffer)
 		buffer.WriteString(commentlist__5)
-		WriteAll(title, true, buffer)
+		WriteAll(meta.Canonical, true, buffer)
 		buffer.WriteString(commentlist__6)
-		WriteAll(appName, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__7)
-		WriteAll(config.Setting("twitter_site"), true, buffer)
+		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__8)
-		WriteAll(title, true, buffer)
+		WriteAll(config.Setting("twitter_site"), true, buffer)
 		buffer.WriteString(commentlist__9)
-		WriteAll(appName, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__10)
 		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__11)
-		WriteAll(appName+" Feed", true, buffer)
+		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__12)
-		WriteAll(utils.Url("/feed"), true, buffer)
+		WriteAll(appName+" Feed", true, buffer)
 		buffer.WriteString(commentlist__13)
+		WriteAll(utils.Url("/feed"), true, buffer)
+		buffer.WriteString(commentlist__14)
 		if appLogo != "" {
-			buffer.WriteString(commentlist__30)
+			buffer.WriteString(commentlist__33)
 			WriteAll(appLogo, true, buffer)
-			buffer.WriteString(commentlist__31)
+			buffer.WriteString(commentlist__34)
 			WriteAll(appLogo, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		if meta.Description != "" {
-			buffer.WriteString(commentlist__33)
+			buffer.WriteString(commentlist__36)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__34)
+			buffer.WriteString(commentlist__37)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__35)
+			buffer.WriteString(commentlist__38)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		if meta.Image != "" {
-			buffer.WriteString(commentlist__37)
+			buffer.WriteString(commentlist__40)
 			WriteAll(meta.Image, true, buffer)
-			buffer.WriteString(commentlist__38)
+			buffer.WriteString(commentlist__41)
 			WriteAll(meta.Image, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		WriteAll(asset.CssFile("css/light.min.css"), false, buffer)
 		WriteAll(asset.CssFile("css/style.css"), false, buffer)
 		WriteAll(config.Setting("inject_header"), false, buffer)
-		buffer.WriteString(commentlist__14)
-		WriteAll(utils.Url(""), true, buffer)
 		buffer.WriteString(commentlist__15)
+		WriteAll(utils.Url(""), true, buffer)
+		buffer.WriteString(commentlist__16)
 		var logoUrl = config.Setting("app_logo")
 		if logoUrl != "" {
-			buffer.WriteString(commentlist__40)
+			buffer.WriteString(commentlist__43)
 			WriteAll(logoUrl, true, buffer)
-			buffer.WriteString(commentlist__41)
+			buffer.WriteString(commentlist__44)
 			WriteAll(config.Setting("app_name"), true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		} else {
-			buffer.WriteString(commentlist__43)
+			buffer.WriteString(commentlist__46)
 
 		}
-		buffer.WriteString(commentlist__16)
-		WriteAll(meta.Query, true, buffer)
 		buffer.WriteString(commentlist__17)
-		WriteAll(utils.Url("/search"), true, buffer)
+		WriteAll(meta.Query, true, buffer)
 		buffer.WriteString(commentlist__18)
 
+		{
+			var (
+				location = "header"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__19)
+		WriteAll(utils.Url("/search"), true, buffer)
+		buffer.WriteString(commentlist__20)
+
 		if meta.User == nil || meta.User.ID == 0 {
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(utils.Url("/login"), true, buffer)
-			buffer.WriteString(commentlist__45)
+			buffer.WriteString(commentlist__66)
 			WriteAll(utils.Url("/register"), true, buffer)
-			buffer.WriteString(commentlist__46)
+			buffer.WriteString(commentlist__67)
 
 		} else {
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(utils.Url("/posts/new"), true, buffer)
-			buffer.WriteString(commentlist__48)
+			buffer.WriteString(commentlist__69)
 			WriteAll(meta.User.Url(), true, buffer)
-			buffer.WriteString(commentlist__49)
+			buffer.WriteString(commentlist__70)
 			WriteAll(meta.User.Username, true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			if meta.User.AvatarImageUrl != "" {
-				buffer.WriteString(commentlist__57)
+				buffer.WriteString(commentlist__78)
 				WriteAll(meta.User.AvatarImageUrl, true, buffer)
-				buffer.WriteString(commentlist__41)
+				buffer.WriteString(commentlist__44)
 				WriteAll(meta.User.Username, true, buffer)
-				buffer.WriteString(commentlist__13)
+				buffer.WriteString(commentlist__14)
 			} else {
-				buffer.WriteString(commentlist__60)
+				buffer.WriteString(commentlist__81)
 
 			}
-			buffer.WriteString(commentlist__51)
+			buffer.WriteString(commentlist__72)
 
 			if meta.User != nil && meta.User.IsRoot() {
-				buffer.WriteString(commentlist__44)
+				buffer.WriteString(commentlist__50)
 				WriteAll(utils.Url("/manage"), true, buffer)
-				buffer.WriteString(commentlist__62)
+				buffer.WriteString(commentlist__83)
 
 			}
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(meta.User.Url(), true, buffer)
-			buffer.WriteString(commentlist__53)
+			buffer.WriteString(commentlist__74)
 			WriteAll(utils.Url("/posts"), true, buffer)
-			buffer.WriteString(commentlist__54)
+			buffer.WriteString(commentlist__75)
 			WriteAll(utils.Url("/settings"), true, buffer)
-			buffer.WriteString(commentlist__55)
+			buffer.WriteString(commentlist__76)
 			WriteAll(utils.Url("/logout"), true, buffer)
-			buffer.WriteString(commentlist__56)
+			buffer.WriteString(commentlist__77)
+
+		}
+		buffer.WriteString(managepagecompose__21)
 
+		{
+			buffer.WriteString(commentlist__125)
+			WriteAll(meta.CsrfToken, true, buffer)
+			buffer.WriteString(commentlist__14)
 		}
-		buffer.WriteString(managepagecompose__19)
+
+		buffer.WriteString(fileedit__22)
 
 		{
-			buffer.WriteString(commentlist__119)
+			buffer.WriteString(commentlist__145)
 			WriteAll(utils.Url("/manage"), true, buffer)
-			buffer.WriteString(commentlist__120)
+			buffer.WriteString(commentlist__146)
 			WriteAll(utils.Url("/manage/topics"), true, buffer)
-			buffer.WriteString(commentlist__121)
+			buffer.WriteString(commentlist__147)
 			WriteAll(utils.Url("/manage/posts"), true, buffer)
-			buffer.WriteString(commentlist__122)
+			buffer.WriteString(commentlist__148)
 			WriteAll(utils.Url("/manage/pages"), true, buffer)
-			buffer.WriteString(commentlist__123)
+			buffer.WriteString(commentlist__149)
 			WriteAll(utils.Url("/manage/roles"), true, buffer)
-			buffer.WriteString(commentlist__124)
+			buffer.WriteString(commentlist__150)
 			WriteAll(utils.Url("/manage/users"), true, buffer)
-			buffer.WriteString(commentlist__125)
+			buffer.WriteString(commentlist__151)
 			WriteAll(utils.Url("/manage/comments"), true, buffer)
-			buffer.WriteString(commentlist__126)
+			buffer.WriteString(commentlist__152)
 			WriteAll(utils.Url("/manage/files"), true, buffer)
-			buffer.WriteString(commentlist__127)
+			buffer.WriteString(commentlist__153)
 			WriteAll(utils.Url("/manage/settings"), true, buffer)
-			buffer.WriteString(commentlist__72)
+			buffer.WriteString(commentlist__154)
+			WriteAll(utils.Url("/manage/menus"), true, buffer)
+			buffer.WriteString(commentlist__155)
+			WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+			buffer.WriteString(commentlist__156)
+			WriteAll(utils.Url("/manage/audit"), true, buffer)
+			buffer.WriteString(commentlist__157)
 
 		}
 
-		buffer.WriteString(managecommentindex__20)
+		buffer.WriteString(manageauditindex__22)
 
 		if ID > 0 {
-			buffer.WriteString(managepagecompose__77)
+			buffer.WriteString(managecustomfieldcompose__106)
 			WriteAll("Editing user: "+user.Username, true, buffer)
-			buffer.WriteString(error__20)
+			buffer.WriteString(error__22)
 		} else {
-			buffer.WriteString(managepagecompose__77)
+			buffer.WriteString(managecustomfieldcompose__106)
 			WriteEscString("Create a new user", buffer)
-			buffer.WriteString(error__20)
+			buffer.WriteString(error__22)
 		}
 		{
 			var (
@@ -199,16 +258,16 @@ func ManageUserCompose(ID int, user *entities.User, roles []*entities.Role, prov
 			)
 
 			if msgs.Length() > 0 {
-				buffer.WriteString(commentlist__73)
+				buffer.WriteString(commentlist__95)
 				var messages = msgs.Get()
 				for _, msg := range messages {
-					buffer.WriteString(commentlist__75)
+					buffer.WriteString(commentlist__97)
 					WriteAll(msg.Type, true, buffer)
-					buffer.WriteString(commentlist__50)
+					buffer.WriteString(commentlist__48)
 					WriteAll(msg.Message, true, buffer)
-					buffer.WriteString(commentlist__77)
+					buffer.WriteString(commentlist__53)
 				}
-				buffer.WriteString(commentlist__74)
+				buffer.WriteString(commentlist__49)
 			}
 		}
 
@@ -219,13 +278,13 @@ func ManageUserCompose(ID int, user *entities.User, roles []*entities.Role, prov
 				label = "Username"
 			)
 
-			buffer.WriteString(managepagecompose__85)
+			buffer.WriteString(fileedit__112)
 			WriteEscString(label, buffer)
-			buffer.WriteString(managepagecompose__86)
+			buffer.WriteString(fileedit__113)
 			WriteEscString(name, buffer)
-			buffer.WriteString(managepagecompose__87)
+			buffer.WriteString(fileedit__114)
 			WriteAll(value, true, buffer)
-			buffer.WriteString(managepagecompose__88)
+			buffer.WriteString(fileedit__115)
 		}
 
 		{
@@ -235,13 +294,13 @@ func ManageUserCompose(ID int, user *entities.User, roles []*entities.Role, prov
 				label = "Display name"
 			)
 
-			buffer.WriteString(managepagecompose__85)
+			buffer.WriteString(fileedit__112)
 			WriteEscString(label, buffer)
-			buffer.WriteString(managepagecompose__86)
+			buffer.WriteString(fileedit__113)
 			WriteEscString(name, buffer)
-			buffer.WriteString(managepagecompose__87)
+			buffer.WriteString(fileedit__114)
 			WriteAll(value, true, buffer)
-			buffer.WriteString(managepagecompose__88)
+			buffer.WriteString(fileedit__115)
 		}
 
 		{
@@ -251,13 +310,13 @@ func ManageUserCompose(ID int, user *entities.User, roles []*entities.Role, prov
 				label = "Email"
 			)
 
-			buffer.WriteString(managepagecompose__85)
+			buffer.WriteString(fileedit__112)
 			WriteEscString(label, buffer)
-			buffer.WriteString(managepagecompose__86)
+			buffer.WriteString(fileedit__113)
 			WriteEscString(name, buffer)
-			buffer.WriteString(managepagecompose__87)
+			buffer.WriteString(fileedit__114)
 			WriteAll(value, true, buffer)
-			buffer.WriteString(managepagecompose__88)
+			buffer.WriteString(fileedit__115)
 		}
 
 		{
@@ -267,13 +326,13 @@ func ManageUserCompose(ID int, user *entities.User, roles []*entities.Role, prov
 				label = "Url"
 			)
 
-			buffer.WriteString(managepagecompose__85)
+			buffer.WriteString(fileedit__112)
 			WriteEscString(label, buffer)
-			buffer.WriteString(managepagecompose__86)
+			buffer.WriteString(fileedit__113)
 			WriteEscString(name, buffer)
-			buffer.WriteString(managepagecompose__87)
+			buffer.WriteString(fileedit__114)
 			WriteAll(value, true, buffer)
-			buffer.WriteString(managepagecompose__88)
+			buffer.WriteString(fileedit__115)
 		}
 
 		{
@@ -283,16 +342,110 @@ func ManageUserCompose(ID int, user *entities.User, roles []*entities.Role, prov
 				label = "User bio"
 			)
 
-			buffer.WriteString(managepagecompose__85)
+			buffer.WriteString(fileedit__112)
 			WriteEscString(label, buffer)
-			buffer.WriteString(managetopiccompose__87)
+			buffer.WriteString(fileedit__121)
 			WriteEscString(name, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			WriteAll(value, true, buffer)
-			buffer.WriteString(managesettings__98)
+			buffer.WriteString(fileedit__123)
 		}
 
-		buffer.WriteString(manageusercompose__21)
+		{
+			var (
+				target = "user"
+				values = user.CustomFields
+			)
+
+			for _, field := range cache.CustomFields(target) {
+				buffer.WriteString(managepagecompose__139)
+				WriteAll(field.InputName(), true, buffer)
+				buffer.WriteString(commentlist__48)
+				WriteAll(field.Label, true, buffer)
+				if field.Required {
+					buffer.WriteString(managepagecompose__143)
+				}
+				buffer.WriteString(managepagecompose__141)
+				if field.Type == "textarea" {
+					buffer.WriteString(managepagecompose__144)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(managepagecompose__145)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(values[field.Name], true, buffer)
+					buffer.WriteString(managepagecompose__147)
+				} else if field.Type == "boolean" {
+					if values[field.Name] == "true" {
+						buffer.WriteString(managepagecompose__148)
+						WriteAll(field.InputName(), true, buffer)
+						buffer.WriteString(managepagecompose__149)
+						WriteAll(field.InputName(), true, buffer)
+						buffer.WriteString(managecustomfieldcompose__147)
+					} else {
+						buffer.WriteString(managepagecompose__148)
+						WriteAll(field.InputName(), true, buffer)
+						buffer.WriteString(managepagecompose__149)
+						WriteAll(field.InputName(), true, buffer)
+						buffer.WriteString(commentlist__14)
+					}
+				} else if field.Type == "select" {
+					buffer.WriteString(managepagecompose__154)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(managepagecompose__145)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(managepagecompose__156)
+
+					for _, option := range field.Options {
+						if option == values[field.Name] {
+							buffer.WriteString(filelist__117)
+							WriteAll(option, true, buffer)
+							buffer.WriteString(filelist__118)
+							WriteAll(option, true, buffer)
+							buffer.WriteString(filelist__119)
+						} else {
+							buffer.WriteString(filelist__117)
+							WriteAll(option, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(option, true, buffer)
+							buffer.WriteString(filelist__119)
+						}
+					}
+					buffer.WriteString(managepagecompose__157)
+				} else if field.Type == "number" {
+					buffer.WriteString(managepagecompose__148)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(managepagecompose__165)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(fileedit__114)
+					WriteAll(values[field.Name], true, buffer)
+					buffer.WriteString(commentlist__14)
+				} else if field.Type == "url" {
+					buffer.WriteString(managepagecompose__148)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(managepagecompose__169)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(fileedit__114)
+					WriteAll(values[field.Name], true, buffer)
+					buffer.WriteString(commentlist__14)
+				} else {
+					buffer.WriteString(managepagecompose__148)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(managepagecompose__145)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(fileedit__114)
+					WriteAll(values[field.Name], true, buffer)
+					buffer.WriteString(commentlist__14)
+				}
+				if field.Description != "" {
+					buffer.WriteString(managepagecompose__176)
+					WriteAll(field.Description, true, buffer)
+					buffer.WriteString(managepagecompose__177)
+				}
+				buffer.WriteString(commentlist__24)
+			}
+		}
+
+		buffer.WriteString(manageusercompose__24)
 
 		for _, provider := range providers {
 			{
@@ -303,22 +456,22 @@ func ManageUserCompose(ID int, user *entities.User, roles []*entities.Role, prov
 				)
 
 				if value == selected {
-					buffer.WriteString(managepostindex__85)
+					buffer.WriteString(filelist__117)
 					WriteAll(value, true, buffer)
-					buffer.WriteString(managerolecompose__98)
+					buffer.WriteString(filelist__118)
 					WriteAll(label, true, buffer)
-					buffer.WriteString(managepostindex__87)
+					buffer.WriteString(filelist__119)
 				} else {
-					buffer.WriteString(managepostindex__85)
+					buffer.WriteString(filelist__117)
 					WriteAll(value, true, buffer)
-					buffer.WriteString(commentlist__50)
+					buffer.WriteString(commentlist__48)
 					WriteAll(label, true, buffer)
-					buffer.WriteString(managepostindex__87)
+					buffer.WriteString(filelist__119)
 				}
 			}
 
 		}
-		buffer.WriteString(manageusercompose__22)
+		buffer.WriteString(invitelist__110)
 
 		{
 			var (
@@ -327,13 +480,13 @@ func ManageUserCompose(ID int, user *entities.User, roles []*entities.Role, prov
 				label = "Provider ID"
 			)
 
-			buffer.WriteString(managepagecompose__85)
+			buffer.WriteString(fileedit__112)
 			WriteEscString(label, buffer)
-			buffer.WriteString(managepagecompose__86)
+			buffer.WriteString(fileedit__113)
 			WriteEscString(name, buffer)
-			buffer.WriteString(managepagecompose__87)
+			buffer.WriteString(fileedit__114)
 			WriteAll(value, true, buffer)
-			buffer.WriteString(managepagecompose__88)
+			buffer.WriteString(fileedit__115)
 		}
 
 		{
@@ -343,13 +496,13 @@ func ManageUserCompose(ID int, user *entities.User, roles []*entities.Role, prov
 				label = "Provider username"
 			)
 
-			buffer.WriteString(managepagecompose__85)
+			buffer.WriteString(fileedit__112)
 			WriteEscString(label, buffer)
-			buffer.WriteString(managepagecompose__86)
+			buffer.WriteString(fileedit__113)
 			WriteEscString(name, buffer)
-			buffer.WriteString(managepagecompose__87)
+			buffer.WriteString(fileedit__114)
 			WriteAll(value, true, buffer)
-			buffer.WriteString(managepagecompose__88)
+			buffer.WriteString(fileedit__115)
 		}
 
 		{
@@ -359,16 +512,16 @@ func ManageUserCompose(ID int, user *entities.User, roles []*entities.Role, prov
 				label = "Provider avatar"
 			)
 
-			buffer.WriteString(managepagecompose__85)
+			buffer.WriteString(fileedit__112)
 			WriteEscString(label, buffer)
-			buffer.WriteString(managepagecompose__86)
+			buffer.WriteString(fileedit__113)
 			WriteEscString(name, buffer)
-			buffer.WriteString(managepagecompose__87)
+			buffer.WriteString(fileedit__114)
 			WriteAll(value, true, buffer)
-			buffer.WriteString(managepagecompose__88)
+			buffer.WriteString(fileedit__115)
 		}
 
-		buffer.WriteString(manageusercompose__23)
+		buffer.WriteString(manageusercompose__26)
 
 		{
 			var (
@@ -377,16 +530,16 @@ func ManageUserCompose(ID int, user *entities.User, roles []*entities.Role, prov
 				label = "Password"
 			)
 
-			buffer.WriteString(managepagecompose__85)
+			buffer.WriteString(fileedit__112)
 			WriteEscString(label, buffer)
-			buffer.WriteString(managepagecompose__86)
+			buffer.WriteString(fileedit__113)
 			WriteEscString(name, buffer)
-			buffer.WriteString(managepagecompose__87)
+			buffer.WriteString(fileedit__114)
 			WriteEscString(value, buffer)
-			buffer.WriteString(managepagecompose__88)
+			buffer.WriteString(fileedit__115)
 		}
 
-		buffer.WriteString(managerolecompose__21)
+		buffer.WriteString(managerolecompose__24)
 
 		{
 			var (
@@ -394,11 +547,11 @@ func ManageUserCompose(ID int, user *entities.User, roles []*entities.Role, prov
 				link  = "/manage/users/new"
 			)
 
-			buffer.WriteString(managepagecompose__93)
+			buffer.WriteString(managecustomfieldcompose__126)
 			WriteEscString(link, buffer)
-			buffer.WriteString(managepagecompose__94)
+			buffer.WriteString(managecustomfieldcompose__127)
 			WriteEscString(label, buffer)
-			buffer.WriteString(commentlist__132)
+			buffer.WriteString(commentlist__52)
 		}
 
 		{
@@ -408,31 +561,31 @@ func ManageUserCompose(ID int, user *entities.User, roles []*entities.Role, prov
 				label     = "Active"
 			)
 
-			buffer.WriteString(managerolecompose__118)
+			buffer.WriteString(managecustomfieldcompose__143)
 			WriteEscString(label, buffer)
-			buffer.WriteString(managerolecompose__119)
+			buffer.WriteString(managecustomfieldcompose__144)
 			if condition {
-				buffer.WriteString(managerolecompose__121)
+				buffer.WriteString(managecustomfieldcompose__146)
 				WriteEscString(name, buffer)
-				buffer.WriteString(managerolecompose__122)
+				buffer.WriteString(managecustomfieldcompose__147)
 			} else {
-				buffer.WriteString(managerolecompose__121)
+				buffer.WriteString(managecustomfieldcompose__146)
 				WriteEscString(name, buffer)
-				buffer.WriteString(commentlist__13)
+				buffer.WriteString(commentlist__14)
 			}
-			buffer.WriteString(managerolecompose__120)
+			buffer.WriteString(managecustomfieldcompose__145)
 
 		}
 
-		buffer.WriteString(managerolecompose__22)
+		buffer.WriteString(managecustomfieldcompose__27)
 
 		if ID > 1 {
-			buffer.WriteString(manageusercompose__138)
+			buffer.WriteString(manageusercompose__204)
 			WriteInt(int64(ID), buffer)
-			buffer.WriteString(managerolecompose__126)
+			buffer.WriteString(managerolecompose__182)
 
 		}
-		buffer.WriteString(manageusercompose__26)
+		buffer.WriteString(manageusercompose__29)
 
 		{
 			var (
@@ -441,128 +594,183 @@ func ManageUserCompose(ID int, user *entities.User, roles []*entities.Role, prov
 				selected = user.RoleIDs
 			)
 
-			buffer.WriteString(manageusercompose__140)
+			buffer.WriteString(manageusercompose__206)
 			for _, role := range roles {
 				var inputId = fmt.Sprintf("role-%d", role.ID)
 				if utils.SliceContains(selected, role.ID) {
-					buffer.WriteString(manageusercompose__142)
+					buffer.WriteString(managerolecompose__121)
 					WriteEscString(inputId, buffer)
-					buffer.WriteString(manageusercompose__143)
+					buffer.WriteString(manageusercompose__209)
 					WriteEscString(name, buffer)
-					buffer.WriteString(managepagecompose__87)
+					buffer.WriteString(fileedit__114)
 					WriteAll(role.ID, true, buffer)
-					buffer.WriteString(manageusercompose__145)
+					buffer.WriteString(managerolecompose__126)
 					WriteEscString(inputId, buffer)
-					buffer.WriteString(manageusercompose__146)
+					buffer.WriteString(manageusercompose__212)
 					WriteAll(role.Name, true, buffer)
-					buffer.WriteString(manageusercompose__147)
+					buffer.WriteString(managerolecompose__124)
 
 				} else {
-					buffer.WriteString(manageusercompose__142)
+					buffer.WriteString(managerolecompose__121)
 					WriteEscString(inputId, buffer)
-					buffer.WriteString(manageusercompose__143)
+					buffer.WriteString(manageusercompose__209)
 					WriteEscString(name, buffer)
-					buffer.WriteString(managepagecompose__87)
+					buffer.WriteString(fileedit__114)
 					WriteAll(role.ID, true, buffer)
-					buffer.WriteString(manageusercompose__145)
+					buffer.WriteString(managerolecompose__126)
 					WriteEscString(inputId, buffer)
-					buffer.WriteString(manageusercompose__152)
+					buffer.WriteString(manageusercompose__218)
 					WriteAll(role.Name, true, buffer)
-					buffer.WriteString(manageusercompose__147)
+					buffer.WriteString(managerolecompose__124)
 
 				}
 			}
-			buffer.WriteString(commentlist__22)
+			buffer.WriteString(commentlist__24)
 		}
 
-		buffer.WriteString(manageusercompose__27)
+		buffer.WriteString(manageusercompose__30)
 
 		if user.AvatarImage != nil {
-			buffer.WriteString(commentlist__40)
-			WriteAll(user.AvatarImage.Url(), true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__43)
+			WriteAll(user.AvatarImage.Url("thumbnail"), true, buffer)
+			buffer.WriteString(commentlist__14)
 		} else {
-			buffer.WriteString(manageusercompose__156)
+			buffer.WriteString(manageusercompose__222)
 		}
-		buffer.WriteString(manageusercompose__28)
+		buffer.WriteString(manageusercompose__31)
 		WriteAll(config.Setting("app_name"), true, buffer)
-		buffer.WriteString(commentlist__25)
+		buffer.WriteString(commentlist__27)
 
 		if meta.User == nil || meta.User.ID == 0 {
-			buffer.WriteString(commentlist__106)
+			buffer.WriteString(commentlist__131)
 			WriteAll(utils.Url("/login"), true, buffer)
-			buffer.WriteString(commentlist__107)
+			buffer.WriteString(commentlist__132)
 			WriteAll(utils.Url("/register"), true, buffer)
-			buffer.WriteString(commentlist__108)
+			buffer.WriteString(commentlist__133)
 
 		} else {
 			{
-				buffer.WriteString(commentlist__63)
+				buffer.WriteString(commentlist__84)
 				WriteAll(meta.User.AvatarElm("32", "32", false), false, buffer)
-				buffer.WriteString(commentlist__64)
+				buffer.WriteString(commentlist__85)
 				WriteAll(meta.User.Url(), true, buffer)
-				buffer.WriteString(commentlist__50)
+				buffer.WriteString(commentlist__48)
 				WriteAll(meta.User.Name(), true, buffer)
-				buffer.WriteString(commentlist__66)
+				buffer.WriteString(commentlist__87)
 				WriteAll("@"+meta.User.Username, true, buffer)
-				buffer.WriteString(commentlist__67)
+				buffer.WriteString(commentlist__88)
 				WriteAll(utils.Url("/posts/new"), true, buffer)
-				buffer.WriteString(commentlist__68)
+				buffer.WriteString(commentlist__89)
 				WriteAll(utils.Url("/posts"), true, buffer)
-				buffer.WriteString(commentlist__69)
+				buffer.WriteString(commentlist__90)
 				WriteAll(utils.Url("/comments"), true, buffer)
-				buffer.WriteString(commentlist__70)
+				buffer.WriteString(commentlist__91)
 				WriteAll(utils.Url("/files"), true, buffer)
-				buffer.WriteString(commentlist__71)
+				buffer.WriteString(commentlist__92)
+				WriteAll(utils.Url("/invites"), true, buffer)
+				buffer.WriteString(commentlist__93)
 				WriteAll(utils.Url("/settings"), true, buffer)
-				buffer.WriteString(commentlist__72)
+				buffer.WriteString(commentlist__94)
 
 			}
 
 			if meta.User.IsRoot() {
 				{
-					buffer.WriteString(commentlist__119)
+					buffer.WriteString(commentlist__145)
 					WriteAll(utils.Url("/manage"), true, buffer)
-					buffer.WriteString(commentlist__120)
+					buffer.WriteString(commentlist__146)
 					WriteAll(utils.Url("/manage/topics"), true, buffer)
-					buffer.WriteString(commentlist__121)
+					buffer.WriteString(commentlist__147)
 					WriteAll(utils.Url("/manage/posts"), true, buffer)
-					buffer.WriteString(commentlist__122)
+					buffer.WriteString(commentlist__148)
 					WriteAll(utils.Url("/manage/pages"), true, buffer)
-					buffer.WriteString(commentlist__123)
+					buffer.WriteString(commentlist__149)
 					WriteAll(utils.Url("/manage/roles"), true, buffer)
-					buffer.WriteString(commentlist__124)
+					buffer.WriteString(commentlist__150)
 					WriteAll(utils.Url("/manage/users"), true, buffer)
-					buffer.WriteString(commentlist__125)
+					buffer.WriteString(commentlist__151)
 					WriteAll(utils.Url("/manage/comments"), true, buffer)
-					buffer.WriteString(commentlist__126)
+					buffer.WriteString(commentlist__152)
 					WriteAll(utils.Url("/manage/files"), true, buffer)
-					buffer.WriteString(commentlist__127)
+					buffer.WriteString(commentlist__153)
 					WriteAll(utils.Url("/manage/settings"), true, buffer)
-					buffer.WriteString(commentlist__72)
+					buffer.WriteString(commentlist__154)
+					WriteAll(utils.Url("/manage/menus"), true, buffer)
+					buffer.WriteString(commentlist__155)
+					WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+					buffer.WriteString(commentlist__156)
+					WriteAll(utils.Url("/manage/audit"), true, buffer)
+					buffer.WriteString(commentlist__157)
 
 				}
 
 			}
 		}
-		buffer.WriteString(commentlist__26)
+		buffer.WriteString(commentlist__28)
 
-		for _, topic := range cache.Topics {
-			buffer.WriteString(commentlist__106)
+		for _, topic := range cache.Topics() {
+			buffer.WriteString(commentlist__131)
 			WriteAll(topic.Url(), true, buffer)
-			buffer.WriteString(commentlist__49)
+			buffer.WriteString(commentlist__70)
 			WriteAll(topic.Name, true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			WriteAll("#"+topic.Name, true, buffer)
-			buffer.WriteString(commentlist__132)
+			buffer.WriteString(commentlist__52)
 		}
-		buffer.WriteString(commentlist__27)
+		buffer.WriteString(commentlist__29)
+
+		{
+			var (
+				location = "footer"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__30)
 		WriteAll(config.Setting("footer_content"), false, buffer)
-		buffer.WriteString(commentlist__28)
+		buffer.WriteString(commentlist__31)
 		WriteAll(config.Setting("inject_footer"), false, buffer)
 		WriteAll(asset.JsFile("js/layout.js"), false, buffer)
 		WriteAll(asset.JsFile("js/main.js"), false, buffer)
-		buffer.WriteString(manageusercompose__33)
+		buffer.WriteString(manageusercompose__37)
 
 	}
 }
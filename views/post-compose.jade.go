@@ -14,11 +14,10 @@ import (
 )
 
 const (
-	postcompose__19 = `</ul><label class="menu-trigger"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M3,6H21V8H3V6M3,11H21V13H3V11M3,16H21V18H3V16Z"></path></svg></label></nav></header><div class="wrapper"><div class="container"><form method="POST" enctype="multipart/form-data"><div class="layout two-right"><div class="main">`
-	postcompose__21 = `</textarea></div><div class="right"><div class="box fixed-sidebar"><div class="save-actions"><button>Save</button><label class="switch" for="save-draft">Draft &nbsp;`
-	postcompose__22 = `<span class="slider"></span></label></div><div><strong>Post Topics</strong>`
-	postcompose__23 = `</div><div><strong>Featured Image</strong><input type="hidden" name="featured_image_id" value="`
-	postcompose__31 = `<script>new TetuaEditor('.content', {uploadHandler: uploadHandler});</script></body></html>`
+	postcompose__22 = `<div class="layout two-right"><div class="main">`
+	postcompose__24 = `</textarea></div><div class="right"><div class="box fixed-sidebar"><div class="save-actions"><button>Save</button><label class="switch" for="save-draft">Draft &nbsp;`
+	postcompose__25 = `<span class="slider"></span></label></div><div><strong>Post Topics</strong>`
+	postcompose__36 = `<script>new TetuaEditor('.content', {uploadHandler: uploadHandler, pickHandler: imagePickHandler});</script></body></html>`
 )
 
 func PostCompose(topics []*entities.Topic, post *entities.PostMutation, featuredImage *entities.File) func(meta *entities.Meta, wr *bufio.Writer) {
@@ -31,52 +30,54 @@ func PostCompose(topics []*entities.Topic, post *entities.PostMutation, featured
 		var appName = config.Setting("app_name")
 		var appLogo = config.Setting("app_logo")
 		buffer.WriteString(commentlist__1)
-		WriteAll(title, true, buffer)
+		WriteAll(meta.CsrfToken, true, buffer)
 		buffer.WriteString(commentlist__2)
-		WriteAll(meta.Canonical, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__3)
-		WriteAll(meta.Type, true, buffer)
-		buffer.WriteString(commentlist__4)
 		WriteAll(meta.Canonical, true, buffer)
+		buffer.WriteString(commentlist__4)
+		WriteAll(meta.Type, true, buffer)
 		buffer.WriteString(commentlist__5)
-		WriteAll(title, true, buffer)
+		WriteAll(meta.Canonical, true, buffer)
 		buffer.WriteString(commentlist__6)
-		WriteAll(appName, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__7)
-		WriteAll(config.Setting("twitter_site"), true, buffer)
+		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__8)
-		WriteAll(title, true, buffer)
+		WriteAll(config.Setting("twitter_site"), true, buffer)
 		buffer.WriteString(commentlist__9)
-		WriteAll(appName, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__10)
 		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__11)
-		WriteAll(appName+" Feed", true, buffer)
+		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__12)
-		WriteAll(utils.Url("/feed"), true, buffer)
+		WriteAll(appName+" Feed", true, buffer)
 		buffer.WriteString(commentlist__13)
+		WriteAll(utils.Url("/feed"), true, buffer)
+		buffer.WriteString(commentlist__14)
 		if appLogo != "" {
-			buffer.WriteString(commentlist__30)
+			buffer.WriteString(commentlist__33)
 			WriteAll(appLogo, true, buffer)
-			buffer.WriteString(commentlist__31)
+			buffer.WriteString(commentlist__34)
 			WriteAll(appLogo, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		if meta.Description != "" {
-			buffer.WriteString(commentlist__33)
+			buffer.WriteString(commentlist__36)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__34)
+			buffer.WriteString(commentlist__37)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__35)
+			buffer.WriteString(commentlist__38)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		if meta.Image != "" {
-			buffer.WriteString(commentlist__37)
+			buffer.WriteString(commentlist__40)
 			WriteAll(meta.Image, true, buffer)
-			buffer.WriteString(commentlist__38)
+			buffer.WriteString(commentlist__41)
 			WriteAll(meta.Image, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		WriteAll(asset.CssFile("css/light.min.css"), false, buffer)
 		WriteAll(asset.CssFile("editor/tippy-6.3.7.min.css"), false, buffer)
@@ -84,71 +85,126 @@ func PostCompose(topics []*entities.Topic, post *entities.PostMutation, featured
 		WriteAll(asset.CssFile("editor/style.css"), false, buffer)
 		WriteAll(asset.CssFile("css/style.css"), false, buffer)
 		WriteAll(config.Setting("inject_header"), false, buffer)
-		buffer.WriteString(commentlist__14)
-		WriteAll(utils.Url(""), true, buffer)
 		buffer.WriteString(commentlist__15)
+		WriteAll(utils.Url(""), true, buffer)
+		buffer.WriteString(commentlist__16)
 		var logoUrl = config.Setting("app_logo")
 		if logoUrl != "" {
-			buffer.WriteString(commentlist__40)
+			buffer.WriteString(commentlist__43)
 			WriteAll(logoUrl, true, buffer)
-			buffer.WriteString(commentlist__41)
+			buffer.WriteString(commentlist__44)
 			WriteAll(config.Setting("app_name"), true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		} else {
-			buffer.WriteString(commentlist__43)
+			buffer.WriteString(commentlist__46)
 
 		}
-		buffer.WriteString(commentlist__16)
-		WriteAll(meta.Query, true, buffer)
 		buffer.WriteString(commentlist__17)
-		WriteAll(utils.Url("/search"), true, buffer)
+		WriteAll(meta.Query, true, buffer)
 		buffer.WriteString(commentlist__18)
 
+		{
+			var (
+				location = "header"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__19)
+		WriteAll(utils.Url("/search"), true, buffer)
+		buffer.WriteString(commentlist__20)
+
 		if meta.User == nil || meta.User.ID == 0 {
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(utils.Url("/login"), true, buffer)
-			buffer.WriteString(commentlist__45)
+			buffer.WriteString(commentlist__66)
 			WriteAll(utils.Url("/register"), true, buffer)
-			buffer.WriteString(commentlist__46)
+			buffer.WriteString(commentlist__67)
 
 		} else {
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(utils.Url("/posts/new"), true, buffer)
-			buffer.WriteString(commentlist__48)
+			buffer.WriteString(commentlist__69)
 			WriteAll(meta.User.Url(), true, buffer)
-			buffer.WriteString(commentlist__49)
+			buffer.WriteString(commentlist__70)
 			WriteAll(meta.User.Username, true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			if meta.User.AvatarImageUrl != "" {
-				buffer.WriteString(commentlist__57)
+				buffer.WriteString(commentlist__78)
 				WriteAll(meta.User.AvatarImageUrl, true, buffer)
-				buffer.WriteString(commentlist__41)
+				buffer.WriteString(commentlist__44)
 				WriteAll(meta.User.Username, true, buffer)
-				buffer.WriteString(commentlist__13)
+				buffer.WriteString(commentlist__14)
 			} else {
-				buffer.WriteString(commentlist__60)
+				buffer.WriteString(commentlist__81)
 
 			}
-			buffer.WriteString(commentlist__51)
+			buffer.WriteString(commentlist__72)
 
 			if meta.User != nil && meta.User.IsRoot() {
-				buffer.WriteString(commentlist__44)
+				buffer.WriteString(commentlist__50)
 				WriteAll(utils.Url("/manage"), true, buffer)
-				buffer.WriteString(commentlist__62)
+				buffer.WriteString(commentlist__83)
 
 			}
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(meta.User.Url(), true, buffer)
-			buffer.WriteString(commentlist__53)
+			buffer.WriteString(commentlist__74)
 			WriteAll(utils.Url("/posts"), true, buffer)
-			buffer.WriteString(commentlist__54)
+			buffer.WriteString(commentlist__75)
 			WriteAll(utils.Url("/settings"), true, buffer)
-			buffer.WriteString(commentlist__55)
+			buffer.WriteString(commentlist__76)
 			WriteAll(utils.Url("/logout"), true, buffer)
-			buffer.WriteString(commentlist__56)
+			buffer.WriteString(commentlist__77)
 
 		}
-		buffer.WriteString(postcompose__19)
+		buffer.WriteString(managepagecompose__21)
+
+		{
+			buffer.WriteString(commentlist__125)
+			WriteAll(meta.CsrfToken, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+
+		buffer.WriteString(postcompose__22)
 
 		{
 			var (
@@ -156,29 +212,29 @@ func PostCompose(topics []*entities.Topic, post *entities.PostMutation, featured
 			)
 
 			if msgs.Length() > 0 {
-				buffer.WriteString(commentlist__73)
+				buffer.WriteString(commentlist__95)
 				var messages = msgs.Get()
 				for _, msg := range messages {
-					buffer.WriteString(commentlist__75)
+					buffer.WriteString(commentlist__97)
 					WriteAll(msg.Type, true, buffer)
-					buffer.WriteString(commentlist__50)
+					buffer.WriteString(commentlist__48)
 					WriteAll(msg.Message, true, buffer)
-					buffer.WriteString(commentlist__77)
+					buffer.WriteString(commentlist__53)
 				}
-				buffer.WriteString(commentlist__74)
+				buffer.WriteString(commentlist__49)
 			}
 		}
 
-		buffer.WriteString(managepagecompose__21)
+		buffer.WriteString(managepagecompose__24)
 		WriteAll(post.Name+"\n"+post.Content, true, buffer)
-		buffer.WriteString(postcompose__21)
+		buffer.WriteString(postcompose__24)
 
 		if post.Draft {
-			buffer.WriteString(managepagecompose__96)
+			buffer.WriteString(managepagecompose__178)
 		} else {
-			buffer.WriteString(managepagecompose__97)
+			buffer.WriteString(managepagecompose__179)
 		}
-		buffer.WriteString(postcompose__22)
+		buffer.WriteString(postcompose__25)
 
 		{
 			var (
@@ -187,132 +243,282 @@ func PostCompose(topics []*entities.Topic, post *entities.PostMutation, featured
 				selected = post.TopicIDs
 			)
 
-			buffer.WriteString(manageusercompose__140)
+			buffer.WriteString(manageusercompose__206)
 			for _, topic := range topics {
 				var inputId = fmt.Sprintf("topic-%d", topic.ID)
 				if utils.SliceContains(selected, topic.ID) {
-					buffer.WriteString(manageusercompose__142)
+					buffer.WriteString(managerolecompose__121)
 					WriteEscString(inputId, buffer)
-					buffer.WriteString(manageusercompose__143)
+					buffer.WriteString(manageusercompose__209)
 					WriteEscString(name, buffer)
-					buffer.WriteString(managepagecompose__87)
+					buffer.WriteString(fileedit__114)
 					WriteAll(topic.ID, true, buffer)
-					buffer.WriteString(manageusercompose__145)
+					buffer.WriteString(managerolecompose__126)
 					WriteEscString(inputId, buffer)
-					buffer.WriteString(manageusercompose__146)
+					buffer.WriteString(manageusercompose__212)
 					WriteAll(topic.Name, true, buffer)
-					buffer.WriteString(manageusercompose__147)
+					buffer.WriteString(managerolecompose__124)
 
 				} else {
-					buffer.WriteString(manageusercompose__142)
+					buffer.WriteString(managerolecompose__121)
 					WriteEscString(inputId, buffer)
-					buffer.WriteString(manageusercompose__143)
+					buffer.WriteString(manageusercompose__209)
 					WriteEscString(name, buffer)
-					buffer.WriteString(managepagecompose__87)
+					buffer.WriteString(fileedit__114)
 					WriteAll(topic.ID, true, buffer)
-					buffer.WriteString(manageusercompose__145)
+					buffer.WriteString(managerolecompose__126)
 					WriteEscString(inputId, buffer)
-					buffer.WriteString(manageusercompose__152)
+					buffer.WriteString(manageusercompose__218)
 					WriteAll(topic.Name, true, buffer)
-					buffer.WriteString(manageusercompose__147)
+					buffer.WriteString(managerolecompose__124)
 
 				}
 			}
-			buffer.WriteString(commentlist__22)
+			buffer.WriteString(commentlist__24)
 		}
 
-		buffer.WriteString(postcompose__23)
+		buffer.WriteString(commentlist__24)
+		{
+			var (
+				target = "post"
+				values = post.CustomFields
+			)
+
+			for _, field := range cache.CustomFields(target) {
+				buffer.WriteString(managepagecompose__139)
+				WriteAll(field.InputName(), true, buffer)
+				buffer.WriteString(commentlist__48)
+				WriteAll(field.Label, true, buffer)
+				if field.Required {
+					buffer.WriteString(managepagecompose__143)
+				}
+				buffer.WriteString(managepagecompose__141)
+				if field.Type == "textarea" {
+					buffer.WriteString(managepagecompose__144)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(managepagecompose__145)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(values[field.Name], true, buffer)
+					buffer.WriteString(managepagecompose__147)
+				} else if field.Type == "boolean" {
+					if values[field.Name] == "true" {
+						buffer.WriteString(managepagecompose__148)
+						WriteAll(field.InputName(), true, buffer)
+						buffer.WriteString(managepagecompose__149)
+						WriteAll(field.InputName(), true, buffer)
+						buffer.WriteString(managecustomfieldcompose__147)
+					} else {
+						buffer.WriteString(managepagecompose__148)
+						WriteAll(field.InputName(), true, buffer)
+						buffer.WriteString(managepagecompose__149)
+						WriteAll(field.InputName(), true, buffer)
+						buffer.WriteString(commentlist__14)
+					}
+				} else if field.Type == "select" {
+					buffer.WriteString(managepagecompose__154)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(managepagecompose__145)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(managepagecompose__156)
+
+					for _, option := range field.Options {
+						if option == values[field.Name] {
+							buffer.WriteString(filelist__117)
+							WriteAll(option, true, buffer)
+							buffer.WriteString(filelist__118)
+							WriteAll(option, true, buffer)
+							buffer.WriteString(filelist__119)
+						} else {
+							buffer.WriteString(filelist__117)
+							WriteAll(option, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(option, true, buffer)
+							buffer.WriteString(filelist__119)
+						}
+					}
+					buffer.WriteString(managepagecompose__157)
+				} else if field.Type == "number" {
+					buffer.WriteString(managepagecompose__148)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(managepagecompose__165)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(fileedit__114)
+					WriteAll(values[field.Name], true, buffer)
+					buffer.WriteString(commentlist__14)
+				} else if field.Type == "url" {
+					buffer.WriteString(managepagecompose__148)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(managepagecompose__169)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(fileedit__114)
+					WriteAll(values[field.Name], true, buffer)
+					buffer.WriteString(commentlist__14)
+				} else {
+					buffer.WriteString(managepagecompose__148)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(managepagecompose__145)
+					WriteAll(field.InputName(), true, buffer)
+					buffer.WriteString(fileedit__114)
+					WriteAll(values[field.Name], true, buffer)
+					buffer.WriteString(commentlist__14)
+				}
+				if field.Description != "" {
+					buffer.WriteString(managepagecompose__176)
+					WriteAll(field.Description, true, buffer)
+					buffer.WriteString(managepagecompose__177)
+				}
+				buffer.WriteString(commentlist__24)
+			}
+		}
+
+		buffer.WriteString(managepagecompose__32)
 		WriteAll(post.FeaturedImageID, true, buffer)
-		buffer.WriteString(managepagecompose__26)
-		WriteAll(featuredImage.Url(), true, buffer)
-		buffer.WriteString(managepagecompose__27)
+		buffer.WriteString(managepagecompose__33)
+		WriteAll(featuredImage.Url("medium"), true, buffer)
+		buffer.WriteString(managepagecompose__34)
 
 		{
-			buffer.WriteString(managepagecompose__101)
+			buffer.WriteString(managecustomfieldindex__105)
 
 		}
 
-		buffer.WriteString(managepagecompose__28)
+		buffer.WriteString(managecustomfieldcompose__33)
 		WriteAll(config.Setting("app_name"), true, buffer)
-		buffer.WriteString(commentlist__25)
+		buffer.WriteString(commentlist__27)
 
 		if meta.User == nil || meta.User.ID == 0 {
-			buffer.WriteString(commentlist__106)
+			buffer.WriteString(commentlist__131)
 			WriteAll(utils.Url("/login"), true, buffer)
-			buffer.WriteString(commentlist__107)
+			buffer.WriteString(commentlist__132)
 			WriteAll(utils.Url("/register"), true, buffer)
-			buffer.WriteString(commentlist__108)
+			buffer.WriteString(commentlist__133)
 
 		} else {
 			{
-				buffer.WriteString(commentlist__63)
+				buffer.WriteString(commentlist__84)
 				WriteAll(meta.User.AvatarElm("32", "32", false), false, buffer)
-				buffer.WriteString(commentlist__64)
+				buffer.WriteString(commentlist__85)
 				WriteAll(meta.User.Url(), true, buffer)
-				buffer.WriteString(commentlist__50)
+				buffer.WriteString(commentlist__48)
 				WriteAll(meta.User.Name(), true, buffer)
-				buffer.WriteString(commentlist__66)
+				buffer.WriteString(commentlist__87)
 				WriteAll("@"+meta.User.Username, true, buffer)
-				buffer.WriteString(commentlist__67)
+				buffer.WriteString(commentlist__88)
 				WriteAll(utils.Url("/posts/new"), true, buffer)
-				buffer.WriteString(commentlist__68)
+				buffer.WriteString(commentlist__89)
 				WriteAll(utils.Url("/posts"), true, buffer)
-				buffer.WriteString(commentlist__69)
+				buffer.WriteString(commentlist__90)
 				WriteAll(utils.Url("/comments"), true, buffer)
-				buffer.WriteString(commentlist__70)
+				buffer.WriteString(commentlist__91)
 				WriteAll(utils.Url("/files"), true, buffer)
-				buffer.WriteString(commentlist__71)
+				buffer.WriteString(commentlist__92)
+				WriteAll(utils.Url("/invites"), true, buffer)
+				buffer.WriteString(commentlist__93)
 				WriteAll(utils.Url("/settings"), true, buffer)
-				buffer.WriteString(commentlist__72)
+				buffer.WriteString(commentlist__94)
 
 			}
 
 			if meta.User.IsRoot() {
 				{
-					buffer.WriteString(commentlist__119)
+					buffer.WriteString(commentlist__145)
 					WriteAll(utils.Url("/manage"), true, buffer)
-					buffer.WriteString(commentlist__120)
+					buffer.WriteString(commentlist__146)
 					WriteAll(utils.Url("/manage/topics"), true, buffer)
-					buffer.WriteString(commentlist__121)
+					buffer.WriteString(commentlist__147)
 					WriteAll(utils.Url("/manage/posts"), true, buffer)
-					buffer.WriteString(commentlist__122)
+					buffer.WriteString(commentlist__148)
 					WriteAll(utils.Url("/manage/pages"), true, buffer)
-					buffer.WriteString(commentlist__123)
+					buffer.WriteString(commentlist__149)
 					WriteAll(utils.Url("/manage/roles"), true, buffer)
-					buffer.WriteString(commentlist__124)
+					buffer.WriteString(commentlist__150)
 					WriteAll(utils.Url("/manage/users"), true, buffer)
-					buffer.WriteString(commentlist__125)
+					buffer.WriteString(commentlist__151)
 					WriteAll(utils.Url("/manage/comments"), true, buffer)
-					buffer.WriteString(commentlist__126)
+					buffer.WriteString(commentlist__152)
 					WriteAll(utils.Url("/manage/files"), true, buffer)
-					buffer.WriteString(commentlist__127)
+					buffer.WriteString(commentlist__153)
 					WriteAll(utils.Url("/manage/settings"), true, buffer)
-					buffer.WriteString(commentlist__72)
+					buffer.WriteString(commentlist__154)
+					WriteAll(utils.Url("/manage/menus"), true, buffer)
+					buffer.WriteString(commentlist__155)
+					WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+					buffer.WriteString(commentlist__156)
+					WriteAll(utils.Url("/manage/audit"), true, buffer)
+					buffer.WriteString(commentlist__157)
 
 				}
 
 			}
 		}
-		buffer.WriteString(commentlist__26)
+		buffer.WriteString(commentlist__28)
 
-		for _, topic := range cache.Topics {
-			buffer.WriteString(commentlist__106)
+		for _, topic := range cache.Topics() {
+			buffer.WriteString(commentlist__131)
 			WriteAll(topic.Url(), true, buffer)
-			buffer.WriteString(commentlist__49)
+			buffer.WriteString(commentlist__70)
 			WriteAll(topic.Name, true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			WriteAll("#"+topic.Name, true, buffer)
-			buffer.WriteString(commentlist__132)
+			buffer.WriteString(commentlist__52)
 		}
-		buffer.WriteString(commentlist__27)
+		buffer.WriteString(commentlist__29)
+
+		{
+			var (
+				location = "footer"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__30)
 		WriteAll(config.Setting("footer_content"), false, buffer)
-		buffer.WriteString(commentlist__28)
+		buffer.WriteString(commentlist__31)
 		WriteAll(config.Setting("inject_footer"), false, buffer)
 		WriteAll(asset.JsFile("js/layout.js"), false, buffer)
 		WriteAll(asset.JsFile("editor/highlight-11.5.0.min.js"), false, buffer)
 		WriteAll(asset.JsFile("editor/editor.js"), false, buffer)
 		WriteAll(asset.JsFile("js/main.js"), false, buffer)
-		buffer.WriteString(postcompose__31)
+		buffer.WriteString(postcompose__36)
 
 	}
 }
@@ -14,15 +14,15 @@ import (
 )
 
 const (
-	postview__19 = `</ul><label class="menu-trigger"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M3,6H21V8H3V6M3,11H21V13H3V11M3,16H21V18H3V16Z"></path></svg></label></nav></header><div class="wrapper"><div class="container"><div class="layout two-right"><div class="main">`
-	postview__20 = `<article class="box full detail">`
-	postview__22 = `<h1 class="post-name">`
-	postview__23 = `</h1><div class="tags">`
-	postview__25 = `<hr/><h2>`
-	postview__26 = `</h2><div class="comments"><div class="flex">`
-	postview__27 = `<div><form method="post" action="/comments/new"><input type="hidden" name="post_id" value="`
-	postview__28 = `"/><textarea name="content" placeholder="Write your comment here..."></textarea><button type="submit">Comment</button></form></div></div>`
-	postview__29 = `</div></div></article></div><div class="right"><div class="box fixed-sidebar"><h2>Trending posts</h2><div class="posts-list">`
+	postview__21 = `</ul><label class="menu-trigger"><svg viewBox="0 0 24 24"><path fill="currentColor" d="M3,6H21V8H3V6M3,11H21V13H3V11M3,16H21V18H3V16Z"></path></svg></label></nav></header><div class="wrapper"><div class="container"><div class="layout two-right"><div class="main">`
+	postview__22 = `<article class="box full detail">`
+	postview__24 = `<h1 class="post-name">`
+	postview__25 = `</h1><div class="tags">`
+	postview__27 = `<hr/><h2>`
+	postview__28 = `</h2><div class="comments"><div class="flex">`
+	postview__29 = `<div><form method="post" action="/comments/new">`
+	postview__31 = `"/><textarea name="content" placeholder="Write your comment here..."></textarea><button type="submit">Comment</button></form></div></div>`
+	postview__32 = `</div></div></article></div><div class="right"><div class="box fixed-sidebar"><h2>Trending posts</h2><div class="posts-list">`
 )
 
 func PostView(post *entities.Post, relatedPosts []*entities.Post, comments []*entities.Comment) func(meta *entities.Meta, wr *bufio.Writer) {
@@ -35,121 +35,170 @@ func PostView(post *entities.Post, relatedPosts []*entities.Post, comments []*en
 		var appName = config.Setting("app_name")
 		var appLogo = config.Setting("app_logo")
 		buffer.WriteString(commentlist__1)
-		WriteAll(title, true, buffer)
+		WriteAll(meta.CsrfToken, true, buffer)
 		buffer.WriteString(commentlist__2)
-		WriteAll(meta.Canonical, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__3)
-		WriteAll(meta.Type, true, buffer)
-		buffer.WriteString(commentlist__4)
 		WriteAll(meta.Canonical, true, buffer)
+		buffer.WriteString(commentlist__4)
+		WriteAll(meta.Type, true, buffer)
 		buffer.WriteString(commentlist__5)
-		WriteAll(title, true, buffer)
+		WriteAll(meta.Canonical, true, buffer)
 		buffer.WriteString(commentlist__6)
-		WriteAll(appName, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__7)
-		WriteAll(config.Setting("twitter_site"), true, buffer)
+		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__8)
-		WriteAll(title, true, buffer)
+		WriteAll(config.Setting("twitter_site"), true, buffer)
 		buffer.WriteString(commentlist__9)
-		WriteAll(appName, true, buffer)
+		WriteAll(title, true, buffer)
 		buffer.WriteString(commentlist__10)
 		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__11)
-		WriteAll(appName+" Feed", true, buffer)
+		WriteAll(appName, true, buffer)
 		buffer.WriteString(commentlist__12)
-		WriteAll(utils.Url("/feed"), true, buffer)
+		WriteAll(appName+" Feed", true, buffer)
 		buffer.WriteString(commentlist__13)
+		WriteAll(utils.Url("/feed"), true, buffer)
+		buffer.WriteString(commentlist__14)
 		if appLogo != "" {
-			buffer.WriteString(commentlist__30)
+			buffer.WriteString(commentlist__33)
 			WriteAll(appLogo, true, buffer)
-			buffer.WriteString(commentlist__31)
+			buffer.WriteString(commentlist__34)
 			WriteAll(appLogo, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		if meta.Description != "" {
-			buffer.WriteString(commentlist__33)
+			buffer.WriteString(commentlist__36)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__34)
+			buffer.WriteString(commentlist__37)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__35)
+			buffer.WriteString(commentlist__38)
 			WriteAll(meta.Description, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		if meta.Image != "" {
-			buffer.WriteString(commentlist__37)
+			buffer.WriteString(commentlist__40)
 			WriteAll(meta.Image, true, buffer)
-			buffer.WriteString(commentlist__38)
+			buffer.WriteString(commentlist__41)
 			WriteAll(meta.Image, true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		}
 		WriteAll(asset.CssFile("css/light.min.css"), false, buffer)
 		WriteAll(asset.CssFile("css/style.css"), false, buffer)
 		WriteAll(config.Setting("inject_header"), false, buffer)
-		buffer.WriteString(commentlist__14)
-		WriteAll(utils.Url(""), true, buffer)
 		buffer.WriteString(commentlist__15)
+		WriteAll(utils.Url(""), true, buffer)
+		buffer.WriteString(commentlist__16)
 		var logoUrl = config.Setting("app_logo")
 		if logoUrl != "" {
-			buffer.WriteString(commentlist__40)
+			buffer.WriteString(commentlist__43)
 			WriteAll(logoUrl, true, buffer)
-			buffer.WriteString(commentlist__41)
+			buffer.WriteString(commentlist__44)
 			WriteAll(config.Setting("app_name"), true, buffer)
-			buffer.WriteString(commentlist__13)
+			buffer.WriteString(commentlist__14)
 		} else {
-			buffer.WriteString(commentlist__43)
+			buffer.WriteString(commentlist__46)
 
 		}
-		buffer.WriteString(commentlist__16)
-		WriteAll(meta.Query, true, buffer)
 		buffer.WriteString(commentlist__17)
-		WriteAll(utils.Url("/search"), true, buffer)
+		WriteAll(meta.Query, true, buffer)
 		buffer.WriteString(commentlist__18)
 
+		{
+			var (
+				location = "header"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__19)
+		WriteAll(utils.Url("/search"), true, buffer)
+		buffer.WriteString(commentlist__20)
+
 		if meta.User == nil || meta.User.ID == 0 {
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(utils.Url("/login"), true, buffer)
-			buffer.WriteString(commentlist__45)
+			buffer.WriteString(commentlist__66)
 			WriteAll(utils.Url("/register"), true, buffer)
-			buffer.WriteString(commentlist__46)
+			buffer.WriteString(commentlist__67)
 
 		} else {
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(utils.Url("/posts/new"), true, buffer)
-			buffer.WriteString(commentlist__48)
+			buffer.WriteString(commentlist__69)
 			WriteAll(meta.User.Url(), true, buffer)
-			buffer.WriteString(commentlist__49)
+			buffer.WriteString(commentlist__70)
 			WriteAll(meta.User.Username, true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			if meta.User.AvatarImageUrl != "" {
-				buffer.WriteString(commentlist__57)
+				buffer.WriteString(commentlist__78)
 				WriteAll(meta.User.AvatarImageUrl, true, buffer)
-				buffer.WriteString(commentlist__41)
+				buffer.WriteString(commentlist__44)
 				WriteAll(meta.User.Username, true, buffer)
-				buffer.WriteString(commentlist__13)
+				buffer.WriteString(commentlist__14)
 			} else {
-				buffer.WriteString(commentlist__60)
+				buffer.WriteString(commentlist__81)
 
 			}
-			buffer.WriteString(commentlist__51)
+			buffer.WriteString(commentlist__72)
 
 			if meta.User != nil && meta.User.IsRoot() {
-				buffer.WriteString(commentlist__44)
+				buffer.WriteString(commentlist__50)
 				WriteAll(utils.Url("/manage"), true, buffer)
-				buffer.WriteString(commentlist__62)
+				buffer.WriteString(commentlist__83)
 
 			}
-			buffer.WriteString(commentlist__44)
+			buffer.WriteString(commentlist__50)
 			WriteAll(meta.User.Url(), true, buffer)
-			buffer.WriteString(commentlist__53)
+			buffer.WriteString(commentlist__74)
 			WriteAll(utils.Url("/posts"), true, buffer)
-			buffer.WriteString(commentlist__54)
+			buffer.WriteString(commentlist__75)
 			WriteAll(utils.Url("/settings"), true, buffer)
-			buffer.WriteString(commentlist__55)
+			buffer.WriteString(commentlist__76)
 			WriteAll(utils.Url("/logout"), true, buffer)
-			buffer.WriteString(commentlist__56)
+			buffer.WriteString(commentlist__77)
 
 		}
-		buffer.WriteString(postview__19)
+		buffer.WriteString(postview__21)
 
 		{
 			var (
@@ -157,74 +206,82 @@ func PostView(post *entities.Post, relatedPosts []*entities.Post, comments []*en
 			)
 
 			if msgs.Length() > 0 {
-				buffer.WriteString(commentlist__73)
+				buffer.WriteString(commentlist__95)
 				var messages = msgs.Get()
 				for _, msg := range messages {
-					buffer.WriteString(commentlist__75)
+					buffer.WriteString(commentlist__97)
 					WriteAll(msg.Type, true, buffer)
-					buffer.WriteString(commentlist__50)
+					buffer.WriteString(commentlist__48)
 					WriteAll(msg.Message, true, buffer)
-					buffer.WriteString(commentlist__77)
+					buffer.WriteString(commentlist__53)
 				}
-				buffer.WriteString(commentlist__74)
+				buffer.WriteString(commentlist__49)
 			}
 		}
 
-		buffer.WriteString(postview__20)
+		buffer.WriteString(postview__22)
 		if post.FeaturedImage != nil && post.FeaturedImage.ID > 0 {
-			buffer.WriteString(pageview__69)
-			WriteAll(post.FeaturedImage.Url(), true, buffer)
-			buffer.WriteString(commentlist__41)
+			buffer.WriteString(pageview__91)
+			WriteAll(post.FeaturedImage.Url("large"), true, buffer)
+			buffer.WriteString(commentlist__44)
 			WriteAll(post.Name, true, buffer)
-			buffer.WriteString(pageview__71)
+			buffer.WriteString(managecustomfieldcompose__32)
 
 		}
-		buffer.WriteString(index__81)
+		buffer.WriteString(index__102)
 		{
 			var (
 				post = post
 			)
 
-			buffer.WriteString(commentlist__63)
+			buffer.WriteString(commentlist__84)
 			WriteAll(post.User.AvatarElm("32", "32", false), false, buffer)
-			buffer.WriteString(commentlist__64)
+			buffer.WriteString(commentlist__85)
 			WriteAll(post.User.Url(), true, buffer)
-			buffer.WriteString(commentlist__49)
+			buffer.WriteString(commentlist__70)
 			WriteAll(post.User.Name(), true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			WriteAll(post.User.Name(), true, buffer)
-			buffer.WriteString(index__96)
+			buffer.WriteString(index__117)
 			WriteAll(post.CreatedAt.Format("2006-01-02T15:04:05-0700"), true, buffer)
-			buffer.WriteString(index__97)
+			buffer.WriteString(index__118)
 			WriteAll(post.CreatedAt.Format("January 2, 2006"), true, buffer)
-			buffer.WriteString(index__98)
+			buffer.WriteString(index__119)
 			WriteEscString(fmt.Sprintf("%d views", post.ViewCount), buffer)
-			buffer.WriteString(index__99)
+			buffer.WriteString(index__120)
 			WriteEscString(fmt.Sprintf("%d comments", post.CommentCount), buffer)
-			buffer.WriteString(index__100)
+			buffer.WriteString(index__121)
 
 		}
 
-		buffer.WriteString(postview__22)
+		buffer.WriteString(postview__24)
 		WriteAll(post.Name, true, buffer)
-		buffer.WriteString(postview__23)
+		buffer.WriteString(postview__25)
 
 		for _, topic := range post.Topics {
-			buffer.WriteString(commentlist__106)
+			buffer.WriteString(commentlist__131)
 			WriteAll(topic.Url(), true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			WriteAll("#"+topic.Name, true, buffer)
-			buffer.WriteString(commentlist__132)
+			buffer.WriteString(commentlist__52)
 		}
-		buffer.WriteString(commentlist__22)
+		buffer.WriteString(commentlist__24)
 		WriteAll(post.ContentHTML, false, buffer)
-		buffer.WriteString(postview__25)
+		buffer.WriteString(postview__27)
 		WriteEscString(fmt.Sprintf("Discussion (%d)", post.CommentCount), buffer)
-		buffer.WriteString(postview__26)
+		buffer.WriteString(postview__28)
 		WriteAll(meta.User.AvatarElm("50", "50", false), false, buffer)
-		buffer.WriteString(postview__27)
+		buffer.WriteString(postview__29)
+
+		{
+			buffer.WriteString(commentlist__125)
+			WriteAll(meta.CsrfToken, true, buffer)
+			buffer.WriteString(commentlist__14)
+		}
+
+		buffer.WriteString(commentlist__122)
 		WriteAll(post.ID, true, buffer)
-		buffer.WriteString(postview__28)
+		buffer.WriteString(postview__31)
 
 		for _, comment := range comments {
 			var canEdit = meta.User != nil && comment.UserID == meta.User.ID
@@ -237,67 +294,74 @@ func PostView(post *entities.Post, relatedPosts []*entities.Post, comments []*en
 				)
 
 				if extraInfo {
-					buffer.WriteString(commentlist__86)
+					buffer.WriteString(commentlist__108)
 					WriteAll(comment.Post.Url(), true, buffer)
-					buffer.WriteString(commentlist__87)
+					buffer.WriteString(commentlist__109)
 					WriteAll(comment.Post.Name, true, buffer)
-					buffer.WriteString(commentlist__88)
+					buffer.WriteString(commentlist__110)
 
 				}
-				buffer.WriteString(commentlist__78)
+				buffer.WriteString(commentlist__100)
 				WriteEscString(fmt.Sprintf("comment-%d", comment.ID), buffer)
-				buffer.WriteString(commentlist__50)
+				buffer.WriteString(commentlist__48)
 				WriteAll(comment.User.AvatarElm("30", "30", false), false, buffer)
-				buffer.WriteString(commentlist__64)
+				buffer.WriteString(commentlist__85)
 				WriteAll(comment.User.Url(), true, buffer)
-				buffer.WriteString(commentlist__50)
+				buffer.WriteString(commentlist__48)
 				WriteAll(comment.User.Name(), true, buffer)
-				buffer.WriteString(commentlist__82)
+				buffer.WriteString(commentlist__104)
 				WriteAll(comment.CreatedAt.Format("January 2, 2006 15:04 MST"), true, buffer)
-				buffer.WriteString(commentlist__83)
+				buffer.WriteString(commentlist__105)
 				WriteAll(comment.ContentHTML, false, buffer)
 				if editCondition {
-					buffer.WriteString(commentlist__89)
+					buffer.WriteString(commentlist__111)
 					WriteAll(comment.ID, true, buffer)
-					buffer.WriteString(commentlist__90)
+					buffer.WriteString(commentlist__112)
 					WriteAll(comment.ID, true, buffer)
-					buffer.WriteString(commentlist__91)
+					buffer.WriteString(commentlist__113)
 
 					if extraInfo {
 						var commentUrl = fmt.Sprintf("%s#comment-%d", comment.Post.Url(), comment.ID)
 						var postCommentsUrl = fmt.Sprintf("/manage/comments?post=%d", postID)
 						var userCommentsUrl = fmt.Sprintf("/manage/comments?user=%d", postID)
-						buffer.WriteString(commentlist__93)
+						buffer.WriteString(commentlist__115)
 						WriteEscString(commentUrl, buffer)
-						buffer.WriteString(commentlist__94)
+						buffer.WriteString(commentlist__116)
 
 						if meta.User.IsRoot() {
-							buffer.WriteString(commentlist__95)
+							buffer.WriteString(commentlist__117)
 							WriteEscString(postCommentsUrl, buffer)
-							buffer.WriteString(commentlist__96)
+							buffer.WriteString(commentlist__118)
 							WriteEscString(userCommentsUrl, buffer)
-							buffer.WriteString(commentlist__97)
+							buffer.WriteString(commentlist__119)
 
 						}
 					}
-					buffer.WriteString(commentlist__22)
+					buffer.WriteString(commentlist__24)
 				}
-				buffer.WriteString(commentlist__22)
+				buffer.WriteString(commentlist__24)
 				if editCondition {
-					buffer.WriteString(commentlist__98)
+					buffer.WriteString(commentlist__120)
 					WriteEscString(fmt.Sprintf("/comments/%d", comment.ID), buffer)
-					buffer.WriteString(commentlist__99)
+					buffer.WriteString(commentlist__48)
+					{
+						buffer.WriteString(commentlist__125)
+						WriteAll(meta.CsrfToken, true, buffer)
+						buffer.WriteString(commentlist__14)
+					}
+
+					buffer.WriteString(commentlist__122)
 					WriteAll(postID, true, buffer)
-					buffer.WriteString(commentlist__100)
+					buffer.WriteString(commentlist__123)
 					WriteAll(comment.Content, true, buffer)
-					buffer.WriteString(commentlist__101)
+					buffer.WriteString(commentlist__124)
 
 				}
-				buffer.WriteString(commentlist__85)
+				buffer.WriteString(commentlist__107)
 			}
 
 		}
-		buffer.WriteString(postview__29)
+		buffer.WriteString(postview__32)
 
 		for pos, post := range relatedPosts {
 			{
@@ -306,114 +370,169 @@ func PostView(post *entities.Post, relatedPosts []*entities.Post, comments []*en
 					pos  = pos + 1
 				)
 
-				buffer.WriteString(index__109)
+				buffer.WriteString(index__130)
 
 				if pos > 0 {
-					buffer.WriteString(index__115)
+					buffer.WriteString(index__136)
 					WriteEscString(fmt.Sprintf("# %d", pos), buffer)
-					buffer.WriteString(index__116)
+					buffer.WriteString(filelist__106)
 				}
-				buffer.WriteString(commentlist__106)
+				buffer.WriteString(commentlist__131)
 				WriteAll(post.Url(), true, buffer)
-				buffer.WriteString(commentlist__49)
+				buffer.WriteString(commentlist__70)
 				WriteAll(post.Name, true, buffer)
-				buffer.WriteString(commentlist__50)
+				buffer.WriteString(commentlist__48)
 				WriteAll(post.Name, true, buffer)
-				buffer.WriteString(index__113)
+				buffer.WriteString(index__134)
 
 				for _, topic := range post.Topics {
-					buffer.WriteString(commentlist__106)
+					buffer.WriteString(commentlist__131)
 					WriteAll(topic.Url(), true, buffer)
-					buffer.WriteString(commentlist__49)
+					buffer.WriteString(commentlist__70)
 					WriteAll(topic.Name, true, buffer)
-					buffer.WriteString(commentlist__50)
+					buffer.WriteString(commentlist__48)
 					WriteAll("#"+topic.Name, true, buffer)
-					buffer.WriteString(commentlist__132)
+					buffer.WriteString(commentlist__52)
 				}
-				buffer.WriteString(index__114)
+				buffer.WriteString(index__135)
 			}
 
 		}
-		buffer.WriteString(index__26)
+		buffer.WriteString(index__28)
 		WriteAll(config.Setting("app_name"), true, buffer)
-		buffer.WriteString(commentlist__25)
+		buffer.WriteString(commentlist__27)
 
 		if meta.User == nil || meta.User.ID == 0 {
-			buffer.WriteString(commentlist__106)
+			buffer.WriteString(commentlist__131)
 			WriteAll(utils.Url("/login"), true, buffer)
-			buffer.WriteString(commentlist__107)
+			buffer.WriteString(commentlist__132)
 			WriteAll(utils.Url("/register"), true, buffer)
-			buffer.WriteString(commentlist__108)
+			buffer.WriteString(commentlist__133)
 
 		} else {
 			{
-				buffer.WriteString(commentlist__63)
+				buffer.WriteString(commentlist__84)
 				WriteAll(meta.User.AvatarElm("32", "32", false), false, buffer)
-				buffer.WriteString(commentlist__64)
+				buffer.WriteString(commentlist__85)
 				WriteAll(meta.User.Url(), true, buffer)
-				buffer.WriteString(commentlist__50)
+				buffer.WriteString(commentlist__48)
 				WriteAll(meta.User.Name(), true, buffer)
-				buffer.WriteString(commentlist__66)
+				buffer.WriteString(commentlist__87)
 				WriteAll("@"+meta.User.Username, true, buffer)
-				buffer.WriteString(commentlist__67)
+				buffer.WriteString(commentlist__88)
 				WriteAll(utils.Url("/posts/new"), true, buffer)
-				buffer.WriteString(commentlist__68)
+				buffer.WriteString(commentlist__89)
 				WriteAll(utils.Url("/posts"), true, buffer)
-				buffer.WriteString(commentlist__69)
+				buffer.WriteString(commentlist__90)
 				WriteAll(utils.Url("/comments"), true, buffer)
-				buffer.WriteString(commentlist__70)
+				buffer.WriteString(commentlist__91)
 				WriteAll(utils.Url("/files"), true, buffer)
-				buffer.WriteString(commentlist__71)
+				buffer.WriteString(commentlist__92)
+				WriteAll(utils.Url("/invites"), true, buffer)
+				buffer.WriteString(commentlist__93)
 				WriteAll(utils.Url("/settings"), true, buffer)
-				buffer.WriteString(commentlist__72)
+				buffer.WriteString(commentlist__94)
 
 			}
 
 			if meta.User.IsRoot() {
 				{
-					buffer.WriteString(commentlist__119)
+					buffer.WriteString(commentlist__145)
 					WriteAll(utils.Url("/manage"), true, buffer)
-					buffer.WriteString(commentlist__120)
+					buffer.WriteString(commentlist__146)
 					WriteAll(utils.Url("/manage/topics"), true, buffer)
-					buffer.WriteString(commentlist__121)
+					buffer.WriteString(commentlist__147)
 					WriteAll(utils.Url("/manage/posts"), true, buffer)
-					buffer.WriteString(commentlist__122)
+					buffer.WriteString(commentlist__148)
 					WriteAll(utils.Url("/manage/pages"), true, buffer)
-					buffer.WriteString(commentlist__123)
+					buffer.WriteString(commentlist__149)
 					WriteAll(utils.Url("/manage/roles"), true, buffer)
-					buffer.WriteString(commentlist__124)
+					buffer.WriteString(commentlist__150)
 					WriteAll(utils.Url("/manage/users"), true, buffer)
-					buffer.WriteString(commentlist__125)
+					buffer.WriteString(commentlist__151)
 					WriteAll(utils.Url("/manage/comments"), true, buffer)
-					buffer.WriteString(commentlist__126)
+					buffer.WriteString(commentlist__152)
 					WriteAll(utils.Url("/manage/files"), true, buffer)
-					buffer.WriteString(commentlist__127)
+					buffer.WriteString(commentlist__153)
 					WriteAll(utils.Url("/manage/settings"), true, buffer)
-					buffer.WriteString(commentlist__72)
+					buffer.WriteString(commentlist__154)
+					WriteAll(utils.Url("/manage/menus"), true, buffer)
+					buffer.WriteString(commentlist__155)
+					WriteAll(utils.Url("/manage/custom-fields"), true, buffer)
+					buffer.WriteString(commentlist__156)
+					WriteAll(utils.Url("/manage/audit"), true, buffer)
+					buffer.WriteString(commentlist__157)
 
 				}
 
 			}
 		}
-		buffer.WriteString(commentlist__26)
+		buffer.WriteString(commentlist__28)
 
-		for _, topic := range cache.Topics {
-			buffer.WriteString(commentlist__106)
+		for _, topic := range cache.Topics() {
+			buffer.WriteString(commentlist__131)
 			WriteAll(topic.Url(), true, buffer)
-			buffer.WriteString(commentlist__49)
+			buffer.WriteString(commentlist__70)
 			WriteAll(topic.Name, true, buffer)
-			buffer.WriteString(commentlist__50)
+			buffer.WriteString(commentlist__48)
 			WriteAll("#"+topic.Name, true, buffer)
-			buffer.WriteString(commentlist__132)
+			buffer.WriteString(commentlist__52)
 		}
-		buffer.WriteString(commentlist__27)
+		buffer.WriteString(commentlist__29)
+
+		{
+			var (
+				location = "footer"
+			)
+
+			if len(cache.Menu(location).Items) > 0 {
+				buffer.WriteString(commentlist__47)
+				WriteEscString("nav-menu nav-menu-"+location, buffer)
+				buffer.WriteString(commentlist__48)
+				for _, item := range cache.Menu(location).Items {
+					buffer.WriteString(commentlist__50)
+					WriteAll(item.Link, true, buffer)
+					buffer.WriteString(commentlist__48)
+					WriteAll(item.Label, true, buffer)
+					buffer.WriteString(commentlist__52)
+					if len(item.Children) > 0 {
+						buffer.WriteString(commentlist__54)
+						for _, child := range item.Children {
+							buffer.WriteString(commentlist__50)
+							WriteAll(child.Link, true, buffer)
+							buffer.WriteString(commentlist__48)
+							WriteAll(child.Label, true, buffer)
+							buffer.WriteString(commentlist__52)
+							if len(child.Children) > 0 {
+								buffer.WriteString(commentlist__54)
+								for _, grandchild := range child.Children {
+									buffer.WriteString(commentlist__50)
+									WriteAll(grandchild.Link, true, buffer)
+									buffer.WriteString(commentlist__48)
+									WriteAll(grandchild.Label, true, buffer)
+									buffer.WriteString(commentlist__64)
+
+								}
+								buffer.WriteString(commentlist__49)
+							}
+							buffer.WriteString(commentlist__53)
+						}
+						buffer.WriteString(commentlist__49)
+					}
+					buffer.WriteString(commentlist__53)
+				}
+				buffer.WriteString(commentlist__49)
+			}
+		}
+
+		buffer.WriteString(commentlist__30)
 		WriteAll(config.Setting("footer_content"), false, buffer)
-		buffer.WriteString(commentlist__28)
+		buffer.WriteString(commentlist__31)
 		WriteAll(config.Setting("inject_footer"), false, buffer)
 		WriteAll(asset.JsFile("js/layout.js"), false, buffer)
 		WriteAll(asset.JsFile("editor/highlight-11.5.0.min.js"), false, buffer)
 		WriteAll(asset.JsFile("js/main.js"), false, buffer)
-		buffer.WriteString(error__26)
+		buffer.WriteString(error__29)
 
 	}
 }